@@ -2,15 +2,53 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
 
+	"golang_modular_monolith/internal/shared/application"
 	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/apiversion"
+	"golang_modular_monolith/internal/shared/infrastructure/audit"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/authz"
+	"golang_modular_monolith/internal/shared/infrastructure/cache"
+	"golang_modular_monolith/internal/shared/infrastructure/compat"
+	"golang_modular_monolith/internal/shared/infrastructure/conditional"
 	"golang_modular_monolith/internal/shared/infrastructure/config"
 	"golang_modular_monolith/internal/shared/infrastructure/database"
+	"golang_modular_monolith/internal/shared/infrastructure/debug"
 	"golang_modular_monolith/internal/shared/infrastructure/eventbus"
+	"golang_modular_monolith/internal/shared/infrastructure/eventstream"
+	"golang_modular_monolith/internal/shared/infrastructure/health"
+	"golang_modular_monolith/internal/shared/infrastructure/httplimit"
+	"golang_modular_monolith/internal/shared/infrastructure/httpmiddleware"
+	"golang_modular_monolith/internal/shared/infrastructure/idempotency"
+	"golang_modular_monolith/internal/shared/infrastructure/ipfilter"
+	"golang_modular_monolith/internal/shared/infrastructure/lock"
+	"golang_modular_monolith/internal/shared/infrastructure/logging"
+	"golang_modular_monolith/internal/shared/infrastructure/mailer"
+	"golang_modular_monolith/internal/shared/infrastructure/metrics"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+	"golang_modular_monolith/internal/shared/infrastructure/ratelimit"
 	"golang_modular_monolith/internal/shared/infrastructure/registry"
+	"golang_modular_monolith/internal/shared/infrastructure/requestid"
+	"golang_modular_monolith/internal/shared/infrastructure/resilience"
+	"golang_modular_monolith/internal/shared/infrastructure/retention"
+	"golang_modular_monolith/internal/shared/infrastructure/search"
+	"golang_modular_monolith/internal/shared/infrastructure/status"
+	"golang_modular_monolith/internal/shared/infrastructure/tenancy"
+	"golang_modular_monolith/internal/shared/infrastructure/tracing"
+	"golang_modular_monolith/internal/shared/infrastructure/usage"
+	"golang_modular_monolith/internal/shared/infrastructure/worker"
 
 	// Import modules package to trigger auto-registration of all modules
 	"golang_modular_monolith/internal/modules"
@@ -26,47 +64,217 @@ func main() {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
-	log.Printf("🔧 Configuration loaded successfully")
-	log.Printf("📱 App: %s v%s (%s)", cfg.App.Name, cfg.App.Version, cfg.App.Environment)
-	log.Printf("🌐 Server: %s", cfg.GetServerAddress())
-	log.Printf("🗄️ Databases: %v", cfg.GetAvailableDatabases())
+	// Initialize the shared logger; the standard library logger and shared packages write through it,
+	// and each module logs through its own logger, at its own level
+	loggers, err := logging.New(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize logging: %v", err)
+	}
+	logger := loggers.Logger()
+	defer func() { _ = logger.Sync() }()
+	zap.ReplaceGlobals(logger)
+	zap.RedirectStdLog(logger)
+
+	logger.Info("configuration loaded",
+		zap.String("server", cfg.GetServerAddress()),
+		zap.Strings("databases", cfg.GetAvailableDatabases()),
+	)
+
+	// Initialize tracing, when features.tracing_enabled is set
+	if tracing.Enabled(cfg) {
+		shutdown, err := tracing.Setup(context.Background(), cfg)
+		if err != nil {
+			logger.Fatal("failed to initialize tracing", zap.Error(err))
+		}
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdown(ctx); err != nil {
+				logger.Warn("failed to flush traces", zap.Error(err))
+			}
+		}()
+		logger.Info("tracing enabled", zap.String("endpoint", cfg.Tracing.Endpoint), zap.Float64("sample_ratio", cfg.Tracing.SampleRatio))
+	}
 
 	// Initialize database manager with Viper config
-	if err := initDatabases(cfg); err != nil {
-		log.Fatalf("Failed to initialize databases: %v", err)
+	if err := initDatabases(cfg, logger); err != nil {
+		logger.Fatal("failed to initialize databases", zap.Error(err))
+	}
+
+	// Refuse to run modules against an outdated schema or incompatible module versions
+	if err := checkCompatibility(cfg); err != nil {
+		logger.Fatal("incompatible modules", zap.Error(err))
+	}
+
+	// Initialize the JWT token service used by login and the auth middleware
+	tokens, err := auth.InitializeWithConfig(cfg)
+	if err != nil {
+		logger.Fatal("failed to initialize authentication", zap.Error(err))
+	}
+
+	// Tenants served by the deployment, when multi-tenancy is enabled
+	tenants, err := tenancy.InitializeWithConfig(cfg, tokens)
+	if err != nil {
+		logger.Fatal("failed to initialize tenancy", zap.Error(err))
 	}
 
 	// Initialize event bus
 	eventBus := eventbus.NewInMemoryEventBus()
 
+	// Locks making scheduled jobs run on one instance at a time
+	locker, err := lock.NewFromConfig(cfg)
+	if err != nil {
+		logger.Fatal("failed to initialize locks", zap.Error(err))
+	}
+
+	// Cache shared by the modules enabling caching, each in its own namespace
+	sharedCache, err := cache.NewFromConfig(cfg)
+	if err != nil {
+		logger.Fatal("failed to initialize cache", zap.Error(err))
+	}
+	defer sharedCache.Close()
+
+	// Outbound email of the modules, captured by the sandbox unless a transport is configured
+	mail, err := mailer.NewFromConfig(cfg)
+	if err != nil {
+		logger.Fatal("failed to initialize mail", zap.Error(err))
+	}
+	logger.Info("mail transport configured", zap.String("transport", mail.Transport().Name()))
+	if mail.Sandbox() != nil && cfg.IsProduction() {
+		logger.Warn("emails are captured by the mail sandbox instead of being delivered")
+	}
+
+	// Search engine modules index their read models in, when one is configured
+	searchEngine, err := search.InitializeWithConfig(cfg)
+	if err != nil {
+		logger.Fatal("failed to initialize search", zap.Error(err))
+	}
+
+	// Initialize the audit log shared by the modules
+	auditLog, err := initAudit(cfg, locker, logger)
+	if err != nil {
+		logger.Fatal("failed to initialize audit log", zap.Error(err))
+	}
+
+	// Count the requests of each consumer to each route
+	usageRecorder, err := initUsage(cfg, logger)
+	if err != nil {
+		logger.Fatal("failed to initialize usage analytics", zap.Error(err))
+	}
+
 	// Load enabled modules
-	moduleRegistry, err := initModules(cfg, eventBus)
+	moduleRegistry, err := initModules(cfg, eventBus, auditLog, sharedCache, mail, loggers)
+	if err != nil {
+		logger.Fatal("failed to initialize modules", zap.Error(err))
+	}
+
+	// Every command of a module dispatching commands on a bus runs in a transaction of its database
+	if err := initCommandBuses(cfg, moduleRegistry, auditLog); err != nil {
+		logger.Fatal("failed to initialize command buses", zap.Error(err))
+	}
+
+	// Retention policies of the modules, applied on schedule when global.retention.enabled is set
+	retentionScheduler, err := initRetention(cfg, moduleRegistry, locker, auditLog)
+	if err != nil {
+		logger.Fatal("failed to initialize retention", zap.Error(err))
+	}
+
+	// Health checks of the databases, modules, Vault, event bus and external services
+	checks, err := healthChecks(cfg, moduleRegistry, eventBus, searchEngine)
 	if err != nil {
-		log.Fatalf("Failed to initialize modules: %v", err)
+		logger.Fatal("failed to initialize health checks", zap.Error(err))
+	}
+
+	// Stream domain events to HTTP clients
+	events := eventstream.NewBroker()
+	if err := eventBus.Subscribe(events); err != nil {
+		logger.Fatal("failed to subscribe the event stream", zap.Error(err))
 	}
 
 	// Initialize Gin router
-	router := initRouter(cfg, moduleRegistry)
+	router, admin, err := initRouter(cfg, moduleRegistry, tokens, tenants, checks, eventBus, events, auditLog, usageRecorder, mail, retentionScheduler, loggers)
+	if err != nil {
+		logger.Fatal("failed to initialize router", zap.Error(err))
+	}
+
+	// Shut down gracefully on SIGINT or SIGTERM; a second signal kills the process
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	// Start modules
-	ctx := context.Background()
 	if err := moduleRegistry.StartAll(ctx); err != nil {
-		log.Fatalf("Failed to start modules: %v", err)
+		logger.Fatal("failed to start modules", zap.Error(err))
+	}
+	if cfg.Modules != nil && cfg.Modules.Global.Retention.Enabled {
+		if err := retentionScheduler.Start(worker.NewManager("retention", logger)); err != nil {
+			logger.Fatal("failed to start retention", zap.Error(err))
+		}
+		logger.Info("retention scheduled", zap.Int("policies", len(retentionScheduler.Policies())))
 	}
 
 	// Start server
-	log.Printf("Starting server on port %s", cfg.App.Port)
-	if err := router.Run(cfg.GetServerAddress()); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	server, err := newServer(cfg, router)
+	if err != nil {
+		logger.Fatal("failed to configure server", zap.Error(err))
+	}
+	var adminServer *http.Server
+	if admin != nil {
+		if adminServer, err = newAdminServer(cfg, admin); err != nil {
+			logger.Fatal("failed to configure admin server", zap.Error(err))
+		}
+		go serveAdmin(adminServer, logger)
+	}
+	served := make(chan error, 1)
+	go func() { served <- serve(cfg, server, logger) }()
+
+	// In-flight requests finish before the modules stop, and the modules before their connections
+	// close; the admin listener keeps answering probes until the end
+	var shutdown shutdownHooks
+	shutdown.add("server", server.Shutdown)
+	shutdown.add("workers", stopSharedWorkers(moduleRegistry))
+	shutdown.add("modules", moduleRegistry.StopAll)
+	shutdown.add("databases", func(context.Context) error { return database.GetGlobalManager().CloseAll() })
+	if adminServer != nil {
+		shutdown.add("admin server", adminServer.Shutdown)
+	}
+
+	select {
+	case err := <-served:
+		logger.Fatal("failed to start server", zap.Error(err))
+	case <-ctx.Done():
 	}
+	stop()
+
+	timeouts, err := cfg.Server.GetTimeouts()
+	if err != nil {
+		logger.Fatal("failed to configure shutdown", zap.Error(err))
+	}
+	logger.Info("shutting down", zap.Duration("timeout", timeouts.Shutdown))
+	shutdown.run(timeouts.Shutdown, logger)
+	logger.Info("server stopped")
 }
 
 // initDatabases initializes all module databases using Viper config
-func initDatabases(cfg *config.Config) error {
-	log.Println("Initializing databases...")
+func initDatabases(cfg *config.Config, logger *zap.Logger) error {
+	logger.Info("initializing databases")
 
 	// Initialize database manager with Viper config
 	manager := database.InitializeWithConfig(cfg)
+	if tracing.Enabled(cfg) {
+		manager.Use(tracing.GORMPlugin{})
+	}
+	// Installed even without multi-tenancy, so that records saved outside a tenant keep theirs
+	manager.Use(tenancy.NewPlugin())
+	if cfg.Modules != nil {
+		slowQuery := cfg.Modules.Global.Database.SlowQuery
+		threshold, err := slowQuery.GetThreshold()
+		if err != nil {
+			return err
+		}
+		if threshold > 0 {
+			manager.Use(database.NewSlowQueryPlugin(threshold, slowQuery.SampleRatio))
+		}
+	}
 
 	// Verify all database connections
 	for _, dbName := range cfg.GetAvailableDatabases() {
@@ -78,9 +286,140 @@ func initDatabases(cfg *config.Config) error {
 	return nil
 }
 
+// checkCompatibility verifies the enabled modules' dependency version constraints and database schema versions
+func checkCompatibility(cfg *config.Config) error {
+	if cfg.Modules == nil {
+		return nil
+	}
+	if err := compat.CheckDependencies(cfg.Modules); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return compat.CheckSchemas(ctx, cfg.Modules)
+}
+
+// initAudit creates the audit log in the database named by global.audit.database, or in memory,
+// and starts purging the entries older than its retention, on one instance at a time
+func initAudit(cfg *config.Config, locker *lock.Locker, logger *zap.Logger) (audit.Store, error) {
+	var settings config.AuditGlobalConfig
+	if cfg.Modules != nil {
+		settings = cfg.Modules.Global.Audit
+	}
+
+	var store audit.Store = audit.NewMemoryStore()
+	if settings.Database != "" {
+		db, err := database.GetGlobalManager().GetConnection(settings.Database)
+		if err != nil {
+			return nil, err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if store, err = audit.NewPostgresStore(ctx, db); err != nil {
+			return nil, err
+		}
+	} else {
+		logger.Warn("global.audit.database is not set; the audit log is kept in memory and lost on restart")
+	}
+
+	retention, err := settings.GetRetention()
+	if err != nil {
+		return nil, err
+	}
+	if retention > 0 {
+		if err := audit.StartRetention(worker.NewManager("audit", logger), locker, store, retention); err != nil {
+			return nil, err
+		}
+	}
+	return store, nil
+}
+
+// initCommandBuses wraps the command bus of every module implementing application.CommandDispatcher
+// in a span when tracing is enabled, audit.CommandMiddleware and application.TransactionMiddleware,
+// over the module's database
+// Commands are recorded in the audit log once their transaction has committed or rolled back
+func initCommandBuses(cfg *config.Config, moduleRegistry *domain.ModuleRegistry, auditLog audit.Store) error {
+	manager := database.GetGlobalManager()
+	for _, name := range moduleRegistry.GetModuleNames() {
+		module, _ := moduleRegistry.GetModule(name)
+		dispatcher, ok := module.(application.CommandDispatcher)
+		if !ok {
+			continue
+		}
+		db, err := manager.GetConnection(name)
+		if err != nil {
+			return fmt.Errorf("failed to get database of module %s: %w", name, err)
+		}
+		if tracing.Enabled(cfg) {
+			dispatcher.CommandBus().Use(tracing.CommandMiddleware())
+		}
+		dispatcher.CommandBus().Use(audit.CommandMiddleware(auditLog, name))
+		dispatcher.CommandBus().Use(application.TransactionMiddleware(database.NewUnitOfWork(db)))
+	}
+	return nil
+}
+
+// initRetention creates the scheduler of the retention policies declared by the modules implementing
+// retention.Retained, with the settings and archive of global.retention
+func initRetention(cfg *config.Config, moduleRegistry *domain.ModuleRegistry, locker *lock.Locker, auditLog audit.Store) (*retention.Scheduler, error) {
+	options, err := retention.OptionsFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make(map[string][]retention.Policy)
+	for _, name := range moduleRegistry.GetModuleNames() {
+		module, _ := moduleRegistry.GetModule(name)
+		if retained, ok := module.(retention.Retained); ok && len(retained.RetentionPolicies()) > 0 {
+			policies[name] = retained.RetentionPolicies()
+		}
+	}
+	return retention.NewScheduler(policies, locker, auditLog, options), nil
+}
+
+// initUsage creates the usage counters, kept in the database named by global.usage.database or in
+// memory, and starts flushing them every global.usage.flush_interval
+func initUsage(cfg *config.Config, logger *zap.Logger) (*usage.Recorder, error) {
+	var settings config.UsageGlobalConfig
+	if cfg.Modules != nil {
+		settings = cfg.Modules.Global.Usage
+	}
+
+	var store usage.Store = usage.NewMemoryStore()
+	if settings.Database != "" {
+		db, err := database.GetGlobalManager().GetConnection(settings.Database)
+		if err != nil {
+			return nil, err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if store, err = usage.NewPostgresStore(ctx, db); err != nil {
+			return nil, err
+		}
+	} else {
+		logger.Warn("global.usage.database is not set; usage counts are kept in memory and lost on restart")
+	}
+
+	interval, err := settings.GetFlushInterval()
+	if err != nil {
+		return nil, err
+	}
+	if interval == 0 {
+		interval = usage.DefaultFlushInterval
+	}
+
+	recorder := usage.NewRecorder(store)
+	if err := recorder.Start(worker.NewManager("usage", logger), interval); err != nil {
+		return nil, err
+	}
+	return recorder, nil
+}
+
 // initModules loads and initializes all enabled modules
-func initModules(cfg *config.Config, eventBus domain.EventBus) (*domain.ModuleRegistry, error) {
-	log.Println("🔧 Initializing modules...")
+func initModules(cfg *config.Config, eventBus domain.EventBus, auditLog audit.Store, sharedCache cache.Cache, mail *mailer.Mailer, loggers *logging.Factory) (*domain.ModuleRegistry, error) {
+	logger := loggers.Logger()
+	logger.Info("initializing modules")
 
 	// Get global module manager
 	manager := registry.GetGlobalManager()
@@ -93,55 +432,592 @@ func initModules(cfg *config.Config, eventBus domain.EventBus) (*domain.ModuleRe
 	// Get module registry
 	moduleRegistry := manager.GetRegistry()
 
-	// Initialize all modules with dependencies
+	// Initialize all modules with dependencies; each module receives its own config, logger and
+	// mailer, and a namespace of the shared cache when it sets features.caching_enabled
 	deps := domain.ModuleDependencies{
-		EventBus: eventBus,
-		Config:   cfg, // Pass full config, modules can extract what they need
+		EventBus:     eventBus,
+		PublicAPIs:   moduleRegistry.PublicAPIs(),
+		Audit:        auditLog,
+		Logger:       logger,
+		ModuleLogger: loggers.Module,
+		ModuleCache: func(module string) domain.Cache {
+			if cfg.Modules == nil || !cfg.Modules.Modules[module].Features.CachingEnabled {
+				return nil
+			}
+			return cache.Namespace(sharedCache, module)
+		},
+		ModuleMailer: func(module string) domain.Mailer {
+			return mail.ForModule(module)
+		},
 	}
 
-	if err := moduleRegistry.InitializeAll(deps); err != nil {
+	if err := moduleRegistry.InitializeAll(context.Background(), deps); err != nil {
 		return nil, err
 	}
 
-	log.Printf("✅ Modules initialized successfully: %v", moduleRegistry.GetModuleNames())
+	logger.Info("modules initialized", zap.Strings("modules", moduleRegistry.GetModuleNames()))
 	return moduleRegistry, nil
 }
 
-// initRouter initializes Gin router with all routes
-func initRouter(cfg *config.Config, moduleRegistry *domain.ModuleRegistry) *gin.Engine {
+// healthChecks registers the checks of the readiness probe and the admin health endpoints: a ping
+// of every module database, the Health of every module, Vault when it is enabled, the event bus when
+// it can report its health, the search engine when one is configured and the circuit breakers of
+// external services
+func healthChecks(cfg *config.Config, moduleRegistry *domain.ModuleRegistry, eventBus domain.EventBus, searchEngine search.Engine) (*health.Registry, error) {
+	checks := health.NewRegistry(health.DefaultTimeout)
+
+	// Modules configured with health.critical: false, and their databases, are reported without
+	// making the service unready
+	moduleCheck := func(module string) []health.CheckOption {
+		if cfg.Modules.IsModuleCritical(module) {
+			return nil
+		}
+		return []health.CheckOption{health.NonCritical()}
+	}
+
+	manager := database.GetGlobalManager()
+	for _, name := range cfg.GetAvailableDatabases() {
+		checks.Register("database:"+name, func(ctx context.Context) error {
+			return manager.Ping(ctx, name)
+		}, moduleCheck(name)...)
+	}
+
+	for _, name := range moduleRegistry.GetModuleNames() {
+		if module, ok := moduleRegistry.GetModule(name); ok {
+			checks.Register("module:"+name, module.Health, moduleCheck(name)...)
+		}
+	}
+
+	vaultCheck, err := config.VaultHealthCheck()
+	if err != nil {
+		return nil, err
+	}
+	if vaultCheck != nil {
+		checks.Register("vault", vaultCheck)
+	}
+
+	if bus, ok := eventBus.(interface{ Health(context.Context) error }); ok {
+		checks.Register("event_bus", bus.Health)
+	}
+
+	// Searches are answered by the databases while the search engine is down
+	if searchEngine != nil {
+		checks.Register("search", searchEngine.Ping, health.NonCritical())
+	}
+
+	// Circuit breakers of external services are reported without making the service unready,
+	// since it degrades rather than stops while one is open
+	for _, breaker := range resilience.Breakers() {
+		checks.Register("breaker:"+breaker.Name(), resilience.HealthCheck(breaker), health.NonCritical())
+	}
+
+	return checks, nil
+}
+
+// initRouter initializes the Gin router of the public API, and the router of the operational routes
+// when they are served on server.admin_port
+func initRouter(
+	cfg *config.Config,
+	moduleRegistry *domain.ModuleRegistry,
+	tokens *auth.TokenService,
+	tenants *tenancy.Tenancy,
+	checks *health.Registry,
+	eventBus domain.EventBus,
+	events *eventstream.Broker,
+	auditLog audit.Store,
+	usageRecorder *usage.Recorder,
+	mail *mailer.Mailer,
+	retentionScheduler *retention.Scheduler,
+	loggers *logging.Factory,
+) (*gin.Engine, *gin.Engine, error) {
+	logger := loggers.Logger()
+
 	// Set Gin mode from config
 	gin.SetMode(cfg.App.GinMode)
+	gin.DebugPrintRouteFunc = func(method, path, handler string, handlers int) {
+		logger.Debug("route registered", zap.String("method", method), zap.String("path", path), zap.String("handler", handler))
+	}
+
+	versions, err := moduleVersions(cfg, moduleRegistry.GetModuleNames())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bodyLimits, err := moduleBodyLimits(cfg, versions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idempotent, err := moduleIdempotentRoutes(cfg, versions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chains, err := moduleChains(cfg, middlewareRegistry(cfg, tokens, tenants, logger), versions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ipRules, err := ipRules(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// Create router
-	router := gin.New()
+	router, err := newEngine(cfg, logger, ipRules, bodyLimits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Routes outside the modules: documentation and the event stream
+	public := router.Group("", logging.Middleware(logger), corsMiddleware())
+
+	// Operational routes: probes, metrics and debug endpoints, on their own listener when
+	// server.admin_port is set, so that the public ingress never exposes them
+	ops := public
+	var admin *gin.Engine
+	if cfg.Server.AdminPort != "" {
+		if admin, err = newEngine(cfg, logger, ipRules, bodyLimits); err != nil {
+			return nil, nil, err
+		}
+		ops = admin.Group("", logging.Middleware(logger))
+	}
+
+	// Add liveness and readiness probes
+	info := health.Info{Service: cfg.App.Name, Version: cfg.App.Version, Environment: cfg.App.Environment}
+	ops.GET(health.LivenessPath, health.LivenessHandler(info))
+	ops.GET(health.ReadinessPath, health.ReadinessHandler(info, checks))
+
+	// Prometheus metrics of the module routes, when features.metrics_enabled is set
+	var httpMetrics *metrics.Metrics
+	if cfg.Modules != nil && cfg.Modules.Global.Features.MetricsEnabled {
+		httpMetrics = metrics.New()
+		httpMetrics.Register(resilience.Collector(), worker.Collector(), database.Collector(), cache.Collector())
+		ops.GET(metrics.Path, gin.WrapH(httpMetrics.Handler()))
+	}
+
+	// Requests between modules are counted when metrics are enabled, and each endpoint is behind
+	// a circuit breaker of its own
+	if httpMetrics != nil {
+		moduleRegistry.Requests().Use(httpMetrics.RequestMiddleware())
+	}
+	moduleRegistry.Requests().Use(resilience.RequestMiddleware(resilience.BreakerSettings{}, 0))
+
+	policies, err := routePolicies(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// API routes: each module is mounted under the prefix of each of its API versions, with the
+	// middleware and route policies of its module.yaml
+	authorizer := authz.Lazy(moduleRegistry.PublicAPIs())
+	middleware := moduleMiddleware(chains, tokens, policies, authorizer, idempotent)
+	err = moduleRegistry.RegisterAllVersionedRoutes(func(module string) []domain.RouteGroup {
+		moduleVersions, ok := versions[module]
+		if !ok {
+			logger.Info("module HTTP routes disabled", zap.String("module", module))
+			return nil
+		}
+
+		groups := make([]domain.RouteGroup, 0, len(moduleVersions))
+		for _, version := range moduleVersions {
+			handlers := append([]gin.HandlerFunc{moduleAvailable(moduleRegistry, module)}, middleware(module, version.Prefix)...)
+			if version.Deprecated {
+				handlers = append([]gin.HandlerFunc{apiversion.Middleware(version)}, handlers...)
+			}
+			// First, so that requests rejected by authentication are counted too
+			handlers = append([]gin.HandlerFunc{usageRecorder.Middleware(module)}, handlers...)
+			if httpMetrics != nil {
+				handlers = append([]gin.HandlerFunc{httpMetrics.Middleware(module)}, handlers...)
+			}
+			groups = append(groups, domain.RouteGroup{Handlers: version.Handlers, Group: router.Group(version.Prefix, handlers...)})
+		}
+		return groups
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Fail on policies for routes that do not exist rather than leave the intended route unguarded
+	for module, moduleVersions := range versions {
+		modulePolicies, ok := policies[module]
+		if !ok {
+			continue
+		}
+		prefixes := make([]string, 0, len(moduleVersions))
+		for _, version := range moduleVersions {
+			prefixes = append(prefixes, version.Prefix)
+		}
+		if unmatched := modulePolicies.Unmatched(router.Routes(), prefixes...); len(unmatched) > 0 {
+			return nil, nil, fmt.Errorf("module %s declares policies for unknown routes: %s", module, strings.Join(unmatched, ", "))
+		}
+	}
+
+	// Live domain events for dashboards, filtered by the events:<aggregate type> permissions of the caller
+	if cfg.Modules == nil || cfg.Modules.Global.Features.EventsEnabled {
+		public.GET(config.DefaultHTTPPrefix+eventstream.Path, auth.Middleware(tokens), eventstream.Handler(events, authorizer))
+	}
+
+	// Health of each module, with its latency and error, for operators
+	ops.GET(health.ModulesPath, auth.Middleware(tokens), authz.RequirePermission(authorizer, health.ModulesPermission),
+		health.ModulesHandler(checks, moduleRegistry))
+
+	// Build, runtime, modules, database pools and event bus load in one document, for operators
+	statusSources := status.Sources{
+		Service:       cfg.App.Name,
+		Environment:   cfg.App.Environment,
+		Modules:       moduleRegistry,
+		Config:        cfg.Modules,
+		Databases:     database.GetGlobalManager().Stats,
+		StreamClients: events.ClientCount,
+		StreamQueued:  events.Queued,
+	}
+	if bus, ok := eventBus.(interface{ SubscriberCount() int }); ok {
+		statusSources.Subscribers = bus.SubscriberCount
+	}
+	ops.GET(status.Path, auth.Middleware(tokens), authz.RequirePermission(authorizer, status.Permission), status.Handler(statusSources))
 
-	// Add middleware
-	router.Use(gin.Logger())
-	router.Use(gin.Recovery())
-	router.Use(corsMiddleware())
+	// Audit log of every module, filtered by actor, aggregate and time range, for administrators
+	ops.GET(audit.Path, auth.Middleware(tokens), authz.RequirePermission(authorizer, audit.Permission), audit.Handler(auditLog))
 
-	// Add health check
-	router.GET("/health", healthCheckHandler(cfg, moduleRegistry))
+	// Requests per day, route and consumer, for usage reports
+	ops.GET(usage.Path, auth.Middleware(tokens), authz.RequirePermission(authorizer, usage.Permission), usage.Handler(usageRecorder))
 
-	// API routes
-	api := router.Group("/api/v1")
-	{
-		// Register routes for all modules
-		moduleRegistry.RegisterAllRoutes(api)
+	// Retention policies and their latest reports, and runs on demand, for administrators
+	retention.Register(ops.Group(retention.Path, auth.Middleware(tokens)), retentionScheduler,
+		authz.RequirePermission(authorizer, retention.ReadPermission), authz.RequirePermission(authorizer, retention.WritePermission))
+
+	// Log levels of the application and of each module, changed at runtime by administrators
+	logging.RegisterLevels(ops.Group(logging.LevelsPath, auth.Middleware(tokens), authz.RequirePermission(authorizer, logging.LevelsPermission)), loggers)
+
+	// Profiling and runtime endpoints, for administrators, when debug.enabled is set
+	if cfg.Debug.Enabled {
+		debug.Register(ops.Group(debug.Path, auth.Middleware(tokens), authz.RequirePermission(authorizer, debug.Permission)))
+		logger.Info("debug endpoints enabled", zap.String("path", debug.Path))
 	}
 
-	return router
+	// Emails captured by the mail sandbox, for developers
+	if sandbox := mail.Sandbox(); sandbox != nil {
+		mailer.RegisterSandbox(ops.Group(mailer.SandboxPath, auth.Middleware(tokens), authz.RequirePermission(authorizer, mailer.SandboxPermission)), sandbox)
+		logger.Info("mail sandbox endpoints enabled", zap.String("path", mailer.SandboxPath))
+	}
+
+	// API documentation of the routes registered so far
+	doc := openapi.Build(openapi.Info{
+		Title:   cfg.App.Name,
+		Version: cfg.App.Version,
+	}, router.Routes(), apiModules(moduleRegistry, versions, policies))
+	public.GET("/openapi.json", openapi.Handler(doc))
+	public.GET("/docs", openapi.UIHandler("/openapi.json", cfg.App.Name+" API"))
+
+	return router, admin, nil
 }
 
-// corsMiddleware adds CORS headers
+// newEngine creates an engine with the middleware of every request, for the public and the admin
+// listener alike; the rest is attached per route group
+func newEngine(cfg *config.Config, logger *zap.Logger, ipRules []ipfilter.Rule, bodyLimits httplimit.BodyLimits) (*gin.Engine, error) {
+	engine := gin.New()
+
+	// Client IPs are read from forwarding headers only on requests of the trusted proxies, so that
+	// rate limits, IP rules and audit logs see the real client behind a load balancer
+	engine.RemoteIPHeaders = cfg.Server.RemoteIPHeaders
+	if err := engine.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid server trusted_proxies: %w", err)
+	}
+
+	engine.Use(requestid.Middleware())
+	if tracing.Enabled(cfg) {
+		engine.Use(tracing.Middleware(cfg.App.Name)...)
+	}
+	engine.Use(logging.Recovery(logger))
+	engine.Use(corsPreflightMiddleware())
+	engine.Use(clientInfoMiddleware())
+	if len(ipRules) > 0 {
+		engine.Use(ipfilter.Middleware(ipRules))
+	}
+	engine.Use(httplimit.Middleware(cfg.Server.MaxBodyBytes, bodyLimits))
+	engine.NoRoute(logging.Middleware(logger))
+	return engine, nil
+}
+
+// apiModules collects the documentation input of each API version of the modules serving HTTP routes
+// Modules implementing openapi.VersionDocumented or openapi.Documented contribute their own operations
+func apiModules(moduleRegistry *domain.ModuleRegistry, versions map[string][]apiversion.Version, policies map[string]authz.RoutePolicies) []openapi.Module {
+	var modules []openapi.Module
+	for name, moduleVersions := range versions {
+		registered, _ := moduleRegistry.GetModule(name)
+		for _, version := range moduleVersions {
+			module := openapi.Module{Name: name, Prefix: version.Prefix, Deprecated: version.Deprecated}
+			if documented, ok := registered.(openapi.VersionDocumented); ok {
+				module.Operations = documented.APIVersionOperations(version.Handlers)
+			} else if documented, ok := registered.(openapi.Documented); ok {
+				module.Operations = documented.APIOperations()
+			}
+			if modulePolicies, ok := policies[name]; ok {
+				module.Permissions = modulePolicies.Required
+			}
+			modules = append(modules, module)
+		}
+	}
+	return modules
+}
+
+// moduleVersions returns the API versions of each module whose HTTP interface is enabled
+func moduleVersions(cfg *config.Config, modules []string) (map[string][]apiversion.Version, error) {
+	versions := make(map[string][]apiversion.Version, len(modules))
+	for _, module := range modules {
+		httpConfig := config.HTTPConfig{Prefix: config.DefaultHTTPPrefix, Enabled: true}
+		if cfg.Modules != nil {
+			httpConfig = cfg.Modules.GetModuleHTTPConfig(module)
+		}
+		if !httpConfig.Enabled {
+			continue
+		}
+
+		moduleVersions, err := apiversion.FromConfig(httpConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid HTTP configuration of module %s: %w", module, err)
+		}
+		versions[module] = moduleVersions
+	}
+
+	return versions, nil
+}
+
+// moduleBodyLimits parses the http.body_limits section of each module's configuration, applying the
+// limits under every API version of the module
+func moduleBodyLimits(cfg *config.Config, versions map[string][]apiversion.Version) (httplimit.BodyLimits, error) {
+	limits := make(httplimit.BodyLimits)
+	if cfg.Modules == nil {
+		return limits, nil
+	}
+
+	for module, moduleVersions := range versions {
+		declared := cfg.Modules.Modules[module].HTTP.BodyLimits
+		if len(declared) == 0 {
+			continue
+		}
+
+		prefixes := make([]string, 0, len(moduleVersions))
+		for _, version := range moduleVersions {
+			prefixes = append(prefixes, version.Prefix)
+		}
+		moduleLimits, err := httplimit.ParseBodyLimits(declared, prefixes...)
+		if err != nil {
+			return nil, fmt.Errorf("invalid http.body_limits of module %s: %w", module, err)
+		}
+		limits.Merge(moduleLimits)
+	}
+
+	return limits, nil
+}
+
+// moduleIdempotentRoutes parses the http.idempotent list of each module's configuration, taking the
+// routes under every API version of the module, and returns the middleware of the modules declaring any
+func moduleIdempotentRoutes(cfg *config.Config, versions map[string][]apiversion.Version) (map[string]gin.HandlerFunc, error) {
+	middleware := make(map[string]gin.HandlerFunc)
+	if cfg.Modules == nil {
+		return middleware, nil
+	}
+
+	ttl, err := cfg.Idempotency.GetTTL()
+	if err != nil {
+		return nil, err
+	}
+
+	memory := idempotency.NewMemoryStore()
+	for module, moduleVersions := range versions {
+		declared := cfg.Modules.Modules[module].HTTP.Idempotent
+		if len(declared) == 0 {
+			continue
+		}
+
+		var store idempotency.Store = memory
+		if cfg.Idempotency.Store == config.IdempotencyStoreDatabase {
+			if store, err = moduleIdempotencyStore(module); err != nil {
+				return nil, err
+			}
+		}
+
+		prefixes := make([]string, 0, len(moduleVersions))
+		for _, version := range moduleVersions {
+			prefixes = append(prefixes, version.Prefix)
+		}
+		routes, err := idempotency.ParseRoutes(declared, prefixes...)
+		if err != nil {
+			return nil, fmt.Errorf("invalid http.idempotent of module %s: %w", module, err)
+		}
+		middleware[module] = idempotency.Middleware(store, routes, ttl)
+	}
+
+	return middleware, nil
+}
+
+// moduleIdempotencyStore keeps the idempotency keys of a module in its own database
+func moduleIdempotencyStore(module string) (idempotency.Store, error) {
+	db, err := database.GetGlobalManager().GetConnection(module)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the database of module %s: %w", module, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	store, err := idempotency.NewPostgresStore(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the idempotency store of module %s: %w", module, err)
+	}
+	return store, nil
+}
+
+// routePolicies parses the http.routes section of each module's configuration
+func routePolicies(cfg *config.Config) (map[string]authz.RoutePolicies, error) {
+	policies := make(map[string]authz.RoutePolicies)
+	if cfg.Modules == nil {
+		return policies, nil
+	}
+
+	for module, moduleConfig := range cfg.Modules.Modules {
+		if len(moduleConfig.HTTP.Routes) == 0 {
+			continue
+		}
+
+		modulePolicies, err := authz.ParseRoutePolicies(moduleConfig.HTTP.Routes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid http.routes of module %s: %w", module, err)
+		}
+		policies[module] = modulePolicies
+	}
+
+	return policies, nil
+}
+
+// ipRules parses global.http.ip_rules
+func ipRules(cfg *config.Config) ([]ipfilter.Rule, error) {
+	if cfg.Modules == nil {
+		return nil, nil
+	}
+
+	rules := make([]ipfilter.Rule, 0, len(cfg.Modules.Global.HTTP.IPRules))
+	for _, ruleConfig := range cfg.Modules.Global.HTTP.IPRules {
+		rule, err := ipfilter.NewRule(ruleConfig.Path, ruleConfig.Allow, ruleConfig.Deny)
+		if err != nil {
+			return nil, fmt.Errorf("invalid global.http.ip_rules: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// middlewareRegistry names the middleware modules can list in http.middleware
+// request_id and recovery are applied to every request by initRouter, so they need no handler of their own
+func middlewareRegistry(cfg *config.Config, tokens *auth.TokenService, tenants *tenancy.Tenancy, logger *zap.Logger) *httpmiddleware.Registry {
+	registry := httpmiddleware.NewRegistry()
+	registry.Register("request_id", func(string) gin.HandlerFunc { return nil })
+	registry.Register("recovery", func(string) gin.HandlerFunc { return nil })
+	registry.Register("cors", func(string) gin.HandlerFunc { return corsMiddleware() })
+	registry.Register("logging", func(string) gin.HandlerFunc { return logging.Middleware(logger) })
+	registry.Register("auth", func(string) gin.HandlerFunc { return auth.Middleware(tokens) })
+	registry.Register("tenant", func(module string) gin.HandlerFunc {
+		// Without global.tenancy.enabled the routes serve no tenant
+		if tenants == nil {
+			return nil
+		}
+		return tenants.Middleware(module)
+	})
+	registry.Register("ratelimit", func(module string) gin.HandlerFunc {
+		// global.http.rate_limiting.enabled switches the limits of every module off at once
+		if cfg.Modules == nil || !cfg.Modules.Global.HTTP.RateLimiting.Enabled {
+			return nil
+		}
+		requestsPerMinute := cfg.Modules.Global.HTTP.RateLimiting.RequestsPerMinute
+		logger.Info("rate limiting module routes", zap.String("module", module), zap.Int("requests_per_minute", requestsPerMinute))
+		return ratelimit.Middleware(ratelimit.NewLimiter(requestsPerMinute))
+	})
+	return registry
+}
+
+// moduleChains resolves the http.middleware list of each module serving HTTP routes, falling back to
+// global.http.default_middleware, then config.DefaultMiddleware, when the module lists none
+func moduleChains(cfg *config.Config, registry *httpmiddleware.Registry, versions map[string][]apiversion.Version) (map[string][]gin.HandlerFunc, error) {
+	chains := make(map[string][]gin.HandlerFunc, len(versions))
+	for module := range versions {
+		var names []string
+		if cfg.Modules != nil {
+			names = cfg.Modules.Modules[module].HTTP.Middleware
+			if len(names) == 0 {
+				names = cfg.Modules.Global.HTTP.DefaultMiddleware
+			}
+		}
+		if len(names) == 0 {
+			names = config.DefaultMiddleware
+		}
+
+		chain, err := registry.Chain(module, names)
+		if err != nil {
+			return nil, fmt.Errorf("invalid http.middleware of module %s: %w", module, err)
+		}
+		chains[module] = chain
+	}
+
+	return chains, nil
+}
+
+// moduleMiddleware returns the handlers of a module's route group: the chain of its http.middleware
+// list, then its route policies; basePath is the module's prefix
+// The idempotency middleware runs last, so that keys are scoped to the authenticated actor
+func moduleMiddleware(
+	chains map[string][]gin.HandlerFunc,
+	tokens *auth.TokenService,
+	policies map[string]authz.RoutePolicies,
+	authorizer authz.Authorizer,
+	idempotent map[string]gin.HandlerFunc,
+) func(module, basePath string) []gin.HandlerFunc {
+	return func(module, basePath string) []gin.HandlerFunc {
+		handlers := append([]gin.HandlerFunc(nil), chains[module]...)
+		if modulePolicies, ok := policies[module]; ok {
+			handlers = append(handlers, modulePolicies.Middleware(authorizer, tokens, basePath))
+		}
+		if replay, ok := idempotent[module]; ok {
+			handlers = append(handlers, replay)
+		}
+		return handlers
+	}
+}
+
+// moduleAvailable answers 503 to the requests of a module that failed to start after its routes
+// were registered, and was skipped or degraded by its failure policy
+func moduleAvailable(moduleRegistry *domain.ModuleRegistry, module string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, failed := moduleRegistry.Failure(module); failed {
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "MODULE_UNAVAILABLE",
+					"message": fmt.Sprintf("The %s module is unavailable", module),
+				},
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// corsMiddleware adds CORS headers to the responses of a route group
 func corsMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.Header("Access-Control-Allow-Origin", "*")
-		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		corsHeaders(c)
+		c.Next()
+	}
+}
 
-		if c.Request.Method == "OPTIONS" {
-			c.AbortWithStatus(204)
+// corsPreflightMiddleware answers CORS preflight requests of every path
+// Preflight requests match no route, so they are answered before routing; whether the actual request
+// may be read cross-origin is up to the cors middleware of its route group
+func corsPreflightMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodOptions {
+			corsHeaders(c)
+			c.AbortWithStatus(http.StatusNoContent)
 			return
 		}
 
@@ -149,51 +1025,30 @@ func corsMiddleware() gin.HandlerFunc {
 	}
 }
 
-// healthCheckHandler returns a health check handler with config and modules
-func healthCheckHandler(cfg *config.Config, moduleRegistry *domain.ModuleRegistry) gin.HandlerFunc {
+// corsHeaders sets the CORS headers of a response
+func corsHeaders(c *gin.Context) {
+	c.Header("Access-Control-Allow-Origin", "*")
+	c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+	c.Header("Access-Control-Allow-Headers", strings.Join([]string{
+		"Content-Type", "Authorization", requestid.Header, conditional.HeaderIfNoneMatch, conditional.HeaderIfMatch,
+		idempotency.KeyHeader, tenancy.DefaultHeader,
+	}, ", "))
+	c.Header("Access-Control-Expose-Headers", strings.Join([]string{
+		auth.ImpersonatorHeader, requestid.Header, conditional.HeaderETag, idempotency.ReplayedHeader,
+		apiversion.HeaderDeprecation, apiversion.HeaderSunset, apiversion.HeaderLink,
+		ratelimit.HeaderLimit, ratelimit.HeaderRemaining, "Retry-After",
+	}, ", "))
+}
+
+// clientInfoMiddleware puts the client IP and user agent on the request context
+func clientInfoMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		manager := database.GetGlobalManager()
-		databases := manager.GetRegisteredDatabases()
-
-		// Check module health
-		ctx := context.Background()
-		moduleHealth := moduleRegistry.HealthCheckAll(ctx)
-
-		// Determine overall status
-		status := "healthy"
-		for _, err := range moduleHealth {
-			if err != nil {
-				status = "unhealthy"
-				break
-			}
-		}
+		ctx := domain.WithClientInfo(c.Request.Context(), domain.ClientInfo{
+			IPAddress: c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		})
+		c.Request = c.Request.WithContext(ctx)
 
-		response := gin.H{
-			"status":      status,
-			"service":     cfg.App.Name,
-			"version":     cfg.App.Version,
-			"environment": cfg.App.Environment,
-			"databases":   databases,
-			"modules":     moduleRegistry.GetModuleNames(),
-			"module_health": func() map[string]string {
-				health := make(map[string]string)
-				for name, err := range moduleHealth {
-					if err != nil {
-						health[name] = err.Error()
-					} else {
-						health[name] = "healthy"
-					}
-				}
-				return health
-			}(),
-			"message":   "🚀 Modular system with dynamic module loading!",
-			"timestamp": "2025-06-12",
-		}
-
-		if status == "healthy" {
-			c.JSON(200, response)
-		} else {
-			c.JSON(503, response)
-		}
+		c.Next()
 	}
 }
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+)
+
+// newServer creates the HTTP server of the router with the configured timeouts and header limit
+// Headers over the limit are rejected with 431 by net/http
+// HTTP/2 is negotiated over TLS, or accepted as h2c over plaintext, unless server.http2 is off
+func newServer(cfg *config.Config, router *gin.Engine) (*http.Server, error) {
+	timeouts, err := cfg.Server.GetTimeouts()
+	if err != nil {
+		return nil, err
+	}
+
+	router.UseH2C = cfg.Server.HTTP2 && !cfg.Server.TLS.Enabled
+	server := &http.Server{
+		Addr:              cfg.GetServerAddress(),
+		Handler:           router.Handler(),
+		ReadTimeout:       timeouts.Read,
+		ReadHeaderTimeout: timeouts.ReadHeader,
+		WriteTimeout:      timeouts.Write,
+		IdleTimeout:       timeouts.Idle,
+		MaxHeaderBytes:    cfg.Server.MaxHeaderBytes,
+	}
+	if !cfg.Server.HTTP2 {
+		// A non-nil map without "h2" disables HTTP/2 over TLS
+		server.TLSNextProto = make(map[string]func(*http.Server, *tls.Conn, http.Handler))
+	}
+	return server, nil
+}
+
+// newAdminServer creates the plaintext server of the operational routes on server.admin_port
+// It is meant for the internal network only, so it terminates no TLS
+func newAdminServer(cfg *config.Config, admin *gin.Engine) (*http.Server, error) {
+	timeouts, err := cfg.Server.GetTimeouts()
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Server{
+		Addr:              ":" + cfg.Server.AdminPort,
+		Handler:           admin.Handler(),
+		ReadTimeout:       timeouts.Read,
+		ReadHeaderTimeout: timeouts.ReadHeader,
+		WriteTimeout:      timeouts.Write,
+		IdleTimeout:       timeouts.Idle,
+		MaxHeaderBytes:    cfg.Server.MaxHeaderBytes,
+	}, nil
+}
+
+// serveAdmin runs the admin server until it is shut down
+func serveAdmin(server *http.Server, logger *zap.Logger) {
+	logger.Info("starting admin server", zap.String("addr", server.Addr))
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		logger.Fatal("failed to start admin server", zap.Error(err))
+	}
+}
+
+// serve runs the server until it fails or is shut down, terminating TLS when server.tls is enabled
+// With autocert, certificates are obtained from Let's Encrypt on the first request for each domain
+func serve(cfg *config.Config, server *http.Server, logger *zap.Logger) error {
+	tlsConfig := cfg.Server.TLS
+	if !tlsConfig.Enabled {
+		logger.Info("starting server", zap.String("port", cfg.App.Port), zap.Bool("http2", cfg.Server.HTTP2))
+		return server.ListenAndServe()
+	}
+
+	// Plaintext requests are redirected to HTTPS, after answering ACME HTTP challenges with autocert
+	var redirect http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, httpsURL(r, cfg.App.Port), http.StatusMovedPermanently)
+	})
+
+	if tlsConfig.Autocert.Enabled {
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(tlsConfig.Autocert.Domains...),
+			Cache:      autocert.DirCache(tlsConfig.Autocert.CacheDir),
+			Email:      tlsConfig.Autocert.Email,
+		}
+		server.TLSConfig = manager.TLSConfig()
+		if !cfg.Server.HTTP2 {
+			server.TLSConfig.NextProtos = []string{"http/1.1", acme.ALPNProto}
+		}
+		redirect = manager.HTTPHandler(redirect)
+	}
+
+	if tlsConfig.RedirectHTTP {
+		redirectServer := &http.Server{
+			Addr:              ":" + tlsConfig.HTTPPort,
+			Handler:           redirect,
+			ReadHeaderTimeout: server.ReadHeaderTimeout,
+			ReadTimeout:       server.ReadTimeout,
+			WriteTimeout:      server.WriteTimeout,
+			IdleTimeout:       server.IdleTimeout,
+		}
+		// Redirects are answered at once, so the redirect listener closes with the server
+		server.RegisterOnShutdown(func() { _ = redirectServer.Close() })
+		go func() {
+			logger.Info("redirecting HTTP to HTTPS", zap.String("port", tlsConfig.HTTPPort))
+			if err := redirectServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Fatal("failed to start HTTP redirect server", zap.Error(err))
+			}
+		}()
+	}
+
+	logger.Info("starting server with TLS",
+		zap.String("port", cfg.App.Port),
+		zap.Bool("http2", cfg.Server.HTTP2),
+		zap.Bool("autocert", tlsConfig.Autocert.Enabled),
+	)
+	if tlsConfig.Autocert.Enabled {
+		return server.ListenAndServeTLS("", "")
+	}
+	return server.ListenAndServeTLS(tlsConfig.CertFile, tlsConfig.KeyFile)
+}
+
+// httpsURL returns the HTTPS URL of a plaintext request, on the server's TLS port
+func httpsURL(r *http.Request, port string) string {
+	host := r.Host
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+	if port != "443" {
+		host = net.JoinHostPort(host, port)
+	}
+	return "https://" + host + r.URL.RequestURI()
+}
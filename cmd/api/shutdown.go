@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/worker"
+)
+
+// shutdownHook is a step of the graceful shutdown
+type shutdownHook struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// shutdownHooks runs the steps of the graceful shutdown in the order they were added
+type shutdownHooks struct {
+	hooks []shutdownHook
+}
+
+// add appends a step to the shutdown
+func (s *shutdownHooks) add(name string, run func(ctx context.Context) error) {
+	s.hooks = append(s.hooks, shutdownHook{name: name, run: run})
+}
+
+// run runs every step within timeout; a failing step is logged and does not keep the next ones from
+// running, so that connections are closed even when a module fails to stop in time
+func (s *shutdownHooks) run(timeout time.Duration, logger *zap.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	for _, hook := range s.hooks {
+		started := time.Now()
+		if err := hook.run(ctx); err != nil {
+			logger.Error("shutdown step failed", zap.String("step", hook.name), zap.Error(err))
+			continue
+		}
+		logger.Info("shutdown step done", zap.String("step", hook.name), zap.Duration("duration", time.Since(started)))
+	}
+}
+
+// stopSharedWorkers stops the workers run outside the modules, such as the audit purge and the usage
+// flush; each module stops its own workers
+func stopSharedWorkers(moduleRegistry *domain.ModuleRegistry) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		var errs []error
+		for _, manager := range worker.Managers() {
+			if _, ok := moduleRegistry.GetModule(manager.Module()); ok {
+				continue
+			}
+			if err := manager.Stop(ctx); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
@@ -0,0 +1,187 @@
+// Command archcheck enforces the boundaries between modules: it parses the imports of every Go file
+// in the tree and fails when a module imports another module's packages other than its public API,
+// or when shared code imports a module.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	modulesDir     = "internal/modules"
+	sharedDir      = "internal/shared"
+	testSupportDir = "internal/testsupport"
+)
+
+// violation is an import crossing a module boundary
+type violation struct {
+	pos    token.Position
+	reason string
+}
+
+func main() {
+	var (
+		root   = flag.String("root", ".", "Root of the Go module to check")
+		public = flag.String("public", "publicapi", "Comma-separated packages of a module other modules may import, relative to the module")
+	)
+	flag.Parse()
+
+	modulePath, err := readModulePath(filepath.Join(*root, "go.mod"))
+	if err != nil {
+		log.Fatalf("Failed to read module path: %v", err)
+	}
+
+	checker := &checker{
+		modules: modulePath + "/" + modulesDir,
+		public:  strings.Split(*public, ","),
+	}
+	violations, err := checker.walk(*root)
+	if err != nil {
+		log.Fatalf("Failed to check imports: %v", err)
+	}
+
+	for _, v := range violations {
+		fmt.Printf("%s: %s\n", v.pos, v.reason)
+	}
+	if len(violations) > 0 {
+		fmt.Printf("%d import(s) cross module boundaries\n", len(violations))
+		os.Exit(1)
+	}
+	fmt.Println("✅ No module boundary violations")
+}
+
+// checker holds the import paths the rules are checked against
+type checker struct {
+	modules string
+	public  []string
+}
+
+// walk parses the imports of the Go files below root and returns the violations, sorted by position
+func (c *checker) walk(root string) ([]violation, error) {
+	var violations []violation
+	fset := token.NewFileSet()
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != root && (strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.ImportsOnly)
+		if err != nil {
+			return err
+		}
+		for _, spec := range file.Imports {
+			imported, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				return err
+			}
+			if reason := c.check(filepath.ToSlash(rel), imported); reason != "" {
+				violations = append(violations, violation{pos: fset.Position(spec.Pos()), reason: reason})
+			}
+		}
+		return nil
+	})
+
+	sort.Slice(violations, func(i, j int) bool {
+		if violations[i].pos.Filename != violations[j].pos.Filename {
+			return violations[i].pos.Filename < violations[j].pos.Filename
+		}
+		return violations[i].pos.Line < violations[j].pos.Line
+	})
+	return violations, err
+}
+
+// check returns why file, relative to the root, may not import imported, or "" when it may
+func (c *checker) check(file, imported string) string {
+	target, rest, ok := splitPath(imported, c.modules)
+	if !ok {
+		return ""
+	}
+
+	// The modules package itself only imports modules to register them, and is imported by commands
+	// and the integration test harness, whose App registers modules like the API does
+	if target == "" {
+		if !strings.HasPrefix(file, "cmd/") && !strings.HasPrefix(file, testSupportDir+"/") {
+			return fmt.Sprintf("%s imports %s, which only commands and %s may import", file, imported, testSupportDir)
+		}
+		return ""
+	}
+
+	if strings.HasPrefix(file, sharedDir+"/") {
+		return fmt.Sprintf("shared code imports module %s (%s)", target, imported)
+	}
+
+	source, _, inModule := splitPath(file, modulesDir)
+	if !inModule || source == "" || source == target {
+		return ""
+	}
+	for _, public := range c.public {
+		if rest == public || strings.HasPrefix(rest, public+"/") {
+			return ""
+		}
+	}
+	return fmt.Sprintf("module %s imports %s, an internal package of module %s; use its %s", source, imported, target, strings.Join(c.public, " or "))
+}
+
+// splitPath splits path below dir into its first element and the rest, e.g. an import path below
+// the modules directory into the module and the package within it; first is "" for dir itself
+func splitPath(path, dir string) (first, rest string, ok bool) {
+	if path == dir {
+		return "", "", true
+	}
+	if !strings.HasPrefix(path, dir+"/") {
+		return "", "", false
+	}
+	first, rest, _ = strings.Cut(strings.TrimPrefix(path, dir+"/"), "/")
+	// Files directly in the directory, e.g. internal/modules/customer.go, belong to the package of
+	// the directory rather than to a module
+	if strings.HasSuffix(first, ".go") {
+		return "", "", true
+	}
+	return first, rest, true
+}
+
+// readModulePath returns the module path declared by a go.mod file
+func readModulePath(goMod string) (string, error) {
+	f, err := os.Open(goMod)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if path, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(path), `"`), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no module directive in %s", goMod)
+}
@@ -5,9 +5,14 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
+
+	"go.uber.org/zap"
 
 	"golang_modular_monolith/internal/shared/infrastructure/config"
 	"golang_modular_monolith/internal/shared/infrastructure/database"
+	"golang_modular_monolith/internal/shared/infrastructure/lock"
+	"golang_modular_monolith/internal/shared/infrastructure/logging"
 	"golang_modular_monolith/internal/shared/infrastructure/migration"
 )
 
@@ -25,6 +30,14 @@ func main() {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
+	// The migration manager logs through the shared logger
+	loggers, err := logging.New(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize logging: %v", err)
+	}
+	defer func() { _ = loggers.Logger().Sync() }()
+	zap.ReplaceGlobals(loggers.Logger())
+
 	// Get available modules from configuration
 	availableModules := getAvailableModules(cfg)
 	if len(availableModules) == 0 {
@@ -55,6 +68,14 @@ func main() {
 		log.Fatalf("Failed to register modules: %v", err)
 	}
 
+	// Instances migrating at once, e.g. from the entrypoints of several containers, take turns
+	locker, err := newLocker(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize locks: %v", err)
+	}
+	defer locker.Close()
+	migrationManager.UseLocker(locker)
+
 	// Execute action
 	switch *action {
 	case "up":
@@ -137,6 +158,32 @@ func registerModules(migrationManager *migration.MigrationManager, cfg *config.C
 }
 
 func registerModule(migrationManager *migration.MigrationManager, cfg *config.Config, moduleName string) error {
+	if err := registerDatabase(cfg, moduleName); err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetGlobalManager().GetConnection(moduleName)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s database: %w", moduleName, err)
+	}
+
+	// Determine migration path - try to get from modules config first
+	migrationPath := fmt.Sprintf("internal/modules/%s/migrations", moduleName)
+	if cfg.Modules != nil {
+		if moduleConfig, moduleExists := cfg.Modules.Modules[moduleName]; moduleExists {
+			if moduleConfig.Migration.Path != "" {
+				migrationPath = moduleConfig.Migration.Path
+			}
+		}
+	}
+
+	log.Printf("📦 Registering migration for module: %s (path: %s)", moduleName, migrationPath)
+	return migrationManager.RegisterModule(moduleName, db, migrationPath)
+}
+
+// registerDatabase registers the database of a module with the global database manager
+func registerDatabase(cfg *config.Config, moduleName string) error {
 	// Try to get database config from databases first (legacy)
 	dbConfig, exists := cfg.Databases[moduleName]
 
@@ -196,25 +243,23 @@ func registerModule(migrationManager *migration.MigrationManager, cfg *config.Co
 
 	// Register database
 	manager.RegisterDatabase(moduleName, databaseConfig)
+	return nil
+}
 
-	// Get database connection
-	db, err := manager.GetConnection(moduleName)
-	if err != nil {
-		return fmt.Errorf("failed to connect to %s database: %w", moduleName, err)
-	}
-
-	// Determine migration path - try to get from modules config first
-	migrationPath := fmt.Sprintf("internal/modules/%s/migrations", moduleName)
+// newLocker creates the locker of global.lock, registering the database of the postgres backend
+// when no migrated module uses it
+func newLocker(cfg *config.Config) (*lock.Locker, error) {
 	if cfg.Modules != nil {
-		if moduleConfig, moduleExists := cfg.Modules.Modules[moduleName]; moduleExists {
-			if moduleConfig.Migration.Path != "" {
-				migrationPath = moduleConfig.Migration.Path
+		settings := cfg.Modules.Global.Lock
+		if settings.Backend == lock.BackendPostgres && settings.Database != "" {
+			if !slices.Contains(database.GetGlobalManager().GetRegisteredDatabases(), settings.Database) {
+				if err := registerDatabase(cfg, settings.Database); err != nil {
+					return nil, err
+				}
 			}
 		}
 	}
-
-	log.Printf("📦 Registering migration for module: %s (path: %s)", moduleName, migrationPath)
-	return migrationManager.RegisterModule(moduleName, db, migrationPath)
+	return lock.NewFromConfig(cfg)
 }
 
 func executeUp(migrationManager *migration.MigrationManager, module string) error {
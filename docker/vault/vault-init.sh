@@ -84,6 +84,46 @@ vault kv put kv/modules/product \
     INVENTORY_API_KEY="product_inventory_api_secret" \
     CACHE_KEY="product_cache_secret"
 
+# Payment module secrets
+echo "💳 Creating payment module secrets..."
+vault kv put kv/modules/payment \
+    DATABASE_HOST="postgres" \
+    DATABASE_PORT="5432" \
+    DATABASE_USER="postgres" \
+    DATABASE_PASSWORD="vault_payment_password" \
+    DATABASE_NAME="modular_monolith_payment" \
+    DATABASE_SSLMODE="disable"
+
+# Notification module secrets
+echo "✉️ Creating notification module secrets..."
+vault kv put kv/modules/notification \
+    DATABASE_HOST="postgres" \
+    DATABASE_PORT="5432" \
+    DATABASE_USER="postgres" \
+    DATABASE_PASSWORD="vault_notification_password" \
+    DATABASE_NAME="modular_monolith_notification" \
+    DATABASE_SSLMODE="disable"
+
+# Files module secrets
+echo "📎 Creating files module secrets..."
+vault kv put kv/modules/files \
+    DATABASE_HOST="postgres" \
+    DATABASE_PORT="5432" \
+    DATABASE_USER="postgres" \
+    DATABASE_PASSWORD="vault_files_password" \
+    DATABASE_NAME="modular_monolith_files" \
+    DATABASE_SSLMODE="disable"
+
+# Reporting module secrets
+echo "📊 Creating reporting module secrets..."
+vault kv put kv/modules/reporting \
+    DATABASE_HOST="postgres" \
+    DATABASE_PORT="5432" \
+    DATABASE_USER="postgres" \
+    DATABASE_PASSWORD="vault_reporting_password" \
+    DATABASE_NAME="modular_monolith_reporting" \
+    DATABASE_SSLMODE="disable"
+
 # Create AppRole for application authentication
 echo "🔐 Setting up AppRole authentication..."
 vault auth enable approle
@@ -121,6 +161,38 @@ path "kv/data/modules/product" {
 path "kv/metadata/modules/product" {
   capabilities = ["read"]
 }
+
+# Payment module secrets
+path "kv/data/modules/payment" {
+  capabilities = ["read"]
+}
+path "kv/metadata/modules/payment" {
+  capabilities = ["read"]
+}
+
+# Notification module secrets
+path "kv/data/modules/notification" {
+  capabilities = ["read"]
+}
+path "kv/metadata/modules/notification" {
+  capabilities = ["read"]
+}
+
+# Files module secrets
+path "kv/data/modules/files" {
+  capabilities = ["read"]
+}
+path "kv/metadata/modules/files" {
+  capabilities = ["read"]
+}
+
+# Reporting module secrets
+path "kv/data/modules/reporting" {
+  capabilities = ["read"]
+}
+path "kv/metadata/modules/reporting" {
+  capabilities = ["read"]
+}
 EOF
 
 # Create AppRole
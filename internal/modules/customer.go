@@ -0,0 +1,6 @@
+//go:build !no_customer
+
+package modules
+
+// Excluded from builds tagged no_customer
+import _ "golang_modular_monolith/internal/modules/customer"
@@ -0,0 +1,65 @@
+package commandhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/customer/application/commands"
+	"golang_modular_monolith/internal/modules/customer/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// ChangeCustomerStatusHandler handles ChangeCustomerStatusCommand
+type ChangeCustomerStatusHandler struct {
+	repo     domain.CustomerRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewChangeCustomerStatusHandler creates a new ChangeCustomerStatusHandler
+func NewChangeCustomerStatusHandler(repo domain.CustomerRepository, eventBus shareddomain.EventBus) *ChangeCustomerStatusHandler {
+	return &ChangeCustomerStatusHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the ChangeCustomerStatusCommand
+// Deletion is not a plain status change: it requires a permission and goes through DeleteCustomerCommand
+func (h *ChangeCustomerStatusHandler) Handle(ctx context.Context, cmd *commands.ChangeCustomerStatusCommand) (*commands.ChangeCustomerStatusResult, error) {
+	if cmd.Status == "" {
+		return nil, shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeInvalidInput,
+			"status is required",
+			"status",
+		)
+	}
+	if domain.CustomerStatus(cmd.Status) == domain.CustomerStatusDeleted {
+		return nil, shareddomain.NewBusinessRuleError(
+			"delete_customer_separately",
+			"customers are deleted with DELETE /customers/:id, which requires the customers:delete permission",
+		)
+	}
+
+	customer, err := loadCustomer(ctx, h.repo, cmd.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(customer, cmd.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	previousStatus := customer.Status
+	if err := customer.TransitionTo(domain.CustomerStatus(cmd.Status)); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, customer); err != nil {
+		return nil, err
+	}
+
+	return &commands.ChangeCustomerStatusResult{
+		CustomerID:     customer.GetID(),
+		PreviousStatus: string(previousStatus),
+		Status:         string(customer.Status),
+		Version:        customer.GetVersion(),
+	}, nil
+}
@@ -6,36 +6,106 @@ import (
 
 	"golang_modular_monolith/internal/modules/customer/application/commands"
 	"golang_modular_monolith/internal/modules/customer/domain"
+	"golang_modular_monolith/internal/shared/application"
 	shareddomain "golang_modular_monolith/internal/shared/domain"
 )
 
 // CreateCustomerHandler handles CreateCustomerCommand
 type CreateCustomerHandler struct {
-	repo      domain.CustomerRepository
-	domainSvc domain.CustomerDomainService
-	eventBus  shareddomain.EventBus
+	repo            domain.CustomerRepository
+	domainSvc       domain.CustomerDomainService
+	duplicateFinder domain.CustomerDuplicateFinder
+	duplicatePolicy domain.DuplicateCheckPolicy
+	eventBus        shareddomain.EventBus
 }
 
 // NewCreateCustomerHandler creates a new CreateCustomerHandler
 func NewCreateCustomerHandler(
 	repo domain.CustomerRepository,
 	domainSvc domain.CustomerDomainService,
+	duplicateFinder domain.CustomerDuplicateFinder,
+	duplicatePolicy domain.DuplicateCheckPolicy,
 	eventBus shareddomain.EventBus,
 ) *CreateCustomerHandler {
 	return &CreateCustomerHandler{
-		repo:      repo,
-		domainSvc: domainSvc,
-		eventBus:  eventBus,
+		repo:            repo,
+		domainSvc:       domainSvc,
+		duplicateFinder: duplicateFinder,
+		duplicatePolicy: duplicatePolicy,
+		eventBus:        eventBus,
 	}
 }
 
 // Handle handles the CreateCustomerCommand
 func (h *CreateCustomerHandler) Handle(ctx context.Context, cmd *commands.CreateCustomerCommand) (*commands.CreateCustomerResult, error) {
+	input, err := h.check(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	candidates := input.candidates
+
+	// Create customer
+	customer, err := domain.NewCustomer(input.name, cmd.Email, input.locale, input.timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create customer: %w", err)
+	}
+
+	// Capture events before the repository clears them on save
+	events := customer.GetUncommittedEvents()
+
+	// Save to repository
+	if err := h.repo.Save(ctx, customer); err != nil {
+		return nil, fmt.Errorf("failed to save customer: %w", err)
+	}
+
+	// Publish domain events
+	if err := h.publishEvents(ctx, events); err != nil {
+		// Log error but don't fail the operation
+		// In a real application, you might want to use outbox pattern or similar
+		fmt.Printf("Warning: failed to publish events for customer %s: %v\n", customer.GetID(), err)
+	}
+
+	return &commands.CreateCustomerResult{
+		CustomerID: customer.GetID(),
+		Name:       customer.Name.Full(),
+		FirstName:  customer.Name.First,
+		LastName:   customer.Name.Last,
+		Email:      customer.Email.Value,
+		Status:     string(customer.Status),
+		Locale:     string(customer.Locale),
+		Timezone:   string(customer.Timezone),
+
+		PossibleDuplicate:   len(candidates) > 0,
+		DuplicateCandidates: candidates,
+	}, nil
+}
+
+// Validate checks the command as Handle would, without creating the customer
+// Imports use it to validate their rows on dry runs
+func (h *CreateCustomerHandler) Validate(ctx context.Context, cmd *commands.CreateCustomerCommand) error {
+	if _, err := h.check(ctx, cmd); err != nil {
+		return err
+	}
+	// Handle rejects invalid emails when creating the customer
+	_, err := domain.NewEmail(cmd.Email)
+	return err
+}
+
+// createInput holds the values a checked CreateCustomerCommand resolves to
+type createInput struct {
+	name       domain.PersonName
+	candidates []domain.DuplicateCandidate
+	locale     domain.Locale
+	timezone   domain.Timezone
+}
+
+// check validates the command, its email uniqueness and duplicate detection, and resolves its values
+func (h *CreateCustomerHandler) check(ctx context.Context, cmd *commands.CreateCustomerCommand) (*createInput, error) {
 	// Validate command
-	if cmd.Name == "" {
+	if cmd.Name == "" && cmd.FirstName == "" {
 		return nil, shareddomain.NewDomainError(
 			shareddomain.ErrCodeInvalidInput,
-			"name is required",
+			"name or first_name is required",
 		)
 	}
 	if cmd.Email == "" {
@@ -58,37 +128,62 @@ func (h *CreateCustomerHandler) Handle(ctx context.Context, cmd *commands.Create
 		)
 	}
 
-	// Create customer
-	customer, err := domain.NewCustomer(cmd.Name, cmd.Email)
+	name, err := resolveName(cmd.Name, cmd.FirstName, cmd.LastName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create customer: %w", err)
+		return nil, err
 	}
 
-	// Save to repository
-	if err := h.repo.Save(ctx, customer); err != nil {
-		return nil, fmt.Errorf("failed to save customer: %w", err)
+	// Look for likely duplicates
+	candidates, err := h.findDuplicates(ctx, name, cmd.Email)
+	if err != nil {
+		return nil, err
 	}
 
-	// Publish domain events
-	if err := h.publishEvents(ctx, customer); err != nil {
-		// Log error but don't fail the operation
-		// In a real application, you might want to use outbox pattern or similar
-		fmt.Printf("Warning: failed to publish events for customer %s: %v\n", customer.GetID(), err)
+	if len(candidates) > 0 && h.duplicatePolicy.Mode == domain.DuplicateCheckReject && !cmd.AllowDuplicates {
+		return nil, domain.DuplicateCustomerError{Candidates: candidates}
 	}
 
-	return &commands.CreateCustomerResult{
-		CustomerID: customer.GetID(),
-		Name:       customer.Name,
-		Email:      customer.Email.Value,
-		Status:     string(customer.Status),
-	}, nil
+	locale, err := domain.NewLocale(cmd.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone, err := domain.NewTimezone(cmd.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &createInput{name: name, candidates: candidates, locale: locale, timezone: timezone}, nil
+}
+
+// findDuplicates returns existing customers resembling the one being created
+// Tenants may switch the check off with their customer_duplicate_detection feature
+func (h *CreateCustomerHandler) findDuplicates(ctx context.Context, name domain.PersonName, email string) ([]domain.DuplicateCandidate, error) {
+	if h.duplicateFinder == nil || !h.duplicatePolicy.Enabled() {
+		return nil, nil
+	}
+	if !shareddomain.TenantFeatureEnabled(ctx, domain.DuplicateDetectionFeature, true) {
+		return nil, nil
+	}
+
+	candidates, err := h.duplicateFinder.FindDuplicateCandidates(ctx, domain.DuplicateCriteria{
+		Name:            name.Full(),
+		NormalizedEmail: domain.NormalizeEmail(email),
+		NameThreshold:   h.duplicatePolicy.NameThreshold,
+		EmailThreshold:  h.duplicatePolicy.EmailThreshold,
+		Limit:           h.duplicatePolicy.MaxCandidates,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for duplicate customers: %w", err)
+	}
+
+	return candidates, nil
 }
 
 // publishEvents publishes domain events
-func (h *CreateCustomerHandler) publishEvents(ctx context.Context, customer *domain.Customer) error {
-	events := customer.GetUncommittedEvents()
+func (h *CreateCustomerHandler) publishEvents(ctx context.Context, events []shareddomain.DomainEvent) error {
 	for _, event := range events {
-		if err := h.eventBus.Publish(event); err != nil {
+		if err := application.PublishEvents(ctx, h.eventBus, event); err != nil {
 			return fmt.Errorf("failed to publish event %T: %w", event, err)
 		}
 	}
@@ -0,0 +1,95 @@
+package commandhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/customer/application/commands"
+	"golang_modular_monolith/internal/modules/customer/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// SetCustomerAttributesHandler handles SetCustomerAttributesCommand
+type SetCustomerAttributesHandler struct {
+	repo     domain.CustomerRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewSetCustomerAttributesHandler creates a new SetCustomerAttributesHandler
+func NewSetCustomerAttributesHandler(repo domain.CustomerRepository, eventBus shareddomain.EventBus) *SetCustomerAttributesHandler {
+	return &SetCustomerAttributesHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the SetCustomerAttributesCommand
+func (h *SetCustomerAttributesHandler) Handle(ctx context.Context, cmd *commands.SetCustomerAttributesCommand) (*commands.CustomerAttributesResult, error) {
+	if len(cmd.Attributes) == 0 {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"at least one attribute is required",
+		)
+	}
+
+	customer, err := loadCustomer(ctx, h.repo, cmd.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := customer.SetAttributes(cmd.Attributes); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, customer); err != nil {
+		return nil, err
+	}
+
+	return &commands.CustomerAttributesResult{
+		CustomerID: customer.GetID(),
+		Attributes: customer.Attributes,
+		Version:    customer.GetVersion(),
+	}, nil
+}
+
+// UnsetCustomerAttributesHandler handles UnsetCustomerAttributesCommand
+type UnsetCustomerAttributesHandler struct {
+	repo     domain.CustomerRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewUnsetCustomerAttributesHandler creates a new UnsetCustomerAttributesHandler
+func NewUnsetCustomerAttributesHandler(repo domain.CustomerRepository, eventBus shareddomain.EventBus) *UnsetCustomerAttributesHandler {
+	return &UnsetCustomerAttributesHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the UnsetCustomerAttributesCommand
+func (h *UnsetCustomerAttributesHandler) Handle(ctx context.Context, cmd *commands.UnsetCustomerAttributesCommand) (*commands.CustomerAttributesResult, error) {
+	if len(cmd.Keys) == 0 {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"at least one attribute key is required",
+		)
+	}
+
+	customer, err := loadCustomer(ctx, h.repo, cmd.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := customer.UnsetAttributes(cmd.Keys); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, customer); err != nil {
+		return nil, err
+	}
+
+	return &commands.CustomerAttributesResult{
+		CustomerID: customer.GetID(),
+		Attributes: customer.Attributes,
+		Version:    customer.GetVersion(),
+	}, nil
+}
@@ -0,0 +1,76 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/customer/domain"
+	"golang_modular_monolith/internal/shared/application"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// loadCustomer loads a customer aggregate, mapping a missing customer to a NOT_FOUND domain error
+func loadCustomer(ctx context.Context, repo domain.CustomerRepository, customerID string) (*domain.Customer, error) {
+	if customerID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"customer ID is required",
+		)
+	}
+
+	customer, err := repo.GetByID(ctx, customerID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("customer with ID %s not found", customerID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get customer: %w", err)
+	}
+
+	return customer, nil
+}
+
+// checkVersion rejects a command expecting another version of the customer than the loaded one
+func checkVersion(customer *domain.Customer, expected *int) error {
+	if expected == nil || *expected == customer.GetVersion() {
+		return nil
+	}
+	return shareddomain.NewDomainError(
+		shareddomain.ErrCodePreconditionFailed,
+		fmt.Sprintf("customer %s is at version %d, not %d; reload it and retry", customer.GetID(), customer.GetVersion(), *expected),
+	)
+}
+
+// resolveName builds the structured name, preferring explicit first/last names over the full name
+func resolveName(name, firstName, lastName string) (domain.PersonName, error) {
+	if firstName != "" {
+		return domain.NewPersonName(firstName, lastName)
+	}
+	return domain.ParsePersonName(name)
+}
+
+// saveAndPublish persists a changed customer and publishes its uncommitted events
+// It is a no-op when the aggregate recorded no changes
+func saveAndPublish(ctx context.Context, repo domain.CustomerRepository, eventBus shareddomain.EventBus, customer *domain.Customer) error {
+	// Capture events before the repository clears them on save
+	events := customer.GetUncommittedEvents()
+	if len(events) == 0 {
+		return nil
+	}
+
+	if err := repo.Save(ctx, customer); err != nil {
+		return fmt.Errorf("failed to save customer: %w", err)
+	}
+
+	for _, event := range events {
+		if err := application.PublishEvents(ctx, eventBus, event); err != nil {
+			// Log error but don't fail the operation
+			// In a real application, you might want to use outbox pattern or similar
+			fmt.Printf("Warning: failed to publish event %T for customer %s: %v\n", event, customer.GetID(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package commandhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/customer/application/commands"
+	"golang_modular_monolith/internal/modules/customer/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// DeleteCustomerHandler handles DeleteCustomerCommand
+type DeleteCustomerHandler struct {
+	repo     domain.CustomerRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewDeleteCustomerHandler creates a new DeleteCustomerHandler
+func NewDeleteCustomerHandler(repo domain.CustomerRepository, eventBus shareddomain.EventBus) *DeleteCustomerHandler {
+	return &DeleteCustomerHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the DeleteCustomerCommand
+func (h *DeleteCustomerHandler) Handle(ctx context.Context, cmd *commands.DeleteCustomerCommand) (*commands.ChangeCustomerStatusResult, error) {
+	customer, err := loadCustomer(ctx, h.repo, cmd.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(customer, cmd.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	previousStatus := customer.Status
+	if err := customer.Delete(); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, customer); err != nil {
+		return nil, err
+	}
+
+	return &commands.ChangeCustomerStatusResult{
+		CustomerID:     customer.GetID(),
+		PreviousStatus: string(previousStatus),
+		Status:         string(customer.Status),
+		Version:        customer.GetVersion(),
+	}, nil
+}
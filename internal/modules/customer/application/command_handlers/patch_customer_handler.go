@@ -0,0 +1,198 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/customer/application/commands"
+	"golang_modular_monolith/internal/modules/customer/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// PatchCustomerHandler handles PatchCustomerCommand
+type PatchCustomerHandler struct {
+	repo      domain.CustomerRepository
+	domainSvc domain.CustomerDomainService
+	eventBus  shareddomain.EventBus
+}
+
+// NewPatchCustomerHandler creates a new PatchCustomerHandler
+func NewPatchCustomerHandler(
+	repo domain.CustomerRepository,
+	domainSvc domain.CustomerDomainService,
+	eventBus shareddomain.EventBus,
+) *PatchCustomerHandler {
+	return &PatchCustomerHandler{
+		repo:      repo,
+		domainSvc: domainSvc,
+		eventBus:  eventBus,
+	}
+}
+
+// Handle handles the PatchCustomerCommand
+func (h *PatchCustomerHandler) Handle(ctx context.Context, cmd *commands.PatchCustomerCommand) (*commands.PatchCustomerResult, error) {
+	fields, err := h.resolveFields(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load aggregate
+	customer, err := loadCustomer(ctx, h.repo, cmd.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(customer, cmd.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	// Apply each requested field through its granular domain method
+	name := customer.Name
+	nameChanged := false
+	locale, timezone := customer.Locale, customer.Timezone
+	preferencesChanged := false
+	for _, field := range fields {
+		switch field {
+		case commands.CustomerFieldName:
+			if name, err = domain.ParsePersonName(*cmd.Name); err != nil {
+				return nil, err
+			}
+			nameChanged = true
+		case commands.CustomerFieldFirstName:
+			name.First = *cmd.FirstName
+			nameChanged = true
+		case commands.CustomerFieldLastName:
+			name.Last = *cmd.LastName
+			nameChanged = true
+		case commands.CustomerFieldLocale:
+			if locale, err = domain.NewLocale(*cmd.Locale); err != nil {
+				return nil, err
+			}
+			preferencesChanged = true
+		case commands.CustomerFieldTimezone:
+			if timezone, err = domain.NewTimezone(*cmd.Timezone); err != nil {
+				return nil, err
+			}
+			preferencesChanged = true
+		case commands.CustomerFieldEmail:
+			isUnique, err := h.domainSvc.IsEmailUnique(ctx, *cmd.Email, customer.GetID())
+			if err != nil {
+				return nil, fmt.Errorf("failed to check email uniqueness: %w", err)
+			}
+			if !isUnique {
+				return nil, shareddomain.NewDomainError(
+					shareddomain.ErrCodeAlreadyExists,
+					"customer with this email already exists",
+				)
+			}
+			if err := customer.ChangeEmail(*cmd.Email); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if nameChanged {
+		if name, err = domain.NewPersonName(name.First, name.Last); err != nil {
+			return nil, err
+		}
+		if err := customer.UpdateName(name); err != nil {
+			return nil, err
+		}
+	}
+
+	if preferencesChanged {
+		if err := customer.UpdatePreferences(locale, timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, customer); err != nil {
+		return nil, err
+	}
+
+	return &commands.PatchCustomerResult{
+		CustomerID:    customer.GetID(),
+		Name:          customer.Name.Full(),
+		FirstName:     customer.Name.First,
+		LastName:      customer.Name.Last,
+		Email:         customer.Email.Value,
+		Status:        string(customer.Status),
+		Locale:        string(customer.Locale),
+		Timezone:      string(customer.Timezone),
+		Version:       customer.GetVersion(),
+		UpdatedFields: fields,
+	}, nil
+}
+
+// resolveFields determines which fields the command updates
+func (h *PatchCustomerHandler) resolveFields(cmd *commands.PatchCustomerCommand) ([]string, error) {
+	provided := map[string]bool{
+		commands.CustomerFieldName:      cmd.Name != nil,
+		commands.CustomerFieldFirstName: cmd.FirstName != nil,
+		commands.CustomerFieldLastName:  cmd.LastName != nil,
+		commands.CustomerFieldEmail:     cmd.Email != nil,
+		commands.CustomerFieldLocale:    cmd.Locale != nil,
+		commands.CustomerFieldTimezone:  cmd.Timezone != nil,
+	}
+
+	var fields []string
+	if len(cmd.FieldMask) == 0 {
+		// Without a mask, every provided field is applied
+		for _, field := range []string{
+			commands.CustomerFieldName,
+			commands.CustomerFieldFirstName,
+			commands.CustomerFieldLastName,
+			commands.CustomerFieldEmail,
+			commands.CustomerFieldLocale,
+			commands.CustomerFieldTimezone,
+		} {
+			if provided[field] {
+				fields = append(fields, field)
+			}
+		}
+	} else {
+		seen := make(map[string]bool)
+		for _, field := range cmd.FieldMask {
+			isProvided, known := provided[field]
+			if !known {
+				return nil, shareddomain.NewDomainErrorWithField(
+					shareddomain.ErrCodeInvalidInput,
+					fmt.Sprintf("unknown field %q in field mask", field),
+					field,
+				)
+			}
+			if !isProvided {
+				return nil, shareddomain.NewDomainErrorWithField(
+					shareddomain.ErrCodeInvalidInput,
+					fmt.Sprintf("field %q is listed in the field mask but has no value", field),
+					field,
+				)
+			}
+			if !seen[field] {
+				seen[field] = true
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"at least one field must be provided",
+		)
+	}
+
+	// The full name and its parts are alternatives, not combinable
+	selected := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		selected[field] = true
+	}
+	if selected[commands.CustomerFieldName] && (selected[commands.CustomerFieldFirstName] || selected[commands.CustomerFieldLastName]) {
+		return nil, shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeInvalidInput,
+			"name cannot be combined with first_name or last_name",
+			commands.CustomerFieldName,
+		)
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,106 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/customer/application/commands"
+	"golang_modular_monolith/internal/modules/customer/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// UpdateCustomerHandler handles UpdateCustomerCommand
+type UpdateCustomerHandler struct {
+	repo      domain.CustomerRepository
+	domainSvc domain.CustomerDomainService
+	eventBus  shareddomain.EventBus
+}
+
+// NewUpdateCustomerHandler creates a new UpdateCustomerHandler
+func NewUpdateCustomerHandler(
+	repo domain.CustomerRepository,
+	domainSvc domain.CustomerDomainService,
+	eventBus shareddomain.EventBus,
+) *UpdateCustomerHandler {
+	return &UpdateCustomerHandler{
+		repo:      repo,
+		domainSvc: domainSvc,
+		eventBus:  eventBus,
+	}
+}
+
+// Handle handles the UpdateCustomerCommand
+// Each changed detail goes through its granular domain method, so that only its event is raised
+func (h *UpdateCustomerHandler) Handle(ctx context.Context, cmd *commands.UpdateCustomerCommand) (*commands.UpdateCustomerResult, error) {
+	if cmd.Name == "" && cmd.FirstName == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"name or first_name is required",
+		)
+	}
+	if cmd.Email == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"email is required",
+		)
+	}
+
+	name, err := resolveName(cmd.Name, cmd.FirstName, cmd.LastName)
+	if err != nil {
+		return nil, err
+	}
+	locale, err := domain.NewLocale(cmd.Locale)
+	if err != nil {
+		return nil, err
+	}
+	timezone, err := domain.NewTimezone(cmd.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := loadCustomer(ctx, h.repo, cmd.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(customer, cmd.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	if err := customer.UpdateName(name); err != nil {
+		return nil, err
+	}
+
+	isUnique, err := h.domainSvc.IsEmailUnique(ctx, cmd.Email, customer.GetID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check email uniqueness: %w", err)
+	}
+	if !isUnique {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeAlreadyExists,
+			"customer with this email already exists",
+		)
+	}
+	if err := customer.ChangeEmail(cmd.Email); err != nil {
+		return nil, err
+	}
+
+	if err := customer.UpdatePreferences(locale, timezone); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, customer); err != nil {
+		return nil, err
+	}
+
+	return &commands.UpdateCustomerResult{
+		CustomerID: customer.GetID(),
+		Name:       customer.Name.Full(),
+		FirstName:  customer.Name.First,
+		LastName:   customer.Name.Last,
+		Email:      customer.Email.Value,
+		Status:     string(customer.Status),
+		Locale:     string(customer.Locale),
+		Timezone:   string(customer.Timezone),
+		Version:    customer.GetVersion(),
+	}, nil
+}
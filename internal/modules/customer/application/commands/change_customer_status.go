@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"golang_modular_monolith/internal/modules/customer/domain"
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// ChangeCustomerStatusCommand represents a command to move a customer through its lifecycle
+type ChangeCustomerStatusCommand struct {
+	application.BaseCommand
+	CustomerID string `json:"customer_id" validate:"required"`
+	Status     string `json:"status" validate:"required"`
+	// ExpectedVersion rejects the change when the customer changed since it was read
+	ExpectedVersion *int `json:"expected_version,omitempty"`
+}
+
+// NewChangeCustomerStatusCommand creates a new change customer status command
+func NewChangeCustomerStatusCommand(customerID, status string) ChangeCustomerStatusCommand {
+	return ChangeCustomerStatusCommand{
+		BaseCommand: application.NewBaseCommand("change_customer_status"),
+		CustomerID:  customerID,
+		Status:      status,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c ChangeCustomerStatusCommand) RequiredPermission() string {
+	return domain.PermissionWriteCustomers
+}
+
+// ChangeCustomerStatusResult represents the result of changing a customer's status
+type ChangeCustomerStatusResult struct {
+	CustomerID     string `json:"customer_id"`
+	PreviousStatus string `json:"previous_status"`
+	Status         string `json:"status"`
+	Version        int    `json:"version"`
+}
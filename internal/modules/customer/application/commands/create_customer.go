@@ -1,14 +1,22 @@
 package commands
 
 import (
+	"golang_modular_monolith/internal/modules/customer/domain"
 	"golang_modular_monolith/internal/shared/application"
 )
 
 // CreateCustomerCommand represents a command to create a new customer
 type CreateCustomerCommand struct {
 	application.BaseCommand
-	Name  string `json:"name" validate:"required,min=1,max=100"`
-	Email string `json:"email" validate:"required,email"`
+	// Name is the free-text full name; FirstName/LastName take precedence when set
+	Name      string `json:"name" validate:"max=255"`
+	FirstName string `json:"first_name" validate:"max=100"`
+	LastName  string `json:"last_name" validate:"max=100"`
+	Email     string `json:"email" validate:"required,email"`
+	Locale    string `json:"locale" validate:"omitempty,bcp47_language_tag"`
+	Timezone  string `json:"timezone" validate:"omitempty,timezone"`
+	// AllowDuplicates creates the customer even if duplicate detection rejects it
+	AllowDuplicates bool `json:"allow_duplicates"`
 }
 
 // NewCreateCustomerCommand creates a new create customer command
@@ -24,6 +32,13 @@ func NewCreateCustomerCommand(name, email string) CreateCustomerCommand {
 type CreateCustomerResult struct {
 	CustomerID string `json:"customer_id"`
 	Name       string `json:"name"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
 	Email      string `json:"email"`
 	Status     string `json:"status"`
+	Locale     string `json:"locale,omitempty"`
+	Timezone   string `json:"timezone,omitempty"`
+	// PossibleDuplicate is set when similar customers already exist
+	PossibleDuplicate   bool                        `json:"possible_duplicate,omitempty"`
+	DuplicateCandidates []domain.DuplicateCandidate `json:"duplicate_candidates,omitempty"`
 }
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"golang_modular_monolith/internal/modules/customer/domain"
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// SetCustomerAttributesCommand represents a command to set custom attributes on a customer
+type SetCustomerAttributesCommand struct {
+	application.BaseCommand
+	CustomerID string                 `json:"customer_id" validate:"required"`
+	Attributes map[string]interface{} `json:"attributes" validate:"required,min=1"`
+}
+
+// NewSetCustomerAttributesCommand creates a new set customer attributes command
+func NewSetCustomerAttributesCommand(customerID string, attributes map[string]interface{}) SetCustomerAttributesCommand {
+	return SetCustomerAttributesCommand{
+		BaseCommand: application.NewBaseCommand("set_customer_attributes"),
+		CustomerID:  customerID,
+		Attributes:  attributes,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c SetCustomerAttributesCommand) RequiredPermission() string {
+	return domain.PermissionWriteCustomers
+}
+
+// UnsetCustomerAttributesCommand represents a command to remove custom attributes from a customer
+type UnsetCustomerAttributesCommand struct {
+	application.BaseCommand
+	CustomerID string   `json:"customer_id" validate:"required"`
+	Keys       []string `json:"keys" validate:"required,min=1"`
+}
+
+// NewUnsetCustomerAttributesCommand creates a new unset customer attributes command
+func NewUnsetCustomerAttributesCommand(customerID string, keys []string) UnsetCustomerAttributesCommand {
+	return UnsetCustomerAttributesCommand{
+		BaseCommand: application.NewBaseCommand("unset_customer_attributes"),
+		CustomerID:  customerID,
+		Keys:        keys,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c UnsetCustomerAttributesCommand) RequiredPermission() string {
+	return domain.PermissionWriteCustomers
+}
+
+// CustomerAttributesResult represents the result of changing customer attributes
+type CustomerAttributesResult struct {
+	CustomerID string                 `json:"customer_id"`
+	Attributes map[string]interface{} `json:"attributes"`
+	Version    int                    `json:"version"`
+}
@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"golang_modular_monolith/internal/modules/customer/domain"
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// DeleteCustomerCommand represents a command to delete a customer
+// Deleted customers are kept for reference but hidden from lookups and listings
+type DeleteCustomerCommand struct {
+	application.BaseCommand
+	CustomerID string `json:"customer_id" validate:"required"`
+	// ExpectedVersion rejects the deletion when the customer changed since it was read
+	ExpectedVersion *int `json:"expected_version,omitempty"`
+}
+
+// NewDeleteCustomerCommand creates a new delete customer command
+func NewDeleteCustomerCommand(customerID string) DeleteCustomerCommand {
+	return DeleteCustomerCommand{
+		BaseCommand: application.NewBaseCommand("delete_customer"),
+		CustomerID:  customerID,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c DeleteCustomerCommand) RequiredPermission() string {
+	return domain.PermissionDeleteCustomers
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"golang_modular_monolith/internal/modules/customer/domain"
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// Patchable customer fields accepted in a field mask
+const (
+	CustomerFieldName      = "name"
+	CustomerFieldFirstName = "first_name"
+	CustomerFieldLastName  = "last_name"
+	CustomerFieldEmail     = "email"
+	CustomerFieldLocale    = "locale"
+	CustomerFieldTimezone  = "timezone"
+)
+
+// PatchCustomerCommand represents a command to partially update a customer
+// Only fields listed in FieldMask are applied; an empty mask applies every provided field
+type PatchCustomerCommand struct {
+	application.BaseCommand
+	CustomerID string   `json:"customer_id" validate:"required"`
+	Name       *string  `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	FirstName  *string  `json:"first_name,omitempty" validate:"omitempty,min=1,max=100"`
+	LastName   *string  `json:"last_name,omitempty" validate:"omitempty,max=100"`
+	Email      *string  `json:"email,omitempty" validate:"omitempty,email"`
+	Locale     *string  `json:"locale,omitempty" validate:"omitempty,bcp47_language_tag"`
+	Timezone   *string  `json:"timezone,omitempty" validate:"omitempty,timezone"`
+	FieldMask  []string `json:"field_mask,omitempty"`
+	// ExpectedVersion rejects the update when the customer changed since it was read
+	ExpectedVersion *int `json:"expected_version,omitempty"`
+}
+
+// NewPatchCustomerCommand creates a new patch customer command
+func NewPatchCustomerCommand(customerID string, name, email *string, fieldMask []string) PatchCustomerCommand {
+	return PatchCustomerCommand{
+		BaseCommand: application.NewBaseCommand("patch_customer"),
+		CustomerID:  customerID,
+		Name:        name,
+		Email:       email,
+		FieldMask:   fieldMask,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c PatchCustomerCommand) RequiredPermission() string {
+	return domain.PermissionWriteCustomers
+}
+
+// PatchCustomerResult represents the result of partially updating a customer
+type PatchCustomerResult struct {
+	CustomerID    string   `json:"customer_id"`
+	Name          string   `json:"name"`
+	FirstName     string   `json:"first_name"`
+	LastName      string   `json:"last_name"`
+	Email         string   `json:"email"`
+	Status        string   `json:"status"`
+	Locale        string   `json:"locale,omitempty"`
+	Timezone      string   `json:"timezone,omitempty"`
+	Version       int      `json:"version"`
+	UpdatedFields []string `json:"updated_fields"`
+}
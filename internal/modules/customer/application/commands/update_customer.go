@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"golang_modular_monolith/internal/modules/customer/domain"
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// UpdateCustomerCommand represents a command to replace a customer's details
+// Unlike PatchCustomerCommand every field is applied: an empty locale or timezone clears it
+type UpdateCustomerCommand struct {
+	application.BaseCommand
+	CustomerID string `json:"customer_id" validate:"required"`
+	// Name is the free-text full name; FirstName/LastName take precedence when set
+	Name      string `json:"name" validate:"max=255"`
+	FirstName string `json:"first_name" validate:"max=100"`
+	LastName  string `json:"last_name" validate:"max=100"`
+	Email     string `json:"email" validate:"required,email"`
+	Locale    string `json:"locale" validate:"omitempty,bcp47_language_tag"`
+	Timezone  string `json:"timezone" validate:"omitempty,timezone"`
+	// ExpectedVersion rejects the update when the customer changed since it was read
+	ExpectedVersion *int `json:"expected_version,omitempty"`
+}
+
+// NewUpdateCustomerCommand creates a new update customer command
+func NewUpdateCustomerCommand(customerID, name, email string) UpdateCustomerCommand {
+	return UpdateCustomerCommand{
+		BaseCommand: application.NewBaseCommand("update_customer"),
+		CustomerID:  customerID,
+		Name:        name,
+		Email:       email,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c UpdateCustomerCommand) RequiredPermission() string {
+	return domain.PermissionWriteCustomers
+}
+
+// UpdateCustomerResult represents the result of updating a customer
+type UpdateCustomerResult struct {
+	CustomerID string `json:"customer_id"`
+	Name       string `json:"name"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	Email      string `json:"email"`
+	Status     string `json:"status"`
+	Locale     string `json:"locale,omitempty"`
+	Timezone   string `json:"timezone,omitempty"`
+	Version    int    `json:"version"`
+}
@@ -1,6 +1,10 @@
 package queries
 
-import "golang_modular_monolith/internal/modules/customer/domain"
+import (
+	"time"
+
+	"golang_modular_monolith/internal/modules/customer/domain"
+)
 
 // GetCustomerQuery represents a query to get a customer by ID
 type GetCustomerQuery struct {
@@ -14,16 +18,34 @@ type GetCustomerResult struct {
 
 // ListCustomersQuery represents a query to list customers with pagination
 type ListCustomersQuery struct {
-	Page           int                    `json:"page"`
-	Limit          int                    `json:"limit"`
-	Status         *domain.CustomerStatus `json:"status,omitempty"`
-	IncludeDeleted bool                   `json:"include_deleted"`
-	SortBy         string                 `json:"sort_by"`
-	SortOrder      string                 `json:"sort_order"`
-	CreatedAfter   *string                `json:"created_after,omitempty"`
-	CreatedBefore  *string                `json:"created_before,omitempty"`
-	UpdatedAfter   *string                `json:"updated_after,omitempty"`
-	UpdatedBefore  *string                `json:"updated_before,omitempty"`
+	Page           int                     `json:"page"`
+	Limit          int                     `json:"limit"`
+	Statuses       []domain.CustomerStatus `json:"statuses,omitempty"`
+	IncludeDeleted bool                    `json:"include_deleted"`
+	Attributes     map[string]string       `json:"attributes,omitempty"`
+	SortBy         string                  `json:"sort_by"`
+	SortOrder      string                  `json:"sort_order"`
+	CreatedAfter   *time.Time              `json:"created_after,omitempty"`
+	CreatedBefore  *time.Time              `json:"created_before,omitempty"`
+	UpdatedAfter   *time.Time              `json:"updated_after,omitempty"`
+	UpdatedBefore  *time.Time              `json:"updated_before,omitempty"`
+}
+
+// ToParams converts the query filters to domain list params
+func (q *ListCustomersQuery) ToParams() domain.ListCustomersParams {
+	return domain.ListCustomersParams{
+		Page:           q.Page,
+		Limit:          q.Limit,
+		Statuses:       q.Statuses,
+		IncludeDeleted: q.IncludeDeleted,
+		Attributes:     q.Attributes,
+		SortBy:         q.SortBy,
+		SortOrder:      q.SortOrder,
+		CreatedAfter:   q.CreatedAfter,
+		CreatedBefore:  q.CreatedBefore,
+		UpdatedAfter:   q.UpdatedAfter,
+		UpdatedBefore:  q.UpdatedBefore,
+	}
 }
 
 // ListCustomersResult represents the result of ListCustomersQuery
@@ -31,17 +53,24 @@ type ListCustomersResult struct {
 	domain.CustomerListResult
 }
 
+// ExportCustomersQuery represents a query to export every customer matching the list filters
+// Page and Limit are ignored
+type ExportCustomersQuery struct {
+	ListCustomersQuery
+}
+
 // SearchCustomersQuery represents a query to search customers
 type SearchCustomersQuery struct {
-	Query     string                 `json:"query"`
-	Email     string                 `json:"email"`
-	FirstName string                 `json:"first_name"`
-	LastName  string                 `json:"last_name"`
-	Page      int                    `json:"page"`
-	Limit     int                    `json:"limit"`
-	Status    *domain.CustomerStatus `json:"status,omitempty"`
-	SortBy    string                 `json:"sort_by"`
-	SortOrder string                 `json:"sort_order"`
+	Query      string                  `json:"query"`
+	Email      string                  `json:"email"`
+	FirstName  string                  `json:"first_name"`
+	LastName   string                  `json:"last_name"`
+	Page       int                     `json:"page"`
+	Limit      int                     `json:"limit"`
+	Statuses   []domain.CustomerStatus `json:"statuses,omitempty"`
+	Attributes map[string]string       `json:"attributes,omitempty"`
+	SortBy     string                  `json:"sort_by"`
+	SortOrder  string                  `json:"sort_order"`
 }
 
 // SearchCustomersResult represents the result of SearchCustomersQuery
@@ -0,0 +1,26 @@
+package queryhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/customer/application/queries"
+	"golang_modular_monolith/internal/modules/customer/domain"
+)
+
+// ExportCustomersHandler handles ExportCustomersQuery
+type ExportCustomersHandler struct {
+	queryRepo domain.CustomerQueryRepository
+}
+
+// NewExportCustomersHandler creates a new ExportCustomersHandler
+func NewExportCustomersHandler(queryRepo domain.CustomerQueryRepository) *ExportCustomersHandler {
+	return &ExportCustomersHandler{
+		queryRepo: queryRepo,
+	}
+}
+
+// Handle streams the matching customers to fn
+// Errors returned by fn are passed through unchanged so the caller can tell write failures apart
+func (h *ExportCustomersHandler) Handle(ctx context.Context, query *queries.ExportCustomersQuery, fn func(domain.CustomerView) error) error {
+	return h.queryRepo.Export(ctx, query.ToParams(), fn)
+}
@@ -23,18 +23,7 @@ func NewListCustomersHandler(queryRepo domain.CustomerQueryRepository) *ListCust
 // Handle handles the ListCustomersQuery
 func (h *ListCustomersHandler) Handle(ctx context.Context, query *queries.ListCustomersQuery) (*queries.ListCustomersResult, error) {
 	// Convert query to domain params
-	params := domain.ListCustomersParams{
-		Page:           query.Page,
-		Limit:          query.Limit,
-		Status:         query.Status,
-		IncludeDeleted: query.IncludeDeleted,
-		SortBy:         query.SortBy,
-		SortOrder:      query.SortOrder,
-		CreatedAfter:   query.CreatedAfter,
-		CreatedBefore:  query.CreatedBefore,
-		UpdatedAfter:   query.UpdatedAfter,
-		UpdatedBefore:  query.UpdatedBefore,
-	}
+	params := query.ToParams()
 
 	// Get customers from repository
 	result, err := h.queryRepo.List(ctx, params)
@@ -25,11 +25,12 @@ func (h *SearchCustomersHandler) Handle(ctx context.Context, query *queries.Sear
 	// Convert query to domain params
 	params := domain.SearchCustomersParams{
 		ListCustomersParams: domain.ListCustomersParams{
-			Page:      query.Page,
-			Limit:     query.Limit,
-			Status:    query.Status,
-			SortBy:    query.SortBy,
-			SortOrder: query.SortOrder,
+			Page:       query.Page,
+			Limit:      query.Limit,
+			Statuses:   query.Statuses,
+			Attributes: query.Attributes,
+			SortBy:     query.SortBy,
+			SortOrder:  query.SortOrder,
 		},
 		Query:     query.Query,
 		Email:     query.Email,
@@ -0,0 +1,68 @@
+package customer
+
+import (
+	commandhandlers "golang_modular_monolith/internal/modules/customer/application/command_handlers"
+	queryhandlers "golang_modular_monolith/internal/modules/customer/application/query_handlers"
+	customerdomain "golang_modular_monolith/internal/modules/customer/domain"
+	customerdb "golang_modular_monolith/internal/modules/customer/infrastructure/database"
+	"golang_modular_monolith/internal/modules/customer/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/customer/infrastructure/persistence"
+	"golang_modular_monolith/internal/modules/customer/infrastructure/projections"
+	customersearch "golang_modular_monolith/internal/modules/customer/infrastructure/search"
+
+	"golang_modular_monolith/internal/shared/application"
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/di"
+	"golang_modular_monolith/internal/shared/infrastructure/importexport"
+	sharedsearch "golang_modular_monolith/internal/shared/infrastructure/search"
+)
+
+// newContainer registers the constructors of the customer module's repositories, projections and
+// handlers; nothing is constructed until it is resolved, so callers may replace providers first
+// With a search engine, customer searches are answered from its index
+func newContainer(eventBus domain.EventBus, commandBus *application.MiddlewareCommandBus, duplicatePolicy customerdomain.DuplicateCheckPolicy, engine sharedsearch.Engine, jobs *importexport.Jobs) *di.Container {
+	c := di.New()
+	di.Value(c, eventBus)
+	di.Value(c, commandBus)
+	di.Value(c, duplicatePolicy)
+	di.Value(c, jobs)
+	c.Provide(customerdb.GetCustomerDB)
+
+	// Repositories and domain services
+	c.Provide(persistence.NewPostgreSQLCustomerRepository, di.As[customerdomain.CustomerRepository]())
+	c.Provide(persistence.NewPostgreSQLCustomerQueryRepository, di.As[customerdomain.CustomerQueryRepository]())
+	c.Provide(persistence.NewPostgreSQLCustomerDuplicateFinder, di.As[customerdomain.CustomerDuplicateFinder]())
+	c.Provide(persistence.NewCustomerDomainService, di.As[customerdomain.CustomerDomainService]())
+
+	// Read model projections
+	c.Provide(projections.NewCustomerViewProjection)
+	c.Provide(projections.NewCustomerOrderStatsProjection)
+
+	// Search index of customers
+	if engine != nil {
+		di.Value(c, engine)
+		di.Decorate(c, func(repo customerdomain.CustomerQueryRepository) (customerdomain.CustomerQueryRepository, error) {
+			return customersearch.NewIndexedCustomerQueryRepository(repo, engine), nil
+		})
+		c.Provide(customersearch.NewCustomerProjection)
+	}
+
+	// Command handlers
+	c.Provide(commandhandlers.NewCreateCustomerHandler)
+	c.Provide(commandhandlers.NewUpdateCustomerHandler)
+	c.Provide(commandhandlers.NewPatchCustomerHandler)
+	c.Provide(commandhandlers.NewSetCustomerAttributesHandler)
+	c.Provide(commandhandlers.NewUnsetCustomerAttributesHandler)
+	c.Provide(commandhandlers.NewChangeCustomerStatusHandler)
+	c.Provide(commandhandlers.NewDeleteCustomerHandler)
+
+	// Query handlers
+	c.Provide(queryhandlers.NewGetCustomerHandler)
+	c.Provide(queryhandlers.NewListCustomersHandler)
+	c.Provide(queryhandlers.NewSearchCustomersHandler)
+	c.Provide(queryhandlers.NewExportCustomersHandler)
+
+	// HTTP handlers
+	c.Provide(handlers.NewCustomerHandler)
+	return c
+}
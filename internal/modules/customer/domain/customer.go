@@ -1,8 +1,12 @@
 package domain
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
+	"time"
+
+	"golang.org/x/text/language"
 
 	"golang_modular_monolith/internal/shared/domain"
 )
@@ -11,17 +15,148 @@ import (
 type CustomerStatus string
 
 const (
+	CustomerStatusProspect CustomerStatus = "prospect"
 	CustomerStatusActive   CustomerStatus = "active"
 	CustomerStatusInactive CustomerStatus = "inactive"
+	CustomerStatusChurned  CustomerStatus = "churned"
 	CustomerStatusDeleted  CustomerStatus = "deleted"
 )
 
+// IsValid checks if the customer status is known
+func (s CustomerStatus) IsValid() bool {
+	switch s {
+	case CustomerStatusProspect, CustomerStatusActive, CustomerStatusInactive, CustomerStatusChurned, CustomerStatusDeleted:
+		return true
+	}
+	return false
+}
+
+// PermissionDeleteCustomers is the permission required to delete customers
+const PermissionDeleteCustomers = "customers:delete"
+
+// PermissionImportCustomers is the permission required to import customers
+const PermissionImportCustomers = "customers:import"
+
+// PermissionWriteCustomers is the permission required to change existing customers
+const PermissionWriteCustomers = "customers:write"
+
+// MaxCustomerAttributes is the maximum number of custom attributes per customer
+const MaxCustomerAttributes = 50
+
+// attributeKeyRegex restricts attribute keys to simple snake_case identifiers
+var attributeKeyRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
 // Customer represents the customer aggregate root
 type Customer struct {
 	domain.BaseAggregateRoot
-	Name   string         `json:"name"`
-	Email  Email          `json:"email"`
-	Status CustomerStatus `json:"status"`
+	Name       PersonName             `json:"name"`
+	Email      Email                  `json:"email"`
+	Status     CustomerStatus         `json:"status"`
+	Attributes map[string]interface{} `json:"attributes"`
+	Locale     Locale                 `json:"locale"`
+	Timezone   Timezone               `json:"timezone"`
+}
+
+// PersonName represents a customer's structured name value object
+type PersonName struct {
+	First string `json:"first_name"`
+	Last  string `json:"last_name"`
+}
+
+// NewPersonName creates a new person name value object
+func NewPersonName(first, last string) (PersonName, error) {
+	first = strings.Join(strings.Fields(first), " ")
+	last = strings.Join(strings.Fields(last), " ")
+
+	if first == "" {
+		return PersonName{}, domain.NewValidationError("first_name", "first name is required")
+	}
+	if len(first)+len(last) > 254 {
+		return PersonName{}, domain.NewValidationError("name", "name is too long")
+	}
+
+	return PersonName{First: first, Last: last}, nil
+}
+
+// ParsePersonName splits a free-text full name into first and last name.
+// The first word is the first name; everything after it is the last name.
+func ParsePersonName(fullName string) (PersonName, error) {
+	parts := strings.Fields(fullName)
+	if len(parts) == 0 {
+		return PersonName{}, domain.NewValidationError("name", "name is required")
+	}
+
+	return NewPersonName(parts[0], strings.Join(parts[1:], " "))
+}
+
+// Full returns the full name ("First Last")
+func (n PersonName) Full() string {
+	if n.Last == "" {
+		return n.First
+	}
+	return n.First + " " + n.Last
+}
+
+// String returns the full name
+func (n PersonName) String() string {
+	return n.Full()
+}
+
+// IsEmpty checks if name is empty
+func (n PersonName) IsEmpty() bool {
+	return n.First == "" && n.Last == ""
+}
+
+// Locale represents a BCP 47 language tag value object (e.g. "en-US", "vi")
+// The zero value means no preference
+type Locale string
+
+// NewLocale validates and canonicalizes a BCP 47 language tag
+func NewLocale(value string) (Locale, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+
+	tag, err := language.Parse(value)
+	if err != nil {
+		return "", domain.NewValidationErrorWithValue("locale", "locale must be a valid BCP 47 language tag", value)
+	}
+
+	return Locale(tag.String()), nil
+}
+
+// Timezone represents an IANA time zone name value object (e.g. "Asia/Ho_Chi_Minh")
+// The zero value means no preference
+type Timezone string
+
+// NewTimezone validates an IANA time zone name
+func NewTimezone(value string) (Timezone, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+
+	// time.LoadLocation also accepts "Local", which is not a portable zone name
+	if value == "Local" {
+		return "", domain.NewValidationErrorWithValue("timezone", "timezone must be a valid IANA time zone", value)
+	}
+	if _, err := time.LoadLocation(value); err != nil {
+		return "", domain.NewValidationErrorWithValue("timezone", "timezone must be a valid IANA time zone", value)
+	}
+
+	return Timezone(value), nil
+}
+
+// Location returns the time zone location, defaulting to UTC when no preference is set
+func (tz Timezone) Location() *time.Location {
+	if tz == "" {
+		return time.UTC
+	}
+	if location, err := time.LoadLocation(string(tz)); err == nil {
+		return location
+	}
+	return time.UTC
 }
 
 // Email represents customer email value object
@@ -55,13 +190,12 @@ func (e Email) IsEmpty() bool {
 }
 
 // NewCustomer creates a new customer
-func NewCustomer(name, email string) (*Customer, error) {
+func NewCustomer(name PersonName, email string, locale Locale, timezone Timezone) (*Customer, error) {
 	// Validate input
 	var validationErrors domain.ValidationErrors
 
-	name = strings.TrimSpace(name)
-	if name == "" {
-		validationErrors.Add("name", "name is required")
+	if name.First == "" {
+		validationErrors.Add("first_name", "first name is required")
 	}
 
 	customerEmail, err := NewEmail(email)
@@ -82,7 +216,10 @@ func NewCustomer(name, email string) (*Customer, error) {
 		BaseAggregateRoot: domain.NewBaseAggregateRoot(),
 		Name:              name,
 		Email:             customerEmail,
-		Status:            CustomerStatusActive,
+		Status:            Lifecycle().InitialStatus,
+		Attributes:        make(map[string]interface{}),
+		Locale:            locale,
+		Timezone:          timezone,
 	}
 
 	// Add domain event
@@ -92,10 +229,9 @@ func NewCustomer(name, email string) (*Customer, error) {
 }
 
 // UpdateName updates customer's name
-func (c *Customer) UpdateName(name string) error {
-	name = strings.TrimSpace(name)
-	if name == "" {
-		return domain.NewValidationError("name", "name is required")
+func (c *Customer) UpdateName(name PersonName) error {
+	if name.First == "" {
+		return domain.NewValidationError("first_name", "first name is required")
 	}
 
 	// Check if anything changed
@@ -135,57 +271,162 @@ func (c *Customer) ChangeEmail(newEmail string) error {
 	return nil
 }
 
-// Activate activates the customer
-func (c *Customer) Activate() error {
-	if c.Status == CustomerStatusActive {
-		return nil
+// UpdatePreferences changes the customer's locale and timezone preferences
+func (c *Customer) UpdatePreferences(locale Locale, timezone Timezone) error {
+	if c.Status == CustomerStatusDeleted {
+		return domain.NewBusinessRuleError("customer_deleted", "cannot change preferences of deleted customer")
 	}
 
-	if c.Status == CustomerStatusDeleted {
-		return domain.NewBusinessRuleError("customer_deleted", "cannot activate deleted customer")
+	// Check if anything changed
+	if c.Locale == locale && c.Timezone == timezone {
+		return nil
 	}
 
-	oldStatus := c.Status
-	c.Status = CustomerStatusActive
+	c.Locale = locale
+	c.Timezone = timezone
 	c.IncrementVersion()
 
 	// Add domain event
-	c.AddEvent(NewCustomerStatusChangedEvent(c, oldStatus, CustomerStatusActive))
+	c.AddEvent(NewCustomerPreferencesChangedEvent(c))
 
 	return nil
 }
 
+// Activate activates the customer
+func (c *Customer) Activate() error {
+	return c.TransitionTo(CustomerStatusActive)
+}
+
 // Deactivate deactivates the customer
 func (c *Customer) Deactivate() error {
-	if c.Status == CustomerStatusInactive {
+	return c.TransitionTo(CustomerStatusInactive)
+}
+
+// Churn marks the customer as churned
+func (c *Customer) Churn() error {
+	return c.TransitionTo(CustomerStatusChurned)
+}
+
+// Delete marks the customer as deleted
+func (c *Customer) Delete() error {
+	return c.TransitionTo(CustomerStatusDeleted)
+}
+
+// TransitionTo moves the customer to a new status if the lifecycle allows it
+func (c *Customer) TransitionTo(status CustomerStatus) error {
+	if !status.IsValid() {
+		return domain.NewValidationError("status", fmt.Sprintf("unknown status %q", status))
+	}
+
+	if c.Status == status {
 		return nil
 	}
 
-	if c.Status == CustomerStatusDeleted {
-		return domain.NewBusinessRuleError("customer_deleted", "cannot deactivate deleted customer")
+	if !Lifecycle().CanTransition(c.Status, status) {
+		return domain.NewBusinessRuleError(
+			"invalid_status_transition",
+			fmt.Sprintf("cannot change customer status from %s to %s", c.Status, status),
+		)
 	}
 
 	oldStatus := c.Status
-	c.Status = CustomerStatusInactive
+	c.Status = status
+	c.IncrementVersion()
+
+	// Add domain events
+	if status == CustomerStatusDeleted {
+		c.AddEvent(NewCustomerDeletedEvent(c))
+		return nil
+	}
+
+	c.AddEvent(NewCustomerStatusChangedEvent(c, oldStatus, status))
+	switch status {
+	case CustomerStatusActive:
+		c.AddEvent(NewCustomerActivatedEvent(c, oldStatus))
+	case CustomerStatusInactive:
+		c.AddEvent(NewCustomerDeactivatedEvent(c, oldStatus))
+	case CustomerStatusChurned:
+		c.AddEvent(NewCustomerChurnedEvent(c, oldStatus))
+	}
+
+	return nil
+}
+
+// SetAttributes sets (adds or overwrites) custom attributes
+func (c *Customer) SetAttributes(attributes map[string]interface{}) error {
+	if c.Status == CustomerStatusDeleted {
+		return domain.NewBusinessRuleError("customer_deleted", "cannot change attributes of deleted customer")
+	}
+
+	var validationErrors domain.ValidationErrors
+	for key := range attributes {
+		if !attributeKeyRegex.MatchString(key) {
+			validationErrors.AddWithValue("attributes."+key, "attribute key must be snake_case and at most 64 characters", key)
+		}
+	}
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+
+	if c.Attributes == nil {
+		c.Attributes = make(map[string]interface{})
+	}
+
+	changed := make(map[string]interface{})
+	for key, value := range attributes {
+		if current, exists := c.Attributes[key]; exists && fmt.Sprint(current) == fmt.Sprint(value) {
+			continue
+		}
+		changed[key] = value
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	newCount := len(c.Attributes)
+	for key := range changed {
+		if _, exists := c.Attributes[key]; !exists {
+			newCount++
+		}
+	}
+	if newCount > MaxCustomerAttributes {
+		return domain.NewBusinessRuleError("attribute_limit", fmt.Sprintf("a customer can have at most %d attributes", MaxCustomerAttributes))
+	}
+
+	for key, value := range changed {
+		c.Attributes[key] = value
+	}
 	c.IncrementVersion()
 
 	// Add domain event
-	c.AddEvent(NewCustomerStatusChangedEvent(c, oldStatus, CustomerStatusInactive))
+	c.AddEvent(NewCustomerAttributesChangedEvent(c, changed, nil))
 
 	return nil
 }
 
-// Delete marks the customer as deleted
-func (c *Customer) Delete() error {
+// UnsetAttributes removes custom attributes
+func (c *Customer) UnsetAttributes(keys []string) error {
 	if c.Status == CustomerStatusDeleted {
+		return domain.NewBusinessRuleError("customer_deleted", "cannot change attributes of deleted customer")
+	}
+
+	var removed []string
+	for _, key := range keys {
+		if _, exists := c.Attributes[key]; exists {
+			delete(c.Attributes, key)
+			removed = append(removed, key)
+		}
+	}
+
+	if len(removed) == 0 {
 		return nil
 	}
 
-	c.Status = CustomerStatusDeleted
 	c.IncrementVersion()
 
 	// Add domain event
-	c.AddEvent(NewCustomerDeletedEvent(c))
+	c.AddEvent(NewCustomerAttributesChangedEvent(c, nil, removed))
 
 	return nil
 }
@@ -208,8 +449,8 @@ func (c *Customer) ValidateForCreation() error {
 		validationErrors.Add("email", "email is required")
 	}
 
-	if strings.TrimSpace(c.Name) == "" {
-		validationErrors.Add("name", "name is required")
+	if c.Name.First == "" {
+		validationErrors.Add("first_name", "first name is required")
 	}
 
 	if validationErrors.HasErrors() {
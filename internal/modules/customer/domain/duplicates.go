@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DuplicateCheckMode controls how customer creation reacts to likely duplicates
+type DuplicateCheckMode string
+
+const (
+	// DuplicateCheckOff disables duplicate detection
+	DuplicateCheckOff DuplicateCheckMode = "off"
+	// DuplicateCheckWarn creates the customer and flags the likely duplicates
+	DuplicateCheckWarn DuplicateCheckMode = "warn"
+	// DuplicateCheckReject refuses to create the customer unless duplicates are explicitly allowed
+	DuplicateCheckReject DuplicateCheckMode = "reject"
+)
+
+// IsValid checks if the duplicate check mode is valid
+func (m DuplicateCheckMode) IsValid() bool {
+	switch m {
+	case DuplicateCheckOff, DuplicateCheckWarn, DuplicateCheckReject:
+		return true
+	}
+	return false
+}
+
+// DuplicateDetectionFeature is the tenant feature flag switching duplicate detection off for a tenant
+const DuplicateDetectionFeature = "customer_duplicate_detection"
+
+// DuplicateCheckPolicy configures duplicate detection on customer creation
+type DuplicateCheckPolicy struct {
+	Mode           DuplicateCheckMode
+	NameThreshold  float64 // minimum trigram similarity of names (0..1)
+	EmailThreshold float64 // minimum trigram similarity of normalized emails (0..1)
+	MaxCandidates  int
+}
+
+// DefaultDuplicateCheckPolicy returns the default duplicate detection policy
+func DefaultDuplicateCheckPolicy() DuplicateCheckPolicy {
+	return DuplicateCheckPolicy{
+		Mode:           DuplicateCheckWarn,
+		NameThreshold:  0.6,
+		EmailThreshold: 0.8,
+		MaxCandidates:  5,
+	}
+}
+
+// Enabled reports whether duplicate detection should run
+func (p DuplicateCheckPolicy) Enabled() bool {
+	return p.Mode != "" && p.Mode != DuplicateCheckOff
+}
+
+// DuplicateCandidate is an existing customer that resembles the one being created
+type DuplicateCandidate struct {
+	CustomerID      string  `json:"customer_id"`
+	Name            string  `json:"name"`
+	Email           string  `json:"email"`
+	NameSimilarity  float64 `json:"name_similarity"`
+	EmailSimilarity float64 `json:"email_similarity"`
+}
+
+// DuplicateCriteria describes what to match existing customers against
+type DuplicateCriteria struct {
+	Name            string
+	NormalizedEmail string
+	NameThreshold   float64
+	EmailThreshold  float64
+	Limit           int
+}
+
+// CustomerDuplicateFinder finds existing customers that resemble a new one
+type CustomerDuplicateFinder interface {
+	// FindDuplicateCandidates returns the closest matches, best match first
+	FindDuplicateCandidates(ctx context.Context, criteria DuplicateCriteria) ([]DuplicateCandidate, error)
+}
+
+// ErrCodeDuplicateCustomer is the error code of DuplicateCustomerError
+const ErrCodeDuplicateCustomer = "DUPLICATE_CUSTOMER"
+
+// DuplicateCustomerError is returned when creation is rejected because of likely duplicates
+type DuplicateCustomerError struct {
+	Candidates []DuplicateCandidate
+}
+
+// Error implements the error interface
+func (e DuplicateCustomerError) Error() string {
+	return fmt.Sprintf("customer looks like a duplicate of %d existing customer(s)", len(e.Candidates))
+}
+
+// NormalizeEmail reduces an email to the form used for duplicate matching:
+// lowercased, with "+tag" suffixes and dots removed from the local part
+func NormalizeEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	local, domainPart, found := strings.Cut(email, "@")
+	if !found {
+		return email
+	}
+
+	local, _, _ = strings.Cut(local, "+")
+	local = strings.ReplaceAll(local, ".", "")
+
+	return local + "@" + domainPart
+}
@@ -6,29 +6,46 @@ import (
 
 // Customer domain event types
 const (
-	CustomerCreatedEventType       = "customer.created"
-	CustomerNameUpdatedEventType   = "customer.name_updated"
-	CustomerEmailChangedEventType  = "customer.email_changed"
-	CustomerStatusChangedEventType = "customer.status_changed"
-	CustomerDeletedEventType       = "customer.deleted"
+	CustomerCreatedEventType            = "customer.created"
+	CustomerNameUpdatedEventType        = "customer.name_updated"
+	CustomerEmailChangedEventType       = "customer.email_changed"
+	CustomerStatusChangedEventType      = "customer.status_changed"
+	CustomerDeletedEventType            = "customer.deleted"
+	CustomerAttributesChangedEventType  = "customer.attributes_changed"
+	CustomerPreferencesChangedEventType = "customer.preferences_changed"
+
+	// Lifecycle transition events, published alongside customer.status_changed
+	CustomerActivatedEventType   = "customer.activated"
+	CustomerDeactivatedEventType = "customer.deactivated"
+	CustomerChurnedEventType     = "customer.churned"
 )
 
 // CustomerCreatedEvent represents the event when a customer is created
 type CustomerCreatedEvent struct {
 	domain.BaseDomainEvent
 	CustomerID string `json:"customer_id"`
+	Version    int    `json:"version"`
 	Name       string `json:"name"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
 	Email      string `json:"email"`
 	Status     string `json:"status"`
+	Locale     string `json:"locale,omitempty"`
+	Timezone   string `json:"timezone,omitempty"`
 }
 
 // NewCustomerCreatedEvent creates a new customer created event
 func NewCustomerCreatedEvent(customer *Customer) CustomerCreatedEvent {
 	eventData := map[string]interface{}{
 		"customer_id": customer.GetID(),
-		"name":        customer.Name,
+		"version":     customer.GetVersion(),
+		"name":        customer.Name.Full(),
+		"first_name":  customer.Name.First,
+		"last_name":   customer.Name.Last,
 		"email":       customer.Email.Value,
 		"status":      customer.Status,
+		"locale":      customer.Locale,
+		"timezone":    customer.Timezone,
 	}
 
 	return CustomerCreatedEvent{
@@ -39,26 +56,57 @@ func NewCustomerCreatedEvent(customer *Customer) CustomerCreatedEvent {
 			eventData,
 		),
 		CustomerID: customer.GetID(),
-		Name:       customer.Name,
+		Version:    customer.GetVersion(),
+		Name:       customer.Name.Full(),
+		FirstName:  customer.Name.First,
+		LastName:   customer.Name.Last,
 		Email:      customer.Email.Value,
 		Status:     string(customer.Status),
+		Locale:     string(customer.Locale),
+		Timezone:   string(customer.Timezone),
 	}
 }
 
+// GetCustomerID returns the ID of the created customer
+func (e CustomerCreatedEvent) GetCustomerID() string {
+	return e.CustomerID
+}
+
+// GetName returns the full name of the customer
+func (e CustomerCreatedEvent) GetName() string {
+	return e.Name
+}
+
+// GetEmail returns the email address of the customer
+func (e CustomerCreatedEvent) GetEmail() string {
+	return e.Email
+}
+
+// GetLocale returns the BCP 47 language tag the customer prefers, empty when not set
+func (e CustomerCreatedEvent) GetLocale() string {
+	return e.Locale
+}
+
 // CustomerNameUpdatedEvent represents the event when customer's name is updated
 type CustomerNameUpdatedEvent struct {
 	domain.BaseDomainEvent
 	CustomerID string `json:"customer_id"`
+	Version    int    `json:"version"`
 	OldName    string `json:"old_name"`
 	NewName    string `json:"new_name"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
 }
 
 // NewCustomerNameUpdatedEvent creates a new customer name updated event
-func NewCustomerNameUpdatedEvent(customer *Customer, oldName string) CustomerNameUpdatedEvent {
+func NewCustomerNameUpdatedEvent(customer *Customer, oldName PersonName) CustomerNameUpdatedEvent {
 	eventData := map[string]interface{}{
 		"customer_id": customer.GetID(),
-		"old_name":    oldName,
-		"new_name":    customer.Name,
+		"version":     customer.GetVersion(),
+		"old_name":    oldName.Full(),
+		"new_name":    customer.Name.Full(),
+		"first_name":  customer.Name.First,
+		"last_name":   customer.Name.Last,
 	}
 
 	return CustomerNameUpdatedEvent{
@@ -69,8 +117,11 @@ func NewCustomerNameUpdatedEvent(customer *Customer, oldName string) CustomerNam
 			eventData,
 		),
 		CustomerID: customer.GetID(),
-		OldName:    oldName,
-		NewName:    customer.Name,
+		Version:    customer.GetVersion(),
+		OldName:    oldName.Full(),
+		NewName:    customer.Name.Full(),
+		FirstName:  customer.Name.First,
+		LastName:   customer.Name.Last,
 	}
 }
 
@@ -78,6 +129,7 @@ func NewCustomerNameUpdatedEvent(customer *Customer, oldName string) CustomerNam
 type CustomerEmailChangedEvent struct {
 	domain.BaseDomainEvent
 	CustomerID string `json:"customer_id"`
+	Version    int    `json:"version"`
 	OldEmail   string `json:"old_email"`
 	NewEmail   string `json:"new_email"`
 }
@@ -86,6 +138,7 @@ type CustomerEmailChangedEvent struct {
 func NewCustomerEmailChangedEvent(customer *Customer, oldEmail, newEmail Email) CustomerEmailChangedEvent {
 	eventData := map[string]interface{}{
 		"customer_id": customer.GetID(),
+		"version":     customer.GetVersion(),
 		"old_email":   oldEmail.Value,
 		"new_email":   newEmail.Value,
 	}
@@ -98,6 +151,7 @@ func NewCustomerEmailChangedEvent(customer *Customer, oldEmail, newEmail Email)
 			eventData,
 		),
 		CustomerID: customer.GetID(),
+		Version:    customer.GetVersion(),
 		OldEmail:   oldEmail.Value,
 		NewEmail:   newEmail.Value,
 	}
@@ -107,6 +161,7 @@ func NewCustomerEmailChangedEvent(customer *Customer, oldEmail, newEmail Email)
 type CustomerStatusChangedEvent struct {
 	domain.BaseDomainEvent
 	CustomerID string `json:"customer_id"`
+	Version    int    `json:"version"`
 	OldStatus  string `json:"old_status"`
 	NewStatus  string `json:"new_status"`
 }
@@ -115,6 +170,7 @@ type CustomerStatusChangedEvent struct {
 func NewCustomerStatusChangedEvent(customer *Customer, oldStatus, newStatus CustomerStatus) CustomerStatusChangedEvent {
 	eventData := map[string]interface{}{
 		"customer_id": customer.GetID(),
+		"version":     customer.GetVersion(),
 		"old_status":  oldStatus,
 		"new_status":  newStatus,
 	}
@@ -127,15 +183,81 @@ func NewCustomerStatusChangedEvent(customer *Customer, oldStatus, newStatus Cust
 			eventData,
 		),
 		CustomerID: customer.GetID(),
+		Version:    customer.GetVersion(),
 		OldStatus:  string(oldStatus),
 		NewStatus:  string(newStatus),
 	}
 }
 
+// CustomerActivatedEvent represents the event when a customer becomes active
+type CustomerActivatedEvent struct {
+	domain.BaseDomainEvent
+	CustomerID string `json:"customer_id"`
+	FromStatus string `json:"from_status"`
+}
+
+// NewCustomerActivatedEvent creates a new customer activated event
+func NewCustomerActivatedEvent(customer *Customer, fromStatus CustomerStatus) CustomerActivatedEvent {
+	return CustomerActivatedEvent{
+		BaseDomainEvent: newTransitionEvent(customer, CustomerActivatedEventType, fromStatus),
+		CustomerID:      customer.GetID(),
+		FromStatus:      string(fromStatus),
+	}
+}
+
+// CustomerDeactivatedEvent represents the event when a customer becomes inactive
+type CustomerDeactivatedEvent struct {
+	domain.BaseDomainEvent
+	CustomerID string `json:"customer_id"`
+	FromStatus string `json:"from_status"`
+}
+
+// NewCustomerDeactivatedEvent creates a new customer deactivated event
+func NewCustomerDeactivatedEvent(customer *Customer, fromStatus CustomerStatus) CustomerDeactivatedEvent {
+	return CustomerDeactivatedEvent{
+		BaseDomainEvent: newTransitionEvent(customer, CustomerDeactivatedEventType, fromStatus),
+		CustomerID:      customer.GetID(),
+		FromStatus:      string(fromStatus),
+	}
+}
+
+// CustomerChurnedEvent represents the event when a customer churns
+type CustomerChurnedEvent struct {
+	domain.BaseDomainEvent
+	CustomerID string `json:"customer_id"`
+	FromStatus string `json:"from_status"`
+}
+
+// NewCustomerChurnedEvent creates a new customer churned event
+func NewCustomerChurnedEvent(customer *Customer, fromStatus CustomerStatus) CustomerChurnedEvent {
+	return CustomerChurnedEvent{
+		BaseDomainEvent: newTransitionEvent(customer, CustomerChurnedEventType, fromStatus),
+		CustomerID:      customer.GetID(),
+		FromStatus:      string(fromStatus),
+	}
+}
+
+// newTransitionEvent builds the base event shared by lifecycle transition events
+func newTransitionEvent(customer *Customer, eventType string, fromStatus CustomerStatus) domain.BaseDomainEvent {
+	eventData := map[string]interface{}{
+		"customer_id": customer.GetID(),
+		"from_status": fromStatus,
+		"to_status":   customer.Status,
+	}
+
+	return domain.NewBaseDomainEvent(
+		customer.GetID(),
+		"customer",
+		eventType,
+		eventData,
+	)
+}
+
 // CustomerDeletedEvent represents the event when customer is deleted
 type CustomerDeletedEvent struct {
 	domain.BaseDomainEvent
 	CustomerID string `json:"customer_id"`
+	Version    int    `json:"version"`
 	Name       string `json:"name"`
 	Email      string `json:"email"`
 }
@@ -144,7 +266,8 @@ type CustomerDeletedEvent struct {
 func NewCustomerDeletedEvent(customer *Customer) CustomerDeletedEvent {
 	eventData := map[string]interface{}{
 		"customer_id": customer.GetID(),
-		"name":        customer.Name,
+		"version":     customer.GetVersion(),
+		"name":        customer.Name.Full(),
 		"email":       customer.Email.Value,
 	}
 
@@ -156,7 +279,81 @@ func NewCustomerDeletedEvent(customer *Customer) CustomerDeletedEvent {
 			eventData,
 		),
 		CustomerID: customer.GetID(),
-		Name:       customer.Name,
+		Version:    customer.GetVersion(),
+		Name:       customer.Name.Full(),
 		Email:      customer.Email.Value,
 	}
 }
+
+// CustomerAttributesChangedEvent represents the event when customer's custom attributes change
+type CustomerAttributesChangedEvent struct {
+	domain.BaseDomainEvent
+	CustomerID string                 `json:"customer_id"`
+	Version    int                    `json:"version"`
+	Set        map[string]interface{} `json:"set,omitempty"`
+	Unset      []string               `json:"unset,omitempty"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// NewCustomerAttributesChangedEvent creates a new customer attributes changed event
+func NewCustomerAttributesChangedEvent(customer *Customer, set map[string]interface{}, unset []string) CustomerAttributesChangedEvent {
+	// Snapshot the full attribute set so consumers don't need to replay history
+	attributes := make(map[string]interface{}, len(customer.Attributes))
+	for key, value := range customer.Attributes {
+		attributes[key] = value
+	}
+
+	eventData := map[string]interface{}{
+		"customer_id": customer.GetID(),
+		"version":     customer.GetVersion(),
+		"set":         set,
+		"unset":       unset,
+		"attributes":  attributes,
+	}
+
+	return CustomerAttributesChangedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			customer.GetID(),
+			"customer",
+			CustomerAttributesChangedEventType,
+			eventData,
+		),
+		CustomerID: customer.GetID(),
+		Version:    customer.GetVersion(),
+		Set:        set,
+		Unset:      unset,
+		Attributes: attributes,
+	}
+}
+
+// CustomerPreferencesChangedEvent represents the event when customer's locale or timezone changes
+type CustomerPreferencesChangedEvent struct {
+	domain.BaseDomainEvent
+	CustomerID string `json:"customer_id"`
+	Version    int    `json:"version"`
+	Locale     string `json:"locale"`
+	Timezone   string `json:"timezone"`
+}
+
+// NewCustomerPreferencesChangedEvent creates a new customer preferences changed event
+func NewCustomerPreferencesChangedEvent(customer *Customer) CustomerPreferencesChangedEvent {
+	eventData := map[string]interface{}{
+		"customer_id": customer.GetID(),
+		"version":     customer.GetVersion(),
+		"locale":      customer.Locale,
+		"timezone":    customer.Timezone,
+	}
+
+	return CustomerPreferencesChangedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			customer.GetID(),
+			"customer",
+			CustomerPreferencesChangedEventType,
+			eventData,
+		),
+		CustomerID: customer.GetID(),
+		Version:    customer.GetVersion(),
+		Locale:     string(customer.Locale),
+		Timezone:   string(customer.Timezone),
+	}
+}
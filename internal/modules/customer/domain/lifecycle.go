@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"fmt"
+	"sync"
+)
+
+// CustomerLifecycle is the state machine governing customer status transitions
+type CustomerLifecycle struct {
+	// InitialStatus is the status of newly created customers
+	InitialStatus CustomerStatus
+	// Transitions lists the statuses reachable from each status
+	Transitions map[CustomerStatus][]CustomerStatus
+}
+
+// DefaultCustomerLifecycle returns the default lifecycle:
+// prospect → active ⇄ inactive, active/inactive → churned → active (win-back), any → deleted
+func DefaultCustomerLifecycle() CustomerLifecycle {
+	return CustomerLifecycle{
+		InitialStatus: CustomerStatusActive,
+		Transitions: map[CustomerStatus][]CustomerStatus{
+			CustomerStatusProspect: {CustomerStatusActive, CustomerStatusDeleted},
+			CustomerStatusActive:   {CustomerStatusInactive, CustomerStatusChurned, CustomerStatusDeleted},
+			CustomerStatusInactive: {CustomerStatusActive, CustomerStatusChurned, CustomerStatusDeleted},
+			CustomerStatusChurned:  {CustomerStatusActive, CustomerStatusDeleted},
+			CustomerStatusDeleted:  {},
+		},
+	}
+}
+
+// CanTransition reports whether the lifecycle allows moving from one status to another
+func (l CustomerLifecycle) CanTransition(from, to CustomerStatus) bool {
+	for _, allowed := range l.Transitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the lifecycle only references known statuses
+func (l CustomerLifecycle) Validate() error {
+	if !l.InitialStatus.IsValid() || l.InitialStatus == CustomerStatusDeleted {
+		return fmt.Errorf("invalid initial status %q", l.InitialStatus)
+	}
+
+	for from, targets := range l.Transitions {
+		if !from.IsValid() {
+			return fmt.Errorf("unknown status %q in transitions", from)
+		}
+		for _, to := range targets {
+			if !to.IsValid() {
+				return fmt.Errorf("unknown status %q in transitions of %q", to, from)
+			}
+		}
+	}
+
+	if len(l.Transitions[CustomerStatusDeleted]) > 0 {
+		return fmt.Errorf("deleted is a terminal status and cannot have transitions")
+	}
+
+	return nil
+}
+
+var (
+	lifecycleMu      sync.RWMutex
+	currentLifecycle = DefaultCustomerLifecycle()
+)
+
+// ConfigureLifecycle replaces the lifecycle used by all customer aggregates
+func ConfigureLifecycle(lifecycle CustomerLifecycle) error {
+	if err := lifecycle.Validate(); err != nil {
+		return err
+	}
+
+	lifecycleMu.Lock()
+	defer lifecycleMu.Unlock()
+	currentLifecycle = lifecycle
+
+	return nil
+}
+
+// Lifecycle returns the lifecycle used by customer aggregates
+func Lifecycle() CustomerLifecycle {
+	lifecycleMu.RLock()
+	defer lifecycleMu.RUnlock()
+	return currentLifecycle
+}
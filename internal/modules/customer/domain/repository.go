@@ -2,11 +2,15 @@ package domain
 
 import (
 	"context"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
 )
 
 // CustomerRepository defines the interface for customer persistence
 type CustomerRepository interface {
 	// Save saves a customer (create or update)
+	// Updating a customer changed by someone else since it was loaded fails with CONCURRENCY_CONFLICT
 	Save(ctx context.Context, customer *Customer) error
 
 	// GetByID retrieves a customer by ID
@@ -33,24 +37,41 @@ type CustomerQueryRepository interface {
 	// GetByEmail retrieves a customer view by email
 	GetByEmail(ctx context.Context, email string) (*CustomerView, error)
 
+	// GetByIDs retrieves the customer views of the given IDs, skipping unknown ones, in no particular order
+	GetByIDs(ctx context.Context, ids []string) ([]CustomerView, error)
+
 	// List retrieves customers with pagination and filtering
 	List(ctx context.Context, params ListCustomersParams) (*CustomerListResult, error)
 
 	// Search searches customers by various criteria
 	Search(ctx context.Context, params SearchCustomersParams) (*CustomerListResult, error)
 
+	// Export streams every customer matching the list filters to fn, ignoring pagination
+	// Errors returned by fn are returned unchanged
+	Export(ctx context.Context, params ListCustomersParams, fn func(CustomerView) error) error
+
 	// Count returns the total number of customers matching criteria
 	Count(ctx context.Context, params CountCustomersParams) (int64, error)
 }
 
 // CustomerView represents a read-model for customer queries
 type CustomerView struct {
-	ID        string         `json:"id"`
-	Email     string         `json:"email"`
-	Name      string         `json:"name"`
-	Status    CustomerStatus `json:"status"`
-	CreatedAt string         `json:"created_at"`
-	UpdatedAt string         `json:"updated_at"`
+	ID             string                 `json:"id"`
+	TenantID       string                 `json:"tenant_id,omitempty"`
+	Email          string                 `json:"email"`
+	Name           string                 `json:"name"`
+	FirstName      string                 `json:"first_name"`
+	LastName       string                 `json:"last_name"`
+	Status         CustomerStatus         `json:"status"`
+	Attributes     map[string]interface{} `json:"attributes"`
+	Locale         string                 `json:"locale,omitempty"`
+	Timezone       string                 `json:"timezone,omitempty"`
+	OrderCount     int                    `json:"order_count"`
+	LifetimeValue  []domain.Money         `json:"lifetime_value"` // one entry per currency, cancelled orders excluded
+	Version        int                    `json:"version"`        // the version writes expect in If-Match
+	LastActivityAt *time.Time             `json:"last_activity_at,omitempty"`
+	CreatedAt      time.Time              `json:"created_at"`
+	UpdatedAt      time.Time              `json:"updated_at"`
 }
 
 // ListCustomersParams represents parameters for listing customers
@@ -64,14 +85,17 @@ type ListCustomersParams struct {
 	SortOrder string `json:"sort_order"` // asc, desc
 
 	// Filtering
-	Status         *CustomerStatus `json:"status,omitempty"`
-	IncludeDeleted bool            `json:"include_deleted"`
+	Statuses       []CustomerStatus `json:"statuses,omitempty"` // any of, e.g. ?status=prospect,active
+	IncludeDeleted bool             `json:"include_deleted"`
 
-	// Date filtering
-	CreatedAfter  *string `json:"created_after,omitempty"`
-	CreatedBefore *string `json:"created_before,omitempty"`
-	UpdatedAfter  *string `json:"updated_after,omitempty"`
-	UpdatedBefore *string `json:"updated_before,omitempty"`
+	// Attribute filtering (exact match on each key, e.g. ?attr.plan=pro)
+	Attributes map[string]string `json:"attributes,omitempty"`
+
+	// Date filtering (inclusive bounds)
+	CreatedAfter  *time.Time `json:"created_after,omitempty"`
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+	UpdatedAfter  *time.Time `json:"updated_after,omitempty"`
+	UpdatedBefore *time.Time `json:"updated_before,omitempty"`
 }
 
 // SearchCustomersParams represents parameters for searching customers
@@ -81,16 +105,16 @@ type SearchCustomersParams struct {
 	// Search criteria
 	Query     string `json:"query"`      // Search in name, email
 	Email     string `json:"email"`      // Exact email match
-	FirstName string `json:"first_name"` // Partial first name match (for compatibility)
-	LastName  string `json:"last_name"`  // Partial last name match (for compatibility)
+	FirstName string `json:"first_name"` // Partial first name match
+	LastName  string `json:"last_name"`  // Partial last name match
 }
 
 // CountCustomersParams represents parameters for counting customers
 type CountCustomersParams struct {
 	Status         *CustomerStatus `json:"status,omitempty"`
 	IncludeDeleted bool            `json:"include_deleted"`
-	CreatedAfter   *string         `json:"created_after,omitempty"`
-	CreatedBefore  *string         `json:"created_before,omitempty"`
+	CreatedAfter   *time.Time      `json:"created_after,omitempty"`
+	CreatedBefore  *time.Time      `json:"created_before,omitempty"`
 }
 
 // CustomerListResult represents the result of a customer list query
@@ -146,6 +170,8 @@ func (p *ListCustomersParams) Validate() error {
 		"id":         true,
 		"email":      true,
 		"name":       true,
+		"first_name": true,
+		"last_name":  true,
 		"created_at": true,
 		"updated_at": true,
 	}
@@ -162,7 +188,14 @@ func (p *ListCustomersParams) Validate() error {
 		p.SortOrder = "desc"
 	}
 
-	return nil
+	if err := validateRange("created", p.CreatedAfter, p.CreatedBefore); err != nil {
+		return err
+	}
+	if err := validateRange("updated", p.UpdatedAfter, p.UpdatedBefore); err != nil {
+		return err
+	}
+
+	return validateStatuses(p.Statuses)
 }
 
 // Validate validates the search parameters
@@ -170,6 +203,29 @@ func (p *SearchCustomersParams) Validate() error {
 	return p.ListCustomersParams.Validate()
 }
 
+// validateRange rejects a date range whose lower bound is after its upper bound
+func validateRange(name string, after, before *time.Time) error {
+	if after != nil && before != nil && after.After(*before) {
+		return domain.NewValidationError(name+"_after", name+"_after must not be later than "+name+"_before")
+	}
+	return nil
+}
+
+// validateStatuses rejects unknown status filters
+func validateStatuses(statuses []CustomerStatus) error {
+	var validationErrors domain.ValidationErrors
+	for _, status := range statuses {
+		if !status.IsValid() {
+			validationErrors.AddWithValue("status", "unknown customer status", string(status))
+		}
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+	return nil
+}
+
 // GetOffset calculates the offset for pagination
 func (p *ListCustomersParams) GetOffset() int {
 	return (p.Page - 1) * p.Limit
@@ -1,16 +1,23 @@
 package handlers
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	commandhandlers "golang_modular_monolith/internal/modules/customer/application/command_handlers"
 	"golang_modular_monolith/internal/modules/customer/application/commands"
 	"golang_modular_monolith/internal/modules/customer/application/queries"
 	queryhandlers "golang_modular_monolith/internal/modules/customer/application/query_handlers"
 	"golang_modular_monolith/internal/modules/customer/domain"
+	"golang_modular_monolith/internal/shared/application"
 	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/conditional"
+	"golang_modular_monolith/internal/shared/infrastructure/importexport"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
 
 	"github.com/gin-gonic/gin"
 )
@@ -18,55 +25,329 @@ import (
 // CustomerHandler handles HTTP requests for customer operations
 type CustomerHandler struct {
 	createCustomerHandler  *commandhandlers.CreateCustomerHandler
+	updateCustomerHandler  *commandhandlers.UpdateCustomerHandler
+	patchCustomerHandler   *commandhandlers.PatchCustomerHandler
+	setAttributesHandler   *commandhandlers.SetCustomerAttributesHandler
+	unsetAttributesHandler *commandhandlers.UnsetCustomerAttributesHandler
+	changeStatusHandler    *commandhandlers.ChangeCustomerStatusHandler
+	deleteCustomerHandler  *commandhandlers.DeleteCustomerHandler
 	getCustomerHandler     *queryhandlers.GetCustomerHandler
 	listCustomersHandler   *queryhandlers.ListCustomersHandler
 	searchCustomersHandler *queryhandlers.SearchCustomersHandler
+	exportCustomersHandler *queryhandlers.ExportCustomersHandler
+	importer               *importexport.Importer[*commands.CreateCustomerCommand]
+	jobs                   *importexport.Jobs
+	commands               *application.MiddlewareCommandBus
 }
 
 // NewCustomerHandler creates a new customer handler
 func NewCustomerHandler(
 	createCustomerHandler *commandhandlers.CreateCustomerHandler,
+	updateCustomerHandler *commandhandlers.UpdateCustomerHandler,
+	patchCustomerHandler *commandhandlers.PatchCustomerHandler,
+	setAttributesHandler *commandhandlers.SetCustomerAttributesHandler,
+	unsetAttributesHandler *commandhandlers.UnsetCustomerAttributesHandler,
+	changeStatusHandler *commandhandlers.ChangeCustomerStatusHandler,
+	deleteCustomerHandler *commandhandlers.DeleteCustomerHandler,
 	getCustomerHandler *queryhandlers.GetCustomerHandler,
 	listCustomersHandler *queryhandlers.ListCustomersHandler,
 	searchCustomersHandler *queryhandlers.SearchCustomersHandler,
+	exportCustomersHandler *queryhandlers.ExportCustomersHandler,
+	jobs *importexport.Jobs,
+	commands *application.MiddlewareCommandBus,
 ) *CustomerHandler {
 	return &CustomerHandler{
 		createCustomerHandler:  createCustomerHandler,
+		updateCustomerHandler:  updateCustomerHandler,
+		patchCustomerHandler:   patchCustomerHandler,
+		setAttributesHandler:   setAttributesHandler,
+		unsetAttributesHandler: unsetAttributesHandler,
+		changeStatusHandler:    changeStatusHandler,
+		deleteCustomerHandler:  deleteCustomerHandler,
 		getCustomerHandler:     getCustomerHandler,
 		listCustomersHandler:   listCustomersHandler,
 		searchCustomersHandler: searchCustomersHandler,
+		exportCustomersHandler: exportCustomersHandler,
+		importer:               newCustomerImporter(createCustomerHandler, commands),
+		jobs:                   jobs,
+		commands:               commands,
 	}
 }
 
 // CreateCustomerRequest represents the request body for creating a customer
+// Either name (free text) or first_name/last_name must be provided
 type CreateCustomerRequest struct {
-	Name  string `json:"name" binding:"required"`
-	Email string `json:"email" binding:"required,email"`
+	Name            string `json:"name"`
+	FirstName       string `json:"first_name"`
+	LastName        string `json:"last_name"`
+	Email           string `json:"email" binding:"required,email"`
+	Locale          string `json:"locale"`
+	Timezone        string `json:"timezone"`
+	AllowDuplicates bool   `json:"allow_duplicates"`
 }
 
 // CreateCustomer handles POST /customers
 func (h *CustomerHandler) CreateCustomer(c *gin.Context) {
 	var req CreateCustomerRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	cmd := &commands.CreateCustomerCommand{
+		Name:            req.Name,
+		FirstName:       req.FirstName,
+		LastName:        req.LastName,
+		Email:           req.Email,
+		Locale:          req.Locale,
+		Timezone:        req.Timezone,
+		AllowDuplicates: req.AllowDuplicates,
+	}
+	result, err := application.RunCommand(c.Request.Context(), h.commands, cmd, h.createCustomerHandler.Handle)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// UpdateCustomerRequest represents the request body for replacing a customer's details
+// Either name (free text) or first_name/last_name must be provided; omitted locale and timezone are cleared
+type UpdateCustomerRequest struct {
+	Name      string `json:"name"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email" binding:"required,email"`
+	Locale    string `json:"locale"`
+	Timezone  string `json:"timezone"`
+}
+
+// UpdateCustomer handles PUT /customers/:id
+func (h *CustomerHandler) UpdateCustomer(c *gin.Context) {
+	expectedVersion, err := h.getExpectedVersion(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	var req UpdateCustomerRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	cmd := &commands.UpdateCustomerCommand{
+		CustomerID:      c.Param("id"),
+		Name:            req.Name,
+		FirstName:       req.FirstName,
+		LastName:        req.LastName,
+		Email:           req.Email,
+		Locale:          req.Locale,
+		Timezone:        req.Timezone,
+		ExpectedVersion: expectedVersion,
+	}
+	result, err := application.RunCommand(c.Request.Context(), h.commands, cmd, h.updateCustomerHandler.Handle)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// PatchCustomerRequest represents the request body for partially updating a customer
+// Omitted fields are left untouched; update_mask restricts which provided fields are applied
+type PatchCustomerRequest struct {
+	Name       *string  `json:"name"`
+	FirstName  *string  `json:"first_name"`
+	LastName   *string  `json:"last_name"`
+	Email      *string  `json:"email"`
+	Locale     *string  `json:"locale"`
+	Timezone   *string  `json:"timezone"`
+	UpdateMask []string `json:"update_mask"`
+}
+
+// PatchCustomer handles PATCH /customers/:id
+func (h *CustomerHandler) PatchCustomer(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
 		h.handleError(c, shareddomain.NewDomainError(
 			shareddomain.ErrCodeInvalidInput,
-			"Invalid request body: "+err.Error(),
+			"Customer ID is required",
 		))
 		return
 	}
 
-	cmd := &commands.CreateCustomerCommand{
-		Name:  req.Name,
-		Email: req.Email,
+	expectedVersion, err := h.getExpectedVersion(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	var req PatchCustomerRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	// The mask may also be supplied as ?update_mask=name,email
+	fieldMask := req.UpdateMask
+	if mask := c.Query("update_mask"); mask != "" {
+		fieldMask = strings.Split(mask, ",")
+	}
+	for i, field := range fieldMask {
+		fieldMask[i] = strings.TrimSpace(field)
 	}
 
-	result, err := h.createCustomerHandler.Handle(c.Request.Context(), cmd)
+	cmd := &commands.PatchCustomerCommand{
+		CustomerID:      id,
+		Name:            req.Name,
+		FirstName:       req.FirstName,
+		LastName:        req.LastName,
+		Email:           req.Email,
+		Locale:          req.Locale,
+		Timezone:        req.Timezone,
+		FieldMask:       fieldMask,
+		ExpectedVersion: expectedVersion,
+	}
+	result, err := application.RunCommand(c.Request.Context(), h.commands, cmd, h.patchCustomerHandler.Handle)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, gin.H{
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// SetCustomerAttributesRequest represents the request body for setting custom attributes
+type SetCustomerAttributesRequest struct {
+	Attributes map[string]interface{} `json:"attributes" binding:"required"`
+}
+
+// SetCustomerAttributes handles PATCH /customers/:id/attributes
+func (h *CustomerHandler) SetCustomerAttributes(c *gin.Context) {
+	var req SetCustomerAttributesRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	cmd := &commands.SetCustomerAttributesCommand{
+		CustomerID: c.Param("id"),
+		Attributes: req.Attributes,
+	}
+
+	result, err := application.RunCommand(c.Request.Context(), h.commands, cmd, h.setAttributesHandler.Handle)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// UnsetCustomerAttribute handles DELETE /customers/:id/attributes/:key
+func (h *CustomerHandler) UnsetCustomerAttribute(c *gin.Context) {
+	cmd := &commands.UnsetCustomerAttributesCommand{
+		CustomerID: c.Param("id"),
+		Keys:       []string{c.Param("key")},
+	}
+
+	result, err := application.RunCommand(c.Request.Context(), h.commands, cmd, h.unsetAttributesHandler.Handle)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ChangeCustomerStatusRequest represents the request body for a lifecycle transition
+type ChangeCustomerStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+// ChangeCustomerStatus handles PUT /customers/:id/status
+func (h *CustomerHandler) ChangeCustomerStatus(c *gin.Context) {
+	var req ChangeCustomerStatusRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	h.changeStatus(c, req.Status)
+}
+
+// ActivateCustomer handles POST /customers/:id/activate
+func (h *CustomerHandler) ActivateCustomer(c *gin.Context) {
+	h.changeStatus(c, string(domain.CustomerStatusActive))
+}
+
+// DeactivateCustomer handles POST /customers/:id/deactivate
+func (h *CustomerHandler) DeactivateCustomer(c *gin.Context) {
+	h.changeStatus(c, string(domain.CustomerStatusInactive))
+}
+
+// changeStatus moves the customer of the request to a status
+func (h *CustomerHandler) changeStatus(c *gin.Context, status string) {
+	expectedVersion, err := h.getExpectedVersion(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	cmd := &commands.ChangeCustomerStatusCommand{
+		CustomerID:      c.Param("id"),
+		Status:          status,
+		ExpectedVersion: expectedVersion,
+	}
+
+	result, err := application.RunCommand(c.Request.Context(), h.commands, cmd, h.changeStatusHandler.Handle)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// DeleteCustomer handles DELETE /customers/:id
+func (h *CustomerHandler) DeleteCustomer(c *gin.Context) {
+	expectedVersion, err := h.getExpectedVersion(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewDeleteCustomerCommand(c.Param("id"))
+	cmd.ExpectedVersion = expectedVersion
+
+	result, err := application.RunCommand(c.Request.Context(), h.commands, &cmd, h.deleteCustomerHandler.Handle)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
 		"success": true,
 		"data":    result,
 	})
@@ -92,6 +373,9 @@ func (h *CustomerHandler) GetCustomer(c *gin.Context) {
 		h.handleError(c, err)
 		return
 	}
+	if representation, err := json.Marshal(result.Customer); err == nil {
+		c.Header(conditional.HeaderETag, conditional.VersionETag(result.Customer.Version, representation))
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -108,26 +392,29 @@ func (h *CustomerHandler) ListCustomers(c *gin.Context) {
 		SortBy:         h.getStringParam(c, "sort_by", "created_at"),
 		SortOrder:      h.getStringParam(c, "sort_order", "desc"),
 		IncludeDeleted: h.getBoolParam(c, "include_deleted", false),
+		Attributes:     h.getAttributeFilters(c),
 	}
 
 	// Parse status filter
-	if statusStr := c.Query("status"); statusStr != "" {
-		status := domain.CustomerStatus(statusStr)
-		query.Status = &status
-	}
+	query.Statuses = h.getStatusFilters(c)
 
 	// Parse date filters
-	if createdAfter := c.Query("created_after"); createdAfter != "" {
-		query.CreatedAfter = &createdAfter
+	var err error
+	if query.CreatedAfter, err = h.getTimeParam(c, "created_after", false); err != nil {
+		h.handleError(c, err)
+		return
 	}
-	if createdBefore := c.Query("created_before"); createdBefore != "" {
-		query.CreatedBefore = &createdBefore
+	if query.CreatedBefore, err = h.getTimeParam(c, "created_before", true); err != nil {
+		h.handleError(c, err)
+		return
 	}
-	if updatedAfter := c.Query("updated_after"); updatedAfter != "" {
-		query.UpdatedAfter = &updatedAfter
+	if query.UpdatedAfter, err = h.getTimeParam(c, "updated_after", false); err != nil {
+		h.handleError(c, err)
+		return
 	}
-	if updatedBefore := c.Query("updated_before"); updatedBefore != "" {
-		query.UpdatedBefore = &updatedBefore
+	if query.UpdatedBefore, err = h.getTimeParam(c, "updated_before", true); err != nil {
+		h.handleError(c, err)
+		return
 	}
 
 	result, err := h.listCustomersHandler.Handle(c.Request.Context(), query)
@@ -146,21 +433,19 @@ func (h *CustomerHandler) ListCustomers(c *gin.Context) {
 // SearchCustomers handles GET /customers/search
 func (h *CustomerHandler) SearchCustomers(c *gin.Context) {
 	query := &queries.SearchCustomersQuery{
-		Query:     c.Query("q"),
-		Email:     c.Query("email"),
-		FirstName: c.Query("first_name"),
-		LastName:  c.Query("last_name"),
-		Page:      h.getIntParam(c, "page", 1),
-		Limit:     h.getIntParam(c, "limit", 20),
-		SortBy:    h.getStringParam(c, "sort_by", "created_at"),
-		SortOrder: h.getStringParam(c, "sort_order", "desc"),
+		Query:      c.Query("q"),
+		Email:      c.Query("email"),
+		FirstName:  c.Query("first_name"),
+		LastName:   c.Query("last_name"),
+		Page:       h.getIntParam(c, "page", 1),
+		Limit:      h.getIntParam(c, "limit", 20),
+		SortBy:     c.Query("sort_by"), // the best matches first when searching an index, else created_at
+		SortOrder:  h.getStringParam(c, "sort_order", "desc"),
+		Attributes: h.getAttributeFilters(c),
 	}
 
 	// Parse status filter
-	if statusStr := c.Query("status"); statusStr != "" {
-		status := domain.CustomerStatus(statusStr)
-		query.Status = &status
-	}
+	query.Statuses = h.getStatusFilters(c)
 
 	result, err := h.searchCustomersHandler.Handle(c.Request.Context(), query)
 	if err != nil {
@@ -187,6 +472,12 @@ func (h *CustomerHandler) getIntParam(c *gin.Context, key string, defaultValue i
 	return defaultValue
 }
 
+// getExpectedVersion returns the version the If-Match header of optimistic updates expects, nil when
+// absent
+func (h *CustomerHandler) getExpectedVersion(c *gin.Context) (*int, error) {
+	return conditional.IfMatchVersion(c)
+}
+
 // getStringParam gets a string parameter with default value
 func (h *CustomerHandler) getStringParam(c *gin.Context, key string, defaultValue string) string {
 	if val := c.Query(key); val != "" {
@@ -205,9 +496,121 @@ func (h *CustomerHandler) getBoolParam(c *gin.Context, key string, defaultValue
 	return defaultValue
 }
 
+// getTimeParam parses a timestamp filter. RFC 3339 values carry their own offset;
+// values without an offset ("2006-01-02" or "2006-01-02T15:04:05") are interpreted
+// in the ?tz= time zone (UTC by default). A date-only upper bound covers the whole day.
+func (h *CustomerHandler) getTimeParam(c *gin.Context, key string, upperBound bool) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	location := time.UTC
+	if tz := c.Query("tz"); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, shareddomain.NewValidationErrorWithValue("tz", "tz must be a valid IANA time zone", tz)
+		}
+		location = loc
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return &t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02T15:04:05", value, location); err == nil {
+		return &t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, location); err == nil {
+		if upperBound {
+			t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		return &t, nil
+	}
+
+	return nil, shareddomain.NewValidationErrorWithValue(key, key+" must be an RFC 3339 timestamp or a YYYY-MM-DD date", value)
+}
+
+// getStatusFilters parses a comma-separated status filter (e.g. ?status=prospect,active)
+func (h *CustomerHandler) getStatusFilters(c *gin.Context) []domain.CustomerStatus {
+	var statuses []domain.CustomerStatus
+	for _, value := range strings.Split(c.Query("status"), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			statuses = append(statuses, domain.CustomerStatus(value))
+		}
+	}
+	return statuses
+}
+
+// getAttributeFilters collects attribute filters from attr.<key>=<value> query parameters
+func (h *CustomerHandler) getAttributeFilters(c *gin.Context) map[string]string {
+	filters := make(map[string]string)
+	for key, values := range c.Request.URL.Query() {
+		if name, ok := strings.CutPrefix(key, "attr."); ok && name != "" && len(values) > 0 {
+			filters[name] = values[0]
+		}
+	}
+
+	if len(filters) == 0 {
+		return nil
+	}
+	return filters
+}
+
 // handleError handles errors and returns appropriate HTTP responses
 func (h *CustomerHandler) handleError(c *gin.Context, err error) {
-	var domainErr *shareddomain.DomainError
+	var duplicateErr domain.DuplicateCustomerError
+	if errors.As(err, &duplicateErr) {
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":       domain.ErrCodeDuplicateCustomer,
+				"message":    duplicateErr.Error(),
+				"candidates": duplicateErr.Candidates,
+			},
+		})
+		return
+	}
+
+	var validationErrs shareddomain.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErrs.Error(),
+				"details": validationErrs,
+			},
+		})
+		return
+	}
+
+	var validationErr shareddomain.ValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErr.Message,
+				"field":   validationErr.Field,
+			},
+		})
+		return
+	}
+
+	var businessErr shareddomain.BusinessRuleError
+	if errors.As(err, &businessErr) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeBusinessRule,
+				"message": businessErr.Message,
+				"rule":    businessErr.Rule,
+			},
+		})
+		return
+	}
+
+	var domainErr shareddomain.DomainError
 	if errors.As(err, &domainErr) {
 		switch domainErr.Code {
 		case shareddomain.ErrCodeNotFound:
@@ -218,7 +621,7 @@ func (h *CustomerHandler) handleError(c *gin.Context, err error) {
 					"message": domainErr.Message,
 				},
 			})
-		case shareddomain.ErrCodeAlreadyExists:
+		case shareddomain.ErrCodeAlreadyExists, shareddomain.ErrCodeConcurrencyConflict:
 			c.JSON(http.StatusConflict, gin.H{
 				"success": false,
 				"error": gin.H{
@@ -226,6 +629,14 @@ func (h *CustomerHandler) handleError(c *gin.Context, err error) {
 					"message": domainErr.Message,
 				},
 			})
+		case shareddomain.ErrCodePreconditionFailed:
+			c.JSON(http.StatusPreconditionFailed, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    domainErr.Code,
+					"message": domainErr.Message,
+				},
+			})
 		case shareddomain.ErrCodeInvalidInput, shareddomain.ErrCodeValidationFailed:
 			c.JSON(http.StatusBadRequest, gin.H{
 				"success": false,
@@ -235,6 +646,22 @@ func (h *CustomerHandler) handleError(c *gin.Context, err error) {
 					"field":   domainErr.Field,
 				},
 			})
+		case shareddomain.ErrCodeRequestTooLarge:
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    domainErr.Code,
+					"message": domainErr.Message,
+				},
+			})
+		case shareddomain.ErrCodeRequestTimeout:
+			c.JSON(http.StatusRequestTimeout, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    domainErr.Code,
+					"message": domainErr.Message,
+				},
+			})
 		case shareddomain.ErrCodeUnauthorized:
 			c.JSON(http.StatusUnauthorized, gin.H{
 				"success": false,
@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	commandhandlers "golang_modular_monolith/internal/modules/customer/application/command_handlers"
+	"golang_modular_monolith/internal/modules/customer/application/commands"
+	"golang_modular_monolith/internal/modules/customer/application/queries"
+	"golang_modular_monolith/internal/modules/customer/domain"
+	"golang_modular_monolith/internal/shared/application"
+	"golang_modular_monolith/internal/shared/infrastructure/importexport"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CustomerImportKind names the customer import jobs
+const CustomerImportKind = "customers"
+
+// customerExporter writes customers to export files
+// Lifetime values are listed per currency in the currency's minor unit, e.g. "1250 EUR;300 USD"
+var customerExporter = &importexport.Exporter[domain.CustomerView]{
+	Name: "customers",
+	Columns: []importexport.Column[domain.CustomerView]{
+		{Name: "id", Value: func(c domain.CustomerView) string { return c.ID }},
+		{Name: "email", Value: func(c domain.CustomerView) string { return c.Email }, Untrusted: true},
+		{Name: "name", Value: func(c domain.CustomerView) string { return c.Name }, Untrusted: true},
+		{Name: "first_name", Value: func(c domain.CustomerView) string { return c.FirstName }, Untrusted: true},
+		{Name: "last_name", Value: func(c domain.CustomerView) string { return c.LastName }, Untrusted: true},
+		{Name: "status", Value: func(c domain.CustomerView) string { return string(c.Status) }},
+		{Name: "locale", Value: func(c domain.CustomerView) string { return c.Locale }},
+		{Name: "timezone", Value: func(c domain.CustomerView) string { return c.Timezone }},
+		{Name: "order_count", Value: func(c domain.CustomerView) string { return strconv.Itoa(c.OrderCount) }, Numeric: true},
+		{Name: "lifetime_value", Value: lifetimeValue},
+		{Name: "attributes", Value: attributesJSON, Untrusted: true},
+		{Name: "last_activity_at", Value: func(c domain.CustomerView) string { return formatTime(c.LastActivityAt) }},
+		{Name: "created_at", Value: func(c domain.CustomerView) string { return formatTime(&c.CreatedAt) }},
+		{Name: "updated_at", Value: func(c domain.CustomerView) string { return formatTime(&c.UpdatedAt) }},
+	},
+	Document: func(c domain.CustomerView) any { return c },
+}
+
+// newCustomerImporter creates the importer creating a customer per row, as POST /customers would
+// Rows repeating the email of an earlier row are rejected, since the first one creates the customer
+func newCustomerImporter(createCustomerHandler *commandhandlers.CreateCustomerHandler, commandBus *application.MiddlewareCommandBus) *importexport.Importer[*commands.CreateCustomerCommand] {
+	return &importexport.Importer[*commands.CreateCustomerCommand]{
+		Kind: CustomerImportKind,
+		Fields: []importexport.Field{
+			{Name: "email", Required: true},
+			{Name: "name"},
+			{Name: "first_name"},
+			{Name: "last_name"},
+			{Name: "locale"},
+			{Name: "timezone"},
+		},
+		Parse: func(row importexport.Row) (*commands.CreateCustomerCommand, error) {
+			cmd := commands.NewCreateCustomerCommand(row.Get("name"), row.Get("email"))
+			cmd.FirstName = row.Get("first_name")
+			cmd.LastName = row.Get("last_name")
+			cmd.Locale = row.Get("locale")
+			cmd.Timezone = row.Get("timezone")
+			return &cmd, nil
+		},
+		Key: func(cmd *commands.CreateCustomerCommand) string {
+			return domain.NormalizeEmail(cmd.Email)
+		},
+		KeyField: "email",
+		Validate: []importexport.Validator[*commands.CreateCustomerCommand]{
+			func(_ context.Context, cmd *commands.CreateCustomerCommand) error { return validation.Command(cmd) },
+			createCustomerHandler.Validate,
+		},
+		Apply: func(ctx context.Context, cmd *commands.CreateCustomerCommand) error {
+			_, err := application.RunCommand(ctx, commandBus, cmd, createCustomerHandler.Handle)
+			return err
+		},
+	}
+}
+
+// ImportCustomers handles POST /customers/import
+// The file is a multipart "file" part or the request body, in CSV, NDJSON or XLSX; it is imported
+// by a background job, answered with 202 Accepted. ?dry_run=true validates the rows without
+// creating customers
+func (h *CustomerHandler) ImportCustomers(c *gin.Context) {
+	file, err := importexport.Upload(c, importexport.DefaultMaxUpload)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	job, err := h.importer.Start(c.Request.Context(), h.jobs, file, h.getBoolParam(c, "dry_run", false))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Header("Location", c.Request.URL.Path+"/"+job.ID)
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    job,
+	})
+}
+
+// GetCustomerImport handles GET /customers/import/:id
+func (h *CustomerHandler) GetCustomerImport(c *gin.Context) {
+	job, err := h.jobs.Get(CustomerImportKind, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    job,
+	})
+}
+
+// GetCustomerImportErrors handles GET /customers/import/:id/errors
+// It downloads the rows that failed, with their errors, as CSV (default), NDJSON or XLSX
+func (h *CustomerHandler) GetCustomerImportErrors(c *gin.Context) {
+	format, err := importexport.ParseFormat(c.Query("format"), importexport.FormatCSV)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	if err := h.jobs.WriteErrors(c, CustomerImportKind, c.Param("id"), format); err != nil {
+		h.handleError(c, err)
+	}
+}
+
+// ExportCustomers handles GET /customers/export
+// It accepts the same filters and sorting as GET /customers and streams every match as CSV
+// (default), NDJSON or XLSX
+func (h *CustomerHandler) ExportCustomers(c *gin.Context) {
+	format, err := importexport.ParseFormat(c.Query("format"), importexport.FormatCSV)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	query := &queries.ExportCustomersQuery{ListCustomersQuery: queries.ListCustomersQuery{
+		SortBy:         h.getStringParam(c, "sort_by", "created_at"),
+		SortOrder:      h.getStringParam(c, "sort_order", "desc"),
+		IncludeDeleted: h.getBoolParam(c, "include_deleted", false),
+		Statuses:       h.getStatusFilters(c),
+		Attributes:     h.getAttributeFilters(c),
+	}}
+	if query.CreatedAfter, err = h.getTimeParam(c, "created_after", false); err != nil {
+		h.handleError(c, err)
+		return
+	}
+	if query.CreatedBefore, err = h.getTimeParam(c, "created_before", true); err != nil {
+		h.handleError(c, err)
+		return
+	}
+	if query.UpdatedAfter, err = h.getTimeParam(c, "updated_after", false); err != nil {
+		h.handleError(c, err)
+		return
+	}
+	if query.UpdatedBefore, err = h.getTimeParam(c, "updated_before", true); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	err = customerExporter.Stream(c, format, func(fn func(domain.CustomerView) error) error {
+		return h.exportCustomersHandler.Handle(c.Request.Context(), query, fn)
+	})
+	if err != nil {
+		h.handleError(c, err)
+	}
+}
+
+// lifetimeValue formats the lifetime value of a customer, one amount per currency
+func lifetimeValue(c domain.CustomerView) string {
+	amounts := make([]string, len(c.LifetimeValue))
+	for i, value := range c.LifetimeValue {
+		amounts[i] = strconv.FormatInt(value.Amount, 10) + " " + value.Currency
+	}
+	return strings.Join(amounts, ";")
+}
+
+// attributesJSON returns the custom attributes of a customer as a JSON object, empty without any
+func attributesJSON(c domain.CustomerView) string {
+	if len(c.Attributes) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(c.Attributes)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// formatTime formats a timestamp of an export in UTC, empty when it is nil
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
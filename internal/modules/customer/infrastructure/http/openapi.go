@@ -0,0 +1,102 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/customer/application/commands"
+	"golang_modular_monolith/internal/modules/customer/domain"
+	"golang_modular_monolith/internal/modules/customer/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/importexport"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+)
+
+// APIOperations documents the routes registered by RegisterCustomerRoutes
+func APIOperations() []*openapi.Operation {
+	return []*openapi.Operation{
+		openapi.Post("/customers", "Create a customer").
+			Describe("Fails with 409 and the matching customers when a likely duplicate exists, unless allow_duplicates is set").
+			Body(handlers.CreateCustomerRequest{}).
+			Created(commands.CreateCustomerResult{}),
+		listCustomers(openapi.Get("/customers", "List customers")).Cached().
+			Query("include_deleted", "boolean", "Include deleted customers").
+			Query("created_after", "string", "RFC 3339 timestamp or date").
+			Query("created_before", "string", "RFC 3339 timestamp or date").
+			Query("updated_after", "string", "RFC 3339 timestamp or date").
+			Query("updated_before", "string", "RFC 3339 timestamp or date"),
+		listCustomers(openapi.Get("/customers/search", "Search customers")).Cached().
+			Describe("Served by the search index when one is configured, with the best matches first unless sort_by is set").
+			Query("q", "string", "Free-text search over name and email").
+			Query("email", "string", "Email address").
+			Query("first_name", "string", "First name").
+			Query("last_name", "string", "Last name"),
+		openapi.Get("/customers/export", "Export customers").
+			Describe("Streams every customer matching the filters of GET /customers; custom attributes are filtered with attr.<key>=<value> query parameters").
+			Query("format", "string", "csv (default), ndjson or xlsx").
+			Query("sort_by", "string", "Field to sort by").
+			Query("sort_order", "string", "asc or desc").
+			Query("status", "string", "Comma-separated statuses").
+			Query("include_deleted", "boolean", "Include deleted customers").
+			Query("created_after", "string", "RFC 3339 timestamp or date").
+			Query("created_before", "string", "RFC 3339 timestamp or date").
+			Query("updated_after", "string", "RFC 3339 timestamp or date").
+			Query("updated_before", "string", "RFC 3339 timestamp or date").
+			Produces("text/csv"),
+		openapi.Post("/customers/import", "Import customers").
+			Describe("Creates a customer per row of a CSV, NDJSON or XLSX file, sent as the multipart file part or the request body, in a background job; rows failing validation are skipped and reported").
+			Requires(domain.PermissionImportCustomers).
+			Query("format", "string", "csv, ndjson or xlsx; defaults to the extension or content type of the file").
+			Query("dry_run", "boolean", "Validate the rows without creating customers").
+			Accepted(importexport.Job{}),
+		openapi.Get("/customers/import/:id", "Get a customer import").
+			Requires(domain.PermissionImportCustomers).
+			Returns(importexport.Job{}),
+		openapi.Get("/customers/import/:id/errors", "Download the rows a customer import rejected").
+			Describe("Lists the values of each rejected row with its row number and errors").
+			Requires(domain.PermissionImportCustomers).
+			Query("format", "string", "csv (default), ndjson or xlsx").
+			Produces("text/csv"),
+		openapi.Get("/customers/:id", "Get a customer").
+			Cached().
+			Returns(domain.CustomerView{}),
+		openapi.Put("/customers/:id", "Replace a customer's details").Versioned().
+			Describe("Every field is applied: omitted locale and timezone are cleared").
+			Body(handlers.UpdateCustomerRequest{}).
+			Requires(domain.PermissionWriteCustomers).
+			Returns(commands.UpdateCustomerResult{}),
+		openapi.Patch("/customers/:id", "Update a customer").Versioned().
+			Describe("Omitted fields are left untouched; update_mask restricts which provided fields are applied").
+			Body(handlers.PatchCustomerRequest{}).
+			Requires(domain.PermissionWriteCustomers).
+			Returns(commands.PatchCustomerResult{}),
+		openapi.Delete("/customers/:id", "Delete a customer").Versioned().
+			Requires(domain.PermissionDeleteCustomers).
+			Returns(commands.ChangeCustomerStatusResult{}),
+		openapi.Put("/customers/:id/status", "Change the lifecycle status of a customer").Versioned().
+			Body(handlers.ChangeCustomerStatusRequest{}).
+			Requires(domain.PermissionWriteCustomers).
+			Returns(commands.ChangeCustomerStatusResult{}),
+		openapi.Post("/customers/:id/activate", "Activate a customer").Versioned().
+			Requires(domain.PermissionWriteCustomers).
+			Returns(commands.ChangeCustomerStatusResult{}),
+		openapi.Post("/customers/:id/deactivate", "Deactivate a customer").Versioned().
+			Requires(domain.PermissionWriteCustomers).
+			Returns(commands.ChangeCustomerStatusResult{}),
+		openapi.Patch("/customers/:id/attributes", "Set custom attributes").
+			Body(handlers.SetCustomerAttributesRequest{}).
+			Requires(domain.PermissionWriteCustomers).
+			Returns(commands.CustomerAttributesResult{}),
+		openapi.Delete("/customers/:id/attributes/:key", "Remove a custom attribute").
+			Requires(domain.PermissionWriteCustomers).
+			Returns(commands.CustomerAttributesResult{}),
+	}
+}
+
+// listCustomers documents the pagination, sorting and filters shared by the customer list routes
+func listCustomers(operation *openapi.Operation) *openapi.Operation {
+	return operation.
+		Query("page", "integer", "Page number, from 1").
+		Query("limit", "integer", "Page size").
+		Query("sort_by", "string", "Field to sort by").
+		Query("sort_order", "string", "asc or desc").
+		Query("status", "string", "Comma-separated statuses").
+		Describe("Custom attributes are filtered with attr.<key>=<value> query parameters").
+		Paginated([]domain.CustomerView{}, domain.PaginationResult{})
+}
@@ -1,19 +1,58 @@
 package http
 
 import (
+	"golang_modular_monolith/internal/modules/customer/domain"
 	"golang_modular_monolith/internal/modules/customer/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/authz"
+	"golang_modular_monolith/internal/shared/infrastructure/conditional"
 
 	"github.com/gin-gonic/gin"
 )
 
 // RegisterCustomerRoutes registers customer routes
-func RegisterCustomerRoutes(router *gin.RouterGroup, customerHandler *handlers.CustomerHandler) {
+// Reads answer with an ETag and 304 Not Modified to a matching If-None-Match; a customer's ETag names
+// its version, and updates given it in If-Match fail with 412 when the customer is at another version
+// Changing a customer requires a bearer token with the customers:write permission, deleting one the
+// customers:delete permission and importing customers the customers:import permission
+func RegisterCustomerRoutes(
+	router *gin.RouterGroup,
+	customerHandler *handlers.CustomerHandler,
+	tokens *auth.TokenService,
+	authorizer authz.Authorizer,
+) {
+	etag := conditional.ETag()
+
 	// Customer routes
 	customers := router.Group("/customers")
 	{
 		customers.POST("", customerHandler.CreateCustomer)
-		customers.GET("", customerHandler.ListCustomers)
-		customers.GET("/search", customerHandler.SearchCustomers)
-		customers.GET("/:id", customerHandler.GetCustomer)
+		customers.GET("", etag, customerHandler.ListCustomers)
+		customers.GET("/search", etag, customerHandler.SearchCustomers)
+		customers.GET("/export", customerHandler.ExportCustomers)
+		imports := customers.Group("/import",
+			auth.Middleware(tokens),
+			authz.RequirePermission(authorizer, domain.PermissionImportCustomers),
+		)
+		imports.POST("", customerHandler.ImportCustomers)
+		imports.GET("/:id", customerHandler.GetCustomerImport)
+		imports.GET("/:id/errors", customerHandler.GetCustomerImportErrors)
+		customers.GET("/:id", etag, customerHandler.GetCustomer)
+		writes := customers.Group("",
+			auth.Middleware(tokens),
+			authz.RequirePermission(authorizer, domain.PermissionWriteCustomers),
+		)
+		writes.PUT("/:id", customerHandler.UpdateCustomer)
+		writes.PATCH("/:id", customerHandler.PatchCustomer)
+		customers.DELETE("/:id",
+			auth.Middleware(tokens),
+			authz.RequirePermission(authorizer, domain.PermissionDeleteCustomers),
+			customerHandler.DeleteCustomer,
+		)
+		writes.PUT("/:id/status", customerHandler.ChangeCustomerStatus)
+		writes.POST("/:id/activate", customerHandler.ActivateCustomer)
+		writes.POST("/:id/deactivate", customerHandler.DeactivateCustomer)
+		writes.PATCH("/:id/attributes", customerHandler.SetCustomerAttributes)
+		writes.DELETE("/:id/attributes/:key", customerHandler.UnsetCustomerAttribute)
 	}
 }
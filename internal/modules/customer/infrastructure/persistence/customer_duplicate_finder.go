@@ -0,0 +1,93 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/customer/domain"
+	customerdb "golang_modular_monolith/internal/modules/customer/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// duplicateCandidatesQuery ranks non-deleted customers of the tenant, or of every tenant when the
+// request serves none, by trigram similarity of name and normalized email
+const duplicateCandidatesQuery = `
+SELECT id, name, email, name_similarity, email_similarity
+FROM (
+    SELECT id, name, email,
+        similarity(name, @name) AS name_similarity,
+        similarity(normalized_email, @email) AS email_similarity
+    FROM customers
+    WHERE status <> 'deleted' AND (@tenant = '' OR tenant_id = @tenant)
+) AS scored
+WHERE name_similarity >= @name_threshold OR email_similarity >= @email_threshold
+ORDER BY GREATEST(name_similarity, email_similarity) DESC
+LIMIT @limit`
+
+// duplicateCandidateRow is the raw result row of duplicateCandidatesQuery
+type duplicateCandidateRow struct {
+	ID              string
+	Name            string
+	Email           string
+	NameSimilarity  float64
+	EmailSimilarity float64
+}
+
+// PostgreSQLCustomerDuplicateFinder implements CustomerDuplicateFinder using pg_trgm
+type PostgreSQLCustomerDuplicateFinder struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLCustomerDuplicateFinder creates a new PostgreSQL duplicate finder
+func NewPostgreSQLCustomerDuplicateFinder(db *gorm.DB) *PostgreSQLCustomerDuplicateFinder {
+	return &PostgreSQLCustomerDuplicateFinder{
+		db: db,
+	}
+}
+
+// NewPostgreSQLCustomerDuplicateFinderFromManager creates duplicate finder using database manager
+func NewPostgreSQLCustomerDuplicateFinderFromManager() (*PostgreSQLCustomerDuplicateFinder, error) {
+	db, err := customerdb.GetCustomerDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer database: %w", err)
+	}
+
+	return &PostgreSQLCustomerDuplicateFinder{
+		db: db,
+	}, nil
+}
+
+// FindDuplicateCandidates returns existing customers resembling the criteria, best match first
+func (f *PostgreSQLCustomerDuplicateFinder) FindDuplicateCandidates(ctx context.Context, criteria domain.DuplicateCriteria) ([]domain.DuplicateCandidate, error) {
+	// Raw queries are not scoped by the tenancy plugin
+	tenantID, _ := shareddomain.TenantIDFromContext(ctx)
+
+	var rows []duplicateCandidateRow
+	err := shareddb.Conn(ctx, f.db).Raw(duplicateCandidatesQuery, map[string]interface{}{
+		"tenant":          tenantID,
+		"name":            criteria.Name,
+		"email":           criteria.NormalizedEmail,
+		"name_threshold":  criteria.NameThreshold,
+		"email_threshold": criteria.EmailThreshold,
+		"limit":           criteria.Limit,
+	}).Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate candidates: %w", err)
+	}
+
+	candidates := make([]domain.DuplicateCandidate, len(rows))
+	for i, row := range rows {
+		candidates[i] = domain.DuplicateCandidate{
+			CustomerID:      row.ID,
+			Name:            row.Name,
+			Email:           row.Email,
+			NameSimilarity:  row.NameSimilarity,
+			EmailSimilarity: row.EmailSimilarity,
+		}
+	}
+
+	return candidates, nil
+}
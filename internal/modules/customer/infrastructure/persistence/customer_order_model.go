@@ -0,0 +1,20 @@
+package persistence
+
+import "time"
+
+// CustomerOrderModel represents an order placed by a customer as seen from order events
+// Rows are maintained by the customer order stats projection
+type CustomerOrderModel struct {
+	OrderID     string     `gorm:"primaryKey;type:varchar(36)"`
+	CustomerID  string     `gorm:"type:varchar(36);not null;index"`
+	Currency    string     `gorm:"type:char(3);not null"`
+	Total       int64      `gorm:"not null;default:0"`
+	Cancelled   bool       `gorm:"not null;default:false"`
+	PlacedAt    time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	CancelledAt *time.Time `gorm:"type:timestamp with time zone"`
+}
+
+// TableName returns the table name for GORM
+func (CustomerOrderModel) TableName() string {
+	return "customer_orders"
+}
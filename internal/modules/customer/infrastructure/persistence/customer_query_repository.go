@@ -2,17 +2,47 @@ package persistence
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"golang_modular_monolith/internal/modules/customer/domain"
 	customerdb "golang_modular_monolith/internal/modules/customer/infrastructure/database"
 	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
 
 	"gorm.io/gorm"
 )
 
+// CustomerViewModel represents the denormalized customer read model
+// Rows are maintained by the customer view projection, never by the write side
+type CustomerViewModel struct {
+	ID             string           `gorm:"primaryKey;type:varchar(36)"`
+	TenantID       string           `gorm:"type:varchar(64);not null;default:''"`
+	Name           string           `gorm:"type:varchar(255);not null"`
+	FirstName      string           `gorm:"type:varchar(255);not null;default:''"`
+	LastName       string           `gorm:"type:varchar(255);not null;default:''"`
+	Email          string           `gorm:"type:varchar(255);not null"`
+	Status         string           `gorm:"type:customer_status;not null;default:active"`
+	Attributes     shareddb.JSONMap `gorm:"type:jsonb;not null;default:'{}'"`
+	Locale         string           `gorm:"type:varchar(35);not null;default:''"`
+	Timezone       string           `gorm:"type:varchar(64);not null;default:''"`
+	OrderCount     int              `gorm:"not null;default:0"`
+	LifetimeValue  shareddb.JSONMap `gorm:"type:jsonb;not null;default:'{}'"`
+	Version        int              `gorm:"not null;default:0"`
+	LastActivityAt *time.Time       `gorm:"type:timestamp with time zone"`
+	CreatedAt      time.Time        `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time        `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (CustomerViewModel) TableName() string {
+	return "customer_views"
+}
+
 // PostgreSQLCustomerQueryRepository implements CustomerQueryRepository using PostgreSQL
 type PostgreSQLCustomerQueryRepository struct {
 	db *gorm.DB
@@ -37,22 +67,51 @@ func NewPostgreSQLCustomerQueryRepositoryFromManager() (*PostgreSQLCustomerQuery
 	}, nil
 }
 
-// toCustomerView converts CustomerModel to CustomerView
-func (r *PostgreSQLCustomerQueryRepository) toCustomerView(model *CustomerModel) *domain.CustomerView {
+// toCustomerView converts CustomerViewModel to CustomerView
+func (r *PostgreSQLCustomerQueryRepository) toCustomerView(model *CustomerViewModel) *domain.CustomerView {
 	return &domain.CustomerView{
-		ID:        model.ID,
-		Email:     model.Email,
-		Name:      model.Name,
-		Status:    domain.CustomerStatus(model.Status),
-		CreatedAt: model.CreatedAt,
-		UpdatedAt: model.UpdatedAt,
+		ID:             model.ID,
+		TenantID:       model.TenantID,
+		Email:          model.Email,
+		Name:           model.Name,
+		FirstName:      model.FirstName,
+		LastName:       model.LastName,
+		Status:         domain.CustomerStatus(model.Status),
+		Attributes:     map[string]interface{}(model.Attributes),
+		Locale:         model.Locale,
+		Timezone:       model.Timezone,
+		OrderCount:     model.OrderCount,
+		LifetimeValue:  toLifetimeValue(model.LifetimeValue),
+		Version:        model.Version,
+		LastActivityAt: model.LastActivityAt,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}
+}
+
+// toLifetimeValue converts the per-currency totals column to money amounts ordered by currency
+func toLifetimeValue(totals shareddb.JSONMap) []shareddomain.Money {
+	currencies := make([]string, 0, len(totals))
+	for currency := range totals {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	values := make([]shareddomain.Money, 0, len(currencies))
+	for _, currency := range currencies {
+		amount, ok := totals[currency].(float64)
+		if !ok {
+			continue
+		}
+		values = append(values, shareddomain.Money{Amount: int64(amount), Currency: currency})
 	}
+	return values
 }
 
 // GetByID retrieves a customer view by ID
 func (r *PostgreSQLCustomerQueryRepository) GetByID(ctx context.Context, id string) (*domain.CustomerView, error) {
-	var model CustomerModel
-	result := r.db.WithContext(ctx).Where("id = ?", id).First(&model)
+	var model CustomerViewModel
+	result := shareddb.Conn(ctx, r.db).Where("id = ?", id).First(&model)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -66,8 +125,8 @@ func (r *PostgreSQLCustomerQueryRepository) GetByID(ctx context.Context, id stri
 
 // GetByEmail retrieves a customer view by email
 func (r *PostgreSQLCustomerQueryRepository) GetByEmail(ctx context.Context, email string) (*domain.CustomerView, error) {
-	var model CustomerModel
-	result := r.db.WithContext(ctx).Where("email = ?", email).First(&model)
+	var model CustomerViewModel
+	result := shareddb.Conn(ctx, r.db).Where("email = ?", email).First(&model)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -79,6 +138,24 @@ func (r *PostgreSQLCustomerQueryRepository) GetByEmail(ctx context.Context, emai
 	return r.toCustomerView(&model), nil
 }
 
+// GetByIDs retrieves the customer views of the given IDs
+func (r *PostgreSQLCustomerQueryRepository) GetByIDs(ctx context.Context, ids []string) ([]domain.CustomerView, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var models []CustomerViewModel
+	if err := shareddb.Conn(ctx, r.db).Where("id IN ?", ids).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to get customers by ID: %w", err)
+	}
+
+	customers := make([]domain.CustomerView, len(models))
+	for i, model := range models {
+		customers[i] = *r.toCustomerView(&model)
+	}
+	return customers, nil
+}
+
 // List retrieves customers with pagination and filtering
 func (r *PostgreSQLCustomerQueryRepository) List(ctx context.Context, params domain.ListCustomersParams) (*domain.CustomerListResult, error) {
 	// Validate parameters
@@ -87,7 +164,7 @@ func (r *PostgreSQLCustomerQueryRepository) List(ctx context.Context, params dom
 	}
 
 	// Build query
-	query := r.db.WithContext(ctx).Model(&CustomerModel{})
+	query := shareddb.Conn(ctx, r.db).Model(&CustomerViewModel{})
 
 	// Apply filters
 	query = r.applyListFilters(query, params)
@@ -103,7 +180,7 @@ func (r *PostgreSQLCustomerQueryRepository) List(ctx context.Context, params dom
 	query = query.Order(fmt.Sprintf("%s %s", params.SortBy, params.SortOrder))
 
 	// Execute query
-	var models []CustomerModel
+	var models []CustomerViewModel
 	if err := query.Find(&models).Error; err != nil {
 		return nil, fmt.Errorf("failed to list customers: %w", err)
 	}
@@ -128,7 +205,7 @@ func (r *PostgreSQLCustomerQueryRepository) Search(ctx context.Context, params d
 	}
 
 	// Build query
-	query := r.db.WithContext(ctx).Model(&CustomerModel{})
+	query := shareddb.Conn(ctx, r.db).Model(&CustomerViewModel{})
 
 	// Apply filters
 	query = r.applyListFilters(query, params.ListCustomersParams)
@@ -147,7 +224,7 @@ func (r *PostgreSQLCustomerQueryRepository) Search(ctx context.Context, params d
 	query = query.Order(fmt.Sprintf("%s %s", params.SortBy, params.SortOrder))
 
 	// Execute query
-	var models []CustomerModel
+	var models []CustomerViewModel
 	if err := query.Find(&models).Error; err != nil {
 		return nil, fmt.Errorf("failed to search customers: %w", err)
 	}
@@ -164,9 +241,42 @@ func (r *PostgreSQLCustomerQueryRepository) Search(ctx context.Context, params d
 	}, nil
 }
 
+// Export streams every customer matching the list filters to fn, ignoring pagination
+// Rows are read one at a time; ties on the sort field are broken by ID so the order is stable
+func (r *PostgreSQLCustomerQueryRepository) Export(ctx context.Context, params domain.ListCustomersParams, fn func(domain.CustomerView) error) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	query := shareddb.Conn(ctx, r.db).Model(&CustomerViewModel{})
+	query = r.applyListFilters(query, params)
+	query = query.Order(fmt.Sprintf("%s %s, id %s", params.SortBy, params.SortOrder, params.SortOrder))
+
+	rows, err := query.Rows()
+	if err != nil {
+		return fmt.Errorf("failed to export customers: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var model CustomerViewModel
+		if err := r.db.ScanRows(rows, &model); err != nil {
+			return fmt.Errorf("failed to scan exported customer: %w", err)
+		}
+		if err := fn(*r.toCustomerView(&model)); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to export customers: %w", err)
+	}
+	return nil
+}
+
 // Count returns the total number of customers matching criteria
 func (r *PostgreSQLCustomerQueryRepository) Count(ctx context.Context, params domain.CountCustomersParams) (int64, error) {
-	query := r.db.WithContext(ctx).Model(&CustomerModel{})
+	query := shareddb.Conn(ctx, r.db).Model(&CustomerViewModel{})
 
 	// Apply filters
 	if params.Status != nil {
@@ -196,8 +306,8 @@ func (r *PostgreSQLCustomerQueryRepository) Count(ctx context.Context, params do
 // applyListFilters applies common list filters to the query
 func (r *PostgreSQLCustomerQueryRepository) applyListFilters(query *gorm.DB, params domain.ListCustomersParams) *gorm.DB {
 	// Status filter
-	if params.Status != nil {
-		query = query.Where("status = ?", *params.Status)
+	if len(params.Statuses) > 0 {
+		query = query.Where("status IN ?", params.Statuses)
 	}
 
 	// Include deleted filter
@@ -205,6 +315,13 @@ func (r *PostgreSQLCustomerQueryRepository) applyListFilters(query *gorm.DB, par
 		query = query.Where("status != ?", domain.CustomerStatusDeleted)
 	}
 
+	// Attribute filters use JSONB containment so the GIN index applies
+	if len(params.Attributes) > 0 {
+		if filter, err := json.Marshal(params.Attributes); err == nil {
+			query = query.Where("attributes @> ?::jsonb", string(filter))
+		}
+	}
+
 	// Date filters
 	if params.CreatedAfter != nil {
 		query = query.Where("created_at >= ?", *params.CreatedAfter)
@@ -239,11 +356,11 @@ func (r *PostgreSQLCustomerQueryRepository) applySearchFilters(query *gorm.DB, p
 	}
 
 	if params.FirstName != "" {
-		query = query.Where("LOWER(name) LIKE ?", "%"+strings.ToLower(params.FirstName)+"%")
+		query = query.Where("LOWER(first_name) LIKE ?", "%"+strings.ToLower(params.FirstName)+"%")
 	}
 
 	if params.LastName != "" {
-		query = query.Where("LOWER(name) LIKE ?", "%"+strings.ToLower(params.LastName)+"%")
+		query = query.Where("LOWER(last_name) LIKE ?", "%"+strings.ToLower(params.LastName)+"%")
 	}
 
 	return query
@@ -4,23 +4,32 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"golang_modular_monolith/internal/modules/customer/domain"
 	customerdb "golang_modular_monolith/internal/modules/customer/infrastructure/database"
 	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
 
 	"gorm.io/gorm"
 )
 
 // CustomerModel represents the customer database model
+// Customers belong to the tenant they were created in; emails are unique within a tenant
 type CustomerModel struct {
-	ID        string `gorm:"primaryKey;type:varchar(36)"`
-	Name      string `gorm:"type:varchar(255);not null"`
-	Email     string `gorm:"type:varchar(255);not null;unique"`
-	Status    string `gorm:"type:customer_status;not null;default:active"`
-	Version   int    `gorm:"not null;default:0"`
-	CreatedAt string `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
-	UpdatedAt string `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	ID         string           `gorm:"primaryKey;type:varchar(36)"`
+	TenantID   string           `gorm:"type:varchar(64);not null;default:'';uniqueIndex:idx_customers_tenant_email"`
+	Name       string           `gorm:"type:varchar(255);not null"`
+	FirstName  string           `gorm:"type:varchar(255);not null;default:''"`
+	LastName   string           `gorm:"type:varchar(255);not null;default:''"`
+	Email      string           `gorm:"type:varchar(255);not null;uniqueIndex:idx_customers_tenant_email"`
+	Status     string           `gorm:"type:customer_status;not null;default:active"`
+	Attributes shareddb.JSONMap `gorm:"type:jsonb;not null;default:'{}'"`
+	Locale     string           `gorm:"type:varchar(35);not null;default:''"`
+	Timezone   string           `gorm:"type:varchar(64);not null;default:''"`
+	Version    int              `gorm:"not null;default:0"`
+	CreatedAt  time.Time        `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt  time.Time        `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
 }
 
 // TableName returns the table name for GORM
@@ -35,15 +44,32 @@ func (m *CustomerModel) ToEntity() (*domain.Customer, error) {
 		return nil, fmt.Errorf("invalid email in database: %w", err)
 	}
 
+	// Rows written before the name split only carry the full name
+	name := domain.PersonName{First: m.FirstName, Last: m.LastName}
+	if name.First == "" {
+		if name, err = domain.ParsePersonName(m.Name); err != nil {
+			return nil, fmt.Errorf("invalid name in database: %w", err)
+		}
+	}
+
 	customer := &domain.Customer{
 		BaseAggregateRoot: shareddomain.NewBaseAggregateRootWithID(m.ID),
-		Name:              m.Name,
+		Name:              name,
 		Email:             email,
 		Status:            domain.CustomerStatus(m.Status),
+		Attributes:        map[string]interface{}(m.Attributes),
+		Locale:            domain.Locale(m.Locale),
+		Timezone:          domain.Timezone(m.Timezone),
+	}
+
+	if customer.Attributes == nil {
+		customer.Attributes = make(map[string]interface{})
 	}
 
-	// Set version from database
-	customer.Version = m.Version
+	// Set version and timestamps from database
+	customer.RestoreVersion(m.Version)
+	customer.CreatedAt = m.CreatedAt
+	customer.UpdatedAt = m.UpdatedAt
 
 	return customer, nil
 }
@@ -51,10 +77,17 @@ func (m *CustomerModel) ToEntity() (*domain.Customer, error) {
 // FromEntity converts domain entity to database model
 func (m *CustomerModel) FromEntity(customer *domain.Customer) {
 	m.ID = customer.GetID()
-	m.Name = customer.Name
+	m.Name = customer.Name.Full()
+	m.FirstName = customer.Name.First
+	m.LastName = customer.Name.Last
 	m.Email = customer.Email.Value
 	m.Status = string(customer.Status)
+	m.Attributes = shareddb.JSONMap(customer.Attributes)
+	m.Locale = string(customer.Locale)
+	m.Timezone = string(customer.Timezone)
 	m.Version = customer.GetVersion()
+	m.CreatedAt = customer.GetCreatedAt()
+	m.UpdatedAt = customer.GetUpdatedAt()
 }
 
 // PostgreSQLCustomerRepository implements CustomerRepository using PostgreSQL
@@ -82,34 +115,75 @@ func NewPostgreSQLCustomerRepositoryFromManager() (*PostgreSQLCustomerRepository
 }
 
 // Save saves a customer (create or update)
+// Updates are optimistic: they only apply to the version the customer was loaded at, and fail with
+// CONCURRENCY_CONFLICT when another save changed it in between
 func (r *PostgreSQLCustomerRepository) Save(ctx context.Context, customer *domain.Customer) error {
 	model := &CustomerModel{}
 	model.FromEntity(customer)
 
-	// Use optimistic locking with version
-	result := r.db.WithContext(ctx).Save(model)
-	if result.Error != nil {
+	var err error
+	if storedVersion, stored := customer.StoredVersion(); stored {
+		err = r.update(ctx, model, storedVersion)
+	} else {
+		err = shareddb.Conn(ctx, r.db).Create(model).Error
+	}
+	if err != nil {
 		// Check for unique constraint violation (email)
-		if isUniqueViolationError(result.Error) {
+		if isUniqueViolationError(err) {
 			return shareddomain.NewDomainErrorWithCause(
 				shareddomain.ErrCodeAlreadyExists,
 				"customer with this email already exists",
-				result.Error,
+				err,
 			)
 		}
-		return fmt.Errorf("failed to save customer: %w", result.Error)
+		var domainErr shareddomain.DomainError
+		if errors.As(err, &domainErr) {
+			return err
+		}
+		return fmt.Errorf("failed to save customer: %w", err)
 	}
 
 	// Clear uncommitted events after successful save
 	customer.ClearUncommittedEvents()
+	customer.MarkStored()
+
+	return nil
+}
 
+// update writes the customer over the row still at storedVersion, moving the row to the
+// customer's version
+func (r *PostgreSQLCustomerRepository) update(ctx context.Context, model *CustomerModel, storedVersion int) error {
+	// created_at is never rewritten; GORM refreshes updated_at
+	result := shareddb.Conn(ctx, r.db).Model(&CustomerModel{}).
+		Where("id = ? AND version = ?", model.ID, storedVersion).
+		Updates(map[string]interface{}{
+			"name":       model.Name,
+			"first_name": model.FirstName,
+			"last_name":  model.LastName,
+			"email":      model.Email,
+			"status":     model.Status,
+			"attributes": model.Attributes,
+			"locale":     model.Locale,
+			"timezone":   model.Timezone,
+			"version":    gorm.Expr("version + ?", model.Version-storedVersion),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return shareddomain.NewDomainErrorWithCause(
+			shareddomain.ErrCodeConcurrencyConflict,
+			fmt.Sprintf("customer %s was changed or removed since it was loaded at version %d; reload it and retry", model.ID, storedVersion),
+			shareddomain.ErrConcurrencyConflict,
+		)
+	}
 	return nil
 }
 
 // GetByID retrieves a customer by ID
 func (r *PostgreSQLCustomerRepository) GetByID(ctx context.Context, id string) (*domain.Customer, error) {
 	var model CustomerModel
-	result := r.db.WithContext(ctx).Where("id = ? AND status != ?", id, domain.CustomerStatusDeleted).First(&model)
+	result := shareddb.Conn(ctx, r.db).Where("id = ? AND status != ?", id, domain.CustomerStatusDeleted).First(&model)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -124,7 +198,7 @@ func (r *PostgreSQLCustomerRepository) GetByID(ctx context.Context, id string) (
 // GetByEmail retrieves a customer by email
 func (r *PostgreSQLCustomerRepository) GetByEmail(ctx context.Context, email string) (*domain.Customer, error) {
 	var model CustomerModel
-	result := r.db.WithContext(ctx).Where("email = ? AND status != ?", email, domain.CustomerStatusDeleted).First(&model)
+	result := shareddb.Conn(ctx, r.db).Where("email = ? AND status != ?", email, domain.CustomerStatusDeleted).First(&model)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -137,10 +211,14 @@ func (r *PostgreSQLCustomerRepository) GetByEmail(ctx context.Context, email str
 }
 
 // Delete soft deletes a customer
+// The version moves too, so that saving a copy loaded before the deletion fails
 func (r *PostgreSQLCustomerRepository) Delete(ctx context.Context, id string) error {
-	result := r.db.WithContext(ctx).Model(&CustomerModel{}).
+	result := shareddb.Conn(ctx, r.db).Model(&CustomerModel{}).
 		Where("id = ? AND status != ?", id, domain.CustomerStatusDeleted).
-		Update("status", domain.CustomerStatusDeleted)
+		Updates(map[string]interface{}{
+			"status":  domain.CustomerStatusDeleted,
+			"version": gorm.Expr("version + 1"),
+		})
 
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete customer: %w", result.Error)
@@ -156,7 +234,7 @@ func (r *PostgreSQLCustomerRepository) Delete(ctx context.Context, id string) er
 // Exists checks if a customer exists by ID
 func (r *PostgreSQLCustomerRepository) Exists(ctx context.Context, id string) (bool, error) {
 	var count int64
-	result := r.db.WithContext(ctx).Model(&CustomerModel{}).
+	result := shareddb.Conn(ctx, r.db).Model(&CustomerModel{}).
 		Where("id = ? AND status != ?", id, domain.CustomerStatusDeleted).
 		Count(&count)
 
@@ -170,7 +248,7 @@ func (r *PostgreSQLCustomerRepository) Exists(ctx context.Context, id string) (b
 // ExistsByEmail checks if a customer exists by email
 func (r *PostgreSQLCustomerRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	var count int64
-	result := r.db.WithContext(ctx).Model(&CustomerModel{}).
+	result := shareddb.Conn(ctx, r.db).Model(&CustomerModel{}).
 		Where("email = ? AND status != ?", email, domain.CustomerStatusDeleted).
 		Count(&count)
 
@@ -0,0 +1,104 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/modules/customer/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+	"golang_modular_monolith/internal/shared/infrastructure/retention"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DeletedCustomersPolicyName is the name of the retention policy of deleted customers
+const DeletedCustomersPolicyName = "deleted_customers"
+
+// DeletedCustomersPolicy purges the customers deleted longer ago than the retention period: deleting
+// a customer only marks it deleted, keeping its name, email and attributes, which the policy
+// removes from the write model, the read model and the order stats for good
+type DeletedCustomersPolicy struct {
+	db        *gorm.DB
+	retention time.Duration
+	// purged is called with the IDs of the purged customers once they are committed, e.g. to remove
+	// them from the search index
+	purged func(ctx context.Context, ids []string) error
+}
+
+// NewDeletedCustomersPolicy creates the retention policy of deleted customers; purged may be nil
+func NewDeletedCustomersPolicy(db *gorm.DB, retention time.Duration, purged func(ctx context.Context, ids []string) error) *DeletedCustomersPolicy {
+	return &DeletedCustomersPolicy{db: db, retention: retention, purged: purged}
+}
+
+// Name returns the policy name
+func (p *DeletedCustomersPolicy) Name() string {
+	return DeletedCustomersPolicyName
+}
+
+// AggregateType returns the type of the purged records
+func (p *DeletedCustomersPolicy) AggregateType() string {
+	return "Customer"
+}
+
+// Retention returns how long deleted customers are kept
+func (p *DeletedCustomersPolicy) Retention() time.Duration {
+	return p.retention
+}
+
+// Apply purges a batch of the customers deleted before the cutoff; a dry run counts them and lists
+// the first ones
+// Purged customers are not archived, since they are removed for their personal data
+func (p *DeletedCustomersPolicy) Apply(ctx context.Context, run retention.Run) (retention.Outcome, error) {
+	var outcome retention.Outcome
+	db := shareddb.Conn(ctx, p.db)
+
+	due := func(db *gorm.DB) *gorm.DB {
+		return db.Model(&CustomerModel{}).Where("status = ? AND updated_at < ?", domain.CustomerStatusDeleted, run.Cutoff)
+	}
+
+	if run.DryRun {
+		if err := due(db).Count(&outcome.Due).Error; err != nil {
+			return outcome, fmt.Errorf("failed to count deleted customers: %w", err)
+		}
+		if err := due(db).Order("updated_at, id").Limit(run.Limit).Pluck("id", &outcome.IDs).Error; err != nil {
+			return outcome, fmt.Errorf("failed to list deleted customers: %w", err)
+		}
+		return outcome, nil
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var ids []string
+		err := due(tx).Order("updated_at, id").Limit(run.Limit).Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).Pluck("id", &ids).Error
+		if err != nil {
+			return fmt.Errorf("failed to list deleted customers: %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := tx.Where("customer_id IN ?", ids).Delete(&CustomerOrderModel{}).Error; err != nil {
+			return fmt.Errorf("failed to purge customer orders: %w", err)
+		}
+		if err := tx.Where("id IN ?", ids).Delete(&CustomerViewModel{}).Error; err != nil {
+			return fmt.Errorf("failed to purge customer views: %w", err)
+		}
+		if err := tx.Where("id IN ?", ids).Delete(&CustomerModel{}).Error; err != nil {
+			return fmt.Errorf("failed to purge customers: %w", err)
+		}
+		outcome.IDs = ids
+		return nil
+	})
+	if err != nil || len(outcome.IDs) == 0 || p.purged == nil {
+		return outcome, err
+	}
+
+	// The customers are gone either way; a stale index entry no longer matches a customer
+	if err := p.purged(ctx, outcome.IDs); err != nil {
+		zap.L().Warn("failed to clean up purged customers", zap.Int("customers", len(outcome.IDs)), zap.Error(err))
+	}
+	return outcome, nil
+}
@@ -0,0 +1,156 @@
+package projections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/customer/infrastructure/persistence"
+	orderapi "golang_modular_monolith/internal/modules/order/publicapi"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CustomerOrderStatsProjection maintains the order count and lifetime value of each customer
+// from the order module's public events
+type CustomerOrderStatsProjection struct {
+	db *gorm.DB
+}
+
+// NewCustomerOrderStatsProjection creates a new customer order stats projection
+func NewCustomerOrderStatsProjection(db *gorm.DB) *CustomerOrderStatsProjection {
+	return &CustomerOrderStatsProjection{
+		db: db,
+	}
+}
+
+// CanHandle reports whether the projection is interested in the event type
+func (p *CustomerOrderStatsProjection) CanHandle(eventType string) bool {
+	switch eventType {
+	case orderapi.OrderCreatedEventType,
+		orderapi.OrderLineAddedEventType,
+		orderapi.OrderCancelledEventType:
+		return true
+	}
+	return false
+}
+
+// Handle records the order and refreshes the customer's statistics
+func (p *CustomerOrderStatsProjection) Handle(event shareddomain.DomainEvent) error {
+	ctx := shareddomain.WithEventActor(context.Background(), event)
+	switch e := event.(type) {
+	case orderapi.OrderCreated:
+		return p.onOrderCreated(ctx, e)
+	case orderapi.OrderLineAdded:
+		return p.onOrderLineAdded(ctx, e)
+	case orderapi.OrderCancelled:
+		return p.onOrderCancelled(ctx, e)
+	default:
+		return fmt.Errorf("unsupported event %T for customer order stats projection", event)
+	}
+}
+
+// onOrderCreated records a new order for the customer
+func (p *CustomerOrderStatsProjection) onOrderCreated(ctx context.Context, event orderapi.OrderCreated) error {
+	total := event.GetTotal()
+	order := &persistence.CustomerOrderModel{
+		OrderID:    event.GetOrderID(),
+		CustomerID: event.GetCustomerID(),
+		Currency:   total.Currency,
+		Total:      total.Amount,
+		PlacedAt:   event.GetOccurredAt().UTC(),
+	}
+
+	return shareddb.Conn(ctx, p.db).Transaction(func(tx *gorm.DB) error {
+		// Skip orders that were already recorded so that replaying the event is harmless
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(order)
+		if result.Error != nil {
+			return fmt.Errorf("failed to record order %s for customer: %w", order.OrderID, result.Error)
+		}
+
+		return p.refresh(tx, order.CustomerID)
+	})
+}
+
+// onOrderLineAdded records the new total of an order a line was added to
+// Totals only grow as lines are added, so keeping the greatest one makes replays harmless
+func (p *CustomerOrderStatsProjection) onOrderLineAdded(ctx context.Context, event orderapi.OrderLineAdded) error {
+	return shareddb.Conn(ctx, p.db).Transaction(func(tx *gorm.DB) error {
+		var order persistence.CustomerOrderModel
+		result := tx.Where("order_id = ?", event.GetOrderID()).First(&order)
+		if result.Error != nil {
+			// Orders placed before the customer module tracked them have nothing to update
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return fmt.Errorf("failed to get order %s for customer: %w", event.GetOrderID(), result.Error)
+		}
+
+		result = tx.Model(&order).Update("total", gorm.Expr("GREATEST(total, ?)", event.GetTotal().Amount))
+		if result.Error != nil {
+			return fmt.Errorf("failed to update total of order %s for customer: %w", order.OrderID, result.Error)
+		}
+
+		return p.refresh(tx, order.CustomerID)
+	})
+}
+
+// onOrderCancelled excludes a cancelled order from the customer's statistics
+func (p *CustomerOrderStatsProjection) onOrderCancelled(ctx context.Context, event orderapi.OrderCancelled) error {
+	return shareddb.Conn(ctx, p.db).Transaction(func(tx *gorm.DB) error {
+		var order persistence.CustomerOrderModel
+		result := tx.Where("order_id = ?", event.GetOrderID()).First(&order)
+		if result.Error != nil {
+			// Orders placed before the customer module tracked them have nothing to update
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return fmt.Errorf("failed to get order %s for customer: %w", event.GetOrderID(), result.Error)
+		}
+
+		if order.Cancelled {
+			return nil
+		}
+
+		cancelledAt := event.GetOccurredAt().UTC()
+		result = tx.Model(&order).Updates(map[string]interface{}{
+			"cancelled":    true,
+			"cancelled_at": cancelledAt,
+		})
+		if result.Error != nil {
+			return fmt.Errorf("failed to cancel order %s for customer: %w", order.OrderID, result.Error)
+		}
+
+		return p.refresh(tx, order.CustomerID)
+	})
+}
+
+// refresh recomputes the customer's order count and lifetime value from the recorded orders
+// Recomputing rather than incrementing keeps the read model correct when events are replayed
+func (p *CustomerOrderStatsProjection) refresh(tx *gorm.DB, customerID string) error {
+	result := tx.Exec(`
+		UPDATE customer_views SET
+			order_count = (
+				SELECT COUNT(*) FROM customer_orders
+				WHERE customer_id = @customer AND NOT cancelled
+			),
+			lifetime_value = (
+				SELECT COALESCE(jsonb_object_agg(currency, total), '{}'::jsonb)
+				FROM (
+					SELECT currency, SUM(total) AS total FROM customer_orders
+					WHERE customer_id = @customer AND NOT cancelled
+					GROUP BY currency
+				) totals
+			)
+		WHERE id = @customer`,
+		map[string]interface{}{"customer": customerID},
+	)
+	if result.Error != nil {
+		return fmt.Errorf("failed to refresh order stats for customer %s: %w", customerID, result.Error)
+	}
+
+	return nil
+}
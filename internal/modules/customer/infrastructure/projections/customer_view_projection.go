@@ -0,0 +1,118 @@
+package projections
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/customer/domain"
+	"golang_modular_monolith/internal/modules/customer/infrastructure/persistence"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CustomerViewProjection keeps the customer_views read model in sync with customer events
+type CustomerViewProjection struct {
+	db *gorm.DB
+}
+
+// NewCustomerViewProjection creates a new customer view projection
+func NewCustomerViewProjection(db *gorm.DB) *CustomerViewProjection {
+	return &CustomerViewProjection{
+		db: db,
+	}
+}
+
+// CanHandle reports whether the projection is interested in the event type
+func (p *CustomerViewProjection) CanHandle(eventType string) bool {
+	switch eventType {
+	case domain.CustomerCreatedEventType,
+		domain.CustomerNameUpdatedEventType,
+		domain.CustomerEmailChangedEventType,
+		domain.CustomerStatusChangedEventType,
+		domain.CustomerDeletedEventType,
+		domain.CustomerAttributesChangedEventType,
+		domain.CustomerPreferencesChangedEventType:
+		return true
+	}
+	return false
+}
+
+// Handle applies a customer event to the read model
+func (p *CustomerViewProjection) Handle(event shareddomain.DomainEvent) error {
+	ctx := shareddomain.WithEventActor(context.Background(), event)
+	switch e := event.(type) {
+	case domain.CustomerCreatedEvent:
+		return p.onCustomerCreated(ctx, e)
+	case domain.CustomerNameUpdatedEvent:
+		return p.update(ctx, e, e.Version, map[string]interface{}{
+			"name":       e.NewName,
+			"first_name": e.FirstName,
+			"last_name":  e.LastName,
+		})
+	case domain.CustomerEmailChangedEvent:
+		return p.update(ctx, e, e.Version, map[string]interface{}{"email": e.NewEmail})
+	case domain.CustomerStatusChangedEvent:
+		return p.update(ctx, e, e.Version, map[string]interface{}{"status": e.NewStatus})
+	case domain.CustomerDeletedEvent:
+		return p.update(ctx, e, e.Version, map[string]interface{}{"status": string(domain.CustomerStatusDeleted)})
+	case domain.CustomerAttributesChangedEvent:
+		return p.update(ctx, e, e.Version, map[string]interface{}{"attributes": shareddb.JSONMap(e.Attributes)})
+	case domain.CustomerPreferencesChangedEvent:
+		return p.update(ctx, e, e.Version, map[string]interface{}{"locale": e.Locale, "timezone": e.Timezone})
+	default:
+		return fmt.Errorf("unsupported event %T for customer view projection", event)
+	}
+}
+
+// onCustomerCreated inserts a new read model row
+func (p *CustomerViewProjection) onCustomerCreated(ctx context.Context, event domain.CustomerCreatedEvent) error {
+	occurredAt := event.GetOccurredAt().UTC()
+	view := &persistence.CustomerViewModel{
+		ID:             event.CustomerID,
+		TenantID:       event.TenantID,
+		Name:           event.Name,
+		FirstName:      event.FirstName,
+		LastName:       event.LastName,
+		Email:          event.Email,
+		Status:         event.Status,
+		Locale:         event.Locale,
+		Timezone:       event.Timezone,
+		Version:        event.Version,
+		LastActivityAt: &occurredAt,
+		CreatedAt:      occurredAt,
+		UpdatedAt:      occurredAt,
+	}
+
+	// Upsert so that replaying the event is harmless
+	result := shareddb.Conn(ctx, p.db).Clauses(clause.OnConflict{DoNothing: true}).Create(view)
+	if result.Error != nil {
+		return fmt.Errorf("failed to project customer created event: %w", result.Error)
+	}
+
+	return nil
+}
+
+// update applies column changes to an existing read model row, moving it to the version of the
+// customer the event left it at; the row keeps a later version it already has
+func (p *CustomerViewProjection) update(ctx context.Context, event shareddomain.DomainEvent, version int, changes map[string]interface{}) error {
+	occurredAt := event.GetOccurredAt().UTC()
+	changes["version"] = gorm.Expr("GREATEST(version, ?)", version)
+	changes["last_activity_at"] = occurredAt
+	changes["updated_at"] = occurredAt
+
+	result := shareddb.Conn(ctx, p.db).Model(&persistence.CustomerViewModel{}).
+		Where("id = ?", event.GetAggregateID()).
+		Updates(changes)
+	if result.Error != nil {
+		return fmt.Errorf("failed to project %s event: %w", event.GetEventType(), result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("customer view %s not found for %s event", event.GetAggregateID(), event.GetEventType())
+	}
+
+	return nil
+}
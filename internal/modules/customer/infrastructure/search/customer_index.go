@@ -0,0 +1,131 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang_modular_monolith/internal/modules/customer/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	sharedsearch "golang_modular_monolith/internal/shared/infrastructure/search"
+)
+
+// CustomerIndexName is the name of the index of customers, after the configured prefix
+const CustomerIndexName = "customers"
+
+// customerEventTypes are the events changing the customer views the index is built from
+var customerEventTypes = []string{
+	domain.CustomerCreatedEventType,
+	domain.CustomerNameUpdatedEventType,
+	domain.CustomerEmailChangedEventType,
+	domain.CustomerStatusChangedEventType,
+	domain.CustomerDeletedEventType,
+	domain.CustomerAttributesChangedEventType,
+	domain.CustomerPreferencesChangedEventType,
+}
+
+// CustomerIndex returns the definition of the index of customers
+// Text fields keep a keyword of their exact value, used for exact matches and sorting
+func CustomerIndex() sharedsearch.Index {
+	text := map[string]any{
+		"type":   "text",
+		"fields": map[string]any{"keyword": map[string]any{"type": "keyword", "ignore_above": 256}},
+	}
+	return sharedsearch.Index{
+		Name: CustomerIndexName,
+		Mappings: map[string]any{
+			"dynamic": "strict",
+			"properties": map[string]any{
+				"id":         map[string]any{"type": "keyword"},
+				"tenant_id":  map[string]any{"type": "keyword"},
+				"email":      text,
+				"name":       text,
+				"first_name": text,
+				"last_name":  text,
+				"status":     map[string]any{"type": "keyword"},
+				"created_at": map[string]any{"type": "date"},
+				"updated_at": map[string]any{"type": "date"},
+			},
+		},
+	}
+}
+
+// customerDocument is the indexed part of a customer view
+type customerDocument struct {
+	ID        string    `json:"id"`
+	TenantID  string    `json:"tenant_id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// documentOf returns the document of a customer view
+func documentOf(view *domain.CustomerView) customerDocument {
+	return customerDocument{
+		ID:        view.ID,
+		TenantID:  view.TenantID,
+		Email:     view.Email,
+		Name:      view.Name,
+		FirstName: view.FirstName,
+		LastName:  view.LastName,
+		Status:    string(view.Status),
+		CreatedAt: view.CreatedAt,
+		UpdatedAt: view.UpdatedAt,
+	}
+}
+
+// CustomerProjection keeps the index of customers in sync with customer events
+type CustomerProjection struct {
+	*sharedsearch.Projection
+	engine    sharedsearch.Engine
+	queryRepo domain.CustomerQueryRepository
+}
+
+// NewCustomerProjection creates a projection indexing the customer views of customer events
+func NewCustomerProjection(engine sharedsearch.Engine, queryRepo domain.CustomerQueryRepository) *CustomerProjection {
+	load := func(ctx context.Context, id string) (any, bool, error) {
+		view, err := queryRepo.GetByID(ctx, id)
+		if errors.Is(err, shareddomain.ErrNotFound) {
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		return documentOf(view), true, nil
+	}
+	return &CustomerProjection{
+		Projection: sharedsearch.NewProjection(engine, CustomerIndexName, load, customerEventTypes...),
+		engine:     engine,
+		queryRepo:  queryRepo,
+	}
+}
+
+// Register creates the index of customers when it does not exist, and reports whether it did
+func (p *CustomerProjection) Register(ctx context.Context) (bool, error) {
+	return p.engine.Register(ctx, CustomerIndex())
+}
+
+// Reindex indexes every customer view, e.g. once the index was created
+func (p *CustomerProjection) Reindex(ctx context.Context) error {
+	params := domain.ListCustomersParams{Page: 1, Limit: 100, SortBy: "id", SortOrder: "asc", IncludeDeleted: true}
+	for {
+		result, err := p.queryRepo.List(ctx, params)
+		if err != nil {
+			return err
+		}
+		for i := range result.Customers {
+			view := &result.Customers[i]
+			if err := p.engine.Put(ctx, CustomerIndexName, view.ID, documentOf(view)); err != nil {
+				return err
+			}
+		}
+		if !result.Pagination.HasNext {
+			return nil
+		}
+		params.Page++
+	}
+}
@@ -0,0 +1,157 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"golang_modular_monolith/internal/modules/customer/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	sharedsearch "golang_modular_monolith/internal/shared/infrastructure/search"
+)
+
+// sortFields maps the sort fields of customer lists to the fields of the index
+var sortFields = map[string]string{
+	"id":         "id",
+	"email":      "email.keyword",
+	"name":       "name.keyword",
+	"first_name": "first_name.keyword",
+	"last_name":  "last_name.keyword",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+}
+
+// IndexedCustomerQueryRepository answers customer searches from the index of customers, loading
+// the matching views from the database, and leaves the other queries to the database
+// Searches the index cannot answer, i.e. by attributes, and searches failing while the engine is
+// unavailable are answered by the database as well
+type IndexedCustomerQueryRepository struct {
+	domain.CustomerQueryRepository
+	engine sharedsearch.Engine
+}
+
+// NewIndexedCustomerQueryRepository wraps the repository of the database
+func NewIndexedCustomerQueryRepository(repo domain.CustomerQueryRepository, engine sharedsearch.Engine) *IndexedCustomerQueryRepository {
+	return &IndexedCustomerQueryRepository{CustomerQueryRepository: repo, engine: engine}
+}
+
+// Search searches customers in the index
+// Free-text searches without a sort order return the best matches first
+func (r *IndexedCustomerQueryRepository) Search(ctx context.Context, params domain.SearchCustomersParams) (*domain.CustomerListResult, error) {
+	byRelevance := params.Query != "" && params.SortBy == ""
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if len(params.Attributes) > 0 {
+		return r.CustomerQueryRepository.Search(ctx, params)
+	}
+
+	tenantID, _ := shareddomain.TenantIDFromContext(ctx)
+	result, err := r.engine.Search(ctx, CustomerIndexName, searchRequest(params, tenantID, byRelevance))
+	if err != nil {
+		return r.CustomerQueryRepository.Search(ctx, params)
+	}
+
+	views, err := r.GetByIDs(ctx, result.IDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]domain.CustomerView, len(views))
+	for _, view := range views {
+		byID[view.ID] = view
+	}
+
+	// Keep the order of the index; customers it still holds but the database no longer does are skipped
+	customers := make([]domain.CustomerView, 0, len(result.IDs))
+	for _, id := range result.IDs {
+		if view, ok := byID[id]; ok {
+			customers = append(customers, view)
+		}
+	}
+
+	return &domain.CustomerListResult{
+		Customers:  customers,
+		Pagination: domain.NewPaginationResult(params.Page, params.Limit, result.Total),
+	}, nil
+}
+
+// searchRequest translates the search parameters to the query of the index, limited to the customers
+// of the tenant when one is given; with byRelevance, the best matches come first
+func searchRequest(params domain.SearchCustomersParams, tenantID string, byRelevance bool) sharedsearch.Request {
+	var must, filter, mustNot []map[string]any
+
+	if tenantID != "" {
+		filter = append(filter, map[string]any{"term": map[string]any{"tenant_id": tenantID}})
+	}
+
+	if params.Query != "" {
+		must = append(must, map[string]any{"multi_match": map[string]any{
+			"query":     params.Query,
+			"type":      "bool_prefix",
+			"fields":    []string{"name", "first_name", "last_name", "email"},
+			"fuzziness": "AUTO",
+			"operator":  "and",
+		}})
+	}
+	if params.Email != "" {
+		filter = append(filter, map[string]any{"term": map[string]any{"email.keyword": params.Email}})
+	}
+	if params.FirstName != "" {
+		must = append(must, map[string]any{"match_bool_prefix": map[string]any{"first_name": params.FirstName}})
+	}
+	if params.LastName != "" {
+		must = append(must, map[string]any{"match_bool_prefix": map[string]any{"last_name": params.LastName}})
+	}
+
+	if len(params.Statuses) > 0 {
+		filter = append(filter, map[string]any{"terms": map[string]any{"status": params.Statuses}})
+	}
+	if !params.IncludeDeleted {
+		mustNot = append(mustNot, map[string]any{"term": map[string]any{"status": domain.CustomerStatusDeleted}})
+	}
+	if dates := dateRange("created_at", params.CreatedAfter, params.CreatedBefore); dates != nil {
+		filter = append(filter, dates)
+	}
+	if dates := dateRange("updated_at", params.UpdatedAfter, params.UpdatedBefore); dates != nil {
+		filter = append(filter, dates)
+	}
+
+	query := map[string]any{}
+	if must != nil {
+		query["must"] = must
+	}
+	if filter != nil {
+		query["filter"] = filter
+	}
+	if mustNot != nil {
+		query["must_not"] = mustNot
+	}
+
+	// The ID breaks ties so that pages do not overlap
+	sort := []map[string]any{{sortFields[params.SortBy]: params.SortOrder}}
+	if byRelevance {
+		sort[0] = map[string]any{"_score": "desc"}
+	}
+	sort = append(sort, map[string]any{"id": "asc"})
+
+	return sharedsearch.Request{
+		Query: map[string]any{"bool": query},
+		Sort:  sort,
+		From:  params.GetOffset(),
+		Size:  params.Limit,
+	}
+}
+
+// dateRange returns the filter of a date field between inclusive bounds, nil without bounds
+func dateRange(field string, after, before *time.Time) map[string]any {
+	bounds := map[string]any{}
+	if after != nil {
+		bounds["gte"] = after
+	}
+	if before != nil {
+		bounds["lte"] = before
+	}
+	if len(bounds) == 0 {
+		return nil
+	}
+	return map[string]any{"range": map[string]any{field: bounds}}
+}
@@ -0,0 +1,131 @@
+package webhooks
+
+import (
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/customer/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/webhook"
+)
+
+// Public webhook event names for the customer module
+const (
+	CustomerCreatedWebhook = "customer.created"
+	CustomerUpdatedWebhook = "customer.updated"
+	CustomerDeletedWebhook = "customer.deleted"
+)
+
+// CustomerPayload is the payload of customer.created and customer.deleted
+type CustomerPayload struct {
+	CustomerID string `json:"customer_id"`
+	Name       string `json:"name"`
+	FirstName  string `json:"first_name,omitempty"`
+	LastName   string `json:"last_name,omitempty"`
+	Email      string `json:"email"`
+	Status     string `json:"status,omitempty"`
+}
+
+// FieldChange describes the old and new value of a changed field
+type FieldChange struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// CustomerUpdatedPayload is the payload of customer.updated
+type CustomerUpdatedPayload struct {
+	CustomerID string                 `json:"customer_id"`
+	Changes    map[string]FieldChange `json:"changes"`
+}
+
+// RegisterCustomerWebhooks registers customer lifecycle events as webhook-eligible
+func RegisterCustomerWebhooks(registry *webhook.Registry) error {
+	definitions := []webhook.EventDefinition{
+		{
+			Name:         CustomerCreatedWebhook,
+			Module:       "customer",
+			Description:  "A customer was created",
+			SourceEvents: []string{domain.CustomerCreatedEventType},
+			Payload:      customerCreatedPayload,
+		},
+		{
+			Name:        CustomerUpdatedWebhook,
+			Module:      "customer",
+			Description: "A customer's name, email, or status changed",
+			SourceEvents: []string{
+				domain.CustomerNameUpdatedEventType,
+				domain.CustomerEmailChangedEventType,
+				domain.CustomerStatusChangedEventType,
+			},
+			Payload: customerUpdatedPayload,
+		},
+		{
+			Name:         CustomerDeletedWebhook,
+			Module:       "customer",
+			Description:  "A customer was deleted",
+			SourceEvents: []string{domain.CustomerDeletedEventType},
+			Payload:      customerDeletedPayload,
+		},
+	}
+
+	for _, definition := range definitions {
+		if err := registry.Register(definition); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// customerCreatedPayload maps CustomerCreatedEvent to the public payload
+func customerCreatedPayload(event shareddomain.DomainEvent) (interface{}, error) {
+	e, ok := event.(domain.CustomerCreatedEvent)
+	if !ok {
+		return nil, fmt.Errorf("unexpected event %T for %s", event, CustomerCreatedWebhook)
+	}
+
+	return CustomerPayload{
+		CustomerID: e.CustomerID,
+		Name:       e.Name,
+		FirstName:  e.FirstName,
+		LastName:   e.LastName,
+		Email:      e.Email,
+		Status:     e.Status,
+	}, nil
+}
+
+// customerUpdatedPayload maps customer change events to the public payload
+func customerUpdatedPayload(event shareddomain.DomainEvent) (interface{}, error) {
+	switch e := event.(type) {
+	case domain.CustomerNameUpdatedEvent:
+		return CustomerUpdatedPayload{
+			CustomerID: e.CustomerID,
+			Changes:    map[string]FieldChange{"name": {Old: e.OldName, New: e.NewName}},
+		}, nil
+	case domain.CustomerEmailChangedEvent:
+		return CustomerUpdatedPayload{
+			CustomerID: e.CustomerID,
+			Changes:    map[string]FieldChange{"email": {Old: e.OldEmail, New: e.NewEmail}},
+		}, nil
+	case domain.CustomerStatusChangedEvent:
+		return CustomerUpdatedPayload{
+			CustomerID: e.CustomerID,
+			Changes:    map[string]FieldChange{"status": {Old: e.OldStatus, New: e.NewStatus}},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unexpected event %T for %s", event, CustomerUpdatedWebhook)
+	}
+}
+
+// customerDeletedPayload maps CustomerDeletedEvent to the public payload
+func customerDeletedPayload(event shareddomain.DomainEvent) (interface{}, error) {
+	e, ok := event.(domain.CustomerDeletedEvent)
+	if !ok {
+		return nil, fmt.Errorf("unexpected event %T for %s", event, CustomerDeletedWebhook)
+	}
+
+	return CustomerPayload{
+		CustomerID: e.CustomerID,
+		Name:       e.Name,
+		Email:      e.Email,
+	}, nil
+}
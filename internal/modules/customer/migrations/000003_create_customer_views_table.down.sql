@@ -0,0 +1,2 @@
+-- Drop customer read model table
+DROP TABLE IF EXISTS "public"."customer_views";
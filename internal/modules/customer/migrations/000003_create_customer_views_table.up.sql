@@ -0,0 +1,24 @@
+-- Create customer read model table (maintained by projections from customer events)
+CREATE TABLE IF NOT EXISTS "public"."customer_views" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "name" VARCHAR(255) NOT NULL,
+    "email" VARCHAR(255) NOT NULL,
+    "status" "public"."customer_status" NOT NULL DEFAULT 'active'::customer_status,
+    "version" INTEGER NOT NULL DEFAULT 0,
+    "last_activity_at" TIMESTAMP WITH TIME ZONE,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for read-side filtering and sorting
+CREATE INDEX IF NOT EXISTS idx_customer_views_email ON "public"."customer_views" ("email");
+CREATE INDEX IF NOT EXISTS idx_customer_views_status ON "public"."customer_views" ("status");
+CREATE INDEX IF NOT EXISTS idx_customer_views_name ON "public"."customer_views" ("name");
+CREATE INDEX IF NOT EXISTS idx_customer_views_created_at ON "public"."customer_views" ("created_at");
+CREATE INDEX IF NOT EXISTS idx_customer_views_updated_at ON "public"."customer_views" ("updated_at");
+
+-- Backfill read model from the write model
+INSERT INTO "public"."customer_views" ("id", "name", "email", "status", "version", "last_activity_at", "created_at", "updated_at")
+SELECT "id", "name", "email", "status", "version", "updated_at", "created_at", "updated_at"
+FROM "public"."customers"
+ON CONFLICT ("id") DO NOTHING;
@@ -0,0 +1,6 @@
+-- Drop attributes index
+DROP INDEX IF EXISTS idx_customer_views_attributes;
+
+-- Drop attributes columns
+ALTER TABLE "public"."customer_views" DROP COLUMN IF EXISTS "attributes";
+ALTER TABLE "public"."customers" DROP COLUMN IF EXISTS "attributes";
@@ -0,0 +1,10 @@
+-- Add custom attributes (integrator extension data) to the write model
+ALTER TABLE "public"."customers"
+    ADD COLUMN IF NOT EXISTS "attributes" JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+-- Add custom attributes to the read model
+ALTER TABLE "public"."customer_views"
+    ADD COLUMN IF NOT EXISTS "attributes" JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+-- GIN index for containment filters (attributes @> '{"plan":"pro"}')
+CREATE INDEX IF NOT EXISTS idx_customer_views_attributes ON "public"."customer_views" USING GIN ("attributes" jsonb_path_ops);
@@ -0,0 +1,6 @@
+-- Drop trigram indexes
+DROP INDEX IF EXISTS idx_customers_normalized_email_trgm;
+DROP INDEX IF EXISTS idx_customers_name_trgm;
+
+-- Drop normalized email column
+ALTER TABLE "public"."customers" DROP COLUMN IF EXISTS "normalized_email";
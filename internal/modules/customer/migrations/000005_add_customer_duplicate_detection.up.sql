@@ -0,0 +1,14 @@
+-- Trigram similarity for fuzzy duplicate detection
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Normalized email used for duplicate matching (must match domain.NormalizeEmail):
+-- lowercased, "+tag" suffix and dots removed from the local part
+ALTER TABLE "public"."customers"
+    ADD COLUMN IF NOT EXISTS "normalized_email" VARCHAR(255) GENERATED ALWAYS AS (
+        regexp_replace(split_part(lower("email"), '@', 1), '(\+.*$)|\.', '', 'g')
+        || '@' || split_part(lower("email"), '@', 2)
+    ) STORED;
+
+-- Trigram indexes for similarity lookups
+CREATE INDEX IF NOT EXISTS idx_customers_name_trgm ON "public"."customers" USING GIN ("name" gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_customers_normalized_email_trgm ON "public"."customers" USING GIN ("normalized_email" gin_trgm_ops);
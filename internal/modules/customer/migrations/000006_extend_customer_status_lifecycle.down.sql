@@ -0,0 +1,20 @@
+-- PostgreSQL cannot drop enum values, so map the lifecycle statuses back and recreate the type
+UPDATE "public"."customers" SET "status" = 'active' WHERE "status" = 'prospect';
+UPDATE "public"."customers" SET "status" = 'inactive' WHERE "status" = 'churned';
+UPDATE "public"."customer_views" SET "status" = 'active' WHERE "status" = 'prospect';
+UPDATE "public"."customer_views" SET "status" = 'inactive' WHERE "status" = 'churned';
+
+ALTER TYPE "public"."customer_status" RENAME TO "customer_status_old";
+CREATE TYPE "public"."customer_status" AS ENUM ('active', 'inactive', 'deleted');
+
+ALTER TABLE "public"."customers" ALTER COLUMN "status" DROP DEFAULT;
+ALTER TABLE "public"."customers"
+    ALTER COLUMN "status" TYPE "public"."customer_status" USING "status"::text::"public"."customer_status";
+ALTER TABLE "public"."customers" ALTER COLUMN "status" SET DEFAULT 'active'::customer_status;
+
+ALTER TABLE "public"."customer_views" ALTER COLUMN "status" DROP DEFAULT;
+ALTER TABLE "public"."customer_views"
+    ALTER COLUMN "status" TYPE "public"."customer_status" USING "status"::text::"public"."customer_status";
+ALTER TABLE "public"."customer_views" ALTER COLUMN "status" SET DEFAULT 'active'::customer_status;
+
+DROP TYPE "public"."customer_status_old";
@@ -0,0 +1,3 @@
+-- Add lifecycle statuses (prospect → active → churned → deleted)
+ALTER TYPE "public"."customer_status" ADD VALUE IF NOT EXISTS 'prospect' BEFORE 'active';
+ALTER TYPE "public"."customer_status" ADD VALUE IF NOT EXISTS 'churned' AFTER 'inactive';
@@ -0,0 +1,7 @@
+-- Drop name part indexes
+DROP INDEX IF EXISTS idx_customer_views_last_name;
+DROP INDEX IF EXISTS idx_customer_views_first_name;
+
+-- Drop name part columns ("name" still holds the full name)
+ALTER TABLE "public"."customer_views" DROP COLUMN IF EXISTS "last_name", DROP COLUMN IF EXISTS "first_name";
+ALTER TABLE "public"."customers" DROP COLUMN IF EXISTS "last_name", DROP COLUMN IF EXISTS "first_name";
@@ -0,0 +1,23 @@
+-- Structured first/last name; "name" is kept as the full name for compatibility
+ALTER TABLE "public"."customers"
+    ADD COLUMN IF NOT EXISTS "first_name" VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS "last_name" VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE "public"."customer_views"
+    ADD COLUMN IF NOT EXISTS "first_name" VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS "last_name" VARCHAR(255) NOT NULL DEFAULT '';
+
+-- Backfill: the first word is the first name, the rest is the last name (matches domain.ParsePersonName)
+UPDATE "public"."customers"
+SET "first_name" = split_part(btrim("name"), ' ', 1),
+    "last_name" = btrim(substr(btrim("name"), length(split_part(btrim("name"), ' ', 1)) + 1))
+WHERE "first_name" = '';
+
+UPDATE "public"."customer_views"
+SET "first_name" = split_part(btrim("name"), ' ', 1),
+    "last_name" = btrim(substr(btrim("name"), length(split_part(btrim("name"), ' ', 1)) + 1))
+WHERE "first_name" = '';
+
+-- Indexes for first/last name search
+CREATE INDEX IF NOT EXISTS idx_customer_views_first_name ON "public"."customer_views" (LOWER("first_name"));
+CREATE INDEX IF NOT EXISTS idx_customer_views_last_name ON "public"."customer_views" (LOWER("last_name"));
@@ -0,0 +1,3 @@
+-- Drop preference columns
+ALTER TABLE "public"."customer_views" DROP COLUMN IF EXISTS "timezone", DROP COLUMN IF EXISTS "locale";
+ALTER TABLE "public"."customers" DROP COLUMN IF EXISTS "timezone", DROP COLUMN IF EXISTS "locale";
@@ -0,0 +1,8 @@
+-- Locale (BCP 47) and timezone (IANA) preferences; empty means no preference
+ALTER TABLE "public"."customers"
+    ADD COLUMN IF NOT EXISTS "locale" VARCHAR(35) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS "timezone" VARCHAR(64) NOT NULL DEFAULT '';
+
+ALTER TABLE "public"."customer_views"
+    ADD COLUMN IF NOT EXISTS "locale" VARCHAR(35) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS "timezone" VARCHAR(64) NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+-- Remove per-customer order statistics
+ALTER TABLE "public"."customer_views"
+    DROP COLUMN IF EXISTS "lifetime_value",
+    DROP COLUMN IF EXISTS "order_count";
+
+DROP TABLE IF EXISTS "public"."customer_orders";
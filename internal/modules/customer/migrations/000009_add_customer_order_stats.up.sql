@@ -0,0 +1,18 @@
+-- Orders seen by the customer module, maintained from order events
+-- Orders placed before this migration are not known here; the order module owns its own database
+CREATE TABLE IF NOT EXISTS "public"."customer_orders" (
+    "order_id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "customer_id" VARCHAR(36) NOT NULL,
+    "currency" CHAR(3) NOT NULL,
+    "total" BIGINT NOT NULL DEFAULT 0,
+    "cancelled" BOOLEAN NOT NULL DEFAULT FALSE,
+    "placed_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "cancelled_at" TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_orders_customer_id ON "public"."customer_orders" ("customer_id");
+
+-- Per-customer order count and lifetime value (minor units keyed by currency), excluding cancelled orders
+ALTER TABLE "public"."customer_views"
+    ADD COLUMN IF NOT EXISTS "order_count" INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "lifetime_value" JSONB NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1,10 @@
+-- Drop the tenant of the read model
+DROP INDEX IF EXISTS idx_customer_views_tenant_id;
+ALTER TABLE "public"."customer_views" DROP COLUMN IF EXISTS "tenant_id";
+
+-- Restore globally unique emails; fails while two tenants share an email
+DROP INDEX IF EXISTS idx_customers_tenant_email;
+ALTER TABLE "public"."customers" ADD CONSTRAINT "customers_email_key" UNIQUE ("email");
+
+-- Drop tenant column
+ALTER TABLE "public"."customers" DROP COLUMN IF EXISTS "tenant_id";
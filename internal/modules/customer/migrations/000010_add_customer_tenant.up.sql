@@ -0,0 +1,13 @@
+-- Customers belong to the tenant they were created in; customers created without multi-tenancy have none
+ALTER TABLE "public"."customers"
+    ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+
+-- Emails are unique within a tenant
+ALTER TABLE "public"."customers" DROP CONSTRAINT IF EXISTS "customers_email_key";
+CREATE UNIQUE INDEX IF NOT EXISTS idx_customers_tenant_email ON "public"."customers" ("tenant_id", "email");
+
+-- The read model carries the tenant of each customer, so that lists and searches are scoped to it
+ALTER TABLE "public"."customer_views"
+    ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_customer_views_tenant_id ON "public"."customer_views" ("tenant_id");
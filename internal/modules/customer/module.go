@@ -3,18 +3,33 @@ package customer
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
 
-	commandhandlers "golang_modular_monolith/internal/modules/customer/application/command_handlers"
-	queryhandlers "golang_modular_monolith/internal/modules/customer/application/query_handlers"
+	customerdomain "golang_modular_monolith/internal/modules/customer/domain"
+	customerdb "golang_modular_monolith/internal/modules/customer/infrastructure/database"
 	customerhttp "golang_modular_monolith/internal/modules/customer/infrastructure/http"
 	"golang_modular_monolith/internal/modules/customer/infrastructure/http/handlers"
 	"golang_modular_monolith/internal/modules/customer/infrastructure/persistence"
+	"golang_modular_monolith/internal/modules/customer/infrastructure/projections"
+	customersearch "golang_modular_monolith/internal/modules/customer/infrastructure/search"
+	"golang_modular_monolith/internal/modules/customer/infrastructure/webhooks"
+	"golang_modular_monolith/internal/modules/customer/publicapi"
 
+	"golang_modular_monolith/internal/shared/application"
 	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/authz"
+	"golang_modular_monolith/internal/shared/infrastructure/di"
+	"golang_modular_monolith/internal/shared/infrastructure/importexport"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
 	"golang_modular_monolith/internal/shared/infrastructure/registry"
+	"golang_modular_monolith/internal/shared/infrastructure/retention"
+	"golang_modular_monolith/internal/shared/infrastructure/search"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+	"golang_modular_monolith/internal/shared/infrastructure/webhook"
+	"golang_modular_monolith/internal/shared/infrastructure/worker"
 )
 
 // Auto-register customer module on package import
@@ -26,11 +41,21 @@ func init() {
 
 // CustomerModule implements the Module interface
 type CustomerModule struct {
-	name    string
-	handler *handlers.CustomerHandler
+	name       string
+	logger     *zap.Logger
+	handler    *handlers.CustomerHandler
+	tokens     *auth.TokenService
+	authorizer authz.Authorizer
+	projection *projections.CustomerViewProjection
+	orderStats *projections.CustomerOrderStatsProjection
+	index      *customersearch.CustomerProjection
+	reindex    bool // the index was created and is not filled yet
+	workers    *worker.Manager
+	retention  []retention.Policy
 
 	// Dependencies
 	eventBus domain.EventBus
+	commands *application.MiddlewareCommandBus
 }
 
 // NewCustomerModule creates a new customer module
@@ -47,53 +72,112 @@ func (m *CustomerModule) Name() string {
 
 // Initialize initializes the customer module with dependencies
 func (m *CustomerModule) Initialize(deps domain.ModuleDependencies) error {
-	log.Printf("🔧 Initializing %s module...", m.name)
+	m.logger = deps.Logger
+	m.logger.Info("initializing module")
 
 	// Store event bus
 	m.eventBus = deps.EventBus
 
-	// Create repositories using factory pattern
-	customerRepo, err := persistence.NewPostgreSQLCustomerRepositoryFromManager()
+	// Apply the configured lifecycle state machine
+	lifecycle, err := loadLifecycle(deps.Config)
 	if err != nil {
-		return fmt.Errorf("failed to create customer repository: %w", err)
+		return fmt.Errorf("invalid lifecycle config: %w", err)
+	}
+	if err := customerdomain.ConfigureLifecycle(lifecycle); err != nil {
+		return fmt.Errorf("invalid lifecycle config: %w", err)
 	}
 
-	customerQueryRepo, err := persistence.NewPostgreSQLCustomerQueryRepositoryFromManager()
+	duplicatePolicy, err := loadDuplicateCheckPolicy(deps.Config)
+	if err != nil {
+		return fmt.Errorf("invalid duplicate detection config: %w", err)
+	}
+	m.logger.Info("duplicate detection configured", zap.String("mode", string(duplicatePolicy.Mode)))
+
+	// Customer searches use the search index when a search engine is configured
+	engine, err := search.GetEngine()
+	if err != nil {
+		engine = nil
+	}
+	m.workers = worker.NewManager(m.name, m.logger)
+
+	// Construct repositories, projections and handlers from their constructors
+	// Imports run as workers of the module and are audited once finished
+	jobs := importexport.NewJobs(m.name, m.workers, deps.Audit)
+	// Commands run through the module's command bus, which the application wraps in transactions;
+	// protected commands are authorized first, with the user module's authorizer
+	m.commands = application.NewMiddlewareCommandBus(application.NewInMemoryCommandBus())
+	m.commands.Use(authz.CommandMiddleware(authz.Lazy(deps.PublicAPIs)))
+	m.commands.Use(validation.CommandMiddleware())
+	container := newContainer(m.eventBus, m.commands, duplicatePolicy, engine, jobs)
+	if m.handler, err = di.Resolve[*handlers.CustomerHandler](container); err != nil {
+		return fmt.Errorf("failed to create customer handler: %w", err)
+	}
+	if m.projection, err = di.Resolve[*projections.CustomerViewProjection](container); err != nil {
+		return fmt.Errorf("failed to create customer view projection: %w", err)
+	}
+	if m.orderStats, err = di.Resolve[*projections.CustomerOrderStatsProjection](container); err != nil {
+		return fmt.Errorf("failed to create customer order stats projection: %w", err)
+	}
+	if engine != nil {
+		if m.index, err = di.Resolve[*customersearch.CustomerProjection](container); err != nil {
+			return fmt.Errorf("failed to create customer search projection: %w", err)
+		}
+	}
+	customerQueryRepo, err := di.Resolve[customerdomain.CustomerQueryRepository](container)
 	if err != nil {
 		return fmt.Errorf("failed to create customer query repository: %w", err)
 	}
 
-	// Create domain services
-	customerDomainService := persistence.NewCustomerDomainService(customerRepo)
+	// Purge deleted customers once retention.deleted_customers.after has passed
+	if m.retention, err = loadRetentionPolicies(deps.Config, engine); err != nil {
+		return err
+	}
 
-	// Create command handlers
-	createCustomerHandler := commandhandlers.NewCreateCustomerHandler(
-		customerRepo,
-		customerDomainService,
-		m.eventBus,
-	)
+	// Guarded routes verify tokens with the shared token service and check permissions
+	// with the user module's authorizer, resolved lazily so module order does not matter
+	m.tokens, err = auth.GetTokenService()
+	if err != nil {
+		return fmt.Errorf("failed to get token service: %w", err)
+	}
+	m.authorizer = authz.Lazy(deps.PublicAPIs)
 
-	// Create query handlers
-	getCustomerHandler := queryhandlers.NewGetCustomerHandler(customerQueryRepo)
-	listCustomersHandler := queryhandlers.NewListCustomersHandler(customerQueryRepo)
-	searchCustomersHandler := queryhandlers.NewSearchCustomersHandler(customerQueryRepo)
+	// Expose the public API to other modules, directly and on the request bus
+	api := publicapi.NewService(customerQueryRepo)
+	if err := publicapi.Register(deps.PublicAPIs, api); err != nil {
+		return fmt.Errorf("failed to register customer public API: %w", err)
+	}
+	if err := publicapi.HandleRequests(deps.Requests, api); err != nil {
+		return fmt.Errorf("failed to handle customer requests: %w", err)
+	}
 
-	// Create HTTP handlers
-	m.handler = handlers.NewCustomerHandler(
-		createCustomerHandler,
-		getCustomerHandler,
-		listCustomersHandler,
-		searchCustomersHandler,
-	)
+	// Expose customer lifecycle events to webhook subscribers
+	if err := webhooks.RegisterCustomerWebhooks(webhook.GetGlobalRegistry()); err != nil {
+		return fmt.Errorf("failed to register customer webhook events: %w", err)
+	}
 
-	log.Printf("✅ %s module initialized successfully", m.name)
+	m.logger.Info("module initialized")
 	return nil
 }
 
 // RegisterRoutes registers HTTP routes for the customer module
 func (m *CustomerModule) RegisterRoutes(router *gin.RouterGroup) {
-	log.Printf("🌐 Registering routes for %s module", m.name)
-	customerhttp.RegisterCustomerRoutes(router, m.handler)
+	m.logger.Info("registering routes")
+	customerhttp.RegisterCustomerRoutes(router, m.handler, m.tokens, m.authorizer)
+}
+
+// CommandBus implements application.CommandDispatcher
+func (m *CustomerModule) CommandBus() *application.MiddlewareCommandBus {
+	return m.commands
+}
+
+// APIOperations implements openapi.Documented
+func (m *CustomerModule) APIOperations() []*openapi.Operation {
+	return customerhttp.APIOperations()
+}
+
+// RetentionPolicies implements retention.Retained
+func (m *CustomerModule) RetentionPolicies() []retention.Policy {
+	return m.retention
 }
 
 // Health checks if the customer module is healthy
@@ -113,34 +197,77 @@ func (m *CustomerModule) Health(ctx context.Context) error {
 
 // Start starts the customer module (optional lifecycle method)
 func (m *CustomerModule) Start(ctx context.Context) error {
-	log.Printf("🚀 Starting %s module", m.name)
+	m.logger.Info("starting module")
 
 	// Register event handlers if needed
 	if err := m.registerEventHandlers(); err != nil {
 		return fmt.Errorf("failed to register event handlers: %w", err)
 	}
 
-	log.Printf("✅ %s module started successfully", m.name)
+	// Create the search index, filling it from the read model the first time
+	if m.index != nil {
+		if err := m.workers.Go("customer_search_index", m.createIndex); err != nil {
+			return fmt.Errorf("failed to start customer search indexing: %w", err)
+		}
+	}
+
+	m.logger.Info("module started")
 	return nil
 }
 
 // Stop stops the customer module (optional lifecycle method)
 func (m *CustomerModule) Stop(ctx context.Context) error {
-	log.Printf("🛑 Stopping %s module", m.name)
+	m.logger.Info("stopping module")
+
+	if m.workers != nil {
+		if err := m.workers.Stop(ctx); err != nil {
+			return fmt.Errorf("failed to stop customer workers: %w", err)
+		}
+	}
+
+	// Unregister event handlers
+	if m.index != nil {
+		if err := m.eventBus.Unsubscribe(m.index); err != nil {
+			return fmt.Errorf("failed to unsubscribe customer search projection: %w", err)
+		}
+	}
+	if m.orderStats != nil {
+		if err := m.eventBus.Unsubscribe(m.orderStats); err != nil {
+			return fmt.Errorf("failed to unsubscribe customer order stats projection: %w", err)
+		}
+	}
+	if m.projection != nil {
+		if err := m.eventBus.Unsubscribe(m.projection); err != nil {
+			return fmt.Errorf("failed to unsubscribe customer view projection: %w", err)
+		}
+	}
 
 	// Cleanup resources if needed
 	// - Close connections
-	// - Unregister event handlers
 	// - Stop background workers
 
-	log.Printf("✅ %s module stopped successfully", m.name)
+	m.logger.Info("module stopped")
 	return nil
 }
 
 // registerEventHandlers registers event handlers for cross-module communication
 func (m *CustomerModule) registerEventHandlers() error {
-	// Example: Register handlers for events from other modules
-	// m.eventBus.SubscribeToEventType("order.created", m.handleOrderCreated)
+	// Keep the customer_views read model in sync with customer events
+	if err := m.eventBus.Subscribe(m.projection); err != nil {
+		return fmt.Errorf("failed to subscribe customer view projection: %w", err)
+	}
+
+	// Maintain per-customer order count and lifetime value from order events
+	if err := m.eventBus.Subscribe(m.orderStats); err != nil {
+		return fmt.Errorf("failed to subscribe customer order stats projection: %w", err)
+	}
+
+	// Index customers once their views are updated, which the view projection subscribed first does
+	if m.index != nil {
+		if err := m.eventBus.Subscribe(m.index); err != nil {
+			return fmt.Errorf("failed to subscribe customer search projection: %w", err)
+		}
+	}
 
 	return nil
 }
@@ -149,3 +276,125 @@ func (m *CustomerModule) registerEventHandlers() error {
 func (m *CustomerModule) GetHandler() *handlers.CustomerHandler {
 	return m.handler
 }
+
+// loadLifecycle reads customer.lifecycle from the module config,
+// falling back to the default lifecycle for anything not configured
+func loadLifecycle(cfg domain.ModuleConfig) (customerdomain.CustomerLifecycle, error) {
+	lifecycle := customerdomain.DefaultCustomerLifecycle()
+
+	settings := cfg.Section("lifecycle")
+	if settings == nil {
+		return lifecycle, nil
+	}
+
+	if initial, ok := settings["initial_status"].(string); ok && initial != "" {
+		lifecycle.InitialStatus = customerdomain.CustomerStatus(initial)
+	}
+
+	if transitions, ok := settings["transitions"].(map[string]interface{}); ok {
+		lifecycle.Transitions = make(map[customerdomain.CustomerStatus][]customerdomain.CustomerStatus)
+		for from, targets := range transitions {
+			list, ok := targets.([]interface{})
+			if !ok && targets != nil {
+				return lifecycle, fmt.Errorf("transitions of %q must be a list", from)
+			}
+
+			allowed := make([]customerdomain.CustomerStatus, 0, len(list))
+			for _, target := range list {
+				allowed = append(allowed, customerdomain.CustomerStatus(fmt.Sprint(target)))
+			}
+			lifecycle.Transitions[customerdomain.CustomerStatus(from)] = allowed
+		}
+	}
+
+	return lifecycle, nil
+}
+
+// loadDuplicateCheckPolicy reads customer.duplicate_detection from the module config,
+// falling back to the defaults for anything not configured
+func loadDuplicateCheckPolicy(cfg domain.ModuleConfig) (customerdomain.DuplicateCheckPolicy, error) {
+	policy := customerdomain.DefaultDuplicateCheckPolicy()
+
+	detection := cfg.Section("duplicate_detection")
+	if detection == nil {
+		return policy, nil
+	}
+
+	if mode, ok := detection["mode"].(string); ok && mode != "" {
+		policy.Mode = customerdomain.DuplicateCheckMode(mode)
+		if !policy.Mode.IsValid() {
+			return policy, fmt.Errorf("unknown mode %q (expected off, warn or reject)", mode)
+		}
+	}
+	if value, ok := toFloat(detection["name_threshold"]); ok {
+		policy.NameThreshold = value
+	}
+	if value, ok := toFloat(detection["email_threshold"]); ok {
+		policy.EmailThreshold = value
+	}
+	if value, ok := toFloat(detection["max_candidates"]); ok && value > 0 {
+		policy.MaxCandidates = int(value)
+	}
+
+	return policy, nil
+}
+
+// loadRetentionPolicies reads customer.retention from the module config and creates the policies
+// it turns on; purged customers are removed from the search index too
+func loadRetentionPolicies(cfg domain.ModuleConfig, engine search.Engine) ([]retention.Policy, error) {
+	after, err := retention.After(cfg, persistence.DeletedCustomersPolicyName)
+	if err != nil || after == 0 {
+		return nil, err
+	}
+
+	db, err := customerdb.GetCustomerDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer database: %w", err)
+	}
+
+	var purged func(ctx context.Context, ids []string) error
+	if engine != nil {
+		purged = func(ctx context.Context, ids []string) error {
+			for _, id := range ids {
+				if err := engine.Delete(ctx, customersearch.CustomerIndexName, id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	return []retention.Policy{persistence.NewDeletedCustomersPolicy(db, after, purged)}, nil
+}
+
+// toFloat converts a numeric YAML value to float64
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// createIndex creates the customer search index, and indexes every customer when it did not exist;
+// it is retried until the search engine is reachable and every customer is indexed
+func (m *CustomerModule) createIndex(ctx context.Context) error {
+	if !m.reindex {
+		created, err := m.index.Register(ctx)
+		if err != nil || !created {
+			return err
+		}
+		m.reindex = true
+	}
+
+	m.logger.Info("indexing customers")
+	if err := m.index.Reindex(ctx); err != nil {
+		return err
+	}
+	m.reindex = false
+	m.logger.Info("customers indexed")
+	return nil
+}
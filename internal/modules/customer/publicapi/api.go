@@ -0,0 +1,136 @@
+// Package publicapi is the customer module's contract for other modules.
+// Other modules depend on this package only, never on customer internals.
+package publicapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// Customer statuses exposed to other modules
+const (
+	StatusProspect = "prospect"
+	StatusActive   = "active"
+	StatusInactive = "inactive"
+	StatusChurned  = "churned"
+	StatusDeleted  = "deleted"
+)
+
+var (
+	// ErrCustomerNotFound is returned when no customer exists with the given ID
+	ErrCustomerNotFound = errors.New("customer not found")
+	// ErrUnavailable is returned when the customer module is not enabled or not initialized
+	ErrUnavailable = errors.New("customer module is not available")
+)
+
+// Customer is the customer summary shared with other modules
+type Customer struct {
+	ID       string `json:"id"`
+	TenantID string `json:"tenant_id,omitempty"` // the tenant the customer belongs to, empty without multi-tenancy
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Status   string `json:"status"`
+	Locale   string `json:"locale,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// IsActive checks if the customer is active
+func (c *Customer) IsActive() bool {
+	return c.Status == StatusActive
+}
+
+// IsDeleted checks if the customer is deleted
+func (c *Customer) IsDeleted() bool {
+	return c.Status == StatusDeleted
+}
+
+// ModuleName is the name the customer module registers its public API under
+const ModuleName = "customer"
+
+// PublicAPI is the customer module's public API
+// Calls whose context serves a tenant (domain.WithTenantID) only see the customers of that tenant;
+// callers pass the context of the request or event they serve
+type PublicAPI interface {
+	// GetCustomer returns a customer by ID, including deleted customers
+	GetCustomer(ctx context.Context, id string) (*Customer, error)
+
+	// CustomerExists checks if a non-deleted customer exists
+	CustomerExists(ctx context.Context, id string) (bool, error)
+}
+
+// Register makes the customer API available to other modules
+// Called by the customer module during initialization
+func Register(apis *shareddomain.PublicAPIRegistry, api PublicAPI) error {
+	return apis.Register(ModuleName, api)
+}
+
+// GetSummaryRequest asks the request bus for the summary of a customer
+type GetSummaryRequest struct {
+	ID string `json:"id"`
+}
+
+// GetSummary answers the summary of a customer, including deleted customers, on the request bus
+// An unknown customer is answered with a NOT_FOUND domain error wrapping ErrCustomerNotFound
+var GetSummary = shareddomain.NewEndpoint[GetSummaryRequest, *Customer]("customer.GetSummary")
+
+// HandleRequests answers the customer endpoints of the request bus with api
+// Called by the customer module during initialization
+func HandleRequests(bus *shareddomain.RequestBus, api PublicAPI) error {
+	return shareddomain.HandleRequest(bus, GetSummary, func(ctx context.Context, request GetSummaryRequest) (*Customer, error) {
+		customer, err := api.GetCustomer(ctx, request.ID)
+		if errors.Is(err, ErrCustomerNotFound) {
+			return nil, shareddomain.DomainError{Code: shareddomain.ErrCodeNotFound, Message: err.Error(), Cause: err}
+		}
+		return customer, err
+	})
+}
+
+// Lookup returns the customer API registered with the module registry
+func Lookup(apis *shareddomain.PublicAPIRegistry) (PublicAPI, error) {
+	if apis == nil {
+		return nil, ErrUnavailable
+	}
+
+	registered, exists := apis.Get(ModuleName)
+	if !exists {
+		return nil, ErrUnavailable
+	}
+
+	api, ok := registered.(PublicAPI)
+	if !ok {
+		return nil, fmt.Errorf("customer public API has unexpected type %T", registered)
+	}
+	return api, nil
+}
+
+// Lazy returns a PublicAPI that resolves the registered implementation on each call,
+// so consumers do not depend on module initialization order
+func Lazy(apis *shareddomain.PublicAPIRegistry) PublicAPI {
+	return lazyAPI{apis: apis}
+}
+
+// lazyAPI delegates to the registered customer API
+type lazyAPI struct {
+	apis *shareddomain.PublicAPIRegistry
+}
+
+// GetCustomer implements PublicAPI
+func (l lazyAPI) GetCustomer(ctx context.Context, id string) (*Customer, error) {
+	api, err := Lookup(l.apis)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetCustomer(ctx, id)
+}
+
+// CustomerExists implements PublicAPI
+func (l lazyAPI) CustomerExists(ctx context.Context, id string) (bool, error) {
+	api, err := Lookup(l.apis)
+	if err != nil {
+		return false, err
+	}
+	return api.CustomerExists(ctx, id)
+}
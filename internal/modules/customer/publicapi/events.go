@@ -0,0 +1,31 @@
+package publicapi
+
+import (
+	"golang_modular_monolith/internal/modules/customer/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// Customer event types other modules may subscribe to
+const (
+	CustomerCreatedEventType = domain.CustomerCreatedEventType
+)
+
+// CustomerCreated is implemented by the customer.created event
+type CustomerCreated interface {
+	shareddomain.DomainEvent
+
+	// GetCustomerID returns the ID of the created customer
+	GetCustomerID() string
+
+	// GetName returns the full name of the customer
+	GetName() string
+
+	// GetEmail returns the email address of the customer
+	GetEmail() string
+
+	// GetLocale returns the BCP 47 language tag the customer prefers, empty when not set
+	GetLocale() string
+}
+
+// The customer.created event published by the customer module implements CustomerCreated
+var _ CustomerCreated = domain.CustomerCreatedEvent{}
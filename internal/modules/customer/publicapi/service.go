@@ -0,0 +1,60 @@
+package publicapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/customer/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// Service implements PublicAPI on top of the customer read model
+type Service struct {
+	queryRepo domain.CustomerQueryRepository
+}
+
+// NewService creates a new customer public API service
+func NewService(queryRepo domain.CustomerQueryRepository) *Service {
+	return &Service{
+		queryRepo: queryRepo,
+	}
+}
+
+// GetCustomer returns a customer by ID, including deleted customers
+func (s *Service) GetCustomer(ctx context.Context, id string) (*Customer, error) {
+	view, err := s.queryRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, shareddomain.ErrNotFound) {
+			return nil, ErrCustomerNotFound
+		}
+		return nil, fmt.Errorf("failed to get customer: %w", err)
+	}
+	// Never hand out a customer of another tenant, whichever repository answered
+	if tenantID, ok := shareddomain.TenantIDFromContext(ctx); ok && view.TenantID != tenantID {
+		return nil, ErrCustomerNotFound
+	}
+
+	return &Customer{
+		ID:       view.ID,
+		TenantID: view.TenantID,
+		Name:     view.Name,
+		Email:    view.Email,
+		Status:   string(view.Status),
+		Locale:   view.Locale,
+		Timezone: view.Timezone,
+	}, nil
+}
+
+// CustomerExists checks if a non-deleted customer exists
+func (s *Service) CustomerExists(ctx context.Context, id string) (bool, error) {
+	customer, err := s.GetCustomer(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrCustomerNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return !customer.IsDeleted(), nil
+}
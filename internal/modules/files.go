@@ -0,0 +1,6 @@
+//go:build !no_files
+
+package modules
+
+// Excluded from builds tagged no_files
+import _ "golang_modular_monolith/internal/modules/files"
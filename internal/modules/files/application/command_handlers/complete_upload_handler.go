@@ -0,0 +1,64 @@
+package commandhandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/files/application/commands"
+	"golang_modular_monolith/internal/modules/files/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CompleteUploadHandler handles CompleteUploadCommand
+type CompleteUploadHandler struct {
+	repo     domain.FileRepository
+	storage  domain.Storage
+	eventBus shareddomain.EventBus
+}
+
+// NewCompleteUploadHandler creates a new CompleteUploadHandler
+func NewCompleteUploadHandler(repo domain.FileRepository, storage domain.Storage, eventBus shareddomain.EventBus) *CompleteUploadHandler {
+	return &CompleteUploadHandler{
+		repo:     repo,
+		storage:  storage,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the CompleteUploadCommand
+// The upload is checked against storage; completing an uploaded file again returns it unchanged
+func (h *CompleteUploadHandler) Handle(ctx context.Context, cmd *commands.CompleteUploadCommand) (*commands.FileResult, error) {
+	file, err := loadFile(ctx, h.repo, cmd.FileID)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.CheckAccess(cmd.Requester); err != nil {
+		return nil, err
+	}
+	if file.Status == domain.FileStatusUploaded {
+		return toFileResult(file), nil
+	}
+
+	object, err := h.storage.Stat(ctx, file.StorageKey)
+	if err != nil {
+		if errors.Is(err, domain.ErrObjectNotFound) {
+			return nil, shareddomain.NewBusinessRuleError(
+				"file_not_uploaded",
+				fmt.Sprintf("the content of file %s has not been uploaded", file.GetID()),
+			)
+		}
+		return nil, fmt.Errorf("failed to check file content: %w", err)
+	}
+
+	// Content uploaded directly to storage is not hashed
+	if err := file.MarkUploaded(object.Size, ""); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, file); err != nil {
+		return nil, err
+	}
+
+	return toFileResult(file), nil
+}
@@ -0,0 +1,64 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/files/application/commands"
+	"golang_modular_monolith/internal/modules/files/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateUploadHandler handles CreateUploadCommand
+type CreateUploadHandler struct {
+	repo     domain.FileRepository
+	links    domain.Links
+	targets  domain.AttachmentTargets
+	policy   domain.UploadPolicy
+	eventBus shareddomain.EventBus
+}
+
+// NewCreateUploadHandler creates a new CreateUploadHandler
+func NewCreateUploadHandler(
+	repo domain.FileRepository,
+	links domain.Links,
+	targets domain.AttachmentTargets,
+	policy domain.UploadPolicy,
+	eventBus shareddomain.EventBus,
+) *CreateUploadHandler {
+	return &CreateUploadHandler{
+		repo:     repo,
+		links:    links,
+		targets:  targets,
+		policy:   policy,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the CreateUploadCommand
+// The file stays pending until its content is uploaded to the returned link and the upload completed
+func (h *CreateUploadHandler) Handle(ctx context.Context, cmd *commands.CreateUploadCommand) (*commands.FileResult, error) {
+	file, err := domain.NewFile(cmd.Name, cmd.ContentType, cmd.Size, cmd.Owner, domain.AttachmentType(cmd.AttachedToType), cmd.AttachedToID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.policy.Check(file.ContentType, file.Size); err != nil {
+		return nil, err
+	}
+	if err := checkAttachmentTarget(ctx, h.targets, file.AttachedToType, file.AttachedToID); err != nil {
+		return nil, err
+	}
+
+	upload, err := h.links.UploadURL(ctx, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign upload link: %w", err)
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, file); err != nil {
+		return nil, err
+	}
+
+	result := toFileResult(file)
+	result.Upload = upload
+	return result, nil
+}
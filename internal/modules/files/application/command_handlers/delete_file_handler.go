@@ -0,0 +1,48 @@
+package commandhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/files/application/commands"
+	"golang_modular_monolith/internal/modules/files/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// DeleteFileHandler handles DeleteFileCommand
+type DeleteFileHandler struct {
+	repo     domain.FileRepository
+	storage  domain.Storage
+	eventBus shareddomain.EventBus
+}
+
+// NewDeleteFileHandler creates a new DeleteFileHandler
+func NewDeleteFileHandler(repo domain.FileRepository, storage domain.Storage, eventBus shareddomain.EventBus) *DeleteFileHandler {
+	return &DeleteFileHandler{
+		repo:     repo,
+		storage:  storage,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the DeleteFileCommand
+// The metadata is kept as deleted and the content removed from storage
+func (h *DeleteFileHandler) Handle(ctx context.Context, cmd *commands.DeleteFileCommand) (*commands.FileResult, error) {
+	file, err := loadFile(ctx, h.repo, cmd.FileID)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.CheckAccess(cmd.Requester); err != nil {
+		return nil, err
+	}
+
+	if err := file.Delete(); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, file); err != nil {
+		return nil, err
+	}
+	removeContent(ctx, h.storage, file)
+
+	return toFileResult(file), nil
+}
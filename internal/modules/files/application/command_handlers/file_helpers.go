@@ -0,0 +1,162 @@
+package commandhandlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"golang_modular_monolith/internal/modules/files/application/commands"
+	"golang_modular_monolith/internal/modules/files/domain"
+	"golang_modular_monolith/internal/shared/application"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"go.uber.org/zap"
+)
+
+// loadFile retrieves a file by ID or returns a not found domain error
+// Deleted files are not found
+func loadFile(ctx context.Context, repo domain.FileRepository, fileID string) (*domain.File, error) {
+	if fileID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"file ID is required",
+		)
+	}
+
+	file, err := repo.GetByID(ctx, fileID)
+	if err != nil && !shareddomain.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	if err != nil || file.Status == domain.FileStatusDeleted {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeNotFound,
+			fmt.Sprintf("file with ID %s not found", fileID),
+		)
+	}
+
+	return file, nil
+}
+
+// checkAttachmentTarget returns a not found domain error unless the aggregate a file is attached to exists
+func checkAttachmentTarget(ctx context.Context, targets domain.AttachmentTargets, attachmentType domain.AttachmentType, id string) error {
+	if !attachmentType.IsValid() {
+		return shareddomain.NewValidationErrorWithValue("attached_to_type", "attached_to_type must be customer or order", string(attachmentType))
+	}
+
+	exists, err := targets.Exists(ctx, attachmentType, id)
+	if err != nil {
+		return fmt.Errorf("failed to check %s %s: %w", attachmentType, id, err)
+	}
+	if !exists {
+		return shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeNotFound,
+			fmt.Sprintf("%s with ID %s not found", attachmentType, id),
+			"attached_to_id",
+		)
+	}
+	return nil
+}
+
+// storeContent stores the content of a pending file, which must hold exactly the announced size,
+// and records it uploaded with its checksum
+func storeContent(ctx context.Context, storage domain.Storage, file *domain.File, content io.Reader) error {
+	counted := &countingReader{reader: io.LimitReader(content, file.Size)}
+	hash := sha256.New()
+
+	if err := storage.Put(ctx, file.StorageKey, io.TeeReader(counted, hash), file.Size, file.ContentType); err != nil {
+		if counted.err != nil {
+			return fmt.Errorf("failed to read file content: %w", counted.err)
+		}
+		if counted.read < file.Size {
+			return sizeMismatch(file)
+		}
+		return fmt.Errorf("failed to store file content: %w", err)
+	}
+
+	// Content shorter or longer than announced is removed again
+	var extra [1]byte
+	if _, err := io.ReadFull(content, extra[:]); counted.read != file.Size || err == nil {
+		removeContent(ctx, storage, file)
+		return sizeMismatch(file)
+	}
+
+	return file.MarkUploaded(counted.read, hex.EncodeToString(hash.Sum(nil)))
+}
+
+// sizeMismatch reports content of another size than the file was announced with
+func sizeMismatch(file *domain.File) error {
+	return shareddomain.NewBusinessRuleError(
+		"file_size_mismatch",
+		fmt.Sprintf("file %s was announced with %d bytes; the content must hold exactly that many", file.GetID(), file.Size),
+	)
+}
+
+// removeContent deletes the content of a file from storage, logging failures
+// Orphaned content only costs storage, so it does not fail the command
+func removeContent(ctx context.Context, storage domain.Storage, file *domain.File) {
+	if err := storage.Delete(ctx, file.StorageKey); err != nil {
+		zap.L().Warn("failed to delete file content",
+			zap.String("file_id", file.GetID()),
+			zap.String("storage", storage.Name()),
+			zap.Error(err),
+		)
+	}
+}
+
+// countingReader counts the bytes read through it and keeps the first read error other than io.EOF
+type countingReader struct {
+	reader io.Reader
+	read   int64
+	err    error
+}
+
+// Read implements io.Reader
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	if err != nil && err != io.EOF && r.err == nil {
+		r.err = err
+	}
+	return n, err
+}
+
+// saveAndPublish persists a changed file and publishes its uncommitted events
+func saveAndPublish(ctx context.Context, repo domain.FileRepository, eventBus shareddomain.EventBus, file *domain.File) error {
+	// Capture events before the repository clears them on save
+	events := file.GetUncommittedEvents()
+
+	if err := repo.Save(ctx, file); err != nil {
+		return fmt.Errorf("failed to save file: %w", err)
+	}
+
+	for _, event := range events {
+		if err := application.PublishEvents(ctx, eventBus, event); err != nil {
+			// The file is saved; a lost event does not undo the upload
+			zap.L().Warn("failed to publish file event",
+				zap.String("event_type", event.GetEventType()),
+				zap.String("file_id", file.GetID()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// toFileResult converts a file to a command result
+func toFileResult(file *domain.File) *commands.FileResult {
+	return &commands.FileResult{
+		ID:             file.GetID(),
+		Name:           file.Name,
+		ContentType:    file.ContentType,
+		Size:           file.Size,
+		Checksum:       file.Checksum,
+		AttachedToType: string(file.AttachedToType),
+		AttachedToID:   file.AttachedToID,
+		Status:         string(file.Status),
+		UploadedAt:     file.UploadedAt,
+		CreatedAt:      file.GetCreatedAt(),
+	}
+}
@@ -0,0 +1,61 @@
+package commandhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/files/application/commands"
+	"golang_modular_monolith/internal/modules/files/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// UploadFileHandler handles UploadFileCommand
+type UploadFileHandler struct {
+	repo     domain.FileRepository
+	storage  domain.Storage
+	targets  domain.AttachmentTargets
+	policy   domain.UploadPolicy
+	eventBus shareddomain.EventBus
+}
+
+// NewUploadFileHandler creates a new UploadFileHandler
+func NewUploadFileHandler(
+	repo domain.FileRepository,
+	storage domain.Storage,
+	targets domain.AttachmentTargets,
+	policy domain.UploadPolicy,
+	eventBus shareddomain.EventBus,
+) *UploadFileHandler {
+	return &UploadFileHandler{
+		repo:     repo,
+		storage:  storage,
+		targets:  targets,
+		policy:   policy,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the UploadFileCommand
+// The content is stored before the file is saved, so a failed upload leaves no metadata behind
+func (h *UploadFileHandler) Handle(ctx context.Context, cmd *commands.UploadFileCommand) (*commands.FileResult, error) {
+	file, err := domain.NewFile(cmd.Name, cmd.ContentType, cmd.Size, cmd.Owner, domain.AttachmentType(cmd.AttachedToType), cmd.AttachedToID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.policy.Check(file.ContentType, file.Size); err != nil {
+		return nil, err
+	}
+	if err := checkAttachmentTarget(ctx, h.targets, file.AttachedToType, file.AttachedToID); err != nil {
+		return nil, err
+	}
+
+	if err := storeContent(ctx, h.storage, file, cmd.Content); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, file); err != nil {
+		removeContent(ctx, h.storage, file)
+		return nil, err
+	}
+
+	return toFileResult(file), nil
+}
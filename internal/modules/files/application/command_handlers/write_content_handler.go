@@ -0,0 +1,50 @@
+package commandhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/files/application/commands"
+	"golang_modular_monolith/internal/modules/files/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// WriteContentHandler handles WriteContentCommand
+type WriteContentHandler struct {
+	repo     domain.FileRepository
+	storage  domain.Storage
+	eventBus shareddomain.EventBus
+}
+
+// NewWriteContentHandler creates a new WriteContentHandler
+func NewWriteContentHandler(repo domain.FileRepository, storage domain.Storage, eventBus shareddomain.EventBus) *WriteContentHandler {
+	return &WriteContentHandler{
+		repo:     repo,
+		storage:  storage,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the WriteContentCommand
+// The caller verified the signed link; storing the content completes the upload
+func (h *WriteContentHandler) Handle(ctx context.Context, cmd *commands.WriteContentCommand) (*commands.FileResult, error) {
+	file, err := loadFile(ctx, h.repo, cmd.FileID)
+	if err != nil {
+		return nil, err
+	}
+	if file.Status != domain.FileStatusPending {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidState,
+			"the content of file "+file.GetID()+" was already uploaded",
+		)
+	}
+
+	if err := storeContent(ctx, h.storage, file, cmd.Content); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, file); err != nil {
+		return nil, err
+	}
+
+	return toFileResult(file), nil
+}
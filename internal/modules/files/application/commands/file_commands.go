@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"io"
+	"time"
+
+	"golang_modular_monolith/internal/modules/files/domain"
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// UploadFileCommand represents a command to store a file uploaded through the API in one request
+type UploadFileCommand struct {
+	application.BaseCommand
+	Name           string `json:"name" validate:"required,max=255"`
+	ContentType    string `json:"content_type"`
+	Size           int64  `json:"size" validate:"required,gt=0"`
+	AttachedToType string `json:"attached_to_type" validate:"required,oneof=customer order"`
+	AttachedToID   string `json:"attached_to_id" validate:"required"`
+	Owner          string `json:"owner" validate:"required"`
+
+	// Content is read up to Size bytes; the command fails when it holds more or less
+	Content io.Reader `json:"-"`
+}
+
+// NewUploadFileCommand creates a new upload file command
+func NewUploadFileCommand(name, contentType string, size int64, attachedToType, attachedToID, owner string, content io.Reader) UploadFileCommand {
+	return UploadFileCommand{
+		BaseCommand:    application.NewBaseCommand("upload_file"),
+		Name:           name,
+		ContentType:    contentType,
+		Size:           size,
+		AttachedToType: attachedToType,
+		AttachedToID:   attachedToID,
+		Owner:          owner,
+		Content:        content,
+	}
+}
+
+// CreateUploadCommand represents a command to announce a file whose content the client uploads
+// to a signed link
+type CreateUploadCommand struct {
+	application.BaseCommand
+	Name           string `json:"name" validate:"required,max=255"`
+	ContentType    string `json:"content_type"`
+	Size           int64  `json:"size" validate:"required,gt=0"`
+	AttachedToType string `json:"attached_to_type" validate:"required,oneof=customer order"`
+	AttachedToID   string `json:"attached_to_id" validate:"required"`
+	Owner          string `json:"owner" validate:"required"`
+}
+
+// NewCreateUploadCommand creates a new create upload command
+func NewCreateUploadCommand(name, contentType string, size int64, attachedToType, attachedToID, owner string) CreateUploadCommand {
+	return CreateUploadCommand{
+		BaseCommand:    application.NewBaseCommand("create_upload"),
+		Name:           name,
+		ContentType:    contentType,
+		Size:           size,
+		AttachedToType: attachedToType,
+		AttachedToID:   attachedToID,
+		Owner:          owner,
+	}
+}
+
+// CompleteUploadCommand represents a command to confirm the content of a file was uploaded to
+// its signed link
+type CompleteUploadCommand struct {
+	application.BaseCommand
+	FileID    string           `json:"file_id" validate:"required"`
+	Requester domain.Requester `json:"-"`
+}
+
+// NewCompleteUploadCommand creates a new complete upload command
+func NewCompleteUploadCommand(fileID string, requester domain.Requester) CompleteUploadCommand {
+	return CompleteUploadCommand{
+		BaseCommand: application.NewBaseCommand("complete_upload"),
+		FileID:      fileID,
+		Requester:   requester,
+	}
+}
+
+// WriteContentCommand represents a command to store the content of a pending file received on
+// its signed upload link
+type WriteContentCommand struct {
+	application.BaseCommand
+	FileID  string    `json:"file_id" validate:"required"`
+	Content io.Reader `json:"-"`
+}
+
+// NewWriteContentCommand creates a new write content command
+func NewWriteContentCommand(fileID string, content io.Reader) WriteContentCommand {
+	return WriteContentCommand{
+		BaseCommand: application.NewBaseCommand("write_file_content"),
+		FileID:      fileID,
+		Content:     content,
+	}
+}
+
+// DeleteFileCommand represents a command to delete a file and its content
+type DeleteFileCommand struct {
+	application.BaseCommand
+	FileID    string           `json:"file_id" validate:"required"`
+	Requester domain.Requester `json:"-"`
+}
+
+// NewDeleteFileCommand creates a new delete file command
+func NewDeleteFileCommand(fileID string, requester domain.Requester) DeleteFileCommand {
+	return DeleteFileCommand{
+		BaseCommand: application.NewBaseCommand("delete_file"),
+		FileID:      fileID,
+		Requester:   requester,
+	}
+}
+
+// FileResult represents the result of a file command
+type FileResult struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	ContentType    string     `json:"content_type"`
+	Size           int64      `json:"size"`
+	Checksum       string     `json:"checksum,omitempty"`
+	AttachedToType string     `json:"attached_to_type"`
+	AttachedToID   string     `json:"attached_to_id"`
+	Status         string     `json:"status"`
+	UploadedAt     *time.Time `json:"uploaded_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+
+	// Upload is the link the content of a pending file is uploaded to
+	Upload *domain.SignedURL `json:"upload,omitempty"`
+}
@@ -0,0 +1,64 @@
+package queries
+
+import (
+	"io"
+
+	"golang_modular_monolith/internal/modules/files/domain"
+)
+
+// GetFileQuery represents a query to get a file by ID
+type GetFileQuery struct {
+	ID        string           `json:"id"`
+	Requester domain.Requester `json:"-"`
+}
+
+// GetFileResult represents the result of GetFileQuery
+type GetFileResult struct {
+	File *domain.File `json:"file"`
+
+	// Download is the link the content is downloaded from, set once the file is uploaded
+	Download *domain.SignedURL `json:"download,omitempty"`
+}
+
+// ListFilesQuery represents a query to page through files, newest first
+// Requesters who do not manage every file only list the files they own
+type ListFilesQuery struct {
+	Page           int              `json:"page"`
+	Limit          int              `json:"limit"`
+	AttachedToType string           `json:"attached_to_type"`
+	AttachedToID   string           `json:"attached_to_id"`
+	Status         string           `json:"status"`
+	Requester      domain.Requester `json:"-"`
+}
+
+// ToParams converts the query to repository parameters
+func (q *ListFilesQuery) ToParams() domain.ListFilesParams {
+	params := domain.ListFilesParams{
+		Page:           q.Page,
+		Limit:          q.Limit,
+		AttachedToType: domain.AttachmentType(q.AttachedToType),
+		AttachedToID:   q.AttachedToID,
+		Status:         domain.FileStatus(q.Status),
+	}
+	if !q.Requester.Manager {
+		params.Owner = q.Requester.Actor
+	}
+	return params
+}
+
+// ListFilesResult represents the result of ListFilesQuery
+type ListFilesResult struct {
+	Files      []*domain.File          `json:"files"`
+	Pagination domain.PaginationResult `json:"pagination"`
+}
+
+// OpenFileQuery represents a query to read the content of a file through its signed download link
+type OpenFileQuery struct {
+	ID string `json:"id"`
+}
+
+// OpenFileResult represents the result of OpenFileQuery; the caller closes Content
+type OpenFileResult struct {
+	File    *domain.File
+	Content io.ReadCloser
+}
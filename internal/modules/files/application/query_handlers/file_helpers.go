@@ -0,0 +1,25 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/files/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// loadFile retrieves a file by ID or returns a not found domain error
+// Deleted files are not found
+func loadFile(ctx context.Context, repo domain.FileRepository, fileID string) (*domain.File, error) {
+	file, err := repo.GetByID(ctx, fileID)
+	if err != nil && !shareddomain.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	if err != nil || file.Status == domain.FileStatusDeleted {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeNotFound,
+			fmt.Sprintf("file with ID %s not found", fileID),
+		)
+	}
+	return file, nil
+}
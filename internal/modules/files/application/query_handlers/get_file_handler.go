@@ -0,0 +1,42 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/files/application/queries"
+	"golang_modular_monolith/internal/modules/files/domain"
+)
+
+// GetFileHandler handles GetFileQuery
+type GetFileHandler struct {
+	repo  domain.FileRepository
+	links domain.Links
+}
+
+// NewGetFileHandler creates a new GetFileHandler
+func NewGetFileHandler(repo domain.FileRepository, links domain.Links) *GetFileHandler {
+	return &GetFileHandler{
+		repo:  repo,
+		links: links,
+	}
+}
+
+// Handle handles the GetFileQuery
+func (h *GetFileHandler) Handle(ctx context.Context, query *queries.GetFileQuery) (*queries.GetFileResult, error) {
+	file, err := loadFile(ctx, h.repo, query.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.CheckAccess(query.Requester); err != nil {
+		return nil, err
+	}
+
+	result := &queries.GetFileResult{File: file}
+	if file.IsDownloadable() {
+		if result.Download, err = h.links.DownloadURL(ctx, file); err != nil {
+			return nil, fmt.Errorf("failed to sign download link: %w", err)
+		}
+	}
+	return result, nil
+}
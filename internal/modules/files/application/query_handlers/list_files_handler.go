@@ -0,0 +1,39 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/files/application/queries"
+	"golang_modular_monolith/internal/modules/files/domain"
+)
+
+// ListFilesHandler handles ListFilesQuery
+type ListFilesHandler struct {
+	repo domain.FileRepository
+}
+
+// NewListFilesHandler creates a new ListFilesHandler
+func NewListFilesHandler(repo domain.FileRepository) *ListFilesHandler {
+	return &ListFilesHandler{
+		repo: repo,
+	}
+}
+
+// Handle handles the ListFilesQuery
+func (h *ListFilesHandler) Handle(ctx context.Context, query *queries.ListFilesQuery) (*queries.ListFilesResult, error) {
+	params := query.ToParams()
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := h.repo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+
+	return &queries.ListFilesResult{
+		Files:      result.Files,
+		Pagination: result.Pagination,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package queryhandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/files/application/queries"
+	"golang_modular_monolith/internal/modules/files/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// OpenFileHandler handles OpenFileQuery
+type OpenFileHandler struct {
+	repo    domain.FileRepository
+	storage domain.Storage
+}
+
+// NewOpenFileHandler creates a new OpenFileHandler
+func NewOpenFileHandler(repo domain.FileRepository, storage domain.Storage) *OpenFileHandler {
+	return &OpenFileHandler{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+// Handle handles the OpenFileQuery
+// The caller verified the signed link, which stands in for ownership
+func (h *OpenFileHandler) Handle(ctx context.Context, query *queries.OpenFileQuery) (*queries.OpenFileResult, error) {
+	file, err := loadFile(ctx, h.repo, query.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !file.IsDownloadable() {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidState,
+			fmt.Sprintf("the content of file %s has not been uploaded", file.GetID()),
+		)
+	}
+
+	content, err := h.storage.Open(ctx, file.StorageKey)
+	if err != nil {
+		if errors.Is(err, domain.ErrObjectNotFound) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("the content of file %s is missing from storage", file.GetID()),
+			)
+		}
+		return nil, fmt.Errorf("failed to open file content: %w", err)
+	}
+
+	return &queries.OpenFileResult{File: file, Content: content}, nil
+}
@@ -0,0 +1,55 @@
+package files
+
+import (
+	commandhandlers "golang_modular_monolith/internal/modules/files/application/command_handlers"
+	queryhandlers "golang_modular_monolith/internal/modules/files/application/query_handlers"
+	filesdomain "golang_modular_monolith/internal/modules/files/domain"
+	filesdb "golang_modular_monolith/internal/modules/files/infrastructure/database"
+	"golang_modular_monolith/internal/modules/files/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/files/infrastructure/persistence"
+	"golang_modular_monolith/internal/modules/files/infrastructure/storage"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/authz"
+	"golang_modular_monolith/internal/shared/infrastructure/di"
+)
+
+// newContainer registers the constructors of the files module's repositories and handlers;
+// nothing is constructed until it is resolved, so callers may replace providers first
+func newContainer(
+	eventBus domain.EventBus,
+	store filesdomain.Storage,
+	links *storage.Links,
+	targets filesdomain.AttachmentTargets,
+	policy filesdomain.UploadPolicy,
+	authorizer authz.Authorizer,
+) *di.Container {
+	c := di.New()
+	di.Value(c, eventBus)
+	di.Value(c, store)
+	di.Value(c, links)
+	di.Value[filesdomain.Links](c, links)
+	di.Value(c, targets)
+	di.Value(c, policy)
+	di.Value(c, authorizer)
+	c.Provide(filesdb.GetFilesDB)
+
+	// Repositories
+	c.Provide(persistence.NewPostgreSQLFileRepository, di.As[filesdomain.FileRepository]())
+
+	// Command handlers
+	c.Provide(commandhandlers.NewUploadFileHandler)
+	c.Provide(commandhandlers.NewCreateUploadHandler)
+	c.Provide(commandhandlers.NewCompleteUploadHandler)
+	c.Provide(commandhandlers.NewWriteContentHandler)
+	c.Provide(commandhandlers.NewDeleteFileHandler)
+
+	// Query handlers
+	c.Provide(queryhandlers.NewGetFileHandler)
+	c.Provide(queryhandlers.NewListFilesHandler)
+	c.Provide(queryhandlers.NewOpenFileHandler)
+
+	// HTTP handlers
+	c.Provide(handlers.NewFileHandler)
+	return c
+}
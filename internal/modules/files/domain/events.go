@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"golang_modular_monolith/internal/modules/files/publicapi"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// File domain event types
+const (
+	FileUploadedEventType = publicapi.FileUploadedEventType
+	FileDeletedEventType  = publicapi.FileDeletedEventType
+)
+
+// FileUploadedEvent represents the event when the content of a file is stored
+type FileUploadedEvent struct {
+	domain.BaseDomainEvent
+	FileID         string `json:"file_id"`
+	Name           string `json:"name"`
+	ContentType    string `json:"content_type"`
+	Size           int64  `json:"size"`
+	AttachedToType string `json:"attached_to_type"`
+	AttachedToID   string `json:"attached_to_id"`
+}
+
+// NewFileUploadedEvent creates a new file uploaded event
+func NewFileUploadedEvent(file *File) FileUploadedEvent {
+	eventData := map[string]interface{}{
+		"file_id":          file.GetID(),
+		"name":             file.Name,
+		"content_type":     file.ContentType,
+		"size":             file.Size,
+		"attached_to_type": file.AttachedToType,
+		"attached_to_id":   file.AttachedToID,
+	}
+
+	return FileUploadedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			file.GetID(),
+			"file",
+			FileUploadedEventType,
+			eventData,
+		),
+		FileID:         file.GetID(),
+		Name:           file.Name,
+		ContentType:    file.ContentType,
+		Size:           file.Size,
+		AttachedToType: string(file.AttachedToType),
+		AttachedToID:   file.AttachedToID,
+	}
+}
+
+// GetFileID returns the ID of the file
+func (e FileUploadedEvent) GetFileID() string {
+	return e.FileID
+}
+
+// GetAttachedTo returns the type and ID of the aggregate the file is attached to
+func (e FileUploadedEvent) GetAttachedTo() (string, string) {
+	return e.AttachedToType, e.AttachedToID
+}
+
+// FileDeletedEvent represents the event when a file is deleted
+type FileDeletedEvent struct {
+	domain.BaseDomainEvent
+	FileID         string `json:"file_id"`
+	AttachedToType string `json:"attached_to_type"`
+	AttachedToID   string `json:"attached_to_id"`
+}
+
+// NewFileDeletedEvent creates a new file deleted event
+func NewFileDeletedEvent(file *File) FileDeletedEvent {
+	eventData := map[string]interface{}{
+		"file_id":          file.GetID(),
+		"attached_to_type": file.AttachedToType,
+		"attached_to_id":   file.AttachedToID,
+	}
+
+	return FileDeletedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			file.GetID(),
+			"file",
+			FileDeletedEventType,
+			eventData,
+		),
+		FileID:         file.GetID(),
+		AttachedToType: string(file.AttachedToType),
+		AttachedToID:   file.AttachedToID,
+	}
+}
+
+// GetFileID returns the ID of the file
+func (e FileDeletedEvent) GetFileID() string {
+	return e.FileID
+}
+
+// GetAttachedTo returns the type and ID of the aggregate the file is attached to
+func (e FileDeletedEvent) GetAttachedTo() (string, string) {
+	return e.AttachedToType, e.AttachedToID
+}
+
+var (
+	_ publicapi.FileEvent = FileUploadedEvent{}
+	_ publicapi.FileEvent = FileDeletedEvent{}
+)
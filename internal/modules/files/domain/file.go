@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/modules/files/publicapi"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// FileStatus represents the upload status of a file
+type FileStatus string
+
+const (
+	// FileStatusPending means the file was announced and its content is being uploaded
+	FileStatusPending FileStatus = "pending"
+	// FileStatusUploaded means the content is stored and can be downloaded
+	FileStatusUploaded FileStatus = "uploaded"
+	// FileStatusDeleted means the file was deleted; its content is removed from storage
+	FileStatusDeleted FileStatus = "deleted"
+)
+
+// IsValid checks if the file status is known
+func (s FileStatus) IsValid() bool {
+	switch s {
+	case FileStatusPending, FileStatusUploaded, FileStatusDeleted:
+		return true
+	}
+	return false
+}
+
+// AttachmentType is the type of aggregate a file is attached to
+type AttachmentType string
+
+const (
+	AttachedToCustomer AttachmentType = publicapi.AttachedToCustomer
+	AttachedToOrder    AttachmentType = publicapi.AttachedToOrder
+)
+
+// IsValid checks if files can be attached to the aggregate type
+func (t AttachmentType) IsValid() bool {
+	return t == AttachedToCustomer || t == AttachedToOrder
+}
+
+// PermissionManageFiles lets a principal read and delete every file, not only those it uploaded
+const PermissionManageFiles = "files:manage"
+
+// maxNameLength bounds file names
+const maxNameLength = 255
+
+// File is the metadata of an uploaded file, attached to a customer or an order
+// The content lives in storage under StorageKey; Owner is the actor who uploaded the file
+type File struct {
+	domain.BaseAggregateRoot
+	Name           string         `json:"name"`
+	ContentType    string         `json:"content_type"`
+	Size           int64          `json:"size"`
+	Checksum       string         `json:"checksum,omitempty"`
+	StorageKey     string         `json:"-"`
+	Owner          string         `json:"owner"`
+	AttachedToType AttachmentType `json:"attached_to_type"`
+	AttachedToID   string         `json:"attached_to_id"`
+	Status         FileStatus     `json:"status"`
+	UploadedAt     *time.Time     `json:"uploaded_at,omitempty"`
+}
+
+// NewFile creates a pending file whose content of size bytes is stored under a new key
+func NewFile(name, contentType string, size int64, owner string, attachedToType AttachmentType, attachedToID string) (*File, error) {
+	// Validate input
+	var validationErrors domain.ValidationErrors
+
+	name = sanitizeName(name)
+	if name == "" {
+		validationErrors.Add("name", "name is required")
+	} else if len(name) > maxNameLength {
+		validationErrors.AddWithValue("name", fmt.Sprintf("name must be at most %d characters", maxNameLength), name)
+	}
+
+	contentType = strings.TrimSpace(contentType)
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	if _, _, err := mime.ParseMediaType(contentType); err != nil {
+		validationErrors.AddWithValue("content_type", "content_type must be a media type", contentType)
+	}
+
+	if size <= 0 {
+		validationErrors.AddWithValue("size", "size must be positive", size)
+	}
+
+	if owner == "" {
+		validationErrors.Add("owner", "owner is required")
+	}
+
+	if !attachedToType.IsValid() {
+		validationErrors.AddWithValue("attached_to_type", "attached_to_type must be customer or order", attachedToType)
+	}
+	if strings.TrimSpace(attachedToID) == "" {
+		validationErrors.Add("attached_to_id", "attached_to_id is required")
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	file := &File{
+		BaseAggregateRoot: domain.NewBaseAggregateRoot(),
+		Name:              name,
+		ContentType:       contentType,
+		Size:              size,
+		Owner:             owner,
+		AttachedToType:    attachedToType,
+		AttachedToID:      strings.TrimSpace(attachedToID),
+		Status:            FileStatusPending,
+	}
+	// Keys are generated, never derived from the client's file name
+	file.StorageKey = path.Join(string(attachedToType), file.GetCreatedAt().UTC().Format("2006/01/02"), file.GetID())
+
+	return file, nil
+}
+
+// MarkUploaded records the content as stored, with its size and SHA-256 checksum when known
+func (f *File) MarkUploaded(size int64, checksum string) error {
+	if f.Status != FileStatusPending {
+		return f.invalidState("uploaded")
+	}
+	if size != f.Size {
+		return domain.NewBusinessRuleError(
+			"file_size_mismatch",
+			fmt.Sprintf("file %s was announced with %d bytes but %d were uploaded", f.GetID(), f.Size, size),
+		)
+	}
+
+	now := time.Now()
+	f.Status = FileStatusUploaded
+	f.Checksum = checksum
+	f.UploadedAt = &now
+	f.IncrementVersion()
+
+	f.AddEvent(NewFileUploadedEvent(f))
+	return nil
+}
+
+// Delete marks the file deleted; its content is removed from storage by the caller
+func (f *File) Delete() error {
+	if f.Status == FileStatusDeleted {
+		return f.invalidState("deleted")
+	}
+
+	f.Status = FileStatusDeleted
+	f.IncrementVersion()
+
+	f.AddEvent(NewFileDeletedEvent(f))
+	return nil
+}
+
+// IsDownloadable checks if the content of the file can be downloaded
+func (f *File) IsDownloadable() bool {
+	return f.Status == FileStatusUploaded
+}
+
+// Requester is the actor asking for a file, and whether it manages every file
+type Requester struct {
+	Actor   string
+	Manager bool
+}
+
+// CheckAccess returns a FORBIDDEN error unless the requester owns the file or manages every file
+func (f *File) CheckAccess(requester Requester) error {
+	if requester.Manager || (requester.Actor != "" && requester.Actor == f.Owner) {
+		return nil
+	}
+	return domain.NewDomainError(
+		domain.ErrCodeForbidden,
+		fmt.Sprintf("file %s belongs to another owner", f.GetID()),
+	)
+}
+
+// invalidState reports a transition the file's status does not allow
+func (f *File) invalidState(to string) error {
+	return domain.NewDomainError(
+		domain.ErrCodeInvalidState,
+		fmt.Sprintf("file %s is %s and cannot be marked %s", f.GetID(), f.Status, to),
+	)
+}
+
+// sanitizeName keeps the base name of a client file name, without control characters
+// Names are shown to users and sent in Content-Disposition headers, never used as paths
+func sanitizeName(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = path.Base(strings.TrimSpace(name))
+	if name == "." || name == "/" {
+		return ""
+	}
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, name)
+}
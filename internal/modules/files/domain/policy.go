@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// UploadPolicy bounds the files that can be uploaded
+type UploadPolicy struct {
+	// MaxSize is the largest file accepted, in bytes
+	MaxSize int64
+	// AllowedContentTypes lists the media types accepted, e.g. "image/*" or "application/pdf";
+	// empty accepts any
+	AllowedContentTypes []string
+}
+
+// Check validates the content type and size of a file to upload
+func (p UploadPolicy) Check(contentType string, size int64) error {
+	var validationErrors domain.ValidationErrors
+
+	if p.MaxSize > 0 && size > p.MaxSize {
+		validationErrors.AddWithValue("size", fmt.Sprintf("size must be at most %d bytes", p.MaxSize), size)
+	}
+	if !p.allows(contentType) {
+		validationErrors.AddWithValue("content_type", "content type is not allowed", contentType)
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+	return nil
+}
+
+// allows checks if a content type matches one of the allowed media types
+func (p UploadPolicy) allows(contentType string) bool {
+	if len(p.AllowedContentTypes) == 0 {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range p.AllowedContentTypes {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed == mediaType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// FileRepository defines the interface for file metadata persistence
+type FileRepository interface {
+	// Save saves a file
+	Save(ctx context.Context, file *File) error
+
+	// GetByID retrieves a file by ID, including deleted files
+	GetByID(ctx context.Context, id string) (*File, error)
+
+	// List retrieves files matching the parameters, newest first, one page at a time
+	// Deleted files are not listed
+	List(ctx context.Context, params ListFilesParams) (*FileListResult, error)
+}
+
+// ListFilesParams represents parameters for listing files
+type ListFilesParams struct {
+	// Pagination
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+
+	// Filtering
+	AttachedToType AttachmentType `json:"attached_to_type,omitempty"`
+	AttachedToID   string         `json:"attached_to_id,omitempty"`
+	Owner          string         `json:"owner,omitempty"`
+	Status         FileStatus     `json:"status,omitempty"`
+}
+
+// Validate applies defaults and validates the list parameters
+func (p *ListFilesParams) Validate() error {
+	if p.Page <= 0 {
+		p.Page = 1
+	}
+
+	if p.Limit <= 0 {
+		p.Limit = 20
+	}
+
+	// Maximum limit
+	if p.Limit > 100 {
+		p.Limit = 100
+	}
+
+	var validationErrors domain.ValidationErrors
+
+	if p.AttachedToType != "" && !p.AttachedToType.IsValid() {
+		validationErrors.AddWithValue("attached_to_type", "attached_to_type must be customer or order", string(p.AttachedToType))
+	}
+
+	if p.Status != "" && (!p.Status.IsValid() || p.Status == FileStatusDeleted) {
+		validationErrors.AddWithValue("status", "status must be pending or uploaded", string(p.Status))
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+	return nil
+}
+
+// FileListResult represents the result of a file list query
+type FileListResult struct {
+	Files      []*File          `json:"files"`
+	Pagination PaginationResult `json:"pagination"`
+}
+
+// PaginationResult represents pagination information
+type PaginationResult struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"total_pages"`
+	HasNext    bool  `json:"has_next"`
+	HasPrev    bool  `json:"has_prev"`
+}
+
+// NewPaginationResult creates a new pagination result
+func NewPaginationResult(page, limit int, total int64) PaginationResult {
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return PaginationResult{
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// ErrObjectNotFound is returned by storages when no object is stored under a key
+var ErrObjectNotFound = errors.New("object not found in storage")
+
+// Object describes stored content
+type Object struct {
+	Size        int64
+	ContentType string
+}
+
+// Storage is implemented by the backends file content is kept in, e.g. local disk and S3
+type Storage interface {
+	// Name returns the storage identifier (e.g. "local")
+	Name() string
+
+	// Put stores size bytes read from body under key, replacing any object stored there
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+
+	// Open returns the content stored under key; the caller closes it
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Stat describes the object stored under key, or returns ErrObjectNotFound
+	Stat(ctx context.Context, key string) (*Object, error)
+
+	// Delete removes the object stored under key; deleting a missing object succeeds
+	Delete(ctx context.Context, key string) error
+}
+
+// SignedURL is a link granting one method on a file's content until it expires, without a token
+type SignedURL struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	// Headers must be sent with the request, e.g. Content-Type on uploads
+	Headers   map[string]string `json:"headers,omitempty"`
+	ExpiresAt time.Time         `json:"expires_at"`
+}
+
+// Links signs the upload and download links of files
+// Links signed within a tenant (shareddomain.WithTenantID) are only valid in that tenant
+type Links interface {
+	// UploadURL returns the link the content of a pending file is uploaded to
+	UploadURL(ctx context.Context, file *File) (*SignedURL, error)
+
+	// DownloadURL returns the link the content of an uploaded file is downloaded from
+	DownloadURL(ctx context.Context, file *File) (*SignedURL, error)
+}
+
+// AttachmentTargets checks the aggregates files are attached to
+type AttachmentTargets interface {
+	// Exists checks if the aggregate of the type exists
+	Exists(ctx context.Context, attachmentType AttachmentType, id string) (bool, error)
+}
@@ -0,0 +1,37 @@
+// Package attachments checks the aggregates of other modules that files are attached to, through
+// their public APIs.
+package attachments
+
+import (
+	"context"
+	"fmt"
+
+	customerapi "golang_modular_monolith/internal/modules/customer/publicapi"
+	"golang_modular_monolith/internal/modules/files/domain"
+	orderapi "golang_modular_monolith/internal/modules/order/publicapi"
+)
+
+// Targets implements domain.AttachmentTargets with the customer and order public APIs
+type Targets struct {
+	customers customerapi.PublicAPI
+	orders    orderapi.PublicAPI
+}
+
+// NewTargets creates attachment targets backed by the customer and order APIs
+func NewTargets(customers customerapi.PublicAPI, orders orderapi.PublicAPI) *Targets {
+	return &Targets{
+		customers: customers,
+		orders:    orders,
+	}
+}
+
+// Exists checks if the customer or order exists; deleted customers do not
+func (t *Targets) Exists(ctx context.Context, attachmentType domain.AttachmentType, id string) (bool, error) {
+	switch attachmentType {
+	case domain.AttachedToCustomer:
+		return t.customers.CustomerExists(ctx, id)
+	case domain.AttachedToOrder:
+		return t.orders.OrderExists(ctx, id)
+	}
+	return false, fmt.Errorf("files cannot be attached to %s", attachmentType)
+}
@@ -0,0 +1,18 @@
+package database
+
+import (
+	"golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// FilesDatabaseName is the identifier for files database
+	FilesDatabaseName = "files"
+)
+
+// GetFilesDB returns the files database connection
+func GetFilesDB() (*gorm.DB, error) {
+	manager := database.GetGlobalManager()
+	return manager.GetConnection(FilesDatabaseName)
+}
@@ -0,0 +1,436 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	commandhandlers "golang_modular_monolith/internal/modules/files/application/command_handlers"
+	"golang_modular_monolith/internal/modules/files/application/commands"
+	"golang_modular_monolith/internal/modules/files/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/files/application/query_handlers"
+	"golang_modular_monolith/internal/modules/files/domain"
+	"golang_modular_monolith/internal/modules/files/infrastructure/storage"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/authz"
+	"golang_modular_monolith/internal/shared/infrastructure/httplimit"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FileHandler handles HTTP requests for file operations
+// Routes other than /files/:id/content require an authenticated principal; the content routes
+// are authorized by the signature of their link instead, so clients can follow them directly
+type FileHandler struct {
+	// Command handlers
+	uploadFileHandler     *commandhandlers.UploadFileHandler
+	createUploadHandler   *commandhandlers.CreateUploadHandler
+	completeUploadHandler *commandhandlers.CompleteUploadHandler
+	writeContentHandler   *commandhandlers.WriteContentHandler
+	deleteFileHandler     *commandhandlers.DeleteFileHandler
+
+	// Query handlers
+	getFileHandler   *queryhandlers.GetFileHandler
+	listFilesHandler *queryhandlers.ListFilesHandler
+	openFileHandler  *queryhandlers.OpenFileHandler
+
+	links      *storage.Links
+	authorizer authz.Authorizer
+}
+
+// NewFileHandler creates a new file handler
+func NewFileHandler(
+	uploadFileHandler *commandhandlers.UploadFileHandler,
+	createUploadHandler *commandhandlers.CreateUploadHandler,
+	completeUploadHandler *commandhandlers.CompleteUploadHandler,
+	writeContentHandler *commandhandlers.WriteContentHandler,
+	deleteFileHandler *commandhandlers.DeleteFileHandler,
+	getFileHandler *queryhandlers.GetFileHandler,
+	listFilesHandler *queryhandlers.ListFilesHandler,
+	openFileHandler *queryhandlers.OpenFileHandler,
+	links *storage.Links,
+	authorizer authz.Authorizer,
+) *FileHandler {
+	return &FileHandler{
+		uploadFileHandler:     uploadFileHandler,
+		createUploadHandler:   createUploadHandler,
+		completeUploadHandler: completeUploadHandler,
+		writeContentHandler:   writeContentHandler,
+		deleteFileHandler:     deleteFileHandler,
+		getFileHandler:        getFileHandler,
+		listFilesHandler:      listFilesHandler,
+		openFileHandler:       openFileHandler,
+		links:                 links,
+		authorizer:            authorizer,
+	}
+}
+
+// CreateUploadRequest represents the request body announcing a file uploaded to a signed link
+type CreateUploadRequest struct {
+	Name           string `json:"name" binding:"required,max=255"`
+	ContentType    string `json:"content_type" binding:"max=255"`
+	Size           int64  `json:"size" binding:"required,gt=0"`
+	AttachedToType string `json:"attached_to_type" binding:"required,oneof=customer order"`
+	AttachedToID   string `json:"attached_to_id" binding:"required,max=36"`
+}
+
+// UploadFile handles POST /files
+// The content is sent as the "file" part of a multipart form and stored before the response
+func (h *FileHandler) UploadFile(c *gin.Context) {
+	requester, ok := h.requester(c)
+	if !ok {
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if httplimit.Interrupted(err) {
+		h.handleError(c, httplimit.ReadError(err))
+		return
+	}
+	if err != nil {
+		h.handleError(c, shareddomain.NewValidationError("file", "file must be a multipart file part"))
+		return
+	}
+	content, err := header.Open()
+	if err != nil {
+		h.handleError(c, fmt.Errorf("failed to open uploaded file: %w", err))
+		return
+	}
+	defer content.Close()
+
+	cmd := commands.NewUploadFileCommand(
+		header.Filename,
+		header.Header.Get("Content-Type"),
+		header.Size,
+		c.PostForm("attached_to_type"),
+		c.PostForm("attached_to_id"),
+		requester.Actor,
+		content,
+	)
+
+	result, err := h.uploadFileHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// CreateUpload handles POST /files/uploads
+// The file is created pending, with the signed link its content is uploaded to; the client
+// confirms the upload with POST /files/:id/complete
+func (h *FileHandler) CreateUpload(c *gin.Context) {
+	requester, ok := h.requester(c)
+	if !ok {
+		return
+	}
+
+	var req CreateUploadRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewCreateUploadCommand(req.Name, req.ContentType, req.Size, req.AttachedToType, req.AttachedToID, requester.Actor)
+
+	result, err := h.createUploadHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// CompleteUpload handles POST /files/:id/complete
+func (h *FileHandler) CompleteUpload(c *gin.Context) {
+	requester, ok := h.requester(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.NewCompleteUploadCommand(c.Param("id"), requester)
+
+	result, err := h.completeUploadHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetFile handles GET /files/:id
+// Uploaded files are returned with a signed download link
+func (h *FileHandler) GetFile(c *gin.Context) {
+	requester, ok := h.requester(c)
+	if !ok {
+		return
+	}
+
+	query := &queries.GetFileQuery{
+		ID:        c.Param("id"),
+		Requester: requester,
+	}
+
+	result, err := h.getFileHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ListFiles handles GET /files
+func (h *FileHandler) ListFiles(c *gin.Context) {
+	requester, ok := h.requester(c)
+	if !ok {
+		return
+	}
+
+	query := &queries.ListFilesQuery{
+		Page:           intQuery(c, "page", 1),
+		Limit:          intQuery(c, "limit", 20),
+		AttachedToType: c.Query("attached_to_type"),
+		AttachedToID:   c.Query("attached_to_id"),
+		Status:         c.Query("status"),
+		Requester:      requester,
+	}
+
+	result, err := h.listFilesHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"data":       result.Files,
+		"pagination": result.Pagination,
+	})
+}
+
+// DeleteFile handles DELETE /files/:id
+func (h *FileHandler) DeleteFile(c *gin.Context) {
+	requester, ok := h.requester(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.NewDeleteFileCommand(c.Param("id"), requester)
+
+	result, err := h.deleteFileHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// WriteContent handles PUT /files/:id/content, the signed upload link of files kept on storages
+// without their own presigned links
+func (h *FileHandler) WriteContent(c *gin.Context) {
+	if !h.verifyLink(c) {
+		return
+	}
+
+	cmd := commands.NewWriteContentCommand(c.Param("id"), c.Request.Body)
+
+	result, err := h.writeContentHandler.Handle(c.Request.Context(), &cmd)
+	if httplimit.Interrupted(err) {
+		// The content is streamed, so the body's limit and read timeout surface from the command
+		err = httplimit.ReadError(err)
+	}
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ReadContent handles GET /files/:id/content, the signed download link of files kept on storages
+// without their own presigned links
+func (h *FileHandler) ReadContent(c *gin.Context) {
+	if !h.verifyLink(c) {
+		return
+	}
+
+	result, err := h.openFileHandler.Handle(c.Request.Context(), &queries.OpenFileQuery{ID: c.Param("id")})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	defer result.Content.Close()
+
+	file := result.File
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.Name}))
+	c.Header("Content-Length", strconv.FormatInt(file.Size, 10))
+	c.Header("Content-Type", file.ContentType)
+	// The content type was chosen by the uploader; browsers must not guess a more dangerous one
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Status(http.StatusOK)
+
+	if _, err := io.Copy(c.Writer, result.Content); err != nil {
+		// Headers are sent, so the client sees a truncated body; the request log records why
+		_ = c.Error(fmt.Errorf("failed to send content of file %s: %w", file.GetID(), err))
+	}
+}
+
+// requester returns the authenticated requester, aborting with 401 without a principal
+// Requesters holding files:manage act on every file; others on the files they uploaded
+func (h *FileHandler) requester(c *gin.Context) (domain.Requester, bool) {
+	principal, ok := auth.CurrentPrincipal(c)
+	if !ok {
+		h.handleError(c, shareddomain.NewDomainError(shareddomain.ErrCodeUnauthorized, "authentication required"))
+		return domain.Requester{}, false
+	}
+
+	// Files uploaded while impersonating belong to the impersonated user
+	actor := principal.Actor()
+	actor.ImpersonatorID = ""
+
+	return domain.Requester{
+		Actor:   actor.String(),
+		Manager: h.authorizer.Authorize(c.Request.Context(), principal, domain.PermissionManageFiles) == nil,
+	}, true
+}
+
+// verifyLink checks the signature of a content link, aborting with 403 when it is invalid or expired
+func (h *FileHandler) verifyLink(c *gin.Context) bool {
+	err := h.links.Verify(c.Request.Context(), c.Request.Method, c.Param("id"), c.Query("expires"), c.Query("signature"))
+	if err != nil {
+		h.handleError(c, shareddomain.NewDomainError(shareddomain.ErrCodeForbidden, err.Error()))
+		return false
+	}
+	return true
+}
+
+// intQuery gets an integer query parameter with default value
+func intQuery(c *gin.Context, key string, defaultValue int) int {
+	if str := c.Query(key); str != "" {
+		if val, err := strconv.Atoi(str); err == nil {
+			return val
+		}
+	}
+	return defaultValue
+}
+
+// handleError handles errors and returns appropriate HTTP responses
+func (h *FileHandler) handleError(c *gin.Context, err error) {
+	var validationErrs shareddomain.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErrs.Error(),
+				"details": validationErrs,
+			},
+		})
+		return
+	}
+
+	var validationErr shareddomain.ValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErr.Message,
+				"field":   validationErr.Field,
+			},
+		})
+		return
+	}
+
+	var businessErr shareddomain.BusinessRuleError
+	if errors.As(err, &businessErr) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeBusinessRule,
+				"message": businessErr.Message,
+				"rule":    businessErr.Rule,
+			},
+		})
+		return
+	}
+
+	var domainErr shareddomain.DomainError
+	if errors.As(err, &domainErr) {
+		status := http.StatusInternalServerError
+		switch domainErr.Code {
+		case shareddomain.ErrCodeNotFound:
+			status = http.StatusNotFound
+		case shareddomain.ErrCodeAlreadyExists, shareddomain.ErrCodeConcurrencyConflict:
+			status = http.StatusConflict
+		case shareddomain.ErrCodeInvalidInput, shareddomain.ErrCodeValidationFailed:
+			status = http.StatusBadRequest
+		case shareddomain.ErrCodeRequestTooLarge:
+			status = http.StatusRequestEntityTooLarge
+		case shareddomain.ErrCodeRequestTimeout:
+			status = http.StatusRequestTimeout
+		case shareddomain.ErrCodeInvalidState:
+			status = http.StatusUnprocessableEntity
+		case shareddomain.ErrCodeUnauthorized:
+			status = http.StatusUnauthorized
+		case shareddomain.ErrCodeForbidden:
+			status = http.StatusForbidden
+		}
+
+		if status == http.StatusInternalServerError {
+			h.internalError(c)
+			return
+		}
+
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    domainErr.Code,
+				"message": domainErr.Message,
+				"field":   domainErr.Field,
+			},
+		})
+		return
+	}
+
+	h.internalError(c)
+}
+
+// internalError writes a generic internal error response
+func (h *FileHandler) internalError(c *gin.Context) {
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": "An internal error occurred",
+		},
+	})
+}
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/files/application/commands"
+	"golang_modular_monolith/internal/modules/files/application/queries"
+	"golang_modular_monolith/internal/modules/files/domain"
+	"golang_modular_monolith/internal/modules/files/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+)
+
+// APIOperations documents the routes registered by RegisterFileRoutes
+func APIOperations() []*openapi.Operation {
+	return []*openapi.Operation{
+		openapi.Get("/files", "List files, newest first").
+			Describe("Principals without files:manage only list the files they uploaded").
+			Query("page", "integer", "Page number, from 1").
+			Query("limit", "integer", "Page size").
+			Query("attached_to_type", "string", "customer or order").
+			Query("attached_to_id", "string", "ID of the customer or order").
+			Query("status", "string", "pending or uploaded").
+			Requires("files:read").
+			Paginated([]domain.File{}, domain.PaginationResult{}),
+		openapi.Post("/files", "Upload a file").
+			Describe("multipart/form-data with the content in the \"file\" part and the attached_to_type and attached_to_id fields").
+			Requires("files:write").
+			Created(commands.FileResult{}),
+		openapi.Post("/files/uploads", "Announce a file uploaded to a signed link").
+			Describe("The file is created pending with the link and headers its content is uploaded with; confirm the upload with POST /files/:id/complete").
+			Body(handlers.CreateUploadRequest{}).
+			Requires("files:write").
+			Created(commands.FileResult{}),
+		openapi.Get("/files/:id", "Get a file with its signed download link").
+			Requires("files:read").
+			Returns(queries.GetFileResult{}),
+		openapi.Delete("/files/:id", "Delete a file and its content").
+			Requires("files:write").
+			Returns(commands.FileResult{}),
+		openapi.Post("/files/:id/complete", "Confirm the content of a file was uploaded").
+			Requires("files:write").
+			Returns(commands.FileResult{}),
+		openapi.Get("/files/:id/content", "Download the content of a file").
+			Describe("Signed download link returned by GET /files/:id; no bearer token is needed").
+			Query("expires", "integer", "Expiry of the link, in Unix seconds").
+			Query("signature", "string", "Signature of the link").
+			Produces("application/octet-stream"),
+		openapi.Put("/files/:id/content", "Upload the content of a pending file").
+			Describe("Signed upload link returned when the file is announced; no bearer token is needed").
+			Query("expires", "integer", "Expiry of the link, in Unix seconds").
+			Query("signature", "string", "Signature of the link").
+			Returns(commands.FileResult{}),
+	}
+}
@@ -0,0 +1,25 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/files/infrastructure/http/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterFileRoutes registers file routes
+// The module configuration requires authentication on every route but the content routes, whose
+// links are signed
+func RegisterFileRoutes(router *gin.RouterGroup, fileHandler *handlers.FileHandler) {
+	// File routes
+	files := router.Group("/files")
+	{
+		files.GET("", fileHandler.ListFiles)
+		files.POST("", fileHandler.UploadFile)
+		files.POST("/uploads", fileHandler.CreateUpload)
+		files.GET("/:id", fileHandler.GetFile)
+		files.DELETE("/:id", fileHandler.DeleteFile)
+		files.POST("/:id/complete", fileHandler.CompleteUpload)
+		files.GET("/:id/content", fileHandler.ReadContent)
+		files.PUT("/:id/content", fileHandler.WriteContent)
+	}
+}
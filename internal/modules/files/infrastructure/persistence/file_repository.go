@@ -0,0 +1,179 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/files/domain"
+	filesdb "golang_modular_monolith/internal/modules/files/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"gorm.io/gorm"
+)
+
+// FileModel represents the file database model
+type FileModel struct {
+	ID             string     `gorm:"primaryKey;type:varchar(36)"`
+	TenantID       string     `gorm:"type:varchar(64);not null;default:''"`
+	Name           string     `gorm:"type:varchar(255);not null"`
+	ContentType    string     `gorm:"type:varchar(255);not null"`
+	Size           int64      `gorm:"not null"`
+	Checksum       string     `gorm:"type:varchar(64);not null;default:''"`
+	StorageKey     string     `gorm:"type:varchar(512);not null;uniqueIndex"`
+	Owner          string     `gorm:"type:varchar(255);not null"`
+	AttachedToType string     `gorm:"type:varchar(32);not null"`
+	AttachedToID   string     `gorm:"type:varchar(36);not null"`
+	Status         string     `gorm:"type:file_status;not null;default:pending"`
+	UploadedAt     *time.Time `gorm:"type:timestamp with time zone"`
+	Version        int        `gorm:"not null;default:0"`
+	CreatedAt      time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (FileModel) TableName() string {
+	return "files"
+}
+
+// ToEntity converts database model to domain entity
+func (m *FileModel) ToEntity() *domain.File {
+	file := &domain.File{
+		BaseAggregateRoot: shareddomain.NewBaseAggregateRootWithID(m.ID),
+		Name:              m.Name,
+		ContentType:       m.ContentType,
+		Size:              m.Size,
+		Checksum:          m.Checksum,
+		StorageKey:        m.StorageKey,
+		Owner:             m.Owner,
+		AttachedToType:    domain.AttachmentType(m.AttachedToType),
+		AttachedToID:      m.AttachedToID,
+		Status:            domain.FileStatus(m.Status),
+		UploadedAt:        m.UploadedAt,
+	}
+
+	// Set version and timestamps from database
+	file.Version = m.Version
+	file.CreatedAt = m.CreatedAt
+	file.UpdatedAt = m.UpdatedAt
+
+	return file
+}
+
+// FromEntity converts domain entity to database model
+func (m *FileModel) FromEntity(file *domain.File) {
+	m.ID = file.GetID()
+	m.Name = file.Name
+	m.ContentType = file.ContentType
+	m.Size = file.Size
+	m.Checksum = file.Checksum
+	m.StorageKey = file.StorageKey
+	m.Owner = file.Owner
+	m.AttachedToType = string(file.AttachedToType)
+	m.AttachedToID = file.AttachedToID
+	m.Status = string(file.Status)
+	m.UploadedAt = file.UploadedAt
+	m.Version = file.GetVersion()
+	m.CreatedAt = file.GetCreatedAt()
+	m.UpdatedAt = file.GetUpdatedAt()
+}
+
+// PostgreSQLFileRepository implements FileRepository using PostgreSQL
+type PostgreSQLFileRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLFileRepository creates a new PostgreSQL file repository
+func NewPostgreSQLFileRepository(db *gorm.DB) *PostgreSQLFileRepository {
+	return &PostgreSQLFileRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLFileRepositoryFromManager creates repository using database manager
+func NewPostgreSQLFileRepositoryFromManager() (*PostgreSQLFileRepository, error) {
+	db, err := filesdb.GetFilesDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get files database: %w", err)
+	}
+
+	return &PostgreSQLFileRepository{
+		db: db,
+	}, nil
+}
+
+// Save saves a file
+func (r *PostgreSQLFileRepository) Save(ctx context.Context, file *domain.File) error {
+	model := &FileModel{}
+	model.FromEntity(file)
+
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return fmt.Errorf("failed to save file: %w", err)
+	}
+
+	// Clear uncommitted events after successful save
+	file.ClearUncommittedEvents()
+
+	return nil
+}
+
+// GetByID retrieves a file by ID, including deleted files
+func (r *PostgreSQLFileRepository) GetByID(ctx context.Context, id string) (*domain.File, error) {
+	var model FileModel
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get file: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// List retrieves files matching the parameters, newest first, one page at a time
+func (r *PostgreSQLFileRepository) List(ctx context.Context, params domain.ListFilesParams) (*domain.FileListResult, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := r.db.WithContext(ctx).Model(&FileModel{}).Where("status <> ?", domain.FileStatusDeleted)
+
+	// Apply filters
+	if params.AttachedToType != "" {
+		query = query.Where("attached_to_type = ?", params.AttachedToType)
+	}
+	if params.AttachedToID != "" {
+		query = query.Where("attached_to_id = ?", params.AttachedToID)
+	}
+	if params.Owner != "" {
+		query = query.Where("owner = ?", params.Owner)
+	}
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+
+	// Count total records
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count files: %w", err)
+	}
+
+	var models []FileModel
+	offset := (params.Page - 1) * params.Limit
+	if err := query.Order("created_at DESC, id").Offset(offset).Limit(params.Limit).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+
+	files := make([]*domain.File, len(models))
+	for i := range models {
+		files[i] = models[i].ToEntity()
+	}
+
+	return &domain.FileListResult{
+		Files:      files,
+		Pagination: domain.NewPaginationResult(params.Page, params.Limit, total),
+	}, nil
+}
@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/modules/files/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/tenancy"
+)
+
+// ErrInvalidLink is returned for signed links that were altered or have expired
+var ErrInvalidLink = errors.New("invalid or expired link")
+
+// Presigner is implemented by storages clients upload to and download from directly, such as S3
+type Presigner interface {
+	PresignUpload(key, contentType string, expires time.Duration) (string, map[string]string)
+	PresignDownload(key, filename string, expires time.Duration) string
+}
+
+// Links implements domain.Links
+// Storages implementing Presigner sign their own links; the content of other storages is
+// uploaded and downloaded through the module's /files/:id/content route, with links signed by
+// an HMAC of the method, file, expiry and tenant; the tenant query parameter names the tenant of
+// the link to the tenant middleware
+type Links struct {
+	presigner Presigner
+	key       []byte
+	baseURL   string
+	expiry    time.Duration
+}
+
+// NewLinks creates the links of files kept in storage, valid for expiry
+// baseURL is the URL the module's routes are served under, e.g. https://api.example.com/api/v1
+func NewLinks(storage domain.Storage, key []byte, baseURL string, expiry time.Duration) *Links {
+	presigner, _ := storage.(Presigner)
+	return &Links{
+		presigner: presigner,
+		key:       key,
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		expiry:    expiry,
+	}
+}
+
+// UploadURL returns the link the content of a pending file is uploaded to
+func (l *Links) UploadURL(ctx context.Context, file *domain.File) (*domain.SignedURL, error) {
+	expiresAt := time.Now().Add(l.expiry)
+	if l.presigner != nil {
+		signed, headers := l.presigner.PresignUpload(file.StorageKey, file.ContentType, l.expiry)
+		return &domain.SignedURL{Method: http.MethodPut, URL: signed, Headers: headers, ExpiresAt: expiresAt}, nil
+	}
+	return &domain.SignedURL{
+		Method:    http.MethodPut,
+		URL:       l.contentURL(ctx, http.MethodPut, file.GetID(), expiresAt),
+		Headers:   map[string]string{"Content-Type": file.ContentType},
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// DownloadURL returns the link the content of an uploaded file is downloaded from
+func (l *Links) DownloadURL(ctx context.Context, file *domain.File) (*domain.SignedURL, error) {
+	expiresAt := time.Now().Add(l.expiry)
+	if l.presigner != nil {
+		signed := l.presigner.PresignDownload(file.StorageKey, file.Name, l.expiry)
+		return &domain.SignedURL{Method: http.MethodGet, URL: signed, ExpiresAt: expiresAt}, nil
+	}
+	return &domain.SignedURL{
+		Method:    http.MethodGet,
+		URL:       l.contentURL(ctx, http.MethodGet, file.GetID(), expiresAt),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Verify checks the expires and signature query parameters of a content link for the method and file,
+// in the tenant of ctx
+func (l *Links) Verify(ctx context.Context, method, fileID, expires, signature string) error {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return ErrInvalidLink
+	}
+
+	tenantID, _ := shareddomain.TenantIDFromContext(ctx)
+	expected := l.sign(method, fileID, tenantID, expiresAt)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return ErrInvalidLink
+	}
+	return nil
+}
+
+// contentURL returns the signed link of the content route, in the tenant of ctx
+func (l *Links) contentURL(ctx context.Context, method, fileID string, expiresAt time.Time) string {
+	query := url.Values{}
+	tenantID, ok := shareddomain.TenantIDFromContext(ctx)
+	if ok {
+		query.Set(tenancy.QueryParam, tenantID)
+	}
+	query.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+	query.Set("signature", l.sign(method, fileID, tenantID, expiresAt.Unix()))
+	return fmt.Sprintf("%s/files/%s/content?%s", l.baseURL, url.PathEscape(fileID), query.Encode())
+}
+
+// sign returns the hex-encoded HMAC-SHA256 of a link
+// Links without a tenant sign the method, file and expiry only
+func (l *Links) sign(method, fileID, tenantID string, expiresAt int64) string {
+	mac := hmac.New(sha256.New, l.key)
+	mac.Write([]byte(method + "\n" + fileID + "\n" + strconv.FormatInt(expiresAt, 10)))
+	if tenantID != "" {
+		mac.Write([]byte("\n" + tenantID))
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,226 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/modules/files/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/awssig"
+	"golang_modular_monolith/internal/shared/infrastructure/resilience"
+)
+
+// S3StorageName is the identifier of the S3 storage
+const S3StorageName = "s3"
+
+// S3Config configures the S3 storage
+type S3Config struct {
+	Bucket          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// Endpoint defaults to the S3 endpoint of the region; set it for S3-compatible services
+	// such as MinIO, usually with PathStyle
+	Endpoint string
+	// PathStyle addresses the bucket in the path (endpoint/bucket/key) rather than the host
+	PathStyle bool
+	// Timeout bounds each attempt of metadata calls; uploads and downloads are bounded by the request
+	Timeout time.Duration
+	// Retries is the number of times a metadata call failing with a transport error or a 5xx is repeated
+	Retries int
+	// Breaker stops calling S3 for a while after consecutive failed calls
+	Breaker resilience.BreakerSettings
+}
+
+// S3Storage keeps file content in an S3 bucket; clients upload and download content directly
+// through presigned URLs
+type S3Storage struct {
+	config      S3Config
+	endpoint    *url.URL
+	credentials awssig.Credentials
+	client      *http.Client
+	policy      resilience.Policy
+	// streams are not repeatable, so uploads and downloads are attempted once
+	streams resilience.Policy
+}
+
+// NewS3Storage creates a new S3 storage
+func NewS3Storage(config S3Config) (*S3Storage, error) {
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+	if config.Region == "" {
+		return nil, fmt.Errorf("s3 region is required")
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("s3 access_key_id and secret_access_key are required")
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = "https://s3." + config.Region + ".amazonaws.com"
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(config.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", config.Endpoint)
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	breaker := resilience.NewBreaker("files:"+S3StorageName, config.Breaker)
+	return &S3Storage{
+		config:   config,
+		endpoint: endpoint,
+		credentials: awssig.Credentials{
+			AccessKeyID:     config.AccessKeyID,
+			SecretAccessKey: config.SecretAccessKey,
+			SessionToken:    config.SessionToken,
+		},
+		client: &http.Client{},
+		policy: resilience.Policy{
+			Timeout: config.Timeout,
+			Retries: config.Retries,
+			Breaker: breaker,
+		},
+		streams: resilience.Policy{Breaker: breaker},
+	}, nil
+}
+
+// Name returns the storage identifier
+func (s *S3Storage) Name() string {
+	return S3StorageName
+}
+
+// Put uploads the content to the bucket in one request
+func (s *S3Storage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	return s.streams.Do(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key, nil).String(), body)
+		if err != nil {
+			return resilience.Permanent(fmt.Errorf("failed to build s3 request: %w", err))
+		}
+		req.ContentLength = size
+		req.Header.Set("Content-Type", contentType)
+
+		resp, err := s.do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+}
+
+// Open downloads the content stored under key
+func (s *S3Storage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	var body io.ReadCloser
+	err := s.streams.Do(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key, nil).String(), nil)
+		if err != nil {
+			return resilience.Permanent(fmt.Errorf("failed to build s3 request: %w", err))
+		}
+		resp, err := s.do(req)
+		if err != nil {
+			return err
+		}
+		body = resp.Body
+		return nil
+	})
+	return body, err
+}
+
+// Stat describes the object stored under key
+func (s *S3Storage) Stat(ctx context.Context, key string) (*domain.Object, error) {
+	var object *domain.Object
+	err := s.policy.Do(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.objectURL(key, nil).String(), nil)
+		if err != nil {
+			return resilience.Permanent(fmt.Errorf("failed to build s3 request: %w", err))
+		}
+		resp, err := s.do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		object = &domain.Object{Size: resp.ContentLength, ContentType: resp.Header.Get("Content-Type")}
+		return nil
+	})
+	return object, err
+}
+
+// Delete removes the object stored under key; S3 answers deletes of missing objects with success
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	return s.policy.Do(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key, nil).String(), nil)
+		if err != nil {
+			return resilience.Permanent(fmt.Errorf("failed to build s3 request: %w", err))
+		}
+		resp, err := s.do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+}
+
+// PresignUpload returns a URL the content of key is uploaded to with PUT until expires elapsed,
+// and the headers the upload must carry
+func (s *S3Storage) PresignUpload(key, contentType string, expires time.Duration) (string, map[string]string) {
+	headers := map[string]string{"Content-Type": contentType}
+	return awssig.Presign(http.MethodPut, s.objectURL(key, nil), headers, s.credentials, s.scope(), time.Now(), expires), headers
+}
+
+// PresignDownload returns a URL the content of key is downloaded from until expires elapsed, as
+// an attachment named filename
+func (s *S3Storage) PresignDownload(key, filename string, expires time.Duration) string {
+	query := url.Values{}
+	query.Set("response-content-disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	return awssig.Presign(http.MethodGet, s.objectURL(key, query), nil, s.credentials, s.scope(), time.Now(), expires)
+}
+
+// do signs and sends a request, mapping 404 to ErrObjectNotFound and other errors by retryability
+// The body of a successful response is left to the caller
+func (s *S3Storage) do(req *http.Request) (*http.Response, error) {
+	awssig.Sign(req, awssig.UnsignedPayload, s.credentials, s.scope(), time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 request failed: %w", err)
+	}
+	if resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, resilience.Permanent(domain.ErrObjectNotFound)
+	case resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests:
+		return nil, fmt.Errorf("s3 returned status %d", resp.StatusCode)
+	}
+	return nil, resilience.Permanent(fmt.Errorf("s3 returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(message))))
+}
+
+// objectURL returns the URL of the object stored under key, with an optional query
+func (s *S3Storage) objectURL(key string, query url.Values) *url.URL {
+	u := *s.endpoint
+	if s.config.PathStyle {
+		u.Path = u.Path + "/" + s.config.Bucket + "/" + key
+	} else {
+		u.Host = s.config.Bucket + "." + u.Host
+		u.Path = u.Path + "/" + key
+	}
+	u.RawQuery = query.Encode()
+	return &u
+}
+
+// scope returns the signing scope of the bucket's region
+func (s *S3Storage) scope() awssig.Scope {
+	return awssig.Scope{Region: s.config.Region, Service: "s3"}
+}
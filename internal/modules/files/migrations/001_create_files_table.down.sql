@@ -0,0 +1,5 @@
+-- Drop files tables
+DROP TABLE IF EXISTS "public"."files";
+
+-- Drop file status enum
+DROP TYPE IF EXISTS "public"."file_status";
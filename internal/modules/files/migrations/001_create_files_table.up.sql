@@ -0,0 +1,29 @@
+-- Create file status enum
+DO $$ BEGIN
+    CREATE TYPE "public"."file_status" AS ENUM ('pending', 'uploaded', 'deleted');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+
+-- Create files table (the metadata of files whose content is kept in storage)
+CREATE TABLE IF NOT EXISTS "public"."files" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "name" VARCHAR(255) NOT NULL,
+    "content_type" VARCHAR(255) NOT NULL,
+    "size" BIGINT NOT NULL,
+    "checksum" VARCHAR(64) NOT NULL DEFAULT '',
+    "storage_key" VARCHAR(512) NOT NULL,
+    "owner" VARCHAR(255) NOT NULL,
+    "attached_to_type" VARCHAR(32) NOT NULL,
+    "attached_to_id" VARCHAR(36) NOT NULL,
+    "status" "public"."file_status" NOT NULL DEFAULT 'pending'::file_status,
+    "uploaded_at" TIMESTAMP WITH TIME ZONE,
+    "version" INTEGER NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for better performance
+CREATE UNIQUE INDEX IF NOT EXISTS idx_files_storage_key ON "public"."files" ("storage_key");
+CREATE INDEX IF NOT EXISTS idx_files_attached_to ON "public"."files" ("attached_to_type", "attached_to_id", "created_at") WHERE "status" <> 'deleted';
+CREATE INDEX IF NOT EXISTS idx_files_owner ON "public"."files" ("owner", "created_at") WHERE "status" <> 'deleted';
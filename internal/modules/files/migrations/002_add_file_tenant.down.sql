@@ -0,0 +1,3 @@
+-- Drop the tenant of files
+DROP INDEX IF EXISTS idx_files_tenant_id;
+ALTER TABLE "public"."files" DROP COLUMN IF EXISTS "tenant_id";
@@ -0,0 +1,3 @@
+-- Files belong to the tenant they were uploaded in; files uploaded without multi-tenancy have none
+ALTER TABLE "public"."files" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_files_tenant_id ON "public"."files" ("tenant_id");
@@ -0,0 +1,234 @@
+package files
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	customerapi "golang_modular_monolith/internal/modules/customer/publicapi"
+	filesdomain "golang_modular_monolith/internal/modules/files/domain"
+	"golang_modular_monolith/internal/modules/files/infrastructure/attachments"
+	fileshttp "golang_modular_monolith/internal/modules/files/infrastructure/http"
+	"golang_modular_monolith/internal/modules/files/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/files/infrastructure/storage"
+	orderapi "golang_modular_monolith/internal/modules/order/publicapi"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/authz"
+	"golang_modular_monolith/internal/shared/infrastructure/di"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+	"golang_modular_monolith/internal/shared/infrastructure/registry"
+	"golang_modular_monolith/internal/shared/infrastructure/resilience"
+)
+
+// Auto-register files module on package import
+func init() {
+	registry.RegisterModule("files", func() domain.Module {
+		return NewFilesModule()
+	})
+}
+
+// FilesModule implements the Module interface
+type FilesModule struct {
+	name    string
+	logger  *zap.Logger
+	handler *handlers.FileHandler
+	storage filesdomain.Storage
+
+	// Dependencies
+	eventBus domain.EventBus
+}
+
+// NewFilesModule creates a new files module
+func NewFilesModule() *FilesModule {
+	return &FilesModule{
+		name: "files",
+	}
+}
+
+// Name returns the module name
+func (m *FilesModule) Name() string {
+	return m.name
+}
+
+// Initialize initializes the files module with dependencies
+func (m *FilesModule) Initialize(deps domain.ModuleDependencies) error {
+	m.logger = deps.Logger
+	m.logger.Info("initializing module")
+
+	// Store event bus
+	m.eventBus = deps.EventBus
+
+	var settings filesSettings
+	if err := deps.Config.Decode("", &settings); err != nil {
+		return fmt.Errorf("invalid files config: %w", err)
+	}
+
+	var err error
+	if m.storage, err = loadStorage(settings); err != nil {
+		return fmt.Errorf("invalid files storage config: %w", err)
+	}
+	m.logger.Info("files storage configured", zap.String("storage", m.storage.Name()))
+
+	key, err := signingKey(settings, m.logger)
+	if err != nil {
+		return fmt.Errorf("invalid files signing config: %w", err)
+	}
+	links := storage.NewLinks(m.storage, key, settings.PublicURL, settings.linkExpiry())
+
+	// Attachments are checked against the customer and order modules, resolved lazily so module
+	// order does not matter; the file routes check permissions with the user module's authorizer
+	targets := attachments.NewTargets(customerapi.Lazy(deps.PublicAPIs), orderapi.Lazy(deps.PublicAPIs))
+	policy := filesdomain.UploadPolicy{
+		MaxSize:             settings.MaxSize,
+		AllowedContentTypes: settings.AllowedContentTypes,
+	}
+
+	// Construct repositories and handlers from their constructors
+	container := newContainer(m.eventBus, m.storage, links, targets, policy, authz.Lazy(deps.PublicAPIs))
+	if m.handler, err = di.Resolve[*handlers.FileHandler](container); err != nil {
+		return fmt.Errorf("failed to create file handler: %w", err)
+	}
+
+	m.logger.Info("module initialized")
+	return nil
+}
+
+// RegisterRoutes registers HTTP routes for the files module
+func (m *FilesModule) RegisterRoutes(router *gin.RouterGroup) {
+	m.logger.Info("registering routes")
+
+	fileshttp.RegisterFileRoutes(router, m.handler)
+}
+
+// APIOperations implements openapi.Documented
+func (m *FilesModule) APIOperations() []*openapi.Operation {
+	return fileshttp.APIOperations()
+}
+
+// Health checks if the files module is healthy
+func (m *FilesModule) Health(ctx context.Context) error {
+	// Check if handler is initialized
+	if m.handler == nil {
+		return fmt.Errorf("file handler not initialized")
+	}
+
+	return nil
+}
+
+// Start starts the files module (optional lifecycle method)
+func (m *FilesModule) Start(ctx context.Context) error {
+	m.logger.Info("starting module")
+	m.logger.Info("module started")
+	return nil
+}
+
+// Stop stops the files module (optional lifecycle method)
+func (m *FilesModule) Stop(ctx context.Context) error {
+	m.logger.Info("stopping module")
+	m.logger.Info("module stopped")
+	return nil
+}
+
+// filesSettings is the files section of the module config
+type filesSettings struct {
+	// Storage keeps file content: local or s3
+	Storage string `mapstructure:"storage"`
+	Local   struct {
+		// Root is the directory file content is written to
+		Root string `mapstructure:"root"`
+	} `mapstructure:"local"`
+	S3 s3Settings `mapstructure:"s3"`
+	// PublicURL is the URL the module's routes are reached at, used in the signed links of the
+	// local storage
+	PublicURL string `mapstructure:"public_url"`
+	// SigningKey signs the links of the local storage; a random key is generated when empty
+	SigningKey string `mapstructure:"signing_key"`
+	// LinkExpiry is how long signed upload and download links are valid
+	LinkExpiry time.Duration `mapstructure:"link_expiry"`
+	// MaxSize is the largest file accepted, in bytes
+	MaxSize int64 `mapstructure:"max_size"`
+	// AllowedContentTypes lists the media types accepted; empty accepts any
+	AllowedContentTypes []string `mapstructure:"allowed_content_types"`
+}
+
+// linkExpiry returns the validity of signed links, 15 minutes by default
+func (s filesSettings) linkExpiry() time.Duration {
+	if s.LinkExpiry <= 0 {
+		return 15 * time.Minute
+	}
+	return s.LinkExpiry
+}
+
+// s3Settings configures the S3 storage
+type s3Settings struct {
+	Bucket          string          `mapstructure:"bucket"`
+	Region          string          `mapstructure:"region"`
+	Endpoint        string          `mapstructure:"endpoint"`
+	PathStyle       bool            `mapstructure:"path_style"`
+	AccessKeyID     string          `mapstructure:"access_key_id"`
+	SecretAccessKey string          `mapstructure:"secret_access_key"`
+	SessionToken    string          `mapstructure:"session_token"`
+	Timeout         time.Duration   `mapstructure:"timeout"`
+	Retries         int             `mapstructure:"retries"`
+	Breaker         breakerSettings `mapstructure:"breaker"`
+}
+
+// breakerSettings configures the circuit breaker of the S3 storage
+type breakerSettings struct {
+	FailureThreshold int           `mapstructure:"failure_threshold"`
+	OpenTimeout      time.Duration `mapstructure:"open_timeout"`
+}
+
+// loadStorage builds the storage named by files.storage, the local disk by default
+func loadStorage(settings filesSettings) (filesdomain.Storage, error) {
+	switch settings.Storage {
+	case "", storage.LocalStorageName:
+		root := settings.Local.Root
+		if root == "" {
+			root = "data/files"
+		}
+		return storage.NewLocalStorage(root)
+	case storage.S3StorageName:
+		s3 := settings.S3
+		return storage.NewS3Storage(storage.S3Config{
+			Bucket:          s3.Bucket,
+			Region:          s3.Region,
+			Endpoint:        s3.Endpoint,
+			PathStyle:       s3.PathStyle,
+			AccessKeyID:     s3.AccessKeyID,
+			SecretAccessKey: s3.SecretAccessKey,
+			SessionToken:    s3.SessionToken,
+			Timeout:         s3.Timeout,
+			Retries:         s3.Retries,
+			Breaker: resilience.BreakerSettings{
+				FailureThreshold: s3.Breaker.FailureThreshold,
+				OpenTimeout:      s3.Breaker.OpenTimeout,
+			},
+		})
+	default:
+		return nil, fmt.Errorf("storage %q is unknown", settings.Storage)
+	}
+}
+
+// signingKey returns the key signing the links of the local storage
+// Without files.signing_key a random key is used, so links do not survive a restart and are only
+// valid on the instance that signed them
+func signingKey(settings filesSettings, logger *zap.Logger) ([]byte, error) {
+	if settings.SigningKey != "" {
+		return []byte(settings.SigningKey), nil
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+	if settings.Storage != storage.S3StorageName {
+		logger.Warn("files.signing_key is not set; signed links are only valid on this instance until it restarts")
+	}
+	return key, nil
+}
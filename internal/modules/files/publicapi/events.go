@@ -0,0 +1,30 @@
+// Package publicapi is the files module's contract for other modules.
+// Other modules depend on this package only, never on files internals.
+package publicapi
+
+import (
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// File event types other modules may subscribe to
+const (
+	FileUploadedEventType = "file.uploaded"
+	FileDeletedEventType  = "file.deleted"
+)
+
+// Types of the aggregates files are attached to
+const (
+	AttachedToCustomer = "customer"
+	AttachedToOrder    = "order"
+)
+
+// FileEvent is implemented by the file.uploaded and file.deleted events
+type FileEvent interface {
+	shareddomain.DomainEvent
+
+	// GetFileID returns the ID of the file
+	GetFileID() string
+
+	// GetAttachedTo returns the type and ID of the aggregate the file is attached to
+	GetAttachedTo() (string, string)
+}
@@ -0,0 +1,6 @@
+//go:build !no_notification
+
+package modules
+
+// Excluded from builds tagged no_notification
+import _ "golang_modular_monolith/internal/modules/notification"
@@ -0,0 +1,117 @@
+package commandhandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/notification/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"go.uber.org/zap"
+)
+
+// DeliveryPolicy bounds the delivery attempts of notifications
+type DeliveryPolicy struct {
+	// MaxAttempts is the number of attempts before a notification fails for good
+	MaxAttempts int
+	// Backoff is the delay before the second attempt, doubling with each failed attempt
+	Backoff time.Duration
+	// BatchSize is the number of notifications claimed at once
+	BatchSize int
+	// Lease is how long claimed notifications are hidden from other instances
+	Lease time.Duration
+}
+
+// DispatchNotificationsHandler delivers the pending notifications due for an attempt
+type DispatchNotificationsHandler struct {
+	repo      domain.NotificationRepository
+	providers domain.Providers
+	eventBus  shareddomain.EventBus
+	policy    DeliveryPolicy
+}
+
+// NewDispatchNotificationsHandler creates a new DispatchNotificationsHandler
+func NewDispatchNotificationsHandler(
+	repo domain.NotificationRepository,
+	providers domain.Providers,
+	eventBus shareddomain.EventBus,
+	policy DeliveryPolicy,
+) *DispatchNotificationsHandler {
+	return &DispatchNotificationsHandler{
+		repo:      repo,
+		providers: providers,
+		eventBus:  eventBus,
+		policy:    policy,
+	}
+}
+
+// Handle delivers one batch of due notifications and returns how many it attempted
+// A failed delivery is recorded on its notification; only failures to claim or save are returned
+func (h *DispatchNotificationsHandler) Handle(ctx context.Context) (int, error) {
+	notifications, err := h.repo.ClaimDue(ctx, h.policy.BatchSize, h.policy.Lease)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim due notifications: %w", err)
+	}
+
+	for _, notification := range notifications {
+		if err := h.deliver(ctx, notification); err != nil {
+			return 0, err
+		}
+	}
+	return len(notifications), nil
+}
+
+// deliver makes one delivery attempt of a notification and records its outcome
+func (h *DispatchNotificationsHandler) deliver(ctx context.Context, notification *domain.Notification) error {
+	logger := zap.L().With(
+		zap.String("notification_id", notification.GetID()),
+		zap.String("channel", string(notification.Channel)),
+		zap.String("template", notification.Template),
+	)
+
+	provider, err := h.providers.Get(notification.Channel)
+	if err != nil {
+		err = notification.RecordFailure("", err.Error(), time.Time{})
+	} else {
+		messageID, sendErr := provider.Send(ctx, domain.Delivery{
+			NotificationID: notification.GetID(),
+			Recipient:      notification.Recipient,
+			Subject:        notification.Subject,
+			Body:           notification.Body,
+		})
+		switch {
+		case sendErr == nil:
+			err = notification.MarkSent(provider.Name(), messageID)
+			logger.Info("notification sent", zap.String("provider", provider.Name()))
+		default:
+			if ctx.Err() != nil {
+				// Stopping; the lease expires and the notification is claimed again
+				return nil
+			}
+			retryAt := h.retryAt(notification, sendErr)
+			err = notification.RecordFailure(provider.Name(), sendErr.Error(), retryAt)
+			logger.Warn("notification delivery failed",
+				zap.String("provider", provider.Name()),
+				zap.Int("attempt", notification.Attempts),
+				zap.Bool("retrying", !retryAt.IsZero()),
+				zap.Error(sendErr),
+			)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	return saveAndPublish(ctx, h.repo, h.eventBus, notification)
+}
+
+// retryAt returns when a notification failing with err is attempted again, or zero when it fails
+// for good: the provider rejected it or it ran out of attempts
+func (h *DispatchNotificationsHandler) retryAt(notification *domain.Notification, err error) time.Time {
+	if errors.Is(err, domain.ErrRejected) || notification.Attempts+1 >= h.policy.MaxAttempts {
+		return time.Time{}
+	}
+	return time.Now().Add(h.policy.Backoff << notification.Attempts)
+}
@@ -0,0 +1,75 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/notification/application/commands"
+	"golang_modular_monolith/internal/modules/notification/domain"
+	"golang_modular_monolith/internal/shared/application"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"go.uber.org/zap"
+)
+
+// loadNotification retrieves a notification by ID or returns a not found domain error
+func loadNotification(ctx context.Context, repo domain.NotificationRepository, notificationID string) (*domain.Notification, error) {
+	if notificationID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"notification ID is required",
+		)
+	}
+
+	notification, err := repo.GetByID(ctx, notificationID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("notification with ID %s not found", notificationID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get notification: %w", err)
+	}
+
+	return notification, nil
+}
+
+// saveAndPublish persists a changed notification and publishes its uncommitted events
+func saveAndPublish(ctx context.Context, repo domain.NotificationRepository, eventBus shareddomain.EventBus, notification *domain.Notification) error {
+	// Capture events before the repository clears them on save
+	events := notification.GetUncommittedEvents()
+
+	if err := repo.Save(ctx, notification); err != nil {
+		return fmt.Errorf("failed to save notification: %w", err)
+	}
+
+	for _, event := range events {
+		if err := application.PublishEvents(ctx, eventBus, event); err != nil {
+			// The notification is saved; a lost event does not undo its delivery
+			zap.L().Warn("failed to publish notification event",
+				zap.String("event_type", event.GetEventType()),
+				zap.String("notification_id", notification.GetID()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// toNotificationResult converts a notification to a command result
+func toNotificationResult(notification *domain.Notification) *commands.NotificationResult {
+	return &commands.NotificationResult{
+		ID:            notification.GetID(),
+		Channel:       string(notification.Channel),
+		Template:      notification.Template,
+		Locale:        notification.Locale,
+		Recipient:     notification.Recipient,
+		Status:        string(notification.Status),
+		Attempts:      notification.Attempts,
+		NextAttemptAt: notification.NextAttemptAt,
+		SentAt:        notification.SentAt,
+		CreatedAt:     notification.GetCreatedAt(),
+	}
+}
@@ -0,0 +1,41 @@
+package commandhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/notification/application/commands"
+	"golang_modular_monolith/internal/modules/notification/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// RetryNotificationHandler handles RetryNotificationCommand
+type RetryNotificationHandler struct {
+	repo     domain.NotificationRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewRetryNotificationHandler creates a new RetryNotificationHandler
+func NewRetryNotificationHandler(repo domain.NotificationRepository, eventBus shareddomain.EventBus) *RetryNotificationHandler {
+	return &RetryNotificationHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the RetryNotificationCommand
+func (h *RetryNotificationHandler) Handle(ctx context.Context, cmd *commands.RetryNotificationCommand) (*commands.NotificationResult, error) {
+	notification, err := loadNotification(ctx, h.repo, cmd.NotificationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := notification.Retry(); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, notification); err != nil {
+		return nil, err
+	}
+
+	return toNotificationResult(notification), nil
+}
@@ -0,0 +1,86 @@
+package commandhandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/notification/application/commands"
+	"golang_modular_monolith/internal/modules/notification/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// SendNotificationHandler handles SendNotificationCommand
+// The notification is rendered and saved as pending; the dispatcher delivers it
+type SendNotificationHandler struct {
+	repo      domain.NotificationRepository
+	renderer  domain.TemplateRenderer
+	providers domain.Providers
+	eventBus  shareddomain.EventBus
+}
+
+// NewSendNotificationHandler creates a new SendNotificationHandler
+func NewSendNotificationHandler(
+	repo domain.NotificationRepository,
+	renderer domain.TemplateRenderer,
+	providers domain.Providers,
+	eventBus shareddomain.EventBus,
+) *SendNotificationHandler {
+	return &SendNotificationHandler{
+		repo:      repo,
+		renderer:  renderer,
+		providers: providers,
+		eventBus:  eventBus,
+	}
+}
+
+// Handle handles the SendNotificationCommand
+// A notification already queued for the same event and template is returned instead of a new one
+func (h *SendNotificationHandler) Handle(ctx context.Context, cmd *commands.SendNotificationCommand) (*commands.NotificationResult, error) {
+	channel := domain.Channel(cmd.Channel)
+	if !channel.IsValid() {
+		return nil, shareddomain.NewValidationErrorWithValue("channel", "channel must be email or sms", cmd.Channel)
+	}
+
+	// Fail now rather than queue a notification no provider can deliver
+	if _, err := h.providers.Get(channel); err != nil {
+		return nil, err
+	}
+
+	if cmd.SourceEventID != "" {
+		existing, err := h.repo.GetBySource(ctx, cmd.SourceEventID, cmd.Template)
+		if err == nil {
+			return toNotificationResult(existing), nil
+		}
+		if !shareddomain.IsNotFoundError(err) {
+			return nil, fmt.Errorf("failed to check existing notification: %w", err)
+		}
+	}
+
+	message, err := h.renderer.Render(channel, cmd.Template, cmd.Locale, cmd.Data)
+	if err != nil {
+		if errors.Is(err, domain.ErrTemplateNotFound) {
+			return nil, shareddomain.NewDomainErrorWithField(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("%s template %s not found", channel, cmd.Template),
+				"template",
+			)
+		}
+		return nil, shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeInvalidInput,
+			fmt.Sprintf("failed to render %s template %s: %v", channel, cmd.Template, err),
+			"data",
+		)
+	}
+
+	notification, err := domain.NewNotification(channel, cmd.Template, cmd.Recipient, message, cmd.SourceEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, notification); err != nil {
+		return nil, err
+	}
+
+	return toNotificationResult(notification), nil
+}
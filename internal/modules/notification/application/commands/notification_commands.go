@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// SendNotificationCommand represents a command to render a template and queue it for delivery
+type SendNotificationCommand struct {
+	application.BaseCommand
+	Channel   string                 `json:"channel" validate:"required,oneof=email sms"`
+	Template  string                 `json:"template" validate:"required,max=100"`
+	Locale    string                 `json:"locale" validate:"max=35"`
+	Recipient string                 `json:"recipient" validate:"required,max=255"`
+	Data      map[string]interface{} `json:"data"`
+
+	// SourceEventID is the event triggering the notification; the notification is queued once
+	// per event and template
+	SourceEventID string `json:"source_event_id"`
+}
+
+// NewSendNotificationCommand creates a new send notification command
+func NewSendNotificationCommand(channel, template, locale, recipient string, data map[string]interface{}) SendNotificationCommand {
+	return SendNotificationCommand{
+		BaseCommand: application.NewBaseCommand("send_notification"),
+		Channel:     channel,
+		Template:    template,
+		Locale:      locale,
+		Recipient:   recipient,
+		Data:        data,
+	}
+}
+
+// RetryNotificationCommand represents a command to queue a failed notification again
+type RetryNotificationCommand struct {
+	application.BaseCommand
+	NotificationID string `json:"notification_id" validate:"required"`
+}
+
+// NewRetryNotificationCommand creates a new retry notification command
+func NewRetryNotificationCommand(notificationID string) RetryNotificationCommand {
+	return RetryNotificationCommand{
+		BaseCommand:    application.NewBaseCommand("retry_notification"),
+		NotificationID: notificationID,
+	}
+}
+
+// NotificationResult represents the result of a notification command
+type NotificationResult struct {
+	ID            string     `json:"id"`
+	Channel       string     `json:"channel"`
+	Template      string     `json:"template"`
+	Locale        string     `json:"locale"`
+	Recipient     string     `json:"recipient"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	SentAt        *time.Time `json:"sent_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
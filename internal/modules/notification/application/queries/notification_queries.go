@@ -0,0 +1,43 @@
+package queries
+
+import (
+	"golang_modular_monolith/internal/modules/notification/domain"
+)
+
+// GetNotificationQuery represents a query to get a notification by ID
+type GetNotificationQuery struct {
+	ID string `json:"id"`
+}
+
+// GetNotificationResult represents the result of GetNotificationQuery
+type GetNotificationResult struct {
+	Notification domain.Notification `json:"notification"`
+}
+
+// ListNotificationsQuery represents a query to page through the notification log, newest first
+type ListNotificationsQuery struct {
+	Page      int    `json:"page"`
+	Limit     int    `json:"limit"`
+	Channel   string `json:"channel"`
+	Status    string `json:"status"`
+	Template  string `json:"template"`
+	Recipient string `json:"recipient"`
+}
+
+// ToParams converts the query to repository parameters
+func (q *ListNotificationsQuery) ToParams() domain.ListNotificationsParams {
+	return domain.ListNotificationsParams{
+		Page:      q.Page,
+		Limit:     q.Limit,
+		Channel:   domain.Channel(q.Channel),
+		Status:    domain.NotificationStatus(q.Status),
+		Template:  q.Template,
+		Recipient: q.Recipient,
+	}
+}
+
+// ListNotificationsResult represents the result of ListNotificationsQuery
+type ListNotificationsResult struct {
+	Notifications []*domain.Notification  `json:"notifications"`
+	Pagination    domain.PaginationResult `json:"pagination"`
+}
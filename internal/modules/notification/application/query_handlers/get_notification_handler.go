@@ -0,0 +1,47 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/notification/application/queries"
+	"golang_modular_monolith/internal/modules/notification/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// GetNotificationHandler handles GetNotificationQuery
+type GetNotificationHandler struct {
+	repo domain.NotificationRepository
+}
+
+// NewGetNotificationHandler creates a new GetNotificationHandler
+func NewGetNotificationHandler(repo domain.NotificationRepository) *GetNotificationHandler {
+	return &GetNotificationHandler{
+		repo: repo,
+	}
+}
+
+// Handle handles the GetNotificationQuery
+func (h *GetNotificationHandler) Handle(ctx context.Context, query *queries.GetNotificationQuery) (*queries.GetNotificationResult, error) {
+	if query.ID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"notification ID is required",
+		)
+	}
+
+	notification, err := h.repo.GetByID(ctx, query.ID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("notification with ID %s not found", query.ID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get notification: %w", err)
+	}
+
+	return &queries.GetNotificationResult{
+		Notification: *notification,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package queryhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/notification/application/queries"
+	"golang_modular_monolith/internal/modules/notification/domain"
+)
+
+// ListNotificationsHandler handles ListNotificationsQuery
+type ListNotificationsHandler struct {
+	repo domain.NotificationRepository
+}
+
+// NewListNotificationsHandler creates a new ListNotificationsHandler
+func NewListNotificationsHandler(repo domain.NotificationRepository) *ListNotificationsHandler {
+	return &ListNotificationsHandler{
+		repo: repo,
+	}
+}
+
+// Handle handles the ListNotificationsQuery
+func (h *ListNotificationsHandler) Handle(ctx context.Context, query *queries.ListNotificationsQuery) (*queries.ListNotificationsResult, error) {
+	result, err := h.repo.List(ctx, query.ToParams())
+	if err != nil {
+		return nil, err
+	}
+
+	return &queries.ListNotificationsResult{
+		Notifications: result.Notifications,
+		Pagination:    result.Pagination,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package notification
+
+import (
+	commandhandlers "golang_modular_monolith/internal/modules/notification/application/command_handlers"
+	queryhandlers "golang_modular_monolith/internal/modules/notification/application/query_handlers"
+	notificationdomain "golang_modular_monolith/internal/modules/notification/domain"
+	notificationdb "golang_modular_monolith/internal/modules/notification/infrastructure/database"
+	"golang_modular_monolith/internal/modules/notification/infrastructure/eventhandlers"
+	"golang_modular_monolith/internal/modules/notification/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/notification/infrastructure/persistence"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/di"
+)
+
+// newContainer registers the constructors of the notification module's repositories and handlers;
+// nothing is constructed until it is resolved, so callers may replace providers first
+func newContainer(
+	eventBus domain.EventBus,
+	providers notificationdomain.Providers,
+	renderer notificationdomain.TemplateRenderer,
+	policy commandhandlers.DeliveryPolicy,
+	triggers eventhandlers.CustomerTriggers,
+) *di.Container {
+	c := di.New()
+	di.Value(c, eventBus)
+	di.Value(c, providers)
+	di.Value(c, policy)
+	di.Value(c, triggers)
+	di.Value(c, renderer)
+	c.Provide(notificationdb.GetNotificationDB)
+
+	// Repositories
+	c.Provide(persistence.NewPostgreSQLNotificationRepository, di.As[notificationdomain.NotificationRepository]())
+
+	// Command handlers
+	c.Provide(commandhandlers.NewSendNotificationHandler)
+	c.Provide(commandhandlers.NewRetryNotificationHandler)
+	c.Provide(commandhandlers.NewDispatchNotificationsHandler)
+
+	// Cross-module event handlers
+	c.Provide(eventhandlers.NewCustomerEventsHandler)
+
+	// Query handlers
+	c.Provide(queryhandlers.NewGetNotificationHandler)
+	c.Provide(queryhandlers.NewListNotificationsHandler)
+
+	// HTTP handlers
+	c.Provide(handlers.NewNotificationHandler)
+	return c
+}
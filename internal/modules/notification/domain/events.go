@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"golang_modular_monolith/internal/modules/notification/publicapi"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Notification domain event types
+const (
+	NotificationQueuedEventType = "notification.queued"
+	NotificationSentEventType   = publicapi.NotificationSentEventType
+	NotificationFailedEventType = publicapi.NotificationFailedEventType
+)
+
+// NotificationQueuedEvent represents the event when a notification is queued for delivery
+type NotificationQueuedEvent struct {
+	domain.BaseDomainEvent
+	NotificationID string `json:"notification_id"`
+	Channel        string `json:"channel"`
+	Template       string `json:"template"`
+}
+
+// NewNotificationQueuedEvent creates a new notification queued event
+func NewNotificationQueuedEvent(notification *Notification) NotificationQueuedEvent {
+	eventData := map[string]interface{}{
+		"notification_id": notification.GetID(),
+		"channel":         notification.Channel,
+		"template":        notification.Template,
+	}
+
+	return NotificationQueuedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			notification.GetID(),
+			"notification",
+			NotificationQueuedEventType,
+			eventData,
+		),
+		NotificationID: notification.GetID(),
+		Channel:        string(notification.Channel),
+		Template:       notification.Template,
+	}
+}
+
+// NotificationSentEvent represents the event when a provider accepted a notification
+type NotificationSentEvent struct {
+	domain.BaseDomainEvent
+	NotificationID    string `json:"notification_id"`
+	Channel           string `json:"channel"`
+	Template          string `json:"template"`
+	Provider          string `json:"provider"`
+	ProviderMessageID string `json:"provider_message_id"`
+	SourceEventID     string `json:"source_event_id,omitempty"`
+}
+
+// NewNotificationSentEvent creates a new notification sent event
+func NewNotificationSentEvent(notification *Notification) NotificationSentEvent {
+	eventData := map[string]interface{}{
+		"notification_id":     notification.GetID(),
+		"channel":             notification.Channel,
+		"template":            notification.Template,
+		"provider":            notification.Provider,
+		"provider_message_id": notification.ProviderMessageID,
+		"source_event_id":     notification.SourceEventID,
+	}
+
+	return NotificationSentEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			notification.GetID(),
+			"notification",
+			NotificationSentEventType,
+			eventData,
+		),
+		NotificationID:    notification.GetID(),
+		Channel:           string(notification.Channel),
+		Template:          notification.Template,
+		Provider:          notification.Provider,
+		ProviderMessageID: notification.ProviderMessageID,
+		SourceEventID:     notification.SourceEventID,
+	}
+}
+
+// NotificationFailedEvent represents the event when every delivery attempt of a notification failed
+type NotificationFailedEvent struct {
+	domain.BaseDomainEvent
+	NotificationID string `json:"notification_id"`
+	Channel        string `json:"channel"`
+	Template       string `json:"template"`
+	Provider       string `json:"provider"`
+	FailureReason  string `json:"failure_reason"`
+	Attempts       int    `json:"attempts"`
+	SourceEventID  string `json:"source_event_id,omitempty"`
+}
+
+// NewNotificationFailedEvent creates a new notification failed event
+func NewNotificationFailedEvent(notification *Notification) NotificationFailedEvent {
+	eventData := map[string]interface{}{
+		"notification_id": notification.GetID(),
+		"channel":         notification.Channel,
+		"template":        notification.Template,
+		"provider":        notification.Provider,
+		"failure_reason":  notification.FailureReason,
+		"attempts":        notification.Attempts,
+		"source_event_id": notification.SourceEventID,
+	}
+
+	return NotificationFailedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			notification.GetID(),
+			"notification",
+			NotificationFailedEventType,
+			eventData,
+		),
+		NotificationID: notification.GetID(),
+		Channel:        string(notification.Channel),
+		Template:       notification.Template,
+		Provider:       notification.Provider,
+		FailureReason:  notification.FailureReason,
+		Attempts:       notification.Attempts,
+		SourceEventID:  notification.SourceEventID,
+	}
+}
+
+// GetNotificationID returns the ID of the notification
+func (e NotificationSentEvent) GetNotificationID() string {
+	return e.NotificationID
+}
+
+// GetChannel returns the channel the notification was delivered through
+func (e NotificationSentEvent) GetChannel() string {
+	return e.Channel
+}
+
+// GetTemplate returns the template the notification was rendered from
+func (e NotificationSentEvent) GetTemplate() string {
+	return e.Template
+}
+
+// GetSourceEventID returns the event that triggered the notification, empty when requested directly
+func (e NotificationSentEvent) GetSourceEventID() string {
+	return e.SourceEventID
+}
+
+// GetNotificationID returns the ID of the notification
+func (e NotificationFailedEvent) GetNotificationID() string {
+	return e.NotificationID
+}
+
+// GetChannel returns the channel the notification was delivered through
+func (e NotificationFailedEvent) GetChannel() string {
+	return e.Channel
+}
+
+// GetTemplate returns the template the notification was rendered from
+func (e NotificationFailedEvent) GetTemplate() string {
+	return e.Template
+}
+
+// GetSourceEventID returns the event that triggered the notification, empty when requested directly
+func (e NotificationFailedEvent) GetSourceEventID() string {
+	return e.SourceEventID
+}
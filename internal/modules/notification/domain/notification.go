@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Channel is the medium a notification is delivered through
+type Channel string
+
+const (
+	ChannelEmail Channel = "email"
+	ChannelSMS   Channel = "sms"
+)
+
+// IsValid checks if the channel is known
+func (c Channel) IsValid() bool {
+	return c == ChannelEmail || c == ChannelSMS
+}
+
+// NotificationStatus represents the delivery status of a notification
+type NotificationStatus string
+
+const (
+	// NotificationStatusPending means the notification waits for its first or next delivery attempt
+	NotificationStatusPending NotificationStatus = "pending"
+	// NotificationStatusSent means the provider accepted the notification
+	NotificationStatusSent NotificationStatus = "sent"
+	// NotificationStatusFailed means every delivery attempt failed
+	NotificationStatusFailed NotificationStatus = "failed"
+)
+
+// IsValid checks if the notification status is known
+func (s NotificationStatus) IsValid() bool {
+	switch s {
+	case NotificationStatusPending, NotificationStatusSent, NotificationStatusFailed:
+		return true
+	}
+	return false
+}
+
+// Notification represents a rendered message to a recipient and the log of its delivery
+type Notification struct {
+	domain.BaseAggregateRoot
+	Channel   Channel `json:"channel"`
+	Template  string  `json:"template"`
+	Locale    string  `json:"locale"`
+	Recipient string  `json:"recipient"`
+	Subject   string  `json:"subject,omitempty"`
+	Body      string  `json:"body"`
+
+	// SourceEventID is the event the notification was triggered by, empty for notifications
+	// requested directly; a notification is created once per event and template
+	SourceEventID string `json:"source_event_id,omitempty"`
+
+	Status            NotificationStatus `json:"status"`
+	Provider          string             `json:"provider,omitempty"`
+	ProviderMessageID string             `json:"provider_message_id,omitempty"`
+	FailureReason     string             `json:"failure_reason,omitempty"`
+	Attempts          int                `json:"attempts"`
+	NextAttemptAt     time.Time          `json:"next_attempt_at"`
+	SentAt            *time.Time         `json:"sent_at,omitempty"`
+}
+
+// Message is the rendered content of a notification
+type Message struct {
+	Locale  string
+	Subject string
+	Body    string
+}
+
+// NewNotification creates a pending notification of a rendered template
+func NewNotification(channel Channel, template, recipient string, message Message, sourceEventID string) (*Notification, error) {
+	// Validate input
+	var validationErrors domain.ValidationErrors
+
+	if !channel.IsValid() {
+		validationErrors.AddWithValue("channel", "channel must be email or sms", channel)
+	}
+
+	recipient = strings.TrimSpace(recipient)
+	switch {
+	case recipient == "":
+		validationErrors.Add("recipient", "recipient is required")
+	case channel == ChannelEmail:
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			validationErrors.AddWithValue("recipient", "recipient must be an email address", recipient)
+		}
+	case channel == ChannelSMS:
+		if !isPhoneNumber(recipient) {
+			validationErrors.AddWithValue("recipient", "recipient must be a phone number in E.164 format", recipient)
+		}
+	}
+
+	if template == "" {
+		validationErrors.Add("template", "template is required")
+	}
+
+	if strings.TrimSpace(message.Body) == "" {
+		validationErrors.Add("body", "the rendered body is empty")
+	}
+
+	if channel == ChannelEmail && strings.TrimSpace(message.Subject) == "" {
+		validationErrors.Add("subject", "the rendered subject of an email is empty")
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	notification := &Notification{
+		BaseAggregateRoot: domain.NewBaseAggregateRoot(),
+		Channel:           channel,
+		Template:          template,
+		Locale:            message.Locale,
+		Recipient:         recipient,
+		Subject:           strings.TrimSpace(message.Subject),
+		Body:              message.Body,
+		SourceEventID:     sourceEventID,
+		Status:            NotificationStatusPending,
+	}
+	notification.NextAttemptAt = notification.GetCreatedAt()
+
+	// Add domain event
+	notification.AddEvent(NewNotificationQueuedEvent(notification))
+
+	return notification, nil
+}
+
+// MarkSent records the provider accepting the notification
+func (n *Notification) MarkSent(provider, messageID string) error {
+	if n.Status != NotificationStatusPending {
+		return n.invalidState("sent")
+	}
+
+	now := time.Now()
+	n.Status = NotificationStatusSent
+	n.Provider = provider
+	n.ProviderMessageID = messageID
+	n.FailureReason = ""
+	n.Attempts++
+	n.SentAt = &now
+	n.IncrementVersion()
+
+	n.AddEvent(NewNotificationSentEvent(n))
+	return nil
+}
+
+// RecordFailure records a failed delivery attempt; the notification is attempted again at retryAt,
+// or fails for good when retryAt is zero
+func (n *Notification) RecordFailure(provider, reason string, retryAt time.Time) error {
+	if n.Status != NotificationStatusPending {
+		return n.invalidState("failed")
+	}
+
+	n.Provider = provider
+	n.FailureReason = reason
+	n.Attempts++
+	n.IncrementVersion()
+
+	if !retryAt.IsZero() {
+		n.NextAttemptAt = retryAt
+		return nil
+	}
+
+	n.Status = NotificationStatusFailed
+	n.AddEvent(NewNotificationFailedEvent(n))
+	return nil
+}
+
+// Retry queues a failed notification for delivery again, with a fresh budget of attempts
+func (n *Notification) Retry() error {
+	if n.Status != NotificationStatusFailed {
+		return domain.NewBusinessRuleError(
+			"notification_not_failed",
+			fmt.Sprintf("notification %s is %s; only failed notifications can be retried", n.GetID(), n.Status),
+		)
+	}
+
+	n.Status = NotificationStatusPending
+	n.Attempts = 0
+	n.NextAttemptAt = time.Now()
+	n.IncrementVersion()
+
+	n.AddEvent(NewNotificationQueuedEvent(n))
+	return nil
+}
+
+// invalidState reports a transition the notification's status does not allow
+func (n *Notification) invalidState(to string) error {
+	return domain.NewDomainError(
+		domain.ErrCodeInvalidState,
+		fmt.Sprintf("notification %s is %s and cannot be marked %s", n.GetID(), n.Status, to),
+	)
+}
+
+// isPhoneNumber checks for an E.164 phone number, e.g. +84901234567
+func isPhoneNumber(value string) bool {
+	if len(value) < 8 || len(value) > 16 || value[0] != '+' || value[1] == '0' {
+		return false
+	}
+	for _, r := range value[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
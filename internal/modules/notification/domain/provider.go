@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// ErrRejected is wrapped by the errors of providers refusing a notification, e.g. for an invalid
+// recipient; rejected notifications fail without being attempted again
+var ErrRejected = errors.New("notification rejected by provider")
+
+// Delivery is a notification handed to a provider
+// NotificationID doubles as the idempotency key where the provider supports one
+type Delivery struct {
+	NotificationID string
+	Recipient      string
+	Subject        string
+	Body           string
+}
+
+// Provider is implemented by email and SMS service adapters
+type Provider interface {
+	// Name returns the provider identifier stored on notifications (e.g. "smtp")
+	Name() string
+
+	// Channel returns the channel the provider delivers through
+	Channel() Channel
+
+	// Send delivers a notification, returning the provider's ID of the message
+	// It returns an error wrapping ErrRejected when the provider refuses the notification
+	Send(ctx context.Context, delivery Delivery) (string, error)
+}
+
+// Providers holds the provider each channel delivers through
+type Providers map[Channel]Provider
+
+// Get returns the provider of a channel
+func (p Providers) Get(channel Channel) (Provider, error) {
+	provider, ok := p[channel]
+	if !ok {
+		return nil, domain.NewDomainErrorWithField(
+			domain.ErrCodeInvalidInput,
+			fmt.Sprintf("no provider is configured for channel %s", channel),
+			"channel",
+		)
+	}
+	return provider, nil
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// NotificationRepository defines the interface for notification persistence
+type NotificationRepository interface {
+	// Save saves a notification
+	Save(ctx context.Context, notification *Notification) error
+
+	// GetByID retrieves a notification by ID
+	GetByID(ctx context.Context, id string) (*Notification, error)
+
+	// GetBySource retrieves the notification rendered from a template for an event
+	GetBySource(ctx context.Context, sourceEventID, template string) (*Notification, error)
+
+	// List retrieves notifications matching the parameters, newest first, one page at a time
+	List(ctx context.Context, params ListNotificationsParams) (*NotificationListResult, error)
+
+	// ClaimDue returns up to limit pending notifications due for an attempt, longest due first, and
+	// postpones their next attempt by lease, so that other instances do not claim them meanwhile
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*Notification, error)
+}
+
+// ListNotificationsParams represents parameters for listing notifications
+type ListNotificationsParams struct {
+	// Pagination
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+
+	// Filtering
+	Channel   Channel            `json:"channel,omitempty"`
+	Status    NotificationStatus `json:"status,omitempty"`
+	Template  string             `json:"template,omitempty"`
+	Recipient string             `json:"recipient,omitempty"`
+}
+
+// Validate applies defaults and validates the list parameters
+func (p *ListNotificationsParams) Validate() error {
+	if p.Page <= 0 {
+		p.Page = 1
+	}
+
+	if p.Limit <= 0 {
+		p.Limit = 20
+	}
+
+	// Maximum limit
+	if p.Limit > 100 {
+		p.Limit = 100
+	}
+
+	var validationErrors domain.ValidationErrors
+
+	if p.Channel != "" && !p.Channel.IsValid() {
+		validationErrors.AddWithValue("channel", "unknown notification channel", string(p.Channel))
+	}
+
+	if p.Status != "" && !p.Status.IsValid() {
+		validationErrors.AddWithValue("status", "unknown notification status", string(p.Status))
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+	return nil
+}
+
+// NotificationListResult represents the result of a notification list query
+type NotificationListResult struct {
+	Notifications []*Notification  `json:"notifications"`
+	Pagination    PaginationResult `json:"pagination"`
+}
+
+// PaginationResult represents pagination information
+type PaginationResult struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"total_pages"`
+	HasNext    bool  `json:"has_next"`
+	HasPrev    bool  `json:"has_prev"`
+}
+
+// NewPaginationResult creates a new pagination result
+func NewPaginationResult(page, limit int, total int64) PaginationResult {
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return PaginationResult{
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+}
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"errors"
+)
+
+// ErrTemplateNotFound is returned when a template exists in no locale for a channel
+var ErrTemplateNotFound = errors.New("notification template not found")
+
+// TemplateRenderer renders notification templates
+type TemplateRenderer interface {
+	// Render renders a template of a channel in the locale closest to locale: the locale itself,
+	// its base language, then the default locale; the returned message carries the locale used
+	Render(channel Channel, template, locale string, data map[string]interface{}) (Message, error)
+}
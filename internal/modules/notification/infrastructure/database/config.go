@@ -0,0 +1,18 @@
+package database
+
+import (
+	"golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// NotificationDatabaseName is the identifier for notification database
+	NotificationDatabaseName = "notification"
+)
+
+// GetNotificationDB returns the notification database connection
+func GetNotificationDB() (*gorm.DB, error) {
+	manager := database.GetGlobalManager()
+	return manager.GetConnection(NotificationDatabaseName)
+}
@@ -0,0 +1,71 @@
+package eventhandlers
+
+import (
+	"context"
+	"fmt"
+
+	customerapi "golang_modular_monolith/internal/modules/customer/publicapi"
+	commandhandlers "golang_modular_monolith/internal/modules/notification/application/command_handlers"
+	"golang_modular_monolith/internal/modules/notification/application/commands"
+	"golang_modular_monolith/internal/modules/notification/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CustomerTriggers names the templates sent on customer events; an empty name sends nothing
+type CustomerTriggers struct {
+	// Welcome is the email template sent to new customers on customer.created
+	Welcome string
+}
+
+// CustomerEventsHandler queues the notifications triggered by customer events
+type CustomerEventsHandler struct {
+	sendNotificationHandler *commandhandlers.SendNotificationHandler
+	triggers                CustomerTriggers
+}
+
+// NewCustomerEventsHandler creates a new customer events handler
+func NewCustomerEventsHandler(
+	sendNotificationHandler *commandhandlers.SendNotificationHandler,
+	triggers CustomerTriggers,
+) *CustomerEventsHandler {
+	return &CustomerEventsHandler{
+		sendNotificationHandler: sendNotificationHandler,
+		triggers:                triggers,
+	}
+}
+
+// CanHandle reports whether the handler is interested in the event type
+func (h *CustomerEventsHandler) CanHandle(eventType string) bool {
+	return eventType == customerapi.CustomerCreatedEventType && h.triggers.Welcome != ""
+}
+
+// Handle queues the welcome email of a new customer in the customer's locale
+// The notification is queued once per event, so a redelivered event sends no second email
+func (h *CustomerEventsHandler) Handle(event shareddomain.DomainEvent) error {
+	created, ok := event.(customerapi.CustomerCreated)
+	if !ok {
+		return fmt.Errorf("unsupported event %T for customer events handler", event)
+	}
+	if created.GetEmail() == "" {
+		return nil
+	}
+
+	ctx := shareddomain.WithEventActor(context.Background(), event)
+	cmd := commands.NewSendNotificationCommand(
+		string(domain.ChannelEmail),
+		h.triggers.Welcome,
+		created.GetLocale(),
+		created.GetEmail(),
+		map[string]interface{}{
+			"CustomerID": created.GetCustomerID(),
+			"Name":       created.GetName(),
+			"Email":      created.GetEmail(),
+		},
+	)
+	cmd.SourceEventID = event.GetEventID()
+
+	if _, err := h.sendNotificationHandler.Handle(ctx, &cmd); err != nil {
+		return fmt.Errorf("failed to queue welcome email for customer %s: %w", created.GetCustomerID(), err)
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	commandhandlers "golang_modular_monolith/internal/modules/notification/application/command_handlers"
+	"golang_modular_monolith/internal/modules/notification/application/commands"
+	"golang_modular_monolith/internal/modules/notification/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/notification/application/query_handlers"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationHandler handles HTTP requests for notification operations
+type NotificationHandler struct {
+	// Command handlers
+	sendNotificationHandler  *commandhandlers.SendNotificationHandler
+	retryNotificationHandler *commandhandlers.RetryNotificationHandler
+
+	// Query handlers
+	getNotificationHandler   *queryhandlers.GetNotificationHandler
+	listNotificationsHandler *queryhandlers.ListNotificationsHandler
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(
+	sendNotificationHandler *commandhandlers.SendNotificationHandler,
+	retryNotificationHandler *commandhandlers.RetryNotificationHandler,
+	getNotificationHandler *queryhandlers.GetNotificationHandler,
+	listNotificationsHandler *queryhandlers.ListNotificationsHandler,
+) *NotificationHandler {
+	return &NotificationHandler{
+		sendNotificationHandler:  sendNotificationHandler,
+		retryNotificationHandler: retryNotificationHandler,
+		getNotificationHandler:   getNotificationHandler,
+		listNotificationsHandler: listNotificationsHandler,
+	}
+}
+
+// SendNotificationRequest represents the request body for sending a notification
+type SendNotificationRequest struct {
+	Channel   string                 `json:"channel" binding:"required,oneof=email sms"`
+	Template  string                 `json:"template" binding:"required,max=100"`
+	Locale    string                 `json:"locale" binding:"max=35"`
+	Recipient string                 `json:"recipient" binding:"required,max=255"`
+	Data      map[string]interface{} `json:"data"`
+}
+
+// SendNotification handles POST /notifications
+// The notification is queued and answered with 202; its status tells when it is delivered
+func (h *NotificationHandler) SendNotification(c *gin.Context) {
+	var req SendNotificationRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewSendNotificationCommand(req.Channel, req.Template, req.Locale, req.Recipient, req.Data)
+
+	result, err := h.sendNotificationHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// RetryNotification handles POST /notifications/:id/retry
+func (h *NotificationHandler) RetryNotification(c *gin.Context) {
+	cmd := commands.NewRetryNotificationCommand(c.Param("id"))
+
+	result, err := h.retryNotificationHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetNotification handles GET /notifications/:id
+func (h *NotificationHandler) GetNotification(c *gin.Context) {
+	query := &queries.GetNotificationQuery{
+		ID: c.Param("id"),
+	}
+
+	result, err := h.getNotificationHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result.Notification,
+	})
+}
+
+// ListNotifications handles GET /notifications
+func (h *NotificationHandler) ListNotifications(c *gin.Context) {
+	query := &queries.ListNotificationsQuery{
+		Page:      intQuery(c, "page", 1),
+		Limit:     intQuery(c, "limit", 20),
+		Channel:   c.Query("channel"),
+		Status:    c.Query("status"),
+		Template:  c.Query("template"),
+		Recipient: c.Query("recipient"),
+	}
+
+	result, err := h.listNotificationsHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"data":       result.Notifications,
+		"pagination": result.Pagination,
+	})
+}
+
+// intQuery gets an integer query parameter with default value
+func intQuery(c *gin.Context, key string, defaultValue int) int {
+	if str := c.Query(key); str != "" {
+		if val, err := strconv.Atoi(str); err == nil {
+			return val
+		}
+	}
+	return defaultValue
+}
+
+// handleError handles errors and returns appropriate HTTP responses
+func (h *NotificationHandler) handleError(c *gin.Context, err error) {
+	var validationErrs shareddomain.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErrs.Error(),
+				"details": validationErrs,
+			},
+		})
+		return
+	}
+
+	var validationErr shareddomain.ValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErr.Message,
+				"field":   validationErr.Field,
+			},
+		})
+		return
+	}
+
+	var businessErr shareddomain.BusinessRuleError
+	if errors.As(err, &businessErr) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeBusinessRule,
+				"message": businessErr.Message,
+				"rule":    businessErr.Rule,
+			},
+		})
+		return
+	}
+
+	var domainErr shareddomain.DomainError
+	if errors.As(err, &domainErr) {
+		status := http.StatusInternalServerError
+		switch domainErr.Code {
+		case shareddomain.ErrCodeNotFound:
+			status = http.StatusNotFound
+		case shareddomain.ErrCodeAlreadyExists, shareddomain.ErrCodeConcurrencyConflict:
+			status = http.StatusConflict
+		case shareddomain.ErrCodeInvalidInput, shareddomain.ErrCodeValidationFailed:
+			status = http.StatusBadRequest
+		case shareddomain.ErrCodeRequestTooLarge:
+			status = http.StatusRequestEntityTooLarge
+		case shareddomain.ErrCodeRequestTimeout:
+			status = http.StatusRequestTimeout
+		case shareddomain.ErrCodeInvalidState:
+			status = http.StatusUnprocessableEntity
+		}
+
+		if status == http.StatusInternalServerError {
+			h.internalError(c)
+			return
+		}
+
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    domainErr.Code,
+				"message": domainErr.Message,
+				"field":   domainErr.Field,
+			},
+		})
+		return
+	}
+
+	h.internalError(c)
+}
+
+// internalError writes a generic internal error response
+func (h *NotificationHandler) internalError(c *gin.Context) {
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": "An internal error occurred",
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/notification/application/commands"
+	"golang_modular_monolith/internal/modules/notification/domain"
+	"golang_modular_monolith/internal/modules/notification/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+)
+
+// APIOperations documents the routes registered by RegisterNotificationRoutes
+func APIOperations() []*openapi.Operation {
+	return []*openapi.Operation{
+		openapi.Get("/notifications", "List the notification log, newest first").
+			Query("page", "integer", "Page number, from 1").
+			Query("limit", "integer", "Page size").
+			Query("channel", "string", "email or sms").
+			Query("status", "string", "pending, sent or failed").
+			Query("template", "string", "Template name").
+			Query("recipient", "string", "Email address or phone number").
+			Paginated([]domain.Notification{}, domain.PaginationResult{}),
+		openapi.Get("/notifications/:id", "Get a notification").
+			Returns(domain.Notification{}),
+		openapi.Post("/notifications", "Send a templated notification").
+			Describe("The template is rendered in the requested locale, falling back to the default locale, and queued; the notification's status tells when it is delivered").
+			Body(handlers.SendNotificationRequest{}).
+			Accepted(commands.NotificationResult{}),
+		openapi.Post("/notifications/:id/retry", "Queue a failed notification again").
+			Accepted(commands.NotificationResult{}),
+	}
+}
@@ -0,0 +1,19 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/notification/infrastructure/http/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterNotificationRoutes registers notification routes
+func RegisterNotificationRoutes(router *gin.RouterGroup, notificationHandler *handlers.NotificationHandler) {
+	// Notification routes
+	notifications := router.Group("/notifications")
+	{
+		notifications.GET("", notificationHandler.ListNotifications)
+		notifications.POST("", notificationHandler.SendNotification)
+		notifications.GET("/:id", notificationHandler.GetNotification)
+		notifications.POST("/:id/retry", notificationHandler.RetryNotification)
+	}
+}
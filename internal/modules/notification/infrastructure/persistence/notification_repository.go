@@ -0,0 +1,232 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/notification/domain"
+	notificationdb "golang_modular_monolith/internal/modules/notification/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"gorm.io/gorm"
+)
+
+// NotificationModel represents the notification database model
+// Notifications belong to the tenant of the request or event that sent them
+type NotificationModel struct {
+	ID                string     `gorm:"primaryKey;type:varchar(36)"`
+	TenantID          string     `gorm:"type:varchar(64);not null;default:''"`
+	Channel           string     `gorm:"type:varchar(16);not null"`
+	Template          string     `gorm:"type:varchar(100);not null"`
+	Locale            string     `gorm:"type:varchar(35);not null;default:''"`
+	Recipient         string     `gorm:"type:varchar(255);not null"`
+	Subject           string     `gorm:"type:text;not null;default:''"`
+	Body              string     `gorm:"type:text;not null"`
+	SourceEventID     string     `gorm:"type:varchar(36);not null;default:''"`
+	Status            string     `gorm:"type:notification_status;not null;default:pending"`
+	Provider          string     `gorm:"type:varchar(32);not null;default:''"`
+	ProviderMessageID string     `gorm:"type:varchar(255);not null;default:''"`
+	FailureReason     string     `gorm:"type:text;not null;default:''"`
+	Attempts          int        `gorm:"not null;default:0"`
+	NextAttemptAt     time.Time  `gorm:"type:timestamp with time zone;not null"`
+	SentAt            *time.Time `gorm:"type:timestamp with time zone"`
+	Version           int        `gorm:"not null;default:0"`
+	CreatedAt         time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt         time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (NotificationModel) TableName() string {
+	return "notifications"
+}
+
+// ToEntity converts database model to domain entity
+func (m *NotificationModel) ToEntity() *domain.Notification {
+	notification := &domain.Notification{
+		BaseAggregateRoot: shareddomain.NewBaseAggregateRootWithID(m.ID),
+		Channel:           domain.Channel(m.Channel),
+		Template:          m.Template,
+		Locale:            m.Locale,
+		Recipient:         m.Recipient,
+		Subject:           m.Subject,
+		Body:              m.Body,
+		SourceEventID:     m.SourceEventID,
+		Status:            domain.NotificationStatus(m.Status),
+		Provider:          m.Provider,
+		ProviderMessageID: m.ProviderMessageID,
+		FailureReason:     m.FailureReason,
+		Attempts:          m.Attempts,
+		NextAttemptAt:     m.NextAttemptAt,
+		SentAt:            m.SentAt,
+	}
+
+	// Set version and timestamps from database
+	notification.Version = m.Version
+	notification.CreatedAt = m.CreatedAt
+	notification.UpdatedAt = m.UpdatedAt
+
+	return notification
+}
+
+// FromEntity converts domain entity to database model
+func (m *NotificationModel) FromEntity(notification *domain.Notification) {
+	m.ID = notification.GetID()
+	m.Channel = string(notification.Channel)
+	m.Template = notification.Template
+	m.Locale = notification.Locale
+	m.Recipient = notification.Recipient
+	m.Subject = notification.Subject
+	m.Body = notification.Body
+	m.SourceEventID = notification.SourceEventID
+	m.Status = string(notification.Status)
+	m.Provider = notification.Provider
+	m.ProviderMessageID = notification.ProviderMessageID
+	m.FailureReason = notification.FailureReason
+	m.Attempts = notification.Attempts
+	m.NextAttemptAt = notification.NextAttemptAt
+	m.SentAt = notification.SentAt
+	m.Version = notification.GetVersion()
+	m.CreatedAt = notification.GetCreatedAt()
+	m.UpdatedAt = notification.GetUpdatedAt()
+}
+
+// PostgreSQLNotificationRepository implements NotificationRepository using PostgreSQL
+type PostgreSQLNotificationRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLNotificationRepository creates a new PostgreSQL notification repository
+func NewPostgreSQLNotificationRepository(db *gorm.DB) *PostgreSQLNotificationRepository {
+	return &PostgreSQLNotificationRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLNotificationRepositoryFromManager creates repository using database manager
+func NewPostgreSQLNotificationRepositoryFromManager() (*PostgreSQLNotificationRepository, error) {
+	db, err := notificationdb.GetNotificationDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification database: %w", err)
+	}
+
+	return &PostgreSQLNotificationRepository{
+		db: db,
+	}, nil
+}
+
+// Save saves a notification
+func (r *PostgreSQLNotificationRepository) Save(ctx context.Context, notification *domain.Notification) error {
+	model := &NotificationModel{}
+	model.FromEntity(notification)
+
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return fmt.Errorf("failed to save notification: %w", err)
+	}
+
+	// Clear uncommitted events after successful save
+	notification.ClearUncommittedEvents()
+
+	return nil
+}
+
+// GetByID retrieves a notification by ID
+func (r *PostgreSQLNotificationRepository) GetByID(ctx context.Context, id string) (*domain.Notification, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+// GetBySource retrieves the notification rendered from a template for an event
+func (r *PostgreSQLNotificationRepository) GetBySource(ctx context.Context, sourceEventID, template string) (*domain.Notification, error) {
+	return r.findOne(ctx, "source_event_id = ? AND template = ?", sourceEventID, template)
+}
+
+// findOne loads a single notification
+func (r *PostgreSQLNotificationRepository) findOne(ctx context.Context, condition string, values ...interface{}) (*domain.Notification, error) {
+	var model NotificationModel
+	result := r.db.WithContext(ctx).Where(condition, values...).First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get notification: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// List retrieves notifications matching the parameters, newest first, one page at a time
+func (r *PostgreSQLNotificationRepository) List(ctx context.Context, params domain.ListNotificationsParams) (*domain.NotificationListResult, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := r.db.WithContext(ctx).Model(&NotificationModel{})
+
+	// Apply filters
+	if params.Channel != "" {
+		query = query.Where("channel = ?", params.Channel)
+	}
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.Template != "" {
+		query = query.Where("template = ?", params.Template)
+	}
+	if params.Recipient != "" {
+		query = query.Where("recipient = ?", params.Recipient)
+	}
+
+	// Count total records
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	var models []NotificationModel
+	offset := (params.Page - 1) * params.Limit
+	if err := query.Order("created_at DESC, id").Offset(offset).Limit(params.Limit).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+
+	notifications := make([]*domain.Notification, len(models))
+	for i := range models {
+		notifications[i] = models[i].ToEntity()
+	}
+
+	return &domain.NotificationListResult{
+		Notifications: notifications,
+		Pagination:    domain.NewPaginationResult(params.Page, params.Limit, total),
+	}, nil
+}
+
+// ClaimDue returns up to limit pending notifications due for an attempt, longest due first, and
+// postpones their next attempt by lease
+// Rows locked by another instance's claim are skipped rather than waited for; without a tenant in
+// the context, notifications of every tenant are claimed
+func (r *PostgreSQLNotificationRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.Notification, error) {
+	now := time.Now()
+	tenantID, _ := shareddomain.TenantIDFromContext(ctx)
+
+	var models []NotificationModel
+	result := r.db.WithContext(ctx).Raw(`
+		UPDATE notifications SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE status = 'pending' AND next_attempt_at <= ? AND (? = '' OR tenant_id = ?)
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, now.Add(lease), now, tenantID, tenantID, limit).Scan(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to claim notifications: %w", result.Error)
+	}
+
+	notifications := make([]*domain.Notification, len(models))
+	for i := range models {
+		notifications[i] = models[i].ToEntity()
+	}
+	return notifications, nil
+}
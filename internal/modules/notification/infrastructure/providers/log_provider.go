@@ -0,0 +1,45 @@
+package providers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/modules/notification/domain"
+)
+
+// LogProviderName is the identifier of the log provider
+const LogProviderName = "log"
+
+// LogProvider writes notifications to the log instead of delivering them, for local development
+type LogProvider struct {
+	channel domain.Channel
+	logger  *zap.Logger
+}
+
+// NewLogProvider creates a log provider for a channel
+func NewLogProvider(channel domain.Channel, logger *zap.Logger) *LogProvider {
+	return &LogProvider{channel: channel, logger: logger}
+}
+
+// Name returns the provider identifier
+func (p *LogProvider) Name() string {
+	return LogProviderName
+}
+
+// Channel returns the channel the provider delivers through
+func (p *LogProvider) Channel() domain.Channel {
+	return p.channel
+}
+
+// Send logs the notification; the notification ID serves as the message ID
+func (p *LogProvider) Send(_ context.Context, delivery domain.Delivery) (string, error) {
+	p.logger.Info("notification delivered to the log",
+		zap.String("channel", string(p.channel)),
+		zap.String("notification_id", delivery.NotificationID),
+		zap.String("recipient", delivery.Recipient),
+		zap.String("subject", delivery.Subject),
+		zap.String("body", delivery.Body),
+	)
+	return delivery.NotificationID, nil
+}
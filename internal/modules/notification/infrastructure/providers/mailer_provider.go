@@ -0,0 +1,49 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/notification/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// MailerProviderName is the identifier of the provider sending through the shared mailer
+const MailerProviderName = "mailer"
+
+// MailerProvider delivers emails through a mailer: the module's own, configured by global.mail,
+// or one of its own SMTP server
+type MailerProvider struct {
+	name   string
+	mailer shareddomain.Mailer
+}
+
+// NewMailerProvider creates an email provider sending through the module's mailer
+func NewMailerProvider(mailer shareddomain.Mailer) *MailerProvider {
+	return &MailerProvider{name: MailerProviderName, mailer: mailer}
+}
+
+// Name returns the provider identifier
+func (p *MailerProvider) Name() string {
+	return p.name
+}
+
+// Channel returns the channel the provider delivers through
+func (p *MailerProvider) Channel() domain.Channel {
+	return domain.ChannelEmail
+}
+
+// Send delivers an email, returning its Message-ID
+func (p *MailerProvider) Send(ctx context.Context, delivery domain.Delivery) (string, error) {
+	messageID, err := p.mailer.Send(ctx, shareddomain.Email{
+		ID:      delivery.NotificationID,
+		To:      []string{delivery.Recipient},
+		Subject: delivery.Subject,
+		Text:    delivery.Body,
+	})
+	if errors.Is(err, shareddomain.ErrMailRejected) {
+		return "", fmt.Errorf("%w: %w", err, domain.ErrRejected)
+	}
+	return messageID, err
+}
@@ -0,0 +1,175 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"golang_modular_monolith/internal/modules/notification/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/awssig"
+	"golang_modular_monolith/internal/shared/infrastructure/resilience"
+)
+
+// SESProviderName is the identifier of the Amazon SES provider
+const SESProviderName = "ses"
+
+// SESConfig configures the Amazon SES adapter
+type SESConfig struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// From is the verified sender address, e.g. "Shop <no-reply@example.com>"
+	From string
+	// ConfigurationSet names the SES configuration set recording delivery events, if any
+	ConfigurationSet string
+	// BaseURL defaults to the SES endpoint of the region
+	BaseURL string
+	// Timeout bounds each attempt of an API call
+	Timeout time.Duration
+	// Retries is the number of times a call failing with a transport error or a 5xx is repeated
+	Retries int
+	// Breaker stops calling SES for a while after consecutive failed calls
+	Breaker resilience.BreakerSettings
+}
+
+// SESProvider delivers emails through the Amazon SES v2 SendEmail API
+type SESProvider struct {
+	config SESConfig
+	client *http.Client
+	policy resilience.Policy
+}
+
+// NewSESProvider creates a new Amazon SES email provider
+func NewSESProvider(config SESConfig) (*SESProvider, error) {
+	if config.Region == "" {
+		return nil, fmt.Errorf("ses region is required")
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("ses access_key_id and secret_access_key are required")
+	}
+	if config.From == "" {
+		return nil, fmt.Errorf("ses from address is required")
+	}
+	if config.BaseURL == "" {
+		config.BaseURL = "https://email." + config.Region + ".amazonaws.com"
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	return &SESProvider{
+		config: config,
+		client: &http.Client{},
+		policy: resilience.Policy{
+			Timeout: config.Timeout,
+			Retries: config.Retries,
+			Breaker: resilience.NewBreaker("notification:"+SESProviderName, config.Breaker),
+		},
+	}, nil
+}
+
+// Name returns the provider identifier
+func (p *SESProvider) Name() string {
+	return SESProviderName
+}
+
+// Channel returns the channel the provider delivers through
+func (p *SESProvider) Channel() domain.Channel {
+	return domain.ChannelEmail
+}
+
+// sesContent is a text part of an SES message
+type sesContent struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset"`
+}
+
+// sesSendEmailRequest is the body of a SendEmail call with simple content
+type sesSendEmailRequest struct {
+	FromEmailAddress string `json:"FromEmailAddress"`
+	Destination      struct {
+		ToAddresses []string `json:"ToAddresses"`
+	} `json:"Destination"`
+	Content struct {
+		Simple struct {
+			Subject sesContent `json:"Subject"`
+			Body    struct {
+				Text sesContent `json:"Text"`
+			} `json:"Body"`
+		} `json:"Simple"`
+	} `json:"Content"`
+	ConfigurationSetName string `json:"ConfigurationSetName,omitempty"`
+}
+
+// Send delivers an email, returning the SES message ID
+func (p *SESProvider) Send(ctx context.Context, delivery domain.Delivery) (string, error) {
+	var request sesSendEmailRequest
+	request.FromEmailAddress = p.config.From
+	request.Destination.ToAddresses = []string{delivery.Recipient}
+	request.Content.Simple.Subject = sesContent{Data: delivery.Subject, Charset: "UTF-8"}
+	request.Content.Simple.Body.Text = sesContent{Data: delivery.Body, Charset: "UTF-8"}
+	request.ConfigurationSetName = p.config.ConfigurationSet
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode ses request: %w", err)
+	}
+
+	var messageID string
+	err = p.policy.Do(ctx, func(ctx context.Context) error {
+		var err error
+		messageID, err = p.send(ctx, payload)
+		return err
+	})
+	return messageID, err
+}
+
+// send makes one attempt of a SendEmail call
+func (p *SESProvider) send(ctx context.Context, payload []byte) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.BaseURL+"/v2/email/outbound-emails", bytes.NewReader(payload))
+	if err != nil {
+		return "", resilience.Permanent(fmt.Errorf("failed to build ses request: %w", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	awssig.Sign(req, awssig.PayloadHash(payload), awssig.Credentials{
+		AccessKeyID:     p.config.AccessKeyID,
+		SecretAccessKey: p.config.SecretAccessKey,
+		SessionToken:    p.config.SessionToken,
+	}, awssig.Scope{Region: p.config.Region, Service: "ses"}, time.Now())
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("ses request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("failed to read ses response: %w", err)
+	}
+
+	// Throttling is temporary; other client errors reject the email
+	if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+		return "", fmt.Errorf("ses returned status %d", resp.StatusCode)
+	}
+	if resp.StatusCode >= 400 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		json.Unmarshal(body, &apiErr)
+		return "", resilience.Permanent(fmt.Errorf("ses returned status %d: %s: %w", resp.StatusCode, apiErr.Message, domain.ErrRejected))
+	}
+
+	var result struct {
+		MessageID string `json:"MessageId"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("failed to decode ses response: %w", err)
+	}
+	return result.MessageID, nil
+}
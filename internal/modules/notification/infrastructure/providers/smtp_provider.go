@@ -0,0 +1,46 @@
+package providers
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/shared/infrastructure/mailer"
+)
+
+// SMTPProviderName is the identifier of the SMTP provider
+const SMTPProviderName = "smtp"
+
+// SMTPConfig configures the SMTP adapter
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// From is the sender address, e.g. "Shop <no-reply@example.com>"
+	From string
+	// ImplicitTLS connects over TLS (port 465); otherwise STARTTLS is used when the server offers it
+	ImplicitTLS bool
+	// Timeout bounds the whole exchange with the server
+	Timeout time.Duration
+}
+
+// NewSMTPProvider creates an email provider delivering through an SMTP server of its own, apart
+// from the server of global.mail
+func NewSMTPProvider(config SMTPConfig) (*MailerProvider, error) {
+	transport, err := mailer.NewSMTPTransport(mailer.SMTPConfig{
+		Host:        config.Host,
+		Port:        config.Port,
+		Username:    config.Username,
+		Password:    config.Password,
+		ImplicitTLS: config.ImplicitTLS,
+		Timeout:     config.Timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	smtp, err := mailer.New(transport, mailer.Options{From: config.From})
+	if err != nil {
+		return nil, err
+	}
+	return &MailerProvider{name: SMTPProviderName, mailer: smtp.Default()}, nil
+}
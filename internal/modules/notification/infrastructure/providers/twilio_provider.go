@@ -0,0 +1,140 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/modules/notification/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/resilience"
+)
+
+// TwilioProviderName is the identifier of the Twilio provider
+const TwilioProviderName = "twilio"
+
+// TwilioConfig configures the Twilio adapter
+type TwilioConfig struct {
+	AccountSID string
+	AuthToken  string
+	// From is the sending phone number in E.164 format, or a messaging service SID (MG...)
+	From string
+	// BaseURL defaults to the public Twilio API
+	BaseURL string
+	// Timeout bounds each attempt of an API call
+	Timeout time.Duration
+	// Retries is the number of times a call failing with a transport error or a 5xx is repeated
+	Retries int
+	// Breaker stops calling Twilio for a while after consecutive failed calls
+	Breaker resilience.BreakerSettings
+}
+
+// TwilioProvider delivers SMS through the Twilio Messages API
+type TwilioProvider struct {
+	config TwilioConfig
+	client *http.Client
+	policy resilience.Policy
+}
+
+// NewTwilioProvider creates a new Twilio SMS provider
+func NewTwilioProvider(config TwilioConfig) (*TwilioProvider, error) {
+	if config.AccountSID == "" || config.AuthToken == "" {
+		return nil, fmt.Errorf("twilio account_sid and auth_token are required")
+	}
+	if config.From == "" {
+		return nil, fmt.Errorf("twilio from is required")
+	}
+	if config.BaseURL == "" {
+		config.BaseURL = "https://api.twilio.com"
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	return &TwilioProvider{
+		config: config,
+		client: &http.Client{},
+		policy: resilience.Policy{
+			Timeout: config.Timeout,
+			Retries: config.Retries,
+			Breaker: resilience.NewBreaker("notification:"+TwilioProviderName, config.Breaker),
+		},
+	}, nil
+}
+
+// Name returns the provider identifier
+func (p *TwilioProvider) Name() string {
+	return TwilioProviderName
+}
+
+// Channel returns the channel the provider delivers through
+func (p *TwilioProvider) Channel() domain.Channel {
+	return domain.ChannelSMS
+}
+
+// Send delivers an SMS, returning the Twilio message SID
+func (p *TwilioProvider) Send(ctx context.Context, delivery domain.Delivery) (string, error) {
+	form := url.Values{}
+	form.Set("To", delivery.Recipient)
+	form.Set("Body", delivery.Body)
+	if strings.HasPrefix(p.config.From, "MG") {
+		form.Set("MessagingServiceSid", p.config.From)
+	} else {
+		form.Set("From", p.config.From)
+	}
+
+	var sid string
+	err := p.policy.Do(ctx, func(ctx context.Context) error {
+		var err error
+		sid, err = p.send(ctx, form)
+		return err
+	})
+	return sid, err
+}
+
+// send makes one attempt of a message creation
+func (p *TwilioProvider) send(ctx context.Context, form url.Values) (string, error) {
+	endpoint := p.config.BaseURL + "/2010-04-01/Accounts/" + url.PathEscape(p.config.AccountSID) + "/Messages.json"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", resilience.Permanent(fmt.Errorf("failed to build twilio request: %w", err))
+	}
+	req.SetBasicAuth(p.config.AccountSID, p.config.AuthToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("twilio request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("failed to read twilio response: %w", err)
+	}
+
+	// Rate limiting is temporary; other client errors reject the message
+	if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+		return "", fmt.Errorf("twilio returned status %d", resp.StatusCode)
+	}
+	if resp.StatusCode >= 400 {
+		var apiErr struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		json.Unmarshal(body, &apiErr)
+		return "", resilience.Permanent(fmt.Errorf("twilio error %d: %s: %w", apiErr.Code, apiErr.Message, domain.ErrRejected))
+	}
+
+	var message struct {
+		SID string `json:"sid"`
+	}
+	if err := json.Unmarshal(body, &message); err != nil {
+		return "", fmt.Errorf("failed to decode twilio response: %w", err)
+	}
+	return message.SID, nil
+}
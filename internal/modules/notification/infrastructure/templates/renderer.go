@@ -0,0 +1,146 @@
+// Package templates renders notification templates: Go text templates named
+// <channel>/<template>.<locale>.tmpl, e.g. email/welcome.vi.tmpl, defining a "body" and, for
+// emails, a "subject". Built-in templates are embedded in the binary and may be overridden or
+// extended from a directory with the same layout.
+package templates
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"text/template"
+
+	"golang.org/x/text/language"
+
+	"golang_modular_monolith/internal/modules/notification/domain"
+)
+
+//go:embed email sms
+var builtin embed.FS
+
+// templateKey identifies a template in one locale
+type templateKey struct {
+	channel domain.Channel
+	name    string
+	locale  string
+}
+
+// Renderer renders the built-in templates and the templates of an optional directory
+type Renderer struct {
+	defaultLocale string
+	templates     map[templateKey]*template.Template
+}
+
+// NewRenderer parses the built-in templates, then those of dir, which replace built-in templates
+// of the same channel, name and locale; templates missing in a locale fall back to defaultLocale
+func NewRenderer(defaultLocale string, dir fs.FS) (*Renderer, error) {
+	tag, err := language.Parse(defaultLocale)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default locale %q: %w", defaultLocale, err)
+	}
+
+	r := &Renderer{
+		defaultLocale: tag.String(),
+		templates:     make(map[templateKey]*template.Template),
+	}
+	if err := r.load(builtin); err != nil {
+		return nil, err
+	}
+	if dir != nil {
+		if err := r.load(dir); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// load parses the templates of every channel in fsys
+func (r *Renderer) load(fsys fs.FS) error {
+	for _, channel := range []domain.Channel{domain.ChannelEmail, domain.ChannelSMS} {
+		files, err := fs.Glob(fsys, string(channel)+"/*.tmpl")
+		if err != nil {
+			return err
+		}
+
+		for _, file := range files {
+			name, locale, ok := strings.Cut(strings.TrimSuffix(path.Base(file), ".tmpl"), ".")
+			if !ok || name == "" {
+				return fmt.Errorf("template %s must be named <template>.<locale>.tmpl", file)
+			}
+			tag, err := language.Parse(locale)
+			if err != nil {
+				return fmt.Errorf("template %s: invalid locale %q: %w", file, locale, err)
+			}
+
+			content, err := fs.ReadFile(fsys, file)
+			if err != nil {
+				return err
+			}
+			tmpl, err := template.New(file).Option("missingkey=error").Parse(string(content))
+			if err != nil {
+				return fmt.Errorf("failed to parse template %s: %w", file, err)
+			}
+
+			if tmpl.Lookup("body") == nil {
+				return fmt.Errorf("template %s does not define a body", file)
+			}
+			if channel == domain.ChannelEmail && tmpl.Lookup("subject") == nil {
+				return fmt.Errorf("template %s does not define a subject", file)
+			}
+
+			r.templates[templateKey{channel: channel, name: name, locale: tag.String()}] = tmpl
+		}
+	}
+	return nil
+}
+
+// Render renders a template of a channel in the locale closest to locale: the locale itself, its
+// base language, then the default locale
+func (r *Renderer) Render(channel domain.Channel, name, locale string, data map[string]interface{}) (domain.Message, error) {
+	for _, candidate := range r.candidates(locale) {
+		tmpl, ok := r.templates[templateKey{channel: channel, name: name, locale: candidate}]
+		if !ok {
+			continue
+		}
+
+		message := domain.Message{Locale: candidate}
+		var err error
+		if message.Body, err = execute(tmpl, "body", data); err != nil {
+			return domain.Message{}, err
+		}
+		if tmpl.Lookup("subject") != nil {
+			if message.Subject, err = execute(tmpl, "subject", data); err != nil {
+				return domain.Message{}, err
+			}
+			message.Subject = strings.Join(strings.Fields(message.Subject), " ")
+		}
+		message.Body = strings.TrimSpace(message.Body)
+		return message, nil
+	}
+
+	return domain.Message{}, fmt.Errorf("%s template %s: %w", channel, name, domain.ErrTemplateNotFound)
+}
+
+// candidates lists the locales to look a template up in, in order
+func (r *Renderer) candidates(locale string) []string {
+	var candidates []string
+	if tag, err := language.Parse(locale); err == nil && locale != "" {
+		candidates = append(candidates, tag.String())
+		if base, confidence := tag.Base(); confidence != language.No && base.String() != tag.String() {
+			candidates = append(candidates, base.String())
+		}
+	}
+	return append(candidates, r.defaultLocale)
+}
+
+// execute renders a named template of the set
+func execute(tmpl *template.Template, name string, data map[string]interface{}) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,5 @@
+-- Drop notification tables
+DROP TABLE IF EXISTS "public"."notifications";
+
+-- Drop notification status enum
+DROP TYPE IF EXISTS "public"."notification_status";
@@ -0,0 +1,35 @@
+-- Create notification status enum
+DO $$ BEGIN
+    CREATE TYPE "public"."notification_status" AS ENUM ('pending', 'sent', 'failed');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+
+-- Create notifications table (the rendered message and the log of its delivery)
+CREATE TABLE IF NOT EXISTS "public"."notifications" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "channel" VARCHAR(16) NOT NULL,
+    "template" VARCHAR(100) NOT NULL,
+    "locale" VARCHAR(35) NOT NULL DEFAULT '',
+    "recipient" VARCHAR(255) NOT NULL,
+    "subject" TEXT NOT NULL DEFAULT '',
+    "body" TEXT NOT NULL,
+    "source_event_id" VARCHAR(36) NOT NULL DEFAULT '',
+    "status" "public"."notification_status" NOT NULL DEFAULT 'pending'::notification_status,
+    "provider" VARCHAR(32) NOT NULL DEFAULT '',
+    "provider_message_id" VARCHAR(255) NOT NULL DEFAULT '',
+    "failure_reason" TEXT NOT NULL DEFAULT '',
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "next_attempt_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "sent_at" TIMESTAMP WITH TIME ZONE,
+    "version" INTEGER NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for better performance
+-- One notification per triggering event and template, so redelivered events are not sent twice
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notifications_source ON "public"."notifications" ("source_event_id", "template") WHERE "source_event_id" <> '';
+CREATE INDEX IF NOT EXISTS idx_notifications_due ON "public"."notifications" ("next_attempt_at") WHERE "status" = 'pending';
+CREATE INDEX IF NOT EXISTS idx_notifications_created_at ON "public"."notifications" ("created_at");
+CREATE INDEX IF NOT EXISTS idx_notifications_recipient ON "public"."notifications" ("recipient", "created_at");
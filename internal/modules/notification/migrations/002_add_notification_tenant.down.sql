@@ -0,0 +1,3 @@
+-- Drop the tenant of notifications
+DROP INDEX IF EXISTS idx_notifications_tenant_created_at;
+ALTER TABLE "public"."notifications" DROP COLUMN IF EXISTS "tenant_id";
@@ -0,0 +1,3 @@
+-- Notifications belong to the tenant they were sent in; notifications sent without multi-tenancy have none
+ALTER TABLE "public"."notifications" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_notifications_tenant_created_at ON "public"."notifications" ("tenant_id", "created_at");
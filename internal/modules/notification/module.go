@@ -0,0 +1,402 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	commandhandlers "golang_modular_monolith/internal/modules/notification/application/command_handlers"
+	notificationdomain "golang_modular_monolith/internal/modules/notification/domain"
+	"golang_modular_monolith/internal/modules/notification/infrastructure/eventhandlers"
+	notificationhttp "golang_modular_monolith/internal/modules/notification/infrastructure/http"
+	"golang_modular_monolith/internal/modules/notification/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/notification/infrastructure/providers"
+	"golang_modular_monolith/internal/modules/notification/infrastructure/templates"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/di"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+	"golang_modular_monolith/internal/shared/infrastructure/registry"
+	"golang_modular_monolith/internal/shared/infrastructure/resilience"
+	"golang_modular_monolith/internal/shared/infrastructure/worker"
+)
+
+// Auto-register notification module on package import
+func init() {
+	registry.RegisterModule("notification", func() domain.Module {
+		return NewNotificationModule()
+	})
+}
+
+// NotificationModule implements the Module interface
+type NotificationModule struct {
+	name           string
+	logger         *zap.Logger
+	handler        *handlers.NotificationHandler
+	dispatcher     *commandhandlers.DispatchNotificationsHandler
+	customerEvents *eventhandlers.CustomerEventsHandler
+	pollInterval   time.Duration
+	workers        *worker.Manager
+
+	// Dependencies
+	eventBus domain.EventBus
+}
+
+// NewNotificationModule creates a new notification module
+func NewNotificationModule() *NotificationModule {
+	return &NotificationModule{
+		name: "notification",
+	}
+}
+
+// Name returns the module name
+func (m *NotificationModule) Name() string {
+	return m.name
+}
+
+// Initialize initializes the notification module with dependencies
+func (m *NotificationModule) Initialize(deps domain.ModuleDependencies) error {
+	m.logger = deps.Logger
+	m.logger.Info("initializing module")
+
+	// Store event bus
+	m.eventBus = deps.EventBus
+
+	var settings notificationSettings
+	if err := deps.Config.Decode("", &settings); err != nil {
+		return fmt.Errorf("invalid notification config: %w", err)
+	}
+
+	renderer, err := loadTemplates(settings)
+	if err != nil {
+		return fmt.Errorf("failed to load notification templates: %w", err)
+	}
+
+	channelProviders, err := loadProviders(settings, deps.Mailer, m.logger)
+	if err != nil {
+		return fmt.Errorf("invalid notification provider config: %w", err)
+	}
+	for channel, provider := range channelProviders {
+		m.logger.Info("notification provider configured",
+			zap.String("channel", string(channel)),
+			zap.String("provider", provider.Name()),
+		)
+	}
+
+	policy, pollInterval := settings.Delivery.policy()
+	m.pollInterval = pollInterval
+	m.workers = worker.NewManager(m.name, m.logger)
+
+	// Construct repositories and handlers from their constructors
+	container := newContainer(m.eventBus, channelProviders, renderer, policy, eventhandlers.CustomerTriggers{
+		Welcome: settings.Triggers.CustomerCreated,
+	})
+	if m.handler, err = di.Resolve[*handlers.NotificationHandler](container); err != nil {
+		return fmt.Errorf("failed to create notification handler: %w", err)
+	}
+	if m.dispatcher, err = di.Resolve[*commandhandlers.DispatchNotificationsHandler](container); err != nil {
+		return fmt.Errorf("failed to create notification dispatcher: %w", err)
+	}
+	if m.customerEvents, err = di.Resolve[*eventhandlers.CustomerEventsHandler](container); err != nil {
+		return fmt.Errorf("failed to create customer events handler: %w", err)
+	}
+
+	// Queue welcome emails from customer events
+	if err := m.eventBus.Subscribe(m.customerEvents); err != nil {
+		return fmt.Errorf("failed to subscribe customer events handler: %w", err)
+	}
+
+	m.logger.Info("module initialized")
+	return nil
+}
+
+// RegisterRoutes registers HTTP routes for the notification module
+func (m *NotificationModule) RegisterRoutes(router *gin.RouterGroup) {
+	m.logger.Info("registering routes")
+
+	notificationhttp.RegisterNotificationRoutes(router, m.handler)
+}
+
+// APIOperations implements openapi.Documented
+func (m *NotificationModule) APIOperations() []*openapi.Operation {
+	return notificationhttp.APIOperations()
+}
+
+// Health checks if the notification module is healthy
+func (m *NotificationModule) Health(ctx context.Context) error {
+	// Check if handler is initialized
+	if m.handler == nil {
+		return fmt.Errorf("notification handler not initialized")
+	}
+
+	return nil
+}
+
+// Start starts the worker delivering queued notifications
+func (m *NotificationModule) Start(ctx context.Context) error {
+	m.logger.Info("starting module")
+
+	if err := m.workers.Go("notification_dispatcher", m.dispatch); err != nil {
+		return fmt.Errorf("failed to start notification dispatcher: %w", err)
+	}
+
+	m.logger.Info("module started")
+	return nil
+}
+
+// Stop stops the notification module (optional lifecycle method)
+func (m *NotificationModule) Stop(ctx context.Context) error {
+	m.logger.Info("stopping module")
+
+	// Notifications being delivered are finished; those left are delivered after the next start
+	if m.workers != nil {
+		if err := m.workers.Stop(ctx); err != nil {
+			return fmt.Errorf("failed to stop notification dispatcher: %w", err)
+		}
+	}
+
+	// Unregister event handlers
+	if m.customerEvents != nil {
+		if err := m.eventBus.Unsubscribe(m.customerEvents); err != nil {
+			return fmt.Errorf("failed to unsubscribe customer events handler: %w", err)
+		}
+	}
+
+	m.logger.Info("module stopped")
+	return nil
+}
+
+// dispatch delivers due notifications every poll interval, batch after batch until none is due
+func (m *NotificationModule) dispatch(ctx context.Context) error {
+	ticker := time.NewTicker(m.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		for {
+			attempted, err := m.dispatcher.Handle(ctx)
+			if err != nil {
+				// Claimed notifications reappear once their lease expires, so the worker keeps running
+				m.logger.Warn("failed to deliver notifications", zap.Error(err))
+				break
+			}
+			if attempted == 0 || ctx.Err() != nil {
+				break
+			}
+		}
+	}
+}
+
+// notificationSettings is the notification section of the module config
+type notificationSettings struct {
+	// DefaultLocale is used when a template is missing in the requested locale
+	DefaultLocale string `mapstructure:"default_locale"`
+	// TemplatesPath is a directory of templates overriding or extending the built-in ones
+	TemplatesPath string `mapstructure:"templates_path"`
+	Email         struct {
+		// Provider delivers emails: mailer, log, smtp or ses
+		Provider string `mapstructure:"provider"`
+	} `mapstructure:"email"`
+	SMS struct {
+		// Provider delivers SMS: log or twilio
+		Provider string `mapstructure:"provider"`
+	} `mapstructure:"sms"`
+	Providers struct {
+		SMTP   smtpSettings   `mapstructure:"smtp"`
+		SES    sesSettings    `mapstructure:"ses"`
+		Twilio twilioSettings `mapstructure:"twilio"`
+	} `mapstructure:"providers"`
+	Delivery deliverySettings `mapstructure:"delivery"`
+	Triggers struct {
+		// CustomerCreated is the email template sent to new customers; empty sends none
+		CustomerCreated string `mapstructure:"customer_created"`
+	} `mapstructure:"triggers"`
+}
+
+// breakerSettings configures the circuit breaker of a provider
+type breakerSettings struct {
+	FailureThreshold int           `mapstructure:"failure_threshold"`
+	OpenTimeout      time.Duration `mapstructure:"open_timeout"`
+}
+
+// resilience converts the settings to circuit breaker settings
+func (s breakerSettings) resilience() resilience.BreakerSettings {
+	return resilience.BreakerSettings{FailureThreshold: s.FailureThreshold, OpenTimeout: s.OpenTimeout}
+}
+
+// smtpSettings configures the SMTP provider
+type smtpSettings struct {
+	Host        string        `mapstructure:"host"`
+	Port        int           `mapstructure:"port"`
+	Username    string        `mapstructure:"username"`
+	Password    string        `mapstructure:"password"`
+	From        string        `mapstructure:"from"`
+	ImplicitTLS bool          `mapstructure:"implicit_tls"`
+	Timeout     time.Duration `mapstructure:"timeout"`
+}
+
+// sesSettings configures the Amazon SES provider
+type sesSettings struct {
+	Region           string          `mapstructure:"region"`
+	AccessKeyID      string          `mapstructure:"access_key_id"`
+	SecretAccessKey  string          `mapstructure:"secret_access_key"`
+	SessionToken     string          `mapstructure:"session_token"`
+	From             string          `mapstructure:"from"`
+	ConfigurationSet string          `mapstructure:"configuration_set"`
+	BaseURL          string          `mapstructure:"base_url"`
+	Timeout          time.Duration   `mapstructure:"timeout"`
+	Retries          int             `mapstructure:"retries"`
+	Breaker          breakerSettings `mapstructure:"breaker"`
+}
+
+// twilioSettings configures the Twilio provider
+type twilioSettings struct {
+	AccountSID string          `mapstructure:"account_sid"`
+	AuthToken  string          `mapstructure:"auth_token"`
+	From       string          `mapstructure:"from"`
+	BaseURL    string          `mapstructure:"base_url"`
+	Timeout    time.Duration   `mapstructure:"timeout"`
+	Retries    int             `mapstructure:"retries"`
+	Breaker    breakerSettings `mapstructure:"breaker"`
+}
+
+// deliverySettings bounds the delivery attempts of queued notifications
+type deliverySettings struct {
+	MaxAttempts  int           `mapstructure:"max_attempts"`
+	Backoff      time.Duration `mapstructure:"backoff"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	Lease        time.Duration `mapstructure:"lease"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+}
+
+// policy returns the delivery policy and the dispatcher's poll interval, defaulting unset values
+func (s deliverySettings) policy() (commandhandlers.DeliveryPolicy, time.Duration) {
+	policy := commandhandlers.DeliveryPolicy{
+		MaxAttempts: s.MaxAttempts,
+		Backoff:     s.Backoff,
+		BatchSize:   s.BatchSize,
+		Lease:       s.Lease,
+	}
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = 5
+	}
+	if policy.Backoff <= 0 {
+		policy.Backoff = 30 * time.Second
+	}
+	if policy.BatchSize <= 0 {
+		policy.BatchSize = 50
+	}
+	if policy.Lease <= 0 {
+		policy.Lease = 5 * time.Minute
+	}
+
+	pollInterval := s.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = 5 * time.Second
+	}
+	return policy, pollInterval
+}
+
+// loadTemplates parses the built-in templates and those of notification.templates_path
+func loadTemplates(settings notificationSettings) (*templates.Renderer, error) {
+	locale := settings.DefaultLocale
+	if locale == "" {
+		locale = "en"
+	}
+
+	var dir fs.FS
+	if settings.TemplatesPath != "" {
+		if _, err := os.Stat(settings.TemplatesPath); err != nil {
+			return nil, fmt.Errorf("templates_path: %w", err)
+		}
+		dir = os.DirFS(settings.TemplatesPath)
+	}
+	return templates.NewRenderer(locale, dir)
+}
+
+// loadProviders builds the provider of each channel named by notification.email.provider and
+// notification.sms.provider; emails default to the module's mailer, and SMS to the log provider,
+// which only logs notifications
+func loadProviders(settings notificationSettings, mailer domain.Mailer, logger *zap.Logger) (notificationdomain.Providers, error) {
+	email, err := emailProvider(settings, mailer, logger)
+	if err != nil {
+		return nil, err
+	}
+	sms, err := smsProvider(settings, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return notificationdomain.Providers{
+		notificationdomain.ChannelEmail: email,
+		notificationdomain.ChannelSMS:   sms,
+	}, nil
+}
+
+// emailProvider builds the provider named by notification.email.provider
+func emailProvider(settings notificationSettings, mailer domain.Mailer, logger *zap.Logger) (notificationdomain.Provider, error) {
+	switch settings.Email.Provider {
+	case "", providers.MailerProviderName:
+		return providers.NewMailerProvider(mailer), nil
+	case providers.LogProviderName:
+		return providers.NewLogProvider(notificationdomain.ChannelEmail, logger), nil
+	case providers.SMTPProviderName:
+		smtp := settings.Providers.SMTP
+		return providers.NewSMTPProvider(providers.SMTPConfig{
+			Host:        smtp.Host,
+			Port:        smtp.Port,
+			Username:    smtp.Username,
+			Password:    smtp.Password,
+			From:        smtp.From,
+			ImplicitTLS: smtp.ImplicitTLS,
+			Timeout:     smtp.Timeout,
+		})
+	case providers.SESProviderName:
+		ses := settings.Providers.SES
+		return providers.NewSESProvider(providers.SESConfig{
+			Region:           ses.Region,
+			AccessKeyID:      ses.AccessKeyID,
+			SecretAccessKey:  ses.SecretAccessKey,
+			SessionToken:     ses.SessionToken,
+			From:             ses.From,
+			ConfigurationSet: ses.ConfigurationSet,
+			BaseURL:          ses.BaseURL,
+			Timeout:          ses.Timeout,
+			Retries:          ses.Retries,
+			Breaker:          ses.Breaker.resilience(),
+		})
+	default:
+		return nil, fmt.Errorf("email provider %q is unknown", settings.Email.Provider)
+	}
+}
+
+// smsProvider builds the provider named by notification.sms.provider
+func smsProvider(settings notificationSettings, logger *zap.Logger) (notificationdomain.Provider, error) {
+	switch settings.SMS.Provider {
+	case "", providers.LogProviderName:
+		return providers.NewLogProvider(notificationdomain.ChannelSMS, logger), nil
+	case providers.TwilioProviderName:
+		twilio := settings.Providers.Twilio
+		return providers.NewTwilioProvider(providers.TwilioConfig{
+			AccountSID: twilio.AccountSID,
+			AuthToken:  twilio.AuthToken,
+			From:       twilio.From,
+			BaseURL:    twilio.BaseURL,
+			Timeout:    twilio.Timeout,
+			Retries:    twilio.Retries,
+			Breaker:    twilio.Breaker.resilience(),
+		})
+	default:
+		return nil, fmt.Errorf("sms provider %q is unknown", settings.SMS.Provider)
+	}
+}
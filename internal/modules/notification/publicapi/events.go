@@ -0,0 +1,30 @@
+// Package publicapi is the notification module's contract for other modules.
+// Other modules depend on this package only, never on notification internals.
+package publicapi
+
+import (
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// Notification event types other modules may subscribe to
+const (
+	NotificationSentEventType   = "notification.sent"
+	NotificationFailedEventType = "notification.failed"
+)
+
+// NotificationOutcome is implemented by the notification.sent and notification.failed events
+type NotificationOutcome interface {
+	shareddomain.DomainEvent
+
+	// GetNotificationID returns the ID of the notification
+	GetNotificationID() string
+
+	// GetChannel returns the channel the notification was delivered through: email or sms
+	GetChannel() string
+
+	// GetTemplate returns the template the notification was rendered from
+	GetTemplate() string
+
+	// GetSourceEventID returns the event that triggered the notification, empty when requested directly
+	GetSourceEventID() string
+}
@@ -0,0 +1,6 @@
+//go:build !no_order
+
+package modules
+
+// Excluded from builds tagged no_order
+import _ "golang_modular_monolith/internal/modules/order"
@@ -0,0 +1,57 @@
+package commandhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// AddOrderLineHandler handles AddOrderLineCommand
+type AddOrderLineHandler struct {
+	repo     domain.OrderRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewAddOrderLineHandler creates a new AddOrderLineHandler
+func NewAddOrderLineHandler(repo domain.OrderRepository, eventBus shareddomain.EventBus) *AddOrderLineHandler {
+	return &AddOrderLineHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the AddOrderLineCommand
+// The line is priced in the order currency; only pending orders accept new lines
+func (h *AddOrderLineHandler) Handle(ctx context.Context, cmd *commands.AddOrderLineCommand) (*commands.AddOrderLineResult, error) {
+	order, err := loadOrder(ctx, h.repo, cmd.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	line, err := order.AddLine(domain.LineItem{
+		ProductID:   cmd.ProductID,
+		ProductName: cmd.ProductName,
+		Quantity:    cmd.Quantity,
+		UnitPrice:   shareddomain.Money{Amount: cmd.UnitPrice, Currency: order.Currency},
+	})
+	if err != nil {
+		return nil, err
+	}
+	added := *line
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, order); err != nil {
+		return nil, err
+	}
+
+	return &commands.AddOrderLineResult{
+		OrderID:  order.GetID(),
+		Line:     toLineResults([]domain.OrderLine{added})[0],
+		Subtotal: order.Subtotal,
+		Discount: order.Discount,
+		Tax:      order.Tax,
+		Total:    order.Total,
+		Version:  order.GetVersion(),
+	}, nil
+}
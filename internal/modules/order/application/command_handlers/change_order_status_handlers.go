@@ -0,0 +1,95 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// ConfirmOrderHandler handles ConfirmOrderCommand
+type ConfirmOrderHandler struct {
+	repo     domain.OrderRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewConfirmOrderHandler creates a new ConfirmOrderHandler
+func NewConfirmOrderHandler(repo domain.OrderRepository, eventBus shareddomain.EventBus) *ConfirmOrderHandler {
+	return &ConfirmOrderHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the ConfirmOrderCommand
+func (h *ConfirmOrderHandler) Handle(ctx context.Context, cmd *commands.ConfirmOrderCommand) (*commands.OrderStatusResult, error) {
+	order, err := loadOrder(ctx, h.repo, cmd.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousStatus := order.Status
+	if err := order.Confirm(cmd.Actor); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, order); err != nil {
+		return nil, err
+	}
+
+	return toStatusResult(order, previousStatus), nil
+}
+
+// CancelOrderHandler handles CancelOrderCommand
+type CancelOrderHandler struct {
+	repo     domain.OrderRepository
+	coupons  domain.CouponRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewCancelOrderHandler creates a new CancelOrderHandler
+func NewCancelOrderHandler(repo domain.OrderRepository, coupons domain.CouponRepository, eventBus shareddomain.EventBus) *CancelOrderHandler {
+	return &CancelOrderHandler{
+		repo:     repo,
+		coupons:  coupons,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the CancelOrderCommand
+func (h *CancelOrderHandler) Handle(ctx context.Context, cmd *commands.CancelOrderCommand) (*commands.OrderStatusResult, error) {
+	order, err := loadOrder(ctx, h.repo, cmd.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousStatus := order.Status
+	if err := order.Cancel(cmd.ReasonCode, cmd.Reason, cmd.Actor); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, order); err != nil {
+		return nil, err
+	}
+
+	// Give back the coupon uses so that a cancelled order does not count against the usage limits
+	if previousStatus != order.Status && len(order.Discounts) > 0 {
+		if err := h.coupons.ReleaseRedemptions(ctx, order.GetID()); err != nil {
+			fmt.Printf("Warning: failed to release coupons of cancelled order %s: %v\n", order.GetID(), err)
+		}
+	}
+
+	return toStatusResult(order, previousStatus), nil
+}
+
+// toStatusResult builds the result of a status change
+func toStatusResult(order *domain.Order, previousStatus domain.OrderStatus) *commands.OrderStatusResult {
+	return &commands.OrderStatusResult{
+		OrderID:        order.GetID(),
+		PreviousStatus: string(previousStatus),
+		Status:         string(order.Status),
+		Version:        order.GetVersion(),
+	}
+}
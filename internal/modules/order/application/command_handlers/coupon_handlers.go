@@ -0,0 +1,159 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateCouponHandler handles CreateCouponCommand
+type CreateCouponHandler struct {
+	coupons domain.CouponRepository
+}
+
+// NewCreateCouponHandler creates a new CreateCouponHandler
+func NewCreateCouponHandler(coupons domain.CouponRepository) *CreateCouponHandler {
+	return &CreateCouponHandler{
+		coupons: coupons,
+	}
+}
+
+// Handle handles the CreateCouponCommand
+func (h *CreateCouponHandler) Handle(ctx context.Context, cmd *commands.CreateCouponCommand) (*commands.CouponResult, error) {
+	coupon, err := domain.NewCoupon(cmd.Code, domain.CouponType(cmd.Type), cmd.Value, cmd.Currency, domain.CouponSettings{
+		Description:               cmd.Description,
+		ValidFrom:                 cmd.ValidFrom,
+		ValidUntil:                cmd.ValidUntil,
+		MaxRedemptions:            cmd.MaxRedemptions,
+		MaxRedemptionsPerCustomer: cmd.MaxRedemptionsPerCustomer,
+		Active:                    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := h.coupons.ExistsByCode(ctx, coupon.Code)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeAlreadyExists,
+			fmt.Sprintf("coupon with code %s already exists", coupon.Code),
+			"code",
+		)
+	}
+
+	if err := h.coupons.Save(ctx, coupon); err != nil {
+		return nil, err
+	}
+
+	return toCouponResult(coupon), nil
+}
+
+// UpdateCouponHandler handles UpdateCouponCommand
+type UpdateCouponHandler struct {
+	coupons domain.CouponRepository
+}
+
+// NewUpdateCouponHandler creates a new UpdateCouponHandler
+func NewUpdateCouponHandler(coupons domain.CouponRepository) *UpdateCouponHandler {
+	return &UpdateCouponHandler{
+		coupons: coupons,
+	}
+}
+
+// Handle handles the UpdateCouponCommand
+func (h *UpdateCouponHandler) Handle(ctx context.Context, cmd *commands.UpdateCouponCommand) (*commands.CouponResult, error) {
+	coupon, err := loadCoupon(ctx, h.coupons, cmd.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := domain.CouponSettings{
+		Description:               coupon.Description,
+		ValidFrom:                 coupon.ValidFrom,
+		ValidUntil:                coupon.ValidUntil,
+		MaxRedemptions:            coupon.MaxRedemptions,
+		MaxRedemptionsPerCustomer: coupon.MaxRedemptionsPerCustomer,
+		Active:                    coupon.Active,
+	}
+	if cmd.Description != nil {
+		settings.Description = *cmd.Description
+	}
+	if cmd.ClearValidity {
+		settings.ValidFrom = nil
+		settings.ValidUntil = nil
+	}
+	if cmd.ValidFrom != nil {
+		settings.ValidFrom = cmd.ValidFrom
+	}
+	if cmd.ValidUntil != nil {
+		settings.ValidUntil = cmd.ValidUntil
+	}
+	if cmd.MaxRedemptions != nil {
+		settings.MaxRedemptions = *cmd.MaxRedemptions
+	}
+	if cmd.MaxRedemptionsPerCustomer != nil {
+		settings.MaxRedemptionsPerCustomer = *cmd.MaxRedemptionsPerCustomer
+	}
+	if cmd.Active != nil {
+		settings.Active = *cmd.Active
+	}
+
+	if err := coupon.UpdateSettings(settings); err != nil {
+		return nil, err
+	}
+
+	if err := h.coupons.Save(ctx, coupon); err != nil {
+		return nil, err
+	}
+
+	return toCouponResult(coupon), nil
+}
+
+// loadCoupon retrieves a coupon or returns a not found domain error
+func loadCoupon(ctx context.Context, coupons domain.CouponRepository, code string) (*domain.Coupon, error) {
+	code = domain.NormalizeCouponCode(code)
+	if code == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"coupon code is required",
+		)
+	}
+
+	coupon, err := coupons.GetByCode(ctx, code)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("coupon with code %s not found", code),
+			)
+		}
+		return nil, fmt.Errorf("failed to get coupon: %w", err)
+	}
+
+	return coupon, nil
+}
+
+// toCouponResult converts a coupon to a command result
+func toCouponResult(coupon *domain.Coupon) *commands.CouponResult {
+	return &commands.CouponResult{
+		Code:                      coupon.Code,
+		Description:               coupon.Description,
+		Type:                      string(coupon.Type),
+		Value:                     coupon.Value,
+		Currency:                  coupon.Currency,
+		ValidFrom:                 coupon.ValidFrom,
+		ValidUntil:                coupon.ValidUntil,
+		MaxRedemptions:            coupon.MaxRedemptions,
+		MaxRedemptionsPerCustomer: coupon.MaxRedemptionsPerCustomer,
+		RedemptionCount:           coupon.RedemptionCount,
+		Active:                    coupon.Active,
+		CreatedAt:                 coupon.CreatedAt,
+		UpdatedAt:                 coupon.UpdatedAt,
+	}
+}
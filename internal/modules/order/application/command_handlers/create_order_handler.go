@@ -0,0 +1,210 @@
+package commandhandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/modules/customer/publicapi"
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateOrderHandler handles CreateOrderCommand
+type CreateOrderHandler struct {
+	repo      domain.OrderRepository
+	coupons   domain.CouponRepository
+	numbers   domain.OrderNumberGenerator
+	customers publicapi.PublicAPI
+	taxPolicy domain.TaxPolicy
+	eventBus  shareddomain.EventBus
+}
+
+// NewCreateOrderHandler creates a new CreateOrderHandler
+func NewCreateOrderHandler(
+	repo domain.OrderRepository,
+	coupons domain.CouponRepository,
+	numbers domain.OrderNumberGenerator,
+	customers publicapi.PublicAPI,
+	taxPolicy domain.TaxPolicy,
+	eventBus shareddomain.EventBus,
+) *CreateOrderHandler {
+	return &CreateOrderHandler{
+		repo:      repo,
+		coupons:   coupons,
+		numbers:   numbers,
+		customers: customers,
+		taxPolicy: taxPolicy,
+		eventBus:  eventBus,
+	}
+}
+
+// Handle validates the command, creates the order and publishes its events
+func (h *CreateOrderHandler) Handle(ctx context.Context, cmd *commands.CreateOrderCommand) (*commands.CreateOrderResult, error) {
+	draft, err := h.draft(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	coupon := draft.Coupon
+
+	// Allocated last so that requests rejected above do not use up numbers
+	if draft.Number, err = h.numbers.Next(ctx, time.Now()); err != nil {
+		return nil, fmt.Errorf("failed to allocate order number: %w", err)
+	}
+
+	order, err := domain.NewOrder(draft)
+	if err != nil {
+		return nil, err
+	}
+
+	// Redeem before saving so that usage limits are enforced before the order exists
+	if coupon != nil {
+		if err := h.coupons.Redeem(ctx, coupon.Code, order.CustomerID, order.GetID()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, order); err != nil {
+		if coupon != nil {
+			if releaseErr := h.coupons.ReleaseRedemptions(ctx, order.GetID()); releaseErr != nil {
+				fmt.Printf("Warning: failed to release coupon %s for unsaved order %s: %v\n", coupon.Code, order.GetID(), releaseErr)
+			}
+		}
+		return nil, err
+	}
+
+	return &commands.CreateOrderResult{
+		OrderID:         order.GetID(),
+		OrderNumber:     order.Number,
+		CustomerID:      order.CustomerID,
+		Status:          string(order.Status),
+		Currency:        order.Currency,
+		Lines:           toLineResults(order.Lines),
+		TaxRateBps:      order.TaxPolicy.RateBasisPoints,
+		Subtotal:        order.Subtotal,
+		Discounts:       toDiscountResults(order.Discounts),
+		Discount:        order.Discount,
+		Tax:             order.Tax,
+		Total:           order.Total,
+		ShippingAddress: toAddressResult(order.ShippingAddress),
+		BillingAddress:  toAddressResult(order.BillingAddress),
+	}, nil
+}
+
+// Validate checks the command as Handle would, without allocating a number or creating the order
+// Imports use it to validate their rows on dry runs
+func (h *CreateOrderHandler) Validate(ctx context.Context, cmd *commands.CreateOrderCommand) error {
+	draft, err := h.draft(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	draft.Number = validationOrderNumber
+	_, err = domain.NewOrder(draft)
+	return err
+}
+
+// validationOrderNumber stands in for the number of orders built only to be validated
+const validationOrderNumber = "VALIDATION"
+
+// draft validates the command, verifying its customer and coupon, and returns the draft of its
+// order without a number
+func (h *CreateOrderHandler) draft(ctx context.Context, cmd *commands.CreateOrderCommand) (domain.OrderDraft, error) {
+	// Validate command
+	if cmd.CustomerID == "" {
+		return domain.OrderDraft{}, shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeInvalidInput,
+			"customer_id is required",
+			"customer_id",
+		)
+	}
+	if len(cmd.Lines) == 0 {
+		return domain.OrderDraft{}, shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeInvalidInput,
+			"at least one order line is required",
+			"lines",
+		)
+	}
+
+	// Verify the customer through the customer module's public API
+	if err := h.verifyCustomer(ctx, cmd.CustomerID); err != nil {
+		return domain.OrderDraft{}, err
+	}
+
+	// Price the lines in the order currency
+	items := make([]domain.LineItem, len(cmd.Lines))
+	for i, line := range cmd.Lines {
+		items[i] = domain.LineItem{
+			ProductID:   line.ProductID,
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+			UnitPrice:   shareddomain.Money{Amount: line.UnitPrice, Currency: strings.ToUpper(strings.TrimSpace(cmd.Currency))},
+		}
+	}
+
+	coupon, err := h.couponToApply(ctx, cmd.CouponCode)
+	if err != nil {
+		return domain.OrderDraft{}, err
+	}
+
+	return domain.OrderDraft{
+		CustomerID: cmd.CustomerID,
+		Currency:   cmd.Currency,
+		TaxPolicy:  h.taxPolicy,
+		Items:      items,
+		Addresses: domain.OrderAddresses{
+			Shipping: toDomainAddress(cmd.ShippingAddress),
+			Billing:  toDomainAddress(cmd.BillingAddress),
+		},
+		Coupon: coupon,
+		Actor:  cmd.Actor,
+	}, nil
+}
+
+// couponToApply retrieves the coupon to apply, or nil when no code was given
+func (h *CreateOrderHandler) couponToApply(ctx context.Context, code string) (*domain.Coupon, error) {
+	code = domain.NormalizeCouponCode(code)
+	if code == "" {
+		return nil, nil
+	}
+
+	coupon, err := h.coupons.GetByCode(ctx, code)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewValidationErrorWithValue("coupon_code", "unknown coupon code", code)
+		}
+		return nil, fmt.Errorf("failed to get coupon: %w", err)
+	}
+
+	return coupon, nil
+}
+
+// verifyCustomer ensures the customer exists and is active
+func (h *CreateOrderHandler) verifyCustomer(ctx context.Context, customerID string) error {
+	customer, err := h.customers.GetCustomer(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, publicapi.ErrCustomerNotFound) {
+			return shareddomain.NewDomainErrorWithField(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("customer with ID %s not found", customerID),
+				"customer_id",
+			)
+		}
+		return fmt.Errorf("failed to verify customer: %w", err)
+	}
+
+	if customer.IsDeleted() {
+		return shareddomain.NewBusinessRuleError("customer_deleted", "cannot create an order for a deleted customer")
+	}
+
+	if !customer.IsActive() {
+		return shareddomain.NewBusinessRuleError(
+			"customer_not_active",
+			fmt.Sprintf("cannot create an order for a customer with status %s", customer.Status),
+		)
+	}
+
+	return nil
+}
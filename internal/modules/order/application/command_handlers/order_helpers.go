@@ -0,0 +1,107 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/domain"
+	"golang_modular_monolith/internal/shared/application"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// loadOrder retrieves an order or returns a not found domain error
+func loadOrder(ctx context.Context, repo domain.OrderRepository, orderID string) (*domain.Order, error) {
+	if orderID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"order ID is required",
+		)
+	}
+
+	order, err := repo.GetByID(ctx, orderID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("order with ID %s not found", orderID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	return order, nil
+}
+
+// saveAndPublish persists a changed order and publishes its uncommitted events
+// It is a no-op when the aggregate recorded no changes
+func saveAndPublish(ctx context.Context, repo domain.OrderRepository, eventBus shareddomain.EventBus, order *domain.Order) error {
+	// Capture events before the repository clears them on save
+	events := order.GetUncommittedEvents()
+	if len(events) == 0 {
+		return nil
+	}
+
+	if err := repo.Save(ctx, order); err != nil {
+		return fmt.Errorf("failed to save order: %w", err)
+	}
+
+	for _, event := range events {
+		if err := application.PublishEvents(ctx, eventBus, event); err != nil {
+			// Log error but don't fail the operation
+			// In a real application, you might want to use outbox pattern or similar
+			fmt.Printf("Warning: failed to publish event %T for order %s: %v\n", event, order.GetID(), err)
+		}
+	}
+
+	return nil
+}
+
+// toLineResults converts order lines to command result lines
+func toLineResults(lines []domain.OrderLine) []commands.OrderLineResult {
+	results := make([]commands.OrderLineResult, len(lines))
+	for i, line := range lines {
+		results[i] = commands.OrderLineResult{
+			ID:          line.ID,
+			ProductID:   line.ProductID,
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+			UnitPrice:   line.UnitPrice,
+			LineTotal:   line.LineTotal,
+		}
+	}
+	return results
+}
+
+// toDomainAddress converts a command address to a domain address
+func toDomainAddress(address *commands.Address) *domain.Address {
+	if address == nil {
+		return nil
+	}
+	converted := domain.Address(*address)
+	return &converted
+}
+
+// toAddressResult converts a domain address to a command result address
+func toAddressResult(address *domain.Address) *commands.Address {
+	if address == nil {
+		return nil
+	}
+	converted := commands.Address(*address)
+	return &converted
+}
+
+// toDiscountResults converts order discount lines to command result discount lines
+func toDiscountResults(discounts []domain.OrderDiscount) []commands.OrderDiscountResult {
+	results := make([]commands.OrderDiscountResult, len(discounts))
+	for i, discount := range discounts {
+		results[i] = commands.OrderDiscountResult{
+			CouponCode:  discount.CouponCode,
+			Description: discount.Description,
+			Type:        string(discount.Type),
+			Value:       discount.Value,
+			Amount:      discount.Amount,
+		}
+	}
+	return results
+}
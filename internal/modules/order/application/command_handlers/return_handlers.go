@@ -0,0 +1,233 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/domain"
+	"golang_modular_monolith/internal/shared/application"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateReturnHandler handles CreateReturnCommand
+type CreateReturnHandler struct {
+	orders   domain.OrderRepository
+	returns  domain.ReturnRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewCreateReturnHandler creates a new CreateReturnHandler
+func NewCreateReturnHandler(orders domain.OrderRepository, returns domain.ReturnRepository, eventBus shareddomain.EventBus) *CreateReturnHandler {
+	return &CreateReturnHandler{
+		orders:   orders,
+		returns:  returns,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the CreateReturnCommand
+func (h *CreateReturnHandler) Handle(ctx context.Context, cmd *commands.CreateReturnCommand) (*commands.ReturnResult, error) {
+	order, err := loadOrder(ctx, h.orders, cmd.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := h.returns.ListByOrderID(ctx, order.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.ReturnItem, len(cmd.Lines))
+	for i, line := range cmd.Lines {
+		items[i] = domain.ReturnItem{OrderLineID: line.OrderLineID, Quantity: line.Quantity}
+	}
+
+	ret, err := domain.NewReturn(order, previous, items, cmd.Reason, cmd.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublishReturn(ctx, h.returns, h.eventBus, ret); err != nil {
+		return nil, err
+	}
+
+	return toReturnResult(ret), nil
+}
+
+// ApproveReturnHandler handles ApproveReturnCommand
+type ApproveReturnHandler struct {
+	returns  domain.ReturnRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewApproveReturnHandler creates a new ApproveReturnHandler
+func NewApproveReturnHandler(returns domain.ReturnRepository, eventBus shareddomain.EventBus) *ApproveReturnHandler {
+	return &ApproveReturnHandler{
+		returns:  returns,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the ApproveReturnCommand
+func (h *ApproveReturnHandler) Handle(ctx context.Context, cmd *commands.ApproveReturnCommand) (*commands.ReturnResult, error) {
+	ret, err := loadReturn(ctx, h.returns, cmd.ReturnID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ret.Approve(cmd.Actor); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublishReturn(ctx, h.returns, h.eventBus, ret); err != nil {
+		return nil, err
+	}
+
+	// The payment module may have refunded the return while the event was published
+	if refreshed, err := h.returns.GetByID(ctx, ret.GetID()); err == nil {
+		ret = refreshed
+	}
+
+	return toReturnResult(ret), nil
+}
+
+// RejectReturnHandler handles RejectReturnCommand
+type RejectReturnHandler struct {
+	returns  domain.ReturnRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewRejectReturnHandler creates a new RejectReturnHandler
+func NewRejectReturnHandler(returns domain.ReturnRepository, eventBus shareddomain.EventBus) *RejectReturnHandler {
+	return &RejectReturnHandler{
+		returns:  returns,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the RejectReturnCommand
+func (h *RejectReturnHandler) Handle(ctx context.Context, cmd *commands.RejectReturnCommand) (*commands.ReturnResult, error) {
+	ret, err := loadReturn(ctx, h.returns, cmd.ReturnID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ret.Reject(cmd.Reason, cmd.Actor); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublishReturn(ctx, h.returns, h.eventBus, ret); err != nil {
+		return nil, err
+	}
+
+	return toReturnResult(ret), nil
+}
+
+// MarkReturnRefundedHandler handles MarkReturnRefundedCommand
+type MarkReturnRefundedHandler struct {
+	returns  domain.ReturnRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewMarkReturnRefundedHandler creates a new MarkReturnRefundedHandler
+func NewMarkReturnRefundedHandler(returns domain.ReturnRepository, eventBus shareddomain.EventBus) *MarkReturnRefundedHandler {
+	return &MarkReturnRefundedHandler{
+		returns:  returns,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the MarkReturnRefundedCommand
+func (h *MarkReturnRefundedHandler) Handle(ctx context.Context, cmd *commands.MarkReturnRefundedCommand) (*commands.ReturnResult, error) {
+	ret, err := loadReturn(ctx, h.returns, cmd.ReturnID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ret.Status == domain.ReturnStatusRefunded {
+		return toReturnResult(ret), nil
+	}
+
+	if err := ret.MarkRefunded(cmd.RefundReference, cmd.Actor); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublishReturn(ctx, h.returns, h.eventBus, ret); err != nil {
+		return nil, err
+	}
+
+	return toReturnResult(ret), nil
+}
+
+// loadReturn retrieves a return by ID or returns a not found domain error
+func loadReturn(ctx context.Context, repo domain.ReturnRepository, returnID string) (*domain.Return, error) {
+	if returnID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"return ID is required",
+		)
+	}
+
+	ret, err := repo.GetByID(ctx, returnID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("return with ID %s not found", returnID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get return: %w", err)
+	}
+
+	return ret, nil
+}
+
+// saveAndPublishReturn persists a changed return and publishes its uncommitted events
+func saveAndPublishReturn(ctx context.Context, repo domain.ReturnRepository, eventBus shareddomain.EventBus, ret *domain.Return) error {
+	// Capture events before the repository clears them on save
+	events := ret.GetUncommittedEvents()
+
+	if err := repo.Save(ctx, ret); err != nil {
+		return fmt.Errorf("failed to save return: %w", err)
+	}
+
+	for _, event := range events {
+		if err := application.PublishEvents(ctx, eventBus, event); err != nil {
+			// Log error but don't fail the operation
+			fmt.Printf("Warning: failed to publish event %T for return %s: %v\n", event, ret.GetID(), err)
+		}
+	}
+
+	return nil
+}
+
+// toReturnResult converts a return to a command result
+func toReturnResult(ret *domain.Return) *commands.ReturnResult {
+	lines := make([]commands.ReturnLineResult, len(ret.Lines))
+	for i, line := range ret.Lines {
+		lines[i] = commands.ReturnLineResult{
+			OrderLineID: line.OrderLineID,
+			ProductID:   line.ProductID,
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+			UnitPrice:   line.UnitPrice,
+			Amount:      line.Amount,
+		}
+	}
+
+	return &commands.ReturnResult{
+		ID:              ret.GetID(),
+		OrderID:         ret.OrderID,
+		CustomerID:      ret.CustomerID,
+		Status:          string(ret.Status),
+		Reason:          ret.Reason,
+		Lines:           lines,
+		RefundAmount:    ret.RefundAmount,
+		RefundReference: ret.RefundReference,
+		RejectionReason: ret.RejectionReason,
+		Version:         ret.GetVersion(),
+		CreatedAt:       ret.GetCreatedAt(),
+		UpdatedAt:       ret.GetUpdatedAt(),
+	}
+}
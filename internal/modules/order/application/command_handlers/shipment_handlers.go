@@ -0,0 +1,179 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateShipmentHandler handles CreateShipmentCommand
+type CreateShipmentHandler struct {
+	repo     domain.OrderRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewCreateShipmentHandler creates a new CreateShipmentHandler
+func NewCreateShipmentHandler(repo domain.OrderRepository, eventBus shareddomain.EventBus) *CreateShipmentHandler {
+	return &CreateShipmentHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the CreateShipmentCommand
+func (h *CreateShipmentHandler) Handle(ctx context.Context, cmd *commands.CreateShipmentCommand) (*commands.ShipmentResult, error) {
+	order, err := loadOrder(ctx, h.repo, cmd.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := domain.ShipmentDraft{
+		Carrier:        cmd.Carrier,
+		TrackingNumber: cmd.TrackingNumber,
+		Items:          make([]domain.ShipmentItem, len(cmd.Lines)),
+	}
+	for i, line := range cmd.Lines {
+		draft.Items[i] = domain.ShipmentItem{OrderLineID: line.OrderLineID, Quantity: line.Quantity}
+	}
+	if cmd.ShippedAt != nil {
+		draft.ShippedAt = *cmd.ShippedAt
+	}
+
+	shipment, err := order.AddShipment(draft, cmd.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, order); err != nil {
+		return nil, err
+	}
+
+	return toShipmentResult(order, shipment), nil
+}
+
+// UpdateShipmentStatusHandler handles UpdateShipmentStatusCommand
+type UpdateShipmentStatusHandler struct {
+	repo     domain.OrderRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewUpdateShipmentStatusHandler creates a new UpdateShipmentStatusHandler
+func NewUpdateShipmentStatusHandler(repo domain.OrderRepository, eventBus shareddomain.EventBus) *UpdateShipmentStatusHandler {
+	return &UpdateShipmentStatusHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the UpdateShipmentStatusCommand
+func (h *UpdateShipmentStatusHandler) Handle(ctx context.Context, cmd *commands.UpdateShipmentStatusCommand) (*commands.ShipmentResult, error) {
+	order, err := loadOrder(ctx, h.repo, cmd.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateShipmentStatus(ctx, h.repo, h.eventBus, order, cmd.ShipmentID, cmd.Update, cmd.Actor)
+}
+
+// RecordTrackingUpdateHandler handles RecordTrackingUpdateCommand
+type RecordTrackingUpdateHandler struct {
+	repo     domain.OrderRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewRecordTrackingUpdateHandler creates a new RecordTrackingUpdateHandler
+func NewRecordTrackingUpdateHandler(repo domain.OrderRepository, eventBus shareddomain.EventBus) *RecordTrackingUpdateHandler {
+	return &RecordTrackingUpdateHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the RecordTrackingUpdateCommand
+func (h *RecordTrackingUpdateHandler) Handle(ctx context.Context, cmd *commands.RecordTrackingUpdateCommand) (*commands.ShipmentResult, error) {
+	carrier := domain.NormalizeCarrier(cmd.Carrier)
+	if carrier == "" || cmd.TrackingNumber == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"carrier and tracking number are required",
+		)
+	}
+
+	order, err := h.repo.GetByShipmentTracking(ctx, carrier, cmd.TrackingNumber)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("no shipment with %s tracking number %s", carrier, cmd.TrackingNumber),
+			)
+		}
+		return nil, fmt.Errorf("failed to get order by tracking number: %w", err)
+	}
+
+	shipment := order.FindShipmentByTracking(carrier, cmd.TrackingNumber)
+	if shipment == nil {
+		return nil, fmt.Errorf("order %s has no shipment with %s tracking number %s", order.GetID(), carrier, cmd.TrackingNumber)
+	}
+
+	return updateShipmentStatus(ctx, h.repo, h.eventBus, order, shipment.ID, cmd.Update, cmd.Actor)
+}
+
+// updateShipmentStatus applies a tracking update to a shipment of a loaded order and saves the order
+func updateShipmentStatus(ctx context.Context, repo domain.OrderRepository, eventBus shareddomain.EventBus, order *domain.Order, shipmentID string, update commands.TrackingUpdate, actor string) (*commands.ShipmentResult, error) {
+	trackingUpdate := domain.TrackingUpdate{
+		Status:      domain.ShipmentStatus(update.Status),
+		Description: update.Description,
+		Location:    update.Location,
+	}
+	if update.OccurredAt != nil {
+		trackingUpdate.OccurredAt = *update.OccurredAt
+	}
+
+	if _, err := order.UpdateShipmentStatus(shipmentID, trackingUpdate, actor); err != nil {
+		return nil, err
+	}
+
+	// Duplicate notifications leave no uncommitted events, so nothing is saved for them
+	if err := saveAndPublish(ctx, repo, eventBus, order); err != nil {
+		return nil, err
+	}
+
+	return toShipmentResult(order, order.FindShipment(shipmentID)), nil
+}
+
+// toShipmentResult converts an order shipment to a command result
+func toShipmentResult(order *domain.Order, shipment *domain.Shipment) *commands.ShipmentResult {
+	result := &commands.ShipmentResult{
+		ID:             shipment.ID,
+		OrderID:        order.GetID(),
+		OrderStatus:    string(order.Status),
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		Status:         string(shipment.Status),
+		Lines:          make([]commands.ShipmentLineResult, len(shipment.Lines)),
+		TrackingEvents: make([]commands.TrackingEventResult, len(shipment.TrackingEvents)),
+		ShippedAt:      shipment.ShippedAt,
+		DeliveredAt:    shipment.DeliveredAt,
+	}
+
+	for i, line := range shipment.Lines {
+		result.Lines[i] = commands.ShipmentLineResult{
+			OrderLineID: line.OrderLineID,
+			Quantity:    line.Quantity,
+		}
+	}
+
+	for i, event := range shipment.TrackingEvents {
+		result.TrackingEvents[i] = commands.TrackingEventResult{
+			Status:      string(event.Status),
+			Description: event.Description,
+			Location:    event.Location,
+			OccurredAt:  event.OccurredAt,
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"golang_modular_monolith/internal/shared/application"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// AddOrderLineCommand represents a command to add a line item to a pending order
+// UnitPrice is in the order currency's minor unit (e.g. cents)
+type AddOrderLineCommand struct {
+	application.BaseCommand
+	OrderID     string `json:"order_id" validate:"required"`
+	ProductID   string `json:"product_id" validate:"required"`
+	ProductName string `json:"product_name" validate:"max=255"`
+	Quantity    int    `json:"quantity" validate:"required,min=1"`
+	UnitPrice   int64  `json:"unit_price" validate:"min=0"`
+}
+
+// NewAddOrderLineCommand creates a new add order line command
+func NewAddOrderLineCommand(orderID string, line CreateOrderLine) AddOrderLineCommand {
+	return AddOrderLineCommand{
+		BaseCommand: application.NewBaseCommand("add_order_line"),
+		OrderID:     orderID,
+		ProductID:   line.ProductID,
+		ProductName: line.ProductName,
+		Quantity:    line.Quantity,
+		UnitPrice:   line.UnitPrice,
+	}
+}
+
+// AddOrderLineResult represents the result of adding a line to an order, with the new totals
+type AddOrderLineResult struct {
+	OrderID  string          `json:"order_id"`
+	Line     OrderLineResult `json:"line"`
+	Subtotal domain.Money    `json:"subtotal"`
+	Discount domain.Money    `json:"discount"`
+	Tax      domain.Money    `json:"tax"`
+	Total    domain.Money    `json:"total"`
+	Version  int             `json:"version"`
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// ConfirmOrderCommand represents a command to confirm a pending order
+type ConfirmOrderCommand struct {
+	application.BaseCommand
+	OrderID string `json:"order_id" validate:"required"`
+	Actor   string `json:"actor"`
+}
+
+// NewConfirmOrderCommand creates a new confirm order command
+func NewConfirmOrderCommand(orderID, actor string) ConfirmOrderCommand {
+	return ConfirmOrderCommand{
+		BaseCommand: application.NewBaseCommand("confirm_order"),
+		OrderID:     orderID,
+		Actor:       actor,
+	}
+}
+
+// CancelOrderCommand represents a command to cancel an order
+// Reserved stock and collected payments are released by the product and payment
+// modules when they receive the resulting order.cancelled event
+type CancelOrderCommand struct {
+	application.BaseCommand
+	OrderID    string `json:"order_id" validate:"required"`
+	ReasonCode string `json:"reason_code" validate:"required"`
+	Reason     string `json:"reason" validate:"max=500"`
+	Actor      string `json:"actor"`
+}
+
+// NewCancelOrderCommand creates a new cancel order command
+func NewCancelOrderCommand(orderID, reasonCode, reason, actor string) CancelOrderCommand {
+	return CancelOrderCommand{
+		BaseCommand: application.NewBaseCommand("cancel_order"),
+		OrderID:     orderID,
+		ReasonCode:  reasonCode,
+		Reason:      reason,
+		Actor:       actor,
+	}
+}
+
+// OrderStatusResult represents the result of changing an order's status
+type OrderStatusResult struct {
+	OrderID        string `json:"order_id"`
+	PreviousStatus string `json:"previous_status"`
+	Status         string `json:"status"`
+	Version        int    `json:"version"`
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// CreateCouponCommand represents a command to create a coupon
+// Value is in basis points for percentage coupons and in the currency's minor unit for fixed_amount coupons
+type CreateCouponCommand struct {
+	application.BaseCommand
+	Code                      string     `json:"code" validate:"required,max=64"`
+	Description               string     `json:"description" validate:"max=255"`
+	Type                      string     `json:"type" validate:"required,oneof=percentage fixed_amount"`
+	Value                     int64      `json:"value" validate:"required,min=1"`
+	Currency                  string     `json:"currency,omitempty"`
+	ValidFrom                 *time.Time `json:"valid_from,omitempty"`
+	ValidUntil                *time.Time `json:"valid_until,omitempty"`
+	MaxRedemptions            int        `json:"max_redemptions" validate:"min=0"`
+	MaxRedemptionsPerCustomer int        `json:"max_redemptions_per_customer" validate:"min=0"`
+}
+
+// NewCreateCouponCommand creates a new create coupon command
+func NewCreateCouponCommand() CreateCouponCommand {
+	return CreateCouponCommand{
+		BaseCommand: application.NewBaseCommand("create_coupon"),
+	}
+}
+
+// UpdateCouponCommand represents a command to change a coupon's settings
+// Nil fields keep their current value; code, type, value and currency cannot change once created
+type UpdateCouponCommand struct {
+	application.BaseCommand
+	Code                      string     `json:"code" validate:"required"`
+	Description               *string    `json:"description,omitempty" validate:"omitempty,max=255"`
+	ValidFrom                 *time.Time `json:"valid_from,omitempty"`
+	ValidUntil                *time.Time `json:"valid_until,omitempty"`
+	ClearValidity             bool       `json:"clear_validity,omitempty"` // removes both validity bounds
+	MaxRedemptions            *int       `json:"max_redemptions,omitempty" validate:"omitempty,min=0"`
+	MaxRedemptionsPerCustomer *int       `json:"max_redemptions_per_customer,omitempty" validate:"omitempty,min=0"`
+	Active                    *bool      `json:"active,omitempty"`
+}
+
+// NewUpdateCouponCommand creates a new update coupon command
+func NewUpdateCouponCommand(code string) UpdateCouponCommand {
+	return UpdateCouponCommand{
+		BaseCommand: application.NewBaseCommand("update_coupon"),
+		Code:        code,
+	}
+}
+
+// CouponResult represents the result of a coupon command
+type CouponResult struct {
+	Code                      string     `json:"code"`
+	Description               string     `json:"description"`
+	Type                      string     `json:"type"`
+	Value                     int64      `json:"value"`
+	Currency                  string     `json:"currency,omitempty"`
+	ValidFrom                 *time.Time `json:"valid_from,omitempty"`
+	ValidUntil                *time.Time `json:"valid_until,omitempty"`
+	MaxRedemptions            int        `json:"max_redemptions"`
+	MaxRedemptionsPerCustomer int        `json:"max_redemptions_per_customer"`
+	RedemptionCount           int        `json:"redemption_count"`
+	Active                    bool       `json:"active"`
+	CreatedAt                 time.Time  `json:"created_at"`
+	UpdatedAt                 time.Time  `json:"updated_at"`
+}
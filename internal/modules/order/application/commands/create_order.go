@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"golang_modular_monolith/internal/shared/application"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateOrderLine represents a line item in CreateOrderCommand
+// UnitPrice is in the order currency's minor unit (e.g. cents)
+type CreateOrderLine struct {
+	ProductID   string `json:"product_id" validate:"required"`
+	ProductName string `json:"product_name" validate:"max=255"`
+	Quantity    int    `json:"quantity" validate:"required,min=1"`
+	UnitPrice   int64  `json:"unit_price" validate:"min=0"`
+}
+
+// Address represents a postal address in CreateOrderCommand and its result
+type Address struct {
+	Name       string `json:"name" validate:"required,max=255"`
+	Line1      string `json:"line1" validate:"required,max=255"`
+	Line2      string `json:"line2,omitempty" validate:"max=255"`
+	City       string `json:"city" validate:"required,max=255"`
+	Region     string `json:"region,omitempty" validate:"max=255"`
+	PostalCode string `json:"postal_code,omitempty" validate:"max=20"`
+	Country    string `json:"country" validate:"required,len=2"`
+	Phone      string `json:"phone,omitempty" validate:"max=32"`
+}
+
+// CreateOrderCommand represents a command to place a new order
+// Reference identifies the imported row the order comes from; on a bus with idempotency middleware,
+// a command is executed once per reference
+type CreateOrderCommand struct {
+	application.BaseCommand
+	CustomerID      string            `json:"customer_id" validate:"required"`
+	Currency        string            `json:"currency" validate:"required,len=3"`
+	Lines           []CreateOrderLine `json:"lines" validate:"required,min=1,dive"`
+	ShippingAddress *Address          `json:"shipping_address,omitempty"`
+	BillingAddress  *Address          `json:"billing_address,omitempty"` // defaults to the shipping address
+	CouponCode      string            `json:"coupon_code,omitempty" validate:"max=64"`
+	Actor           string            `json:"actor"`
+	Reference       string            `json:"reference,omitempty" validate:"max=248"`
+}
+
+// CommandName returns the name of the command
+func (*CreateOrderCommand) CommandName() string {
+	return "create_order"
+}
+
+// IdempotencyKey returns the key of the command's retries, derived from its import reference
+func (c *CreateOrderCommand) IdempotencyKey() string {
+	if c.Reference == "" {
+		return ""
+	}
+	return "import:" + c.Reference
+}
+
+// NewCreateOrderCommand creates a new create order command
+func NewCreateOrderCommand(customerID, currency string, lines []CreateOrderLine, actor string) CreateOrderCommand {
+	return CreateOrderCommand{
+		BaseCommand: application.NewBaseCommand("create_order"),
+		CustomerID:  customerID,
+		Currency:    currency,
+		Lines:       lines,
+		Actor:       actor,
+	}
+}
+
+// OrderLineResult represents a line item in order command results
+type OrderLineResult struct {
+	ID          string       `json:"id"`
+	ProductID   string       `json:"product_id"`
+	ProductName string       `json:"product_name"`
+	Quantity    int          `json:"quantity"`
+	UnitPrice   domain.Money `json:"unit_price"`
+	LineTotal   domain.Money `json:"line_total"`
+}
+
+// OrderDiscountResult represents a discount line in order command results
+type OrderDiscountResult struct {
+	CouponCode  string       `json:"coupon_code"`
+	Description string       `json:"description,omitempty"`
+	Type        string       `json:"type"`
+	Value       int64        `json:"value"`
+	Amount      domain.Money `json:"amount"`
+}
+
+// CreateOrderResult represents the result of creating an order
+type CreateOrderResult struct {
+	OrderID         string                `json:"order_id"`
+	OrderNumber     string                `json:"order_number"`
+	CustomerID      string                `json:"customer_id"`
+	Status          string                `json:"status"`
+	Currency        string                `json:"currency"`
+	Lines           []OrderLineResult     `json:"lines"`
+	TaxRateBps      int64                 `json:"tax_rate_bps"`
+	Subtotal        domain.Money          `json:"subtotal"`
+	Discounts       []OrderDiscountResult `json:"discounts"`
+	Discount        domain.Money          `json:"discount"`
+	Tax             domain.Money          `json:"tax"`
+	Total           domain.Money          `json:"total"`
+	ShippingAddress *Address              `json:"shipping_address,omitempty"`
+	BillingAddress  *Address              `json:"billing_address,omitempty"`
+}
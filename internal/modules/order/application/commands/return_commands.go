@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/shared/application"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateReturnLine represents an order line quantity in CreateReturnCommand
+type CreateReturnLine struct {
+	OrderLineID string `json:"order_line_id" validate:"required"`
+	Quantity    int    `json:"quantity" validate:"required,min=1"`
+}
+
+// CreateReturnCommand represents a command to request the return of order items
+type CreateReturnCommand struct {
+	application.BaseCommand
+	OrderID string             `json:"order_id" validate:"required"`
+	Lines   []CreateReturnLine `json:"lines" validate:"required,min=1,dive"`
+	Reason  string             `json:"reason" validate:"max=500"`
+	Actor   string             `json:"actor"`
+}
+
+// NewCreateReturnCommand creates a new create return command
+func NewCreateReturnCommand(orderID string, lines []CreateReturnLine, reason, actor string) CreateReturnCommand {
+	return CreateReturnCommand{
+		BaseCommand: application.NewBaseCommand("create_return"),
+		OrderID:     orderID,
+		Lines:       lines,
+		Reason:      reason,
+		Actor:       actor,
+	}
+}
+
+// ApproveReturnCommand represents a command to accept a return
+// The refund is issued by the payment module when it receives the resulting order.return_approved event
+type ApproveReturnCommand struct {
+	application.BaseCommand
+	ReturnID string `json:"return_id" validate:"required"`
+	Actor    string `json:"actor"`
+}
+
+// NewApproveReturnCommand creates a new approve return command
+func NewApproveReturnCommand(returnID, actor string) ApproveReturnCommand {
+	return ApproveReturnCommand{
+		BaseCommand: application.NewBaseCommand("approve_return"),
+		ReturnID:    returnID,
+		Actor:       actor,
+	}
+}
+
+// RejectReturnCommand represents a command to decline a return
+type RejectReturnCommand struct {
+	application.BaseCommand
+	ReturnID string `json:"return_id" validate:"required"`
+	Reason   string `json:"reason" validate:"max=500"`
+	Actor    string `json:"actor"`
+}
+
+// NewRejectReturnCommand creates a new reject return command
+func NewRejectReturnCommand(returnID, reason, actor string) RejectReturnCommand {
+	return RejectReturnCommand{
+		BaseCommand: application.NewBaseCommand("reject_return"),
+		ReturnID:    returnID,
+		Reason:      reason,
+		Actor:       actor,
+	}
+}
+
+// MarkReturnRefundedCommand represents a command to record the refund issued for an approved return
+type MarkReturnRefundedCommand struct {
+	application.BaseCommand
+	ReturnID        string `json:"return_id" validate:"required"`
+	RefundReference string `json:"refund_reference"`
+	Actor           string `json:"actor"`
+}
+
+// NewMarkReturnRefundedCommand creates a new mark return refunded command
+func NewMarkReturnRefundedCommand(returnID, refundReference, actor string) MarkReturnRefundedCommand {
+	return MarkReturnRefundedCommand{
+		BaseCommand:     application.NewBaseCommand("mark_return_refunded"),
+		ReturnID:        returnID,
+		RefundReference: refundReference,
+		Actor:           actor,
+	}
+}
+
+// ReturnLineResult represents a returned order line in ReturnResult
+type ReturnLineResult struct {
+	OrderLineID string       `json:"order_line_id"`
+	ProductID   string       `json:"product_id"`
+	ProductName string       `json:"product_name"`
+	Quantity    int          `json:"quantity"`
+	UnitPrice   domain.Money `json:"unit_price"`
+	Amount      domain.Money `json:"amount"`
+}
+
+// ReturnResult represents the state of a return returned by return commands
+type ReturnResult struct {
+	ID              string             `json:"id"`
+	OrderID         string             `json:"order_id"`
+	CustomerID      string             `json:"customer_id"`
+	Status          string             `json:"status"`
+	Reason          string             `json:"reason,omitempty"`
+	Lines           []ReturnLineResult `json:"lines"`
+	RefundAmount    domain.Money       `json:"refund_amount"`
+	RefundReference string             `json:"refund_reference,omitempty"`
+	RejectionReason string             `json:"rejection_reason,omitempty"`
+	Version         int                `json:"version"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
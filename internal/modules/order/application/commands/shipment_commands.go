@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// CreateShipmentLine represents an order line quantity in CreateShipmentCommand
+type CreateShipmentLine struct {
+	OrderLineID string `json:"order_line_id" validate:"required"`
+	Quantity    int    `json:"quantity" validate:"required,min=1"`
+}
+
+// CreateShipmentCommand represents a command to record order items handed over to a carrier
+// Without lines, every quantity that has not been shipped yet is included
+type CreateShipmentCommand struct {
+	application.BaseCommand
+	OrderID        string               `json:"order_id" validate:"required"`
+	Carrier        string               `json:"carrier" validate:"required,max=50"`
+	TrackingNumber string               `json:"tracking_number" validate:"required,max=100"`
+	Lines          []CreateShipmentLine `json:"lines" validate:"dive"`
+	ShippedAt      *time.Time           `json:"shipped_at"`
+	Actor          string               `json:"actor"`
+}
+
+// NewCreateShipmentCommand creates a new create shipment command
+func NewCreateShipmentCommand(orderID, carrier, trackingNumber string, lines []CreateShipmentLine, actor string) CreateShipmentCommand {
+	return CreateShipmentCommand{
+		BaseCommand:    application.NewBaseCommand("create_shipment"),
+		OrderID:        orderID,
+		Carrier:        carrier,
+		TrackingNumber: trackingNumber,
+		Lines:          lines,
+		Actor:          actor,
+	}
+}
+
+// TrackingUpdate represents a shipment status update in shipment commands
+type TrackingUpdate struct {
+	Status      string     `json:"status" validate:"required"`
+	Description string     `json:"description" validate:"max=500"`
+	Location    string     `json:"location" validate:"max=255"`
+	OccurredAt  *time.Time `json:"occurred_at"`
+}
+
+// UpdateShipmentStatusCommand represents a command to record a shipment status update manually
+type UpdateShipmentStatusCommand struct {
+	application.BaseCommand
+	OrderID    string         `json:"order_id" validate:"required"`
+	ShipmentID string         `json:"shipment_id" validate:"required"`
+	Update     TrackingUpdate `json:"update"`
+	Actor      string         `json:"actor"`
+}
+
+// NewUpdateShipmentStatusCommand creates a new update shipment status command
+func NewUpdateShipmentStatusCommand(orderID, shipmentID string, update TrackingUpdate, actor string) UpdateShipmentStatusCommand {
+	return UpdateShipmentStatusCommand{
+		BaseCommand: application.NewBaseCommand("update_shipment_status"),
+		OrderID:     orderID,
+		ShipmentID:  shipmentID,
+		Update:      update,
+		Actor:       actor,
+	}
+}
+
+// RecordTrackingUpdateCommand represents a command to ingest a carrier tracking notification
+// The shipment is looked up by carrier and tracking number
+type RecordTrackingUpdateCommand struct {
+	application.BaseCommand
+	Carrier        string         `json:"carrier" validate:"required"`
+	TrackingNumber string         `json:"tracking_number" validate:"required"`
+	Update         TrackingUpdate `json:"update"`
+	Actor          string         `json:"actor"`
+}
+
+// NewRecordTrackingUpdateCommand creates a new record tracking update command
+func NewRecordTrackingUpdateCommand(carrier, trackingNumber string, update TrackingUpdate, actor string) RecordTrackingUpdateCommand {
+	return RecordTrackingUpdateCommand{
+		BaseCommand:    application.NewBaseCommand("record_tracking_update"),
+		Carrier:        carrier,
+		TrackingNumber: trackingNumber,
+		Update:         update,
+		Actor:          actor,
+	}
+}
+
+// ShipmentLineResult represents a shipped order line in ShipmentResult
+type ShipmentLineResult struct {
+	OrderLineID string `json:"order_line_id"`
+	Quantity    int    `json:"quantity"`
+}
+
+// TrackingEventResult represents a recorded shipment status update in ShipmentResult
+type TrackingEventResult struct {
+	Status      string    `json:"status"`
+	Description string    `json:"description,omitempty"`
+	Location    string    `json:"location,omitempty"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+// ShipmentResult represents the state of a shipment returned by shipment commands
+// OrderStatus reflects automatic transitions to shipped or delivered
+type ShipmentResult struct {
+	ID             string                `json:"id"`
+	OrderID        string                `json:"order_id"`
+	OrderStatus    string                `json:"order_status"`
+	Carrier        string                `json:"carrier"`
+	TrackingNumber string                `json:"tracking_number"`
+	Status         string                `json:"status"`
+	Lines          []ShipmentLineResult  `json:"lines"`
+	TrackingEvents []TrackingEventResult `json:"tracking_events"`
+	ShippedAt      time.Time             `json:"shipped_at"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+}
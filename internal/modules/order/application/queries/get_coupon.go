@@ -0,0 +1,27 @@
+package queries
+
+import (
+	"golang_modular_monolith/internal/modules/order/domain"
+)
+
+// GetCouponQuery represents a query to get a coupon by code
+type GetCouponQuery struct {
+	Code string `json:"code"`
+}
+
+// GetCouponResult represents the result of GetCouponQuery
+type GetCouponResult struct {
+	Coupon domain.Coupon `json:"coupon"`
+}
+
+// ListCouponsQuery represents a query to list coupons with pagination
+type ListCouponsQuery struct {
+	Page       int  `json:"page"`
+	Limit      int  `json:"limit"`
+	ActiveOnly bool `json:"active_only"`
+}
+
+// ListCouponsResult represents the result of ListCouponsQuery
+type ListCouponsResult struct {
+	domain.CouponListResult
+}
@@ -0,0 +1,77 @@
+package queries
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+)
+
+// GetOrderQuery represents a query to get an order by ID
+type GetOrderQuery struct {
+	ID string `json:"id"`
+}
+
+// GetOrderResult represents the result of GetOrderQuery
+type GetOrderResult struct {
+	Order domain.OrderView `json:"order"`
+}
+
+// GetOrderHistoryQuery represents a query to get the status history of an order
+type GetOrderHistoryQuery struct {
+	ID string `json:"id"`
+}
+
+// GetOrderHistoryResult represents the result of GetOrderHistoryQuery
+type GetOrderHistoryResult struct {
+	OrderID string                     `json:"order_id"`
+	Entries []domain.OrderHistoryEntry `json:"entries"`
+}
+
+// ListOrdersQuery represents a query to list orders with pagination
+type ListOrdersQuery struct {
+	Page          int                  `json:"page"`
+	Limit         int                  `json:"limit"`
+	Statuses      []domain.OrderStatus `json:"statuses,omitempty"`
+	CustomerID    string               `json:"customer_id,omitempty"`
+	Currency      string               `json:"currency,omitempty"`
+	MinTotal      *int64               `json:"min_total,omitempty"`
+	MaxTotal      *int64               `json:"max_total,omitempty"`
+	CreatedAfter  *time.Time           `json:"created_after,omitempty"`
+	CreatedBefore *time.Time           `json:"created_before,omitempty"`
+	SortBy        string               `json:"sort_by"`
+	SortOrder     string               `json:"sort_order"`
+}
+
+// ListOrdersResult represents the result of ListOrdersQuery
+type ListOrdersResult struct {
+	domain.OrderListResult
+}
+
+// SearchOrdersQuery represents a query to search orders
+type SearchOrdersQuery struct {
+	ListOrdersQuery
+	Query     string `json:"query"`
+	ProductID string `json:"product_id"`
+}
+
+// SearchOrdersResult represents the result of SearchOrdersQuery
+type SearchOrdersResult struct {
+	domain.OrderListResult
+}
+
+// ToParams converts the query filters to domain list params
+func (q *ListOrdersQuery) ToParams() domain.ListOrdersParams {
+	return domain.ListOrdersParams{
+		Page:          q.Page,
+		Limit:         q.Limit,
+		SortBy:        q.SortBy,
+		SortOrder:     q.SortOrder,
+		Statuses:      q.Statuses,
+		CustomerID:    q.CustomerID,
+		Currency:      q.Currency,
+		MinTotal:      q.MinTotal,
+		MaxTotal:      q.MaxTotal,
+		CreatedAfter:  q.CreatedAfter,
+		CreatedBefore: q.CreatedBefore,
+	}
+}
@@ -0,0 +1,26 @@
+package queries
+
+import (
+	"golang_modular_monolith/internal/modules/order/domain"
+)
+
+// GetReturnQuery represents a query to get an order return by ID
+type GetReturnQuery struct {
+	ID string `json:"id"`
+}
+
+// GetReturnResult represents the result of GetReturnQuery
+type GetReturnResult struct {
+	Return domain.Return `json:"return"`
+}
+
+// ListOrderReturnsQuery represents a query to list the returns of an order
+type ListOrderReturnsQuery struct {
+	OrderID string `json:"order_id"`
+}
+
+// ListOrderReturnsResult represents the result of ListOrderReturnsQuery
+type ListOrderReturnsResult struct {
+	OrderID string          `json:"order_id"`
+	Returns []domain.Return `json:"returns"`
+}
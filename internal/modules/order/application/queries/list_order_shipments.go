@@ -0,0 +1,17 @@
+package queries
+
+import (
+	"golang_modular_monolith/internal/modules/order/domain"
+)
+
+// ListOrderShipmentsQuery represents a query to list the shipments of an order
+type ListOrderShipmentsQuery struct {
+	OrderID string `json:"order_id"`
+}
+
+// ListOrderShipmentsResult represents the result of ListOrderShipmentsQuery
+type ListOrderShipmentsResult struct {
+	OrderID     string            `json:"order_id"`
+	OrderStatus string            `json:"order_status"`
+	Shipments   []domain.Shipment `json:"shipments"`
+}
@@ -0,0 +1,23 @@
+package queries
+
+import "golang_modular_monolith/internal/modules/order/domain"
+
+// ExportOrdersQuery represents a query to export every order matching the list filters
+// Page and Limit are ignored
+type ExportOrdersQuery struct {
+	ListOrdersQuery
+}
+
+// GetOrderSummaryQuery represents a query for order counts and revenue grouped by day or status
+type GetOrderSummaryQuery struct {
+	ListOrdersQuery
+	GroupBy  string `json:"group_by"`
+	Timezone string `json:"timezone"`
+}
+
+// GetOrderSummaryResult represents the result of GetOrderSummaryQuery
+type GetOrderSummaryResult struct {
+	GroupBy  domain.OrderSummaryGroupBy `json:"group_by"`
+	Timezone string                     `json:"timezone"`
+	Groups   []domain.OrderSummaryGroup `json:"groups"`
+}
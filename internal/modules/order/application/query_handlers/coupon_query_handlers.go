@@ -0,0 +1,78 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// GetCouponHandler handles GetCouponQuery
+type GetCouponHandler struct {
+	coupons domain.CouponRepository
+}
+
+// NewGetCouponHandler creates a new GetCouponHandler
+func NewGetCouponHandler(coupons domain.CouponRepository) *GetCouponHandler {
+	return &GetCouponHandler{
+		coupons: coupons,
+	}
+}
+
+// Handle handles the GetCouponQuery
+func (h *GetCouponHandler) Handle(ctx context.Context, query *queries.GetCouponQuery) (*queries.GetCouponResult, error) {
+	code := domain.NormalizeCouponCode(query.Code)
+	if code == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"coupon code is required",
+		)
+	}
+
+	coupon, err := h.coupons.GetByCode(ctx, code)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("coupon with code %s not found", code),
+			)
+		}
+		return nil, fmt.Errorf("failed to get coupon: %w", err)
+	}
+
+	return &queries.GetCouponResult{
+		Coupon: *coupon,
+	}, nil
+}
+
+// ListCouponsHandler handles ListCouponsQuery
+type ListCouponsHandler struct {
+	coupons domain.CouponRepository
+}
+
+// NewListCouponsHandler creates a new ListCouponsHandler
+func NewListCouponsHandler(coupons domain.CouponRepository) *ListCouponsHandler {
+	return &ListCouponsHandler{
+		coupons: coupons,
+	}
+}
+
+// Handle handles the ListCouponsQuery
+func (h *ListCouponsHandler) Handle(ctx context.Context, query *queries.ListCouponsQuery) (*queries.ListCouponsResult, error) {
+	params := domain.ListCouponsParams{
+		Page:       query.Page,
+		Limit:      query.Limit,
+		ActiveOnly: query.ActiveOnly,
+	}
+
+	result, err := h.coupons.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list coupons: %w", err)
+	}
+
+	return &queries.ListCouponsResult{
+		CouponListResult: *result,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// GetOrderHandler handles GetOrderQuery
+type GetOrderHandler struct {
+	queryRepo domain.OrderQueryRepository
+}
+
+// NewGetOrderHandler creates a new GetOrderHandler
+func NewGetOrderHandler(queryRepo domain.OrderQueryRepository) *GetOrderHandler {
+	return &GetOrderHandler{
+		queryRepo: queryRepo,
+	}
+}
+
+// Handle handles the GetOrderQuery
+func (h *GetOrderHandler) Handle(ctx context.Context, query *queries.GetOrderQuery) (*queries.GetOrderResult, error) {
+	// Validate query
+	if query.ID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"order ID is required",
+		)
+	}
+
+	// Get order from repository
+	order, err := h.queryRepo.GetByID(ctx, query.ID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("order with ID %s not found", query.ID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	return &queries.GetOrderResult{
+		Order: *order,
+	}, nil
+}
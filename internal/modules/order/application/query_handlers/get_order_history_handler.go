@@ -0,0 +1,51 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// GetOrderHistoryHandler handles GetOrderHistoryQuery
+type GetOrderHistoryHandler struct {
+	queryRepo domain.OrderQueryRepository
+}
+
+// NewGetOrderHistoryHandler creates a new GetOrderHistoryHandler
+func NewGetOrderHistoryHandler(queryRepo domain.OrderQueryRepository) *GetOrderHistoryHandler {
+	return &GetOrderHistoryHandler{
+		queryRepo: queryRepo,
+	}
+}
+
+// Handle handles the GetOrderHistoryQuery
+func (h *GetOrderHistoryHandler) Handle(ctx context.Context, query *queries.GetOrderHistoryQuery) (*queries.GetOrderHistoryResult, error) {
+	// Validate query
+	if query.ID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"order ID is required",
+		)
+	}
+
+	entries, err := h.queryRepo.GetHistory(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty timeline means the order does not exist, since creation is always recorded
+	if len(entries) == 0 {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeNotFound,
+			fmt.Sprintf("order with ID %s not found", query.ID),
+		)
+	}
+
+	return &queries.GetOrderHistoryResult{
+		OrderID: query.ID,
+		Entries: entries,
+	}, nil
+}
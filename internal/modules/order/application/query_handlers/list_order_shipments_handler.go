@@ -0,0 +1,50 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// ListOrderShipmentsHandler handles ListOrderShipmentsQuery
+// Shipments are part of the order aggregate and read from the order repository
+type ListOrderShipmentsHandler struct {
+	orders domain.OrderRepository
+}
+
+// NewListOrderShipmentsHandler creates a new ListOrderShipmentsHandler
+func NewListOrderShipmentsHandler(orders domain.OrderRepository) *ListOrderShipmentsHandler {
+	return &ListOrderShipmentsHandler{
+		orders: orders,
+	}
+}
+
+// Handle handles the ListOrderShipmentsQuery
+func (h *ListOrderShipmentsHandler) Handle(ctx context.Context, query *queries.ListOrderShipmentsQuery) (*queries.ListOrderShipmentsResult, error) {
+	if query.OrderID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"order ID is required",
+		)
+	}
+
+	order, err := h.orders.GetByID(ctx, query.OrderID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("order with ID %s not found", query.OrderID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	return &queries.ListOrderShipmentsResult{
+		OrderID:     order.GetID(),
+		OrderStatus: string(order.Status),
+		Shipments:   order.Shipments,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	"golang_modular_monolith/internal/modules/order/domain"
+)
+
+// ListOrdersHandler handles ListOrdersQuery
+type ListOrdersHandler struct {
+	queryRepo domain.OrderQueryRepository
+}
+
+// NewListOrdersHandler creates a new ListOrdersHandler
+func NewListOrdersHandler(queryRepo domain.OrderQueryRepository) *ListOrdersHandler {
+	return &ListOrdersHandler{
+		queryRepo: queryRepo,
+	}
+}
+
+// Handle handles the ListOrdersQuery
+func (h *ListOrdersHandler) Handle(ctx context.Context, query *queries.ListOrdersQuery) (*queries.ListOrdersResult, error) {
+	// Get orders from repository
+	result, err := h.queryRepo.List(ctx, query.ToParams())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list orders: %w", err)
+	}
+
+	return &queries.ListOrdersResult{
+		OrderListResult: *result,
+	}, nil
+}
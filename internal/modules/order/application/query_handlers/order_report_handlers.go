@@ -0,0 +1,64 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	"golang_modular_monolith/internal/modules/order/domain"
+)
+
+// ExportOrdersHandler handles ExportOrdersQuery
+type ExportOrdersHandler struct {
+	queryRepo domain.OrderQueryRepository
+}
+
+// NewExportOrdersHandler creates a new ExportOrdersHandler
+func NewExportOrdersHandler(queryRepo domain.OrderQueryRepository) *ExportOrdersHandler {
+	return &ExportOrdersHandler{
+		queryRepo: queryRepo,
+	}
+}
+
+// Handle streams the matching orders to fn
+// Errors returned by fn are passed through unchanged so the caller can tell write failures apart
+func (h *ExportOrdersHandler) Handle(ctx context.Context, query *queries.ExportOrdersQuery, fn func(domain.OrderView) error) error {
+	return h.queryRepo.Export(ctx, query.ToParams(), fn)
+}
+
+// GetOrderSummaryHandler handles GetOrderSummaryQuery
+type GetOrderSummaryHandler struct {
+	queryRepo domain.OrderQueryRepository
+}
+
+// NewGetOrderSummaryHandler creates a new GetOrderSummaryHandler
+func NewGetOrderSummaryHandler(queryRepo domain.OrderQueryRepository) *GetOrderSummaryHandler {
+	return &GetOrderSummaryHandler{
+		queryRepo: queryRepo,
+	}
+}
+
+// Handle handles the GetOrderSummaryQuery
+func (h *GetOrderSummaryHandler) Handle(ctx context.Context, query *queries.GetOrderSummaryQuery) (*queries.GetOrderSummaryResult, error) {
+	params := domain.OrderSummaryParams{
+		ListOrdersParams: query.ToParams(),
+		GroupBy:          domain.OrderSummaryGroupBy(query.GroupBy),
+		Timezone:         query.Timezone,
+	}
+
+	// Validate here as well so the defaults applied are reported back in the result
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	groups, err := h.queryRepo.Summarize(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize orders: %w", err)
+	}
+
+	return &queries.GetOrderSummaryResult{
+		GroupBy:  params.GroupBy,
+		Timezone: params.Timezone,
+		Groups:   groups,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// GetReturnHandler handles GetReturnQuery
+type GetReturnHandler struct {
+	returns domain.ReturnRepository
+}
+
+// NewGetReturnHandler creates a new GetReturnHandler
+func NewGetReturnHandler(returns domain.ReturnRepository) *GetReturnHandler {
+	return &GetReturnHandler{
+		returns: returns,
+	}
+}
+
+// Handle handles the GetReturnQuery
+func (h *GetReturnHandler) Handle(ctx context.Context, query *queries.GetReturnQuery) (*queries.GetReturnResult, error) {
+	if query.ID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"return ID is required",
+		)
+	}
+
+	ret, err := h.returns.GetByID(ctx, query.ID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("return with ID %s not found", query.ID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get return: %w", err)
+	}
+
+	return &queries.GetReturnResult{
+		Return: *ret,
+	}, nil
+}
+
+// ListOrderReturnsHandler handles ListOrderReturnsQuery
+type ListOrderReturnsHandler struct {
+	orders  domain.OrderQueryRepository
+	returns domain.ReturnRepository
+}
+
+// NewListOrderReturnsHandler creates a new ListOrderReturnsHandler
+func NewListOrderReturnsHandler(orders domain.OrderQueryRepository, returns domain.ReturnRepository) *ListOrderReturnsHandler {
+	return &ListOrderReturnsHandler{
+		orders:  orders,
+		returns: returns,
+	}
+}
+
+// Handle handles the ListOrderReturnsQuery
+// An order without returns yields an empty list; an unknown order is not found
+func (h *ListOrderReturnsHandler) Handle(ctx context.Context, query *queries.ListOrderReturnsQuery) (*queries.ListOrderReturnsResult, error) {
+	if query.OrderID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"order ID is required",
+		)
+	}
+
+	if _, err := h.orders.GetByID(ctx, query.OrderID); err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("order with ID %s not found", query.OrderID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	returns, err := h.returns.ListByOrderID(ctx, query.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &queries.ListOrderReturnsResult{
+		OrderID: query.OrderID,
+		Returns: make([]domain.Return, len(returns)),
+	}
+	for i, ret := range returns {
+		result.Returns[i] = *ret
+	}
+
+	return result, nil
+}
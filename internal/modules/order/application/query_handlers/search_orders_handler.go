@@ -0,0 +1,41 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	"golang_modular_monolith/internal/modules/order/domain"
+)
+
+// SearchOrdersHandler handles SearchOrdersQuery
+type SearchOrdersHandler struct {
+	queryRepo domain.OrderQueryRepository
+}
+
+// NewSearchOrdersHandler creates a new SearchOrdersHandler
+func NewSearchOrdersHandler(queryRepo domain.OrderQueryRepository) *SearchOrdersHandler {
+	return &SearchOrdersHandler{
+		queryRepo: queryRepo,
+	}
+}
+
+// Handle handles the SearchOrdersQuery
+func (h *SearchOrdersHandler) Handle(ctx context.Context, query *queries.SearchOrdersQuery) (*queries.SearchOrdersResult, error) {
+	// Convert query to domain params
+	params := domain.SearchOrdersParams{
+		ListOrdersParams: query.ToParams(),
+		Query:            query.Query,
+		ProductID:        query.ProductID,
+	}
+
+	// Search orders from repository
+	result, err := h.queryRepo.Search(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search orders: %w", err)
+	}
+
+	return &queries.SearchOrdersResult{
+		OrderListResult: *result,
+	}, nil
+}
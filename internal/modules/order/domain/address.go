@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Address field length limits
+const (
+	MaxAddressFieldLength = 255
+	MaxPostalCodeLength   = 20
+	MaxPhoneLength        = 32
+)
+
+// Address is a postal address copied into an order when it is placed
+// The copy is never updated, so later changes to the customer's addresses do not rewrite order history
+type Address struct {
+	Name       string `json:"name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"` // ISO 3166-1 alpha-2, e.g. "VN"
+	Phone      string `json:"phone,omitempty"`
+}
+
+// OrderAddresses holds the addresses chosen for an order
+// The billing address defaults to the shipping address when omitted
+type OrderAddresses struct {
+	Shipping *Address
+	Billing  *Address
+}
+
+// normalize trims the address fields and validates them, reporting errors under the given field name
+func (a Address) normalize(field string, validationErrors *domain.ValidationErrors) Address {
+	normalized := Address{
+		Name:       strings.TrimSpace(a.Name),
+		Line1:      strings.TrimSpace(a.Line1),
+		Line2:      strings.TrimSpace(a.Line2),
+		City:       strings.TrimSpace(a.City),
+		Region:     strings.TrimSpace(a.Region),
+		PostalCode: strings.TrimSpace(a.PostalCode),
+		Country:    strings.ToUpper(strings.TrimSpace(a.Country)),
+		Phone:      strings.TrimSpace(a.Phone),
+	}
+
+	fields := []struct {
+		name     string
+		value    string
+		required bool
+	}{
+		{"name", normalized.Name, true},
+		{"line1", normalized.Line1, true},
+		{"line2", normalized.Line2, false},
+		{"city", normalized.City, true},
+		{"region", normalized.Region, false},
+	}
+	for _, f := range fields {
+		if f.required && f.value == "" {
+			validationErrors.Add(field+"."+f.name, f.name+" is required")
+		}
+		if len(f.value) > MaxAddressFieldLength {
+			validationErrors.Add(field+"."+f.name, fmt.Sprintf("%s must be at most %d characters", f.name, MaxAddressFieldLength))
+		}
+	}
+
+	if len(normalized.PostalCode) > MaxPostalCodeLength {
+		validationErrors.Add(field+".postal_code", fmt.Sprintf("postal_code must be at most %d characters", MaxPostalCodeLength))
+	}
+	if len(normalized.Phone) > MaxPhoneLength {
+		validationErrors.Add(field+".phone", fmt.Sprintf("phone must be at most %d characters", MaxPhoneLength))
+	}
+	if !isCountryCode(normalized.Country) {
+		validationErrors.AddWithValue(field+".country", "country must be an ISO 3166-1 alpha-2 code", a.Country)
+	}
+
+	return normalized
+}
+
+// normalize validates the order addresses and applies the billing default
+func (a OrderAddresses) normalize(validationErrors *domain.ValidationErrors) OrderAddresses {
+	var normalized OrderAddresses
+
+	if a.Shipping != nil {
+		shipping := a.Shipping.normalize("shipping_address", validationErrors)
+		normalized.Shipping = &shipping
+	}
+
+	if a.Billing != nil {
+		billing := a.Billing.normalize("billing_address", validationErrors)
+		normalized.Billing = &billing
+	} else if normalized.Shipping != nil {
+		billing := *normalized.Shipping
+		normalized.Billing = &billing
+	}
+
+	return normalized
+}
+
+// isCountryCode checks for two upper case ASCII letters
+func isCountryCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
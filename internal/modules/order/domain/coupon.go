@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// CouponType represents how a coupon discount is calculated
+type CouponType string
+
+const (
+	CouponTypePercentage  CouponType = "percentage"   // Value is in basis points of the subtotal
+	CouponTypeFixedAmount CouponType = "fixed_amount" // Value is in the coupon currency's minor unit
+)
+
+// IsValid checks if the coupon type is known
+func (t CouponType) IsValid() bool {
+	return t == CouponTypePercentage || t == CouponTypeFixedAmount
+}
+
+// MaxCouponCodeLength is the longest accepted coupon code
+const MaxCouponCodeLength = 64
+
+// Coupon represents a discount code that can be applied to new orders
+// Usage limits are enforced when the coupon is redeemed, see CouponRepository.Redeem
+type Coupon struct {
+	Code                      string     `json:"code"`
+	Description               string     `json:"description"`
+	Type                      CouponType `json:"type"`
+	Value                     int64      `json:"value"`
+	Currency                  string     `json:"currency,omitempty"` // required for fixed_amount coupons
+	ValidFrom                 *time.Time `json:"valid_from,omitempty"`
+	ValidUntil                *time.Time `json:"valid_until,omitempty"`
+	MaxRedemptions            int        `json:"max_redemptions"`              // 0 means unlimited
+	MaxRedemptionsPerCustomer int        `json:"max_redemptions_per_customer"` // 0 means unlimited
+	RedemptionCount           int        `json:"redemption_count"`
+	Active                    bool       `json:"active"`
+	CreatedAt                 time.Time  `json:"created_at"`
+	UpdatedAt                 time.Time  `json:"updated_at"`
+}
+
+// CouponSettings holds the mutable settings of a coupon
+type CouponSettings struct {
+	Description               string
+	ValidFrom                 *time.Time
+	ValidUntil                *time.Time
+	MaxRedemptions            int
+	MaxRedemptionsPerCustomer int
+	Active                    bool
+}
+
+// NormalizeCouponCode trims and upper-cases a coupon code so lookups are case-insensitive
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// NewCoupon creates a new active coupon
+func NewCoupon(code string, couponType CouponType, value int64, currency string, settings CouponSettings) (*Coupon, error) {
+	var validationErrors domain.ValidationErrors
+
+	code = NormalizeCouponCode(code)
+	if code == "" {
+		validationErrors.Add("code", "code is required")
+	} else if len(code) > MaxCouponCodeLength {
+		validationErrors.Add("code", fmt.Sprintf("code must be at most %d characters", MaxCouponCodeLength))
+	}
+
+	switch couponType {
+	case CouponTypePercentage:
+		if value <= 0 || value > 10000 {
+			validationErrors.AddWithValue("value", "percentage value must be between 1 and 10000 basis points", value)
+		}
+		currency = ""
+	case CouponTypeFixedAmount:
+		if value <= 0 {
+			validationErrors.AddWithValue("value", "fixed amount value must be positive", value)
+		}
+		normalized, err := domain.NormalizeCurrency(currency)
+		if err != nil {
+			validationErrors.AddWithValue("currency", "fixed amount coupons require a valid currency", currency)
+		}
+		currency = normalized
+	default:
+		validationErrors.AddWithValue("type", "type must be percentage or fixed_amount", string(couponType))
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	now := time.Now()
+	coupon := &Coupon{
+		Code:      code,
+		Type:      couponType,
+		Value:     value,
+		Currency:  currency,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := coupon.UpdateSettings(settings); err != nil {
+		return nil, err
+	}
+
+	return coupon, nil
+}
+
+// UpdateSettings replaces the coupon's description, validity window, usage limits and active flag
+func (c *Coupon) UpdateSettings(settings CouponSettings) error {
+	var validationErrors domain.ValidationErrors
+
+	if len(settings.Description) > 255 {
+		validationErrors.Add("description", "description must be at most 255 characters")
+	}
+	if settings.ValidFrom != nil && settings.ValidUntil != nil && !settings.ValidFrom.Before(*settings.ValidUntil) {
+		validationErrors.Add("valid_until", "valid_until must be later than valid_from")
+	}
+	if settings.MaxRedemptions < 0 {
+		validationErrors.AddWithValue("max_redemptions", "max_redemptions must not be negative", settings.MaxRedemptions)
+	}
+	if settings.MaxRedemptionsPerCustomer < 0 {
+		validationErrors.AddWithValue("max_redemptions_per_customer", "max_redemptions_per_customer must not be negative", settings.MaxRedemptionsPerCustomer)
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+
+	c.Description = strings.TrimSpace(settings.Description)
+	c.ValidFrom = settings.ValidFrom
+	c.ValidUntil = settings.ValidUntil
+	c.MaxRedemptions = settings.MaxRedemptions
+	c.MaxRedemptionsPerCustomer = settings.MaxRedemptionsPerCustomer
+	c.Active = settings.Active
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// CheckApplicable checks if the coupon may be applied to an order in the given currency at the given time
+// Usage limits are not checked here because they depend on concurrent redemptions
+func (c *Coupon) CheckApplicable(currency string, at time.Time) error {
+	if !c.Active {
+		return domain.NewBusinessRuleError("coupon_inactive", fmt.Sprintf("coupon %s is not active", c.Code))
+	}
+	if c.ValidFrom != nil && at.Before(*c.ValidFrom) {
+		return domain.NewBusinessRuleError("coupon_not_yet_valid", fmt.Sprintf("coupon %s is not valid yet", c.Code))
+	}
+	if c.ValidUntil != nil && !at.Before(*c.ValidUntil) {
+		return domain.NewBusinessRuleError("coupon_expired", fmt.Sprintf("coupon %s has expired", c.Code))
+	}
+	if c.Type == CouponTypeFixedAmount && c.Currency != currency {
+		return domain.NewBusinessRuleError("coupon_currency_mismatch", fmt.Sprintf("coupon %s only applies to %s orders", c.Code, c.Currency))
+	}
+	if c.MaxRedemptions > 0 && c.RedemptionCount >= c.MaxRedemptions {
+		return domain.NewBusinessRuleError("coupon_exhausted", fmt.Sprintf("coupon %s has reached its redemption limit", c.Code))
+	}
+	return nil
+}
+
+// ToDiscount returns the order discount granted by the coupon
+func (c *Coupon) ToDiscount() OrderDiscount {
+	return OrderDiscount{
+		CouponCode:  c.Code,
+		Description: c.Description,
+		Type:        c.Type,
+		Value:       c.Value,
+	}
+}
+
+// OrderDiscount is a discount line applied to an order
+// Amount is recalculated from Type and Value whenever the order totals change
+type OrderDiscount struct {
+	CouponCode  string       `json:"coupon_code"`
+	Description string       `json:"description,omitempty"`
+	Type        CouponType   `json:"type"`
+	Value       int64        `json:"value"`
+	Amount      domain.Money `json:"amount"`
+}
+
+// amountFor calculates the discount on the given remaining subtotal, never exceeding it
+func (d OrderDiscount) amountFor(remaining domain.Money) (domain.Money, error) {
+	var amount domain.Money
+	switch d.Type {
+	case CouponTypePercentage:
+		var err error
+		if amount, err = remaining.MultiplyBasisPoints(d.Value, domain.RoundHalfUp); err != nil {
+			return domain.Money{}, err
+		}
+	case CouponTypeFixedAmount:
+		amount = domain.Money{Amount: d.Value, Currency: remaining.Currency}
+	default:
+		return domain.Money{}, fmt.Errorf("unknown discount type %q", d.Type)
+	}
+
+	if amount.Amount > remaining.Amount {
+		amount.Amount = remaining.Amount
+	}
+	return amount, nil
+}
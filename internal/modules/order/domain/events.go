@@ -0,0 +1,561 @@
+package domain
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/modules/order/publicapi"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Order domain event types
+const (
+	OrderCreatedEventType   = publicapi.OrderCreatedEventType
+	OrderLineAddedEventType = publicapi.OrderLineAddedEventType
+	OrderConfirmedEventType = publicapi.OrderConfirmedEventType
+	OrderCancelledEventType = publicapi.OrderCancelledEventType
+	OrderShippedEventType   = publicapi.OrderShippedEventType
+	OrderDeliveredEventType = publicapi.OrderDeliveredEventType
+
+	OrderShipmentCreatedEventType = "order.shipment_created"
+	OrderShipmentUpdatedEventType = "order.shipment_updated"
+
+	ReturnRequestedEventType = "order.return_requested"
+	ReturnApprovedEventType  = publicapi.OrderReturnApprovedEventType
+	ReturnRejectedEventType  = "order.return_rejected"
+	ReturnRefundedEventType  = "order.return_refunded"
+)
+
+// OrderCreatedEvent represents the event when an order is created
+type OrderCreatedEvent struct {
+	domain.BaseDomainEvent
+	OrderID         string          `json:"order_id"`
+	OrderNumber     string          `json:"order_number"`
+	CustomerID      string          `json:"customer_id"`
+	Status          string          `json:"status"`
+	Currency        string          `json:"currency"`
+	Lines           []OrderLine     `json:"lines"`
+	Discounts       []OrderDiscount `json:"discounts"`
+	Subtotal        domain.Money    `json:"subtotal"`
+	Discount        domain.Money    `json:"discount"`
+	Tax             domain.Money    `json:"tax"`
+	Total           domain.Money    `json:"total"`
+	ShippingAddress *Address        `json:"shipping_address,omitempty"`
+	BillingAddress  *Address        `json:"billing_address,omitempty"`
+	Actor           string          `json:"actor"`
+}
+
+// NewOrderCreatedEvent creates a new order created event
+func NewOrderCreatedEvent(order *Order, actor string) OrderCreatedEvent {
+	lines := make([]OrderLine, len(order.Lines))
+	copy(lines, order.Lines)
+	discounts := make([]OrderDiscount, len(order.Discounts))
+	copy(discounts, order.Discounts)
+
+	eventData := map[string]interface{}{
+		"order_id":         order.GetID(),
+		"order_number":     order.Number,
+		"customer_id":      order.CustomerID,
+		"status":           order.Status,
+		"currency":         order.Currency,
+		"lines":            lines,
+		"discounts":        discounts,
+		"subtotal":         order.Subtotal,
+		"discount":         order.Discount,
+		"tax":              order.Tax,
+		"total":            order.Total,
+		"actor":            actor,
+		"shipping_address": order.ShippingAddress,
+		"billing_address":  order.BillingAddress,
+	}
+
+	return OrderCreatedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			order.GetID(),
+			"order",
+			OrderCreatedEventType,
+			eventData,
+		),
+		OrderID:         order.GetID(),
+		OrderNumber:     order.Number,
+		CustomerID:      order.CustomerID,
+		Status:          string(order.Status),
+		Currency:        order.Currency,
+		Lines:           lines,
+		Discounts:       discounts,
+		Subtotal:        order.Subtotal,
+		Discount:        order.Discount,
+		Tax:             order.Tax,
+		Total:           order.Total,
+		ShippingAddress: order.ShippingAddress,
+		BillingAddress:  order.BillingAddress,
+		Actor:           actor,
+	}
+}
+
+// GetOrderID returns the ID of the created order
+func (e OrderCreatedEvent) GetOrderID() string {
+	return e.OrderID
+}
+
+// GetCustomerID returns the ID of the ordering customer
+func (e OrderCreatedEvent) GetCustomerID() string {
+	return e.CustomerID
+}
+
+// GetTotal returns the amount due for the order, tax included
+func (e OrderCreatedEvent) GetTotal() domain.Money {
+	return e.Total
+}
+
+// GetItems returns the requested products, one entry per order line
+func (e OrderCreatedEvent) GetItems() []publicapi.OrderItem {
+	items := make([]publicapi.OrderItem, len(e.Lines))
+	for i, line := range e.Lines {
+		items[i] = publicapi.OrderItem{ProductID: line.ProductID, Quantity: line.Quantity}
+	}
+	return items
+}
+
+// OrderLineAddedEvent represents the event when a line item is added to an order
+type OrderLineAddedEvent struct {
+	domain.BaseDomainEvent
+	OrderID       string       `json:"order_id"`
+	Line          OrderLine    `json:"line"`
+	OrderSubtotal domain.Money `json:"order_subtotal"`
+	OrderDiscount domain.Money `json:"order_discount"`
+	OrderTax      domain.Money `json:"order_tax"`
+	OrderTotal    domain.Money `json:"order_total"`
+}
+
+// NewOrderLineAddedEvent creates a new order line added event
+func NewOrderLineAddedEvent(order *Order, line OrderLine) OrderLineAddedEvent {
+	eventData := map[string]interface{}{
+		"order_id":       order.GetID(),
+		"line_id":        line.ID,
+		"product_id":     line.ProductID,
+		"quantity":       line.Quantity,
+		"unit_price":     line.UnitPrice,
+		"line_total":     line.LineTotal,
+		"order_subtotal": order.Subtotal,
+		"order_discount": order.Discount,
+		"order_tax":      order.Tax,
+		"order_total":    order.Total,
+	}
+
+	return OrderLineAddedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			order.GetID(),
+			"order",
+			OrderLineAddedEventType,
+			eventData,
+		),
+		OrderID:       order.GetID(),
+		Line:          line,
+		OrderSubtotal: order.Subtotal,
+		OrderDiscount: order.Discount,
+		OrderTax:      order.Tax,
+		OrderTotal:    order.Total,
+	}
+}
+
+// GetOrderID returns the ID of the order the line was added to
+func (e OrderLineAddedEvent) GetOrderID() string {
+	return e.OrderID
+}
+
+// GetLineID returns the ID of the added line
+func (e OrderLineAddedEvent) GetLineID() string {
+	return e.Line.ID
+}
+
+// GetItem returns the product quantity requested by the line
+func (e OrderLineAddedEvent) GetItem() publicapi.OrderItem {
+	return publicapi.OrderItem{ProductID: e.Line.ProductID, Quantity: e.Line.Quantity}
+}
+
+// GetTotal returns the order total with the line, tax included
+func (e OrderLineAddedEvent) GetTotal() domain.Money {
+	return e.OrderTotal
+}
+
+// OrderConfirmedEvent represents the event when an order is confirmed
+type OrderConfirmedEvent struct {
+	domain.BaseDomainEvent
+	OrderID        string `json:"order_id"`
+	PreviousStatus string `json:"previous_status"`
+	Actor          string `json:"actor"`
+}
+
+// NewOrderConfirmedEvent creates a new order confirmed event
+func NewOrderConfirmedEvent(order *Order, previousStatus OrderStatus, actor string) OrderConfirmedEvent {
+	eventData := map[string]interface{}{
+		"order_id":        order.GetID(),
+		"status":          order.Status,
+		"previous_status": previousStatus,
+		"actor":           actor,
+	}
+
+	return OrderConfirmedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			order.GetID(),
+			"order",
+			OrderConfirmedEventType,
+			eventData,
+		),
+		OrderID:        order.GetID(),
+		PreviousStatus: string(previousStatus),
+		Actor:          actor,
+	}
+}
+
+// OrderCancelledEvent represents the event when an order is cancelled
+type OrderCancelledEvent struct {
+	domain.BaseDomainEvent
+	OrderID        string `json:"order_id"`
+	PreviousStatus string `json:"previous_status"`
+	ReasonCode     string `json:"reason_code"`
+	Reason         string `json:"reason,omitempty"`
+	Actor          string `json:"actor"`
+}
+
+// NewOrderCancelledEvent creates a new order cancelled event
+func NewOrderCancelledEvent(order *Order, previousStatus OrderStatus, reasonCode, reason, actor string) OrderCancelledEvent {
+	eventData := map[string]interface{}{
+		"order_id":        order.GetID(),
+		"previous_status": previousStatus,
+		"reason_code":     reasonCode,
+		"reason":          reason,
+		"actor":           actor,
+	}
+
+	return OrderCancelledEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			order.GetID(),
+			"order",
+			OrderCancelledEventType,
+			eventData,
+		),
+		OrderID:        order.GetID(),
+		PreviousStatus: string(previousStatus),
+		ReasonCode:     reasonCode,
+		Reason:         reason,
+		Actor:          actor,
+	}
+}
+
+// GetOrderID returns the ID of the cancelled order
+func (e OrderCancelledEvent) GetOrderID() string {
+	return e.OrderID
+}
+
+// GetPreviousStatus returns the status the order had before it was cancelled
+func (e OrderCancelledEvent) GetPreviousStatus() string {
+	return e.PreviousStatus
+}
+
+// GetReasonCode returns the cancellation reason code
+func (e OrderCancelledEvent) GetReasonCode() string {
+	return e.ReasonCode
+}
+
+// OrderShipmentCreatedEvent represents the event when order items are handed over to a carrier
+type OrderShipmentCreatedEvent struct {
+	domain.BaseDomainEvent
+	OrderID        string         `json:"order_id"`
+	ShipmentID     string         `json:"shipment_id"`
+	Carrier        string         `json:"carrier"`
+	TrackingNumber string         `json:"tracking_number"`
+	Lines          []ShipmentLine `json:"lines"`
+	ShippedAt      time.Time      `json:"shipped_at"`
+	Actor          string         `json:"actor"`
+}
+
+// NewOrderShipmentCreatedEvent creates a new order shipment created event
+func NewOrderShipmentCreatedEvent(order *Order, shipment Shipment, actor string) OrderShipmentCreatedEvent {
+	eventData := map[string]interface{}{
+		"order_id":        order.GetID(),
+		"shipment_id":     shipment.ID,
+		"carrier":         shipment.Carrier,
+		"tracking_number": shipment.TrackingNumber,
+		"lines":           shipment.Lines,
+		"shipped_at":      shipment.ShippedAt,
+		"actor":           actor,
+	}
+
+	return OrderShipmentCreatedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			order.GetID(),
+			"order",
+			OrderShipmentCreatedEventType,
+			eventData,
+		),
+		OrderID:        order.GetID(),
+		ShipmentID:     shipment.ID,
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		Lines:          shipment.Lines,
+		ShippedAt:      shipment.ShippedAt,
+		Actor:          actor,
+	}
+}
+
+// OrderShipmentUpdatedEvent represents the event when a shipment status update is recorded
+type OrderShipmentUpdatedEvent struct {
+	domain.BaseDomainEvent
+	OrderID        string         `json:"order_id"`
+	ShipmentID     string         `json:"shipment_id"`
+	Status         ShipmentStatus `json:"status"`
+	PreviousStatus ShipmentStatus `json:"previous_status"`
+	Update         TrackingEvent  `json:"update"`
+	Actor          string         `json:"actor"`
+}
+
+// NewOrderShipmentUpdatedEvent creates a new order shipment updated event
+func NewOrderShipmentUpdatedEvent(order *Order, shipment Shipment, previousStatus ShipmentStatus, update TrackingEvent, actor string) OrderShipmentUpdatedEvent {
+	eventData := map[string]interface{}{
+		"order_id":        order.GetID(),
+		"shipment_id":     shipment.ID,
+		"status":          shipment.Status,
+		"previous_status": previousStatus,
+		"update":          update,
+		"actor":           actor,
+	}
+
+	return OrderShipmentUpdatedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			order.GetID(),
+			"order",
+			OrderShipmentUpdatedEventType,
+			eventData,
+		),
+		OrderID:        order.GetID(),
+		ShipmentID:     shipment.ID,
+		Status:         shipment.Status,
+		PreviousStatus: previousStatus,
+		Update:         update,
+		Actor:          actor,
+	}
+}
+
+// OrderShippedEvent represents the event when every line of an order has been shipped
+type OrderShippedEvent struct {
+	domain.BaseDomainEvent
+	OrderID        string `json:"order_id"`
+	PreviousStatus string `json:"previous_status"`
+	Actor          string `json:"actor"`
+}
+
+// NewOrderShippedEvent creates a new order shipped event
+func NewOrderShippedEvent(order *Order, previousStatus OrderStatus, actor string) OrderShippedEvent {
+	eventData := map[string]interface{}{
+		"order_id":        order.GetID(),
+		"status":          order.Status,
+		"previous_status": previousStatus,
+		"actor":           actor,
+	}
+
+	return OrderShippedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			order.GetID(),
+			"order",
+			OrderShippedEventType,
+			eventData,
+		),
+		OrderID:        order.GetID(),
+		PreviousStatus: string(previousStatus),
+		Actor:          actor,
+	}
+}
+
+// OrderDeliveredEvent represents the event when every shipment of an order has been delivered
+type OrderDeliveredEvent struct {
+	domain.BaseDomainEvent
+	OrderID        string `json:"order_id"`
+	PreviousStatus string `json:"previous_status"`
+	Actor          string `json:"actor"`
+}
+
+// NewOrderDeliveredEvent creates a new order delivered event
+func NewOrderDeliveredEvent(order *Order, previousStatus OrderStatus, actor string) OrderDeliveredEvent {
+	eventData := map[string]interface{}{
+		"order_id":        order.GetID(),
+		"status":          order.Status,
+		"previous_status": previousStatus,
+		"actor":           actor,
+	}
+
+	return OrderDeliveredEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			order.GetID(),
+			"order",
+			OrderDeliveredEventType,
+			eventData,
+		),
+		OrderID:        order.GetID(),
+		PreviousStatus: string(previousStatus),
+		Actor:          actor,
+	}
+}
+
+// ReturnRequestedEvent represents the event when a customer asks to return order items
+type ReturnRequestedEvent struct {
+	domain.BaseDomainEvent
+	ReturnID     string       `json:"return_id"`
+	OrderID      string       `json:"order_id"`
+	Lines        []ReturnLine `json:"lines"`
+	RefundAmount domain.Money `json:"refund_amount"`
+	Reason       string       `json:"reason,omitempty"`
+	Actor        string       `json:"actor"`
+}
+
+// NewReturnRequestedEvent creates a new return requested event
+func NewReturnRequestedEvent(ret *Return, actor string) ReturnRequestedEvent {
+	lines := make([]ReturnLine, len(ret.Lines))
+	copy(lines, ret.Lines)
+
+	eventData := map[string]interface{}{
+		"return_id":     ret.GetID(),
+		"order_id":      ret.OrderID,
+		"lines":         lines,
+		"refund_amount": ret.RefundAmount,
+		"reason":        ret.Reason,
+		"actor":         actor,
+	}
+
+	return ReturnRequestedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			ret.GetID(),
+			"order_return",
+			ReturnRequestedEventType,
+			eventData,
+		),
+		ReturnID:     ret.GetID(),
+		OrderID:      ret.OrderID,
+		Lines:        lines,
+		RefundAmount: ret.RefundAmount,
+		Reason:       ret.Reason,
+		Actor:        actor,
+	}
+}
+
+// ReturnApprovedEvent represents the event when a return is accepted and its refund is due
+type ReturnApprovedEvent struct {
+	domain.BaseDomainEvent
+	ReturnID     string       `json:"return_id"`
+	OrderID      string       `json:"order_id"`
+	RefundAmount domain.Money `json:"refund_amount"`
+	Reason       string       `json:"reason,omitempty"`
+	Actor        string       `json:"actor"`
+}
+
+// NewReturnApprovedEvent creates a new return approved event
+func NewReturnApprovedEvent(ret *Return, actor string) ReturnApprovedEvent {
+	eventData := map[string]interface{}{
+		"return_id":     ret.GetID(),
+		"order_id":      ret.OrderID,
+		"refund_amount": ret.RefundAmount,
+		"reason":        ret.Reason,
+		"actor":         actor,
+	}
+
+	return ReturnApprovedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			ret.GetID(),
+			"order_return",
+			ReturnApprovedEventType,
+			eventData,
+		),
+		ReturnID:     ret.GetID(),
+		OrderID:      ret.OrderID,
+		RefundAmount: ret.RefundAmount,
+		Reason:       ret.Reason,
+		Actor:        actor,
+	}
+}
+
+// GetReturnID returns the ID of the approved return
+func (e ReturnApprovedEvent) GetReturnID() string {
+	return e.ReturnID
+}
+
+// GetOrderID returns the ID of the order the goods are returned from
+func (e ReturnApprovedEvent) GetOrderID() string {
+	return e.OrderID
+}
+
+// GetRefundAmount returns the amount to refund, tax included and discounts deducted
+func (e ReturnApprovedEvent) GetRefundAmount() domain.Money {
+	return e.RefundAmount
+}
+
+// GetReason returns the customer's reason for the return
+func (e ReturnApprovedEvent) GetReason() string {
+	return e.Reason
+}
+
+// ReturnRejectedEvent represents the event when a return is declined
+type ReturnRejectedEvent struct {
+	domain.BaseDomainEvent
+	ReturnID string `json:"return_id"`
+	OrderID  string `json:"order_id"`
+	Reason   string `json:"reason,omitempty"`
+	Actor    string `json:"actor"`
+}
+
+// NewReturnRejectedEvent creates a new return rejected event
+func NewReturnRejectedEvent(ret *Return, actor string) ReturnRejectedEvent {
+	eventData := map[string]interface{}{
+		"return_id": ret.GetID(),
+		"order_id":  ret.OrderID,
+		"reason":    ret.RejectionReason,
+		"actor":     actor,
+	}
+
+	return ReturnRejectedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			ret.GetID(),
+			"order_return",
+			ReturnRejectedEventType,
+			eventData,
+		),
+		ReturnID: ret.GetID(),
+		OrderID:  ret.OrderID,
+		Reason:   ret.RejectionReason,
+		Actor:    actor,
+	}
+}
+
+// ReturnRefundedEvent represents the event when the refund of a return has been issued
+type ReturnRefundedEvent struct {
+	domain.BaseDomainEvent
+	ReturnID        string       `json:"return_id"`
+	OrderID         string       `json:"order_id"`
+	RefundAmount    domain.Money `json:"refund_amount"`
+	RefundReference string       `json:"refund_reference,omitempty"`
+	Actor           string       `json:"actor"`
+}
+
+// NewReturnRefundedEvent creates a new return refunded event
+func NewReturnRefundedEvent(ret *Return, actor string) ReturnRefundedEvent {
+	eventData := map[string]interface{}{
+		"return_id":        ret.GetID(),
+		"order_id":         ret.OrderID,
+		"refund_amount":    ret.RefundAmount,
+		"refund_reference": ret.RefundReference,
+		"actor":            actor,
+	}
+
+	return ReturnRefundedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			ret.GetID(),
+			"order_return",
+			ReturnRefundedEventType,
+			eventData,
+		),
+		ReturnID:        ret.GetID(),
+		OrderID:         ret.OrderID,
+		RefundAmount:    ret.RefundAmount,
+		RefundReference: ret.RefundReference,
+		Actor:           actor,
+	}
+}
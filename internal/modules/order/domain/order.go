@@ -0,0 +1,464 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"golang_modular_monolith/internal/modules/order/publicapi"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// OrderStatus represents the status of an order
+type OrderStatus string
+
+const (
+	OrderStatusPending   OrderStatus = "pending"
+	OrderStatusConfirmed OrderStatus = "confirmed"
+	OrderStatusShipped   OrderStatus = "shipped"   // every line has been handed over to a carrier
+	OrderStatusDelivered OrderStatus = "delivered" // every shipment has been delivered
+	OrderStatusCompleted OrderStatus = "completed"
+	OrderStatusCancelled OrderStatus = "cancelled"
+)
+
+// IsValid checks if the order status is known
+func (s OrderStatus) IsValid() bool {
+	switch s {
+	case OrderStatusPending, OrderStatusConfirmed, OrderStatusShipped, OrderStatusDelivered,
+		OrderStatusCompleted, OrderStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// Actors recorded in the order history when no authenticated caller is known
+// Authenticated callers are recorded by their actor (shared domain.Actor), e.g. "user:<id>"
+const (
+	ActorSystem = "system" // changes made by event handlers, e.g. after an inventory reservation
+	ActorAPI    = "api"    // changes requested through the HTTP API by unauthenticated callers
+)
+
+// PermissionImportOrders is the permission required to import orders
+const PermissionImportOrders = "orders:import"
+
+// Permissions required to request returns, and to approve or reject them, which refunds the order
+const (
+	PermissionCreateReturns  = "returns:create"
+	PermissionApproveReturns = "returns:approve"
+)
+
+// PermissionWriteShipments is the permission required to ship orders and update their shipments
+const PermissionWriteShipments = "shipments:write"
+
+// PermissionWriteCoupons is the permission required to manage coupons; listing them reveals their codes,
+// so reads require it too
+const PermissionWriteCoupons = "coupons:write"
+
+// normalizeActor falls back to the system actor for blank values
+func normalizeActor(actor string) string {
+	actor = strings.TrimSpace(actor)
+	if actor == "" {
+		return ActorSystem
+	}
+	return actor
+}
+
+// MaxOrderLines is the maximum number of lines per order
+const MaxOrderLines = 100
+
+// MaxTaxRateBasisPoints is the highest accepted tax rate (100%)
+const MaxTaxRateBasisPoints = 10000
+
+// TaxPolicy describes how tax is charged on an order
+// Tax is calculated once on the order subtotal and rounded to the currency's minor unit
+type TaxPolicy struct {
+	RateBasisPoints int64               `json:"rate_bps"` // 1 bp = 0.01%, e.g. 1000 = 10%
+	Rounding        domain.RoundingMode `json:"rounding"`
+}
+
+// DefaultTaxPolicy returns a tax-free policy with commercial rounding
+func DefaultTaxPolicy() TaxPolicy {
+	return TaxPolicy{
+		RateBasisPoints: 0,
+		Rounding:        domain.RoundHalfUp,
+	}
+}
+
+// Validate validates the tax policy
+func (p TaxPolicy) Validate() error {
+	if p.RateBasisPoints < 0 || p.RateBasisPoints > MaxTaxRateBasisPoints {
+		return domain.NewValidationErrorWithValue("rate_bps", fmt.Sprintf("tax rate must be between 0 and %d basis points", MaxTaxRateBasisPoints), p.RateBasisPoints)
+	}
+	if !p.Rounding.IsValid() {
+		return domain.NewValidationErrorWithValue("rounding", "rounding must be half_up, half_even or down", string(p.Rounding))
+	}
+	return nil
+}
+
+// Order represents the order aggregate root
+type Order struct {
+	domain.BaseAggregateRoot
+	Number     string          `json:"number"` // human-readable order number, e.g. ORD-2025-000123
+	CustomerID string          `json:"customer_id"`
+	Status     OrderStatus     `json:"status"`
+	Currency   string          `json:"currency"`
+	Lines      []OrderLine     `json:"lines"`
+	Discounts  []OrderDiscount `json:"discounts"`
+	TaxPolicy  TaxPolicy       `json:"tax_policy"`
+	Subtotal   domain.Money    `json:"subtotal"`
+	Discount   domain.Money    `json:"discount"` // sum of the discount lines
+	Tax        domain.Money    `json:"tax"`
+	Total      domain.Money    `json:"total"`
+
+	// Address snapshots taken when the order was placed; nil when none was given
+	ShippingAddress *Address `json:"shipping_address,omitempty"`
+	BillingAddress  *Address `json:"billing_address,omitempty"`
+
+	// Shipments of the order's items, in the order they were handed over to carriers
+	Shipments []Shipment `json:"shipments"`
+}
+
+// OrderLine represents a line item entity within an order
+type OrderLine struct {
+	ID          string       `json:"id"`
+	ProductID   string       `json:"product_id"`
+	ProductName string       `json:"product_name"`
+	Quantity    int          `json:"quantity"`
+	UnitPrice   domain.Money `json:"unit_price"`
+	LineTotal   domain.Money `json:"line_total"`
+}
+
+// LineItem describes a line to add to an order
+type LineItem struct {
+	ProductID   string
+	ProductName string
+	Quantity    int
+	UnitPrice   domain.Money
+}
+
+// OrderDraft describes an order to be placed
+type OrderDraft struct {
+	Number     string // allocated by an OrderNumberGenerator
+	CustomerID string
+	Currency   string
+	TaxPolicy  TaxPolicy
+	Items      []LineItem
+	Addresses  OrderAddresses
+	Coupon     *Coupon // optional; its usage limits are enforced by the caller when redeeming it
+	Actor      string  // who placed the order, recorded in the order history
+}
+
+// NewOrder creates a new pending order for a customer with its initial lines
+// The order.created event carries the lines so downstream modules see the whole order at once
+func NewOrder(draft OrderDraft) (*Order, error) {
+	// Validate input
+	var validationErrors domain.ValidationErrors
+
+	number := strings.TrimSpace(draft.Number)
+	if number == "" {
+		validationErrors.Add("number", "order number is required")
+	} else if len(number) > MaxOrderNumberLength {
+		validationErrors.AddWithValue("number", fmt.Sprintf("order number must not exceed %d characters", MaxOrderNumberLength), number)
+	}
+
+	customerID := strings.TrimSpace(draft.CustomerID)
+	if customerID == "" {
+		validationErrors.Add("customer_id", "customer_id is required")
+	}
+
+	currency, err := domain.NormalizeCurrency(draft.Currency)
+	if err != nil {
+		if validationErr, ok := err.(domain.ValidationError); ok {
+			validationErrors = append(validationErrors, validationErr)
+		} else {
+			return nil, err
+		}
+	}
+
+	if len(draft.Items) == 0 {
+		validationErrors.Add("lines", "at least one order line is required")
+	}
+
+	addresses := draft.Addresses.normalize(&validationErrors)
+
+	if err := draft.TaxPolicy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	discounts := make([]OrderDiscount, 0)
+	if draft.Coupon != nil {
+		if err := draft.Coupon.CheckApplicable(currency, time.Now()); err != nil {
+			return nil, err
+		}
+		discounts = append(discounts, draft.Coupon.ToDiscount())
+	}
+
+	// Create order
+	order := &Order{
+		BaseAggregateRoot: domain.NewBaseAggregateRoot(),
+		Number:            number,
+		CustomerID:        customerID,
+		Status:            OrderStatusPending,
+		Currency:          currency,
+		Lines:             make([]OrderLine, 0),
+		Discounts:         discounts,
+		Shipments:         make([]Shipment, 0),
+		TaxPolicy:         draft.TaxPolicy,
+		Subtotal:          domain.ZeroMoney(currency),
+		Discount:          domain.ZeroMoney(currency),
+		Tax:               domain.ZeroMoney(currency),
+		Total:             domain.ZeroMoney(currency),
+		ShippingAddress:   addresses.Shipping,
+		BillingAddress:    addresses.Billing,
+	}
+
+	for _, item := range draft.Items {
+		if _, err := order.appendLine(item); err != nil {
+			return nil, err
+		}
+	}
+
+	// Add domain event
+	order.AddEvent(NewOrderCreatedEvent(order, normalizeActor(draft.Actor)))
+
+	return order, nil
+}
+
+// AddLine adds a line item to a pending order
+func (o *Order) AddLine(item LineItem) (*OrderLine, error) {
+	if o.Status != OrderStatusPending {
+		return nil, domain.NewBusinessRuleError("order_not_pending", fmt.Sprintf("cannot add lines to a %s order", o.Status))
+	}
+
+	line, err := o.appendLine(item)
+	if err != nil {
+		return nil, err
+	}
+	o.IncrementVersion()
+
+	// Add domain event
+	o.AddEvent(NewOrderLineAddedEvent(o, *line))
+
+	return line, nil
+}
+
+// appendLine validates a line item and appends it, keeping the totals in sync
+func (o *Order) appendLine(item LineItem) (*OrderLine, error) {
+	// Validate input
+	var validationErrors domain.ValidationErrors
+
+	productID := strings.TrimSpace(item.ProductID)
+	if productID == "" {
+		validationErrors.Add("product_id", "product_id is required")
+	}
+	if item.Quantity <= 0 {
+		validationErrors.AddWithValue("quantity", "quantity must be positive", item.Quantity)
+	}
+	if item.UnitPrice.IsNegative() {
+		validationErrors.AddWithValue("unit_price", "unit_price must not be negative", item.UnitPrice.Amount)
+	}
+	if item.UnitPrice.Currency != o.Currency {
+		validationErrors.AddWithValue("unit_price", "unit_price must be in the order currency "+o.Currency, item.UnitPrice.Currency)
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	if len(o.Lines) >= MaxOrderLines {
+		return nil, domain.NewBusinessRuleError("order_line_limit", fmt.Sprintf("an order can have at most %d lines", MaxOrderLines))
+	}
+
+	lineTotal, err := item.UnitPrice.Multiply(int64(item.Quantity))
+	if err != nil {
+		return nil, err
+	}
+
+	line := OrderLine{
+		ID:          uuid.New().String(),
+		ProductID:   productID,
+		ProductName: strings.TrimSpace(item.ProductName),
+		Quantity:    item.Quantity,
+		UnitPrice:   item.UnitPrice,
+		LineTotal:   lineTotal,
+	}
+
+	lines := append(o.Lines, line)
+	if err := o.recalculateTotals(lines); err != nil {
+		return nil, err
+	}
+	o.Lines = lines
+
+	return &o.Lines[len(o.Lines)-1], nil
+}
+
+// recalculateTotals recomputes subtotal, discounts, tax and total from the given lines
+// Discounts apply in order to what is left of the subtotal, and tax is charged on the discounted amount
+// The order is left unchanged if any amount overflows
+func (o *Order) recalculateTotals(lines []OrderLine) error {
+	subtotal := domain.ZeroMoney(o.Currency)
+	for _, line := range lines {
+		var err error
+		if subtotal, err = subtotal.Add(line.LineTotal); err != nil {
+			return err
+		}
+	}
+
+	discounts := make([]OrderDiscount, len(o.Discounts))
+	discountTotal := domain.ZeroMoney(o.Currency)
+	taxable := subtotal
+	for i, discount := range o.Discounts {
+		amount, err := discount.amountFor(taxable)
+		if err != nil {
+			return err
+		}
+		if taxable, err = taxable.Subtract(amount); err != nil {
+			return err
+		}
+		if discountTotal, err = discountTotal.Add(amount); err != nil {
+			return err
+		}
+		discount.Amount = amount
+		discounts[i] = discount
+	}
+
+	tax, err := taxable.MultiplyBasisPoints(o.TaxPolicy.RateBasisPoints, o.TaxPolicy.Rounding)
+	if err != nil {
+		return err
+	}
+
+	total, err := taxable.Add(tax)
+	if err != nil {
+		return err
+	}
+
+	o.Subtotal = subtotal
+	o.Discounts = discounts
+	o.Discount = discountTotal
+	o.Tax = tax
+	o.Total = total
+	return nil
+}
+
+// CouponCodes returns the codes of the coupons applied to the order
+func (o *Order) CouponCodes() []string {
+	codes := make([]string, len(o.Discounts))
+	for i, discount := range o.Discounts {
+		codes[i] = discount.CouponCode
+	}
+	return codes
+}
+
+// orderTransitions lists the statuses each order status may move to
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPending:   {OrderStatusConfirmed, OrderStatusCancelled},
+	OrderStatusConfirmed: {OrderStatusShipped, OrderStatusCompleted, OrderStatusCancelled},
+	OrderStatusShipped:   {OrderStatusDelivered},
+	OrderStatusDelivered: {OrderStatusCompleted},
+}
+
+// CanTransitionTo checks if the order may move to the given status
+func (o *Order) CanTransitionTo(status OrderStatus) bool {
+	for _, allowed := range orderTransitions[o.Status] {
+		if allowed == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Confirm confirms a pending order once its stock has been reserved
+func (o *Order) Confirm(actor string) error {
+	if o.Status == OrderStatusConfirmed {
+		return nil
+	}
+
+	previousStatus := o.Status
+	if err := o.transitionTo(OrderStatusConfirmed); err != nil {
+		return err
+	}
+
+	// Add domain event
+	o.AddEvent(NewOrderConfirmedEvent(o, previousStatus, normalizeActor(actor)))
+
+	return nil
+}
+
+// cancelReasonCodes lists the accepted cancellation reason codes
+var cancelReasonCodes = map[string]bool{
+	publicapi.CancelReasonCustomerRequest:  true,
+	publicapi.CancelReasonOutOfStock:       true,
+	publicapi.CancelReasonPaymentFailed:    true,
+	publicapi.CancelReasonFraudSuspected:   true,
+	publicapi.CancelReasonMerchantDecision: true,
+	publicapi.CancelReasonOther:            true,
+}
+
+// IsValidCancelReasonCode checks if the cancellation reason code is known
+func IsValidCancelReasonCode(code string) bool {
+	return cancelReasonCodes[code]
+}
+
+// Cancel cancels the order, recording a reason code and an optional free-text note
+// Cancelling an already cancelled order is a no-op
+func (o *Order) Cancel(reasonCode, note, actor string) error {
+	if o.Status == OrderStatusCancelled {
+		return nil
+	}
+
+	if !IsValidCancelReasonCode(reasonCode) {
+		return domain.NewValidationErrorWithValue("reason_code", "unknown cancellation reason code", reasonCode)
+	}
+
+	// Items already handed over to a carrier have to come back through a return
+	if len(o.Shipments) > 0 {
+		return domain.NewBusinessRuleError(
+			"order_has_shipments",
+			"cannot cancel an order with shipped items",
+		)
+	}
+
+	previousStatus := o.Status
+	if err := o.transitionTo(OrderStatusCancelled); err != nil {
+		return err
+	}
+
+	// Add domain event
+	o.AddEvent(NewOrderCancelledEvent(o, previousStatus, reasonCode, strings.TrimSpace(note), normalizeActor(actor)))
+
+	return nil
+}
+
+// transitionTo moves the order to a new status if the state machine allows it
+func (o *Order) transitionTo(status OrderStatus) error {
+	if !o.CanTransitionTo(status) {
+		return domain.NewBusinessRuleError(
+			"invalid_status_transition",
+			fmt.Sprintf("cannot change order status from %s to %s", o.Status, status),
+		)
+	}
+
+	o.Status = status
+	o.IncrementVersion()
+	return nil
+}
+
+// IsPending checks if order is pending
+func (o *Order) IsPending() bool {
+	return o.Status == OrderStatusPending
+}
+
+// ItemCount returns the total quantity across all lines
+func (o *Order) ItemCount() int {
+	count := 0
+	for _, line := range o.Lines {
+		count += line.Quantity
+	}
+	return count
+}
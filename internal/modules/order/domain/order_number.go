@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Order number defaults
+const (
+	DefaultOrderNumberPrefix = "ORD"
+	OrderNumberDigits        = 6 // the sequence is zero-padded to at least this many digits
+	MaxOrderNumberLength     = 32
+)
+
+// orderNumberPrefixPattern restricts prefixes to short upper-case identifiers, e.g. "ORD" or "ACME"
+var orderNumberPrefixPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{0,15}$`)
+
+// OrderNumberGenerator allocates human-readable order numbers such as ORD-2025-000123
+// Numbers are sequential per prefix and year; a number allocated for an order that
+// is never saved is not reused, so the sequence can have occasional gaps
+type OrderNumberGenerator interface {
+	Next(ctx context.Context, at time.Time) (string, error)
+}
+
+// ValidateOrderNumberPrefix checks that a configured order number prefix is usable
+func ValidateOrderNumberPrefix(prefix string) error {
+	if !orderNumberPrefixPattern.MatchString(prefix) {
+		return domain.NewValidationErrorWithValue("prefix", "order number prefix must be 1-16 upper-case letters or digits, starting with a letter", prefix)
+	}
+	return nil
+}
+
+// FormatOrderNumber builds the order number for a prefix, year and sequence value
+func FormatOrderNumber(prefix string, year int, sequence int64) string {
+	return fmt.Sprintf("%s-%d-%0*d", prefix, year, OrderNumberDigits, sequence)
+}
@@ -0,0 +1,350 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// OrderRepository defines the interface for order persistence
+type OrderRepository interface {
+	// Save saves an order and its lines (create or update)
+	Save(ctx context.Context, order *Order) error
+
+	// GetByID retrieves an order with its lines by ID
+	GetByID(ctx context.Context, id string) (*Order, error)
+
+	// GetByShipmentTracking retrieves the order owning the shipment with the given carrier and tracking number
+	// It returns ErrNotFound when no shipment matches
+	GetByShipmentTracking(ctx context.Context, carrier, trackingNumber string) (*Order, error)
+
+	// Exists checks if an order exists by ID
+	Exists(ctx context.Context, id string) (bool, error)
+}
+
+// CouponRepository defines the interface for coupon persistence
+type CouponRepository interface {
+	// Save creates a coupon or updates its settings; the redemption count is never overwritten
+	Save(ctx context.Context, coupon *Coupon) error
+
+	// GetByCode retrieves a coupon by its normalized code
+	GetByCode(ctx context.Context, code string) (*Coupon, error)
+
+	// ExistsByCode checks if a coupon exists with the given normalized code
+	ExistsByCode(ctx context.Context, code string) (bool, error)
+
+	// List retrieves coupons with pagination, newest first
+	List(ctx context.Context, params ListCouponsParams) (*CouponListResult, error)
+
+	// Redeem atomically records the use of a coupon by an order, enforcing the usage limits
+	// Redeeming the same coupon for the same order again is a no-op
+	Redeem(ctx context.Context, code, customerID, orderID string) error
+
+	// ReleaseRedemptions gives back the coupon uses of an order that did not go through
+	ReleaseRedemptions(ctx context.Context, orderID string) error
+}
+
+// ListCouponsParams represents parameters for listing coupons
+type ListCouponsParams struct {
+	Page       int  `json:"page"`
+	Limit      int  `json:"limit"`
+	ActiveOnly bool `json:"active_only"`
+}
+
+// Validate applies pagination defaults and limits
+func (p *ListCouponsParams) Validate() {
+	if p.Page <= 0 {
+		p.Page = 1
+	}
+	if p.Limit <= 0 {
+		p.Limit = 20
+	}
+	if p.Limit > 100 {
+		p.Limit = 100
+	}
+}
+
+// GetOffset calculates the offset for pagination
+func (p *ListCouponsParams) GetOffset() int {
+	return (p.Page - 1) * p.Limit
+}
+
+// CouponListResult represents the result of a coupon list query
+type CouponListResult struct {
+	Coupons    []Coupon         `json:"coupons"`
+	Pagination PaginationResult `json:"pagination"`
+}
+
+// ReturnRepository defines the interface for order return persistence
+type ReturnRepository interface {
+	// Save saves a return (create or update)
+	Save(ctx context.Context, ret *Return) error
+
+	// GetByID retrieves a return by ID
+	GetByID(ctx context.Context, id string) (*Return, error)
+
+	// ListByOrderID retrieves the returns of an order, oldest first
+	ListByOrderID(ctx context.Context, orderID string) ([]*Return, error)
+}
+
+// OrderQueryRepository defines the interface for order queries (read-side CQRS)
+type OrderQueryRepository interface {
+	// GetByID retrieves an order view by ID
+	GetByID(ctx context.Context, id string) (*OrderView, error)
+
+	// List retrieves orders with pagination and filtering
+	List(ctx context.Context, params ListOrdersParams) (*OrderListResult, error)
+
+	// Search searches orders by various criteria
+	Search(ctx context.Context, params SearchOrdersParams) (*OrderListResult, error)
+
+	// GetHistory retrieves the status changes of an order, oldest first
+	GetHistory(ctx context.Context, orderID string) ([]OrderHistoryEntry, error)
+
+	// Export streams every order matching the filters to fn in the requested sort order
+	// Pagination is ignored; an error returned by fn stops the export and is returned as is
+	Export(ctx context.Context, params ListOrdersParams, fn func(OrderView) error) error
+
+	// Summarize aggregates order counts and revenue per group and currency
+	Summarize(ctx context.Context, params OrderSummaryParams) ([]OrderSummaryGroup, error)
+}
+
+// OrderView represents a read-model for order queries
+type OrderView struct {
+	ID              string          `json:"id"`
+	Number          string          `json:"number"`
+	CustomerID      string          `json:"customer_id"`
+	Status          OrderStatus     `json:"status"`
+	Currency        string          `json:"currency"`
+	Lines           []OrderLineView `json:"lines"`
+	LineCount       int             `json:"line_count"`
+	ItemCount       int             `json:"item_count"`
+	Subtotal        domain.Money    `json:"subtotal"`
+	Discounts       []OrderDiscount `json:"discounts"`
+	Discount        domain.Money    `json:"discount"`
+	Tax             domain.Money    `json:"tax"`
+	Total           domain.Money    `json:"total"`
+	ShippingAddress *Address        `json:"shipping_address,omitempty"`
+	BillingAddress  *Address        `json:"billing_address,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// OrderHistoryEntry represents a single status change in an order's timeline
+// FromStatus is empty for the entry recording the order's creation
+type OrderHistoryEntry struct {
+	ID         int64       `json:"id"`
+	OrderID    string      `json:"order_id"`
+	EventType  string      `json:"event_type"`
+	FromStatus OrderStatus `json:"from_status,omitempty"`
+	ToStatus   OrderStatus `json:"to_status"`
+	ReasonCode string      `json:"reason_code,omitempty"`
+	Reason     string      `json:"reason,omitempty"`
+	Actor      string      `json:"actor"`
+	OccurredAt time.Time   `json:"occurred_at"`
+}
+
+// OrderLineView represents a line item in the order read-model
+type OrderLineView struct {
+	ID          string       `json:"id"`
+	ProductID   string       `json:"product_id"`
+	ProductName string       `json:"product_name"`
+	Quantity    int          `json:"quantity"`
+	UnitPrice   domain.Money `json:"unit_price"`
+	LineTotal   domain.Money `json:"line_total"`
+}
+
+// ListOrdersParams represents parameters for listing orders
+type ListOrdersParams struct {
+	// Pagination
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+
+	// Sorting
+	SortBy    string `json:"sort_by"`    // created_at, updated_at, total, status
+	SortOrder string `json:"sort_order"` // asc, desc
+
+	// Filtering
+	Statuses   []OrderStatus `json:"statuses,omitempty"` // any of, e.g. ?status=pending,confirmed
+	CustomerID string        `json:"customer_id,omitempty"`
+	Currency   string        `json:"currency,omitempty"`
+
+	// Total filtering in minor units (inclusive bounds)
+	MinTotal *int64 `json:"min_total,omitempty"`
+	MaxTotal *int64 `json:"max_total,omitempty"`
+
+	// Date filtering (inclusive bounds)
+	CreatedAfter  *time.Time `json:"created_after,omitempty"`
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+}
+
+// SearchOrdersParams represents parameters for searching orders
+type SearchOrdersParams struct {
+	ListOrdersParams
+
+	// Search criteria
+	Query     string `json:"query"`      // Order ID or order number prefix, or partial product name
+	ProductID string `json:"product_id"` // Orders containing the product
+}
+
+// OrderListResult represents the result of an order list query
+type OrderListResult struct {
+	Orders     []OrderView      `json:"orders"`
+	Pagination PaginationResult `json:"pagination"`
+}
+
+// PaginationResult represents pagination information
+type PaginationResult struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"total_pages"`
+	HasNext    bool  `json:"has_next"`
+	HasPrev    bool  `json:"has_prev"`
+}
+
+// NewPaginationResult creates a new pagination result
+func NewPaginationResult(page, limit int, total int64) PaginationResult {
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return PaginationResult{
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+}
+
+// Validate validates the list parameters
+func (p *ListOrdersParams) Validate() error {
+	if p.Page <= 0 {
+		p.Page = 1
+	}
+
+	if p.Limit <= 0 {
+		p.Limit = 20
+	}
+
+	// Maximum limit
+	if p.Limit > 100 {
+		p.Limit = 100
+	}
+
+	// Valid sort fields
+	validSortFields := map[string]bool{
+		"created_at": true,
+		"updated_at": true,
+		"total":      true,
+		"status":     true,
+	}
+
+	if !validSortFields[p.SortBy] {
+		p.SortBy = "created_at"
+	}
+
+	if p.SortOrder != "asc" && p.SortOrder != "desc" {
+		p.SortOrder = "desc"
+	}
+
+	var validationErrors domain.ValidationErrors
+
+	for _, status := range p.Statuses {
+		if !status.IsValid() {
+			validationErrors.AddWithValue("status", "unknown order status", string(status))
+		}
+	}
+
+	if p.MinTotal != nil && *p.MinTotal < 0 {
+		validationErrors.AddWithValue("min_total", "min_total must not be negative", *p.MinTotal)
+	}
+	if p.MinTotal != nil && p.MaxTotal != nil && *p.MinTotal > *p.MaxTotal {
+		validationErrors.Add("min_total", "min_total must not be greater than max_total")
+	}
+
+	if p.CreatedAfter != nil && p.CreatedBefore != nil && p.CreatedAfter.After(*p.CreatedBefore) {
+		validationErrors.Add("created_after", "created_after must not be later than created_before")
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+	return nil
+}
+
+// Validate validates the search parameters
+func (p *SearchOrdersParams) Validate() error {
+	return p.ListOrdersParams.Validate()
+}
+
+// OrderSummaryGroupBy identifies how the order summary report groups orders
+type OrderSummaryGroupBy string
+
+// Order summary groupings
+const (
+	OrderSummaryByDay    OrderSummaryGroupBy = "day"
+	OrderSummaryByStatus OrderSummaryGroupBy = "status"
+)
+
+// OrderSummaryParams represents parameters for the order summary report
+// Only the filters of ListOrdersParams apply; pagination and sorting are ignored
+type OrderSummaryParams struct {
+	ListOrdersParams
+
+	GroupBy  OrderSummaryGroupBy `json:"group_by"`
+	Timezone string              `json:"timezone"` // IANA time zone used to bucket days, UTC by default
+}
+
+// Validate validates the summary parameters
+func (p *OrderSummaryParams) Validate() error {
+	var validationErrors domain.ValidationErrors
+
+	if err := p.ListOrdersParams.Validate(); err != nil {
+		var listErrors domain.ValidationErrors
+		if !errors.As(err, &listErrors) {
+			return err
+		}
+		validationErrors = append(validationErrors, listErrors...)
+	}
+
+	if p.GroupBy == "" {
+		p.GroupBy = OrderSummaryByDay
+	}
+	if p.GroupBy != OrderSummaryByDay && p.GroupBy != OrderSummaryByStatus {
+		validationErrors.AddWithValue("group_by", "group_by must be one of: day, status", string(p.GroupBy))
+	}
+
+	if p.Timezone == "" {
+		p.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(p.Timezone); err != nil {
+		validationErrors.AddWithValue("tz", "tz must be a valid IANA time zone", p.Timezone)
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+	return nil
+}
+
+// OrderSummaryGroup represents one row of the order summary report
+// Amounts only cover orders that were not cancelled, so a cancelled group reports zero revenue
+type OrderSummaryGroup struct {
+	Key        string       `json:"key"` // YYYY-MM-DD when grouped by day, the status otherwise
+	Currency   string       `json:"currency"`
+	OrderCount int64        `json:"order_count"`
+	Revenue    domain.Money `json:"revenue"`
+	Discount   domain.Money `json:"discount"`
+	Tax        domain.Money `json:"tax"`
+}
+
+// GetOffset calculates the offset for pagination
+func (p *ListOrdersParams) GetOffset() int {
+	return (p.Page - 1) * p.Limit
+}
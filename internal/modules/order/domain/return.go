@@ -0,0 +1,277 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// ReturnStatus represents the status of an order return
+type ReturnStatus string
+
+const (
+	ReturnStatusRequested ReturnStatus = "requested"
+	ReturnStatusApproved  ReturnStatus = "approved" // refund requested from the payment module
+	ReturnStatusRejected  ReturnStatus = "rejected"
+	ReturnStatusRefunded  ReturnStatus = "refunded"
+)
+
+// IsValid checks if the return status is known
+func (s ReturnStatus) IsValid() bool {
+	switch s {
+	case ReturnStatusRequested, ReturnStatusApproved, ReturnStatusRejected, ReturnStatusRefunded:
+		return true
+	}
+	return false
+}
+
+// MaxReturnReasonLength is the maximum length of a return or rejection reason
+const MaxReturnReasonLength = 500
+
+// ReturnItem is a quantity of an order line the customer wants to return
+type ReturnItem struct {
+	OrderLineID string
+	Quantity    int
+}
+
+// ReturnLine represents an order line quantity included in a return
+// Amount is the merchandise value of the returned quantity, before discounts and tax
+type ReturnLine struct {
+	OrderLineID string       `json:"order_line_id"`
+	ProductID   string       `json:"product_id"`
+	ProductName string       `json:"product_name"`
+	Quantity    int          `json:"quantity"`
+	UnitPrice   domain.Money `json:"unit_price"`
+	Amount      domain.Money `json:"amount"`
+}
+
+// Return represents a return merchandise authorization (RMA) for part of an order
+type Return struct {
+	domain.BaseAggregateRoot
+	OrderID         string       `json:"order_id"`
+	CustomerID      string       `json:"customer_id"`
+	Status          ReturnStatus `json:"status"`
+	Reason          string       `json:"reason,omitempty"`
+	Lines           []ReturnLine `json:"lines"`
+	RefundAmount    domain.Money `json:"refund_amount"` // share of the order total, discounts deducted and tax included
+	RefundReference string       `json:"refund_reference,omitempty"`
+	RejectionReason string       `json:"rejection_reason,omitempty"`
+}
+
+// IsOpen checks if the return still counts against the order's returnable quantities
+func (r *Return) IsOpen() bool {
+	return r.Status != ReturnStatusRejected
+}
+
+// NewReturn creates a requested return for items of a confirmed, shipped, delivered or completed order
+// previous holds the order's earlier returns; quantities of returns that were not rejected are no longer returnable
+//
+// The refund is the order total prorated by merchandise value, so discounts and tax are
+// returned in the same proportion they were charged. Each return refunds the difference
+// between the cumulative shares after and before it, which makes the refunds of returns
+// covering the whole order add up to exactly the order total.
+func NewReturn(order *Order, previous []*Return, items []ReturnItem, reason, actor string) (*Return, error) {
+	switch order.Status {
+	case OrderStatusConfirmed, OrderStatusShipped, OrderStatusDelivered, OrderStatusCompleted:
+	default:
+		return nil, domain.NewBusinessRuleError(
+			"order_not_returnable",
+			fmt.Sprintf("cannot return items of a %s order", order.Status),
+		)
+	}
+
+	// Validate input
+	var validationErrors domain.ValidationErrors
+
+	reason = strings.TrimSpace(reason)
+	if len(reason) > MaxReturnReasonLength {
+		validationErrors.Add("reason", fmt.Sprintf("reason must not exceed %d characters", MaxReturnReasonLength))
+	}
+
+	if len(items) == 0 {
+		validationErrors.Add("lines", "at least one return line is required")
+	}
+
+	// Quantities already returned per order line, and their merchandise value
+	returned := make(map[string]int)
+	returnedValue := int64(0)
+	for _, previousReturn := range previous {
+		if !previousReturn.IsOpen() {
+			continue
+		}
+		for _, line := range previousReturn.Lines {
+			returned[line.OrderLineID] += line.Quantity
+			returnedValue += line.Amount.Amount
+		}
+	}
+
+	lines := make([]ReturnLine, 0, len(items))
+	seen := make(map[string]bool)
+	value := int64(0)
+	for i, item := range items {
+		field := fmt.Sprintf("lines[%d]", i)
+
+		orderLine := order.findLine(strings.TrimSpace(item.OrderLineID))
+		if orderLine == nil {
+			validationErrors.AddWithValue(field+".order_line_id", "order line not found", item.OrderLineID)
+			continue
+		}
+		if seen[orderLine.ID] {
+			validationErrors.AddWithValue(field+".order_line_id", "order line is listed more than once", item.OrderLineID)
+			continue
+		}
+		seen[orderLine.ID] = true
+
+		returnable := orderLine.Quantity - returned[orderLine.ID]
+		if item.Quantity <= 0 {
+			validationErrors.AddWithValue(field+".quantity", "quantity must be positive", item.Quantity)
+			continue
+		}
+		if item.Quantity > returnable {
+			validationErrors.AddWithValue(field+".quantity", fmt.Sprintf("only %d can be returned", returnable), item.Quantity)
+			continue
+		}
+
+		amount, err := orderLine.UnitPrice.Multiply(int64(item.Quantity))
+		if err != nil {
+			return nil, err
+		}
+		value += amount.Amount
+
+		lines = append(lines, ReturnLine{
+			OrderLineID: orderLine.ID,
+			ProductID:   orderLine.ProductID,
+			ProductName: orderLine.ProductName,
+			Quantity:    item.Quantity,
+			UnitPrice:   orderLine.UnitPrice,
+			Amount:      amount,
+		})
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	refund, err := order.refundShare(returnedValue, returnedValue+value)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &Return{
+		BaseAggregateRoot: domain.NewBaseAggregateRoot(),
+		OrderID:           order.GetID(),
+		CustomerID:        order.CustomerID,
+		Status:            ReturnStatusRequested,
+		Reason:            reason,
+		Lines:             lines,
+		RefundAmount:      refund,
+	}
+
+	// Add domain event
+	ret.AddEvent(NewReturnRequestedEvent(ret, normalizeActor(actor)))
+
+	return ret, nil
+}
+
+// Approve accepts the return; the payment module then refunds RefundAmount
+// A return with nothing to refund (e.g. from a free order) is refunded right away
+func (r *Return) Approve(actor string) error {
+	if err := r.transitionTo(ReturnStatusApproved); err != nil {
+		return err
+	}
+
+	actor = normalizeActor(actor)
+	r.AddEvent(NewReturnApprovedEvent(r, actor))
+
+	if r.RefundAmount.IsZero() {
+		return r.MarkRefunded("", actor)
+	}
+	return nil
+}
+
+// Reject declines the return, making its quantities returnable again
+func (r *Return) Reject(reason, actor string) error {
+	reason = strings.TrimSpace(reason)
+	if len(reason) > MaxReturnReasonLength {
+		return domain.NewValidationErrorWithValue("reason", fmt.Sprintf("reason must not exceed %d characters", MaxReturnReasonLength), len(reason))
+	}
+
+	if err := r.transitionTo(ReturnStatusRejected); err != nil {
+		return err
+	}
+	r.RejectionReason = reason
+
+	// Add domain event
+	r.AddEvent(NewReturnRejectedEvent(r, normalizeActor(actor)))
+
+	return nil
+}
+
+// MarkRefunded records the refund issued for an approved return
+// Repeated notifications for an already refunded return are ignored
+func (r *Return) MarkRefunded(refundReference, actor string) error {
+	if r.Status == ReturnStatusRefunded {
+		return nil
+	}
+	if err := r.transitionTo(ReturnStatusRefunded); err != nil {
+		return err
+	}
+	r.RefundReference = refundReference
+
+	// Add domain event
+	r.AddEvent(NewReturnRefundedEvent(r, normalizeActor(actor)))
+
+	return nil
+}
+
+// returnTransitions lists the statuses each return status may move to
+var returnTransitions = map[ReturnStatus][]ReturnStatus{
+	ReturnStatusRequested: {ReturnStatusApproved, ReturnStatusRejected},
+	ReturnStatusApproved:  {ReturnStatusRefunded},
+}
+
+// transitionTo moves the return to a new status if the transition is allowed
+func (r *Return) transitionTo(status ReturnStatus) error {
+	for _, allowed := range returnTransitions[r.Status] {
+		if allowed == status {
+			r.Status = status
+			r.IncrementVersion()
+			return nil
+		}
+	}
+
+	return domain.NewBusinessRuleError(
+		"invalid_status_transition",
+		fmt.Sprintf("cannot change return status from %s to %s", r.Status, status),
+	)
+}
+
+// findLine returns the order line with the given ID, or nil
+func (o *Order) findLine(lineID string) *OrderLine {
+	for i := range o.Lines {
+		if o.Lines[i].ID == lineID {
+			return &o.Lines[i]
+		}
+	}
+	return nil
+}
+
+// refundShare returns the part of the order total attributable to the merchandise value
+// between the cumulative amounts from and to
+func (o *Order) refundShare(from, to int64) (domain.Money, error) {
+	if o.Subtotal.Amount <= 0 {
+		return domain.ZeroMoney(o.Currency), nil
+	}
+
+	before, err := o.Total.Prorate(from, o.Subtotal.Amount)
+	if err != nil {
+		return domain.Money{}, err
+	}
+	after, err := o.Total.Prorate(to, o.Subtotal.Amount)
+	if err != nil {
+		return domain.Money{}, err
+	}
+
+	return after.Subtract(before)
+}
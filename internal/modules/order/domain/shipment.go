@@ -0,0 +1,349 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// ShipmentStatus represents the carrier-reported status of a shipment
+type ShipmentStatus string
+
+const (
+	ShipmentStatusInTransit      ShipmentStatus = "in_transit"
+	ShipmentStatusOutForDelivery ShipmentStatus = "out_for_delivery"
+	ShipmentStatusException      ShipmentStatus = "exception" // delayed, damaged or undeliverable; may still recover
+	ShipmentStatusDelivered      ShipmentStatus = "delivered"
+)
+
+// IsValid checks if the shipment status is known
+func (s ShipmentStatus) IsValid() bool {
+	switch s {
+	case ShipmentStatusInTransit, ShipmentStatusOutForDelivery, ShipmentStatusException, ShipmentStatusDelivered:
+		return true
+	}
+	return false
+}
+
+// Shipment field limits
+const (
+	MaxCarrierLength             = 50
+	MaxTrackingNumberLength      = 100
+	MaxTrackingDescriptionLength = 500
+	MaxTrackingLocationLength    = 255
+)
+
+// ShipmentItem is a quantity of an order line handed over to a carrier
+type ShipmentItem struct {
+	OrderLineID string
+	Quantity    int
+}
+
+// ShipmentLine represents an order line quantity included in a shipment
+type ShipmentLine struct {
+	OrderLineID string `json:"order_line_id"`
+	Quantity    int    `json:"quantity"`
+}
+
+// TrackingEvent is a status update reported for a shipment
+type TrackingEvent struct {
+	Status      ShipmentStatus `json:"status"`
+	Description string         `json:"description,omitempty"`
+	Location    string         `json:"location,omitempty"`
+	OccurredAt  time.Time      `json:"occurred_at"`
+}
+
+// Shipment represents a parcel of order items handed over to a carrier
+// It is an entity of the order aggregate
+type Shipment struct {
+	ID             string          `json:"id"`
+	Carrier        string          `json:"carrier"`
+	TrackingNumber string          `json:"tracking_number"`
+	Status         ShipmentStatus  `json:"status"`
+	Lines          []ShipmentLine  `json:"lines"`
+	TrackingEvents []TrackingEvent `json:"tracking_events"`
+	ShippedAt      time.Time       `json:"shipped_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+}
+
+// IsDelivered checks if the carrier reported the shipment as delivered
+func (s *Shipment) IsDelivered() bool {
+	return s.Status == ShipmentStatusDelivered
+}
+
+// hasTrackingEvent checks if an identical status update was already recorded
+func (s *Shipment) hasTrackingEvent(status ShipmentStatus, occurredAt time.Time) bool {
+	for _, event := range s.TrackingEvents {
+		if event.Status == status && event.OccurredAt.Equal(occurredAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// ShipmentDraft describes a shipment to add to an order
+// An empty Items list ships every quantity that has not been shipped yet
+type ShipmentDraft struct {
+	Carrier        string
+	TrackingNumber string
+	Items          []ShipmentItem
+	ShippedAt      time.Time // defaults to now
+}
+
+// TrackingUpdate is a shipment status update reported manually or by a carrier webhook
+type TrackingUpdate struct {
+	Status      ShipmentStatus
+	Description string
+	Location    string
+	OccurredAt  time.Time // defaults to now
+}
+
+// NormalizeCarrier returns the carrier code in its stored form (trimmed, lower-cased)
+func NormalizeCarrier(carrier string) string {
+	return strings.ToLower(strings.TrimSpace(carrier))
+}
+
+// AddShipment records items of a confirmed order as handed over to a carrier
+// Once every line is fully shipped, the order moves to shipped
+func (o *Order) AddShipment(draft ShipmentDraft, actor string) (*Shipment, error) {
+	if o.Status != OrderStatusConfirmed {
+		return nil, domain.NewBusinessRuleError(
+			"order_not_shippable",
+			fmt.Sprintf("cannot ship items of a %s order", o.Status),
+		)
+	}
+
+	// Validate input
+	var validationErrors domain.ValidationErrors
+
+	carrier := NormalizeCarrier(draft.Carrier)
+	if carrier == "" {
+		validationErrors.Add("carrier", "carrier is required")
+	} else if len(carrier) > MaxCarrierLength {
+		validationErrors.AddWithValue("carrier", fmt.Sprintf("carrier must not exceed %d characters", MaxCarrierLength), carrier)
+	}
+
+	trackingNumber := strings.TrimSpace(draft.TrackingNumber)
+	if trackingNumber == "" {
+		validationErrors.Add("tracking_number", "tracking number is required")
+	} else if len(trackingNumber) > MaxTrackingNumberLength {
+		validationErrors.AddWithValue("tracking_number", fmt.Sprintf("tracking number must not exceed %d characters", MaxTrackingNumberLength), trackingNumber)
+	}
+
+	for _, shipment := range o.Shipments {
+		if shipment.Carrier == carrier && shipment.TrackingNumber == trackingNumber {
+			validationErrors.AddWithValue("tracking_number", "tracking number is already used by a shipment of this order", trackingNumber)
+		}
+	}
+
+	shipped := o.shippedQuantities()
+	items := draft.Items
+	if len(items) == 0 {
+		for _, line := range o.Lines {
+			if remaining := line.Quantity - shipped[line.ID]; remaining > 0 {
+				items = append(items, ShipmentItem{OrderLineID: line.ID, Quantity: remaining})
+			}
+		}
+		if len(items) == 0 {
+			validationErrors.Add("lines", "all order lines have already been shipped")
+		}
+	}
+
+	lines := make([]ShipmentLine, 0, len(items))
+	seen := make(map[string]bool)
+	for i, item := range items {
+		field := fmt.Sprintf("lines[%d]", i)
+
+		orderLine := o.findLine(strings.TrimSpace(item.OrderLineID))
+		if orderLine == nil {
+			validationErrors.AddWithValue(field+".order_line_id", "order line not found", item.OrderLineID)
+			continue
+		}
+		if seen[orderLine.ID] {
+			validationErrors.AddWithValue(field+".order_line_id", "order line is listed more than once", item.OrderLineID)
+			continue
+		}
+		seen[orderLine.ID] = true
+
+		remaining := orderLine.Quantity - shipped[orderLine.ID]
+		if item.Quantity <= 0 {
+			validationErrors.AddWithValue(field+".quantity", "quantity must be positive", item.Quantity)
+			continue
+		}
+		if item.Quantity > remaining {
+			validationErrors.AddWithValue(field+".quantity", fmt.Sprintf("only %d left to ship", remaining), item.Quantity)
+			continue
+		}
+
+		lines = append(lines, ShipmentLine{OrderLineID: orderLine.ID, Quantity: item.Quantity})
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	shippedAt := draft.ShippedAt
+	if shippedAt.IsZero() {
+		shippedAt = time.Now()
+	}
+	shippedAt = shippedAt.UTC()
+
+	shipment := Shipment{
+		ID:             uuid.New().String(),
+		Carrier:        carrier,
+		TrackingNumber: trackingNumber,
+		Status:         ShipmentStatusInTransit,
+		Lines:          lines,
+		TrackingEvents: []TrackingEvent{{Status: ShipmentStatusInTransit, OccurredAt: shippedAt}},
+		ShippedAt:      shippedAt,
+	}
+	o.Shipments = append(o.Shipments, shipment)
+	o.IncrementVersion()
+
+	actor = normalizeActor(actor)
+	o.AddEvent(NewOrderShipmentCreatedEvent(o, shipment, actor))
+
+	if o.isFullyShipped() {
+		previousStatus := o.Status
+		if err := o.transitionTo(OrderStatusShipped); err != nil {
+			return nil, err
+		}
+		o.AddEvent(NewOrderShippedEvent(o, previousStatus, actor))
+	}
+
+	return &o.Shipments[len(o.Shipments)-1], nil
+}
+
+// UpdateShipmentStatus records a status update of a shipment
+// Once the order is fully shipped and every shipment is delivered, the order moves to delivered.
+// It returns false when the update was already recorded, so repeated webhook deliveries are harmless.
+func (o *Order) UpdateShipmentStatus(shipmentID string, update TrackingUpdate, actor string) (bool, error) {
+	shipment := o.FindShipment(shipmentID)
+	if shipment == nil {
+		return false, domain.NewDomainErrorWithField(
+			domain.ErrCodeNotFound,
+			fmt.Sprintf("shipment with ID %s not found", shipmentID),
+			"shipment_id",
+		)
+	}
+
+	// Validate input
+	var validationErrors domain.ValidationErrors
+
+	if !update.Status.IsValid() {
+		validationErrors.AddWithValue("status", "status must be in_transit, out_for_delivery, exception or delivered", string(update.Status))
+	}
+
+	description := strings.TrimSpace(update.Description)
+	if len(description) > MaxTrackingDescriptionLength {
+		validationErrors.Add("description", fmt.Sprintf("description must not exceed %d characters", MaxTrackingDescriptionLength))
+	}
+
+	location := strings.TrimSpace(update.Location)
+	if len(location) > MaxTrackingLocationLength {
+		validationErrors.Add("location", fmt.Sprintf("location must not exceed %d characters", MaxTrackingLocationLength))
+	}
+
+	if validationErrors.HasErrors() {
+		return false, validationErrors
+	}
+
+	occurredAt := update.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+	occurredAt = occurredAt.UTC()
+
+	if shipment.hasTrackingEvent(update.Status, occurredAt) {
+		return false, nil
+	}
+
+	// Carriers may report late scans after delivery; they are kept in the tracking history only
+	previousStatus := shipment.Status
+	shipment.TrackingEvents = append(shipment.TrackingEvents, TrackingEvent{
+		Status:      update.Status,
+		Description: description,
+		Location:    location,
+		OccurredAt:  occurredAt,
+	})
+	if !shipment.IsDelivered() {
+		shipment.Status = update.Status
+		if shipment.IsDelivered() {
+			shipment.DeliveredAt = &occurredAt
+		}
+	}
+	o.IncrementVersion()
+
+	actor = normalizeActor(actor)
+	o.AddEvent(NewOrderShipmentUpdatedEvent(o, *shipment, previousStatus, shipment.TrackingEvents[len(shipment.TrackingEvents)-1], actor))
+
+	if o.Status == OrderStatusShipped && o.isFullyDelivered() {
+		if err := o.transitionTo(OrderStatusDelivered); err != nil {
+			return false, err
+		}
+		o.AddEvent(NewOrderDeliveredEvent(o, OrderStatusShipped, actor))
+	}
+
+	return true, nil
+}
+
+// FindShipmentByTracking returns the shipment with the given carrier and tracking number, or nil
+func (o *Order) FindShipmentByTracking(carrier, trackingNumber string) *Shipment {
+	carrier = NormalizeCarrier(carrier)
+	trackingNumber = strings.TrimSpace(trackingNumber)
+	for i := range o.Shipments {
+		if o.Shipments[i].Carrier == carrier && o.Shipments[i].TrackingNumber == trackingNumber {
+			return &o.Shipments[i]
+		}
+	}
+	return nil
+}
+
+// FindShipment returns the shipment with the given ID, or nil
+func (o *Order) FindShipment(shipmentID string) *Shipment {
+	for i := range o.Shipments {
+		if o.Shipments[i].ID == shipmentID {
+			return &o.Shipments[i]
+		}
+	}
+	return nil
+}
+
+// shippedQuantities returns the quantity shipped so far per order line
+func (o *Order) shippedQuantities() map[string]int {
+	shipped := make(map[string]int)
+	for _, shipment := range o.Shipments {
+		for _, line := range shipment.Lines {
+			shipped[line.OrderLineID] += line.Quantity
+		}
+	}
+	return shipped
+}
+
+// isFullyShipped checks if every order line quantity is part of a shipment
+func (o *Order) isFullyShipped() bool {
+	shipped := o.shippedQuantities()
+	for _, line := range o.Lines {
+		if shipped[line.ID] < line.Quantity {
+			return false
+		}
+	}
+	return true
+}
+
+// isFullyDelivered checks if every shipment of the order has been delivered
+func (o *Order) isFullyDelivered() bool {
+	if len(o.Shipments) == 0 {
+		return false
+	}
+	for _, shipment := range o.Shipments {
+		if !shipment.IsDelivered() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,18 @@
+package database
+
+import (
+	"golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// OrderDatabaseName is the identifier for order database
+	OrderDatabaseName = "order"
+)
+
+// GetOrderDB returns the order database connection
+func GetOrderDB() (*gorm.DB, error) {
+	manager := database.GetGlobalManager()
+	return manager.GetConnection(OrderDatabaseName)
+}
@@ -0,0 +1,63 @@
+package eventhandlers
+
+import (
+	"context"
+	"fmt"
+
+	commandhandlers "golang_modular_monolith/internal/modules/order/application/command_handlers"
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/domain"
+	orderapi "golang_modular_monolith/internal/modules/order/publicapi"
+	productapi "golang_modular_monolith/internal/modules/product/publicapi"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// InventoryEventsHandler completes the order side of the inventory reservation saga:
+// a reserved order is confirmed, a rejected one is cancelled
+type InventoryEventsHandler struct {
+	confirmOrderHandler *commandhandlers.ConfirmOrderHandler
+	cancelOrderHandler  *commandhandlers.CancelOrderHandler
+}
+
+// NewInventoryEventsHandler creates a new inventory events handler
+func NewInventoryEventsHandler(
+	confirmOrderHandler *commandhandlers.ConfirmOrderHandler,
+	cancelOrderHandler *commandhandlers.CancelOrderHandler,
+) *InventoryEventsHandler {
+	return &InventoryEventsHandler{
+		confirmOrderHandler: confirmOrderHandler,
+		cancelOrderHandler:  cancelOrderHandler,
+	}
+}
+
+// CanHandle reports whether the handler is interested in the event type
+func (h *InventoryEventsHandler) CanHandle(eventType string) bool {
+	switch eventType {
+	case productapi.InventoryReservedEventType,
+		productapi.InventoryReservationRejectedEventType:
+		return true
+	}
+	return false
+}
+
+// Handle transitions the order according to the reservation outcome
+func (h *InventoryEventsHandler) Handle(event shareddomain.DomainEvent) error {
+	ctx := shareddomain.WithEventActor(context.Background(), event)
+
+	switch e := event.(type) {
+	case productapi.InventoryReservedEvent:
+		cmd := commands.NewConfirmOrderCommand(e.OrderID, domain.ActorSystem)
+		if _, err := h.confirmOrderHandler.Handle(ctx, &cmd); err != nil {
+			return fmt.Errorf("failed to confirm order %s after inventory reservation: %w", e.OrderID, err)
+		}
+	case productapi.InventoryReservationRejectedEvent:
+		cmd := commands.NewCancelOrderCommand(e.OrderID, orderapi.CancelReasonOutOfStock, "inventory reservation rejected: "+e.Reason, domain.ActorSystem)
+		if _, err := h.cancelOrderHandler.Handle(ctx, &cmd); err != nil {
+			return fmt.Errorf("failed to cancel order %s after inventory rejection: %w", e.OrderID, err)
+		}
+	default:
+		return fmt.Errorf("unsupported event %T for inventory events handler", event)
+	}
+
+	return nil
+}
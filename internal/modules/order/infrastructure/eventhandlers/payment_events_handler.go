@@ -0,0 +1,49 @@
+package eventhandlers
+
+import (
+	"context"
+	"fmt"
+
+	commandhandlers "golang_modular_monolith/internal/modules/order/application/command_handlers"
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/domain"
+	paymentapi "golang_modular_monolith/internal/modules/payment/publicapi"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// PaymentEventsHandler completes order returns once the payment module has refunded them
+type PaymentEventsHandler struct {
+	markReturnRefundedHandler *commandhandlers.MarkReturnRefundedHandler
+}
+
+// NewPaymentEventsHandler creates a new payment events handler
+func NewPaymentEventsHandler(markReturnRefundedHandler *commandhandlers.MarkReturnRefundedHandler) *PaymentEventsHandler {
+	return &PaymentEventsHandler{
+		markReturnRefundedHandler: markReturnRefundedHandler,
+	}
+}
+
+// CanHandle reports whether the handler is interested in the event type
+func (h *PaymentEventsHandler) CanHandle(eventType string) bool {
+	return eventType == paymentapi.PaymentRefundedEventType
+}
+
+// Handle marks the return a refund was issued for as refunded
+// Refunds that do not belong to a return (e.g. after an order cancellation) are ignored
+func (h *PaymentEventsHandler) Handle(event shareddomain.DomainEvent) error {
+	refunded, ok := event.(paymentapi.PaymentRefunded)
+	if !ok {
+		return fmt.Errorf("unsupported event %T for payment events handler", event)
+	}
+
+	if refunded.GetReturnID() == "" {
+		return nil
+	}
+
+	cmd := commands.NewMarkReturnRefundedCommand(refunded.GetReturnID(), refunded.GetRefundReference(), domain.ActorSystem)
+	if _, err := h.markReturnRefundedHandler.Handle(shareddomain.WithEventActor(context.Background(), event), &cmd); err != nil {
+		return fmt.Errorf("failed to mark return %s as refunded: %w", refunded.GetReturnID(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	commandhandlers "golang_modular_monolith/internal/modules/order/application/command_handlers"
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/order/application/query_handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CouponHandler handles HTTP requests for coupon management
+type CouponHandler struct {
+	// Command handlers
+	createCouponHandler *commandhandlers.CreateCouponHandler
+	updateCouponHandler *commandhandlers.UpdateCouponHandler
+
+	// Query handlers
+	getCouponHandler   *queryhandlers.GetCouponHandler
+	listCouponsHandler *queryhandlers.ListCouponsHandler
+}
+
+// NewCouponHandler creates a new coupon handler
+func NewCouponHandler(
+	createCouponHandler *commandhandlers.CreateCouponHandler,
+	updateCouponHandler *commandhandlers.UpdateCouponHandler,
+	getCouponHandler *queryhandlers.GetCouponHandler,
+	listCouponsHandler *queryhandlers.ListCouponsHandler,
+) *CouponHandler {
+	return &CouponHandler{
+		createCouponHandler: createCouponHandler,
+		updateCouponHandler: updateCouponHandler,
+		getCouponHandler:    getCouponHandler,
+		listCouponsHandler:  listCouponsHandler,
+	}
+}
+
+// CreateCouponRequest represents the request body for creating a coupon
+// Value is in basis points for percentage coupons (1000 = 10%) and in minor units for fixed_amount coupons
+type CreateCouponRequest struct {
+	Code                      string     `json:"code" binding:"required,max=64"`
+	Description               string     `json:"description" binding:"max=255"`
+	Type                      string     `json:"type" binding:"required,oneof=percentage fixed_amount"`
+	Value                     int64      `json:"value" binding:"required,min=1"`
+	Currency                  string     `json:"currency"`
+	ValidFrom                 *time.Time `json:"valid_from"`
+	ValidUntil                *time.Time `json:"valid_until"`
+	MaxRedemptions            int        `json:"max_redemptions" binding:"min=0"`
+	MaxRedemptionsPerCustomer int        `json:"max_redemptions_per_customer" binding:"min=0"`
+}
+
+// UpdateCouponRequest represents the request body for updating a coupon
+// Omitted fields keep their current value
+type UpdateCouponRequest struct {
+	Description               *string    `json:"description" binding:"omitempty,max=255"`
+	ValidFrom                 *time.Time `json:"valid_from"`
+	ValidUntil                *time.Time `json:"valid_until"`
+	ClearValidity             bool       `json:"clear_validity"`
+	MaxRedemptions            *int       `json:"max_redemptions" binding:"omitempty,min=0"`
+	MaxRedemptionsPerCustomer *int       `json:"max_redemptions_per_customer" binding:"omitempty,min=0"`
+	Active                    *bool      `json:"active"`
+}
+
+// CreateCoupon handles POST /coupons
+func (h *CouponHandler) CreateCoupon(c *gin.Context) {
+	var req CreateCouponRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewCreateCouponCommand()
+	cmd.Code = req.Code
+	cmd.Description = req.Description
+	cmd.Type = req.Type
+	cmd.Value = req.Value
+	cmd.Currency = req.Currency
+	cmd.ValidFrom = req.ValidFrom
+	cmd.ValidUntil = req.ValidUntil
+	cmd.MaxRedemptions = req.MaxRedemptions
+	cmd.MaxRedemptionsPerCustomer = req.MaxRedemptionsPerCustomer
+
+	result, err := h.createCouponHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// UpdateCoupon handles PATCH /coupons/:code
+func (h *CouponHandler) UpdateCoupon(c *gin.Context) {
+	var req UpdateCouponRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewUpdateCouponCommand(c.Param("code"))
+	cmd.Description = req.Description
+	cmd.ValidFrom = req.ValidFrom
+	cmd.ValidUntil = req.ValidUntil
+	cmd.ClearValidity = req.ClearValidity
+	cmd.MaxRedemptions = req.MaxRedemptions
+	cmd.MaxRedemptionsPerCustomer = req.MaxRedemptionsPerCustomer
+	cmd.Active = req.Active
+
+	result, err := h.updateCouponHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetCoupon handles GET /coupons/:code
+func (h *CouponHandler) GetCoupon(c *gin.Context) {
+	query := &queries.GetCouponQuery{
+		Code: c.Param("code"),
+	}
+
+	result, err := h.getCouponHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result.Coupon,
+	})
+}
+
+// ListCoupons handles GET /coupons
+func (h *CouponHandler) ListCoupons(c *gin.Context) {
+	query := &queries.ListCouponsQuery{
+		ActiveOnly: c.Query("active") == "true",
+	}
+	query.Page, _ = strconv.Atoi(c.Query("page"))
+	query.Limit, _ = strconv.Atoi(c.Query("limit"))
+
+	result, err := h.listCouponsHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"data":       result.Coupons,
+		"pagination": result.Pagination,
+	})
+}
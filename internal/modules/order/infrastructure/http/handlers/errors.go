@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handleError handles errors and returns appropriate HTTP responses
+// Shared by all order module handlers
+func handleError(c *gin.Context, err error) {
+	var validationErrs shareddomain.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErrs.Error(),
+				"details": validationErrs,
+			},
+		})
+		return
+	}
+
+	var validationErr shareddomain.ValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErr.Message,
+				"field":   validationErr.Field,
+			},
+		})
+		return
+	}
+
+	var businessErr shareddomain.BusinessRuleError
+	if errors.As(err, &businessErr) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeBusinessRule,
+				"message": businessErr.Message,
+				"rule":    businessErr.Rule,
+			},
+		})
+		return
+	}
+
+	var domainErr shareddomain.DomainError
+	if errors.As(err, &domainErr) {
+		status := http.StatusInternalServerError
+		switch domainErr.Code {
+		case shareddomain.ErrCodeNotFound:
+			status = http.StatusNotFound
+		case shareddomain.ErrCodeAlreadyExists, shareddomain.ErrCodeConcurrencyConflict:
+			status = http.StatusConflict
+		case shareddomain.ErrCodeInvalidInput, shareddomain.ErrCodeValidationFailed:
+			status = http.StatusBadRequest
+		case shareddomain.ErrCodeRequestTooLarge:
+			status = http.StatusRequestEntityTooLarge
+		case shareddomain.ErrCodeRequestTimeout:
+			status = http.StatusRequestTimeout
+		case shareddomain.ErrCodeUnauthorized:
+			status = http.StatusUnauthorized
+		case shareddomain.ErrCodeInvalidState:
+			status = http.StatusUnprocessableEntity
+		}
+
+		if status == http.StatusInternalServerError {
+			internalError(c)
+			return
+		}
+
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    domainErr.Code,
+				"message": domainErr.Message,
+				"field":   domainErr.Field,
+			},
+		})
+		return
+	}
+
+	// Handle standard errors
+	if shareddomain.IsNotFoundError(err) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "NOT_FOUND",
+				"message": "Resource not found",
+			},
+		})
+		return
+	}
+
+	internalError(c)
+}
+
+// internalError writes a generic internal error response
+func internalError(c *gin.Context) {
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": "An internal error occurred",
+		},
+	})
+}
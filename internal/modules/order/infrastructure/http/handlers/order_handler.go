@@ -0,0 +1,430 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	commandhandlers "golang_modular_monolith/internal/modules/order/application/command_handlers"
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/order/application/query_handlers"
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/idempotency"
+	"golang_modular_monolith/internal/shared/infrastructure/importexport"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OrderHandler handles HTTP requests for order operations
+type OrderHandler struct {
+	// Command handlers
+	createOrderHandler  *commandhandlers.CreateOrderHandler
+	addOrderLineHandler *commandhandlers.AddOrderLineHandler
+	cancelOrderHandler  *commandhandlers.CancelOrderHandler
+
+	// Query handlers
+	getOrderHandler        *queryhandlers.GetOrderHandler
+	getOrderHistoryHandler *queryhandlers.GetOrderHistoryHandler
+	listOrdersHandler      *queryhandlers.ListOrdersHandler
+	searchOrdersHandler    *queryhandlers.SearchOrdersHandler
+	exportOrdersHandler    *queryhandlers.ExportOrdersHandler
+	getOrderSummaryHandler *queryhandlers.GetOrderSummaryHandler
+
+	// Import jobs, and the keys of the rows they imported
+	jobs    *importexport.Jobs
+	imports idempotency.Store
+}
+
+// NewOrderHandler creates a new order handler
+func NewOrderHandler(
+	createOrderHandler *commandhandlers.CreateOrderHandler,
+	addOrderLineHandler *commandhandlers.AddOrderLineHandler,
+	cancelOrderHandler *commandhandlers.CancelOrderHandler,
+	getOrderHandler *queryhandlers.GetOrderHandler,
+	getOrderHistoryHandler *queryhandlers.GetOrderHistoryHandler,
+	listOrdersHandler *queryhandlers.ListOrdersHandler,
+	searchOrdersHandler *queryhandlers.SearchOrdersHandler,
+	exportOrdersHandler *queryhandlers.ExportOrdersHandler,
+	getOrderSummaryHandler *queryhandlers.GetOrderSummaryHandler,
+	jobs *importexport.Jobs,
+	imports idempotency.Store,
+) *OrderHandler {
+	return &OrderHandler{
+		createOrderHandler:     createOrderHandler,
+		addOrderLineHandler:    addOrderLineHandler,
+		cancelOrderHandler:     cancelOrderHandler,
+		getOrderHandler:        getOrderHandler,
+		getOrderHistoryHandler: getOrderHistoryHandler,
+		listOrdersHandler:      listOrdersHandler,
+		searchOrdersHandler:    searchOrdersHandler,
+		exportOrdersHandler:    exportOrdersHandler,
+		getOrderSummaryHandler: getOrderSummaryHandler,
+		jobs:                   jobs,
+		imports:                imports,
+	}
+}
+
+// CreateOrderLineRequest represents a line item in the create order request
+type CreateOrderLineRequest struct {
+	ProductID   string `json:"product_id" binding:"required"`
+	ProductName string `json:"product_name"`
+	Quantity    int    `json:"quantity" binding:"required,min=1"`
+	UnitPrice   int64  `json:"unit_price" binding:"min=0"`
+}
+
+// AddressRequest represents a postal address in the create order request
+type AddressRequest struct {
+	Name       string `json:"name" binding:"required,max=255"`
+	Line1      string `json:"line1" binding:"required,max=255"`
+	Line2      string `json:"line2" binding:"max=255"`
+	City       string `json:"city" binding:"required,max=255"`
+	Region     string `json:"region" binding:"max=255"`
+	PostalCode string `json:"postal_code" binding:"max=20"`
+	Country    string `json:"country" binding:"required,len=2"`
+	Phone      string `json:"phone" binding:"max=32"`
+}
+
+// CreateOrderRequest represents the request body for creating an order
+// Amounts are integers in the currency's minor unit (e.g. cents)
+// The addresses are copied into the order; the billing address defaults to the shipping address
+type CreateOrderRequest struct {
+	CustomerID      string                   `json:"customer_id" binding:"required"`
+	Currency        string                   `json:"currency" binding:"required,len=3"`
+	Lines           []CreateOrderLineRequest `json:"lines" binding:"required,min=1,dive"`
+	ShippingAddress *AddressRequest          `json:"shipping_address"`
+	BillingAddress  *AddressRequest          `json:"billing_address"`
+	CouponCode      string                   `json:"coupon_code" binding:"max=64"`
+}
+
+// CreateOrder handles POST /orders
+// Retries with an Idempotency-Key are replayed by the idempotency middleware of the module's routes
+func (h *OrderHandler) CreateOrder(c *gin.Context) {
+	var req CreateOrderRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	lines := make([]commands.CreateOrderLine, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = commands.CreateOrderLine{
+			ProductID:   line.ProductID,
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+			UnitPrice:   line.UnitPrice,
+		}
+	}
+
+	cmd := &commands.CreateOrderCommand{
+		CustomerID:      req.CustomerID,
+		Currency:        req.Currency,
+		Lines:           lines,
+		ShippingAddress: toAddressCommand(req.ShippingAddress),
+		BillingAddress:  toAddressCommand(req.BillingAddress),
+		CouponCode:      req.CouponCode,
+		Actor:           requestActor(c),
+	}
+
+	result, err := h.createOrderHandler.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// toAddressCommand converts an address request to a command address
+func toAddressCommand(req *AddressRequest) *commands.Address {
+	if req == nil {
+		return nil
+	}
+	address := commands.Address(*req)
+	return &address
+}
+
+// AddOrderLine handles POST /orders/:id/lines
+// The body is a line of the create order request, priced in the order currency
+func (h *OrderHandler) AddOrderLine(c *gin.Context) {
+	var req CreateOrderLineRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewAddOrderLineCommand(c.Param("id"), commands.CreateOrderLine{
+		ProductID:   req.ProductID,
+		ProductName: req.ProductName,
+		Quantity:    req.Quantity,
+		UnitPrice:   req.UnitPrice,
+	})
+
+	result, err := h.addOrderLineHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// CancelOrderRequest represents the request body for cancelling an order
+type CancelOrderRequest struct {
+	ReasonCode string `json:"reason_code" binding:"required"`
+	Reason     string `json:"reason" binding:"max=500"`
+}
+
+// CancelOrder handles POST /orders/:id/cancel
+func (h *OrderHandler) CancelOrder(c *gin.Context) {
+	var req CancelOrderRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewCancelOrderCommand(c.Param("id"), req.ReasonCode, req.Reason, requestActor(c))
+
+	result, err := h.cancelOrderHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetOrder handles GET /orders/:id
+func (h *OrderHandler) GetOrder(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		handleError(c, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"Order ID is required",
+		))
+		return
+	}
+
+	query := &queries.GetOrderQuery{
+		ID: id,
+	}
+
+	result, err := h.getOrderHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result.Order,
+	})
+}
+
+// GetOrderHistory handles GET /orders/:id/history
+func (h *OrderHandler) GetOrderHistory(c *gin.Context) {
+	query := &queries.GetOrderHistoryQuery{
+		ID: c.Param("id"),
+	}
+
+	result, err := h.getOrderHistoryHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ListOrders handles GET /orders
+func (h *OrderHandler) ListOrders(c *gin.Context) {
+	query, err := h.getListQuery(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	result, err := h.listOrdersHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"data":       result.Orders,
+		"pagination": result.Pagination,
+	})
+}
+
+// SearchOrders handles GET /orders/search
+func (h *OrderHandler) SearchOrders(c *gin.Context) {
+	listQuery, err := h.getListQuery(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	query := &queries.SearchOrdersQuery{
+		ListOrdersQuery: *listQuery,
+		Query:           c.Query("q"),
+		ProductID:       c.Query("product_id"),
+	}
+
+	result, err := h.searchOrdersHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"data":       result.Orders,
+		"pagination": result.Pagination,
+	})
+}
+
+// Helper methods
+
+// getListQuery parses the pagination, sorting and filter parameters shared by list and search
+func (h *OrderHandler) getListQuery(c *gin.Context) (*queries.ListOrdersQuery, error) {
+	query := &queries.ListOrdersQuery{
+		Page:       h.getIntParam(c, "page", 1),
+		Limit:      h.getIntParam(c, "limit", 20),
+		SortBy:     h.getStringParam(c, "sort_by", "created_at"),
+		SortOrder:  h.getStringParam(c, "sort_order", "desc"),
+		CustomerID: c.Query("customer_id"),
+		Currency:   c.Query("currency"),
+		Statuses:   h.getStatusFilters(c),
+	}
+
+	// Parse total filters
+	var err error
+	if query.MinTotal, err = h.getAmountParam(c, "min_total"); err != nil {
+		return nil, err
+	}
+	if query.MaxTotal, err = h.getAmountParam(c, "max_total"); err != nil {
+		return nil, err
+	}
+
+	// Parse date filters
+	if query.CreatedAfter, err = h.getTimeParam(c, "created_after", false); err != nil {
+		return nil, err
+	}
+	if query.CreatedBefore, err = h.getTimeParam(c, "created_before", true); err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+// getIntParam gets an integer parameter with default value
+func (h *OrderHandler) getIntParam(c *gin.Context, key string, defaultValue int) int {
+	if str := c.Query(key); str != "" {
+		if val, err := strconv.Atoi(str); err == nil {
+			return val
+		}
+	}
+	return defaultValue
+}
+
+// getBoolParam gets a boolean parameter with default value
+func (h *OrderHandler) getBoolParam(c *gin.Context, key string, defaultValue bool) bool {
+	if str := c.Query(key); str != "" {
+		if val, err := strconv.ParseBool(str); err == nil {
+			return val
+		}
+	}
+	return defaultValue
+}
+
+// getStringParam gets a string parameter with default value
+func (h *OrderHandler) getStringParam(c *gin.Context, key string, defaultValue string) string {
+	if val := c.Query(key); val != "" {
+		return val
+	}
+	return defaultValue
+}
+
+// getAmountParam parses an amount filter in the currency's minor unit
+func (h *OrderHandler) getAmountParam(c *gin.Context, key string) (*int64, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	amount, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, shareddomain.NewValidationErrorWithValue(key, key+" must be an integer amount in minor units", value)
+	}
+	return &amount, nil
+}
+
+// getTimeParam parses a timestamp filter. RFC 3339 values carry their own offset;
+// values without an offset ("2006-01-02" or "2006-01-02T15:04:05") are interpreted
+// in the ?tz= time zone (UTC by default). A date-only upper bound covers the whole day.
+func (h *OrderHandler) getTimeParam(c *gin.Context, key string, upperBound bool) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	location := time.UTC
+	if tz := c.Query("tz"); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, shareddomain.NewValidationErrorWithValue("tz", "tz must be a valid IANA time zone", tz)
+		}
+		location = loc
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return &t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02T15:04:05", value, location); err == nil {
+		return &t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, location); err == nil {
+		if upperBound {
+			t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		return &t, nil
+	}
+
+	return nil, shareddomain.NewValidationErrorWithValue(key, key+" must be an RFC 3339 timestamp or a YYYY-MM-DD date", value)
+}
+
+// getStatusFilters parses a comma-separated status filter (e.g. ?status=pending,confirmed)
+func (h *OrderHandler) getStatusFilters(c *gin.Context) []domain.OrderStatus {
+	var statuses []domain.OrderStatus
+	for _, value := range strings.Split(c.Query("status"), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			statuses = append(statuses, domain.OrderStatus(value))
+		}
+	}
+	return statuses
+}
+
+// requestActor returns the actor recorded for changes requested by the caller,
+// falling back to the API actor for unauthenticated requests
+func requestActor(c *gin.Context) string {
+	if actor, ok := shareddomain.ActorFromContext(c.Request.Context()); ok {
+		return actor.String()
+	}
+	return domain.ActorAPI
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	commandhandlers "golang_modular_monolith/internal/modules/order/application/command_handlers"
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/shared/application"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/idempotency"
+	"golang_modular_monolith/internal/shared/infrastructure/importexport"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OrderImportKind names the order import jobs
+const OrderImportKind = "orders"
+
+// ImportReferenceTTL is how long the reference of an imported row is kept
+const ImportReferenceTTL = 90 * 24 * time.Hour
+
+// newOrderImporter creates the importer creating an order per row, as POST /orders would, on behalf
+// of actor
+// Lines are a JSON array in the "lines" column; the shipping address, when given, is also the
+// billing address. A row's reference is its idempotency key in imports, so importing a file again
+// within ImportReferenceTTL creates its orders once
+func newOrderImporter(createOrderHandler *commandhandlers.CreateOrderHandler, imports idempotency.Store, actor string) *importexport.Importer[*commands.CreateOrderCommand] {
+	once := idempotency.CommandMiddleware(imports, ImportReferenceTTL)
+	return &importexport.Importer[*commands.CreateOrderCommand]{
+		Kind: OrderImportKind,
+		Fields: []importexport.Field{
+			{Name: "reference"},
+			{Name: "customer_id", Required: true},
+			{Name: "currency", Required: true},
+			{Name: "lines", Required: true},
+			{Name: "coupon_code"},
+			{Name: "shipping_name"},
+			{Name: "shipping_line1"},
+			{Name: "shipping_line2"},
+			{Name: "shipping_city"},
+			{Name: "shipping_region"},
+			{Name: "shipping_postal_code"},
+			{Name: "shipping_country"},
+			{Name: "shipping_phone"},
+		},
+		Parse: func(row importexport.Row) (*commands.CreateOrderCommand, error) {
+			var lines []commands.CreateOrderLine
+			if err := json.Unmarshal([]byte(row.Get("lines")), &lines); err != nil {
+				return nil, shareddomain.NewValidationError("lines", "lines must be a JSON array of {product_id, product_name, quantity, unit_price}")
+			}
+
+			cmd := &commands.CreateOrderCommand{
+				CustomerID: row.Get("customer_id"),
+				Currency:   strings.ToUpper(row.Get("currency")),
+				Lines:      lines,
+				CouponCode: row.Get("coupon_code"),
+				Reference:  row.Get("reference"),
+				Actor:      actor,
+			}
+
+			address := &commands.Address{
+				Name:       row.Get("shipping_name"),
+				Line1:      row.Get("shipping_line1"),
+				Line2:      row.Get("shipping_line2"),
+				City:       row.Get("shipping_city"),
+				Region:     row.Get("shipping_region"),
+				PostalCode: row.Get("shipping_postal_code"),
+				Country:    strings.ToUpper(row.Get("shipping_country")),
+				Phone:      row.Get("shipping_phone"),
+			}
+			if *address != (commands.Address{}) {
+				cmd.ShippingAddress = address
+				cmd.BillingAddress = address
+			}
+			return cmd, nil
+		},
+		Key: func(cmd *commands.CreateOrderCommand) string {
+			return cmd.Reference
+		},
+		KeyField: "reference",
+		Validate: []importexport.Validator[*commands.CreateOrderCommand]{
+			createOrderHandler.Validate,
+		},
+		Apply: func(ctx context.Context, cmd *commands.CreateOrderCommand) error {
+			return once.Execute(ctx, cmd, func(ctx context.Context, _ application.Command) error {
+				_, err := createOrderHandler.Handle(ctx, cmd)
+				return err
+			})
+		},
+	}
+}
+
+// ImportOrders handles POST /orders/import
+// The file is a multipart "file" part or the request body, in CSV, NDJSON or XLSX; it is imported
+// by a background job, answered with 202 Accepted. ?dry_run=true validates the rows without
+// creating orders
+func (h *OrderHandler) ImportOrders(c *gin.Context) {
+	file, err := importexport.Upload(c, importexport.DefaultMaxUpload)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	importer := newOrderImporter(h.createOrderHandler, h.imports, requestActor(c))
+	job, err := importer.Start(c.Request.Context(), h.jobs, file, h.getBoolParam(c, "dry_run", false))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Location", c.Request.URL.Path+"/"+job.ID)
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    job,
+	})
+}
+
+// GetOrderImport handles GET /orders/import/:id
+func (h *OrderHandler) GetOrderImport(c *gin.Context) {
+	job, err := h.jobs.Get(OrderImportKind, c.Param("id"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    job,
+	})
+}
+
+// GetOrderImportErrors handles GET /orders/import/:id/errors
+// It downloads the rows that failed, with their errors, as CSV (default), NDJSON or XLSX
+func (h *OrderHandler) GetOrderImportErrors(c *gin.Context) {
+	format, err := importexport.ParseFormat(c.Query("format"), importexport.FormatCSV)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if err := h.jobs.WriteErrors(c, OrderImportKind, c.Param("id"), format); err != nil {
+		handleError(c, err)
+	}
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	"golang_modular_monolith/internal/modules/order/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/importexport"
+
+	"github.com/gin-gonic/gin"
+)
+
+// orderExporter writes orders to export files
+// Amounts are in the currency's minor unit; NDJSON exports write the whole order view
+var orderExporter = &importexport.Exporter[domain.OrderView]{
+	Name: "orders",
+	Columns: []importexport.Column[domain.OrderView]{
+		{Name: "id", Value: func(o domain.OrderView) string { return o.ID }},
+		{Name: "number", Value: func(o domain.OrderView) string { return o.Number }},
+		{Name: "customer_id", Value: func(o domain.OrderView) string { return o.CustomerID }},
+		{Name: "status", Value: func(o domain.OrderView) string { return string(o.Status) }},
+		{Name: "currency", Value: func(o domain.OrderView) string { return o.Currency }},
+		{Name: "line_count", Value: func(o domain.OrderView) string { return strconv.Itoa(o.LineCount) }, Numeric: true},
+		{Name: "item_count", Value: func(o domain.OrderView) string { return strconv.Itoa(o.ItemCount) }, Numeric: true},
+		{Name: "subtotal", Value: func(o domain.OrderView) string { return strconv.FormatInt(o.Subtotal.Amount, 10) }, Numeric: true},
+		{Name: "discount", Value: func(o domain.OrderView) string { return strconv.FormatInt(o.Discount.Amount, 10) }, Numeric: true},
+		{Name: "tax", Value: func(o domain.OrderView) string { return strconv.FormatInt(o.Tax.Amount, 10) }, Numeric: true},
+		{Name: "total", Value: func(o domain.OrderView) string { return strconv.FormatInt(o.Total.Amount, 10) }, Numeric: true},
+		{Name: "coupon_codes", Value: couponCodes},
+		{Name: "shipping_name", Value: func(o domain.OrderView) string { return shippingAddress(o).Name }, Untrusted: true},
+		{Name: "shipping_city", Value: func(o domain.OrderView) string { return shippingAddress(o).City }, Untrusted: true},
+		{Name: "shipping_postal_code", Value: func(o domain.OrderView) string { return shippingAddress(o).PostalCode }, Untrusted: true},
+		{Name: "shipping_country", Value: func(o domain.OrderView) string { return shippingAddress(o).Country }},
+		{Name: "billing_country", Value: billingCountry},
+		{Name: "created_at", Value: func(o domain.OrderView) string { return o.CreatedAt.UTC().Format(time.RFC3339) }},
+		{Name: "updated_at", Value: func(o domain.OrderView) string { return o.UpdatedAt.UTC().Format(time.RFC3339) }},
+	},
+	Document: func(o domain.OrderView) any { return o },
+}
+
+// ExportOrders handles GET /orders/export
+// It accepts the same filters and sorting as GET /orders and streams every match as CSV (default), NDJSON or XLSX
+func (h *OrderHandler) ExportOrders(c *gin.Context) {
+	format, err := importexport.ParseFormat(c.Query("format"), importexport.FormatCSV)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	listQuery, err := h.getListQuery(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	query := &queries.ExportOrdersQuery{ListOrdersQuery: *listQuery}
+	err = orderExporter.Stream(c, format, func(fn func(domain.OrderView) error) error {
+		return h.exportOrdersHandler.Handle(c.Request.Context(), query, fn)
+	})
+	if err != nil {
+		handleError(c, err)
+	}
+}
+
+// GetOrderSummary handles GET /orders/reports/summary
+// Orders are grouped by ?group_by=day|status (default day) and currency; days are bucketed in the ?tz= time zone
+func (h *OrderHandler) GetOrderSummary(c *gin.Context) {
+	listQuery, err := h.getListQuery(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	query := &queries.GetOrderSummaryQuery{
+		ListOrdersQuery: *listQuery,
+		GroupBy:         c.Query("group_by"),
+		Timezone:        c.Query("tz"),
+	}
+
+	result, err := h.getOrderSummaryHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// couponCodes lists the coupon codes applied to an order
+func couponCodes(order domain.OrderView) string {
+	codes := make([]string, len(order.Discounts))
+	for i, discount := range order.Discounts {
+		codes[i] = discount.CouponCode
+	}
+	return strings.Join(codes, ";")
+}
+
+// shippingAddress returns the shipping address of an order, empty when it has none
+func shippingAddress(order domain.OrderView) domain.Address {
+	if order.ShippingAddress == nil {
+		return domain.Address{}
+	}
+	return *order.ShippingAddress
+}
+
+// billingCountry returns the billing country of an order, empty when it has no billing address
+func billingCountry(order domain.OrderView) string {
+	if order.BillingAddress == nil {
+		return ""
+	}
+	return order.BillingAddress.Country
+}
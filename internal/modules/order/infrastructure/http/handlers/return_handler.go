@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"net/http"
+
+	commandhandlers "golang_modular_monolith/internal/modules/order/application/command_handlers"
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/order/application/query_handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReturnHandler handles HTTP requests for order returns
+type ReturnHandler struct {
+	// Command handlers
+	createReturnHandler  *commandhandlers.CreateReturnHandler
+	approveReturnHandler *commandhandlers.ApproveReturnHandler
+	rejectReturnHandler  *commandhandlers.RejectReturnHandler
+
+	// Query handlers
+	getReturnHandler        *queryhandlers.GetReturnHandler
+	listOrderReturnsHandler *queryhandlers.ListOrderReturnsHandler
+}
+
+// NewReturnHandler creates a new return handler
+func NewReturnHandler(
+	createReturnHandler *commandhandlers.CreateReturnHandler,
+	approveReturnHandler *commandhandlers.ApproveReturnHandler,
+	rejectReturnHandler *commandhandlers.RejectReturnHandler,
+	getReturnHandler *queryhandlers.GetReturnHandler,
+	listOrderReturnsHandler *queryhandlers.ListOrderReturnsHandler,
+) *ReturnHandler {
+	return &ReturnHandler{
+		createReturnHandler:     createReturnHandler,
+		approveReturnHandler:    approveReturnHandler,
+		rejectReturnHandler:     rejectReturnHandler,
+		getReturnHandler:        getReturnHandler,
+		listOrderReturnsHandler: listOrderReturnsHandler,
+	}
+}
+
+// CreateReturnLineRequest represents a returned order line quantity
+type CreateReturnLineRequest struct {
+	OrderLineID string `json:"order_line_id" binding:"required"`
+	Quantity    int    `json:"quantity" binding:"required,min=1"`
+}
+
+// CreateReturnRequest represents the request body for requesting a return
+type CreateReturnRequest struct {
+	Lines  []CreateReturnLineRequest `json:"lines" binding:"required,min=1,dive"`
+	Reason string                    `json:"reason" binding:"max=500"`
+}
+
+// RejectReturnRequest represents the request body for rejecting a return
+type RejectReturnRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// CreateReturn handles POST /orders/:id/returns
+func (h *ReturnHandler) CreateReturn(c *gin.Context) {
+	var req CreateReturnRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	lines := make([]commands.CreateReturnLine, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = commands.CreateReturnLine{
+			OrderLineID: line.OrderLineID,
+			Quantity:    line.Quantity,
+		}
+	}
+
+	cmd := commands.NewCreateReturnCommand(c.Param("id"), lines, req.Reason, requestActor(c))
+
+	result, err := h.createReturnHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ListOrderReturns handles GET /orders/:id/returns
+func (h *ReturnHandler) ListOrderReturns(c *gin.Context) {
+	query := &queries.ListOrderReturnsQuery{
+		OrderID: c.Param("id"),
+	}
+
+	result, err := h.listOrderReturnsHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetReturn handles GET /returns/:id
+func (h *ReturnHandler) GetReturn(c *gin.Context) {
+	query := &queries.GetReturnQuery{
+		ID: c.Param("id"),
+	}
+
+	result, err := h.getReturnHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result.Return,
+	})
+}
+
+// ApproveReturn handles POST /returns/:id/approve
+// Approval hands the refund over to the payment module
+func (h *ReturnHandler) ApproveReturn(c *gin.Context) {
+	cmd := commands.NewApproveReturnCommand(c.Param("id"), requestActor(c))
+
+	result, err := h.approveReturnHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// RejectReturn handles POST /returns/:id/reject
+func (h *ReturnHandler) RejectReturn(c *gin.Context) {
+	var req RejectReturnRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewRejectReturnCommand(c.Param("id"), req.Reason, requestActor(c))
+
+	result, err := h.rejectReturnHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	commandhandlers "golang_modular_monolith/internal/modules/order/application/command_handlers"
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/order/application/query_handlers"
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/httplimit"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// TrackingSignatureHeader carries the hex encoded HMAC-SHA256 of a tracking webhook body
+const TrackingSignatureHeader = "X-Tracking-Signature"
+
+// maxTrackingWebhookBodyBytes bounds the size of tracking webhook payloads
+const maxTrackingWebhookBodyBytes = 64 << 10
+
+// ShipmentHandler handles HTTP requests for order shipments
+type ShipmentHandler struct {
+	// Command handlers
+	createShipmentHandler       *commandhandlers.CreateShipmentHandler
+	updateShipmentStatusHandler *commandhandlers.UpdateShipmentStatusHandler
+	recordTrackingUpdateHandler *commandhandlers.RecordTrackingUpdateHandler
+
+	// Query handlers
+	listOrderShipmentsHandler *queryhandlers.ListOrderShipmentsHandler
+
+	// webhookSecret signs tracking webhooks; when empty, unsigned webhooks are accepted
+	webhookSecret string
+}
+
+// NewShipmentHandler creates a new shipment handler
+func NewShipmentHandler(
+	createShipmentHandler *commandhandlers.CreateShipmentHandler,
+	updateShipmentStatusHandler *commandhandlers.UpdateShipmentStatusHandler,
+	recordTrackingUpdateHandler *commandhandlers.RecordTrackingUpdateHandler,
+	listOrderShipmentsHandler *queryhandlers.ListOrderShipmentsHandler,
+	webhookSecret string,
+) *ShipmentHandler {
+	return &ShipmentHandler{
+		createShipmentHandler:       createShipmentHandler,
+		updateShipmentStatusHandler: updateShipmentStatusHandler,
+		recordTrackingUpdateHandler: recordTrackingUpdateHandler,
+		listOrderShipmentsHandler:   listOrderShipmentsHandler,
+		webhookSecret:               webhookSecret,
+	}
+}
+
+// CreateShipmentLineRequest represents a shipped order line quantity
+type CreateShipmentLineRequest struct {
+	OrderLineID string `json:"order_line_id" binding:"required"`
+	Quantity    int    `json:"quantity" binding:"required,min=1"`
+}
+
+// CreateShipmentRequest represents the request body for creating a shipment
+// Omitting lines ships every quantity that has not been shipped yet
+type CreateShipmentRequest struct {
+	Carrier        string                      `json:"carrier" binding:"required,max=50"`
+	TrackingNumber string                      `json:"tracking_number" binding:"required,max=100"`
+	Lines          []CreateShipmentLineRequest `json:"lines" binding:"omitempty,dive"`
+	ShippedAt      *time.Time                  `json:"shipped_at"`
+}
+
+// TrackingUpdateRequest represents a shipment status update
+type TrackingUpdateRequest struct {
+	Status      string     `json:"status" binding:"required,oneof=in_transit out_for_delivery exception delivered"`
+	Description string     `json:"description" binding:"max=500"`
+	Location    string     `json:"location" binding:"max=255"`
+	OccurredAt  *time.Time `json:"occurred_at"`
+}
+
+// TrackingWebhookRequest represents a carrier tracking notification
+// Carrier integrations translate their native payloads into this format
+type TrackingWebhookRequest struct {
+	Carrier        string `json:"carrier" binding:"required"`
+	TrackingNumber string `json:"tracking_number" binding:"required"`
+	TrackingUpdateRequest
+}
+
+// toCommand converts the request to a command tracking update
+func (r TrackingUpdateRequest) toCommand() commands.TrackingUpdate {
+	return commands.TrackingUpdate{
+		Status:      r.Status,
+		Description: r.Description,
+		Location:    r.Location,
+		OccurredAt:  r.OccurredAt,
+	}
+}
+
+// CreateShipment handles POST /orders/:id/shipments
+func (h *ShipmentHandler) CreateShipment(c *gin.Context) {
+	var req CreateShipmentRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	lines := make([]commands.CreateShipmentLine, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = commands.CreateShipmentLine{
+			OrderLineID: line.OrderLineID,
+			Quantity:    line.Quantity,
+		}
+	}
+
+	cmd := commands.NewCreateShipmentCommand(c.Param("id"), req.Carrier, req.TrackingNumber, lines, requestActor(c))
+	cmd.ShippedAt = req.ShippedAt
+
+	result, err := h.createShipmentHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ListOrderShipments handles GET /orders/:id/shipments
+func (h *ShipmentHandler) ListOrderShipments(c *gin.Context) {
+	query := &queries.ListOrderShipmentsQuery{
+		OrderID: c.Param("id"),
+	}
+
+	result, err := h.listOrderShipmentsHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// UpdateShipmentStatus handles POST /orders/:id/shipments/:shipmentId/status
+func (h *ShipmentHandler) UpdateShipmentStatus(c *gin.Context) {
+	var req TrackingUpdateRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewUpdateShipmentStatusCommand(c.Param("id"), c.Param("shipmentId"), req.toCommand(), requestActor(c))
+
+	result, err := h.updateShipmentStatusHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// HandleTrackingWebhook handles POST /shipments/tracking
+// Repeated deliveries of the same notification are acknowledged without changing the shipment
+func (h *ShipmentHandler) HandleTrackingWebhook(c *gin.Context) {
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxTrackingWebhookBodyBytes))
+	if err != nil {
+		handleError(c, httplimit.ReadError(err))
+		return
+	}
+
+	if !h.verifyTrackingSignature(payload, c.GetHeader(TrackingSignatureHeader)) {
+		handleError(c, shareddomain.NewDomainError(
+			shareddomain.ErrCodeUnauthorized,
+			"invalid webhook signature",
+		))
+		return
+	}
+
+	var req TrackingWebhookRequest
+	if err := binding.JSON.BindBody(payload, &req); err != nil {
+		handleError(c, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"Invalid webhook body: "+err.Error(),
+		))
+		return
+	}
+
+	cmd := commands.NewRecordTrackingUpdateCommand(req.Carrier, req.TrackingNumber, req.toCommand(), domain.ActorSystem)
+
+	result, err := h.recordTrackingUpdateHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// verifyTrackingSignature checks the HMAC-SHA256 signature of a tracking webhook body
+// Without a secret no signature can be checked, so every webhook is refused
+func (h *ShipmentHandler) verifyTrackingSignature(payload []byte, signature string) bool {
+	if h.webhookSecret == "" {
+		return false
+	}
+
+	decoded, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(h.webhookSecret))
+	mac.Write(payload)
+	return hmac.Equal(decoded, mac.Sum(nil))
+}
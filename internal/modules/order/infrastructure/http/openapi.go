@@ -0,0 +1,139 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/order/application/commands"
+	"golang_modular_monolith/internal/modules/order/application/queries"
+	"golang_modular_monolith/internal/modules/order/domain"
+	"golang_modular_monolith/internal/modules/order/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/idempotency"
+	"golang_modular_monolith/internal/shared/infrastructure/importexport"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+)
+
+// APIOperations documents the routes registered by RegisterOrderRoutes
+func APIOperations() []*openapi.Operation {
+	return []*openapi.Operation{
+		// Orders
+		openapi.Post("/orders", "Place an order").
+			Describe("Amounts are in the currency's minor unit, e.g. cents. Retries with the same Idempotency-Key return the original order").
+			Header(idempotency.KeyHeader, "Makes retries safe").
+			Body(handlers.CreateOrderRequest{}).
+			Created(commands.CreateOrderResult{}),
+		orderFilters(openapi.Get("/orders", "List orders")).
+			Cached().
+			Paginated([]domain.OrderView{}, domain.PaginationResult{}),
+		orderFilters(openapi.Get("/orders/search", "Search orders")).
+			Cached().
+			Query("q", "string", "Free-text search").
+			Query("product_id", "string", "Orders containing the product").
+			Paginated([]domain.OrderView{}, domain.PaginationResult{}),
+		orderFilters(openapi.Get("/orders/export", "Export orders")).
+			Describe("Streams every order matching the filters").
+			Query("format", "string", "csv (default), ndjson or xlsx").
+			Produces("text/csv"),
+		openapi.Post("/orders/import", "Import orders").
+			Describe("Places an order per row of a CSV, NDJSON or XLSX file, sent as the multipart file part or the request body, in a background job; lines are a JSON array and a row's reference is its idempotency key").
+			Requires(domain.PermissionImportOrders).
+			Query("format", "string", "csv, ndjson or xlsx; defaults to the extension or content type of the file").
+			Query("dry_run", "boolean", "Validate the rows without placing orders").
+			Accepted(importexport.Job{}),
+		openapi.Get("/orders/import/:id", "Get an order import").
+			Requires(domain.PermissionImportOrders).
+			Returns(importexport.Job{}),
+		openapi.Get("/orders/import/:id/errors", "Download the rows an order import rejected").
+			Describe("Lists the values of each rejected row with its row number and errors").
+			Requires(domain.PermissionImportOrders).
+			Query("format", "string", "csv (default), ndjson or xlsx").
+			Produces("text/csv"),
+		orderFilters(openapi.Get("/orders/reports/summary", "Summarize orders")).
+			Cached().
+			Describe("Days are bucketed in the tz time zone").
+			Query("group_by", "string", "day (default) or status").
+			Returns(queries.GetOrderSummaryResult{}),
+		openapi.Get("/orders/:id", "Get an order").
+			Cached().
+			Returns(domain.OrderView{}),
+		openapi.Get("/orders/:id/history", "Get the status history of an order").
+			Cached().
+			Returns(queries.GetOrderHistoryResult{}),
+		openapi.Post("/orders/:id/lines", "Add a line to an order").
+			Describe("Only pending orders accept lines; the unit price is in the order currency's minor unit").
+			Body(handlers.CreateOrderLineRequest{}).
+			Created(commands.AddOrderLineResult{}),
+		openapi.Post("/orders/:id/cancel", "Cancel an order").
+			Body(handlers.CancelOrderRequest{}).
+			Returns(commands.OrderStatusResult{}),
+
+		// Returns
+		openapi.Post("/orders/:id/returns", "Request a return").
+			Body(handlers.CreateReturnRequest{}).
+			Requires(domain.PermissionCreateReturns).
+			Created(commands.ReturnResult{}),
+		openapi.Get("/orders/:id/returns", "List the returns of an order").
+			Returns(queries.ListOrderReturnsResult{}),
+		openapi.Get("/returns/:id", "Get a return").
+			Returns(domain.Return{}),
+		openapi.Post("/returns/:id/approve", "Approve a return").
+			Describe("Refunds the returned lines").
+			Requires(domain.PermissionApproveReturns).
+			Returns(commands.ReturnResult{}),
+		openapi.Post("/returns/:id/reject", "Reject a return").
+			Body(handlers.RejectReturnRequest{}).
+			Requires(domain.PermissionApproveReturns).
+			Returns(commands.ReturnResult{}),
+
+		// Shipments
+		openapi.Post("/orders/:id/shipments", "Ship an order").
+			Describe("Omitting lines ships every quantity that has not been shipped yet").
+			Body(handlers.CreateShipmentRequest{}).
+			Requires(domain.PermissionWriteShipments).
+			Created(commands.ShipmentResult{}),
+		openapi.Get("/orders/:id/shipments", "List the shipments of an order").
+			Returns(queries.ListOrderShipmentsResult{}),
+		openapi.Post("/orders/:id/shipments/:shipmentId/status", "Update the status of a shipment").
+			Body(handlers.TrackingUpdateRequest{}).
+			Requires(domain.PermissionWriteShipments).
+			Returns(commands.ShipmentResult{}),
+		openapi.Post("/shipments/tracking", "Receive a carrier tracking notification").
+			Describe("Signed by the carrier; repeated deliveries are acknowledged without changing the shipment").
+			Header(handlers.TrackingSignatureHeader, "HMAC-SHA256 signature of the body").
+			Body(handlers.TrackingWebhookRequest{}).
+			Returns(commands.ShipmentResult{}),
+
+		// Coupons
+		openapi.Post("/coupons", "Create a coupon").
+			Body(handlers.CreateCouponRequest{}).
+			Requires(domain.PermissionWriteCoupons).
+			Created(commands.CouponResult{}),
+		openapi.Get("/coupons", "List coupons").
+			Query("page", "integer", "Page number, from 1").
+			Query("limit", "integer", "Page size").
+			Query("active", "boolean", "Only active coupons").
+			Requires(domain.PermissionWriteCoupons).
+			Paginated([]domain.Coupon{}, domain.PaginationResult{}),
+		openapi.Get("/coupons/:code", "Get a coupon").
+			Requires(domain.PermissionWriteCoupons).
+			Returns(domain.Coupon{}),
+		openapi.Patch("/coupons/:code", "Update a coupon").
+			Body(handlers.UpdateCouponRequest{}).
+			Requires(domain.PermissionWriteCoupons).
+			Returns(commands.CouponResult{}),
+	}
+}
+
+// orderFilters documents the pagination, sorting and filters shared by the order list routes
+func orderFilters(operation *openapi.Operation) *openapi.Operation {
+	return operation.
+		Query("page", "integer", "Page number, from 1").
+		Query("limit", "integer", "Page size").
+		Query("sort_by", "string", "Field to sort by").
+		Query("sort_order", "string", "asc or desc").
+		Query("customer_id", "string", "Customer ID").
+		Query("currency", "string", "ISO 4217 currency code").
+		Query("status", "string", "Comma-separated statuses").
+		Query("min_total", "integer", "Minimum total in minor units").
+		Query("max_total", "integer", "Maximum total in minor units").
+		Query("created_after", "string", "RFC 3339 timestamp or date").
+		Query("created_before", "string", "RFC 3339 timestamp or date").
+		Query("tz", "string", "IANA time zone of dates without an offset, UTC by default")
+}
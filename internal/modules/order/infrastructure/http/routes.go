@@ -0,0 +1,56 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/order/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/conditional"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterOrderRoutes registers order routes
+// Order reads answer with an ETag and 304 Not Modified to a matching If-None-Match; the export streams
+func RegisterOrderRoutes(router *gin.RouterGroup, orderHandler *handlers.OrderHandler, couponHandler *handlers.CouponHandler, returnHandler *handlers.ReturnHandler, shipmentHandler *handlers.ShipmentHandler) {
+	etag := conditional.ETag()
+
+	// Order routes
+	orders := router.Group("/orders")
+	{
+		orders.POST("", orderHandler.CreateOrder)
+		orders.GET("", etag, orderHandler.ListOrders)
+		orders.GET("/search", etag, orderHandler.SearchOrders)
+		orders.GET("/export", orderHandler.ExportOrders)
+		orders.POST("/import", orderHandler.ImportOrders)
+		orders.GET("/import/:id", orderHandler.GetOrderImport)
+		orders.GET("/import/:id/errors", orderHandler.GetOrderImportErrors)
+		orders.GET("/reports/summary", etag, orderHandler.GetOrderSummary)
+		orders.GET("/:id", etag, orderHandler.GetOrder)
+		orders.GET("/:id/history", etag, orderHandler.GetOrderHistory)
+		orders.POST("/:id/lines", orderHandler.AddOrderLine)
+		orders.POST("/:id/cancel", orderHandler.CancelOrder)
+		orders.POST("/:id/returns", returnHandler.CreateReturn)
+		orders.GET("/:id/returns", returnHandler.ListOrderReturns)
+		orders.POST("/:id/shipments", shipmentHandler.CreateShipment)
+		orders.GET("/:id/shipments", shipmentHandler.ListOrderShipments)
+		orders.POST("/:id/shipments/:shipmentId/status", shipmentHandler.UpdateShipmentStatus)
+	}
+
+	// Carrier tracking notifications
+	router.POST("/shipments/tracking", shipmentHandler.HandleTrackingWebhook)
+
+	// Return (RMA) routes
+	returns := router.Group("/returns")
+	{
+		returns.GET("/:id", returnHandler.GetReturn)
+		returns.POST("/:id/approve", returnHandler.ApproveReturn)
+		returns.POST("/:id/reject", returnHandler.RejectReturn)
+	}
+
+	// Coupon management routes
+	coupons := router.Group("/coupons")
+	{
+		coupons.POST("", couponHandler.CreateCoupon)
+		coupons.GET("", couponHandler.ListCoupons)
+		coupons.GET("/:code", couponHandler.GetCoupon)
+		coupons.PATCH("/:code", couponHandler.UpdateCoupon)
+	}
+}
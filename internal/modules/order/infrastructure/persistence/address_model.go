@@ -0,0 +1,73 @@
+package persistence
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+)
+
+// AddressModel represents an address snapshot stored in a JSONB column
+type AddressModel struct {
+	Name       string `json:"name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+	Phone      string `json:"phone,omitempty"`
+}
+
+// Value implements driver.Valuer
+func (a AddressModel) Value() (driver.Value, error) {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal address: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (a *AddressModel) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported address value type: %T", value)
+	}
+
+	if err := json.Unmarshal(data, a); err != nil {
+		return fmt.Errorf("failed to unmarshal address: %w", err)
+	}
+
+	return nil
+}
+
+// GormDataType returns the GORM data type
+func (AddressModel) GormDataType() string {
+	return "jsonb"
+}
+
+// NewAddressModel converts a domain address to its stored representation
+func NewAddressModel(address *domain.Address) *AddressModel {
+	if address == nil {
+		return nil
+	}
+	model := AddressModel(*address)
+	return &model
+}
+
+// toDomainAddress converts a stored address to a domain address
+func toDomainAddress(model *AddressModel) *domain.Address {
+	if model == nil {
+		return nil
+	}
+	address := domain.Address(*model)
+	return &address
+}
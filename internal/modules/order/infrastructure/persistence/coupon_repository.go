@@ -0,0 +1,301 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+	orderdb "golang_modular_monolith/internal/modules/order/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CouponModel represents the coupon database model
+// Codes are unique within a tenant, so tenants name their coupons independently
+type CouponModel struct {
+	TenantID                  string     `gorm:"primaryKey;type:varchar(64);default:''"`
+	Code                      string     `gorm:"primaryKey;type:varchar(64)"`
+	Description               string     `gorm:"type:varchar(255);not null;default:''"`
+	Type                      string     `gorm:"type:varchar(16);not null"`
+	Value                     int64      `gorm:"not null"`
+	Currency                  *string    `gorm:"type:char(3)"`
+	ValidFrom                 *time.Time `gorm:"type:timestamp with time zone"`
+	ValidUntil                *time.Time `gorm:"type:timestamp with time zone"`
+	MaxRedemptions            int        `gorm:"not null;default:0"`
+	MaxRedemptionsPerCustomer int        `gorm:"not null;default:0"`
+	RedemptionCount           int        `gorm:"not null;default:0"`
+	Active                    bool       `gorm:"not null;default:true"`
+	CreatedAt                 time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt                 time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (CouponModel) TableName() string {
+	return "coupons"
+}
+
+// CouponRedemptionModel represents the use of a coupon by an order
+type CouponRedemptionModel struct {
+	ID         int64      `gorm:"primaryKey;autoIncrement"`
+	TenantID   string     `gorm:"type:varchar(64);not null;default:''"`
+	CouponCode string     `gorm:"type:varchar(64);not null"`
+	OrderID    string     `gorm:"type:varchar(36);not null"`
+	CustomerID string     `gorm:"type:varchar(36);not null"`
+	RedeemedAt time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	ReleasedAt *time.Time `gorm:"type:timestamp with time zone"`
+}
+
+// TableName returns the table name for GORM
+func (CouponRedemptionModel) TableName() string {
+	return "coupon_redemptions"
+}
+
+// ToEntity converts database model to domain entity
+func (m *CouponModel) ToEntity() *domain.Coupon {
+	coupon := &domain.Coupon{
+		Code:                      m.Code,
+		Description:               m.Description,
+		Type:                      domain.CouponType(m.Type),
+		Value:                     m.Value,
+		ValidFrom:                 m.ValidFrom,
+		ValidUntil:                m.ValidUntil,
+		MaxRedemptions:            m.MaxRedemptions,
+		MaxRedemptionsPerCustomer: m.MaxRedemptionsPerCustomer,
+		RedemptionCount:           m.RedemptionCount,
+		Active:                    m.Active,
+		CreatedAt:                 m.CreatedAt,
+		UpdatedAt:                 m.UpdatedAt,
+	}
+	if m.Currency != nil {
+		coupon.Currency = *m.Currency
+	}
+	return coupon
+}
+
+// FromEntity converts domain entity to database model
+func (m *CouponModel) FromEntity(coupon *domain.Coupon) {
+	m.Code = coupon.Code
+	m.Description = coupon.Description
+	m.Type = string(coupon.Type)
+	m.Value = coupon.Value
+	m.Currency = nil
+	if coupon.Currency != "" {
+		currency := coupon.Currency
+		m.Currency = &currency
+	}
+	m.ValidFrom = coupon.ValidFrom
+	m.ValidUntil = coupon.ValidUntil
+	m.MaxRedemptions = coupon.MaxRedemptions
+	m.MaxRedemptionsPerCustomer = coupon.MaxRedemptionsPerCustomer
+	m.RedemptionCount = coupon.RedemptionCount
+	m.Active = coupon.Active
+	m.CreatedAt = coupon.CreatedAt
+	m.UpdatedAt = coupon.UpdatedAt
+}
+
+// PostgreSQLCouponRepository implements CouponRepository using PostgreSQL
+type PostgreSQLCouponRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLCouponRepositoryFromManager creates repository using database manager
+func NewPostgreSQLCouponRepositoryFromManager() (*PostgreSQLCouponRepository, error) {
+	db, err := orderdb.GetOrderDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order database: %w", err)
+	}
+
+	return &PostgreSQLCouponRepository{
+		db: db,
+	}, nil
+}
+
+// Save creates a coupon or updates its settings
+// The redemption count is owned by Redeem and ReleaseRedemptions, so it is never overwritten here
+func (r *PostgreSQLCouponRepository) Save(ctx context.Context, coupon *domain.Coupon) error {
+	model := &CouponModel{}
+	model.FromEntity(coupon)
+
+	result := shareddb.Conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "tenant_id"}, {Name: "code"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"description",
+			"valid_from",
+			"valid_until",
+			"max_redemptions",
+			"max_redemptions_per_customer",
+			"active",
+			"updated_at",
+		}),
+	}).Create(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save coupon: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetByCode retrieves a coupon by its normalized code
+func (r *PostgreSQLCouponRepository) GetByCode(ctx context.Context, code string) (*domain.Coupon, error) {
+	var model CouponModel
+	result := shareddb.Conn(ctx, r.db).Where("code = ?", code).First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get coupon by code: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// ExistsByCode checks if a coupon exists with the given normalized code
+func (r *PostgreSQLCouponRepository) ExistsByCode(ctx context.Context, code string) (bool, error) {
+	var count int64
+	result := shareddb.Conn(ctx, r.db).Model(&CouponModel{}).
+		Where("code = ?", code).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to check coupon existence: %w", result.Error)
+	}
+
+	return count > 0, nil
+}
+
+// List retrieves coupons with pagination, newest first
+func (r *PostgreSQLCouponRepository) List(ctx context.Context, params domain.ListCouponsParams) (*domain.CouponListResult, error) {
+	params.Validate()
+
+	query := shareddb.Conn(ctx, r.db).Model(&CouponModel{})
+	if params.ActiveOnly {
+		query = query.Where("active = ?", true)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count coupons: %w", err)
+	}
+
+	var models []CouponModel
+	result := query.
+		Order("created_at DESC, code ASC").
+		Offset(params.GetOffset()).
+		Limit(params.Limit).
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list coupons: %w", result.Error)
+	}
+
+	coupons := make([]domain.Coupon, len(models))
+	for i := range models {
+		coupons[i] = *models[i].ToEntity()
+	}
+
+	return &domain.CouponListResult{
+		Coupons:    coupons,
+		Pagination: domain.NewPaginationResult(params.Page, params.Limit, total),
+	}, nil
+}
+
+// Redeem atomically records the use of a coupon by an order, enforcing the usage limits
+// The coupon row is locked so that concurrent orders cannot exceed the limits
+func (r *PostgreSQLCouponRepository) Redeem(ctx context.Context, code, customerID, orderID string) error {
+	return shareddb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var coupon CouponModel
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("code = ?", code).First(&coupon)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return shareddomain.ErrNotFound
+			}
+			return fmt.Errorf("failed to lock coupon: %w", result.Error)
+		}
+
+		var existing int64
+		if err := tx.Model(&CouponRedemptionModel{}).
+			Where("coupon_code = ? AND order_id = ? AND released_at IS NULL", code, orderID).
+			Count(&existing).Error; err != nil {
+			return fmt.Errorf("failed to check coupon redemption: %w", err)
+		}
+		if existing > 0 {
+			return nil
+		}
+
+		if coupon.MaxRedemptions > 0 && coupon.RedemptionCount >= coupon.MaxRedemptions {
+			return shareddomain.NewBusinessRuleError("coupon_exhausted", fmt.Sprintf("coupon %s has reached its redemption limit", code))
+		}
+
+		if coupon.MaxRedemptionsPerCustomer > 0 {
+			var used int64
+			if err := tx.Model(&CouponRedemptionModel{}).
+				Where("coupon_code = ? AND customer_id = ? AND released_at IS NULL", code, customerID).
+				Count(&used).Error; err != nil {
+				return fmt.Errorf("failed to count customer coupon redemptions: %w", err)
+			}
+			if used >= int64(coupon.MaxRedemptionsPerCustomer) {
+				return shareddomain.NewBusinessRuleError(
+					"coupon_customer_limit",
+					fmt.Sprintf("coupon %s can be used at most %d times per customer", code, coupon.MaxRedemptionsPerCustomer),
+				)
+			}
+		}
+
+		// A released redemption of the same order is reused so the (coupon, order) pair stays unique
+		redemption := &CouponRedemptionModel{
+			CouponCode: code,
+			OrderID:    orderID,
+			CustomerID: customerID,
+			RedeemedAt: time.Now(),
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "coupon_code"}, {Name: "order_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"redeemed_at": redemption.RedeemedAt, "released_at": nil}),
+		}).Create(redemption).Error; err != nil {
+			return fmt.Errorf("failed to record coupon redemption: %w", err)
+		}
+
+		if err := tx.Model(&CouponModel{}).
+			Where("code = ?", code).
+			Update("redemption_count", gorm.Expr("redemption_count + 1")).Error; err != nil {
+			return fmt.Errorf("failed to update coupon redemption count: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ReleaseRedemptions gives back the coupon uses of an order that did not go through
+// Releasing an order twice is a no-op
+func (r *PostgreSQLCouponRepository) ReleaseRedemptions(ctx context.Context, orderID string) error {
+	return shareddb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var redemptions []CouponRedemptionModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("order_id = ? AND released_at IS NULL", orderID).
+			Find(&redemptions).Error; err != nil {
+			return fmt.Errorf("failed to get coupon redemptions: %w", err)
+		}
+
+		now := time.Now()
+		for _, redemption := range redemptions {
+			if err := tx.Model(&CouponRedemptionModel{}).
+				Where("id = ?", redemption.ID).
+				Update("released_at", now).Error; err != nil {
+				return fmt.Errorf("failed to release coupon redemption: %w", err)
+			}
+
+			if err := tx.Model(&CouponModel{}).
+				Where("code = ? AND redemption_count > 0", redemption.CouponCode).
+				Update("redemption_count", gorm.Expr("redemption_count - 1")).Error; err != nil {
+				return fmt.Errorf("failed to update coupon redemption count: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
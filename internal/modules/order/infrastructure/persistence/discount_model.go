@@ -0,0 +1,98 @@
+package persistence
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// OrderDiscountModel represents a discount line stored in a JSONB column
+// Amounts are in the order currency's minor unit
+type OrderDiscountModel struct {
+	CouponCode  string `json:"coupon_code"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type"`
+	Value       int64  `json:"value"`
+	Amount      int64  `json:"amount"`
+}
+
+// OrderDiscountModels is the list of discount lines stored in a JSONB column
+type OrderDiscountModels []OrderDiscountModel
+
+// Value implements driver.Valuer
+func (d OrderDiscountModels) Value() (driver.Value, error) {
+	if d == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal order discounts: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (d *OrderDiscountModels) Scan(value interface{}) error {
+	if value == nil {
+		*d = OrderDiscountModels{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported order discounts value type: %T", value)
+	}
+
+	result := OrderDiscountModels{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("failed to unmarshal order discounts: %w", err)
+	}
+
+	*d = result
+	return nil
+}
+
+// GormDataType returns the GORM data type
+func (OrderDiscountModels) GormDataType() string {
+	return "jsonb"
+}
+
+// NewOrderDiscountModels converts domain discount lines to their stored representation
+func NewOrderDiscountModels(discounts []domain.OrderDiscount) OrderDiscountModels {
+	models := make(OrderDiscountModels, len(discounts))
+	for i, discount := range discounts {
+		models[i] = OrderDiscountModel{
+			CouponCode:  discount.CouponCode,
+			Description: discount.Description,
+			Type:        string(discount.Type),
+			Value:       discount.Value,
+			Amount:      discount.Amount.Amount,
+		}
+	}
+	return models
+}
+
+// toDomainDiscounts converts stored discount lines to domain discount lines
+func toDomainDiscounts(models OrderDiscountModels, currency string) []domain.OrderDiscount {
+	discounts := make([]domain.OrderDiscount, len(models))
+	for i, model := range models {
+		discounts[i] = domain.OrderDiscount{
+			CouponCode:  model.CouponCode,
+			Description: model.Description,
+			Type:        domain.CouponType(model.Type),
+			Value:       model.Value,
+			Amount:      shareddomain.Money{Amount: model.Amount, Currency: currency},
+		}
+	}
+	return discounts
+}
@@ -0,0 +1,62 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+)
+
+// OrderEventModel represents a row in the order status history
+// Rows are appended by the order history projection, never updated
+type OrderEventModel struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement"`
+	EventID    *string   `gorm:"type:varchar(36);uniqueIndex"`
+	TenantID   string    `gorm:"type:varchar(64);not null;default:''"`
+	OrderID    string    `gorm:"type:varchar(36);not null;index"`
+	EventType  string    `gorm:"type:varchar(64);not null"`
+	FromStatus *string   `gorm:"type:order_status"`
+	ToStatus   string    `gorm:"type:order_status;not null"`
+	ReasonCode string    `gorm:"type:varchar(64);not null;default:''"`
+	Reason     string    `gorm:"type:text;not null;default:''"`
+	Actor      string    `gorm:"type:varchar(255);not null"`
+	OccurredAt time.Time `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (OrderEventModel) TableName() string {
+	return "order_events"
+}
+
+// GetHistory retrieves the status changes of an order, oldest first
+func (r *PostgreSQLOrderQueryRepository) GetHistory(ctx context.Context, orderID string) ([]domain.OrderHistoryEntry, error) {
+	var models []OrderEventModel
+	result := shareddb.Conn(ctx, r.db).
+		Where("order_id = ?", orderID).
+		Order("occurred_at ASC, id ASC").
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get order history: %w", result.Error)
+	}
+
+	entries := make([]domain.OrderHistoryEntry, len(models))
+	for i, model := range models {
+		entries[i] = domain.OrderHistoryEntry{
+			ID:         model.ID,
+			OrderID:    model.OrderID,
+			EventType:  model.EventType,
+			ToStatus:   domain.OrderStatus(model.ToStatus),
+			ReasonCode: model.ReasonCode,
+			Reason:     model.Reason,
+			Actor:      model.Actor,
+			OccurredAt: model.OccurredAt,
+		}
+		if model.FromStatus != nil {
+			entries[i].FromStatus = domain.OrderStatus(*model.FromStatus)
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,78 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+	orderdb "golang_modular_monolith/internal/modules/order/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// OrderNumberCounterModel represents the last order number allocated for a prefix and year
+// Each tenant numbers its orders on its own
+type OrderNumberCounterModel struct {
+	TenantID  string    `gorm:"primaryKey;type:varchar(64);default:''"`
+	Prefix    string    `gorm:"primaryKey;type:varchar(16)"`
+	Year      int       `gorm:"primaryKey"`
+	LastValue int64     `gorm:"not null;default:0"`
+	UpdatedAt time.Time `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (OrderNumberCounterModel) TableName() string {
+	return "order_number_counters"
+}
+
+// PostgreSQLOrderNumberGenerator implements OrderNumberGenerator with a counter row per tenant, prefix
+// and year
+// The upsert takes a row lock, so concurrent requests receive distinct, increasing numbers
+type PostgreSQLOrderNumberGenerator struct {
+	db     *gorm.DB
+	prefix string
+}
+
+// NewPostgreSQLOrderNumberGenerator creates a new PostgreSQL order number generator
+func NewPostgreSQLOrderNumberGenerator(db *gorm.DB, prefix string) (*PostgreSQLOrderNumberGenerator, error) {
+	if err := domain.ValidateOrderNumberPrefix(prefix); err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLOrderNumberGenerator{
+		db:     db,
+		prefix: prefix,
+	}, nil
+}
+
+// NewPostgreSQLOrderNumberGeneratorFromManager creates the generator using database manager
+func NewPostgreSQLOrderNumberGeneratorFromManager(prefix string) (*PostgreSQLOrderNumberGenerator, error) {
+	db, err := orderdb.GetOrderDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order database: %w", err)
+	}
+
+	return NewPostgreSQLOrderNumberGenerator(db, prefix)
+}
+
+// Next allocates the next order number of the context's tenant for the year of the given time (UTC)
+func (g *PostgreSQLOrderNumberGenerator) Next(ctx context.Context, at time.Time) (string, error) {
+	year := at.UTC().Year()
+	tenantID, _ := shareddomain.TenantIDFromContext(ctx)
+
+	var sequence int64
+	err := shareddb.Conn(ctx, g.db).Raw(`
+		INSERT INTO order_number_counters (tenant_id, prefix, year, last_value, updated_at)
+		VALUES (?, ?, ?, 1, NOW())
+		ON CONFLICT (tenant_id, prefix, year)
+		DO UPDATE SET last_value = order_number_counters.last_value + 1, updated_at = NOW()
+		RETURNING last_value`, tenantID, g.prefix, year).Scan(&sequence).Error
+	if err != nil {
+		return "", fmt.Errorf("failed to increment order number counter: %w", err)
+	}
+
+	return domain.FormatOrderNumber(g.prefix, year, sequence), nil
+}
@@ -0,0 +1,302 @@
+package persistence
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+	orderdb "golang_modular_monolith/internal/modules/order/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// OrderLineViewModel represents an order line stored in the order_views JSONB column
+// Amounts are in the order currency's minor unit
+type OrderLineViewModel struct {
+	ID          string `json:"id"`
+	ProductID   string `json:"product_id"`
+	ProductName string `json:"product_name"`
+	Quantity    int    `json:"quantity"`
+	UnitPrice   int64  `json:"unit_price"`
+	LineTotal   int64  `json:"line_total"`
+}
+
+// OrderLineViews is the list of order lines stored in the order_views JSONB column
+type OrderLineViews []OrderLineViewModel
+
+// Value implements driver.Valuer
+func (l OrderLineViews) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(l)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal order lines: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (l *OrderLineViews) Scan(value interface{}) error {
+	if value == nil {
+		*l = OrderLineViews{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported order lines value type: %T", value)
+	}
+
+	result := OrderLineViews{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("failed to unmarshal order lines: %w", err)
+	}
+
+	*l = result
+	return nil
+}
+
+// GormDataType returns the GORM data type
+func (OrderLineViews) GormDataType() string {
+	return "jsonb"
+}
+
+// OrderViewModel represents the denormalized order read model
+// Rows are maintained by the order view projection, never by the write side
+type OrderViewModel struct {
+	ID              string              `gorm:"primaryKey;type:varchar(36)"`
+	TenantID        string              `gorm:"type:varchar(64);not null;default:''"`
+	Number          string              `gorm:"type:varchar(32)"`
+	CustomerID      string              `gorm:"type:varchar(36);not null"`
+	Status          string              `gorm:"type:order_status;not null;default:pending"`
+	Currency        string              `gorm:"type:char(3);not null"`
+	Lines           OrderLineViews      `gorm:"type:jsonb;not null;default:'[]'"`
+	LineCount       int                 `gorm:"not null;default:0"`
+	ItemCount       int                 `gorm:"not null;default:0"`
+	Subtotal        int64               `gorm:"not null;default:0"`
+	Discount        int64               `gorm:"not null;default:0"`
+	Discounts       OrderDiscountModels `gorm:"type:jsonb;not null;default:'[]'"`
+	Tax             int64               `gorm:"not null;default:0"`
+	Total           int64               `gorm:"not null;default:0"`
+	ShippingAddress *AddressModel       `gorm:"type:jsonb"`
+	BillingAddress  *AddressModel       `gorm:"type:jsonb"`
+	Version         int                 `gorm:"not null;default:0"`
+	CreatedAt       time.Time           `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt       time.Time           `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (OrderViewModel) TableName() string {
+	return "order_views"
+}
+
+// PostgreSQLOrderQueryRepository implements OrderQueryRepository using PostgreSQL
+type PostgreSQLOrderQueryRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLOrderQueryRepository creates a new PostgreSQL order query repository
+func NewPostgreSQLOrderQueryRepository(db *gorm.DB) *PostgreSQLOrderQueryRepository {
+	return &PostgreSQLOrderQueryRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLOrderQueryRepositoryFromManager creates repository using database manager
+func NewPostgreSQLOrderQueryRepositoryFromManager() (*PostgreSQLOrderQueryRepository, error) {
+	db, err := orderdb.GetOrderDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order database: %w", err)
+	}
+
+	return &PostgreSQLOrderQueryRepository{
+		db: db,
+	}, nil
+}
+
+// toOrderView converts OrderViewModel to OrderView
+func (r *PostgreSQLOrderQueryRepository) toOrderView(model *OrderViewModel) *domain.OrderView {
+	lines := make([]domain.OrderLineView, len(model.Lines))
+	for i, line := range model.Lines {
+		lines[i] = domain.OrderLineView{
+			ID:          line.ID,
+			ProductID:   line.ProductID,
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+			UnitPrice:   shareddomain.Money{Amount: line.UnitPrice, Currency: model.Currency},
+			LineTotal:   shareddomain.Money{Amount: line.LineTotal, Currency: model.Currency},
+		}
+	}
+
+	return &domain.OrderView{
+		ID:              model.ID,
+		Number:          model.Number,
+		CustomerID:      model.CustomerID,
+		Status:          domain.OrderStatus(model.Status),
+		Currency:        model.Currency,
+		Lines:           lines,
+		LineCount:       model.LineCount,
+		ItemCount:       model.ItemCount,
+		Subtotal:        shareddomain.Money{Amount: model.Subtotal, Currency: model.Currency},
+		Discounts:       toDomainDiscounts(model.Discounts, model.Currency),
+		Discount:        shareddomain.Money{Amount: model.Discount, Currency: model.Currency},
+		Tax:             shareddomain.Money{Amount: model.Tax, Currency: model.Currency},
+		Total:           shareddomain.Money{Amount: model.Total, Currency: model.Currency},
+		ShippingAddress: toDomainAddress(model.ShippingAddress),
+		BillingAddress:  toDomainAddress(model.BillingAddress),
+		CreatedAt:       model.CreatedAt,
+		UpdatedAt:       model.UpdatedAt,
+	}
+}
+
+// GetByID retrieves an order view by ID
+func (r *PostgreSQLOrderQueryRepository) GetByID(ctx context.Context, id string) (*domain.OrderView, error) {
+	var model OrderViewModel
+	result := shareddb.Conn(ctx, r.db).Where("id = ?", id).First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get order by ID: %w", result.Error)
+	}
+
+	return r.toOrderView(&model), nil
+}
+
+// List retrieves orders with pagination and filtering
+func (r *PostgreSQLOrderQueryRepository) List(ctx context.Context, params domain.ListOrdersParams) (*domain.OrderListResult, error) {
+	// Validate parameters
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Build query
+	query := shareddb.Conn(ctx, r.db).Model(&OrderViewModel{})
+
+	// Apply filters
+	query = r.applyListFilters(query, params)
+
+	return r.find(query, params, "list")
+}
+
+// Search searches orders by various criteria
+func (r *PostgreSQLOrderQueryRepository) Search(ctx context.Context, params domain.SearchOrdersParams) (*domain.OrderListResult, error) {
+	// Validate parameters
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Build query
+	query := shareddb.Conn(ctx, r.db).Model(&OrderViewModel{})
+
+	// Apply filters
+	query = r.applyListFilters(query, params.ListOrdersParams)
+
+	// Apply search criteria
+	query = r.applySearchFilters(query, params)
+
+	return r.find(query, params.ListOrdersParams, "search")
+}
+
+// find counts, paginates and loads the filtered order views
+func (r *PostgreSQLOrderQueryRepository) find(query *gorm.DB, params domain.ListOrdersParams, operation string) (*domain.OrderListResult, error) {
+	// Count total records
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count orders: %w", err)
+	}
+
+	// Apply pagination and sorting (id breaks ties so pages are stable)
+	query = query.Offset(params.GetOffset()).Limit(params.Limit)
+	query = query.Order(fmt.Sprintf("%s %s, id %s", params.SortBy, params.SortOrder, params.SortOrder))
+
+	// Execute query
+	var models []OrderViewModel
+	if err := query.Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to %s orders: %w", operation, err)
+	}
+
+	// Convert to views
+	orders := make([]domain.OrderView, len(models))
+	for i, model := range models {
+		orders[i] = *r.toOrderView(&model)
+	}
+
+	return &domain.OrderListResult{
+		Orders:     orders,
+		Pagination: domain.NewPaginationResult(params.Page, params.Limit, total),
+	}, nil
+}
+
+// applyListFilters applies common list filters to the query
+func (r *PostgreSQLOrderQueryRepository) applyListFilters(query *gorm.DB, params domain.ListOrdersParams) *gorm.DB {
+	// Status filter
+	if len(params.Statuses) > 0 {
+		query = query.Where("status IN ?", params.Statuses)
+	}
+
+	if params.CustomerID != "" {
+		query = query.Where("customer_id = ?", params.CustomerID)
+	}
+
+	if params.Currency != "" {
+		query = query.Where("currency = ?", strings.ToUpper(params.Currency))
+	}
+
+	// Total filters
+	if params.MinTotal != nil {
+		query = query.Where("total >= ?", *params.MinTotal)
+	}
+
+	if params.MaxTotal != nil {
+		query = query.Where("total <= ?", *params.MaxTotal)
+	}
+
+	// Date filters
+	if params.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", *params.CreatedAfter)
+	}
+
+	if params.CreatedBefore != nil {
+		query = query.Where("created_at <= ?", *params.CreatedBefore)
+	}
+
+	return query
+}
+
+// applySearchFilters applies search-specific filters to the query
+func (r *PostgreSQLOrderQueryRepository) applySearchFilters(query *gorm.DB, params domain.SearchOrdersParams) *gorm.DB {
+	// General search query (order ID or order number prefix, or product name)
+	if params.Query != "" {
+		term := strings.ToLower(params.Query)
+		query = query.Where(
+			"(id LIKE ? OR number LIKE ? OR EXISTS (SELECT 1 FROM jsonb_array_elements(lines) AS line WHERE LOWER(line->>'product_name') LIKE ?))",
+			term+"%", strings.ToUpper(params.Query)+"%", "%"+term+"%",
+		)
+	}
+
+	// Product containment uses the GIN index on lines
+	if params.ProductID != "" {
+		if filter, err := json.Marshal([]map[string]string{{"product_id": params.ProductID}}); err == nil {
+			query = query.Where("lines @> ?::jsonb", string(filter))
+		}
+	}
+
+	return query
+}
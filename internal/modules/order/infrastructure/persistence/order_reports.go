@@ -0,0 +1,103 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+)
+
+// orderSummaryRow is a single aggregated row of the order summary report
+type orderSummaryRow struct {
+	GroupKey   string
+	Currency   string
+	OrderCount int64
+	Revenue    int64
+	Discount   int64
+	Tax        int64
+}
+
+// Export streams every order matching the filters to fn in the requested sort order
+// Rows are read from a cursor one at a time, so large exports do not load the whole result set
+func (r *PostgreSQLOrderQueryRepository) Export(ctx context.Context, params domain.ListOrdersParams, fn func(domain.OrderView) error) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	query := shareddb.Conn(ctx, r.db).Model(&OrderViewModel{})
+	query = r.applyListFilters(query, params)
+	query = query.Order(fmt.Sprintf("%s %s, id %s", params.SortBy, params.SortOrder, params.SortOrder))
+
+	rows, err := query.Rows()
+	if err != nil {
+		return fmt.Errorf("failed to export orders: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var model OrderViewModel
+		if err := r.db.ScanRows(rows, &model); err != nil {
+			return fmt.Errorf("failed to scan exported order: %w", err)
+		}
+		if err := fn(*r.toOrderView(&model)); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to export orders: %w", err)
+	}
+	return nil
+}
+
+// Summarize aggregates order counts and revenue per group and currency
+// Day buckets are computed in the requested time zone; the covering index on
+// (created_at, status) keeps the aggregation off the JSONB columns
+func (r *PostgreSQLOrderQueryRepository) Summarize(ctx context.Context, params domain.OrderSummaryParams) ([]domain.OrderSummaryGroup, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	var keyExpr string
+	var keyArgs []interface{}
+	switch params.GroupBy {
+	case domain.OrderSummaryByStatus:
+		keyExpr = "status::text"
+	default:
+		keyExpr = "to_char(created_at AT TIME ZONE ?, 'YYYY-MM-DD')"
+		keyArgs = append(keyArgs, params.Timezone)
+	}
+
+	cancelled := string(domain.OrderStatusCancelled)
+	args := append(keyArgs, cancelled, cancelled, cancelled)
+
+	query := shareddb.Conn(ctx, r.db).Model(&OrderViewModel{}).
+		Select(keyExpr+` AS group_key,
+			currency,
+			COUNT(*) AS order_count,
+			COALESCE(SUM(total) FILTER (WHERE status <> ?), 0) AS revenue,
+			COALESCE(SUM(discount) FILTER (WHERE status <> ?), 0) AS discount,
+			COALESCE(SUM(tax) FILTER (WHERE status <> ?), 0) AS tax`, args...)
+	query = r.applyListFilters(query, params.ListOrdersParams)
+
+	var rows []orderSummaryRow
+	if err := query.Group("group_key, currency").Order("group_key ASC, currency ASC").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to summarize orders: %w", err)
+	}
+
+	groups := make([]domain.OrderSummaryGroup, len(rows))
+	for i, row := range rows {
+		groups[i] = domain.OrderSummaryGroup{
+			Key:        row.GroupKey,
+			Currency:   row.Currency,
+			OrderCount: row.OrderCount,
+			Revenue:    shareddomain.Money{Amount: row.Revenue, Currency: row.Currency},
+			Discount:   shareddomain.Money{Amount: row.Discount, Currency: row.Currency},
+			Tax:        shareddomain.Money{Amount: row.Tax, Currency: row.Currency},
+		}
+	}
+
+	return groups, nil
+}
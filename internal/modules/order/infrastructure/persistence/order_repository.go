@@ -0,0 +1,295 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+	orderdb "golang_modular_monolith/internal/modules/order/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OrderModel represents the order database model
+type OrderModel struct {
+	ID              string              `gorm:"primaryKey;type:varchar(36)"`
+	TenantID        string              `gorm:"type:varchar(64);not null;default:'';uniqueIndex:idx_orders_tenant_number"`
+	Number          string              `gorm:"type:varchar(32);not null;uniqueIndex:idx_orders_tenant_number"`
+	CustomerID      string              `gorm:"type:varchar(36);not null;index"`
+	Status          string              `gorm:"type:order_status;not null;default:pending"`
+	Currency        string              `gorm:"type:char(3);not null"`
+	Subtotal        int64               `gorm:"not null;default:0"`
+	Discount        int64               `gorm:"not null;default:0"`
+	Discounts       OrderDiscountModels `gorm:"type:jsonb;not null;default:'[]'"`
+	Tax             int64               `gorm:"not null;default:0"`
+	Total           int64               `gorm:"not null;default:0"`
+	TaxRateBps      int64               `gorm:"column:tax_rate_bps;not null;default:0"`
+	TaxRounding     string              `gorm:"type:varchar(16);not null;default:half_up"`
+	ShippingAddress *AddressModel       `gorm:"type:jsonb"`
+	BillingAddress  *AddressModel       `gorm:"type:jsonb"`
+	Version         int                 `gorm:"not null;default:0"`
+	Lines           []OrderLineModel    `gorm:"foreignKey:OrderID"`
+	Shipments       []ShipmentModel     `gorm:"foreignKey:OrderID"`
+	CreatedAt       time.Time           `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt       time.Time           `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (OrderModel) TableName() string {
+	return "orders"
+}
+
+// OrderLineModel represents the order line database model
+type OrderLineModel struct {
+	ID          string    `gorm:"primaryKey;type:varchar(36)"`
+	OrderID     string    `gorm:"type:varchar(36);not null;index"`
+	Position    int       `gorm:"not null"`
+	ProductID   string    `gorm:"type:varchar(36);not null"`
+	ProductName string    `gorm:"type:varchar(255);not null;default:''"`
+	Quantity    int       `gorm:"not null"`
+	UnitPrice   int64     `gorm:"not null"`
+	LineTotal   int64     `gorm:"not null"`
+	CreatedAt   time.Time `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (OrderLineModel) TableName() string {
+	return "order_lines"
+}
+
+// ToEntity converts database model to domain entity
+func (m *OrderModel) ToEntity() *domain.Order {
+	order := &domain.Order{
+		BaseAggregateRoot: shareddomain.NewBaseAggregateRootWithID(m.ID),
+		Number:            m.Number,
+		CustomerID:        m.CustomerID,
+		Status:            domain.OrderStatus(m.Status),
+		Currency:          m.Currency,
+		Lines:             make([]domain.OrderLine, len(m.Lines)),
+		TaxPolicy: domain.TaxPolicy{
+			RateBasisPoints: m.TaxRateBps,
+			Rounding:        shareddomain.RoundingMode(m.TaxRounding),
+		},
+		Subtotal:        shareddomain.Money{Amount: m.Subtotal, Currency: m.Currency},
+		Discounts:       toDomainDiscounts(m.Discounts, m.Currency),
+		Discount:        shareddomain.Money{Amount: m.Discount, Currency: m.Currency},
+		Tax:             shareddomain.Money{Amount: m.Tax, Currency: m.Currency},
+		Total:           shareddomain.Money{Amount: m.Total, Currency: m.Currency},
+		ShippingAddress: toDomainAddress(m.ShippingAddress),
+		BillingAddress:  toDomainAddress(m.BillingAddress),
+		Shipments:       make([]domain.Shipment, len(m.Shipments)),
+	}
+
+	for i, shipment := range m.Shipments {
+		order.Shipments[i] = toDomainShipment(shipment)
+	}
+
+	for i, line := range m.Lines {
+		order.Lines[i] = domain.OrderLine{
+			ID:          line.ID,
+			ProductID:   line.ProductID,
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+			UnitPrice:   shareddomain.Money{Amount: line.UnitPrice, Currency: m.Currency},
+			LineTotal:   shareddomain.Money{Amount: line.LineTotal, Currency: m.Currency},
+		}
+	}
+
+	// Set version and timestamps from database
+	order.Version = m.Version
+	order.CreatedAt = m.CreatedAt
+	order.UpdatedAt = m.UpdatedAt
+
+	return order
+}
+
+// FromEntity converts domain entity to database model
+func (m *OrderModel) FromEntity(order *domain.Order) {
+	m.ID = order.GetID()
+	m.Number = order.Number
+	m.CustomerID = order.CustomerID
+	m.Status = string(order.Status)
+	m.Currency = order.Currency
+	m.Subtotal = order.Subtotal.Amount
+	m.Discount = order.Discount.Amount
+	m.Discounts = NewOrderDiscountModels(order.Discounts)
+	m.Tax = order.Tax.Amount
+	m.Total = order.Total.Amount
+	m.TaxRateBps = order.TaxPolicy.RateBasisPoints
+	m.TaxRounding = string(order.TaxPolicy.Rounding)
+	m.ShippingAddress = NewAddressModel(order.ShippingAddress)
+	m.BillingAddress = NewAddressModel(order.BillingAddress)
+	m.Version = order.GetVersion()
+	m.CreatedAt = order.GetCreatedAt()
+	m.UpdatedAt = order.GetUpdatedAt()
+
+	m.Lines = make([]OrderLineModel, len(order.Lines))
+	for i, line := range order.Lines {
+		m.Lines[i] = OrderLineModel{
+			ID:          line.ID,
+			OrderID:     order.GetID(),
+			Position:    i + 1,
+			ProductID:   line.ProductID,
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+			UnitPrice:   line.UnitPrice.Amount,
+			LineTotal:   line.LineTotal.Amount,
+		}
+	}
+
+	m.Shipments = make([]ShipmentModel, len(order.Shipments))
+	for i, shipment := range order.Shipments {
+		m.Shipments[i] = newShipmentModel(order.GetID(), i+1, shipment)
+	}
+}
+
+// PostgreSQLOrderRepository implements OrderRepository using PostgreSQL
+type PostgreSQLOrderRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLOrderRepository creates a new PostgreSQL order repository
+func NewPostgreSQLOrderRepository(db *gorm.DB) *PostgreSQLOrderRepository {
+	return &PostgreSQLOrderRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLOrderRepositoryFromManager creates repository using database manager
+func NewPostgreSQLOrderRepositoryFromManager() (*PostgreSQLOrderRepository, error) {
+	db, err := orderdb.GetOrderDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order database: %w", err)
+	}
+
+	return &PostgreSQLOrderRepository{
+		db: db,
+	}, nil
+}
+
+// Save saves an order and replaces its lines in a single transaction
+func (r *PostgreSQLOrderRepository) Save(ctx context.Context, order *domain.Order) error {
+	model := &OrderModel{}
+	model.FromEntity(order)
+	lines := model.Lines
+	shipments := model.Shipments
+	model.Lines = nil
+	model.Shipments = nil
+
+	err := shareddb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(model).Error; err != nil {
+			return fmt.Errorf("failed to save order: %w", err)
+		}
+
+		// Lines are owned by the aggregate, so the stored set is replaced wholesale
+		if err := tx.Where("order_id = ?", model.ID).Delete(&OrderLineModel{}).Error; err != nil {
+			return fmt.Errorf("failed to replace order lines: %w", err)
+		}
+		if len(lines) > 0 {
+			if err := tx.Create(&lines).Error; err != nil {
+				return fmt.Errorf("failed to save order lines: %w", err)
+			}
+		}
+
+		if err := saveShipments(tx, shipments); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Clear uncommitted events after successful save
+	order.ClearUncommittedEvents()
+
+	return nil
+}
+
+// GetByID retrieves an order with its lines by ID
+func (r *PostgreSQLOrderRepository) GetByID(ctx context.Context, id string) (*domain.Order, error) {
+	var model OrderModel
+	result := r.withAggregate(ctx).
+		Where("id = ?", id).
+		First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get order by ID: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// GetByShipmentTracking retrieves the order owning the shipment with the given carrier and tracking number
+func (r *PostgreSQLOrderRepository) GetByShipmentTracking(ctx context.Context, carrier, trackingNumber string) (*domain.Order, error) {
+	var model OrderModel
+	result := r.withAggregate(ctx).
+		Where("id = (?)", r.db.Model(&ShipmentModel{}).
+			Select("order_id").
+			Where("carrier = ? AND tracking_number = ?", carrier, trackingNumber)).
+		First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get order by shipment tracking number: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// withAggregate preloads the entities owned by the order aggregate
+func (r *PostgreSQLOrderRepository) withAggregate(ctx context.Context) *gorm.DB {
+	byPosition := func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	}
+
+	return shareddb.Conn(ctx, r.db).
+		Preload("Lines", byPosition).
+		Preload("Shipments", byPosition).
+		Preload("Shipments.Lines", byPosition)
+}
+
+// saveShipments upserts the order's shipments
+// Shipment lines never change once created, so existing ones are left untouched
+func saveShipments(tx *gorm.DB, shipments []ShipmentModel) error {
+	for i := range shipments {
+		lines := shipments[i].Lines
+		shipments[i].Lines = nil
+
+		if err := tx.Save(&shipments[i]).Error; err != nil {
+			return fmt.Errorf("failed to save order shipment: %w", err)
+		}
+		if len(lines) > 0 {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&lines).Error; err != nil {
+				return fmt.Errorf("failed to save order shipment lines: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Exists checks if an order exists by ID
+func (r *PostgreSQLOrderRepository) Exists(ctx context.Context, id string) (bool, error) {
+	var count int64
+	result := shareddb.Conn(ctx, r.db).Model(&OrderModel{}).
+		Where("id = ?", id).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to check order existence: %w", result.Error)
+	}
+
+	return count > 0, nil
+}
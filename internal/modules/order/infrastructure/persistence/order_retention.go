@@ -0,0 +1,147 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+	"golang_modular_monolith/internal/shared/infrastructure/retention"
+
+	"gorm.io/gorm"
+)
+
+// DeliveredOrdersPolicyName is the name of the retention policy of delivered orders
+const DeliveredOrdersPolicyName = "delivered_orders"
+
+// archiveDeliveredOrdersSQL copies a batch of delivered orders, with their lines, shipments and
+// returns, to orders_archive and returns their documents; the batch is locked until it is deleted,
+// and orders locked by other transactions are left for the next batch
+const archiveDeliveredOrdersSQL = `
+	WITH batch AS (
+		SELECT id FROM orders
+		WHERE status = ? AND updated_at < ?
+		ORDER BY updated_at, id
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED
+	)
+	INSERT INTO orders_archive (id, number, customer_id, status, currency, total, document, created_at, updated_at, archived_at)
+	SELECT o.id, o.number, o.customer_id, o.status::text, o.currency, o.total,
+		to_jsonb(o) || jsonb_build_object(
+			'lines', COALESCE((
+				SELECT jsonb_agg(to_jsonb(l) ORDER BY l.position)
+				FROM order_lines l WHERE l.order_id = o.id
+			), '[]'::jsonb),
+			'shipments', COALESCE((
+				SELECT jsonb_agg(to_jsonb(s) || jsonb_build_object('lines', COALESCE((
+					SELECT jsonb_agg(to_jsonb(sl) ORDER BY sl.position)
+					FROM order_shipment_lines sl WHERE sl.shipment_id = s.id
+				), '[]'::jsonb)) ORDER BY s.position)
+				FROM order_shipments s WHERE s.order_id = o.id
+			), '[]'::jsonb),
+			'returns', COALESCE((
+				SELECT jsonb_agg(to_jsonb(r) || jsonb_build_object('lines', COALESCE((
+					SELECT jsonb_agg(to_jsonb(rl) ORDER BY rl.position)
+					FROM order_return_lines rl WHERE rl.return_id = r.id
+				), '[]'::jsonb)) ORDER BY r.created_at, r.id)
+				FROM order_returns r WHERE r.order_id = o.id
+			), '[]'::jsonb)
+		),
+		o.created_at, o.updated_at, NOW()
+	FROM orders o JOIN batch b ON b.id = o.id
+	ON CONFLICT (id) DO UPDATE SET document = EXCLUDED.document, archived_at = EXCLUDED.archived_at
+	RETURNING id, document`
+
+// archivedOrder is an order copied to orders_archive
+type archivedOrder struct {
+	ID       string
+	Document []byte
+}
+
+// DeliveredOrdersPolicy moves orders delivered longer ago than the retention period from the orders
+// table, with their lines, shipments and returns, to the orders_archive cold table
+// The order_views read model and the order_events history are kept, so archived orders still show
+// in lists, reports and timelines
+type DeliveredOrdersPolicy struct {
+	db        *gorm.DB
+	retention time.Duration
+}
+
+// NewDeliveredOrdersPolicy creates the retention policy of delivered orders
+func NewDeliveredOrdersPolicy(db *gorm.DB, retention time.Duration) *DeliveredOrdersPolicy {
+	return &DeliveredOrdersPolicy{db: db, retention: retention}
+}
+
+// Name returns the policy name
+func (p *DeliveredOrdersPolicy) Name() string {
+	return DeliveredOrdersPolicyName
+}
+
+// AggregateType returns the type of the archived records
+func (p *DeliveredOrdersPolicy) AggregateType() string {
+	return "Order"
+}
+
+// Retention returns how long delivered orders are kept in the orders table
+func (p *DeliveredOrdersPolicy) Retention() time.Duration {
+	return p.retention
+}
+
+// Apply archives a batch of the orders delivered before the cutoff, also storing them in the object
+// archive of the run when it has one; a dry run counts them and lists the first ones
+func (p *DeliveredOrdersPolicy) Apply(ctx context.Context, run retention.Run) (retention.Outcome, error) {
+	var outcome retention.Outcome
+	db := shareddb.Conn(ctx, p.db)
+
+	if run.DryRun {
+		due := func() *gorm.DB {
+			return db.Table("orders").Where("status = ? AND updated_at < ?", domain.OrderStatusDelivered, run.Cutoff)
+		}
+		if err := due().Count(&outcome.Due).Error; err != nil {
+			return outcome, fmt.Errorf("failed to count delivered orders: %w", err)
+		}
+		err := due().Order("updated_at, id").Limit(run.Limit).Pluck("id", &outcome.IDs).Error
+		if err != nil {
+			return outcome, fmt.Errorf("failed to list delivered orders: %w", err)
+		}
+		return outcome, nil
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var archived []archivedOrder
+		if err := tx.Raw(archiveDeliveredOrdersSQL, domain.OrderStatusDelivered, run.Cutoff, run.Limit).Scan(&archived).Error; err != nil {
+			return fmt.Errorf("failed to archive delivered orders: %w", err)
+		}
+		if len(archived) == 0 {
+			return nil
+		}
+
+		ids := make([]string, 0, len(archived))
+		documents := make([]json.RawMessage, 0, len(archived))
+		for _, order := range archived {
+			ids = append(ids, order.ID)
+			documents = append(documents, order.Document)
+		}
+
+		// Stored before the orders are deleted, so that a failed upload leaves them in place
+		location, err := run.Store(ctx, documents)
+		if err != nil {
+			return fmt.Errorf("failed to store archived orders: %w", err)
+		}
+
+		// Lines, shipments and returns are deleted with their order
+		if err := tx.Exec("DELETE FROM orders WHERE id IN ?", ids).Error; err != nil {
+			return fmt.Errorf("failed to delete archived orders: %w", err)
+		}
+
+		outcome.IDs = ids
+		outcome.Destination = "orders_archive"
+		if location != "" {
+			outcome.Destination += " and " + location
+		}
+		return nil
+	})
+	return outcome, err
+}
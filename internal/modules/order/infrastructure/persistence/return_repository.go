@@ -0,0 +1,220 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+	orderdb "golang_modular_monolith/internal/modules/order/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// ReturnModel represents the order return database model
+type ReturnModel struct {
+	ID              string            `gorm:"primaryKey;type:varchar(36)"`
+	TenantID        string            `gorm:"type:varchar(64);not null;default:''"`
+	OrderID         string            `gorm:"type:varchar(36);not null;index"`
+	CustomerID      string            `gorm:"type:varchar(36);not null"`
+	Status          string            `gorm:"type:varchar(16);not null;default:requested"`
+	Reason          string            `gorm:"type:text;not null;default:''"`
+	Currency        string            `gorm:"type:char(3);not null"`
+	RefundAmount    int64             `gorm:"not null;default:0"`
+	RefundReference string            `gorm:"type:varchar(255);not null;default:''"`
+	RejectionReason string            `gorm:"type:text;not null;default:''"`
+	Version         int               `gorm:"not null;default:0"`
+	Lines           []ReturnLineModel `gorm:"foreignKey:ReturnID"`
+	CreatedAt       time.Time         `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt       time.Time         `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (ReturnModel) TableName() string {
+	return "order_returns"
+}
+
+// ReturnLineModel represents the order return line database model
+type ReturnLineModel struct {
+	ReturnID    string `gorm:"primaryKey;type:varchar(36)"`
+	OrderLineID string `gorm:"primaryKey;type:varchar(36)"`
+	Position    int    `gorm:"not null"`
+	ProductID   string `gorm:"type:varchar(36);not null"`
+	ProductName string `gorm:"type:varchar(255);not null;default:''"`
+	Quantity    int    `gorm:"not null"`
+	UnitPrice   int64  `gorm:"not null"`
+	Amount      int64  `gorm:"not null"`
+}
+
+// TableName returns the table name for GORM
+func (ReturnLineModel) TableName() string {
+	return "order_return_lines"
+}
+
+// ToEntity converts database model to domain entity
+func (m *ReturnModel) ToEntity() *domain.Return {
+	ret := &domain.Return{
+		BaseAggregateRoot: shareddomain.NewBaseAggregateRootWithID(m.ID),
+		OrderID:           m.OrderID,
+		CustomerID:        m.CustomerID,
+		Status:            domain.ReturnStatus(m.Status),
+		Reason:            m.Reason,
+		Lines:             make([]domain.ReturnLine, len(m.Lines)),
+		RefundAmount:      shareddomain.Money{Amount: m.RefundAmount, Currency: m.Currency},
+		RefundReference:   m.RefundReference,
+		RejectionReason:   m.RejectionReason,
+	}
+
+	for i, line := range m.Lines {
+		ret.Lines[i] = domain.ReturnLine{
+			OrderLineID: line.OrderLineID,
+			ProductID:   line.ProductID,
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+			UnitPrice:   shareddomain.Money{Amount: line.UnitPrice, Currency: m.Currency},
+			Amount:      shareddomain.Money{Amount: line.Amount, Currency: m.Currency},
+		}
+	}
+
+	// Set version and timestamps from database
+	ret.Version = m.Version
+	ret.CreatedAt = m.CreatedAt
+	ret.UpdatedAt = m.UpdatedAt
+
+	return ret
+}
+
+// FromEntity converts domain entity to database model
+func (m *ReturnModel) FromEntity(ret *domain.Return) {
+	m.ID = ret.GetID()
+	m.OrderID = ret.OrderID
+	m.CustomerID = ret.CustomerID
+	m.Status = string(ret.Status)
+	m.Reason = ret.Reason
+	m.Currency = ret.RefundAmount.Currency
+	m.RefundAmount = ret.RefundAmount.Amount
+	m.RefundReference = ret.RefundReference
+	m.RejectionReason = ret.RejectionReason
+	m.Version = ret.GetVersion()
+	m.CreatedAt = ret.GetCreatedAt()
+	m.UpdatedAt = ret.GetUpdatedAt()
+
+	m.Lines = make([]ReturnLineModel, len(ret.Lines))
+	for i, line := range ret.Lines {
+		m.Lines[i] = ReturnLineModel{
+			ReturnID:    ret.GetID(),
+			OrderLineID: line.OrderLineID,
+			Position:    i + 1,
+			ProductID:   line.ProductID,
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+			UnitPrice:   line.UnitPrice.Amount,
+			Amount:      line.Amount.Amount,
+		}
+	}
+}
+
+// PostgreSQLReturnRepository implements ReturnRepository using PostgreSQL
+type PostgreSQLReturnRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLReturnRepository creates a new PostgreSQL return repository
+func NewPostgreSQLReturnRepository(db *gorm.DB) *PostgreSQLReturnRepository {
+	return &PostgreSQLReturnRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLReturnRepositoryFromManager creates repository using database manager
+func NewPostgreSQLReturnRepositoryFromManager() (*PostgreSQLReturnRepository, error) {
+	db, err := orderdb.GetOrderDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order database: %w", err)
+	}
+
+	return &PostgreSQLReturnRepository{
+		db: db,
+	}, nil
+}
+
+// Save saves a return; its lines never change after creation, so they are only inserted once
+func (r *PostgreSQLReturnRepository) Save(ctx context.Context, ret *domain.Return) error {
+	model := &ReturnModel{}
+	model.FromEntity(ret)
+	lines := model.Lines
+	model.Lines = nil
+
+	err := shareddb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&ReturnLineModel{}).Where("return_id = ?", model.ID).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check return lines: %w", err)
+		}
+
+		if err := tx.Save(model).Error; err != nil {
+			return fmt.Errorf("failed to save return: %w", err)
+		}
+
+		if count == 0 && len(lines) > 0 {
+			if err := tx.Create(&lines).Error; err != nil {
+				return fmt.Errorf("failed to save return lines: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Clear uncommitted events after successful save
+	ret.ClearUncommittedEvents()
+
+	return nil
+}
+
+// GetByID retrieves a return with its lines by ID
+func (r *PostgreSQLReturnRepository) GetByID(ctx context.Context, id string) (*domain.Return, error) {
+	var model ReturnModel
+	result := shareddb.Conn(ctx, r.db).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Where("id = ?", id).
+		First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get return by ID: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// ListByOrderID retrieves the returns of an order, oldest first
+func (r *PostgreSQLReturnRepository) ListByOrderID(ctx context.Context, orderID string) ([]*domain.Return, error) {
+	var models []ReturnModel
+	result := shareddb.Conn(ctx, r.db).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Where("order_id = ?", orderID).
+		Order("created_at ASC, id ASC").
+		Find(&models)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list order returns: %w", result.Error)
+	}
+
+	returns := make([]*domain.Return, len(models))
+	for i := range models {
+		returns[i] = models[i].ToEntity()
+	}
+
+	return returns, nil
+}
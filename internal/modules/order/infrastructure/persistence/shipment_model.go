@@ -0,0 +1,168 @@
+package persistence
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+)
+
+// ShipmentModel represents the order shipment database model
+// created_at is left to the column default, since shipments are upserted on every order save
+type ShipmentModel struct {
+	ID             string              `gorm:"primaryKey;type:varchar(36)"`
+	OrderID        string              `gorm:"type:varchar(36);not null;index"`
+	Position       int                 `gorm:"not null"`
+	Carrier        string              `gorm:"type:varchar(50);not null"`
+	TrackingNumber string              `gorm:"type:varchar(100);not null"`
+	Status         string              `gorm:"type:varchar(32);not null;default:in_transit"`
+	TrackingEvents TrackingEventModels `gorm:"type:jsonb;not null;default:'[]'"`
+	ShippedAt      time.Time           `gorm:"type:timestamp with time zone;not null"`
+	DeliveredAt    *time.Time          `gorm:"type:timestamp with time zone"`
+	Lines          []ShipmentLineModel `gorm:"foreignKey:ShipmentID"`
+	UpdatedAt      time.Time           `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (ShipmentModel) TableName() string {
+	return "order_shipments"
+}
+
+// ShipmentLineModel represents the order shipment line database model
+type ShipmentLineModel struct {
+	ShipmentID  string `gorm:"primaryKey;type:varchar(36)"`
+	OrderLineID string `gorm:"primaryKey;type:varchar(36)"`
+	Position    int    `gorm:"not null"`
+	Quantity    int    `gorm:"not null"`
+}
+
+// TableName returns the table name for GORM
+func (ShipmentLineModel) TableName() string {
+	return "order_shipment_lines"
+}
+
+// TrackingEventModel represents a shipment status update stored in a JSONB column
+type TrackingEventModel struct {
+	Status      string    `json:"status"`
+	Description string    `json:"description,omitempty"`
+	Location    string    `json:"location,omitempty"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+// TrackingEventModels is the tracking history stored in a JSONB column
+type TrackingEventModels []TrackingEventModel
+
+// Value implements driver.Valuer
+func (t TrackingEventModels) Value() (driver.Value, error) {
+	if t == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tracking events: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (t *TrackingEventModels) Scan(value interface{}) error {
+	if value == nil {
+		*t = TrackingEventModels{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported tracking events value type: %T", value)
+	}
+
+	result := TrackingEventModels{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("failed to unmarshal tracking events: %w", err)
+	}
+
+	*t = result
+	return nil
+}
+
+// GormDataType returns the GORM data type
+func (TrackingEventModels) GormDataType() string {
+	return "jsonb"
+}
+
+// newShipmentModel converts a domain shipment to its database model
+func newShipmentModel(orderID string, position int, shipment domain.Shipment) ShipmentModel {
+	model := ShipmentModel{
+		ID:             shipment.ID,
+		OrderID:        orderID,
+		Position:       position,
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		Status:         string(shipment.Status),
+		TrackingEvents: make(TrackingEventModels, len(shipment.TrackingEvents)),
+		ShippedAt:      shipment.ShippedAt,
+		DeliveredAt:    shipment.DeliveredAt,
+		Lines:          make([]ShipmentLineModel, len(shipment.Lines)),
+	}
+
+	for i, event := range shipment.TrackingEvents {
+		model.TrackingEvents[i] = TrackingEventModel{
+			Status:      string(event.Status),
+			Description: event.Description,
+			Location:    event.Location,
+			OccurredAt:  event.OccurredAt,
+		}
+	}
+
+	for i, line := range shipment.Lines {
+		model.Lines[i] = ShipmentLineModel{
+			ShipmentID:  shipment.ID,
+			OrderLineID: line.OrderLineID,
+			Position:    i + 1,
+			Quantity:    line.Quantity,
+		}
+	}
+
+	return model
+}
+
+// toDomainShipment converts a shipment database model to its domain entity
+func toDomainShipment(model ShipmentModel) domain.Shipment {
+	shipment := domain.Shipment{
+		ID:             model.ID,
+		Carrier:        model.Carrier,
+		TrackingNumber: model.TrackingNumber,
+		Status:         domain.ShipmentStatus(model.Status),
+		Lines:          make([]domain.ShipmentLine, len(model.Lines)),
+		TrackingEvents: make([]domain.TrackingEvent, len(model.TrackingEvents)),
+		ShippedAt:      model.ShippedAt,
+		DeliveredAt:    model.DeliveredAt,
+	}
+
+	for i, line := range model.Lines {
+		shipment.Lines[i] = domain.ShipmentLine{
+			OrderLineID: line.OrderLineID,
+			Quantity:    line.Quantity,
+		}
+	}
+
+	for i, event := range model.TrackingEvents {
+		shipment.TrackingEvents[i] = domain.TrackingEvent{
+			Status:      domain.ShipmentStatus(event.Status),
+			Description: event.Description,
+			Location:    event.Location,
+			OccurredAt:  event.OccurredAt,
+		}
+	}
+
+	return shipment
+}
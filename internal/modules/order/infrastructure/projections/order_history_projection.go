@@ -0,0 +1,84 @@
+package projections
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+	"golang_modular_monolith/internal/modules/order/infrastructure/persistence"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OrderHistoryProjection records every order status change in the order_events table
+type OrderHistoryProjection struct {
+	db *gorm.DB
+}
+
+// NewOrderHistoryProjection creates a new order history projection
+func NewOrderHistoryProjection(db *gorm.DB) *OrderHistoryProjection {
+	return &OrderHistoryProjection{
+		db: db,
+	}
+}
+
+// CanHandle reports whether the projection is interested in the event type
+func (p *OrderHistoryProjection) CanHandle(eventType string) bool {
+	switch eventType {
+	case domain.OrderCreatedEventType,
+		domain.OrderConfirmedEventType,
+		domain.OrderShippedEventType,
+		domain.OrderDeliveredEventType,
+		domain.OrderCancelledEventType:
+		return true
+	}
+	return false
+}
+
+// Handle appends a history entry for an order status change
+func (p *OrderHistoryProjection) Handle(event shareddomain.DomainEvent) error {
+	ctx := shareddomain.WithEventActor(context.Background(), event)
+	switch e := event.(type) {
+	case domain.OrderCreatedEvent:
+		return p.record(ctx, e, "", e.Status, "", "", e.Actor)
+	case domain.OrderConfirmedEvent:
+		return p.record(ctx, e, e.PreviousStatus, string(domain.OrderStatusConfirmed), "", "", e.Actor)
+	case domain.OrderShippedEvent:
+		return p.record(ctx, e, e.PreviousStatus, string(domain.OrderStatusShipped), "", "", e.Actor)
+	case domain.OrderDeliveredEvent:
+		return p.record(ctx, e, e.PreviousStatus, string(domain.OrderStatusDelivered), "", "", e.Actor)
+	case domain.OrderCancelledEvent:
+		return p.record(ctx, e, e.PreviousStatus, string(domain.OrderStatusCancelled), e.ReasonCode, e.Reason, e.Actor)
+	default:
+		return fmt.Errorf("unsupported event %T for order history projection", event)
+	}
+}
+
+// record inserts a history row keyed by the event ID
+func (p *OrderHistoryProjection) record(ctx context.Context, event shareddomain.DomainEvent, fromStatus, toStatus, reasonCode, reason, actor string) error {
+	eventID := event.GetEventID()
+	entry := &persistence.OrderEventModel{
+		EventID:    &eventID,
+		OrderID:    event.GetAggregateID(),
+		EventType:  event.GetEventType(),
+		ToStatus:   toStatus,
+		ReasonCode: reasonCode,
+		Reason:     reason,
+		Actor:      actor,
+		OccurredAt: event.GetOccurredAt().UTC(),
+	}
+	if fromStatus != "" {
+		entry.FromStatus = &fromStatus
+	}
+
+	// Skip events that were already recorded so that replaying them is harmless
+	result := shareddb.Conn(ctx, p.db).Clauses(clause.OnConflict{DoNothing: true}).Create(entry)
+	if result.Error != nil {
+		return fmt.Errorf("failed to record %s event in order history: %w", event.GetEventType(), result.Error)
+	}
+
+	return nil
+}
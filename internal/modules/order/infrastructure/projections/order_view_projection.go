@@ -0,0 +1,148 @@
+package projections
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/order/domain"
+	"golang_modular_monolith/internal/modules/order/infrastructure/persistence"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OrderViewProjection keeps the order_views read model in sync with order events
+type OrderViewProjection struct {
+	db *gorm.DB
+}
+
+// NewOrderViewProjection creates a new order view projection
+func NewOrderViewProjection(db *gorm.DB) *OrderViewProjection {
+	return &OrderViewProjection{
+		db: db,
+	}
+}
+
+// CanHandle reports whether the projection is interested in the event type
+func (p *OrderViewProjection) CanHandle(eventType string) bool {
+	switch eventType {
+	case domain.OrderCreatedEventType,
+		domain.OrderLineAddedEventType,
+		domain.OrderConfirmedEventType,
+		domain.OrderShippedEventType,
+		domain.OrderDeliveredEventType,
+		domain.OrderCancelledEventType:
+		return true
+	}
+	return false
+}
+
+// Handle applies an order event to the read model
+func (p *OrderViewProjection) Handle(event shareddomain.DomainEvent) error {
+	ctx := shareddomain.WithEventActor(context.Background(), event)
+	switch e := event.(type) {
+	case domain.OrderCreatedEvent:
+		return p.onOrderCreated(ctx, e)
+	case domain.OrderLineAddedEvent:
+		return p.onOrderLineAdded(ctx, e)
+	case domain.OrderConfirmedEvent:
+		return p.update(ctx, e, map[string]interface{}{"status": string(domain.OrderStatusConfirmed)}, "")
+	case domain.OrderShippedEvent:
+		return p.update(ctx, e, map[string]interface{}{"status": string(domain.OrderStatusShipped)}, "")
+	case domain.OrderDeliveredEvent:
+		return p.update(ctx, e, map[string]interface{}{"status": string(domain.OrderStatusDelivered)}, "")
+	case domain.OrderCancelledEvent:
+		return p.update(ctx, e, map[string]interface{}{"status": string(domain.OrderStatusCancelled)}, "")
+	default:
+		return fmt.Errorf("unsupported event %T for order view projection", event)
+	}
+}
+
+// onOrderCreated inserts a new read model row
+func (p *OrderViewProjection) onOrderCreated(ctx context.Context, event domain.OrderCreatedEvent) error {
+	occurredAt := event.GetOccurredAt().UTC()
+	view := &persistence.OrderViewModel{
+		ID:              event.OrderID,
+		Number:          event.OrderNumber,
+		CustomerID:      event.CustomerID,
+		Status:          event.Status,
+		Currency:        event.Currency,
+		Lines:           make(persistence.OrderLineViews, len(event.Lines)),
+		LineCount:       len(event.Lines),
+		Subtotal:        event.Subtotal.Amount,
+		Discount:        event.Discount.Amount,
+		Discounts:       persistence.NewOrderDiscountModels(event.Discounts),
+		Tax:             event.Tax.Amount,
+		Total:           event.Total.Amount,
+		ShippingAddress: persistence.NewAddressModel(event.ShippingAddress),
+		BillingAddress:  persistence.NewAddressModel(event.BillingAddress),
+		CreatedAt:       occurredAt,
+		UpdatedAt:       occurredAt,
+	}
+
+	for i, line := range event.Lines {
+		view.Lines[i] = toLineViewModel(line)
+		view.ItemCount += line.Quantity
+	}
+
+	// Upsert so that replaying the event is harmless
+	result := shareddb.Conn(ctx, p.db).Clauses(clause.OnConflict{DoNothing: true}).Create(view)
+	if result.Error != nil {
+		return fmt.Errorf("failed to project order created event: %w", result.Error)
+	}
+
+	return nil
+}
+
+// onOrderLineAdded appends the line to the read model row and refreshes its totals
+func (p *OrderViewProjection) onOrderLineAdded(ctx context.Context, event domain.OrderLineAddedEvent) error {
+	line, err := json.Marshal(persistence.OrderLineViews{toLineViewModel(event.Line)})
+	if err != nil {
+		return fmt.Errorf("failed to marshal order line: %w", err)
+	}
+
+	// The containment guard skips lines that were already projected
+	return p.update(ctx, event, map[string]interface{}{
+		"lines":      gorm.Expr("lines || ?::jsonb", string(line)),
+		"line_count": gorm.Expr("line_count + 1"),
+		"item_count": gorm.Expr("item_count + ?", event.Line.Quantity),
+		"subtotal":   event.OrderSubtotal.Amount,
+		"discount":   event.OrderDiscount.Amount,
+		"tax":        event.OrderTax.Amount,
+		"total":      event.OrderTotal.Amount,
+	}, "NOT lines @> ?::jsonb", fmt.Sprintf(`[{"id":%q}]`, event.Line.ID))
+}
+
+// update applies column changes to an existing read model row
+func (p *OrderViewProjection) update(ctx context.Context, event shareddomain.DomainEvent, changes map[string]interface{}, guard string, guardArgs ...interface{}) error {
+	occurredAt := event.GetOccurredAt().UTC()
+	changes["version"] = gorm.Expr("version + 1")
+	changes["updated_at"] = occurredAt
+
+	query := shareddb.Conn(ctx, p.db).Model(&persistence.OrderViewModel{}).Where("id = ?", event.GetAggregateID())
+	if guard != "" {
+		query = query.Where(guard, guardArgs...)
+	}
+
+	result := query.Updates(changes)
+	if result.Error != nil {
+		return fmt.Errorf("failed to project %s event: %w", event.GetEventType(), result.Error)
+	}
+
+	return nil
+}
+
+// toLineViewModel converts an order line to its read model representation
+func toLineViewModel(line domain.OrderLine) persistence.OrderLineViewModel {
+	return persistence.OrderLineViewModel{
+		ID:          line.ID,
+		ProductID:   line.ProductID,
+		ProductName: line.ProductName,
+		Quantity:    line.Quantity,
+		UnitPrice:   line.UnitPrice.Amount,
+		LineTotal:   line.LineTotal.Amount,
+	}
+}
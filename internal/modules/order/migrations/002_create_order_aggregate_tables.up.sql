@@ -0,0 +1,38 @@
+-- Replace the skeleton orders table (integer ids, decimal totals) with the order aggregate schema
+DROP TABLE IF EXISTS "public"."orders";
+
+-- Create order status enum
+DROP TYPE IF EXISTS "public"."order_status";
+CREATE TYPE "public"."order_status" AS ENUM ('pending', 'confirmed', 'completed', 'cancelled');
+
+-- Create orders table (amounts in the currency's minor unit)
+CREATE TABLE IF NOT EXISTS "public"."orders" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "customer_id" VARCHAR(36) NOT NULL,
+    "status" "public"."order_status" NOT NULL DEFAULT 'pending'::order_status,
+    "currency" CHAR(3) NOT NULL,
+    "subtotal" BIGINT NOT NULL DEFAULT 0,
+    "total" BIGINT NOT NULL DEFAULT 0,
+    "version" INTEGER NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create order lines table
+CREATE TABLE IF NOT EXISTS "public"."order_lines" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "order_id" VARCHAR(36) NOT NULL REFERENCES "public"."orders" ("id") ON DELETE CASCADE,
+    "position" INTEGER NOT NULL,
+    "product_id" VARCHAR(36) NOT NULL,
+    "product_name" VARCHAR(255) NOT NULL DEFAULT '',
+    "quantity" INTEGER NOT NULL CHECK ("quantity" > 0),
+    "unit_price" BIGINT NOT NULL CHECK ("unit_price" >= 0),
+    "line_total" BIGINT NOT NULL,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for better performance
+CREATE INDEX IF NOT EXISTS idx_orders_customer_id ON "public"."orders" ("customer_id");
+CREATE INDEX IF NOT EXISTS idx_orders_status ON "public"."orders" ("status");
+CREATE INDEX IF NOT EXISTS idx_orders_created_at ON "public"."orders" ("created_at");
+CREATE INDEX IF NOT EXISTS idx_order_lines_order_id ON "public"."order_lines" ("order_id");
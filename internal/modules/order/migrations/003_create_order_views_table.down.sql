@@ -0,0 +1,2 @@
+-- Drop order read model table
+DROP TABLE IF EXISTS "public"."order_views";
@@ -0,0 +1,43 @@
+-- Create order read model table (maintained by projections from order events)
+CREATE TABLE IF NOT EXISTS "public"."order_views" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "customer_id" VARCHAR(36) NOT NULL,
+    "status" "public"."order_status" NOT NULL DEFAULT 'pending'::order_status,
+    "currency" CHAR(3) NOT NULL,
+    "lines" JSONB NOT NULL DEFAULT '[]'::jsonb,
+    "line_count" INTEGER NOT NULL DEFAULT 0,
+    "item_count" INTEGER NOT NULL DEFAULT 0,
+    "subtotal" BIGINT NOT NULL DEFAULT 0,
+    "total" BIGINT NOT NULL DEFAULT 0,
+    "version" INTEGER NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for read-side filtering and sorting
+CREATE INDEX IF NOT EXISTS idx_order_views_customer_id_created_at ON "public"."order_views" ("customer_id", "created_at");
+CREATE INDEX IF NOT EXISTS idx_order_views_status ON "public"."order_views" ("status");
+CREATE INDEX IF NOT EXISTS idx_order_views_total ON "public"."order_views" ("total");
+CREATE INDEX IF NOT EXISTS idx_order_views_created_at ON "public"."order_views" ("created_at");
+CREATE INDEX IF NOT EXISTS idx_order_views_updated_at ON "public"."order_views" ("updated_at");
+
+-- Create GIN index for product containment searches on line items
+CREATE INDEX IF NOT EXISTS idx_order_views_lines ON "public"."order_views" USING GIN ("lines" jsonb_path_ops);
+
+-- Backfill read model from the write model
+INSERT INTO "public"."order_views" ("id", "customer_id", "status", "currency", "lines", "line_count", "item_count", "subtotal", "total", "version", "created_at", "updated_at")
+SELECT o."id", o."customer_id", o."status", o."currency",
+       COALESCE(jsonb_agg(jsonb_build_object(
+           'id', l."id",
+           'product_id', l."product_id",
+           'product_name', l."product_name",
+           'quantity', l."quantity",
+           'unit_price', l."unit_price",
+           'line_total', l."line_total"
+       ) ORDER BY l."position") FILTER (WHERE l."id" IS NOT NULL), '[]'::jsonb),
+       COUNT(l."id"), COALESCE(SUM(l."quantity"), 0),
+       o."subtotal", o."total", o."version", o."created_at", o."updated_at"
+FROM "public"."orders" o
+LEFT JOIN "public"."order_lines" l ON l."order_id" = o."id"
+GROUP BY o."id"
+ON CONFLICT ("id") DO NOTHING;
@@ -0,0 +1,9 @@
+-- Remove tax amount from the order read model
+ALTER TABLE "public"."order_views"
+    DROP COLUMN IF EXISTS "tax";
+
+-- Remove tax columns from orders
+ALTER TABLE "public"."orders"
+    DROP COLUMN IF EXISTS "tax_rounding",
+    DROP COLUMN IF EXISTS "tax_rate_bps",
+    DROP COLUMN IF EXISTS "tax";
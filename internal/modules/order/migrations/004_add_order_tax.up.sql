@@ -0,0 +1,9 @@
+-- Add tax amount and the tax policy applied to each order
+ALTER TABLE "public"."orders"
+    ADD COLUMN IF NOT EXISTS "tax" BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "tax_rate_bps" BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "tax_rounding" VARCHAR(16) NOT NULL DEFAULT 'half_up';
+
+-- Add tax amount to the order read model
+ALTER TABLE "public"."order_views"
+    ADD COLUMN IF NOT EXISTS "tax" BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Drop order status history table
+DROP TABLE IF EXISTS "public"."order_events";
@@ -0,0 +1,29 @@
+-- Create order status history table (maintained by projections from order events)
+CREATE TABLE IF NOT EXISTS "public"."order_events" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "event_id" VARCHAR(36) UNIQUE,
+    "order_id" VARCHAR(36) NOT NULL,
+    "event_type" VARCHAR(64) NOT NULL,
+    "from_status" "public"."order_status",
+    "to_status" "public"."order_status" NOT NULL,
+    "reason_code" VARCHAR(64) NOT NULL DEFAULT '',
+    "reason" TEXT NOT NULL DEFAULT '',
+    "actor" VARCHAR(255) NOT NULL,
+    "occurred_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create index for per-order timelines
+CREATE INDEX IF NOT EXISTS idx_order_events_order_id_occurred_at ON "public"."order_events" ("order_id", "occurred_at", "id");
+
+-- Backfill a creation entry for existing orders
+-- Earlier transitions were not recorded, so only the current status can be reconstructed
+INSERT INTO "public"."order_events" ("order_id", "event_type", "from_status", "to_status", "actor", "occurred_at")
+SELECT o."id", 'order.created', NULL, 'pending', 'migration', o."created_at"
+FROM "public"."orders" o
+WHERE NOT EXISTS (SELECT 1 FROM "public"."order_events" e WHERE e."order_id" = o."id");
+
+INSERT INTO "public"."order_events" ("order_id", "event_type", "from_status", "to_status", "actor", "occurred_at")
+SELECT o."id", 'order.' || o."status"::text, 'pending', o."status", 'migration', o."updated_at"
+FROM "public"."orders" o
+WHERE o."status" <> 'pending'
+  AND NOT EXISTS (SELECT 1 FROM "public"."order_events" e WHERE e."order_id" = o."id" AND e."to_status" = o."status");
@@ -0,0 +1,2 @@
+-- Drop order idempotency keys table
+DROP TABLE IF EXISTS "public"."order_idempotency_keys";
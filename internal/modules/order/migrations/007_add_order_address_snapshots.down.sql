@@ -0,0 +1,8 @@
+-- Remove address snapshots
+ALTER TABLE "public"."order_views"
+    DROP COLUMN IF EXISTS "billing_address",
+    DROP COLUMN IF EXISTS "shipping_address";
+
+ALTER TABLE "public"."orders"
+    DROP COLUMN IF EXISTS "billing_address",
+    DROP COLUMN IF EXISTS "shipping_address";
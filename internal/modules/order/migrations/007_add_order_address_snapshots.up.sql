@@ -0,0 +1,8 @@
+-- Shipping and billing address snapshots copied into the order when it is placed
+ALTER TABLE "public"."orders"
+    ADD COLUMN IF NOT EXISTS "shipping_address" JSONB,
+    ADD COLUMN IF NOT EXISTS "billing_address" JSONB;
+
+ALTER TABLE "public"."order_views"
+    ADD COLUMN IF NOT EXISTS "shipping_address" JSONB,
+    ADD COLUMN IF NOT EXISTS "billing_address" JSONB;
@@ -0,0 +1,12 @@
+-- Remove discounts from the order read model and orders
+ALTER TABLE "public"."order_views"
+    DROP COLUMN IF EXISTS "discounts",
+    DROP COLUMN IF EXISTS "discount";
+
+ALTER TABLE "public"."orders"
+    DROP COLUMN IF EXISTS "discounts",
+    DROP COLUMN IF EXISTS "discount";
+
+-- Drop coupon tables
+DROP TABLE IF EXISTS "public"."coupon_redemptions";
+DROP TABLE IF EXISTS "public"."coupons";
@@ -0,0 +1,41 @@
+-- Create coupons table
+CREATE TABLE IF NOT EXISTS "public"."coupons" (
+    "code" VARCHAR(64) NOT NULL PRIMARY KEY,
+    "description" VARCHAR(255) NOT NULL DEFAULT '',
+    "type" VARCHAR(16) NOT NULL CHECK ("type" IN ('percentage', 'fixed_amount')),
+    "value" BIGINT NOT NULL CHECK ("value" > 0),
+    "currency" CHAR(3),
+    "valid_from" TIMESTAMP WITH TIME ZONE,
+    "valid_until" TIMESTAMP WITH TIME ZONE,
+    "max_redemptions" INTEGER NOT NULL DEFAULT 0,
+    "max_redemptions_per_customer" INTEGER NOT NULL DEFAULT 0,
+    "redemption_count" INTEGER NOT NULL DEFAULT 0,
+    "active" BOOLEAN NOT NULL DEFAULT TRUE,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_coupons_created_at ON "public"."coupons" ("created_at");
+
+-- Create coupon redemptions table; released rows belong to orders that were cancelled
+CREATE TABLE IF NOT EXISTS "public"."coupon_redemptions" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "coupon_code" VARCHAR(64) NOT NULL REFERENCES "public"."coupons" ("code") ON DELETE CASCADE,
+    "order_id" VARCHAR(36) NOT NULL,
+    "customer_id" VARCHAR(36) NOT NULL,
+    "redeemed_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "released_at" TIMESTAMP WITH TIME ZONE,
+    UNIQUE ("coupon_code", "order_id")
+);
+
+CREATE INDEX IF NOT EXISTS idx_coupon_redemptions_customer ON "public"."coupon_redemptions" ("coupon_code", "customer_id");
+CREATE INDEX IF NOT EXISTS idx_coupon_redemptions_order_id ON "public"."coupon_redemptions" ("order_id");
+
+-- Add discount lines and the discount total to orders and the order read model
+ALTER TABLE "public"."orders"
+    ADD COLUMN IF NOT EXISTS "discount" BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "discounts" JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+ALTER TABLE "public"."order_views"
+    ADD COLUMN IF NOT EXISTS "discount" BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "discounts" JSONB NOT NULL DEFAULT '[]'::jsonb;
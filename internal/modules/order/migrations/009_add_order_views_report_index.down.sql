@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "public"."idx_order_views_created_at_status";
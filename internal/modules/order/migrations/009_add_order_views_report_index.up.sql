@@ -0,0 +1,2 @@
+-- Covering index for the order summary report and date-bounded exports
+CREATE INDEX IF NOT EXISTS idx_order_views_created_at_status ON "public"."order_views" ("created_at", "status") INCLUDE ("currency", "total", "discount", "tax");
@@ -0,0 +1,8 @@
+-- Remove order numbers
+DROP INDEX IF EXISTS "public"."idx_order_views_number";
+DROP INDEX IF EXISTS "public"."idx_orders_number";
+
+ALTER TABLE "public"."order_views" DROP COLUMN IF EXISTS "number";
+ALTER TABLE "public"."orders" DROP COLUMN IF EXISTS "number";
+
+DROP TABLE IF EXISTS "public"."order_number_counters";
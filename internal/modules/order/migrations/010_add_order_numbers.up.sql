@@ -0,0 +1,49 @@
+-- Counters behind the human-readable order numbers (e.g. ORD-2025-000123), one row per prefix and year
+CREATE TABLE IF NOT EXISTS "public"."order_number_counters" (
+    "prefix" VARCHAR(16) NOT NULL,
+    "year" INTEGER NOT NULL,
+    "last_value" BIGINT NOT NULL DEFAULT 0,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("prefix", "year")
+);
+
+ALTER TABLE "public"."orders" ADD COLUMN IF NOT EXISTS "number" VARCHAR(32);
+ALTER TABLE "public"."order_views" ADD COLUMN IF NOT EXISTS "number" VARCHAR(32);
+
+-- Number existing orders in creation order, restarting at 1 each (UTC) year
+WITH numbered AS (
+    SELECT
+        "id",
+        EXTRACT(YEAR FROM "created_at" AT TIME ZONE 'UTC')::INTEGER AS "year",
+        ROW_NUMBER() OVER (
+            PARTITION BY EXTRACT(YEAR FROM "created_at" AT TIME ZONE 'UTC')
+            ORDER BY "created_at", "id"
+        ) AS "sequence"
+    FROM "public"."orders"
+    WHERE "number" IS NULL
+)
+UPDATE "public"."orders" o
+SET "number" = 'ORD-' || n."year" || '-' || LPAD(n."sequence"::TEXT, 6, '0')
+FROM numbered n
+WHERE o."id" = n."id";
+
+-- Continue the sequences after the backfilled numbers
+INSERT INTO "public"."order_number_counters" ("prefix", "year", "last_value")
+SELECT
+    SPLIT_PART("number", '-', 1),
+    SPLIT_PART("number", '-', 2)::INTEGER,
+    MAX(SPLIT_PART("number", '-', 3)::BIGINT)
+FROM "public"."orders"
+GROUP BY 1, 2
+ON CONFLICT ("prefix", "year") DO UPDATE
+SET "last_value" = GREATEST("order_number_counters"."last_value", EXCLUDED."last_value");
+
+UPDATE "public"."order_views" v
+SET "number" = o."number"
+FROM "public"."orders" o
+WHERE v."id" = o."id" AND v."number" IS NULL;
+
+ALTER TABLE "public"."orders" ALTER COLUMN "number" SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_number ON "public"."orders" ("number");
+CREATE UNIQUE INDEX IF NOT EXISTS idx_order_views_number ON "public"."order_views" ("number");
@@ -0,0 +1,3 @@
+-- Drop order returns tables
+DROP TABLE IF EXISTS "public"."order_return_lines";
+DROP TABLE IF EXISTS "public"."order_returns";
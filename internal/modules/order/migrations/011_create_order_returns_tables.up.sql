@@ -0,0 +1,31 @@
+-- Create order returns table (return merchandise authorizations, amounts in the currency's minor unit)
+CREATE TABLE IF NOT EXISTS "public"."order_returns" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "order_id" VARCHAR(36) NOT NULL REFERENCES "public"."orders" ("id") ON DELETE CASCADE,
+    "customer_id" VARCHAR(36) NOT NULL,
+    "status" VARCHAR(16) NOT NULL DEFAULT 'requested' CHECK ("status" IN ('requested', 'approved', 'rejected', 'refunded')),
+    "reason" TEXT NOT NULL DEFAULT '',
+    "currency" CHAR(3) NOT NULL,
+    "refund_amount" BIGINT NOT NULL DEFAULT 0 CHECK ("refund_amount" >= 0),
+    "refund_reference" VARCHAR(255) NOT NULL DEFAULT '',
+    "rejection_reason" TEXT NOT NULL DEFAULT '',
+    "version" INTEGER NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create order return lines table
+CREATE TABLE IF NOT EXISTS "public"."order_return_lines" (
+    "return_id" VARCHAR(36) NOT NULL REFERENCES "public"."order_returns" ("id") ON DELETE CASCADE,
+    "order_line_id" VARCHAR(36) NOT NULL,
+    "position" INTEGER NOT NULL,
+    "product_id" VARCHAR(36) NOT NULL,
+    "product_name" VARCHAR(255) NOT NULL DEFAULT '',
+    "quantity" INTEGER NOT NULL CHECK ("quantity" > 0),
+    "unit_price" BIGINT NOT NULL,
+    "amount" BIGINT NOT NULL,
+    PRIMARY KEY ("return_id", "order_line_id")
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_returns_order_id ON "public"."order_returns" ("order_id", "created_at");
+CREATE INDEX IF NOT EXISTS idx_order_returns_status ON "public"."order_returns" ("status");
@@ -0,0 +1,28 @@
+-- Drop order shipments tables
+DROP TABLE IF EXISTS "public"."order_shipment_lines";
+DROP TABLE IF EXISTS "public"."order_shipments";
+
+-- Enum values cannot be dropped, so the order status type is rebuilt without the shipping statuses
+UPDATE "public"."orders" SET "status" = 'completed' WHERE "status" = 'delivered';
+UPDATE "public"."orders" SET "status" = 'confirmed' WHERE "status" = 'shipped';
+UPDATE "public"."order_views" SET "status" = 'completed' WHERE "status" = 'delivered';
+UPDATE "public"."order_views" SET "status" = 'confirmed' WHERE "status" = 'shipped';
+DELETE FROM "public"."order_events" WHERE "to_status" IN ('shipped', 'delivered');
+UPDATE "public"."order_events" SET "from_status" = 'confirmed' WHERE "from_status" = 'shipped';
+UPDATE "public"."order_events" SET "from_status" = 'completed' WHERE "from_status" = 'delivered';
+
+ALTER TABLE "public"."orders" ALTER COLUMN "status" DROP DEFAULT;
+ALTER TABLE "public"."order_views" ALTER COLUMN "status" DROP DEFAULT;
+
+ALTER TYPE "public"."order_status" RENAME TO "order_status_old";
+CREATE TYPE "public"."order_status" AS ENUM ('pending', 'confirmed', 'completed', 'cancelled');
+
+ALTER TABLE "public"."orders" ALTER COLUMN "status" TYPE "public"."order_status" USING "status"::text::"public"."order_status";
+ALTER TABLE "public"."order_views" ALTER COLUMN "status" TYPE "public"."order_status" USING "status"::text::"public"."order_status";
+ALTER TABLE "public"."order_events" ALTER COLUMN "from_status" TYPE "public"."order_status" USING "from_status"::text::"public"."order_status";
+ALTER TABLE "public"."order_events" ALTER COLUMN "to_status" TYPE "public"."order_status" USING "to_status"::text::"public"."order_status";
+
+ALTER TABLE "public"."orders" ALTER COLUMN "status" SET DEFAULT 'pending'::order_status;
+ALTER TABLE "public"."order_views" ALTER COLUMN "status" SET DEFAULT 'pending'::order_status;
+
+DROP TYPE "public"."order_status_old";
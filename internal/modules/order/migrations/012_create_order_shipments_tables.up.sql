@@ -0,0 +1,31 @@
+-- Add the shipping statuses to the order status enum
+ALTER TYPE "public"."order_status" ADD VALUE IF NOT EXISTS 'shipped' AFTER 'confirmed';
+ALTER TYPE "public"."order_status" ADD VALUE IF NOT EXISTS 'delivered' AFTER 'shipped';
+
+-- Create order shipments table (tracking events are kept as a JSONB history)
+CREATE TABLE IF NOT EXISTS "public"."order_shipments" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "order_id" VARCHAR(36) NOT NULL REFERENCES "public"."orders" ("id") ON DELETE CASCADE,
+    "position" INTEGER NOT NULL,
+    "carrier" VARCHAR(50) NOT NULL,
+    "tracking_number" VARCHAR(100) NOT NULL,
+    "status" VARCHAR(32) NOT NULL DEFAULT 'in_transit' CHECK ("status" IN ('in_transit', 'out_for_delivery', 'exception', 'delivered')),
+    "tracking_events" JSONB NOT NULL DEFAULT '[]',
+    "shipped_at" TIMESTAMP WITH TIME ZONE NOT NULL,
+    "delivered_at" TIMESTAMP WITH TIME ZONE,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create order shipment lines table
+CREATE TABLE IF NOT EXISTS "public"."order_shipment_lines" (
+    "shipment_id" VARCHAR(36) NOT NULL REFERENCES "public"."order_shipments" ("id") ON DELETE CASCADE,
+    "order_line_id" VARCHAR(36) NOT NULL,
+    "position" INTEGER NOT NULL,
+    "quantity" INTEGER NOT NULL CHECK ("quantity" > 0),
+    PRIMARY KEY ("shipment_id", "order_line_id")
+);
+
+-- Carrier webhooks look shipments up by tracking number
+CREATE UNIQUE INDEX IF NOT EXISTS idx_order_shipments_tracking ON "public"."order_shipments" ("carrier", "tracking_number");
+CREATE INDEX IF NOT EXISTS idx_order_shipments_order_id ON "public"."order_shipments" ("order_id", "position");
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "public"."idx_orders_status_updated_at";
+DROP TABLE IF EXISTS "public"."orders_archive";
@@ -0,0 +1,20 @@
+-- Cold table of the orders moved out of the orders table by the delivered_orders retention policy
+-- Each row keeps the whole order, with its lines, shipments and returns, as one JSONB document
+CREATE TABLE IF NOT EXISTS "public"."orders_archive" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "number" VARCHAR(32) NOT NULL,
+    "customer_id" VARCHAR(36) NOT NULL,
+    "status" VARCHAR(32) NOT NULL,
+    "currency" CHAR(3) NOT NULL,
+    "total" BIGINT NOT NULL,
+    "document" JSONB NOT NULL,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL,
+    "archived_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_archive_number ON "public"."orders_archive" ("number");
+CREATE INDEX IF NOT EXISTS idx_orders_archive_customer_id ON "public"."orders_archive" ("customer_id", "created_at");
+
+-- The retention policy looks delivered orders up by the time they last changed
+CREATE INDEX IF NOT EXISTS idx_orders_status_updated_at ON "public"."orders" ("status", "updated_at");
@@ -0,0 +1,14 @@
+-- Create table storing the first response of create order requests per Idempotency-Key
+CREATE TABLE IF NOT EXISTS "public"."order_idempotency_keys" (
+    "customer_id" VARCHAR(36) NOT NULL,
+    "key" VARCHAR(255) NOT NULL,
+    "request_hash" CHAR(64) NOT NULL,
+    "order_id" VARCHAR(36),
+    "response" JSONB,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "completed_at" TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY ("customer_id", "key")
+);
+
+-- Create index for expiring old keys
+CREATE INDEX IF NOT EXISTS idx_order_idempotency_keys_created_at ON "public"."order_idempotency_keys" ("created_at");
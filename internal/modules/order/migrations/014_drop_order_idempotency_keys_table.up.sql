@@ -0,0 +1,3 @@
+-- POST /orders retries are replayed by the shared idempotency middleware and imported rows are
+-- deduplicated in its idempotency_keys table, so order_idempotency_keys is no longer used
+DROP TABLE IF EXISTS "public"."order_idempotency_keys";
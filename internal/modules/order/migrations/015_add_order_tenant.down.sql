@@ -0,0 +1,11 @@
+-- Drop the tenant of orders and of the tables following them
+ALTER TABLE "public"."order_events" DROP COLUMN IF EXISTS "tenant_id";
+
+DROP INDEX IF EXISTS idx_order_views_tenant_id;
+ALTER TABLE "public"."order_views" DROP COLUMN IF EXISTS "tenant_id";
+
+DROP INDEX IF EXISTS idx_order_returns_tenant_id;
+ALTER TABLE "public"."order_returns" DROP COLUMN IF EXISTS "tenant_id";
+
+DROP INDEX IF EXISTS idx_orders_tenant_id;
+ALTER TABLE "public"."orders" DROP COLUMN IF EXISTS "tenant_id";
@@ -0,0 +1,12 @@
+-- Orders belong to the tenant they were placed in; orders placed without multi-tenancy have none
+ALTER TABLE "public"."orders" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_orders_tenant_id ON "public"."orders" ("tenant_id");
+
+-- Returns, the read model and the history carry the tenant of their order, so that they are scoped to it
+ALTER TABLE "public"."order_returns" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_order_returns_tenant_id ON "public"."order_returns" ("tenant_id");
+
+ALTER TABLE "public"."order_views" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_order_views_tenant_id ON "public"."order_views" ("tenant_id");
+
+ALTER TABLE "public"."order_events" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
@@ -0,0 +1,28 @@
+-- Share order numbers and coupons between tenants again; fails while two tenants use the same
+-- order number or coupon code
+DROP INDEX IF EXISTS idx_coupon_redemptions_customer;
+CREATE INDEX IF NOT EXISTS idx_coupon_redemptions_customer ON "public"."coupon_redemptions" ("coupon_code", "customer_id");
+
+ALTER TABLE "public"."coupon_redemptions" DROP CONSTRAINT IF EXISTS "coupon_redemptions_coupon_fkey";
+ALTER TABLE "public"."coupons" DROP CONSTRAINT IF EXISTS "coupons_pkey";
+ALTER TABLE "public"."coupons" ADD PRIMARY KEY ("code");
+ALTER TABLE "public"."coupon_redemptions"
+    ADD CONSTRAINT "coupon_redemptions_coupon_code_fkey" FOREIGN KEY ("coupon_code")
+    REFERENCES "public"."coupons" ("code") ON DELETE CASCADE;
+
+ALTER TABLE "public"."coupon_redemptions" DROP COLUMN IF EXISTS "tenant_id";
+ALTER TABLE "public"."coupons" DROP COLUMN IF EXISTS "tenant_id";
+
+DROP INDEX IF EXISTS idx_order_views_tenant_number;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_order_views_number ON "public"."order_views" ("number");
+DROP INDEX IF EXISTS idx_orders_tenant_number;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_number ON "public"."orders" ("number");
+
+-- Continue each prefix and year after the highest number of any tenant
+DELETE FROM "public"."order_number_counters" c
+USING "public"."order_number_counters" o
+WHERE c."prefix" = o."prefix" AND c."year" = o."year"
+  AND (c."last_value", c."tenant_id") < (o."last_value", o."tenant_id");
+ALTER TABLE "public"."order_number_counters" DROP CONSTRAINT IF EXISTS "order_number_counters_pkey";
+ALTER TABLE "public"."order_number_counters" ADD PRIMARY KEY ("prefix", "year");
+ALTER TABLE "public"."order_number_counters" DROP COLUMN IF EXISTS "tenant_id";
@@ -0,0 +1,25 @@
+-- Each tenant numbers its orders on its own: counters are kept per tenant, and numbers are unique
+-- within a tenant
+ALTER TABLE "public"."order_number_counters" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE "public"."order_number_counters" DROP CONSTRAINT IF EXISTS "order_number_counters_pkey";
+ALTER TABLE "public"."order_number_counters" ADD PRIMARY KEY ("tenant_id", "prefix", "year");
+
+DROP INDEX IF EXISTS idx_orders_number;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_tenant_number ON "public"."orders" ("tenant_id", "number");
+DROP INDEX IF EXISTS idx_order_views_number;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_order_views_tenant_number ON "public"."order_views" ("tenant_id", "number");
+
+-- Coupons belong to a tenant, and their codes are unique within it; coupons created before
+-- multi-tenancy have none
+ALTER TABLE "public"."coupons" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE "public"."coupon_redemptions" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+
+ALTER TABLE "public"."coupon_redemptions" DROP CONSTRAINT IF EXISTS "coupon_redemptions_coupon_code_fkey";
+ALTER TABLE "public"."coupons" DROP CONSTRAINT IF EXISTS "coupons_pkey";
+ALTER TABLE "public"."coupons" ADD PRIMARY KEY ("tenant_id", "code");
+ALTER TABLE "public"."coupon_redemptions"
+    ADD CONSTRAINT "coupon_redemptions_coupon_fkey" FOREIGN KEY ("tenant_id", "coupon_code")
+    REFERENCES "public"."coupons" ("tenant_id", "code") ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS idx_coupon_redemptions_customer;
+CREATE INDEX IF NOT EXISTS idx_coupon_redemptions_customer ON "public"."coupon_redemptions" ("tenant_id", "coupon_code", "customer_id");
@@ -2,12 +2,32 @@ package order
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/modules/customer/publicapi"
+	commandhandlers "golang_modular_monolith/internal/modules/order/application/command_handlers"
+	queryhandlers "golang_modular_monolith/internal/modules/order/application/query_handlers"
+	orderdomain "golang_modular_monolith/internal/modules/order/domain"
+	orderdb "golang_modular_monolith/internal/modules/order/infrastructure/database"
+	"golang_modular_monolith/internal/modules/order/infrastructure/eventhandlers"
+	orderhttp "golang_modular_monolith/internal/modules/order/infrastructure/http"
+	"golang_modular_monolith/internal/modules/order/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/order/infrastructure/persistence"
+	"golang_modular_monolith/internal/modules/order/infrastructure/projections"
+	orderapi "golang_modular_monolith/internal/modules/order/publicapi"
 
 	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/idempotency"
+	"golang_modular_monolith/internal/shared/infrastructure/importexport"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
 	"golang_modular_monolith/internal/shared/infrastructure/registry"
+	"golang_modular_monolith/internal/shared/infrastructure/retention"
+	"golang_modular_monolith/internal/shared/infrastructure/worker"
 )
 
 // Auto-register order module on package import
@@ -19,7 +39,19 @@ func init() {
 
 // OrderModule implements the Module interface
 type OrderModule struct {
-	name string
+	name            string
+	logger          *zap.Logger
+	orderRepo       orderdomain.OrderRepository
+	handler         *handlers.OrderHandler
+	couponHandler   *handlers.CouponHandler
+	returnHandler   *handlers.ReturnHandler
+	shipmentHandler *handlers.ShipmentHandler
+	projection      *projections.OrderViewProjection
+	history         *projections.OrderHistoryProjection
+	inventoryEvents *eventhandlers.InventoryEventsHandler
+	paymentEvents   *eventhandlers.PaymentEventsHandler
+	retention       []retention.Policy
+	workers         *worker.Manager
 
 	// Dependencies
 	eventBus domain.EventBus
@@ -39,36 +71,197 @@ func (m *OrderModule) Name() string {
 
 // Initialize initializes the order module with dependencies
 func (m *OrderModule) Initialize(deps domain.ModuleDependencies) error {
-	log.Printf("🔧 Initializing %s module...", m.name)
+	m.logger = deps.Logger
+	m.logger.Info("initializing module")
 
 	// Store event bus
 	m.eventBus = deps.EventBus
 
-	// TODO: Initialize order-specific dependencies
-	// - Order repositories
-	// - Order domain services
-	// - Order command/query handlers
-	// - Order HTTP handlers
+	// Create repositories using factory pattern
+	orderRepo, err := persistence.NewPostgreSQLOrderRepositoryFromManager()
+	if err != nil {
+		return fmt.Errorf("failed to create order repository: %w", err)
+	}
+	m.orderRepo = orderRepo
 
-	log.Printf("✅ %s module initialized successfully (skeleton)", m.name)
+	// Expose the public API to other modules
+	if err := orderapi.Register(deps.PublicAPIs, publicAPI{repo: orderRepo}); err != nil {
+		return fmt.Errorf("failed to register order public API: %w", err)
+	}
+
+	couponRepo, err := persistence.NewPostgreSQLCouponRepositoryFromManager()
+	if err != nil {
+		return fmt.Errorf("failed to create coupon repository: %w", err)
+	}
+
+	returnRepo, err := persistence.NewPostgreSQLReturnRepositoryFromManager()
+	if err != nil {
+		return fmt.Errorf("failed to create return repository: %w", err)
+	}
+
+	orderQueryRepo, err := persistence.NewPostgreSQLOrderQueryRepositoryFromManager()
+	if err != nil {
+		return fmt.Errorf("failed to create order query repository: %w", err)
+	}
+
+	// Create read model projection
+	orderDB, err := orderdb.GetOrderDB()
+	if err != nil {
+		return fmt.Errorf("failed to get order database: %w", err)
+	}
+	m.projection = projections.NewOrderViewProjection(orderDB)
+
+	// Keep the order_views read model in sync with order events
+	// Subscribed here rather than in Start: the in-memory bus delivers events in subscription order,
+	// so the read model must see order.created before cross-module handlers react to it
+	if err := m.eventBus.Subscribe(m.projection); err != nil {
+		return fmt.Errorf("failed to subscribe order view projection: %w", err)
+	}
+
+	// Record every status change in the order_events history table
+	m.history = projections.NewOrderHistoryProjection(orderDB)
+	if err := m.eventBus.Subscribe(m.history); err != nil {
+		return fmt.Errorf("failed to subscribe order history projection: %w", err)
+	}
+
+	// Load the configured tax policy
+	taxPolicy, err := loadTaxPolicy(deps.Config)
+	if err != nil {
+		return fmt.Errorf("invalid tax config: %w", err)
+	}
+	m.logger.Info("tax policy configured", zap.Int64("rate_bps", taxPolicy.RateBasisPoints), zap.String("rounding", string(taxPolicy.Rounding)))
+
+	// Load the order number prefix and create the generator
+	numberPrefix := loadOrderNumberPrefix(deps.Config)
+	orderNumbers, err := persistence.NewPostgreSQLOrderNumberGeneratorFromManager(numberPrefix)
+	if err != nil {
+		return fmt.Errorf("invalid order numbering config: %w", err)
+	}
+	m.logger.Info("order number prefix configured", zap.String("prefix", numberPrefix))
+
+	// Move delivered orders to the orders_archive cold table once retention.delivered_orders.after
+	// has passed
+	deliveredRetention, err := retention.After(deps.Config, persistence.DeliveredOrdersPolicyName)
+	if err != nil {
+		return err
+	}
+	if deliveredRetention > 0 {
+		m.retention = append(m.retention, persistence.NewDeliveredOrdersPolicy(orderDB, deliveredRetention))
+	}
+
+	// Create command handlers
+	// The customer API is resolved lazily so module initialization order does not matter
+	createOrderHandler := commandhandlers.NewCreateOrderHandler(
+		orderRepo,
+		couponRepo,
+		orderNumbers,
+		publicapi.Lazy(deps.PublicAPIs),
+		taxPolicy,
+		m.eventBus,
+	)
+
+	addOrderLineHandler := commandhandlers.NewAddOrderLineHandler(orderRepo, m.eventBus)
+	confirmOrderHandler := commandhandlers.NewConfirmOrderHandler(orderRepo, m.eventBus)
+	cancelOrderHandler := commandhandlers.NewCancelOrderHandler(orderRepo, couponRepo, m.eventBus)
+
+	createCouponHandler := commandhandlers.NewCreateCouponHandler(couponRepo)
+	updateCouponHandler := commandhandlers.NewUpdateCouponHandler(couponRepo)
+
+	// Create cross-module event handlers
+	m.inventoryEvents = eventhandlers.NewInventoryEventsHandler(confirmOrderHandler, cancelOrderHandler)
+
+	createReturnHandler := commandhandlers.NewCreateReturnHandler(orderRepo, returnRepo, m.eventBus)
+	approveReturnHandler := commandhandlers.NewApproveReturnHandler(returnRepo, m.eventBus)
+	rejectReturnHandler := commandhandlers.NewRejectReturnHandler(returnRepo, m.eventBus)
+	markReturnRefundedHandler := commandhandlers.NewMarkReturnRefundedHandler(returnRepo, m.eventBus)
+
+	createShipmentHandler := commandhandlers.NewCreateShipmentHandler(orderRepo, m.eventBus)
+	updateShipmentStatusHandler := commandhandlers.NewUpdateShipmentStatusHandler(orderRepo, m.eventBus)
+	recordTrackingUpdateHandler := commandhandlers.NewRecordTrackingUpdateHandler(orderRepo, m.eventBus)
+
+	// Complete returns once the payment module has refunded them
+	m.paymentEvents = eventhandlers.NewPaymentEventsHandler(markReturnRefundedHandler)
+
+	// Create query handlers
+	getOrderHandler := queryhandlers.NewGetOrderHandler(orderQueryRepo)
+	getOrderHistoryHandler := queryhandlers.NewGetOrderHistoryHandler(orderQueryRepo)
+	listOrdersHandler := queryhandlers.NewListOrdersHandler(orderQueryRepo)
+	searchOrdersHandler := queryhandlers.NewSearchOrdersHandler(orderQueryRepo)
+	exportOrdersHandler := queryhandlers.NewExportOrdersHandler(orderQueryRepo)
+	getOrderSummaryHandler := queryhandlers.NewGetOrderSummaryHandler(orderQueryRepo)
+	getCouponHandler := queryhandlers.NewGetCouponHandler(couponRepo)
+	listCouponsHandler := queryhandlers.NewListCouponsHandler(couponRepo)
+	getReturnHandler := queryhandlers.NewGetReturnHandler(returnRepo)
+	listOrderReturnsHandler := queryhandlers.NewListOrderReturnsHandler(orderQueryRepo, returnRepo)
+	listOrderShipmentsHandler := queryhandlers.NewListOrderShipmentsHandler(orderRepo)
+
+	// Imports run as workers of the module and are audited once finished
+	m.workers = worker.NewManager(m.name, m.logger)
+	jobs := importexport.NewJobs(m.name, m.workers, deps.Audit)
+
+	// The references of imported rows are kept in the idempotency_keys table of the module database,
+	// whatever idempotency.store, so that importing a file again places its orders once
+	storeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	imports, err := idempotency.NewPostgresStore(storeCtx, orderDB)
+	if err != nil {
+		return fmt.Errorf("failed to create order import key store: %w", err)
+	}
+
+	// Create HTTP handlers
+	m.handler = handlers.NewOrderHandler(
+		createOrderHandler,
+		addOrderLineHandler,
+		cancelOrderHandler,
+		getOrderHandler,
+		getOrderHistoryHandler,
+		listOrdersHandler,
+		searchOrdersHandler,
+		exportOrdersHandler,
+		getOrderSummaryHandler,
+		jobs,
+		imports,
+	)
+	m.couponHandler = handlers.NewCouponHandler(
+		createCouponHandler,
+		updateCouponHandler,
+		getCouponHandler,
+		listCouponsHandler,
+	)
+	m.returnHandler = handlers.NewReturnHandler(
+		createReturnHandler,
+		approveReturnHandler,
+		rejectReturnHandler,
+		getReturnHandler,
+		listOrderReturnsHandler,
+	)
+	m.shipmentHandler = handlers.NewShipmentHandler(
+		createShipmentHandler,
+		updateShipmentStatusHandler,
+		recordTrackingUpdateHandler,
+		listOrderShipmentsHandler,
+		loadTrackingWebhookSecret(deps.Config, m.logger),
+	)
+
+	m.logger.Info("module initialized")
 	return nil
 }
 
 // RegisterRoutes registers HTTP routes for the order module
 func (m *OrderModule) RegisterRoutes(router *gin.RouterGroup) {
-	log.Printf("🌐 Registering routes for %s module", m.name)
-
-	// TODO: Register order routes
-	orderGroup := router.Group("/orders")
-	{
-		orderGroup.GET("/", func(c *gin.Context) {
-			c.JSON(200, gin.H{
-				"message": "Order module is working!",
-				"module":  m.name,
-				"status":  "skeleton",
-			})
-		})
-	}
+	m.logger.Info("registering routes")
+
+	orderhttp.RegisterOrderRoutes(router, m.handler, m.couponHandler, m.returnHandler, m.shipmentHandler)
+}
+
+// APIOperations implements openapi.Documented
+func (m *OrderModule) APIOperations() []*openapi.Operation {
+	return orderhttp.APIOperations()
+}
+
+// RetentionPolicies implements retention.Retained
+func (m *OrderModule) RetentionPolicies() []retention.Policy {
+	return m.retention
 }
 
 // Health checks if the order module is healthy
@@ -82,22 +275,107 @@ func (m *OrderModule) Health(ctx context.Context) error {
 
 // Start starts the order module (optional lifecycle method)
 func (m *OrderModule) Start(ctx context.Context) error {
-	log.Printf("🚀 Starting %s module", m.name)
+	m.logger.Info("starting module")
 
-	// TODO: Start order-specific services
-	// - Background workers
-	// - Event handlers
+	// Confirm or cancel orders when the product module answers a reservation request
+	if err := m.eventBus.Subscribe(m.inventoryEvents); err != nil {
+		return fmt.Errorf("failed to subscribe inventory events handler: %w", err)
+	}
+
+	// Mark returns refunded when the payment module reports their refund
+	if err := m.eventBus.Subscribe(m.paymentEvents); err != nil {
+		return fmt.Errorf("failed to subscribe payment events handler: %w", err)
+	}
 
-	log.Printf("✅ %s module started successfully (skeleton)", m.name)
+	m.logger.Info("module started")
 	return nil
 }
 
 // Stop stops the order module (optional lifecycle method)
 func (m *OrderModule) Stop(ctx context.Context) error {
-	log.Printf("🛑 Stopping %s module", m.name)
+	m.logger.Info("stopping module")
+
+	// Wait for running imports
+	if m.workers != nil {
+		if err := m.workers.Stop(ctx); err != nil {
+			return fmt.Errorf("failed to stop order workers: %w", err)
+		}
+	}
 
-	// TODO: Cleanup order resources
+	// Unregister event handlers
+	if m.paymentEvents != nil {
+		if err := m.eventBus.Unsubscribe(m.paymentEvents); err != nil {
+			return fmt.Errorf("failed to unsubscribe payment events handler: %w", err)
+		}
+	}
+	if m.inventoryEvents != nil {
+		if err := m.eventBus.Unsubscribe(m.inventoryEvents); err != nil {
+			return fmt.Errorf("failed to unsubscribe inventory events handler: %w", err)
+		}
+	}
+	if m.history != nil {
+		if err := m.eventBus.Unsubscribe(m.history); err != nil {
+			return fmt.Errorf("failed to unsubscribe order history projection: %w", err)
+		}
+	}
+	if m.projection != nil {
+		if err := m.eventBus.Unsubscribe(m.projection); err != nil {
+			return fmt.Errorf("failed to unsubscribe order view projection: %w", err)
+		}
+	}
 
-	log.Printf("✅ %s module stopped successfully", m.name)
+	m.logger.Info("module stopped")
 	return nil
 }
+
+// loadTaxPolicy reads order.tax from the module config,
+// falling back to the default tax policy for anything not configured
+func loadTaxPolicy(cfg domain.ModuleConfig) (orderdomain.TaxPolicy, error) {
+	policy := orderdomain.DefaultTaxPolicy()
+
+	tax := cfg.Section("tax")
+	if tax == nil {
+		return policy, nil
+	}
+
+	switch rate := tax["rate_bps"].(type) {
+	case int:
+		policy.RateBasisPoints = int64(rate)
+	case int64:
+		policy.RateBasisPoints = rate
+	case float64:
+		if rate != float64(int64(rate)) {
+			return policy, fmt.Errorf("rate_bps must be a whole number of basis points, got %v", rate)
+		}
+		policy.RateBasisPoints = int64(rate)
+	case nil:
+	default:
+		return policy, fmt.Errorf("rate_bps must be a number, got %v", rate)
+	}
+
+	if rounding, ok := tax["rounding"].(string); ok && rounding != "" {
+		policy.Rounding = domain.RoundingMode(rounding)
+	}
+
+	return policy, policy.Validate()
+}
+
+// loadOrderNumberPrefix reads order.numbering.prefix from the module config,
+// falling back to the default prefix when it is not configured
+func loadOrderNumberPrefix(cfg domain.ModuleConfig) string {
+	numbering := cfg.Section("numbering")
+	if prefix, ok := numbering["prefix"].(string); ok && strings.TrimSpace(prefix) != "" {
+		return strings.ToUpper(strings.TrimSpace(prefix))
+	}
+	return orderdomain.DefaultOrderNumberPrefix
+}
+
+// loadTrackingWebhookSecret reads the secret carrier tracking webhooks are signed with
+func loadTrackingWebhookSecret(cfg domain.ModuleConfig, logger *zap.Logger) string {
+	shipping := cfg.Section("shipping")
+	secret, _ := shipping["webhook_secret"].(string)
+	if secret == "" {
+		logger.Warn("order shipping webhook_secret is not set, tracking webhooks are refused")
+	}
+	return secret
+}
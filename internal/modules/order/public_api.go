@@ -0,0 +1,18 @@
+package order
+
+import (
+	"context"
+
+	orderdomain "golang_modular_monolith/internal/modules/order/domain"
+)
+
+// publicAPI implements the order module's public API on top of the order repository
+// It lives outside package publicapi, which the order domain imports for its event contracts
+type publicAPI struct {
+	repo orderdomain.OrderRepository
+}
+
+// OrderExists implements publicapi.PublicAPI
+func (a publicAPI) OrderExists(ctx context.Context, id string) (bool, error) {
+	return a.repo.Exists(ctx, id)
+}
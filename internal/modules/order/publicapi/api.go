@@ -0,0 +1,65 @@
+package publicapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// ErrUnavailable is returned when the order module is not enabled or not initialized
+var ErrUnavailable = errors.New("order module is not available")
+
+// ModuleName is the name the order module registers its public API under
+const ModuleName = "order"
+
+// PublicAPI is the order module's public API
+type PublicAPI interface {
+	// OrderExists checks if an order exists
+	OrderExists(ctx context.Context, id string) (bool, error)
+}
+
+// Register makes the order API available to other modules
+// Called by the order module during initialization
+func Register(apis *shareddomain.PublicAPIRegistry, api PublicAPI) error {
+	return apis.Register(ModuleName, api)
+}
+
+// Lookup returns the order API registered with the module registry
+func Lookup(apis *shareddomain.PublicAPIRegistry) (PublicAPI, error) {
+	if apis == nil {
+		return nil, ErrUnavailable
+	}
+
+	registered, exists := apis.Get(ModuleName)
+	if !exists {
+		return nil, ErrUnavailable
+	}
+
+	api, ok := registered.(PublicAPI)
+	if !ok {
+		return nil, fmt.Errorf("order public API has unexpected type %T", registered)
+	}
+	return api, nil
+}
+
+// Lazy returns a PublicAPI that resolves the registered implementation on each call,
+// so consumers do not depend on module initialization order
+func Lazy(apis *shareddomain.PublicAPIRegistry) PublicAPI {
+	return lazyAPI{apis: apis}
+}
+
+// lazyAPI delegates to the registered order API
+type lazyAPI struct {
+	apis *shareddomain.PublicAPIRegistry
+}
+
+// OrderExists implements PublicAPI
+func (l lazyAPI) OrderExists(ctx context.Context, id string) (bool, error) {
+	api, err := Lookup(l.apis)
+	if err != nil {
+		return false, err
+	}
+	return api.OrderExists(ctx, id)
+}
@@ -0,0 +1,106 @@
+// Package publicapi is the order module's contract for other modules.
+// Other modules depend on this package only, never on order internals.
+package publicapi
+
+import (
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// Order event types other modules may subscribe to
+const (
+	OrderCreatedEventType   = "order.created"
+	OrderLineAddedEventType = "order.line_added"
+	OrderConfirmedEventType = "order.confirmed"
+	OrderCancelledEventType = "order.cancelled"
+	OrderShippedEventType   = "order.shipped"
+	OrderDeliveredEventType = "order.delivered"
+
+	OrderReturnApprovedEventType = "order.return_approved"
+)
+
+// Reason codes carried by order.cancelled
+const (
+	CancelReasonCustomerRequest  = "customer_request"
+	CancelReasonOutOfStock       = "out_of_stock"
+	CancelReasonPaymentFailed    = "payment_failed"
+	CancelReasonFraudSuspected   = "fraud_suspected"
+	CancelReasonMerchantDecision = "merchant_decision"
+	CancelReasonOther            = "other"
+)
+
+// OrderItem is a product quantity requested by an order
+type OrderItem struct {
+	ProductID string `json:"product_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+// OrderCreated is implemented by the order.created event
+type OrderCreated interface {
+	shareddomain.DomainEvent
+
+	// GetOrderID returns the ID of the created order
+	GetOrderID() string
+
+	// GetCustomerID returns the ID of the ordering customer
+	GetCustomerID() string
+
+	// GetTotal returns the amount due for the order, tax included
+	GetTotal() shareddomain.Money
+
+	// GetItems returns the requested products, one entry per order line
+	GetItems() []OrderItem
+}
+
+// OrderLineAdded is implemented by the order.line_added event, published when a line is added to
+// a pending order after order.created
+// Modules acting on the order's items or total (stock, payments, statistics) update them when they
+// receive it
+type OrderLineAdded interface {
+	shareddomain.DomainEvent
+
+	// GetOrderID returns the ID of the order the line was added to
+	GetOrderID() string
+
+	// GetLineID returns the ID of the added line, the same for every delivery of the event
+	GetLineID() string
+
+	// GetItem returns the product quantity requested by the line
+	GetItem() OrderItem
+
+	// GetTotal returns the amount due for the order with the line, tax included
+	// Adding a line never lowers the total
+	GetTotal() shareddomain.Money
+}
+
+// OrderCancelled is implemented by the order.cancelled event
+// Modules holding resources for the order (stock, payments) release them when they receive it
+type OrderCancelled interface {
+	shareddomain.DomainEvent
+
+	// GetOrderID returns the ID of the cancelled order
+	GetOrderID() string
+
+	// GetPreviousStatus returns the status the order had before it was cancelled
+	GetPreviousStatus() string
+
+	// GetReasonCode returns one of the CancelReason* codes
+	GetReasonCode() string
+}
+
+// ReturnApproved is implemented by the order.return_approved event
+// The payment module refunds the return's amount from the order's payment when it receives it
+type ReturnApproved interface {
+	shareddomain.DomainEvent
+
+	// GetReturnID returns the ID of the approved return
+	GetReturnID() string
+
+	// GetOrderID returns the ID of the order the goods are returned from
+	GetOrderID() string
+
+	// GetRefundAmount returns the amount to refund, tax included and discounts deducted
+	GetRefundAmount() shareddomain.Money
+
+	// GetReason returns the customer's reason for the return
+	GetReason() string
+}
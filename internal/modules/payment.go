@@ -0,0 +1,6 @@
+//go:build !no_payment
+
+package modules
+
+// Excluded from builds tagged no_payment
+import _ "golang_modular_monolith/internal/modules/payment"
@@ -0,0 +1,64 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/payment/application/commands"
+	"golang_modular_monolith/internal/modules/payment/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CancelPaymentHandler handles CancelPaymentCommand
+// It compensates the order's payment according to its state: an uncollected payment
+// is cancelled, a collected one is refunded through its provider
+type CancelPaymentHandler struct {
+	repo      domain.PaymentRepository
+	providers domain.PaymentProviders
+	eventBus  shareddomain.EventBus
+}
+
+// NewCancelPaymentHandler creates a new CancelPaymentHandler
+func NewCancelPaymentHandler(
+	repo domain.PaymentRepository,
+	providers domain.PaymentProviders,
+	eventBus shareddomain.EventBus,
+) *CancelPaymentHandler {
+	return &CancelPaymentHandler{
+		repo:      repo,
+		providers: providers,
+		eventBus:  eventBus,
+	}
+}
+
+// Handle handles the CancelPaymentCommand
+func (h *CancelPaymentHandler) Handle(ctx context.Context, cmd *commands.CancelPaymentCommand) (*commands.PaymentResult, error) {
+	payment, err := loadOrderPayment(ctx, h.repo, cmd.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch payment.Status {
+	case domain.PaymentStatusCancelled, domain.PaymentStatusRefunded:
+		return toPaymentResult(payment), nil
+	case domain.PaymentStatusSucceeded:
+		err = refundPayment(ctx, h.providers, payment, cmd.Reason)
+	case domain.PaymentStatusProcessing:
+		// The provider may still settle the charge; it is refunded once the outcome is known
+		return nil, shareddomain.NewBusinessRuleError(
+			"payment_in_flight",
+			fmt.Sprintf("payment %s is still being processed by %s", payment.GetID(), payment.Provider),
+		)
+	default:
+		err = payment.Cancel(cmd.Reason)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, payment); err != nil {
+		return nil, err
+	}
+
+	return toPaymentResult(payment), nil
+}
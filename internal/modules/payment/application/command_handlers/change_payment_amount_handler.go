@@ -0,0 +1,41 @@
+package commandhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/payment/application/commands"
+	"golang_modular_monolith/internal/modules/payment/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// ChangePaymentAmountHandler handles ChangePaymentAmountCommand
+type ChangePaymentAmountHandler struct {
+	repo     domain.PaymentRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewChangePaymentAmountHandler creates a new ChangePaymentAmountHandler
+func NewChangePaymentAmountHandler(repo domain.PaymentRepository, eventBus shareddomain.EventBus) *ChangePaymentAmountHandler {
+	return &ChangePaymentAmountHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the ChangePaymentAmountCommand
+func (h *ChangePaymentAmountHandler) Handle(ctx context.Context, cmd *commands.ChangePaymentAmountCommand) (*commands.PaymentResult, error) {
+	payment, err := loadOrderPayment(ctx, h.repo, cmd.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := payment.ChangeAmount(cmd.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, payment); err != nil {
+		return nil, err
+	}
+
+	return toPaymentResult(payment), nil
+}
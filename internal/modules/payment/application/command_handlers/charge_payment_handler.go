@@ -0,0 +1,97 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	customerapi "golang_modular_monolith/internal/modules/customer/publicapi"
+	"golang_modular_monolith/internal/modules/payment/application/commands"
+	"golang_modular_monolith/internal/modules/payment/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// ChargePaymentHandler handles ChargePaymentCommand
+type ChargePaymentHandler struct {
+	repo      domain.PaymentRepository
+	providers domain.PaymentProviders
+	customers customerapi.PublicAPI
+	eventBus  shareddomain.EventBus
+}
+
+// NewChargePaymentHandler creates a new ChargePaymentHandler
+func NewChargePaymentHandler(
+	repo domain.PaymentRepository,
+	providers domain.PaymentProviders,
+	customers customerapi.PublicAPI,
+	eventBus shareddomain.EventBus,
+) *ChargePaymentHandler {
+	return &ChargePaymentHandler{
+		repo:      repo,
+		providers: providers,
+		customers: customers,
+		eventBus:  eventBus,
+	}
+}
+
+// Handle handles the ChargePaymentCommand
+// Payments that are already settled or in flight are returned unchanged
+func (h *ChargePaymentHandler) Handle(ctx context.Context, cmd *commands.ChargePaymentCommand) (*commands.PaymentResult, error) {
+	payment, err := loadOrderPayment(ctx, h.repo, cmd.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !payment.CanCharge() {
+		return toPaymentResult(payment), nil
+	}
+
+	provider, err := h.providers.Get(payment.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := provider.Charge(ctx, domain.ChargeRequest{
+		PaymentID:     payment.GetID(),
+		OrderID:       payment.OrderID,
+		CustomerID:    payment.CustomerID,
+		CustomerEmail: h.customerEmail(ctx, payment.CustomerID),
+		Amount:        payment.Amount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to charge payment %s: %w", payment.GetID(), err)
+	}
+
+	switch result.Status {
+	case domain.ChargeStatusSucceeded:
+		err = payment.RecordChargeSucceeded(result.ProviderReference)
+	case domain.ChargeStatusPending:
+		err = payment.RecordChargeSubmitted(result.ProviderReference)
+	default:
+		err = payment.RecordChargeFailed(result.ProviderReference, result.FailureReason)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, payment); err != nil {
+		return nil, err
+	}
+
+	return toPaymentResult(payment), nil
+}
+
+// customerEmail returns the address the provider sends the receipt to
+// A missing customer does not block the charge; the receipt is simply not sent
+func (h *ChargePaymentHandler) customerEmail(ctx context.Context, customerID string) string {
+	if customerID == "" {
+		return ""
+	}
+
+	customer, err := h.customers.GetCustomer(ctx, customerID)
+	if err != nil {
+		fmt.Printf("Warning: failed to look up customer %s for payment receipt: %v\n", customerID, err)
+		return ""
+	}
+
+	return customer.Email
+}
@@ -0,0 +1,55 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/payment/application/commands"
+	"golang_modular_monolith/internal/modules/payment/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CreatePaymentHandler handles CreatePaymentCommand
+type CreatePaymentHandler struct {
+	repo     domain.PaymentRepository
+	provider domain.PaymentProvider
+	eventBus shareddomain.EventBus
+}
+
+// NewCreatePaymentHandler creates a new CreatePaymentHandler
+// New payments are bound to the given provider for their whole life
+func NewCreatePaymentHandler(
+	repo domain.PaymentRepository,
+	provider domain.PaymentProvider,
+	eventBus shareddomain.EventBus,
+) *CreatePaymentHandler {
+	return &CreatePaymentHandler{
+		repo:     repo,
+		provider: provider,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the CreatePaymentCommand
+// An order already holding a payment gets that payment back
+func (h *CreatePaymentHandler) Handle(ctx context.Context, cmd *commands.CreatePaymentCommand) (*commands.PaymentResult, error) {
+	existing, err := h.repo.GetByOrderID(ctx, cmd.OrderID)
+	if err == nil {
+		return toPaymentResult(existing), nil
+	}
+	if !shareddomain.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to check existing payment: %w", err)
+	}
+
+	// Create payment
+	payment, err := domain.NewPayment(cmd.OrderID, cmd.CustomerID, cmd.Amount, h.provider.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, payment); err != nil {
+		return nil, err
+	}
+
+	return toPaymentResult(payment), nil
+}
@@ -0,0 +1,107 @@
+package commandhandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/payment/application/commands"
+	"golang_modular_monolith/internal/modules/payment/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// HandleWebhookHandler handles HandleWebhookCommand
+type HandleWebhookHandler struct {
+	repo      domain.PaymentRepository
+	inbox     domain.WebhookInbox
+	providers domain.PaymentProviders
+	eventBus  shareddomain.EventBus
+}
+
+// NewHandleWebhookHandler creates a new HandleWebhookHandler
+func NewHandleWebhookHandler(
+	repo domain.PaymentRepository,
+	inbox domain.WebhookInbox,
+	providers domain.PaymentProviders,
+	eventBus shareddomain.EventBus,
+) *HandleWebhookHandler {
+	return &HandleWebhookHandler{
+		repo:      repo,
+		inbox:     inbox,
+		providers: providers,
+		eventBus:  eventBus,
+	}
+}
+
+// Handle handles the HandleWebhookCommand
+// Unknown notifications and retried deliveries are acknowledged without changes
+func (h *HandleWebhookHandler) Handle(ctx context.Context, cmd *commands.HandleWebhookCommand) (*commands.WebhookResult, error) {
+	provider, err := h.providers.Get(cmd.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := provider.VerifyWebhook(cmd.Payload, cmd.Headers)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidWebhookSignature) {
+			return nil, shareddomain.NewDomainError(shareddomain.ErrCodeUnauthorized, "invalid webhook signature")
+		}
+		return nil, shareddomain.NewDomainError(shareddomain.ErrCodeInvalidInput, err.Error())
+	}
+
+	result := &commands.WebhookResult{EventID: event.ID}
+	if event.Type == domain.WebhookIgnored || event.PaymentID == "" {
+		return result, nil
+	}
+
+	if event.ID != "" {
+		processed, err := h.inbox.WasProcessed(ctx, cmd.Provider, event.ID)
+		if err != nil {
+			return nil, err
+		}
+		if processed {
+			return result, nil
+		}
+	}
+
+	payment, err := loadPayment(ctx, h.repo, event.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+	if payment.Provider != cmd.Provider {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			fmt.Sprintf("payment %s is not handled by provider %s", payment.GetID(), cmd.Provider),
+		)
+	}
+
+	if err := applyWebhook(payment, event); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, payment); err != nil {
+		return nil, err
+	}
+
+	if event.ID != "" {
+		if err := h.inbox.MarkProcessed(ctx, cmd.Provider, event.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	result.Applied = true
+	return result, nil
+}
+
+// applyWebhook applies a verified provider notification to the payment
+func applyWebhook(payment *domain.Payment, event *domain.WebhookEvent) error {
+	switch event.Type {
+	case domain.WebhookChargeSucceeded:
+		return payment.RecordChargeSucceeded(event.ProviderReference)
+	case domain.WebhookChargeFailed:
+		return payment.RecordChargeFailed(event.ProviderReference, event.FailureReason)
+	case domain.WebhookRefunded:
+		return payment.RecordRefund(event.ProviderReference)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/payment/application/commands"
+	"golang_modular_monolith/internal/modules/payment/domain"
+	"golang_modular_monolith/internal/shared/application"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// loadPayment retrieves a payment by ID or returns a not found domain error
+func loadPayment(ctx context.Context, repo domain.PaymentRepository, paymentID string) (*domain.Payment, error) {
+	if paymentID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"payment ID is required",
+		)
+	}
+
+	payment, err := repo.GetByID(ctx, paymentID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("payment with ID %s not found", paymentID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	return payment, nil
+}
+
+// loadOrderPayment retrieves the payment of an order or returns a not found domain error
+func loadOrderPayment(ctx context.Context, repo domain.PaymentRepository, orderID string) (*domain.Payment, error) {
+	if orderID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"order ID is required",
+		)
+	}
+
+	payment, err := repo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("payment for order %s not found", orderID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	return payment, nil
+}
+
+// saveAndPublish persists a changed payment and publishes its uncommitted events
+func saveAndPublish(ctx context.Context, repo domain.PaymentRepository, eventBus shareddomain.EventBus, payment *domain.Payment) error {
+	// Capture events before the repository clears them on save
+	events := payment.GetUncommittedEvents()
+
+	if err := repo.Save(ctx, payment); err != nil {
+		return fmt.Errorf("failed to save payment: %w", err)
+	}
+
+	for _, event := range events {
+		if err := application.PublishEvents(ctx, eventBus, event); err != nil {
+			// Log error but don't fail the operation
+			// In a real application, you might want to use outbox pattern or similar
+			fmt.Printf("Warning: failed to publish event %T for payment %s: %v\n", event, payment.GetID(), err)
+		}
+	}
+
+	return nil
+}
+
+// toPaymentResult converts a payment to a command result
+func toPaymentResult(payment *domain.Payment) *commands.PaymentResult {
+	return &commands.PaymentResult{
+		ID:                payment.GetID(),
+		OrderID:           payment.OrderID,
+		Amount:            payment.Amount,
+		RefundedAmount:    payment.RefundedAmount,
+		Status:            string(payment.Status),
+		Provider:          payment.Provider,
+		ProviderReference: payment.ProviderReference,
+		FailureReason:     payment.FailureReason,
+		Version:           payment.GetVersion(),
+		UpdatedAt:         payment.GetUpdatedAt(),
+	}
+}
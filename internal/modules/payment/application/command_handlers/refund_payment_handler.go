@@ -0,0 +1,95 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/payment/application/commands"
+	"golang_modular_monolith/internal/modules/payment/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// RefundPaymentHandler handles RefundPaymentCommand
+type RefundPaymentHandler struct {
+	repo      domain.PaymentRepository
+	providers domain.PaymentProviders
+	eventBus  shareddomain.EventBus
+}
+
+// NewRefundPaymentHandler creates a new RefundPaymentHandler
+func NewRefundPaymentHandler(
+	repo domain.PaymentRepository,
+	providers domain.PaymentProviders,
+	eventBus shareddomain.EventBus,
+) *RefundPaymentHandler {
+	return &RefundPaymentHandler{
+		repo:      repo,
+		providers: providers,
+		eventBus:  eventBus,
+	}
+}
+
+// Handle handles the RefundPaymentCommand
+func (h *RefundPaymentHandler) Handle(ctx context.Context, cmd *commands.RefundPaymentCommand) (*commands.PaymentResult, error) {
+	payment, err := loadPayment(ctx, h.repo, cmd.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.Status == domain.PaymentStatusRefunded {
+		return toPaymentResult(payment), nil
+	}
+
+	if err := refundPayment(ctx, h.providers, payment, cmd.Reason); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, payment); err != nil {
+		return nil, err
+	}
+
+	return toPaymentResult(payment), nil
+}
+
+// refundPayment refunds everything not refunded yet through the payment's provider and records the refund
+func refundPayment(ctx context.Context, providers domain.PaymentProviders, payment *domain.Payment, reason string) error {
+	if !payment.CanTransitionTo(domain.PaymentStatusRefunded) {
+		return shareddomain.NewBusinessRuleError(
+			"invalid_status_transition",
+			fmt.Sprintf("cannot refund a payment in status %s", payment.Status),
+		)
+	}
+
+	// The payment ID stays the idempotency key of full refunds
+	reference, err := requestRefund(ctx, providers, payment, payment.RefundableAmount(), payment.GetID(), reason)
+	if err != nil {
+		return err
+	}
+
+	return payment.RecordRefund(reference)
+}
+
+// requestRefund asks the payment's provider to refund amount and returns the provider's refund reference
+func requestRefund(ctx context.Context, providers domain.PaymentProviders, payment *domain.Payment, amount shareddomain.Money, refundKey, reason string) (string, error) {
+	if err := payment.CanRefund(amount); err != nil {
+		return "", err
+	}
+
+	provider, err := providers.Get(payment.Provider)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := provider.Refund(ctx, domain.RefundRequest{
+		PaymentID:         payment.GetID(),
+		RefundKey:         refundKey,
+		ProviderReference: payment.ProviderReference,
+		Amount:            amount,
+		Reason:            reason,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to refund payment %s: %w", payment.GetID(), err)
+	}
+
+	return result.ProviderReference, nil
+}
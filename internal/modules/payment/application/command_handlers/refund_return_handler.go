@@ -0,0 +1,66 @@
+package commandhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/payment/application/commands"
+	"golang_modular_monolith/internal/modules/payment/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// RefundReturnHandler handles RefundReturnCommand
+type RefundReturnHandler struct {
+	repo      domain.PaymentRepository
+	providers domain.PaymentProviders
+	eventBus  shareddomain.EventBus
+}
+
+// NewRefundReturnHandler creates a new RefundReturnHandler
+func NewRefundReturnHandler(
+	repo domain.PaymentRepository,
+	providers domain.PaymentProviders,
+	eventBus shareddomain.EventBus,
+) *RefundReturnHandler {
+	return &RefundReturnHandler{
+		repo:      repo,
+		providers: providers,
+		eventBus:  eventBus,
+	}
+}
+
+// Handle refunds the amount of an approved order return from the order's payment
+// A return that was already refunded is not refunded again
+func (h *RefundReturnHandler) Handle(ctx context.Context, cmd *commands.RefundReturnCommand) (*commands.PaymentResult, error) {
+	if cmd.ReturnID == "" {
+		return nil, shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeInvalidInput,
+			"return ID is required",
+			"return_id",
+		)
+	}
+
+	payment, err := loadOrderPayment(ctx, h.repo, cmd.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.HasRefundForReturn(cmd.ReturnID) {
+		return toPaymentResult(payment), nil
+	}
+
+	// Keyed by return so that a retried refund never pays out twice
+	reference, err := requestRefund(ctx, h.providers, payment, cmd.Amount, payment.GetID()+"-"+cmd.ReturnID, cmd.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := payment.RecordPartialRefund(cmd.Amount, reference, cmd.ReturnID); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, payment); err != nil {
+		return nil, err
+	}
+
+	return toPaymentResult(payment), nil
+}
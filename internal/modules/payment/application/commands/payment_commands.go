@@ -0,0 +1,148 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/shared/application"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CreatePaymentCommand represents a command to open a payment intent for an order
+type CreatePaymentCommand struct {
+	application.BaseCommand
+	OrderID    string             `json:"order_id" validate:"required"`
+	CustomerID string             `json:"customer_id"`
+	Amount     shareddomain.Money `json:"amount"`
+}
+
+// NewCreatePaymentCommand creates a new create payment command
+func NewCreatePaymentCommand(orderID, customerID string, amount shareddomain.Money) CreatePaymentCommand {
+	return CreatePaymentCommand{
+		BaseCommand: application.NewBaseCommand("create_payment"),
+		OrderID:     orderID,
+		CustomerID:  customerID,
+		Amount:      amount,
+	}
+}
+
+// ChangePaymentAmountCommand represents a command to set the amount to collect for an order whose
+// total changed before its payment was charged
+type ChangePaymentAmountCommand struct {
+	application.BaseCommand
+	OrderID string             `json:"order_id" validate:"required"`
+	Amount  shareddomain.Money `json:"amount"`
+}
+
+// NewChangePaymentAmountCommand creates a new change payment amount command
+func NewChangePaymentAmountCommand(orderID string, amount shareddomain.Money) ChangePaymentAmountCommand {
+	return ChangePaymentAmountCommand{
+		BaseCommand: application.NewBaseCommand("change_payment_amount"),
+		OrderID:     orderID,
+		Amount:      amount,
+	}
+}
+
+// ChargePaymentCommand represents a command to collect the payment of an order
+type ChargePaymentCommand struct {
+	application.BaseCommand
+	OrderID string `json:"order_id" validate:"required"`
+}
+
+// NewChargePaymentCommand creates a new charge payment command
+func NewChargePaymentCommand(orderID string) ChargePaymentCommand {
+	return ChargePaymentCommand{
+		BaseCommand: application.NewBaseCommand("charge_payment"),
+		OrderID:     orderID,
+	}
+}
+
+// CancelPaymentCommand represents a command to void the payment of a cancelled order
+// Uncollected payments are cancelled, collected ones are refunded
+type CancelPaymentCommand struct {
+	application.BaseCommand
+	OrderID string `json:"order_id" validate:"required"`
+	Reason  string `json:"reason" validate:"max=500"`
+}
+
+// NewCancelPaymentCommand creates a new cancel payment command
+func NewCancelPaymentCommand(orderID, reason string) CancelPaymentCommand {
+	return CancelPaymentCommand{
+		BaseCommand: application.NewBaseCommand("cancel_payment"),
+		OrderID:     orderID,
+		Reason:      reason,
+	}
+}
+
+// RefundPaymentCommand represents a command to refund a collected payment
+type RefundPaymentCommand struct {
+	application.BaseCommand
+	PaymentID string `json:"payment_id" validate:"required"`
+	Reason    string `json:"reason" validate:"max=500"`
+}
+
+// NewRefundPaymentCommand creates a new refund payment command
+func NewRefundPaymentCommand(paymentID, reason string) RefundPaymentCommand {
+	return RefundPaymentCommand{
+		BaseCommand: application.NewBaseCommand("refund_payment"),
+		PaymentID:   paymentID,
+		Reason:      reason,
+	}
+}
+
+// RefundReturnCommand represents a command to refund part of an order's payment for an approved return
+type RefundReturnCommand struct {
+	application.BaseCommand
+	OrderID  string             `json:"order_id" validate:"required"`
+	ReturnID string             `json:"return_id" validate:"required"`
+	Amount   shareddomain.Money `json:"amount"`
+	Reason   string             `json:"reason" validate:"max=500"`
+}
+
+// NewRefundReturnCommand creates a new refund return command
+func NewRefundReturnCommand(orderID, returnID string, amount shareddomain.Money, reason string) RefundReturnCommand {
+	return RefundReturnCommand{
+		BaseCommand: application.NewBaseCommand("refund_return"),
+		OrderID:     orderID,
+		ReturnID:    returnID,
+		Amount:      amount,
+		Reason:      reason,
+	}
+}
+
+// HandleWebhookCommand represents a command to apply a provider webhook
+type HandleWebhookCommand struct {
+	application.BaseCommand
+	Provider string              `json:"provider" validate:"required"`
+	Payload  []byte              `json:"-"`
+	Headers  map[string][]string `json:"-"`
+}
+
+// NewHandleWebhookCommand creates a new handle webhook command
+func NewHandleWebhookCommand(provider string, payload []byte, headers map[string][]string) HandleWebhookCommand {
+	return HandleWebhookCommand{
+		BaseCommand: application.NewBaseCommand("handle_payment_webhook"),
+		Provider:    provider,
+		Payload:     payload,
+		Headers:     headers,
+	}
+}
+
+// PaymentResult represents the state of a payment returned by payment commands
+type PaymentResult struct {
+	ID                string             `json:"id"`
+	OrderID           string             `json:"order_id"`
+	Amount            shareddomain.Money `json:"amount"`
+	RefundedAmount    shareddomain.Money `json:"refunded_amount"`
+	Status            string             `json:"status"`
+	Provider          string             `json:"provider"`
+	ProviderReference string             `json:"provider_reference,omitempty"`
+	FailureReason     string             `json:"failure_reason,omitempty"`
+	Version           int                `json:"version"`
+	UpdatedAt         time.Time          `json:"updated_at"`
+}
+
+// WebhookResult represents the outcome of applying a provider webhook
+type WebhookResult struct {
+	EventID string `json:"event_id"`
+	Applied bool   `json:"applied"`
+}
@@ -0,0 +1,16 @@
+package queries
+
+import (
+	"golang_modular_monolith/internal/modules/payment/domain"
+)
+
+// GetPaymentQuery represents a query to get a payment by ID or by order ID
+type GetPaymentQuery struct {
+	ID      string `json:"id"`
+	OrderID string `json:"order_id"`
+}
+
+// GetPaymentResult represents the result of GetPaymentQuery
+type GetPaymentResult struct {
+	Payment domain.Payment `json:"payment"`
+}
@@ -0,0 +1,60 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/payment/application/queries"
+	"golang_modular_monolith/internal/modules/payment/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// GetPaymentHandler handles GetPaymentQuery
+type GetPaymentHandler struct {
+	repo domain.PaymentRepository
+}
+
+// NewGetPaymentHandler creates a new GetPaymentHandler
+func NewGetPaymentHandler(repo domain.PaymentRepository) *GetPaymentHandler {
+	return &GetPaymentHandler{
+		repo: repo,
+	}
+}
+
+// Handle handles the GetPaymentQuery
+func (h *GetPaymentHandler) Handle(ctx context.Context, query *queries.GetPaymentQuery) (*queries.GetPaymentResult, error) {
+	var (
+		payment *domain.Payment
+		err     error
+		lookup  string
+	)
+
+	// Get payment from repository
+	switch {
+	case query.ID != "":
+		lookup = "with ID " + query.ID
+		payment, err = h.repo.GetByID(ctx, query.ID)
+	case query.OrderID != "":
+		lookup = "for order " + query.OrderID
+		payment, err = h.repo.GetByOrderID(ctx, query.OrderID)
+	default:
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"payment ID or order ID is required",
+		)
+	}
+
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("payment %s not found", lookup),
+			)
+		}
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	return &queries.GetPaymentResult{
+		Payment: *payment,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package payment
+
+import (
+	customerapi "golang_modular_monolith/internal/modules/customer/publicapi"
+	commandhandlers "golang_modular_monolith/internal/modules/payment/application/command_handlers"
+	queryhandlers "golang_modular_monolith/internal/modules/payment/application/query_handlers"
+	paymentdomain "golang_modular_monolith/internal/modules/payment/domain"
+	paymentdb "golang_modular_monolith/internal/modules/payment/infrastructure/database"
+	"golang_modular_monolith/internal/modules/payment/infrastructure/eventhandlers"
+	"golang_modular_monolith/internal/modules/payment/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/payment/infrastructure/persistence"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/di"
+)
+
+// newContainer registers the constructors of the payment module's repositories and handlers;
+// nothing is constructed until it is resolved, so callers may replace providers first
+// active is the provider new payments are opened with, and customers the customer module's API
+func newContainer(
+	eventBus domain.EventBus,
+	paymentProviders paymentdomain.PaymentProviders,
+	active paymentdomain.PaymentProvider,
+	customers customerapi.PublicAPI,
+) *di.Container {
+	c := di.New()
+	di.Value(c, eventBus)
+	di.Value(c, paymentProviders)
+	di.Value(c, active)
+	di.Value(c, customers)
+	c.Provide(paymentdb.GetPaymentDB)
+
+	// Repositories
+	c.Provide(persistence.NewPostgreSQLPaymentRepository, di.As[paymentdomain.PaymentRepository]())
+	c.Provide(persistence.NewPostgreSQLWebhookInbox, di.As[paymentdomain.WebhookInbox]())
+
+	// Command handlers
+	c.Provide(commandhandlers.NewCreatePaymentHandler)
+	c.Provide(commandhandlers.NewChangePaymentAmountHandler)
+	c.Provide(commandhandlers.NewChargePaymentHandler)
+	c.Provide(commandhandlers.NewCancelPaymentHandler)
+	c.Provide(commandhandlers.NewRefundPaymentHandler)
+	c.Provide(commandhandlers.NewRefundReturnHandler)
+	c.Provide(commandhandlers.NewHandleWebhookHandler)
+
+	// Cross-module event handlers
+	c.Provide(eventhandlers.NewOrderEventsHandler)
+
+	// Query handlers
+	c.Provide(queryhandlers.NewGetPaymentHandler)
+
+	// HTTP handlers
+	c.Provide(handlers.NewPaymentHandler)
+	return c
+}
@@ -0,0 +1,277 @@
+package domain
+
+import (
+	"golang_modular_monolith/internal/modules/payment/publicapi"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Payment domain event types
+const (
+	PaymentCreatedEventType       = "payment.created"
+	PaymentAmountChangedEventType = "payment.amount_changed"
+	PaymentSucceededEventType     = publicapi.PaymentSucceededEventType
+	PaymentFailedEventType        = publicapi.PaymentFailedEventType
+	PaymentCancelledEventType     = "payment.cancelled"
+	PaymentRefundedEventType      = publicapi.PaymentRefundedEventType
+)
+
+// PaymentCreatedEvent represents the event when a payment intent is created for an order
+type PaymentCreatedEvent struct {
+	domain.BaseDomainEvent
+	PaymentID string       `json:"payment_id"`
+	OrderID   string       `json:"order_id"`
+	Amount    domain.Money `json:"amount"`
+	Provider  string       `json:"provider"`
+}
+
+// NewPaymentCreatedEvent creates a new payment created event
+func NewPaymentCreatedEvent(payment *Payment) PaymentCreatedEvent {
+	eventData := map[string]interface{}{
+		"payment_id": payment.GetID(),
+		"order_id":   payment.OrderID,
+		"amount":     payment.Amount,
+		"provider":   payment.Provider,
+	}
+
+	return PaymentCreatedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			payment.GetID(),
+			"payment",
+			PaymentCreatedEventType,
+			eventData,
+		),
+		PaymentID: payment.GetID(),
+		OrderID:   payment.OrderID,
+		Amount:    payment.Amount,
+		Provider:  payment.Provider,
+	}
+}
+
+// PaymentAmountChangedEvent represents the event when the amount of an uncollected payment changes
+type PaymentAmountChangedEvent struct {
+	domain.BaseDomainEvent
+	PaymentID      string       `json:"payment_id"`
+	OrderID        string       `json:"order_id"`
+	Amount         domain.Money `json:"amount"`
+	PreviousAmount domain.Money `json:"previous_amount"`
+}
+
+// NewPaymentAmountChangedEvent creates a new payment amount changed event
+func NewPaymentAmountChangedEvent(payment *Payment, previousAmount domain.Money) PaymentAmountChangedEvent {
+	eventData := map[string]interface{}{
+		"payment_id":      payment.GetID(),
+		"order_id":        payment.OrderID,
+		"amount":          payment.Amount,
+		"previous_amount": previousAmount,
+	}
+
+	return PaymentAmountChangedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			payment.GetID(),
+			"payment",
+			PaymentAmountChangedEventType,
+			eventData,
+		),
+		PaymentID:      payment.GetID(),
+		OrderID:        payment.OrderID,
+		Amount:         payment.Amount,
+		PreviousAmount: previousAmount,
+	}
+}
+
+// PaymentSucceededEvent represents the event when a payment has been collected
+type PaymentSucceededEvent struct {
+	domain.BaseDomainEvent
+	PaymentID         string       `json:"payment_id"`
+	OrderID           string       `json:"order_id"`
+	Amount            domain.Money `json:"amount"`
+	ProviderReference string       `json:"provider_reference"`
+}
+
+// NewPaymentSucceededEvent creates a new payment succeeded event
+func NewPaymentSucceededEvent(payment *Payment) PaymentSucceededEvent {
+	eventData := map[string]interface{}{
+		"payment_id":         payment.GetID(),
+		"order_id":           payment.OrderID,
+		"amount":             payment.Amount,
+		"provider_reference": payment.ProviderReference,
+	}
+
+	return PaymentSucceededEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			payment.GetID(),
+			"payment",
+			PaymentSucceededEventType,
+			eventData,
+		),
+		PaymentID:         payment.GetID(),
+		OrderID:           payment.OrderID,
+		Amount:            payment.Amount,
+		ProviderReference: payment.ProviderReference,
+	}
+}
+
+// GetPaymentID returns the ID of the payment
+func (e PaymentSucceededEvent) GetPaymentID() string {
+	return e.PaymentID
+}
+
+// GetOrderID returns the ID of the order the payment belongs to
+func (e PaymentSucceededEvent) GetOrderID() string {
+	return e.OrderID
+}
+
+// GetAmount returns the amount charged
+func (e PaymentSucceededEvent) GetAmount() domain.Money {
+	return e.Amount
+}
+
+// PaymentFailedEvent represents the event when a charge is declined or fails
+type PaymentFailedEvent struct {
+	domain.BaseDomainEvent
+	PaymentID string       `json:"payment_id"`
+	OrderID   string       `json:"order_id"`
+	Amount    domain.Money `json:"amount"`
+	Reason    string       `json:"reason"`
+}
+
+// NewPaymentFailedEvent creates a new payment failed event
+func NewPaymentFailedEvent(payment *Payment) PaymentFailedEvent {
+	eventData := map[string]interface{}{
+		"payment_id": payment.GetID(),
+		"order_id":   payment.OrderID,
+		"amount":     payment.Amount,
+		"reason":     payment.FailureReason,
+	}
+
+	return PaymentFailedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			payment.GetID(),
+			"payment",
+			PaymentFailedEventType,
+			eventData,
+		),
+		PaymentID: payment.GetID(),
+		OrderID:   payment.OrderID,
+		Amount:    payment.Amount,
+		Reason:    payment.FailureReason,
+	}
+}
+
+// GetPaymentID returns the ID of the payment
+func (e PaymentFailedEvent) GetPaymentID() string {
+	return e.PaymentID
+}
+
+// GetOrderID returns the ID of the order the payment belongs to
+func (e PaymentFailedEvent) GetOrderID() string {
+	return e.OrderID
+}
+
+// GetAmount returns the amount that could not be charged
+func (e PaymentFailedEvent) GetAmount() domain.Money {
+	return e.Amount
+}
+
+// PaymentCancelledEvent represents the event when an uncollected payment is cancelled
+type PaymentCancelledEvent struct {
+	domain.BaseDomainEvent
+	PaymentID      string `json:"payment_id"`
+	OrderID        string `json:"order_id"`
+	PreviousStatus string `json:"previous_status"`
+	Reason         string `json:"reason,omitempty"`
+}
+
+// NewPaymentCancelledEvent creates a new payment cancelled event
+func NewPaymentCancelledEvent(payment *Payment, previousStatus PaymentStatus, reason string) PaymentCancelledEvent {
+	eventData := map[string]interface{}{
+		"payment_id":      payment.GetID(),
+		"order_id":        payment.OrderID,
+		"previous_status": previousStatus,
+		"reason":          reason,
+	}
+
+	return PaymentCancelledEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			payment.GetID(),
+			"payment",
+			PaymentCancelledEventType,
+			eventData,
+		),
+		PaymentID:      payment.GetID(),
+		OrderID:        payment.OrderID,
+		PreviousStatus: string(previousStatus),
+		Reason:         reason,
+	}
+}
+
+// PaymentRefundedEvent represents the event when a collected payment is refunded in full or in part
+type PaymentRefundedEvent struct {
+	domain.BaseDomainEvent
+	PaymentID       string       `json:"payment_id"`
+	OrderID         string       `json:"order_id"`
+	Amount          domain.Money `json:"amount"`          // amount of this refund
+	RefundedAmount  domain.Money `json:"refunded_amount"` // everything refunded so far
+	FullyRefunded   bool         `json:"fully_refunded"`
+	RefundReference string       `json:"refund_reference"`
+	ReturnID        string       `json:"return_id,omitempty"`
+}
+
+// NewPaymentRefundedEvent creates a new payment refunded event
+func NewPaymentRefundedEvent(payment *Payment, amount domain.Money, refundReference, returnID string) PaymentRefundedEvent {
+	eventData := map[string]interface{}{
+		"payment_id":       payment.GetID(),
+		"order_id":         payment.OrderID,
+		"amount":           amount,
+		"refunded_amount":  payment.RefundedAmount,
+		"fully_refunded":   payment.Status == PaymentStatusRefunded,
+		"refund_reference": refundReference,
+		"return_id":        returnID,
+	}
+
+	return PaymentRefundedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			payment.GetID(),
+			"payment",
+			PaymentRefundedEventType,
+			eventData,
+		),
+		PaymentID:       payment.GetID(),
+		OrderID:         payment.OrderID,
+		Amount:          amount,
+		RefundedAmount:  payment.RefundedAmount,
+		FullyRefunded:   payment.Status == PaymentStatusRefunded,
+		RefundReference: refundReference,
+		ReturnID:        returnID,
+	}
+}
+
+// GetPaymentID returns the ID of the payment
+func (e PaymentRefundedEvent) GetPaymentID() string {
+	return e.PaymentID
+}
+
+// GetOrderID returns the ID of the order the payment belongs to
+func (e PaymentRefundedEvent) GetOrderID() string {
+	return e.OrderID
+}
+
+// GetAmount returns the amount refunded by this refund
+func (e PaymentRefundedEvent) GetAmount() domain.Money {
+	return e.Amount
+}
+
+// GetReturnID returns the order return the refund was issued for, empty for other refunds
+func (e PaymentRefundedEvent) GetReturnID() string {
+	return e.ReturnID
+}
+
+// GetRefundReference returns the provider's reference of the refund
+func (e PaymentRefundedEvent) GetRefundReference() string {
+	return e.RefundReference
+}
+
+// IsFullyRefunded reports whether nothing is left to refund on the payment
+func (e PaymentRefundedEvent) IsFullyRefunded() bool {
+	return e.FullyRefunded
+}
@@ -0,0 +1,355 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+
+	"github.com/google/uuid"
+)
+
+// PaymentStatus represents the status of a payment
+type PaymentStatus string
+
+const (
+	PaymentStatusPending    PaymentStatus = "pending"
+	PaymentStatusProcessing PaymentStatus = "processing"
+	PaymentStatusSucceeded  PaymentStatus = "succeeded"
+	PaymentStatusFailed     PaymentStatus = "failed"
+	PaymentStatusCancelled  PaymentStatus = "cancelled"
+	PaymentStatusRefunded   PaymentStatus = "refunded"
+)
+
+// IsValid checks if the payment status is known
+func (s PaymentStatus) IsValid() bool {
+	switch s {
+	case PaymentStatusPending, PaymentStatusProcessing, PaymentStatusSucceeded,
+		PaymentStatusFailed, PaymentStatusCancelled, PaymentStatusRefunded:
+		return true
+	}
+	return false
+}
+
+// AttemptKind distinguishes charge attempts from refund attempts
+type AttemptKind string
+
+const (
+	AttemptKindCharge AttemptKind = "charge"
+	AttemptKindRefund AttemptKind = "refund"
+)
+
+// AttemptStatus represents the outcome of a provider call
+type AttemptStatus string
+
+const (
+	AttemptStatusPending   AttemptStatus = "pending"
+	AttemptStatusSucceeded AttemptStatus = "succeeded"
+	AttemptStatusFailed    AttemptStatus = "failed"
+)
+
+// PaymentAttempt records a single call to the payment provider
+type PaymentAttempt struct {
+	ID                string        `json:"id"`
+	Kind              AttemptKind   `json:"kind"`
+	Status            AttemptStatus `json:"status"`
+	Amount            domain.Money  `json:"amount"`
+	ProviderReference string        `json:"provider_reference,omitempty"`
+	FailureReason     string        `json:"failure_reason,omitempty"`
+	ReturnID          string        `json:"return_id,omitempty"` // set on partial refunds issued for an order return
+	CreatedAt         time.Time     `json:"created_at"`
+}
+
+// Payment represents the payment intent aggregate root for an order
+// There is exactly one payment per order; every provider call is kept as an attempt
+type Payment struct {
+	domain.BaseAggregateRoot
+	OrderID           string           `json:"order_id"`
+	CustomerID        string           `json:"customer_id"`
+	Amount            domain.Money     `json:"amount"`
+	RefundedAmount    domain.Money     `json:"refunded_amount"`
+	Status            PaymentStatus    `json:"status"`
+	Provider          string           `json:"provider"`
+	ProviderReference string           `json:"provider_reference,omitempty"`
+	FailureReason     string           `json:"failure_reason,omitempty"`
+	Attempts          []PaymentAttempt `json:"attempts"`
+}
+
+// NewPayment creates a pending payment intent for an order
+func NewPayment(orderID, customerID string, amount domain.Money, provider string) (*Payment, error) {
+	// Validate input
+	var validationErrors domain.ValidationErrors
+
+	orderID = strings.TrimSpace(orderID)
+	if orderID == "" {
+		validationErrors.Add("order_id", "order_id is required")
+	}
+
+	if amount.Amount <= 0 {
+		validationErrors.AddWithValue("amount", "amount must be positive", amount.Amount)
+	}
+
+	currency, err := domain.NormalizeCurrency(amount.Currency)
+	if err != nil {
+		validationErrors.AddWithValue("currency", "currency must be an ISO 4217 code", amount.Currency)
+	}
+
+	if provider == "" {
+		validationErrors.Add("provider", "provider is required")
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	// Create payment
+	payment := &Payment{
+		BaseAggregateRoot: domain.NewBaseAggregateRoot(),
+		OrderID:           orderID,
+		CustomerID:        customerID,
+		Amount:            domain.Money{Amount: amount.Amount, Currency: currency},
+		RefundedAmount:    domain.ZeroMoney(currency),
+		Status:            PaymentStatusPending,
+		Provider:          provider,
+		Attempts:          []PaymentAttempt{},
+	}
+
+	// Add domain event
+	payment.AddEvent(NewPaymentCreatedEvent(payment))
+
+	return payment, nil
+}
+
+// paymentTransitions lists the statuses each payment status may move to
+var paymentTransitions = map[PaymentStatus][]PaymentStatus{
+	PaymentStatusPending:    {PaymentStatusProcessing, PaymentStatusSucceeded, PaymentStatusFailed, PaymentStatusCancelled},
+	PaymentStatusProcessing: {PaymentStatusSucceeded, PaymentStatusFailed},
+	PaymentStatusFailed:     {PaymentStatusProcessing, PaymentStatusSucceeded, PaymentStatusFailed, PaymentStatusCancelled},
+	PaymentStatusSucceeded:  {PaymentStatusRefunded},
+}
+
+// CanTransitionTo checks if the payment may move to the given status
+func (p *Payment) CanTransitionTo(status PaymentStatus) bool {
+	for _, allowed := range paymentTransitions[p.Status] {
+		if allowed == status {
+			return true
+		}
+	}
+	return false
+}
+
+// CanCharge checks if a charge may be attempted
+func (p *Payment) CanCharge() bool {
+	return p.Status == PaymentStatusPending || p.Status == PaymentStatusFailed
+}
+
+// RecordChargeSubmitted records a charge the provider accepted but has not settled yet
+// The outcome arrives later through a provider webhook
+func (p *Payment) RecordChargeSubmitted(providerReference string) error {
+	if err := p.transitionTo(PaymentStatusProcessing); err != nil {
+		return err
+	}
+
+	p.ProviderReference = providerReference
+	p.FailureReason = ""
+	p.addAttempt(AttemptKindCharge, AttemptStatusPending, p.Amount, providerReference, "")
+
+	return nil
+}
+
+// RecordChargeSucceeded records a settled charge
+// Repeated notifications for an already settled charge are ignored
+func (p *Payment) RecordChargeSucceeded(providerReference string) error {
+	if p.Status == PaymentStatusSucceeded && p.ProviderReference == providerReference {
+		return nil
+	}
+	if err := p.transitionTo(PaymentStatusSucceeded); err != nil {
+		return err
+	}
+
+	p.ProviderReference = providerReference
+	p.FailureReason = ""
+	p.addAttempt(AttemptKindCharge, AttemptStatusSucceeded, p.Amount, providerReference, "")
+
+	// Add domain event
+	p.AddEvent(NewPaymentSucceededEvent(p))
+
+	return nil
+}
+
+// RecordChargeFailed records a declined or failed charge
+func (p *Payment) RecordChargeFailed(providerReference, reason string) error {
+	if err := p.transitionTo(PaymentStatusFailed); err != nil {
+		return err
+	}
+
+	if providerReference != "" {
+		p.ProviderReference = providerReference
+	}
+	p.FailureReason = reason
+	p.addAttempt(AttemptKindCharge, AttemptStatusFailed, p.Amount, providerReference, reason)
+
+	// Add domain event
+	p.AddEvent(NewPaymentFailedEvent(p))
+
+	return nil
+}
+
+// Cancel cancels a payment that has not been collected
+func (p *Payment) Cancel(reason string) error {
+	if p.Status == PaymentStatusCancelled {
+		return nil
+	}
+
+	previousStatus := p.Status
+	if err := p.transitionTo(PaymentStatusCancelled); err != nil {
+		return err
+	}
+
+	// Add domain event
+	p.AddEvent(NewPaymentCancelledEvent(p, previousStatus, reason))
+
+	return nil
+}
+
+// ChangeAmount sets the amount to collect, when the order total changes before the payment is charged
+// Setting the current amount again is a no-op
+func (p *Payment) ChangeAmount(amount domain.Money) error {
+	if p.Status != PaymentStatusPending && p.Status != PaymentStatusFailed {
+		return domain.NewBusinessRuleError(
+			"payment_amount_locked",
+			fmt.Sprintf("cannot change the amount of a payment in status %s", p.Status),
+		)
+	}
+	if amount.Currency != p.Amount.Currency {
+		return domain.NewBusinessRuleError(
+			"currency_mismatch",
+			fmt.Sprintf("cannot change a payment in %s to an amount in %s", p.Amount.Currency, amount.Currency),
+		)
+	}
+	if amount.Amount <= 0 {
+		return domain.NewValidationErrorWithValue("amount", "amount must be positive", amount.Amount)
+	}
+	if amount.Amount == p.Amount.Amount {
+		return nil
+	}
+
+	previousAmount := p.Amount
+	p.Amount = amount
+	p.IncrementVersion()
+
+	// Add domain event
+	p.AddEvent(NewPaymentAmountChangedEvent(p, previousAmount))
+
+	return nil
+}
+
+// RefundableAmount returns the collected amount that has not been refunded yet
+func (p *Payment) RefundableAmount() domain.Money {
+	return domain.Money{Amount: p.Amount.Amount - p.RefundedAmount.Amount, Currency: p.Amount.Currency}
+}
+
+// HasRefundForReturn checks if a partial refund was already recorded for an order return
+func (p *Payment) HasRefundForReturn(returnID string) bool {
+	for _, attempt := range p.Attempts {
+		if attempt.Kind == AttemptKindRefund && attempt.Status == AttemptStatusSucceeded && attempt.ReturnID == returnID {
+			return true
+		}
+	}
+	return false
+}
+
+// CanRefund checks if amount may be refunded from the payment
+func (p *Payment) CanRefund(amount domain.Money) error {
+	if p.Status != PaymentStatusSucceeded {
+		return domain.NewBusinessRuleError(
+			"invalid_status_transition",
+			fmt.Sprintf("cannot refund a payment in status %s", p.Status),
+		)
+	}
+	if amount.Currency != p.Amount.Currency {
+		return domain.NewBusinessRuleError(
+			"currency_mismatch",
+			fmt.Sprintf("cannot refund %s from a payment in %s", amount.Currency, p.Amount.Currency),
+		)
+	}
+	if amount.Amount <= 0 {
+		return domain.NewValidationErrorWithValue("amount", "refund amount must be positive", amount.Amount)
+	}
+	if amount.Amount > p.RefundableAmount().Amount {
+		return domain.NewBusinessRuleError(
+			"refund_exceeds_payment",
+			fmt.Sprintf("cannot refund %s, only %s is refundable", amount, p.RefundableAmount()),
+		)
+	}
+	return nil
+}
+
+// RecordRefund records a refund of everything not refunded yet
+func (p *Payment) RecordRefund(providerReference string) error {
+	if p.Status == PaymentStatusRefunded {
+		return nil
+	}
+	return p.recordRefund(p.RefundableAmount(), providerReference, "")
+}
+
+// RecordPartialRefund records a refund of part of a settled charge for an order return
+// The payment becomes refunded once nothing is left to refund; a repeated refund for the same return is ignored
+func (p *Payment) RecordPartialRefund(amount domain.Money, providerReference, returnID string) error {
+	if returnID != "" && p.HasRefundForReturn(returnID) {
+		return nil
+	}
+	return p.recordRefund(amount, providerReference, returnID)
+}
+
+// recordRefund adds a refund to the refunded amount and moves the payment to refunded when fully refunded
+func (p *Payment) recordRefund(amount domain.Money, providerReference, returnID string) error {
+	if err := p.CanRefund(amount); err != nil {
+		return err
+	}
+
+	p.RefundedAmount = domain.Money{Amount: p.RefundedAmount.Amount + amount.Amount, Currency: p.Amount.Currency}
+	if p.RefundableAmount().IsZero() {
+		if err := p.transitionTo(PaymentStatusRefunded); err != nil {
+			return err
+		}
+	} else {
+		p.IncrementVersion()
+	}
+
+	p.addAttempt(AttemptKindRefund, AttemptStatusSucceeded, amount, providerReference, "")
+	p.Attempts[len(p.Attempts)-1].ReturnID = returnID
+
+	// Add domain event
+	p.AddEvent(NewPaymentRefundedEvent(p, amount, providerReference, returnID))
+
+	return nil
+}
+
+// addAttempt appends a provider call to the attempt history
+func (p *Payment) addAttempt(kind AttemptKind, status AttemptStatus, amount domain.Money, providerReference, reason string) {
+	p.Attempts = append(p.Attempts, PaymentAttempt{
+		ID:                uuid.New().String(),
+		Kind:              kind,
+		Status:            status,
+		Amount:            amount,
+		ProviderReference: providerReference,
+		FailureReason:     reason,
+		CreatedAt:         time.Now(),
+	})
+}
+
+// transitionTo moves the payment to a new status if the transition is allowed
+func (p *Payment) transitionTo(status PaymentStatus) error {
+	if !p.CanTransitionTo(status) {
+		return domain.NewBusinessRuleError(
+			"invalid_status_transition",
+			fmt.Sprintf("cannot change payment status from %s to %s", p.Status, status),
+		)
+	}
+
+	p.Status = status
+	p.IncrementVersion()
+	return nil
+}
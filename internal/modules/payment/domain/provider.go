@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// ChargeStatus represents the provider's answer to a charge request
+type ChargeStatus string
+
+const (
+	// ChargeStatusSucceeded means the money has been collected
+	ChargeStatusSucceeded ChargeStatus = "succeeded"
+	// ChargeStatusPending means the provider settles the charge asynchronously and reports it via webhook
+	ChargeStatusPending ChargeStatus = "pending"
+	// ChargeStatusFailed means the charge was declined
+	ChargeStatusFailed ChargeStatus = "failed"
+)
+
+// ChargeRequest asks a provider to collect a payment
+// PaymentID doubles as the idempotency key, so retried requests never charge twice
+type ChargeRequest struct {
+	PaymentID     string
+	OrderID       string
+	CustomerID    string
+	CustomerEmail string // receipt address; empty when the customer module is unavailable
+	Amount        domain.Money
+}
+
+// ChargeResult is the provider's answer to a charge request
+type ChargeResult struct {
+	Status            ChargeStatus
+	ProviderReference string
+	FailureReason     string
+}
+
+// RefundRequest asks a provider to refund all or part of a collected payment
+// RefundKey doubles as the idempotency key, so a retried request never refunds twice
+type RefundRequest struct {
+	PaymentID         string
+	RefundKey         string
+	ProviderReference string
+	Amount            domain.Money
+	Reason            string
+}
+
+// RefundResult is the provider's answer to a refund request
+type RefundResult struct {
+	ProviderReference string
+}
+
+// WebhookEventType is the provider-independent kind of a webhook notification
+type WebhookEventType string
+
+const (
+	WebhookChargeSucceeded WebhookEventType = "charge.succeeded"
+	WebhookChargeFailed    WebhookEventType = "charge.failed"
+	WebhookRefunded        WebhookEventType = "refund.succeeded"
+	// WebhookIgnored marks notifications the payment module does not act on
+	WebhookIgnored WebhookEventType = "ignored"
+)
+
+// WebhookEvent is a verified provider notification
+type WebhookEvent struct {
+	ID                string
+	Type              WebhookEventType
+	PaymentID         string
+	ProviderReference string
+	FailureReason     string
+}
+
+// ErrInvalidWebhookSignature is returned when a webhook cannot be authenticated
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// PaymentProvider is implemented by payment service adapters
+// Charge and Refund return an error only when the provider could not be reached or
+// answered unexpectedly; a declined card is a ChargeResult with ChargeStatusFailed.
+type PaymentProvider interface {
+	// Name returns the provider identifier stored on payments (e.g. "stripe")
+	Name() string
+
+	// Charge collects a payment
+	Charge(ctx context.Context, req ChargeRequest) (*ChargeResult, error)
+
+	// Refund refunds the requested amount of a collected payment
+	Refund(ctx context.Context, req RefundRequest) (*RefundResult, error)
+
+	// VerifyWebhook authenticates a webhook request and decodes its payload
+	// It returns ErrInvalidWebhookSignature when the signature does not match
+	VerifyWebhook(payload []byte, headers map[string][]string) (*WebhookEvent, error)
+}
+
+// PaymentProviders holds the configured providers by name
+type PaymentProviders map[string]PaymentProvider
+
+// Get returns the provider with the given name
+func (p PaymentProviders) Get(name string) (PaymentProvider, error) {
+	provider, ok := p[name]
+	if !ok {
+		return nil, domain.NewDomainErrorWithField(
+			domain.ErrCodeNotFound,
+			fmt.Sprintf("payment provider %s is not configured", name),
+			"provider",
+		)
+	}
+	return provider, nil
+}
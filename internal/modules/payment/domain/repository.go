@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+)
+
+// PaymentRepository defines the interface for payment persistence
+type PaymentRepository interface {
+	// Save saves a payment and appends its new attempts
+	Save(ctx context.Context, payment *Payment) error
+
+	// GetByID retrieves a payment with its attempts by ID
+	GetByID(ctx context.Context, id string) (*Payment, error)
+
+	// GetByOrderID retrieves the payment of an order
+	GetByOrderID(ctx context.Context, orderID string) (*Payment, error)
+}
+
+// WebhookInbox records processed provider webhooks so retried deliveries are applied once
+type WebhookInbox interface {
+	// WasProcessed checks if a webhook event has already been applied
+	WasProcessed(ctx context.Context, provider, eventID string) (bool, error)
+
+	// MarkProcessed records a webhook event as applied
+	MarkProcessed(ctx context.Context, provider, eventID string) error
+}
@@ -0,0 +1,18 @@
+package database
+
+import (
+	"golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// PaymentDatabaseName is the identifier for payment database
+	PaymentDatabaseName = "payment"
+)
+
+// GetPaymentDB returns the payment database connection
+func GetPaymentDB() (*gorm.DB, error) {
+	manager := database.GetGlobalManager()
+	return manager.GetConnection(PaymentDatabaseName)
+}
@@ -0,0 +1,114 @@
+package eventhandlers
+
+import (
+	"context"
+	"fmt"
+
+	orderapi "golang_modular_monolith/internal/modules/order/publicapi"
+	commandhandlers "golang_modular_monolith/internal/modules/payment/application/command_handlers"
+	"golang_modular_monolith/internal/modules/payment/application/commands"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// OrderEventsHandler drives payments from the order lifecycle:
+// a payment intent is opened when an order is created, follows its total as lines
+// are added, is charged once the order is confirmed, cancelled or refunded when the
+// order is cancelled, and partially refunded when a return is approved
+type OrderEventsHandler struct {
+	createPaymentHandler       *commandhandlers.CreatePaymentHandler
+	changePaymentAmountHandler *commandhandlers.ChangePaymentAmountHandler
+	chargePaymentHandler       *commandhandlers.ChargePaymentHandler
+	cancelPaymentHandler       *commandhandlers.CancelPaymentHandler
+	refundReturnHandler        *commandhandlers.RefundReturnHandler
+}
+
+// NewOrderEventsHandler creates a new order events handler
+func NewOrderEventsHandler(
+	createPaymentHandler *commandhandlers.CreatePaymentHandler,
+	changePaymentAmountHandler *commandhandlers.ChangePaymentAmountHandler,
+	chargePaymentHandler *commandhandlers.ChargePaymentHandler,
+	cancelPaymentHandler *commandhandlers.CancelPaymentHandler,
+	refundReturnHandler *commandhandlers.RefundReturnHandler,
+) *OrderEventsHandler {
+	return &OrderEventsHandler{
+		createPaymentHandler:       createPaymentHandler,
+		changePaymentAmountHandler: changePaymentAmountHandler,
+		chargePaymentHandler:       chargePaymentHandler,
+		cancelPaymentHandler:       cancelPaymentHandler,
+		refundReturnHandler:        refundReturnHandler,
+	}
+}
+
+// CanHandle reports whether the handler is interested in the event type
+func (h *OrderEventsHandler) CanHandle(eventType string) bool {
+	switch eventType {
+	case orderapi.OrderCreatedEventType,
+		orderapi.OrderLineAddedEventType,
+		orderapi.OrderConfirmedEventType,
+		orderapi.OrderCancelledEventType,
+		orderapi.OrderReturnApprovedEventType:
+		return true
+	}
+	return false
+}
+
+// Handle applies an order event to the order's payment
+func (h *OrderEventsHandler) Handle(event shareddomain.DomainEvent) error {
+	ctx := shareddomain.WithEventActor(context.Background(), event)
+	orderID := event.GetAggregateID()
+
+	switch event.GetEventType() {
+	case orderapi.OrderCreatedEventType:
+		created, ok := event.(orderapi.OrderCreated)
+		if !ok {
+			return fmt.Errorf("unsupported event %T for order events handler", event)
+		}
+
+		cmd := commands.NewCreatePaymentCommand(created.GetOrderID(), created.GetCustomerID(), created.GetTotal())
+		if _, err := h.createPaymentHandler.Handle(ctx, &cmd); err != nil {
+			return fmt.Errorf("failed to create payment for order %s: %w", orderID, err)
+		}
+	case orderapi.OrderLineAddedEventType:
+		added, ok := event.(orderapi.OrderLineAdded)
+		if !ok {
+			return fmt.Errorf("unsupported event %T for order events handler", event)
+		}
+
+		cmd := commands.NewChangePaymentAmountCommand(added.GetOrderID(), added.GetTotal())
+		if _, err := h.changePaymentAmountHandler.Handle(ctx, &cmd); err != nil {
+			return fmt.Errorf("failed to change payment amount of order %s: %w", orderID, err)
+		}
+	case orderapi.OrderConfirmedEventType:
+		cmd := commands.NewChargePaymentCommand(orderID)
+		if _, err := h.chargePaymentHandler.Handle(ctx, &cmd); err != nil {
+			return fmt.Errorf("failed to charge payment for order %s: %w", orderID, err)
+		}
+	case orderapi.OrderCancelledEventType:
+		reason := "order cancelled"
+		if cancelled, ok := event.(orderapi.OrderCancelled); ok {
+			reason += ": " + cancelled.GetReasonCode()
+		}
+
+		cmd := commands.NewCancelPaymentCommand(orderID, reason)
+		if _, err := h.cancelPaymentHandler.Handle(ctx, &cmd); err != nil {
+			return fmt.Errorf("failed to cancel payment for order %s: %w", orderID, err)
+		}
+	case orderapi.OrderReturnApprovedEventType:
+		approved, ok := event.(orderapi.ReturnApproved)
+		if !ok {
+			return fmt.Errorf("unsupported event %T for order events handler", event)
+		}
+
+		reason := "order return"
+		if approved.GetReason() != "" {
+			reason += ": " + approved.GetReason()
+		}
+
+		cmd := commands.NewRefundReturnCommand(approved.GetOrderID(), approved.GetReturnID(), approved.GetRefundAmount(), reason)
+		if _, err := h.refundReturnHandler.Handle(ctx, &cmd); err != nil {
+			return fmt.Errorf("failed to refund return %s of order %s: %w", approved.GetReturnID(), approved.GetOrderID(), err)
+		}
+	}
+
+	return nil
+}
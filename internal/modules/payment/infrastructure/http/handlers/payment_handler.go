@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	commandhandlers "golang_modular_monolith/internal/modules/payment/application/command_handlers"
+	"golang_modular_monolith/internal/modules/payment/application/commands"
+	"golang_modular_monolith/internal/modules/payment/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/payment/application/query_handlers"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/httplimit"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxWebhookBodyBytes bounds the size of provider webhook payloads
+const maxWebhookBodyBytes = 1 << 20
+
+// PaymentHandler handles HTTP requests for payment operations
+type PaymentHandler struct {
+	// Command handlers
+	refundPaymentHandler *commandhandlers.RefundPaymentHandler
+	handleWebhookHandler *commandhandlers.HandleWebhookHandler
+
+	// Query handlers
+	getPaymentHandler *queryhandlers.GetPaymentHandler
+}
+
+// NewPaymentHandler creates a new payment handler
+func NewPaymentHandler(
+	refundPaymentHandler *commandhandlers.RefundPaymentHandler,
+	handleWebhookHandler *commandhandlers.HandleWebhookHandler,
+	getPaymentHandler *queryhandlers.GetPaymentHandler,
+) *PaymentHandler {
+	return &PaymentHandler{
+		refundPaymentHandler: refundPaymentHandler,
+		handleWebhookHandler: handleWebhookHandler,
+		getPaymentHandler:    getPaymentHandler,
+	}
+}
+
+// RefundPaymentRequest represents the request body for refunding a payment
+type RefundPaymentRequest struct {
+	Reason string `json:"reason" binding:"max=500"`
+}
+
+// GetPayment handles GET /payments/:id
+func (h *PaymentHandler) GetPayment(c *gin.Context) {
+	query := &queries.GetPaymentQuery{
+		ID: c.Param("id"),
+	}
+
+	h.getPayment(c, query)
+}
+
+// GetOrderPayment handles GET /payments?order_id=
+func (h *PaymentHandler) GetOrderPayment(c *gin.Context) {
+	orderID := c.Query("order_id")
+	if orderID == "" {
+		h.handleError(c, shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeInvalidInput,
+			"order_id query parameter is required",
+			"order_id",
+		))
+		return
+	}
+
+	query := &queries.GetPaymentQuery{
+		OrderID: orderID,
+	}
+
+	h.getPayment(c, query)
+}
+
+// getPayment runs a payment query and writes the response
+func (h *PaymentHandler) getPayment(c *gin.Context, query *queries.GetPaymentQuery) {
+	result, err := h.getPaymentHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result.Payment,
+	})
+}
+
+// RefundPayment handles POST /payments/:id/refund
+func (h *PaymentHandler) RefundPayment(c *gin.Context) {
+	var req RefundPaymentRequest
+	if c.Request.ContentLength != 0 {
+		if err := validation.BindJSON(c, &req); err != nil {
+			h.handleError(c, err)
+			return
+		}
+	}
+
+	cmd := commands.NewRefundPaymentCommand(c.Param("id"), req.Reason)
+
+	result, err := h.refundPaymentHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// HandleWebhook handles POST /payments/webhooks/:provider
+// The raw body is passed on untouched because providers sign the exact bytes they send
+func (h *PaymentHandler) HandleWebhook(c *gin.Context) {
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookBodyBytes))
+	if err != nil {
+		h.handleError(c, httplimit.ReadError(err))
+		return
+	}
+
+	cmd := commands.NewHandleWebhookCommand(c.Param("provider"), payload, c.Request.Header)
+
+	result, err := h.handleWebhookHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// handleError handles errors and returns appropriate HTTP responses
+func (h *PaymentHandler) handleError(c *gin.Context, err error) {
+	var validationErrs shareddomain.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErrs.Error(),
+				"details": validationErrs,
+			},
+		})
+		return
+	}
+
+	var validationErr shareddomain.ValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErr.Message,
+				"field":   validationErr.Field,
+			},
+		})
+		return
+	}
+
+	var businessErr shareddomain.BusinessRuleError
+	if errors.As(err, &businessErr) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeBusinessRule,
+				"message": businessErr.Message,
+				"rule":    businessErr.Rule,
+			},
+		})
+		return
+	}
+
+	var domainErr shareddomain.DomainError
+	if errors.As(err, &domainErr) {
+		status := http.StatusInternalServerError
+		switch domainErr.Code {
+		case shareddomain.ErrCodeNotFound:
+			status = http.StatusNotFound
+		case shareddomain.ErrCodeAlreadyExists, shareddomain.ErrCodeConcurrencyConflict:
+			status = http.StatusConflict
+		case shareddomain.ErrCodeInvalidInput, shareddomain.ErrCodeValidationFailed:
+			status = http.StatusBadRequest
+		case shareddomain.ErrCodeRequestTooLarge:
+			status = http.StatusRequestEntityTooLarge
+		case shareddomain.ErrCodeRequestTimeout:
+			status = http.StatusRequestTimeout
+		case shareddomain.ErrCodeUnauthorized:
+			status = http.StatusUnauthorized
+		case shareddomain.ErrCodeInvalidState:
+			status = http.StatusUnprocessableEntity
+		}
+
+		if status == http.StatusInternalServerError {
+			h.internalError(c)
+			return
+		}
+
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    domainErr.Code,
+				"message": domainErr.Message,
+				"field":   domainErr.Field,
+			},
+		})
+		return
+	}
+
+	// Handle standard errors
+	if shareddomain.IsNotFoundError(err) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "NOT_FOUND",
+				"message": "Resource not found",
+			},
+		})
+		return
+	}
+
+	h.internalError(c)
+}
+
+// internalError writes a generic internal error response
+func (h *PaymentHandler) internalError(c *gin.Context) {
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": "An internal error occurred",
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/payment/application/commands"
+	"golang_modular_monolith/internal/modules/payment/domain"
+	"golang_modular_monolith/internal/modules/payment/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/payment/infrastructure/providers"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+)
+
+// APIOperations documents the routes registered by RegisterPaymentRoutes
+func APIOperations() []*openapi.Operation {
+	return []*openapi.Operation{
+		openapi.Get("/payments", "Get the payment of an order").
+			Query("order_id", "string", "Order ID").
+			Requires("payments:read").
+			Returns(domain.Payment{}),
+		openapi.Get("/payments/:id", "Get a payment").
+			Requires("payments:read").
+			Returns(domain.Payment{}),
+		openapi.Post("/payments/:id/refund", "Refund a payment").
+			Describe("The body is optional").
+			Body(handlers.RefundPaymentRequest{}).
+			Requires("payments:refund").
+			Returns(commands.PaymentResult{}),
+		openapi.Post("/payments/webhooks/:provider", "Receive a payment provider notification").
+			Describe("The provider signs the raw body; repeated deliveries are acknowledged without changing the payment").
+			Header(providers.StripeSignatureHeader, "Signature of Stripe notifications").
+			Header(providers.FakeSignatureHeader, "Signature of fake provider notifications").
+			Returns(commands.WebhookResult{}),
+	}
+}
@@ -0,0 +1,19 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/payment/infrastructure/http/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterPaymentRoutes registers payment routes
+func RegisterPaymentRoutes(router *gin.RouterGroup, paymentHandler *handlers.PaymentHandler) {
+	// Payment routes
+	payments := router.Group("/payments")
+	{
+		payments.GET("", paymentHandler.GetOrderPayment)
+		payments.GET("/:id", paymentHandler.GetPayment)
+		payments.POST("/:id/refund", paymentHandler.RefundPayment)
+		payments.POST("/webhooks/:provider", paymentHandler.HandleWebhook)
+	}
+}
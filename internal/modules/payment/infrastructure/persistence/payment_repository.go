@@ -0,0 +1,275 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/payment/domain"
+	paymentdb "golang_modular_monolith/internal/modules/payment/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PaymentModel represents the payment database model
+type PaymentModel struct {
+	ID                string                `gorm:"primaryKey;type:varchar(36)"`
+	TenantID          string                `gorm:"type:varchar(64);not null;default:''"`
+	OrderID           string                `gorm:"type:varchar(36);not null;uniqueIndex"`
+	CustomerID        string                `gorm:"type:varchar(36);not null;default:''"`
+	Amount            int64                 `gorm:"not null"`
+	RefundedAmount    int64                 `gorm:"not null;default:0"`
+	Currency          string                `gorm:"type:char(3);not null"`
+	Status            string                `gorm:"type:payment_status;not null;default:pending"`
+	Provider          string                `gorm:"type:varchar(32);not null"`
+	ProviderReference string                `gorm:"type:varchar(255);not null;default:''"`
+	FailureReason     string                `gorm:"type:text;not null;default:''"`
+	Version           int                   `gorm:"not null;default:0"`
+	Attempts          []PaymentAttemptModel `gorm:"foreignKey:PaymentID"`
+	CreatedAt         time.Time             `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt         time.Time             `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (PaymentModel) TableName() string {
+	return "payments"
+}
+
+// PaymentAttemptModel represents the payment attempt database model
+type PaymentAttemptModel struct {
+	ID                string    `gorm:"primaryKey;type:varchar(36)"`
+	PaymentID         string    `gorm:"type:varchar(36);not null;index"`
+	Kind              string    `gorm:"type:varchar(16);not null"`
+	Status            string    `gorm:"type:varchar(16);not null"`
+	Amount            int64     `gorm:"not null"`
+	Currency          string    `gorm:"type:char(3);not null"`
+	ProviderReference string    `gorm:"type:varchar(255);not null;default:''"`
+	FailureReason     string    `gorm:"type:text;not null;default:''"`
+	ReturnID          string    `gorm:"type:varchar(36);not null;default:''"`
+	CreatedAt         time.Time `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (PaymentAttemptModel) TableName() string {
+	return "payment_attempts"
+}
+
+// ToEntity converts database model to domain entity
+func (m *PaymentModel) ToEntity() *domain.Payment {
+	payment := &domain.Payment{
+		BaseAggregateRoot: shareddomain.NewBaseAggregateRootWithID(m.ID),
+		OrderID:           m.OrderID,
+		CustomerID:        m.CustomerID,
+		Amount:            shareddomain.Money{Amount: m.Amount, Currency: m.Currency},
+		RefundedAmount:    shareddomain.Money{Amount: m.RefundedAmount, Currency: m.Currency},
+		Status:            domain.PaymentStatus(m.Status),
+		Provider:          m.Provider,
+		ProviderReference: m.ProviderReference,
+		FailureReason:     m.FailureReason,
+		Attempts:          make([]domain.PaymentAttempt, len(m.Attempts)),
+	}
+
+	for i, attempt := range m.Attempts {
+		payment.Attempts[i] = domain.PaymentAttempt{
+			ID:                attempt.ID,
+			Kind:              domain.AttemptKind(attempt.Kind),
+			Status:            domain.AttemptStatus(attempt.Status),
+			Amount:            shareddomain.Money{Amount: attempt.Amount, Currency: attempt.Currency},
+			ProviderReference: attempt.ProviderReference,
+			FailureReason:     attempt.FailureReason,
+			ReturnID:          attempt.ReturnID,
+			CreatedAt:         attempt.CreatedAt,
+		}
+	}
+
+	// Set version and timestamps from database
+	payment.Version = m.Version
+	payment.CreatedAt = m.CreatedAt
+	payment.UpdatedAt = m.UpdatedAt
+
+	return payment
+}
+
+// FromEntity converts domain entity to database model
+func (m *PaymentModel) FromEntity(payment *domain.Payment) {
+	m.ID = payment.GetID()
+	m.OrderID = payment.OrderID
+	m.CustomerID = payment.CustomerID
+	m.Amount = payment.Amount.Amount
+	m.RefundedAmount = payment.RefundedAmount.Amount
+	m.Currency = payment.Amount.Currency
+	m.Status = string(payment.Status)
+	m.Provider = payment.Provider
+	m.ProviderReference = payment.ProviderReference
+	m.FailureReason = payment.FailureReason
+	m.Version = payment.GetVersion()
+	m.CreatedAt = payment.GetCreatedAt()
+	m.UpdatedAt = payment.GetUpdatedAt()
+
+	m.Attempts = make([]PaymentAttemptModel, len(payment.Attempts))
+	for i, attempt := range payment.Attempts {
+		m.Attempts[i] = PaymentAttemptModel{
+			ID:                attempt.ID,
+			PaymentID:         payment.GetID(),
+			Kind:              string(attempt.Kind),
+			Status:            string(attempt.Status),
+			Amount:            attempt.Amount.Amount,
+			Currency:          attempt.Amount.Currency,
+			ProviderReference: attempt.ProviderReference,
+			FailureReason:     attempt.FailureReason,
+			ReturnID:          attempt.ReturnID,
+			CreatedAt:         attempt.CreatedAt,
+		}
+	}
+}
+
+// PostgreSQLPaymentRepository implements PaymentRepository using PostgreSQL
+type PostgreSQLPaymentRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLPaymentRepository creates a new PostgreSQL payment repository
+func NewPostgreSQLPaymentRepository(db *gorm.DB) *PostgreSQLPaymentRepository {
+	return &PostgreSQLPaymentRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLPaymentRepositoryFromManager creates repository using database manager
+func NewPostgreSQLPaymentRepositoryFromManager() (*PostgreSQLPaymentRepository, error) {
+	db, err := paymentdb.GetPaymentDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment database: %w", err)
+	}
+
+	return &PostgreSQLPaymentRepository{
+		db: db,
+	}, nil
+}
+
+// Save saves a payment and appends its new attempts in a single transaction
+func (r *PostgreSQLPaymentRepository) Save(ctx context.Context, payment *domain.Payment) error {
+	model := &PaymentModel{}
+	model.FromEntity(payment)
+	attempts := model.Attempts
+	model.Attempts = nil
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(model).Error; err != nil {
+			return fmt.Errorf("failed to save payment: %w", err)
+		}
+
+		// Attempts are append-only, so existing rows are left untouched
+		if len(attempts) > 0 {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&attempts).Error; err != nil {
+				return fmt.Errorf("failed to save payment attempts: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Clear uncommitted events after successful save
+	payment.ClearUncommittedEvents()
+
+	return nil
+}
+
+// GetByID retrieves a payment with its attempts by ID
+func (r *PostgreSQLPaymentRepository) GetByID(ctx context.Context, id string) (*domain.Payment, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+// GetByOrderID retrieves the payment of an order
+func (r *PostgreSQLPaymentRepository) GetByOrderID(ctx context.Context, orderID string) (*domain.Payment, error) {
+	return r.findOne(ctx, "order_id = ?", orderID)
+}
+
+// findOne loads a single payment with its attempts in chronological order
+func (r *PostgreSQLPaymentRepository) findOne(ctx context.Context, condition string, value string) (*domain.Payment, error) {
+	var model PaymentModel
+	result := r.db.WithContext(ctx).
+		Preload("Attempts", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Where(condition, value).
+		First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get payment: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// WebhookEventModel represents a processed provider webhook
+type WebhookEventModel struct {
+	Provider    string    `gorm:"primaryKey;type:varchar(32)"`
+	EventID     string    `gorm:"primaryKey;type:varchar(255)"`
+	ProcessedAt time.Time `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (WebhookEventModel) TableName() string {
+	return "payment_webhook_events"
+}
+
+// PostgreSQLWebhookInbox implements WebhookInbox using PostgreSQL
+type PostgreSQLWebhookInbox struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLWebhookInbox creates a new PostgreSQL webhook inbox
+func NewPostgreSQLWebhookInbox(db *gorm.DB) *PostgreSQLWebhookInbox {
+	return &PostgreSQLWebhookInbox{
+		db: db,
+	}
+}
+
+// NewPostgreSQLWebhookInboxFromManager creates a webhook inbox using database manager
+func NewPostgreSQLWebhookInboxFromManager() (*PostgreSQLWebhookInbox, error) {
+	db, err := paymentdb.GetPaymentDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment database: %w", err)
+	}
+
+	return &PostgreSQLWebhookInbox{
+		db: db,
+	}, nil
+}
+
+// WasProcessed checks if a webhook event has already been applied
+func (i *PostgreSQLWebhookInbox) WasProcessed(ctx context.Context, provider, eventID string) (bool, error) {
+	var count int64
+	result := i.db.WithContext(ctx).Model(&WebhookEventModel{}).
+		Where("provider = ? AND event_id = ?", provider, eventID).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to check webhook event: %w", result.Error)
+	}
+
+	return count > 0, nil
+}
+
+// MarkProcessed records a webhook event as applied
+func (i *PostgreSQLWebhookInbox) MarkProcessed(ctx context.Context, provider, eventID string) error {
+	result := i.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&WebhookEventModel{Provider: provider, EventID: eventID, ProcessedAt: time.Now()})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to record webhook event: %w", result.Error)
+	}
+
+	return nil
+}
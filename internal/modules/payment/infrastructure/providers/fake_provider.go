@@ -0,0 +1,118 @@
+package providers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"golang_modular_monolith/internal/modules/payment/domain"
+
+	"github.com/google/uuid"
+)
+
+// FakeProviderName is the identifier of the fake provider
+const FakeProviderName = "fake"
+
+// FakeSignatureHeader carries the hex HMAC-SHA256 of a fake webhook body
+const FakeSignatureHeader = "X-Fake-Signature"
+
+// FakeConfig configures the fake provider
+type FakeConfig struct {
+	// DeclineAbove declines charges above this amount in minor units; 0 never declines
+	DeclineAbove int64
+	// WebhookSecret signs webhooks; when empty, unsigned webhooks are accepted
+	WebhookSecret string
+}
+
+// FakeProvider settles payments in memory without calling any service
+// It is meant for local development and demos
+type FakeProvider struct {
+	config FakeConfig
+}
+
+// NewFakeProvider creates a new fake payment provider
+func NewFakeProvider(config FakeConfig) *FakeProvider {
+	return &FakeProvider{
+		config: config,
+	}
+}
+
+// Name returns the provider identifier
+func (p *FakeProvider) Name() string {
+	return FakeProviderName
+}
+
+// Charge succeeds immediately unless the amount is above the decline threshold
+func (p *FakeProvider) Charge(ctx context.Context, req domain.ChargeRequest) (*domain.ChargeResult, error) {
+	reference := "fake_ch_" + uuid.New().String()
+
+	if p.config.DeclineAbove > 0 && req.Amount.Amount > p.config.DeclineAbove {
+		return &domain.ChargeResult{
+			Status:            domain.ChargeStatusFailed,
+			ProviderReference: reference,
+			FailureReason:     "card_declined",
+		}, nil
+	}
+
+	return &domain.ChargeResult{
+		Status:            domain.ChargeStatusSucceeded,
+		ProviderReference: reference,
+	}, nil
+}
+
+// Refund always succeeds
+func (p *FakeProvider) Refund(ctx context.Context, req domain.RefundRequest) (*domain.RefundResult, error) {
+	return &domain.RefundResult{
+		ProviderReference: "fake_re_" + uuid.New().String(),
+	}, nil
+}
+
+// fakeWebhookPayload is the body of a fake webhook
+type fakeWebhookPayload struct {
+	ID            string `json:"id"`
+	Type          string `json:"type"`
+	PaymentID     string `json:"payment_id"`
+	Reference     string `json:"reference"`
+	FailureReason string `json:"failure_reason"`
+}
+
+// VerifyWebhook checks the X-Fake-Signature header and decodes the payload
+func (p *FakeProvider) VerifyWebhook(payload []byte, headers map[string][]string) (*domain.WebhookEvent, error) {
+	if p.config.WebhookSecret != "" {
+		signature, err := hex.DecodeString(http.Header(headers).Get(FakeSignatureHeader))
+		if err != nil || !hmac.Equal(signature, signPayload(p.config.WebhookSecret, payload)) {
+			return nil, domain.ErrInvalidWebhookSignature
+		}
+	}
+
+	var body fakeWebhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("invalid fake webhook payload: %w", err)
+	}
+
+	eventType := domain.WebhookEventType(body.Type)
+	switch eventType {
+	case domain.WebhookChargeSucceeded, domain.WebhookChargeFailed, domain.WebhookRefunded:
+	default:
+		eventType = domain.WebhookIgnored
+	}
+
+	return &domain.WebhookEvent{
+		ID:                body.ID,
+		Type:              eventType,
+		PaymentID:         body.PaymentID,
+		ProviderReference: body.Reference,
+		FailureReason:     body.FailureReason,
+	}, nil
+}
+
+// signPayload computes the HMAC-SHA256 of a payload
+func signPayload(secret string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
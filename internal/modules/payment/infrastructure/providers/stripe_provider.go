@@ -0,0 +1,336 @@
+package providers
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/modules/payment/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/resilience"
+)
+
+// StripeProviderName is the identifier of the Stripe provider
+const StripeProviderName = "stripe"
+
+// StripeSignatureHeader carries the timestamped signature of a Stripe webhook
+const StripeSignatureHeader = "Stripe-Signature"
+
+// StripeConfig configures the Stripe adapter
+type StripeConfig struct {
+	APIKey        string
+	WebhookSecret string
+	// BaseURL defaults to the public Stripe API
+	BaseURL string
+	// Timeout bounds each attempt of an API call
+	Timeout time.Duration
+	// Retries is the number of times a call failing with a transport error or a 5xx is repeated;
+	// requests carry an idempotency key, so Stripe applies a repeated charge once
+	Retries int
+	// Breaker stops calling Stripe for a while after consecutive failed calls
+	Breaker resilience.BreakerSettings
+	// WebhookTolerance is the maximum age of a webhook signature
+	WebhookTolerance time.Duration
+}
+
+// StripeProvider adapts the Stripe PaymentIntents API to PaymentProvider
+// Charges create and confirm a PaymentIntent; if Stripe cannot settle it right away
+// (e.g. 3-D Secure), the outcome arrives via the payment_intent.* webhooks
+type StripeProvider struct {
+	config StripeConfig
+	client *http.Client
+	policy resilience.Policy
+}
+
+// NewStripeProvider creates a new Stripe payment provider
+func NewStripeProvider(config StripeConfig) (*StripeProvider, error) {
+	if config.APIKey == "" {
+		return nil, fmt.Errorf("stripe api_key is required")
+	}
+	if config.BaseURL == "" {
+		config.BaseURL = "https://api.stripe.com"
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+	if config.WebhookTolerance <= 0 {
+		config.WebhookTolerance = 5 * time.Minute
+	}
+
+	return &StripeProvider{
+		config: config,
+		client: &http.Client{},
+		policy: resilience.Policy{
+			Timeout: config.Timeout,
+			Retries: config.Retries,
+			Breaker: resilience.NewBreaker("payment:"+StripeProviderName, config.Breaker),
+		},
+	}, nil
+}
+
+// Name returns the provider identifier
+func (p *StripeProvider) Name() string {
+	return StripeProviderName
+}
+
+// stripePaymentIntent is the subset of a PaymentIntent the adapter reads
+type stripePaymentIntent struct {
+	ID               string `json:"id"`
+	Status           string `json:"status"`
+	LastPaymentError *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"last_payment_error"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// stripeError is the error envelope of the Stripe API
+type stripeError struct {
+	Error struct {
+		Type          string `json:"type"`
+		Code          string `json:"code"`
+		DeclineCode   string `json:"decline_code"`
+		Message       string `json:"message"`
+		PaymentIntent *struct {
+			ID string `json:"id"`
+		} `json:"payment_intent"`
+	} `json:"error"`
+}
+
+// Charge creates and confirms a PaymentIntent for the payment
+func (p *StripeProvider) Charge(ctx context.Context, req domain.ChargeRequest) (*domain.ChargeResult, error) {
+	form := url.Values{}
+	form.Set("amount", strconv.FormatInt(req.Amount.Amount, 10))
+	form.Set("currency", strings.ToLower(req.Amount.Currency))
+	form.Set("confirm", "true")
+	form.Set("automatic_payment_methods[enabled]", "true")
+	form.Set("automatic_payment_methods[allow_redirects]", "never")
+	form.Set("metadata[payment_id]", req.PaymentID)
+	form.Set("metadata[order_id]", req.OrderID)
+	form.Set("metadata[customer_id]", req.CustomerID)
+	if req.CustomerEmail != "" {
+		form.Set("receipt_email", req.CustomerEmail)
+	}
+
+	var intent stripePaymentIntent
+	apiErr, err := p.post(ctx, "/v1/payment_intents", "charge-"+req.PaymentID, form, &intent)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiErr != nil {
+		// Card errors are declines, everything else is a failed call
+		if apiErr.Error.Type != "card_error" {
+			return nil, fmt.Errorf("stripe charge failed: %s", apiErr.Error.Message)
+		}
+
+		result := &domain.ChargeResult{
+			Status:        domain.ChargeStatusFailed,
+			FailureReason: firstNonEmpty(apiErr.Error.DeclineCode, apiErr.Error.Code, apiErr.Error.Message),
+		}
+		if apiErr.Error.PaymentIntent != nil {
+			result.ProviderReference = apiErr.Error.PaymentIntent.ID
+		}
+		return result, nil
+	}
+
+	result := &domain.ChargeResult{ProviderReference: intent.ID}
+	switch intent.Status {
+	case "succeeded":
+		result.Status = domain.ChargeStatusSucceeded
+	case "canceled", "requires_payment_method":
+		result.Status = domain.ChargeStatusFailed
+		result.FailureReason = "payment_method_required"
+		if intent.LastPaymentError != nil {
+			result.FailureReason = firstNonEmpty(intent.LastPaymentError.Code, intent.LastPaymentError.Message)
+		}
+	default:
+		result.Status = domain.ChargeStatusPending
+	}
+
+	return result, nil
+}
+
+// Refund refunds the requested amount of a PaymentIntent
+func (p *StripeProvider) Refund(ctx context.Context, req domain.RefundRequest) (*domain.RefundResult, error) {
+	form := url.Values{}
+	form.Set("payment_intent", req.ProviderReference)
+	form.Set("amount", strconv.FormatInt(req.Amount.Amount, 10))
+	form.Set("metadata[payment_id]", req.PaymentID)
+	if req.Reason != "" {
+		form.Set("metadata[reason]", req.Reason)
+	}
+
+	var refund struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+	}
+	apiErr, err := p.post(ctx, "/v1/refunds", "refund-"+req.RefundKey, form, &refund)
+	if err != nil {
+		return nil, err
+	}
+	if apiErr != nil {
+		return nil, fmt.Errorf("stripe refund failed: %s", apiErr.Error.Message)
+	}
+	if refund.Status == "failed" || refund.Status == "canceled" {
+		return nil, fmt.Errorf("stripe refund %s ended with status %s", refund.ID, refund.Status)
+	}
+
+	return &domain.RefundResult{
+		ProviderReference: refund.ID,
+	}, nil
+}
+
+// stripeEvent is the subset of a webhook event the adapter reads
+type stripeEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Object stripePaymentIntent `json:"object"`
+	} `json:"data"`
+}
+
+// VerifyWebhook checks the Stripe-Signature header and decodes PaymentIntent events
+func (p *StripeProvider) VerifyWebhook(payload []byte, headers map[string][]string) (*domain.WebhookEvent, error) {
+	if err := p.verifySignature(payload, http.Header(headers).Get(StripeSignatureHeader), time.Now()); err != nil {
+		return nil, err
+	}
+
+	var event stripeEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("invalid stripe webhook payload: %w", err)
+	}
+
+	intent := event.Data.Object
+	result := &domain.WebhookEvent{
+		ID:                event.ID,
+		Type:              domain.WebhookIgnored,
+		PaymentID:         intent.Metadata["payment_id"],
+		ProviderReference: intent.ID,
+	}
+
+	switch event.Type {
+	case "payment_intent.succeeded":
+		result.Type = domain.WebhookChargeSucceeded
+	case "payment_intent.payment_failed":
+		result.Type = domain.WebhookChargeFailed
+		if intent.LastPaymentError != nil {
+			result.FailureReason = firstNonEmpty(intent.LastPaymentError.Code, intent.LastPaymentError.Message)
+		}
+	}
+
+	return result, nil
+}
+
+// verifySignature validates a "t=<unix>,v1=<hex>" signature header
+// The signed content is "<t>.<payload>" and any v1 entry may match (secret rotation)
+func (p *StripeProvider) verifySignature(payload []byte, header string, now time.Time) error {
+	if p.config.WebhookSecret == "" || header == "" {
+		return domain.ErrInvalidWebhookSignature
+	}
+
+	var timestamp string
+	var signatures [][]byte
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			if signature, err := hex.DecodeString(value); err == nil {
+				signatures = append(signatures, signature)
+			}
+		}
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return domain.ErrInvalidWebhookSignature
+	}
+
+	age := now.Sub(time.Unix(seconds, 0))
+	if age > p.config.WebhookTolerance || age < -p.config.WebhookTolerance {
+		return domain.ErrInvalidWebhookSignature
+	}
+
+	expected := signPayload(p.config.WebhookSecret, []byte(timestamp+"."+string(payload)))
+	for _, signature := range signatures {
+		if hmac.Equal(signature, expected) {
+			return nil
+		}
+	}
+
+	return domain.ErrInvalidWebhookSignature
+}
+
+// post sends a form-encoded request to the Stripe API through the provider's retry policy and breaker
+// It returns the decoded API error for 4xx answers and an error for transport failures
+func (p *StripeProvider) post(ctx context.Context, path, idempotencyKey string, form url.Values, out interface{}) (*stripeError, error) {
+	var apiErr *stripeError
+	err := p.policy.Do(ctx, func(ctx context.Context) error {
+		var err error
+		apiErr, err = p.send(ctx, path, idempotencyKey, form, out)
+		return err
+	})
+	return apiErr, err
+}
+
+// send makes one attempt of a post
+func (p *StripeProvider) send(ctx context.Context, path, idempotencyKey string, form url.Values, out interface{}) (*stripeError, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.BaseURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, resilience.Permanent(fmt.Errorf("failed to build stripe request: %w", err))
+	}
+	req.Header.Set("Authorization", "Bearer "+p.config.APIKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Idempotency-Key", idempotencyKey)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("stripe request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stripe response: %w", err)
+	}
+
+	if resp.StatusCode >= 500 {
+		return nil, fmt.Errorf("stripe returned status %d", resp.StatusCode)
+	}
+
+	if resp.StatusCode >= 400 {
+		var apiErr stripeError
+		if err := json.Unmarshal(body, &apiErr); err != nil {
+			return nil, fmt.Errorf("stripe returned status %d", resp.StatusCode)
+		}
+		return &apiErr, nil
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("failed to decode stripe response: %w", err)
+	}
+
+	return nil, nil
+}
+
+// firstNonEmpty returns the first non-empty string
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,7 @@
+-- Drop payment tables
+DROP TABLE IF EXISTS "public"."payment_webhook_events";
+DROP TABLE IF EXISTS "public"."payment_attempts";
+DROP TABLE IF EXISTS "public"."payments";
+
+-- Drop payment status enum
+DROP TYPE IF EXISTS "public"."payment_status";
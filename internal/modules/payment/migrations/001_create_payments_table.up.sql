@@ -0,0 +1,49 @@
+-- Create payment status enum
+DO $$ BEGIN
+    CREATE TYPE "public"."payment_status" AS ENUM ('pending', 'processing', 'succeeded', 'failed', 'cancelled', 'refunded');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+
+-- Create payments table (one payment intent per order, amounts in the currency's minor unit)
+CREATE TABLE IF NOT EXISTS "public"."payments" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "order_id" VARCHAR(36) NOT NULL,
+    "customer_id" VARCHAR(36) NOT NULL DEFAULT '',
+    "amount" BIGINT NOT NULL CHECK ("amount" > 0),
+    "currency" CHAR(3) NOT NULL,
+    "status" "public"."payment_status" NOT NULL DEFAULT 'pending'::payment_status,
+    "provider" VARCHAR(32) NOT NULL,
+    "provider_reference" VARCHAR(255) NOT NULL DEFAULT '',
+    "failure_reason" TEXT NOT NULL DEFAULT '',
+    "version" INTEGER NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create payment attempts table (append-only history of provider calls)
+CREATE TABLE IF NOT EXISTS "public"."payment_attempts" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "payment_id" VARCHAR(36) NOT NULL REFERENCES "public"."payments" ("id") ON DELETE CASCADE,
+    "kind" VARCHAR(16) NOT NULL,
+    "status" VARCHAR(16) NOT NULL,
+    "amount" BIGINT NOT NULL,
+    "currency" CHAR(3) NOT NULL,
+    "provider_reference" VARCHAR(255) NOT NULL DEFAULT '',
+    "failure_reason" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create processed webhook events table (deduplicates provider retries)
+CREATE TABLE IF NOT EXISTS "public"."payment_webhook_events" (
+    "provider" VARCHAR(32) NOT NULL,
+    "event_id" VARCHAR(255) NOT NULL,
+    "processed_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("provider", "event_id")
+);
+
+-- Create indexes for better performance
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payments_order_id ON "public"."payments" ("order_id");
+CREATE INDEX IF NOT EXISTS idx_payments_status ON "public"."payments" ("status");
+CREATE INDEX IF NOT EXISTS idx_payments_provider_reference ON "public"."payments" ("provider", "provider_reference");
+CREATE INDEX IF NOT EXISTS idx_payment_attempts_payment_id ON "public"."payment_attempts" ("payment_id", "created_at");
@@ -0,0 +1,5 @@
+-- Remove partial refund tracking
+DROP INDEX IF EXISTS "public"."idx_payment_attempts_return_refund";
+
+ALTER TABLE "public"."payment_attempts" DROP COLUMN IF EXISTS "return_id";
+ALTER TABLE "public"."payments" DROP COLUMN IF EXISTS "refunded_amount";
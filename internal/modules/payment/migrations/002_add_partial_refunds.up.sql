@@ -0,0 +1,13 @@
+-- Track partial refunds (e.g. for order returns) next to the collected amount
+ALTER TABLE "public"."payments"
+    ADD COLUMN IF NOT EXISTS "refunded_amount" BIGINT NOT NULL DEFAULT 0 CHECK ("refunded_amount" >= 0);
+
+-- Payments refunded before partial refunds existed were refunded in full
+UPDATE "public"."payments" SET "refunded_amount" = "amount" WHERE "status" = 'refunded' AND "refunded_amount" = 0;
+
+ALTER TABLE "public"."payment_attempts"
+    ADD COLUMN IF NOT EXISTS "return_id" VARCHAR(36) NOT NULL DEFAULT '';
+
+-- At most one successful refund per order return
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payment_attempts_return_refund ON "public"."payment_attempts" ("payment_id", "return_id")
+    WHERE "kind" = 'refund' AND "status" = 'succeeded' AND "return_id" <> '';
@@ -0,0 +1,3 @@
+-- Drop the tenant of payments
+DROP INDEX IF EXISTS idx_payments_tenant_id;
+ALTER TABLE "public"."payments" DROP COLUMN IF EXISTS "tenant_id";
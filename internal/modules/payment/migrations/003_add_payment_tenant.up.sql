@@ -0,0 +1,3 @@
+-- Payments belong to the tenant of their order; payments made without multi-tenancy have none
+ALTER TABLE "public"."payments" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_payments_tenant_id ON "public"."payments" ("tenant_id");
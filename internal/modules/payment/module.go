@@ -0,0 +1,209 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	customerapi "golang_modular_monolith/internal/modules/customer/publicapi"
+	paymentdomain "golang_modular_monolith/internal/modules/payment/domain"
+	"golang_modular_monolith/internal/modules/payment/infrastructure/eventhandlers"
+	paymenthttp "golang_modular_monolith/internal/modules/payment/infrastructure/http"
+	"golang_modular_monolith/internal/modules/payment/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/payment/infrastructure/providers"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/di"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+	"golang_modular_monolith/internal/shared/infrastructure/registry"
+	"golang_modular_monolith/internal/shared/infrastructure/resilience"
+)
+
+// Auto-register payment module on package import
+func init() {
+	registry.RegisterModule("payment", func() domain.Module {
+		return NewPaymentModule()
+	})
+}
+
+// PaymentModule implements the Module interface
+type PaymentModule struct {
+	name        string
+	logger      *zap.Logger
+	handler     *handlers.PaymentHandler
+	orderEvents *eventhandlers.OrderEventsHandler
+
+	// Dependencies
+	eventBus domain.EventBus
+}
+
+// NewPaymentModule creates a new payment module
+func NewPaymentModule() *PaymentModule {
+	return &PaymentModule{
+		name: "payment",
+	}
+}
+
+// Name returns the module name
+func (m *PaymentModule) Name() string {
+	return m.name
+}
+
+// Initialize initializes the payment module with dependencies
+func (m *PaymentModule) Initialize(deps domain.ModuleDependencies) error {
+	m.logger = deps.Logger
+	m.logger.Info("initializing module")
+
+	// Store event bus
+	m.eventBus = deps.EventBus
+
+	// Create the configured payment providers
+	paymentProviders, active, err := loadProviders(deps.Config)
+	if err != nil {
+		return fmt.Errorf("invalid payment provider config: %w", err)
+	}
+	m.logger.Info("payment provider configured", zap.String("provider", active.Name()))
+
+	// Construct repositories and handlers from their constructors
+	// The customer API is resolved lazily so module initialization order does not matter
+	container := newContainer(m.eventBus, paymentProviders, active, customerapi.Lazy(deps.PublicAPIs))
+	if m.handler, err = di.Resolve[*handlers.PaymentHandler](container); err != nil {
+		return fmt.Errorf("failed to create payment handler: %w", err)
+	}
+	if m.orderEvents, err = di.Resolve[*eventhandlers.OrderEventsHandler](container); err != nil {
+		return fmt.Errorf("failed to create order events handler: %w", err)
+	}
+
+	// Open payment intents from order events
+	// Subscribed here rather than in Start: the in-memory bus delivers events in subscription order,
+	// so the payment must exist before the inventory saga confirms the order within the same publish
+	if err := m.eventBus.Subscribe(m.orderEvents); err != nil {
+		return fmt.Errorf("failed to subscribe order events handler: %w", err)
+	}
+
+	m.logger.Info("module initialized")
+	return nil
+}
+
+// RegisterRoutes registers HTTP routes for the payment module
+func (m *PaymentModule) RegisterRoutes(router *gin.RouterGroup) {
+	m.logger.Info("registering routes")
+
+	paymenthttp.RegisterPaymentRoutes(router, m.handler)
+}
+
+// APIOperations implements openapi.Documented
+func (m *PaymentModule) APIOperations() []*openapi.Operation {
+	return paymenthttp.APIOperations()
+}
+
+// Health checks if the payment module is healthy
+func (m *PaymentModule) Health(ctx context.Context) error {
+	// Check if handler is initialized
+	if m.handler == nil {
+		return fmt.Errorf("payment handler not initialized")
+	}
+
+	return nil
+}
+
+// Start starts the payment module (optional lifecycle method)
+func (m *PaymentModule) Start(ctx context.Context) error {
+	m.logger.Info("starting module")
+	m.logger.Info("module started")
+	return nil
+}
+
+// Stop stops the payment module (optional lifecycle method)
+func (m *PaymentModule) Stop(ctx context.Context) error {
+	m.logger.Info("stopping module")
+
+	// Unregister event handlers
+	if m.orderEvents != nil {
+		if err := m.eventBus.Unsubscribe(m.orderEvents); err != nil {
+			return fmt.Errorf("failed to unsubscribe order events handler: %w", err)
+		}
+	}
+
+	m.logger.Info("module stopped")
+	return nil
+}
+
+// paymentSettings is the payment section of the module config
+type paymentSettings struct {
+	// Provider is the provider used for new payments
+	Provider  string `mapstructure:"provider"`
+	Providers struct {
+		Fake   fakeSettings   `mapstructure:"fake"`
+		Stripe stripeSettings `mapstructure:"stripe"`
+	} `mapstructure:"providers"`
+}
+
+// fakeSettings configures the fake provider
+type fakeSettings struct {
+	DeclineAbove  int64  `mapstructure:"decline_above"`
+	WebhookSecret string `mapstructure:"webhook_secret"`
+}
+
+// stripeSettings configures the Stripe provider
+type stripeSettings struct {
+	APIKey           string        `mapstructure:"api_key"`
+	WebhookSecret    string        `mapstructure:"webhook_secret"`
+	BaseURL          string        `mapstructure:"base_url"`
+	Timeout          time.Duration `mapstructure:"timeout"`
+	Retries          int           `mapstructure:"retries"`
+	WebhookTolerance time.Duration `mapstructure:"webhook_tolerance"`
+	Breaker          struct {
+		FailureThreshold int           `mapstructure:"failure_threshold"`
+		OpenTimeout      time.Duration `mapstructure:"open_timeout"`
+	} `mapstructure:"breaker"`
+}
+
+// loadProviders builds every configured provider from payment.providers and returns
+// the one named by payment.provider for new payments; the fake provider is always available
+func loadProviders(cfg domain.ModuleConfig) (paymentdomain.PaymentProviders, paymentdomain.PaymentProvider, error) {
+	var settings paymentSettings
+	if err := cfg.Decode("", &settings); err != nil {
+		return nil, nil, err
+	}
+
+	fake := settings.Providers.Fake
+	configured := paymentdomain.PaymentProviders{
+		providers.FakeProviderName: providers.NewFakeProvider(providers.FakeConfig{
+			DeclineAbove:  fake.DeclineAbove,
+			WebhookSecret: fake.WebhookSecret,
+		}),
+	}
+
+	// Stripe is only registered when an API key is set
+	if stripe := settings.Providers.Stripe; stripe.APIKey != "" {
+		provider, err := providers.NewStripeProvider(providers.StripeConfig{
+			APIKey:           stripe.APIKey,
+			WebhookSecret:    stripe.WebhookSecret,
+			BaseURL:          stripe.BaseURL,
+			Timeout:          stripe.Timeout,
+			Retries:          stripe.Retries,
+			Breaker:          resilience.BreakerSettings{FailureThreshold: stripe.Breaker.FailureThreshold, OpenTimeout: stripe.Breaker.OpenTimeout},
+			WebhookTolerance: stripe.WebhookTolerance,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		configured[providers.StripeProviderName] = provider
+	}
+
+	name := settings.Provider
+	if name == "" {
+		name = providers.FakeProviderName
+	}
+
+	active, ok := configured[name]
+	if !ok {
+		return nil, nil, fmt.Errorf("provider %q is unknown or not configured", name)
+	}
+
+	return configured, active, nil
+}
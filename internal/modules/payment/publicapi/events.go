@@ -0,0 +1,43 @@
+// Package publicapi is the payment module's contract for other modules.
+// Other modules depend on this package only, never on payment internals.
+package publicapi
+
+import (
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// Payment event types other modules may subscribe to
+const (
+	PaymentSucceededEventType = "payment.succeeded"
+	PaymentFailedEventType    = "payment.failed"
+	PaymentRefundedEventType  = "payment.refunded"
+)
+
+// PaymentOutcome is implemented by the payment.succeeded, payment.failed and payment.refunded events
+type PaymentOutcome interface {
+	shareddomain.DomainEvent
+
+	// GetPaymentID returns the ID of the payment
+	GetPaymentID() string
+
+	// GetOrderID returns the ID of the order the payment belongs to
+	GetOrderID() string
+
+	// GetAmount returns the amount charged, failed or refunded
+	GetAmount() shareddomain.Money
+}
+
+// PaymentRefunded is implemented by the payment.refunded event
+// A payment can be refunded several times in part, e.g. once per order return
+type PaymentRefunded interface {
+	PaymentOutcome
+
+	// GetReturnID returns the order return the refund was issued for, empty for other refunds
+	GetReturnID() string
+
+	// GetRefundReference returns the provider's reference of the refund
+	GetRefundReference() string
+
+	// IsFullyRefunded reports whether nothing is left to refund on the payment
+	IsFullyRefunded() bool
+}
@@ -0,0 +1,6 @@
+//go:build !no_product
+
+package modules
+
+// Excluded from builds tagged no_product
+import _ "golang_modular_monolith/internal/modules/product"
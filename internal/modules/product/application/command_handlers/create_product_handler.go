@@ -0,0 +1,168 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/product/application/commands"
+	"golang_modular_monolith/internal/modules/product/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateProductHandler handles CreateProductCommand
+type CreateProductHandler struct {
+	repo     domain.ProductRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewCreateProductHandler creates a new CreateProductHandler
+func NewCreateProductHandler(repo domain.ProductRepository, eventBus shareddomain.EventBus) *CreateProductHandler {
+	return &CreateProductHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the CreateProductCommand
+func (h *CreateProductHandler) Handle(ctx context.Context, cmd *commands.CreateProductCommand) (*commands.ProductResult, error) {
+	// Create product
+	price := shareddomain.Money{Amount: cmd.Price, Currency: cmd.Currency}
+	product, err := domain.NewProduct(cmd.SKU, cmd.Name, price, cmd.StockOnHand)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if SKU is unique
+	exists, err := h.repo.ExistsBySKU(ctx, product.SKU)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check SKU uniqueness: %w", err)
+	}
+
+	if exists {
+		return nil, shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeAlreadyExists,
+			fmt.Sprintf("product with SKU %s already exists", product.SKU),
+			"sku",
+		)
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, product); err != nil {
+		return nil, err
+	}
+
+	return toProductResult(product), nil
+}
+
+// SetProductStockHandler handles SetProductStockCommand
+type SetProductStockHandler struct {
+	repo     domain.ProductRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewSetProductStockHandler creates a new SetProductStockHandler
+func NewSetProductStockHandler(repo domain.ProductRepository, eventBus shareddomain.EventBus) *SetProductStockHandler {
+	return &SetProductStockHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the SetProductStockCommand
+func (h *SetProductStockHandler) Handle(ctx context.Context, cmd *commands.SetProductStockCommand) (*commands.ProductResult, error) {
+	product, err := loadProduct(ctx, h.repo, cmd.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := product.SetStock(cmd.StockOnHand); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, product); err != nil {
+		return nil, err
+	}
+
+	return toProductResult(product), nil
+}
+
+// UpdateProductHandler handles UpdateProductCommand
+type UpdateProductHandler struct {
+	repo     domain.ProductRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewUpdateProductHandler creates a new UpdateProductHandler
+func NewUpdateProductHandler(repo domain.ProductRepository, eventBus shareddomain.EventBus) *UpdateProductHandler {
+	return &UpdateProductHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the UpdateProductCommand
+func (h *UpdateProductHandler) Handle(ctx context.Context, cmd *commands.UpdateProductCommand) (*commands.ProductResult, error) {
+	product, err := loadProduct(ctx, h.repo, cmd.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := domain.ProductChanges{Name: cmd.Name}
+	if cmd.Price != nil || cmd.Currency != nil {
+		price := product.Price
+		if cmd.Price != nil {
+			price.Amount = *cmd.Price
+		}
+		if cmd.Currency != nil {
+			price.Currency = *cmd.Currency
+		}
+		changes.Price = &price
+	}
+
+	if err := product.Update(changes); err != nil {
+		return nil, err
+	}
+
+	if cmd.Status != nil {
+		if err := product.ChangeStatus(domain.ProductStatus(*cmd.Status)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, product); err != nil {
+		return nil, err
+	}
+
+	return toProductResult(product), nil
+}
+
+// ArchiveProductHandler handles ArchiveProductCommand
+type ArchiveProductHandler struct {
+	repo     domain.ProductRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewArchiveProductHandler creates a new ArchiveProductHandler
+func NewArchiveProductHandler(repo domain.ProductRepository, eventBus shareddomain.EventBus) *ArchiveProductHandler {
+	return &ArchiveProductHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the ArchiveProductCommand
+func (h *ArchiveProductHandler) Handle(ctx context.Context, cmd *commands.ArchiveProductCommand) (*commands.ProductResult, error) {
+	product, err := loadProduct(ctx, h.repo, cmd.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := product.ChangeStatus(domain.ProductStatusArchived); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, product); err != nil {
+		return nil, err
+	}
+
+	return toProductResult(product), nil
+}
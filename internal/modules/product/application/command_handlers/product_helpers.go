@@ -0,0 +1,80 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/product/application/commands"
+	"golang_modular_monolith/internal/modules/product/domain"
+	"golang_modular_monolith/internal/shared/application"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// loadProduct retrieves a product or returns a not found domain error
+func loadProduct(ctx context.Context, repo domain.ProductRepository, productID string) (*domain.Product, error) {
+	if productID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"product ID is required",
+		)
+	}
+
+	product, err := repo.GetByID(ctx, productID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("product with ID %s not found", productID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	return product, nil
+}
+
+// saveAndPublish persists a changed product and publishes its uncommitted events
+// It is a no-op when the aggregate recorded no changes
+func saveAndPublish(ctx context.Context, repo domain.ProductRepository, eventBus shareddomain.EventBus, product *domain.Product) error {
+	// Capture events before the repository clears them on save
+	events := product.GetUncommittedEvents()
+	if len(events) == 0 {
+		return nil
+	}
+
+	if err := repo.Save(ctx, product); err != nil {
+		return fmt.Errorf("failed to save product: %w", err)
+	}
+
+	for _, event := range events {
+		publish(ctx, eventBus, event)
+	}
+
+	return nil
+}
+
+// publish publishes an event stamped with the actor of ctx, logging rather than failing on errors
+func publish(ctx context.Context, eventBus shareddomain.EventBus, event shareddomain.DomainEvent) {
+	if err := application.PublishEvents(ctx, eventBus, event); err != nil {
+		// Log error but don't fail the operation
+		// In a real application, you might want to use outbox pattern or similar
+		fmt.Printf("Warning: failed to publish event %T for %s: %v\n", event, event.GetAggregateID(), err)
+	}
+}
+
+// toProductResult converts a product to a command result
+func toProductResult(product *domain.Product) *commands.ProductResult {
+	return &commands.ProductResult{
+		ID:            product.GetID(),
+		SKU:           product.SKU,
+		Name:          product.Name,
+		Price:         product.Price,
+		Status:        string(product.Status),
+		StockOnHand:   product.StockOnHand,
+		StockReserved: product.StockReserved,
+		Available:     product.Available(),
+		Version:       product.GetVersion(),
+		CreatedAt:     product.GetCreatedAt(),
+		UpdatedAt:     product.GetUpdatedAt(),
+	}
+}
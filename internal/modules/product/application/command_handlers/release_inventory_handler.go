@@ -0,0 +1,52 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/product/application/commands"
+	"golang_modular_monolith/internal/modules/product/domain"
+	"golang_modular_monolith/internal/modules/product/publicapi"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// ReleaseInventoryHandler handles ReleaseInventoryCommand
+type ReleaseInventoryHandler struct {
+	repo     domain.InventoryRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewReleaseInventoryHandler creates a new ReleaseInventoryHandler
+func NewReleaseInventoryHandler(repo domain.InventoryRepository, eventBus shareddomain.EventBus) *ReleaseInventoryHandler {
+	return &ReleaseInventoryHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the ReleaseInventoryCommand
+// Orders without an active reservation (e.g. rejected ones) are acknowledged without changes
+func (h *ReleaseInventoryHandler) Handle(ctx context.Context, cmd *commands.ReleaseInventoryCommand) (*commands.ReleaseInventoryResult, error) {
+	if cmd.OrderID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"order ID is required",
+		)
+	}
+
+	reservation, err := h.repo.Release(ctx, cmd.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to release inventory: %w", err)
+	}
+
+	if reservation == nil {
+		return &commands.ReleaseInventoryResult{OrderID: cmd.OrderID}, nil
+	}
+
+	publish(ctx, h.eventBus, publicapi.NewInventoryReleasedEvent(cmd.OrderID))
+
+	return &commands.ReleaseInventoryResult{
+		OrderID:  cmd.OrderID,
+		Released: true,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package commandhandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/product/application/commands"
+	"golang_modular_monolith/internal/modules/product/domain"
+	"golang_modular_monolith/internal/modules/product/publicapi"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// ReserveInventoryHandler handles ReserveInventoryCommand
+// The outcome is always announced with an inventory event so the requesting module can react;
+// only infrastructure failures are returned as errors
+type ReserveInventoryHandler struct {
+	repo     domain.InventoryRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewReserveInventoryHandler creates a new ReserveInventoryHandler
+func NewReserveInventoryHandler(repo domain.InventoryRepository, eventBus shareddomain.EventBus) *ReserveInventoryHandler {
+	return &ReserveInventoryHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the ReserveInventoryCommand
+func (h *ReserveInventoryHandler) Handle(ctx context.Context, cmd *commands.ReserveInventoryCommand) (*commands.ReserveInventoryResult, error) {
+	items := make([]domain.ReservationItem, len(cmd.Items))
+	for i, item := range cmd.Items {
+		items[i] = domain.ReservationItem{ProductID: item.ProductID, Quantity: item.Quantity}
+	}
+
+	reservation, err := domain.NewReservation(cmd.OrderID, items)
+	if err != nil {
+		return rejectReservation(ctx, h.eventBus, cmd.OrderID, publicapi.RejectReasonInvalidRequest, nil), nil
+	}
+
+	if err := h.repo.Reserve(ctx, reservation); err != nil {
+		var rejected domain.ReservationRejectedError
+		if errors.As(err, &rejected) {
+			return rejectReservation(ctx, h.eventBus, cmd.OrderID, rejected.Reason, rejected.Shortages), nil
+		}
+		return nil, fmt.Errorf("failed to reserve inventory: %w", err)
+	}
+
+	publish(ctx, h.eventBus, publicapi.NewInventoryReservedEvent(cmd.OrderID))
+
+	return &commands.ReserveInventoryResult{
+		OrderID:  cmd.OrderID,
+		Reserved: true,
+	}, nil
+}
+
+// rejectReservation publishes the rejection of a reservation request
+func rejectReservation(ctx context.Context, eventBus shareddomain.EventBus, orderID, reason string, shortages []domain.StockShortage) *commands.ReserveInventoryResult {
+	apiShortages := make([]publicapi.StockShortage, len(shortages))
+	for i, shortage := range shortages {
+		apiShortages[i] = publicapi.StockShortage{
+			ProductID: shortage.ProductID,
+			Requested: shortage.Requested,
+			Available: shortage.Available,
+		}
+	}
+
+	publish(ctx, eventBus, publicapi.NewInventoryReservationRejectedEvent(orderID, reason, apiShortages))
+
+	return &commands.ReserveInventoryResult{
+		OrderID: orderID,
+		Reason:  reason,
+	}
+}
@@ -0,0 +1,53 @@
+package commandhandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang_modular_monolith/internal/modules/product/application/commands"
+	"golang_modular_monolith/internal/modules/product/domain"
+	"golang_modular_monolith/internal/modules/product/publicapi"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// ReserveOrderLineHandler handles ReserveOrderLineCommand
+// Like a reservation, the outcome is announced with an inventory event: a rejected line rejects the
+// order's reservation, so that the order is cancelled and its stock released
+type ReserveOrderLineHandler struct {
+	repo     domain.InventoryRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewReserveOrderLineHandler creates a new ReserveOrderLineHandler
+func NewReserveOrderLineHandler(repo domain.InventoryRepository, eventBus shareddomain.EventBus) *ReserveOrderLineHandler {
+	return &ReserveOrderLineHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the ReserveOrderLineCommand
+func (h *ReserveOrderLineHandler) Handle(ctx context.Context, cmd *commands.ReserveOrderLineCommand) (*commands.ReserveInventoryResult, error) {
+	item := domain.ReservationItem{ProductID: cmd.Item.ProductID, Quantity: cmd.Item.Quantity}
+	addition, err := domain.NewReservation(cmd.OrderID, []domain.ReservationItem{item})
+	if err != nil || strings.TrimSpace(cmd.LineID) == "" {
+		return rejectReservation(ctx, h.eventBus, cmd.OrderID, publicapi.RejectReasonInvalidRequest, nil), nil
+	}
+
+	if err := h.repo.ReserveLine(ctx, addition.OrderID, cmd.LineID, addition.Items[0]); err != nil {
+		var rejected domain.ReservationRejectedError
+		if errors.As(err, &rejected) {
+			return rejectReservation(ctx, h.eventBus, cmd.OrderID, rejected.Reason, rejected.Shortages), nil
+		}
+		return nil, fmt.Errorf("failed to reserve order line %s: %w", cmd.LineID, err)
+	}
+
+	publish(ctx, h.eventBus, publicapi.NewInventoryReservedEvent(cmd.OrderID))
+
+	return &commands.ReserveInventoryResult{
+		OrderID:  cmd.OrderID,
+		Reserved: true,
+	}, nil
+}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/shared/application"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateProductCommand represents a command to create a new product
+// Price is in the currency's minor unit (e.g. cents)
+type CreateProductCommand struct {
+	application.BaseCommand
+	SKU         string `json:"sku" validate:"required,max=64"`
+	Name        string `json:"name" validate:"required,min=1,max=255"`
+	Price       int64  `json:"price" validate:"min=0"`
+	Currency    string `json:"currency" validate:"required,len=3"`
+	StockOnHand int    `json:"stock_on_hand" validate:"min=0"`
+}
+
+// NewCreateProductCommand creates a new create product command
+func NewCreateProductCommand(sku, name string, price int64, currency string, stockOnHand int) CreateProductCommand {
+	return CreateProductCommand{
+		BaseCommand: application.NewBaseCommand("create_product"),
+		SKU:         sku,
+		Name:        name,
+		Price:       price,
+		Currency:    currency,
+		StockOnHand: stockOnHand,
+	}
+}
+
+// UpdateProductCommand represents a command to change a product's catalog data
+// Nil fields keep their current value; a price without currency keeps the current currency
+type UpdateProductCommand struct {
+	application.BaseCommand
+	ProductID string  `json:"product_id" validate:"required"`
+	Name      *string `json:"name" validate:"omitempty,min=1,max=255"`
+	Price     *int64  `json:"price" validate:"omitempty,min=0"`
+	Currency  *string `json:"currency" validate:"omitempty,len=3"`
+	Status    *string `json:"status" validate:"omitempty,oneof=active inactive"`
+}
+
+// NewUpdateProductCommand creates a new update product command
+func NewUpdateProductCommand(productID string) UpdateProductCommand {
+	return UpdateProductCommand{
+		BaseCommand: application.NewBaseCommand("update_product"),
+		ProductID:   productID,
+	}
+}
+
+// ArchiveProductCommand represents a command to remove a product from the catalog
+// Archived products are kept so that orders can still refer to them
+type ArchiveProductCommand struct {
+	application.BaseCommand
+	ProductID string `json:"product_id" validate:"required"`
+}
+
+// NewArchiveProductCommand creates a new archive product command
+func NewArchiveProductCommand(productID string) ArchiveProductCommand {
+	return ArchiveProductCommand{
+		BaseCommand: application.NewBaseCommand("archive_product"),
+		ProductID:   productID,
+	}
+}
+
+// SetProductStockCommand represents a command to set a product's stock on hand
+type SetProductStockCommand struct {
+	application.BaseCommand
+	ProductID   string `json:"product_id" validate:"required"`
+	StockOnHand int    `json:"stock_on_hand" validate:"min=0"`
+}
+
+// NewSetProductStockCommand creates a new set product stock command
+func NewSetProductStockCommand(productID string, stockOnHand int) SetProductStockCommand {
+	return SetProductStockCommand{
+		BaseCommand: application.NewBaseCommand("set_product_stock"),
+		ProductID:   productID,
+		StockOnHand: stockOnHand,
+	}
+}
+
+// ProductResult represents the state of a product returned by product commands
+type ProductResult struct {
+	ID            string       `json:"id"`
+	SKU           string       `json:"sku"`
+	Name          string       `json:"name"`
+	Price         domain.Money `json:"price"`
+	Status        string       `json:"status"`
+	StockOnHand   int          `json:"stock_on_hand"`
+	StockReserved int          `json:"stock_reserved"`
+	Available     int          `json:"available"`
+	Version       int          `json:"version"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+}
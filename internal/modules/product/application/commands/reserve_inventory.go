@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// ReserveInventoryItem is a product quantity to reserve
+type ReserveInventoryItem struct {
+	ProductID string `json:"product_id" validate:"required"`
+	Quantity  int    `json:"quantity" validate:"required,min=1"`
+}
+
+// ReserveInventoryCommand represents a command to reserve stock for an order
+type ReserveInventoryCommand struct {
+	application.BaseCommand
+	OrderID string                 `json:"order_id" validate:"required"`
+	Items   []ReserveInventoryItem `json:"items" validate:"required,min=1,dive"`
+}
+
+// NewReserveInventoryCommand creates a new reserve inventory command
+func NewReserveInventoryCommand(orderID string, items []ReserveInventoryItem) ReserveInventoryCommand {
+	return ReserveInventoryCommand{
+		BaseCommand: application.NewBaseCommand("reserve_inventory"),
+		OrderID:     orderID,
+		Items:       items,
+	}
+}
+
+// ReserveOrderLineCommand represents a command to reserve stock for a line added to an order
+// holding a reservation
+type ReserveOrderLineCommand struct {
+	application.BaseCommand
+	OrderID string               `json:"order_id" validate:"required"`
+	LineID  string               `json:"line_id" validate:"required"`
+	Item    ReserveInventoryItem `json:"item"`
+}
+
+// NewReserveOrderLineCommand creates a new reserve order line command
+func NewReserveOrderLineCommand(orderID, lineID string, item ReserveInventoryItem) ReserveOrderLineCommand {
+	return ReserveOrderLineCommand{
+		BaseCommand: application.NewBaseCommand("reserve_order_line"),
+		OrderID:     orderID,
+		LineID:      lineID,
+		Item:        item,
+	}
+}
+
+// ReserveInventoryResult represents the outcome of a reservation request
+type ReserveInventoryResult struct {
+	OrderID  string `json:"order_id"`
+	Reserved bool   `json:"reserved"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// ReleaseInventoryCommand represents a command to return the stock reserved for an order
+type ReleaseInventoryCommand struct {
+	application.BaseCommand
+	OrderID string `json:"order_id" validate:"required"`
+}
+
+// NewReleaseInventoryCommand creates a new release inventory command
+func NewReleaseInventoryCommand(orderID string) ReleaseInventoryCommand {
+	return ReleaseInventoryCommand{
+		BaseCommand: application.NewBaseCommand("release_inventory"),
+		OrderID:     orderID,
+	}
+}
+
+// ReleaseInventoryResult represents the outcome of a release request
+type ReleaseInventoryResult struct {
+	OrderID  string `json:"order_id"`
+	Released bool   `json:"released"`
+}
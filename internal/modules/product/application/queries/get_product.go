@@ -0,0 +1,78 @@
+package queries
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/modules/product/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// GetProductQuery represents a query to get a product by ID
+type GetProductQuery struct {
+	ID string `json:"id"`
+}
+
+// ProductDTO represents a product with its price and stock levels
+type ProductDTO struct {
+	ID            string             `json:"id"`
+	SKU           string             `json:"sku"`
+	Name          string             `json:"name"`
+	Price         shareddomain.Money `json:"price"`
+	Status        string             `json:"status"`
+	StockOnHand   int                `json:"stock_on_hand"`
+	StockReserved int                `json:"stock_reserved"`
+	Available     int                `json:"available"`
+	Version       int                `json:"version"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+// NewProductDTO converts a product to its query representation
+func NewProductDTO(product *domain.Product) ProductDTO {
+	return ProductDTO{
+		ID:            product.GetID(),
+		SKU:           product.SKU,
+		Name:          product.Name,
+		Price:         product.Price,
+		Status:        string(product.Status),
+		StockOnHand:   product.StockOnHand,
+		StockReserved: product.StockReserved,
+		Available:     product.Available(),
+		Version:       product.GetVersion(),
+		CreatedAt:     product.GetCreatedAt(),
+		UpdatedAt:     product.GetUpdatedAt(),
+	}
+}
+
+// GetProductResult represents the result of GetProductQuery
+type GetProductResult struct {
+	Product ProductDTO `json:"product"`
+}
+
+// ListProductsQuery represents a query to list products with pagination
+type ListProductsQuery struct {
+	Page      int                    `json:"page"`
+	Limit     int                    `json:"limit"`
+	Statuses  []domain.ProductStatus `json:"statuses,omitempty"`
+	Query     string                 `json:"query,omitempty"`
+	SortBy    string                 `json:"sort_by"`
+	SortOrder string                 `json:"sort_order"`
+}
+
+// ToParams converts the query to repository list parameters
+func (q *ListProductsQuery) ToParams() domain.ListProductsParams {
+	return domain.ListProductsParams{
+		Page:      q.Page,
+		Limit:     q.Limit,
+		SortBy:    q.SortBy,
+		SortOrder: q.SortOrder,
+		Statuses:  q.Statuses,
+		Query:     q.Query,
+	}
+}
+
+// ListProductsResult represents the result of ListProductsQuery
+type ListProductsResult struct {
+	Products   []ProductDTO            `json:"products"`
+	Pagination domain.PaginationResult `json:"pagination"`
+}
@@ -0,0 +1,50 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/product/application/queries"
+	"golang_modular_monolith/internal/modules/product/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// GetProductHandler handles GetProductQuery
+// Products are read straight from the write model; stock levels must not lag behind reservations
+type GetProductHandler struct {
+	repo domain.ProductRepository
+}
+
+// NewGetProductHandler creates a new GetProductHandler
+func NewGetProductHandler(repo domain.ProductRepository) *GetProductHandler {
+	return &GetProductHandler{
+		repo: repo,
+	}
+}
+
+// Handle handles the GetProductQuery
+func (h *GetProductHandler) Handle(ctx context.Context, query *queries.GetProductQuery) (*queries.GetProductResult, error) {
+	// Validate query
+	if query.ID == "" {
+		return nil, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"product ID is required",
+		)
+	}
+
+	// Get product from repository
+	product, err := h.repo.GetByID(ctx, query.ID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("product with ID %s not found", query.ID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	return &queries.GetProductResult{
+		Product: queries.NewProductDTO(product),
+	}, nil
+}
@@ -0,0 +1,38 @@
+package queryhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/product/application/queries"
+	"golang_modular_monolith/internal/modules/product/domain"
+)
+
+// ListProductsHandler handles ListProductsQuery
+type ListProductsHandler struct {
+	repo domain.ProductRepository
+}
+
+// NewListProductsHandler creates a new ListProductsHandler
+func NewListProductsHandler(repo domain.ProductRepository) *ListProductsHandler {
+	return &ListProductsHandler{
+		repo: repo,
+	}
+}
+
+// Handle handles the ListProductsQuery
+func (h *ListProductsHandler) Handle(ctx context.Context, query *queries.ListProductsQuery) (*queries.ListProductsResult, error) {
+	result, err := h.repo.List(ctx, query.ToParams())
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]queries.ProductDTO, len(result.Products))
+	for i, product := range result.Products {
+		products[i] = queries.NewProductDTO(product)
+	}
+
+	return &queries.ListProductsResult{
+		Products:   products,
+		Pagination: result.Pagination,
+	}, nil
+}
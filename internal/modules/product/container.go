@@ -0,0 +1,46 @@
+package product
+
+import (
+	commandhandlers "golang_modular_monolith/internal/modules/product/application/command_handlers"
+	queryhandlers "golang_modular_monolith/internal/modules/product/application/query_handlers"
+	productdomain "golang_modular_monolith/internal/modules/product/domain"
+	productdb "golang_modular_monolith/internal/modules/product/infrastructure/database"
+	"golang_modular_monolith/internal/modules/product/infrastructure/eventhandlers"
+	"golang_modular_monolith/internal/modules/product/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/product/infrastructure/persistence"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/di"
+)
+
+// newContainer registers the constructors of the product module's repositories and handlers;
+// nothing is constructed until it is resolved, so callers may replace providers first
+func newContainer(eventBus domain.EventBus) *di.Container {
+	c := di.New()
+	di.Value(c, eventBus)
+	c.Provide(productdb.GetProductDB)
+
+	// Repositories
+	c.Provide(persistence.NewPostgreSQLProductRepository, di.As[productdomain.ProductRepository]())
+	c.Provide(persistence.NewPostgreSQLInventoryRepository, di.As[productdomain.InventoryRepository]())
+
+	// Command handlers
+	c.Provide(commandhandlers.NewCreateProductHandler)
+	c.Provide(commandhandlers.NewUpdateProductHandler)
+	c.Provide(commandhandlers.NewArchiveProductHandler)
+	c.Provide(commandhandlers.NewSetProductStockHandler)
+	c.Provide(commandhandlers.NewReserveInventoryHandler)
+	c.Provide(commandhandlers.NewReserveOrderLineHandler)
+	c.Provide(commandhandlers.NewReleaseInventoryHandler)
+
+	// Cross-module event handlers
+	c.Provide(eventhandlers.NewOrderEventsHandler)
+
+	// Query handlers
+	c.Provide(queryhandlers.NewGetProductHandler)
+	c.Provide(queryhandlers.NewListProductsHandler)
+
+	// HTTP handlers
+	c.Provide(handlers.NewProductHandler)
+	return c
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Product domain event types
+const (
+	ProductCreatedEventType       = "product.created"
+	ProductUpdatedEventType       = "product.updated"
+	ProductStatusChangedEventType = "product.status_changed"
+	ProductStockChangedEventType  = "product.stock_changed"
+)
+
+// ProductCreatedEvent represents the event when a product is created
+type ProductCreatedEvent struct {
+	domain.BaseDomainEvent
+	ProductID   string        `json:"product_id"`
+	SKU         string        `json:"sku"`
+	Name        string        `json:"name"`
+	Price       domain.Money  `json:"price"`
+	Status      ProductStatus `json:"status"`
+	StockOnHand int           `json:"stock_on_hand"`
+}
+
+// NewProductCreatedEvent creates a new product created event
+func NewProductCreatedEvent(product *Product) ProductCreatedEvent {
+	eventData := map[string]interface{}{
+		"product_id":    product.GetID(),
+		"sku":           product.SKU,
+		"name":          product.Name,
+		"price":         product.Price,
+		"status":        product.Status,
+		"stock_on_hand": product.StockOnHand,
+	}
+
+	return ProductCreatedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			product.GetID(),
+			"product",
+			ProductCreatedEventType,
+			eventData,
+		),
+		ProductID:   product.GetID(),
+		SKU:         product.SKU,
+		Name:        product.Name,
+		Price:       product.Price,
+		Status:      product.Status,
+		StockOnHand: product.StockOnHand,
+	}
+}
+
+// ProductUpdatedEvent represents the event when a product's name or price changes
+type ProductUpdatedEvent struct {
+	domain.BaseDomainEvent
+	ProductID     string       `json:"product_id"`
+	Name          string       `json:"name"`
+	Price         domain.Money `json:"price"`
+	PreviousPrice domain.Money `json:"previous_price"`
+}
+
+// NewProductUpdatedEvent creates a new product updated event
+func NewProductUpdatedEvent(product *Product, previousPrice domain.Money) ProductUpdatedEvent {
+	eventData := map[string]interface{}{
+		"product_id":     product.GetID(),
+		"name":           product.Name,
+		"price":          product.Price,
+		"previous_price": previousPrice,
+	}
+
+	return ProductUpdatedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			product.GetID(),
+			"product",
+			ProductUpdatedEventType,
+			eventData,
+		),
+		ProductID:     product.GetID(),
+		Name:          product.Name,
+		Price:         product.Price,
+		PreviousPrice: previousPrice,
+	}
+}
+
+// ProductStatusChangedEvent represents the event when a product is activated, deactivated or archived
+type ProductStatusChangedEvent struct {
+	domain.BaseDomainEvent
+	ProductID      string        `json:"product_id"`
+	Status         ProductStatus `json:"status"`
+	PreviousStatus ProductStatus `json:"previous_status"`
+}
+
+// NewProductStatusChangedEvent creates a new product status changed event
+func NewProductStatusChangedEvent(product *Product, previousStatus ProductStatus) ProductStatusChangedEvent {
+	eventData := map[string]interface{}{
+		"product_id":      product.GetID(),
+		"status":          product.Status,
+		"previous_status": previousStatus,
+	}
+
+	return ProductStatusChangedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			product.GetID(),
+			"product",
+			ProductStatusChangedEventType,
+			eventData,
+		),
+		ProductID:      product.GetID(),
+		Status:         product.Status,
+		PreviousStatus: previousStatus,
+	}
+}
+
+// ProductStockChangedEvent represents the event when a product's stock on hand changes
+type ProductStockChangedEvent struct {
+	domain.BaseDomainEvent
+	ProductID        string `json:"product_id"`
+	PreviousQuantity int    `json:"previous_quantity"`
+	NewQuantity      int    `json:"new_quantity"`
+}
+
+// NewProductStockChangedEvent creates a new product stock changed event
+func NewProductStockChangedEvent(product *Product, previousQuantity int) ProductStockChangedEvent {
+	eventData := map[string]interface{}{
+		"product_id":        product.GetID(),
+		"previous_quantity": previousQuantity,
+		"new_quantity":      product.StockOnHand,
+	}
+
+	return ProductStockChangedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			product.GetID(),
+			"product",
+			ProductStockChangedEventType,
+			eventData,
+		),
+		ProductID:        product.GetID(),
+		PreviousQuantity: previousQuantity,
+		NewQuantity:      product.StockOnHand,
+	}
+}
@@ -0,0 +1,304 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// skuRegex restricts SKUs to upper-case letters, digits and dashes
+var skuRegex = regexp.MustCompile(`^[A-Z0-9][A-Z0-9\-]{0,63}$`)
+
+// MaxProductNameLength is the maximum length of a product name
+const MaxProductNameLength = 255
+
+// Permissions required to change the catalog and to set stock on hand
+const (
+	PermissionWriteProducts = "products:write"
+	PermissionWriteStock    = "stock:write"
+)
+
+// ProductStatus represents the catalog status of a product
+type ProductStatus string
+
+const (
+	ProductStatusActive   ProductStatus = "active"   // listed and orderable
+	ProductStatusInactive ProductStatus = "inactive" // temporarily not orderable
+	ProductStatusArchived ProductStatus = "archived" // removed from the catalog; kept for order history
+)
+
+// IsValid checks if the product status is known
+func (s ProductStatus) IsValid() bool {
+	switch s {
+	case ProductStatusActive, ProductStatusInactive, ProductStatusArchived:
+		return true
+	}
+	return false
+}
+
+// Product represents the product aggregate root with its price and stock level
+// Reserved stock is held for orders that have not been fulfilled yet
+type Product struct {
+	domain.BaseAggregateRoot
+	SKU           string        `json:"sku"`
+	Name          string        `json:"name"`
+	Price         domain.Money  `json:"price"` // list price in the currency's minor unit
+	Status        ProductStatus `json:"status"`
+	StockOnHand   int           `json:"stock_on_hand"`
+	StockReserved int           `json:"stock_reserved"`
+}
+
+// NewProduct creates a new active product with a list price and an initial stock level
+func NewProduct(sku, name string, price domain.Money, stockOnHand int) (*Product, error) {
+	// Validate input
+	var validationErrors domain.ValidationErrors
+
+	sku = strings.ToUpper(strings.TrimSpace(sku))
+	if !skuRegex.MatchString(sku) {
+		validationErrors.AddWithValue("sku", "sku must contain only letters, digits and dashes", sku)
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		validationErrors.Add("name", "name is required")
+	} else if len(name) > MaxProductNameLength {
+		validationErrors.Add("name", fmt.Sprintf("name must not exceed %d characters", MaxProductNameLength))
+	}
+
+	price, err := normalizePrice(price, &validationErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	if stockOnHand < 0 {
+		validationErrors.AddWithValue("stock_on_hand", "stock_on_hand must not be negative", stockOnHand)
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	// Create product
+	product := &Product{
+		BaseAggregateRoot: domain.NewBaseAggregateRoot(),
+		SKU:               sku,
+		Name:              name,
+		Price:             price,
+		Status:            ProductStatusActive,
+		StockOnHand:       stockOnHand,
+	}
+
+	// Add domain event
+	product.AddEvent(NewProductCreatedEvent(product))
+
+	return product, nil
+}
+
+// Available returns the stock that can still be reserved
+func (p *Product) Available() int {
+	return p.StockOnHand - p.StockReserved
+}
+
+// IsOrderable checks if orders may reserve stock of the product
+func (p *Product) IsOrderable() bool {
+	return p.Status == ProductStatusActive
+}
+
+// ProductChanges describes an update of a product's catalog data
+// Nil fields keep their current value
+type ProductChanges struct {
+	Name  *string
+	Price *domain.Money
+}
+
+// Update changes the product's name and price
+// Archived products are read-only
+func (p *Product) Update(changes ProductChanges) error {
+	if p.Status == ProductStatusArchived {
+		return domain.NewBusinessRuleError(
+			"product_archived",
+			fmt.Sprintf("product %s is archived and cannot be changed", p.SKU),
+		)
+	}
+
+	// Validate input
+	var validationErrors domain.ValidationErrors
+
+	name := p.Name
+	if changes.Name != nil {
+		name = strings.TrimSpace(*changes.Name)
+		if name == "" {
+			validationErrors.Add("name", "name is required")
+		} else if len(name) > MaxProductNameLength {
+			validationErrors.Add("name", fmt.Sprintf("name must not exceed %d characters", MaxProductNameLength))
+		}
+	}
+
+	price := p.Price
+	if changes.Price != nil {
+		var err error
+		if price, err = normalizePrice(*changes.Price, &validationErrors); err != nil {
+			return err
+		}
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+
+	if name == p.Name && price.Equals(p.Price) {
+		return nil
+	}
+
+	previousPrice := p.Price
+	p.Name = name
+	p.Price = price
+	p.IncrementVersion()
+
+	// Add domain event
+	p.AddEvent(NewProductUpdatedEvent(p, previousPrice))
+
+	return nil
+}
+
+// productTransitions lists the statuses each product status may move to
+var productTransitions = map[ProductStatus][]ProductStatus{
+	ProductStatusActive:   {ProductStatusInactive, ProductStatusArchived},
+	ProductStatusInactive: {ProductStatusActive, ProductStatusArchived},
+}
+
+// ChangeStatus activates, deactivates or archives the product
+// Products holding reserved stock cannot be archived; changing to the current status is a no-op
+func (p *Product) ChangeStatus(status ProductStatus) error {
+	if !status.IsValid() {
+		return domain.NewValidationErrorWithValue("status", "status must be active, inactive or archived", string(status))
+	}
+	if p.Status == status {
+		return nil
+	}
+
+	allowed := false
+	for _, next := range productTransitions[p.Status] {
+		if next == status {
+			allowed = true
+		}
+	}
+	if !allowed {
+		return domain.NewBusinessRuleError(
+			"invalid_status_transition",
+			fmt.Sprintf("cannot change product status from %s to %s", p.Status, status),
+		)
+	}
+
+	if status == ProductStatusArchived && p.StockReserved > 0 {
+		return domain.NewBusinessRuleError(
+			"product_has_reservations",
+			fmt.Sprintf("product %s still has %d units reserved for orders", p.SKU, p.StockReserved),
+		)
+	}
+
+	previous := p.Status
+	p.Status = status
+	p.IncrementVersion()
+
+	// Add domain event
+	p.AddEvent(NewProductStatusChangedEvent(p, previous))
+
+	return nil
+}
+
+// normalizePrice validates a list price and normalizes its currency code
+// Validation problems are collected in validationErrors; other errors are returned
+func normalizePrice(price domain.Money, validationErrors *domain.ValidationErrors) (domain.Money, error) {
+	normalized, err := domain.NewMoney(price.Amount, price.Currency)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if !errors.As(err, &validationErr) {
+			return domain.Money{}, err
+		}
+		*validationErrors = append(*validationErrors, domain.ValidationError{
+			Field:   "price.currency",
+			Message: validationErr.Message,
+			Value:   validationErr.Value,
+		})
+		return price, nil
+	}
+
+	if normalized.IsNegative() {
+		validationErrors.AddWithValue("price.amount", "price must not be negative", price.Amount)
+	}
+	return normalized, nil
+}
+
+// SetStock sets the stock on hand, e.g. after a stock count
+// The new level cannot drop below the stock already reserved
+func (p *Product) SetStock(stockOnHand int) error {
+	if stockOnHand < 0 {
+		return domain.NewValidationErrorWithValue("stock_on_hand", "stock_on_hand must not be negative", stockOnHand)
+	}
+	if p.Status == ProductStatusArchived {
+		return domain.NewBusinessRuleError(
+			"product_archived",
+			fmt.Sprintf("product %s is archived and cannot be changed", p.SKU),
+		)
+	}
+	if stockOnHand < p.StockReserved {
+		return domain.NewBusinessRuleError(
+			"stock_below_reserved",
+			fmt.Sprintf("stock on hand cannot be lower than the %d units already reserved", p.StockReserved),
+		)
+	}
+
+	if p.StockOnHand == stockOnHand {
+		return nil
+	}
+
+	previous := p.StockOnHand
+	p.StockOnHand = stockOnHand
+	p.IncrementVersion()
+
+	// Add domain event
+	p.AddEvent(NewProductStockChangedEvent(p, previous))
+
+	return nil
+}
+
+// Reserve holds stock for an order
+func (p *Product) Reserve(quantity int) error {
+	if quantity <= 0 {
+		return domain.NewValidationErrorWithValue("quantity", "quantity must be positive", quantity)
+	}
+	if !p.IsOrderable() {
+		return domain.NewBusinessRuleError(
+			"product_unavailable",
+			fmt.Sprintf("product %s is %s", p.SKU, p.Status),
+		)
+	}
+	if quantity > p.Available() {
+		return domain.NewBusinessRuleError(
+			"insufficient_stock",
+			fmt.Sprintf("only %d units of %s are available", p.Available(), p.SKU),
+		)
+	}
+
+	p.StockReserved += quantity
+	p.IncrementVersion()
+	return nil
+}
+
+// ReleaseReservation returns previously reserved stock
+func (p *Product) ReleaseReservation(quantity int) error {
+	if quantity <= 0 || quantity > p.StockReserved {
+		return domain.NewBusinessRuleError(
+			"invalid_release",
+			fmt.Sprintf("cannot release %d units of %s with %d reserved", quantity, p.SKU, p.StockReserved),
+		)
+	}
+
+	p.StockReserved -= quantity
+	p.IncrementVersion()
+	return nil
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// ProductRepository defines the interface for product persistence
+type ProductRepository interface {
+	// Save saves a product (create or update)
+	Save(ctx context.Context, product *Product) error
+
+	// GetByID retrieves a product by ID
+	GetByID(ctx context.Context, id string) (*Product, error)
+
+	// ExistsBySKU checks if a product exists by SKU
+	ExistsBySKU(ctx context.Context, sku string) (bool, error)
+
+	// List retrieves products matching the parameters, one page at a time
+	List(ctx context.Context, params ListProductsParams) (*ProductListResult, error)
+}
+
+// ListProductsParams represents parameters for listing products
+type ListProductsParams struct {
+	// Pagination
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+
+	// Sorting
+	SortBy    string `json:"sort_by"`    // name, sku, price, created_at, updated_at
+	SortOrder string `json:"sort_order"` // asc, desc
+
+	// Filtering
+	Statuses []ProductStatus `json:"statuses,omitempty"` // any of; active and inactive products by default
+	Query    string          `json:"query,omitempty"`    // SKU prefix or partial name
+}
+
+// Validate applies defaults and validates the list parameters
+func (p *ListProductsParams) Validate() error {
+	if p.Page <= 0 {
+		p.Page = 1
+	}
+
+	if p.Limit <= 0 {
+		p.Limit = 20
+	}
+
+	// Maximum limit
+	if p.Limit > 100 {
+		p.Limit = 100
+	}
+
+	// Valid sort fields
+	validSortFields := map[string]bool{
+		"name":       true,
+		"sku":        true,
+		"price":      true,
+		"created_at": true,
+		"updated_at": true,
+	}
+
+	if !validSortFields[p.SortBy] {
+		p.SortBy = "name"
+	}
+
+	if p.SortOrder != "asc" && p.SortOrder != "desc" {
+		p.SortOrder = "asc"
+	}
+
+	var validationErrors domain.ValidationErrors
+
+	for _, status := range p.Statuses {
+		if !status.IsValid() {
+			validationErrors.AddWithValue("status", "unknown product status", string(status))
+		}
+	}
+	if len(p.Statuses) == 0 {
+		p.Statuses = []ProductStatus{ProductStatusActive, ProductStatusInactive}
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+	return nil
+}
+
+// ProductListResult represents the result of a product list query
+type ProductListResult struct {
+	Products   []*Product       `json:"products"`
+	Pagination PaginationResult `json:"pagination"`
+}
+
+// PaginationResult represents pagination information
+type PaginationResult struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"total_pages"`
+	HasNext    bool  `json:"has_next"`
+	HasPrev    bool  `json:"has_prev"`
+}
+
+// NewPaginationResult creates a new pagination result
+func NewPaginationResult(page, limit int, total int64) PaginationResult {
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return PaginationResult{
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+}
+
+// InventoryRepository defines the interface for stock reservations
+type InventoryRepository interface {
+	// Reserve reserves stock for every item of the reservation atomically
+	// It returns ReservationRejectedError when any product is unknown or short of stock.
+	// Reserving again for an order that already holds a reservation is a no-op.
+	Reserve(ctx context.Context, reservation *Reservation) error
+
+	// ReserveLine reserves the item of a line added to an order on top of the order's reservation
+	// It returns ReservationRejectedError when the product is unknown or short of stock, and
+	// ErrNotFound when the order holds no reservation yet. Reserving a line again, or for an order
+	// whose reservation was released, is a no-op.
+	ReserveLine(ctx context.Context, orderID, lineID string, item ReservationItem) error
+
+	// Release returns the stock held for an order and marks its reservation released
+	// It returns nil when the order holds no active reservation, so releasing twice is a no-op.
+	Release(ctx context.Context, orderID string) (*Reservation, error)
+}
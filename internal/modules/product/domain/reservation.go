@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// ReservationStatus represents the status of an inventory reservation
+type ReservationStatus string
+
+const (
+	ReservationStatusReserved ReservationStatus = "reserved"
+	ReservationStatusReleased ReservationStatus = "released"
+)
+
+// Reasons a reservation can be rejected
+const (
+	RejectReasonInsufficientStock  = "insufficient_stock"
+	RejectReasonUnknownProduct     = "unknown_product"
+	RejectReasonProductUnavailable = "product_unavailable" // inactive or archived
+)
+
+// ReservationItem is a product quantity held by a reservation
+type ReservationItem struct {
+	ProductID string `json:"product_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+// Reservation holds stock of one or more products for an order
+// A reservation is all-or-nothing: either every item is reserved or none is. Lines added to the
+// order afterwards are reserved on top of it, one at a time, and listed in LineIDs
+type Reservation struct {
+	OrderID string            `json:"order_id"`
+	Items   []ReservationItem `json:"items"`
+	LineIDs []string          `json:"line_ids,omitempty"`
+	Status  ReservationStatus `json:"status"`
+}
+
+// NewReservation creates a reservation request for an order
+// Items for the same product are merged and sorted by product ID
+func NewReservation(orderID string, items []ReservationItem) (*Reservation, error) {
+	// Validate input
+	var validationErrors domain.ValidationErrors
+
+	orderID = strings.TrimSpace(orderID)
+	if orderID == "" {
+		validationErrors.Add("order_id", "order_id is required")
+	}
+	if len(items) == 0 {
+		validationErrors.Add("items", "at least one item is required")
+	}
+
+	quantities := make(map[string]int)
+	for _, item := range items {
+		productID := strings.TrimSpace(item.ProductID)
+		if productID == "" {
+			validationErrors.Add("product_id", "product_id is required")
+			continue
+		}
+		if item.Quantity <= 0 {
+			validationErrors.AddWithValue("quantity", "quantity must be positive", item.Quantity)
+			continue
+		}
+		quantities[productID] += item.Quantity
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	merged := make([]ReservationItem, 0, len(quantities))
+	for productID, quantity := range quantities {
+		merged = append(merged, ReservationItem{ProductID: productID, Quantity: quantity})
+	}
+	// Sorted items give a stable lock order across concurrent reservations
+	sort.Slice(merged, func(i, j int) bool { return merged[i].ProductID < merged[j].ProductID })
+
+	return &Reservation{
+		OrderID: orderID,
+		Items:   merged,
+		Status:  ReservationStatusReserved,
+	}, nil
+}
+
+// ProductIDs returns the IDs of the reserved products
+func (r *Reservation) ProductIDs() []string {
+	ids := make([]string, len(r.Items))
+	for i, item := range r.Items {
+		ids[i] = item.ProductID
+	}
+	return ids
+}
+
+// HasLine checks if the item of an order line added after the reservation is held
+func (r *Reservation) HasLine(lineID string) bool {
+	for _, id := range r.LineIDs {
+		if id == lineID {
+			return true
+		}
+	}
+	return false
+}
+
+// AddLine adds the item of an order line added after the reservation to its items
+// Adding a line that is already held is a no-op
+func (r *Reservation) AddLine(lineID string, item ReservationItem) error {
+	if r.Status != ReservationStatusReserved {
+		return domain.NewBusinessRuleError("reservation_released", fmt.Sprintf("the reservation of order %s was released", r.OrderID))
+	}
+	if strings.TrimSpace(lineID) == "" {
+		return domain.NewValidationError("line_id", "line_id is required")
+	}
+	if r.HasLine(lineID) {
+		return nil
+	}
+
+	merged, err := NewReservation(r.OrderID, append(append([]ReservationItem{}, r.Items...), item))
+	if err != nil {
+		return err
+	}
+	r.Items = merged.Items
+	r.LineIDs = append(r.LineIDs, lineID)
+	return nil
+}
+
+// StockShortage describes a product that could not be reserved
+type StockShortage struct {
+	ProductID string `json:"product_id"`
+	Requested int    `json:"requested"`
+	Available int    `json:"available"`
+}
+
+// ReservationRejectedError is returned when stock cannot be reserved for an order
+type ReservationRejectedError struct {
+	Reason    string
+	Shortages []StockShortage
+}
+
+// Error implements the error interface
+func (e ReservationRejectedError) Error() string {
+	return fmt.Sprintf("inventory reservation rejected: %s", e.Reason)
+}
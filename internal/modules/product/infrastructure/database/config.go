@@ -0,0 +1,18 @@
+package database
+
+import (
+	"golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// ProductDatabaseName is the identifier for product database
+	ProductDatabaseName = "product"
+)
+
+// GetProductDB returns the product database connection
+func GetProductDB() (*gorm.DB, error) {
+	manager := database.GetGlobalManager()
+	return manager.GetConnection(ProductDatabaseName)
+}
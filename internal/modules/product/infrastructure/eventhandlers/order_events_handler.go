@@ -0,0 +1,95 @@
+package eventhandlers
+
+import (
+	"context"
+	"fmt"
+
+	orderapi "golang_modular_monolith/internal/modules/order/publicapi"
+	commandhandlers "golang_modular_monolith/internal/modules/product/application/command_handlers"
+	"golang_modular_monolith/internal/modules/product/application/commands"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// OrderEventsHandler reserves stock for newly created orders and the lines added to them, and
+// returns it when they are cancelled
+// The outcome is reported back through inventory.reserved, inventory.reservation_rejected or inventory.released
+type OrderEventsHandler struct {
+	reserveInventoryHandler *commandhandlers.ReserveInventoryHandler
+	reserveOrderLineHandler *commandhandlers.ReserveOrderLineHandler
+	releaseInventoryHandler *commandhandlers.ReleaseInventoryHandler
+}
+
+// NewOrderEventsHandler creates a new order events handler
+func NewOrderEventsHandler(
+	reserveInventoryHandler *commandhandlers.ReserveInventoryHandler,
+	reserveOrderLineHandler *commandhandlers.ReserveOrderLineHandler,
+	releaseInventoryHandler *commandhandlers.ReleaseInventoryHandler,
+) *OrderEventsHandler {
+	return &OrderEventsHandler{
+		reserveInventoryHandler: reserveInventoryHandler,
+		reserveOrderLineHandler: reserveOrderLineHandler,
+		releaseInventoryHandler: releaseInventoryHandler,
+	}
+}
+
+// CanHandle reports whether the handler is interested in the event type
+func (h *OrderEventsHandler) CanHandle(eventType string) bool {
+	switch eventType {
+	case orderapi.OrderCreatedEventType,
+		orderapi.OrderLineAddedEventType,
+		orderapi.OrderCancelledEventType:
+		return true
+	}
+	return false
+}
+
+// Handle reserves or releases stock for the order
+func (h *OrderEventsHandler) Handle(event shareddomain.DomainEvent) error {
+	switch e := event.(type) {
+	case orderapi.OrderCreated:
+		return h.reserve(e)
+	case orderapi.OrderLineAdded:
+		return h.reserveLine(e)
+	case orderapi.OrderCancelled:
+		return h.release(e)
+	default:
+		return fmt.Errorf("unsupported event %T for order events handler", event)
+	}
+}
+
+// reserve requests a reservation for the order's items
+func (h *OrderEventsHandler) reserve(created orderapi.OrderCreated) error {
+	orderItems := created.GetItems()
+	items := make([]commands.ReserveInventoryItem, len(orderItems))
+	for i, item := range orderItems {
+		items[i] = commands.ReserveInventoryItem{ProductID: item.ProductID, Quantity: item.Quantity}
+	}
+
+	cmd := commands.NewReserveInventoryCommand(created.GetOrderID(), items)
+	if _, err := h.reserveInventoryHandler.Handle(shareddomain.WithEventActor(context.Background(), created), &cmd); err != nil {
+		return fmt.Errorf("failed to reserve inventory for order %s: %w", created.GetOrderID(), err)
+	}
+
+	return nil
+}
+
+// reserveLine requests the stock of a line added to the order on top of its reservation
+func (h *OrderEventsHandler) reserveLine(added orderapi.OrderLineAdded) error {
+	item := added.GetItem()
+	cmd := commands.NewReserveOrderLineCommand(added.GetOrderID(), added.GetLineID(), commands.ReserveInventoryItem{ProductID: item.ProductID, Quantity: item.Quantity})
+	if _, err := h.reserveOrderLineHandler.Handle(shareddomain.WithEventActor(context.Background(), added), &cmd); err != nil {
+		return fmt.Errorf("failed to reserve line %s of order %s: %w", added.GetLineID(), added.GetOrderID(), err)
+	}
+
+	return nil
+}
+
+// release returns the stock held for a cancelled order
+func (h *OrderEventsHandler) release(cancelled orderapi.OrderCancelled) error {
+	cmd := commands.NewReleaseInventoryCommand(cancelled.GetOrderID())
+	if _, err := h.releaseInventoryHandler.Handle(shareddomain.WithEventActor(context.Background(), cancelled), &cmd); err != nil {
+		return fmt.Errorf("failed to release inventory for order %s: %w", cancelled.GetOrderID(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,345 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	commandhandlers "golang_modular_monolith/internal/modules/product/application/command_handlers"
+	"golang_modular_monolith/internal/modules/product/application/commands"
+	"golang_modular_monolith/internal/modules/product/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/product/application/query_handlers"
+	"golang_modular_monolith/internal/modules/product/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProductHandler handles HTTP requests for product operations
+type ProductHandler struct {
+	// Command handlers
+	createProductHandler   *commandhandlers.CreateProductHandler
+	updateProductHandler   *commandhandlers.UpdateProductHandler
+	archiveProductHandler  *commandhandlers.ArchiveProductHandler
+	setProductStockHandler *commandhandlers.SetProductStockHandler
+
+	// Query handlers
+	getProductHandler   *queryhandlers.GetProductHandler
+	listProductsHandler *queryhandlers.ListProductsHandler
+}
+
+// NewProductHandler creates a new product handler
+func NewProductHandler(
+	createProductHandler *commandhandlers.CreateProductHandler,
+	updateProductHandler *commandhandlers.UpdateProductHandler,
+	archiveProductHandler *commandhandlers.ArchiveProductHandler,
+	setProductStockHandler *commandhandlers.SetProductStockHandler,
+	getProductHandler *queryhandlers.GetProductHandler,
+	listProductsHandler *queryhandlers.ListProductsHandler,
+) *ProductHandler {
+	return &ProductHandler{
+		createProductHandler:   createProductHandler,
+		updateProductHandler:   updateProductHandler,
+		archiveProductHandler:  archiveProductHandler,
+		setProductStockHandler: setProductStockHandler,
+		getProductHandler:      getProductHandler,
+		listProductsHandler:    listProductsHandler,
+	}
+}
+
+// CreateProductRequest represents the request body for creating a product
+// Price is in the currency's minor unit (e.g. cents)
+type CreateProductRequest struct {
+	SKU         string `json:"sku" binding:"required,max=64"`
+	Name        string `json:"name" binding:"required,min=1,max=255"`
+	Price       *int64 `json:"price" binding:"required,min=0"`
+	Currency    string `json:"currency" binding:"required,len=3"`
+	StockOnHand int    `json:"stock_on_hand" binding:"min=0"`
+}
+
+// UpdateProductRequest represents the request body for partially updating a product
+// Omitted fields keep their current value
+type UpdateProductRequest struct {
+	Name     *string `json:"name" binding:"omitempty,min=1,max=255"`
+	Price    *int64  `json:"price" binding:"omitempty,min=0"`
+	Currency *string `json:"currency" binding:"omitempty,len=3"`
+	Status   *string `json:"status" binding:"omitempty,oneof=active inactive"`
+}
+
+// SetProductStockRequest represents the request body for setting a product's stock
+type SetProductStockRequest struct {
+	StockOnHand *int `json:"stock_on_hand" binding:"required,min=0"`
+}
+
+// CreateProduct handles POST /products
+func (h *ProductHandler) CreateProduct(c *gin.Context) {
+	var req CreateProductRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewCreateProductCommand(req.SKU, req.Name, *req.Price, req.Currency, req.StockOnHand)
+
+	result, err := h.createProductHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetProduct handles GET /products/:id
+func (h *ProductHandler) GetProduct(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		h.handleError(c, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"Product ID is required",
+		))
+		return
+	}
+
+	query := &queries.GetProductQuery{
+		ID: id,
+	}
+
+	result, err := h.getProductHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result.Product,
+	})
+}
+
+// ListProducts handles GET /products
+// Archived products are only listed when requested explicitly (?status=archived)
+func (h *ProductHandler) ListProducts(c *gin.Context) {
+	query := &queries.ListProductsQuery{
+		Page:      h.getIntParam(c, "page", 1),
+		Limit:     h.getIntParam(c, "limit", 20),
+		SortBy:    h.getStringParam(c, "sort_by", "name"),
+		SortOrder: h.getStringParam(c, "sort_order", "asc"),
+		Statuses:  h.getStatusFilters(c),
+		Query:     c.Query("q"),
+	}
+
+	result, err := h.listProductsHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"data":       result.Products,
+		"pagination": result.Pagination,
+	})
+}
+
+// UpdateProduct handles PATCH /products/:id
+func (h *ProductHandler) UpdateProduct(c *gin.Context) {
+	var req UpdateProductRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewUpdateProductCommand(c.Param("id"))
+	cmd.Name = req.Name
+	cmd.Price = req.Price
+	cmd.Currency = req.Currency
+	cmd.Status = req.Status
+
+	result, err := h.updateProductHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// DeleteProduct handles DELETE /products/:id
+// The product is archived rather than removed, so existing orders keep referring to it
+func (h *ProductHandler) DeleteProduct(c *gin.Context) {
+	cmd := commands.NewArchiveProductCommand(c.Param("id"))
+
+	result, err := h.archiveProductHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// SetProductStock handles PUT /products/:id/stock
+func (h *ProductHandler) SetProductStock(c *gin.Context) {
+	var req SetProductStockRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewSetProductStockCommand(c.Param("id"), *req.StockOnHand)
+
+	result, err := h.setProductStockHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// Helper methods
+
+// getIntParam gets an integer parameter with default value
+func (h *ProductHandler) getIntParam(c *gin.Context, key string, defaultValue int) int {
+	if str := c.Query(key); str != "" {
+		if val, err := strconv.Atoi(str); err == nil {
+			return val
+		}
+	}
+	return defaultValue
+}
+
+// getStringParam gets a string parameter with default value
+func (h *ProductHandler) getStringParam(c *gin.Context, key string, defaultValue string) string {
+	if val := c.Query(key); val != "" {
+		return val
+	}
+	return defaultValue
+}
+
+// getStatusFilters parses a comma-separated status filter (e.g. ?status=active,inactive)
+func (h *ProductHandler) getStatusFilters(c *gin.Context) []domain.ProductStatus {
+	var statuses []domain.ProductStatus
+	for _, value := range strings.Split(c.Query("status"), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			statuses = append(statuses, domain.ProductStatus(value))
+		}
+	}
+	return statuses
+}
+
+// handleError handles errors and returns appropriate HTTP responses
+func (h *ProductHandler) handleError(c *gin.Context, err error) {
+	var validationErrs shareddomain.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErrs.Error(),
+				"details": validationErrs,
+			},
+		})
+		return
+	}
+
+	var validationErr shareddomain.ValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErr.Message,
+				"field":   validationErr.Field,
+			},
+		})
+		return
+	}
+
+	var businessErr shareddomain.BusinessRuleError
+	if errors.As(err, &businessErr) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeBusinessRule,
+				"message": businessErr.Message,
+				"rule":    businessErr.Rule,
+			},
+		})
+		return
+	}
+
+	var domainErr shareddomain.DomainError
+	if errors.As(err, &domainErr) {
+		status := http.StatusInternalServerError
+		switch domainErr.Code {
+		case shareddomain.ErrCodeNotFound:
+			status = http.StatusNotFound
+		case shareddomain.ErrCodeAlreadyExists, shareddomain.ErrCodeConcurrencyConflict:
+			status = http.StatusConflict
+		case shareddomain.ErrCodeInvalidInput, shareddomain.ErrCodeValidationFailed:
+			status = http.StatusBadRequest
+		case shareddomain.ErrCodeRequestTooLarge:
+			status = http.StatusRequestEntityTooLarge
+		case shareddomain.ErrCodeRequestTimeout:
+			status = http.StatusRequestTimeout
+		case shareddomain.ErrCodeInvalidState:
+			status = http.StatusUnprocessableEntity
+		}
+
+		if status == http.StatusInternalServerError {
+			h.internalError(c)
+			return
+		}
+
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    domainErr.Code,
+				"message": domainErr.Message,
+				"field":   domainErr.Field,
+			},
+		})
+		return
+	}
+
+	// Handle standard errors
+	if shareddomain.IsNotFoundError(err) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "NOT_FOUND",
+				"message": "Resource not found",
+			},
+		})
+		return
+	}
+
+	h.internalError(c)
+}
+
+// internalError writes a generic internal error response
+func (h *ProductHandler) internalError(c *gin.Context) {
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": "An internal error occurred",
+		},
+	})
+}
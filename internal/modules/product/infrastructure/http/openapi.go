@@ -0,0 +1,42 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/product/application/commands"
+	"golang_modular_monolith/internal/modules/product/application/queries"
+	"golang_modular_monolith/internal/modules/product/domain"
+	"golang_modular_monolith/internal/modules/product/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+)
+
+// APIOperations documents the routes registered by RegisterProductRoutes
+func APIOperations() []*openapi.Operation {
+	return []*openapi.Operation{
+		openapi.Post("/products", "Create a product").
+			Describe("Prices are in the currency's minor unit, e.g. cents").
+			Body(handlers.CreateProductRequest{}).
+			Requires(domain.PermissionWriteProducts).
+			Created(commands.ProductResult{}),
+		openapi.Get("/products", "List products").
+			Query("page", "integer", "Page number, from 1").
+			Query("limit", "integer", "Page size").
+			Query("sort_by", "string", "Field to sort by").
+			Query("sort_order", "string", "asc or desc").
+			Query("status", "string", "Comma-separated statuses; archived products are only listed when requested").
+			Query("q", "string", "Search over SKU and name").
+			Paginated([]queries.ProductDTO{}, domain.PaginationResult{}),
+		openapi.Get("/products/:id", "Get a product").
+			Returns(queries.ProductDTO{}),
+		openapi.Patch("/products/:id", "Update a product").
+			Describe("Omitted fields keep their current value").
+			Body(handlers.UpdateProductRequest{}).
+			Requires(domain.PermissionWriteProducts).
+			Returns(commands.ProductResult{}),
+		openapi.Delete("/products/:id", "Archive a product").
+			Requires(domain.PermissionWriteProducts).
+			Returns(commands.ProductResult{}),
+		openapi.Put("/products/:id/stock", "Set the stock on hand of a product").
+			Body(handlers.SetProductStockRequest{}).
+			Requires(domain.PermissionWriteStock).
+			Returns(commands.ProductResult{}),
+	}
+}
@@ -0,0 +1,21 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/product/infrastructure/http/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterProductRoutes registers product routes
+func RegisterProductRoutes(router *gin.RouterGroup, productHandler *handlers.ProductHandler) {
+	// Product routes
+	products := router.Group("/products")
+	{
+		products.POST("", productHandler.CreateProduct)
+		products.GET("", productHandler.ListProducts)
+		products.GET("/:id", productHandler.GetProduct)
+		products.PATCH("/:id", productHandler.UpdateProduct)
+		products.DELETE("/:id", productHandler.DeleteProduct)
+		products.PUT("/:id/stock", productHandler.SetProductStock)
+	}
+}
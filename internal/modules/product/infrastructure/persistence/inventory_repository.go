@@ -0,0 +1,322 @@
+package persistence
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/product/domain"
+	productdb "golang_modular_monolith/internal/modules/product/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReservationItems is the list of reserved items stored in a JSONB column
+type ReservationItems []domain.ReservationItem
+
+// Value implements driver.Valuer
+func (i ReservationItems) Value() (driver.Value, error) {
+	if i == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal reservation items: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (i *ReservationItems) Scan(value interface{}) error {
+	if value == nil {
+		*i = ReservationItems{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported reservation items value type: %T", value)
+	}
+
+	result := ReservationItems{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("failed to unmarshal reservation items: %w", err)
+	}
+
+	*i = result
+	return nil
+}
+
+// GormDataType returns the GORM data type
+func (ReservationItems) GormDataType() string {
+	return "jsonb"
+}
+
+// ReservationModel represents the inventory reservation database model
+type ReservationModel struct {
+	OrderID   string           `gorm:"primaryKey;type:varchar(36)"`
+	TenantID  string           `gorm:"type:varchar(64);not null;default:''"`
+	Items     ReservationItems `gorm:"type:jsonb;not null;default:'[]'"`
+	LineIDs   []string         `gorm:"column:line_ids;serializer:json;type:jsonb;not null;default:'[]'"`
+	Status    string           `gorm:"type:varchar(20);not null;default:reserved"`
+	CreatedAt time.Time        `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt time.Time        `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (ReservationModel) TableName() string {
+	return "inventory_reservations"
+}
+
+// ToEntity converts the model to a domain reservation
+func (m *ReservationModel) ToEntity() *domain.Reservation {
+	return &domain.Reservation{
+		OrderID: m.OrderID,
+		Items:   m.Items,
+		LineIDs: m.LineIDs,
+		Status:  domain.ReservationStatus(m.Status),
+	}
+}
+
+// PostgreSQLInventoryRepository implements InventoryRepository using PostgreSQL
+type PostgreSQLInventoryRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLInventoryRepository creates a new PostgreSQL inventory repository
+func NewPostgreSQLInventoryRepository(db *gorm.DB) *PostgreSQLInventoryRepository {
+	return &PostgreSQLInventoryRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLInventoryRepositoryFromManager creates repository using database manager
+func NewPostgreSQLInventoryRepositoryFromManager() (*PostgreSQLInventoryRepository, error) {
+	db, err := productdb.GetProductDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product database: %w", err)
+	}
+
+	return &PostgreSQLInventoryRepository{
+		db: db,
+	}, nil
+}
+
+// Reserve reserves stock for every item of the reservation in a single transaction
+func (r *PostgreSQLInventoryRepository) Reserve(ctx context.Context, reservation *domain.Reservation) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Replaying the request for an order that already holds a reservation is harmless
+		var existing ReservationModel
+		err := tx.Where("order_id = ?", reservation.OrderID).First(&existing).Error
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to check existing reservation: %w", err)
+		}
+
+		if err := reserveStock(tx, reservation); err != nil {
+			return err
+		}
+
+		if err := tx.Create(&ReservationModel{
+			OrderID: reservation.OrderID,
+			Items:   ReservationItems(reservation.Items),
+			LineIDs: []string{},
+			Status:  string(reservation.Status),
+		}).Error; err != nil {
+			return fmt.Errorf("failed to save reservation: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ReserveLine reserves the item of an order line on top of the order's reservation in a single
+// transaction
+// The reservation row is locked first, so that deliveries of the same line cannot both reserve it
+func (r *PostgreSQLInventoryRepository) ReserveLine(ctx context.Context, orderID, lineID string, item domain.ReservationItem) error {
+	addition, err := domain.NewReservation(orderID, []domain.ReservationItem{item})
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model ReservationModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("order_id = ?", addition.OrderID).
+			First(&model).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("order %s holds no reservation: %w", addition.OrderID, shareddomain.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to load reservation: %w", err)
+		}
+
+		// A released reservation belongs to a cancelled order, which needs no stock
+		reservation := model.ToEntity()
+		if reservation.Status != domain.ReservationStatusReserved || reservation.HasLine(lineID) {
+			return nil
+		}
+		if err := reservation.AddLine(lineID, addition.Items[0]); err != nil {
+			return err
+		}
+
+		if err := reserveStock(tx, addition); err != nil {
+			return err
+		}
+
+		model.Items = ReservationItems(reservation.Items)
+		model.LineIDs = reservation.LineIDs
+		model.UpdatedAt = time.Now()
+		if err := tx.Model(&model).Select("items", "line_ids", "updated_at").Updates(&model).Error; err != nil {
+			return fmt.Errorf("failed to save reservation: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// reserveStock locks the products of a reservation and reserves its items, or returns
+// ReservationRejectedError leaving them untouched
+// Product rows are locked in ID order so concurrent reservations cannot deadlock
+func reserveStock(tx *gorm.DB, reservation *domain.Reservation) error {
+	var models []ProductModel
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", reservation.ProductIDs()).
+		Order("id").
+		Find(&models).Error; err != nil {
+		return fmt.Errorf("failed to lock products: %w", err)
+	}
+
+	products := make(map[string]*domain.Product, len(models))
+	for i := range models {
+		products[models[i].ID] = models[i].ToEntity()
+	}
+
+	// Check every item first so the rejection lists all shortages
+	var shortages []domain.StockShortage
+	for _, item := range reservation.Items {
+		product, ok := products[item.ProductID]
+		if !ok {
+			return domain.ReservationRejectedError{
+				Reason:    domain.RejectReasonUnknownProduct,
+				Shortages: []domain.StockShortage{{ProductID: item.ProductID, Requested: item.Quantity}},
+			}
+		}
+		if !product.IsOrderable() {
+			return domain.ReservationRejectedError{
+				Reason:    domain.RejectReasonProductUnavailable,
+				Shortages: []domain.StockShortage{{ProductID: item.ProductID, Requested: item.Quantity}},
+			}
+		}
+		if item.Quantity > product.Available() {
+			shortages = append(shortages, domain.StockShortage{
+				ProductID: item.ProductID,
+				Requested: item.Quantity,
+				Available: product.Available(),
+			})
+		}
+	}
+	if len(shortages) > 0 {
+		return domain.ReservationRejectedError{
+			Reason:    domain.RejectReasonInsufficientStock,
+			Shortages: shortages,
+		}
+	}
+
+	for _, item := range reservation.Items {
+		product := products[item.ProductID]
+		if err := product.Reserve(item.Quantity); err != nil {
+			return err
+		}
+
+		model := &ProductModel{}
+		model.FromEntity(product)
+		if err := tx.Model(model).Select("stock_reserved", "version", "updated_at").Updates(model).Error; err != nil {
+			return fmt.Errorf("failed to update reserved stock: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Release returns the stock held for an order in a single transaction
+func (r *PostgreSQLInventoryRepository) Release(ctx context.Context, orderID string) (*domain.Reservation, error) {
+	var released *domain.Reservation
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model ReservationModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("order_id = ? AND status = ?", orderID, string(domain.ReservationStatusReserved)).
+			First(&model).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to load reservation: %w", err)
+		}
+
+		reservation := model.ToEntity()
+
+		var models []ProductModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", reservation.ProductIDs()).
+			Order("id").
+			Find(&models).Error; err != nil {
+			return fmt.Errorf("failed to lock products: %w", err)
+		}
+
+		products := make(map[string]*domain.Product, len(models))
+		for i := range models {
+			products[models[i].ID] = models[i].ToEntity()
+		}
+
+		for _, item := range reservation.Items {
+			product, ok := products[item.ProductID]
+			if !ok {
+				// The product was removed after the reservation; nothing to return
+				continue
+			}
+			if err := product.ReleaseReservation(item.Quantity); err != nil {
+				return err
+			}
+
+			model := &ProductModel{}
+			model.FromEntity(product)
+			if err := tx.Model(model).Select("stock_reserved", "version", "updated_at").Updates(model).Error; err != nil {
+				return fmt.Errorf("failed to update reserved stock: %w", err)
+			}
+		}
+
+		if err := tx.Model(&ReservationModel{}).
+			Where("order_id = ?", orderID).
+			Updates(map[string]interface{}{
+				"status":     string(domain.ReservationStatusReleased),
+				"updated_at": time.Now(),
+			}).Error; err != nil {
+			return fmt.Errorf("failed to release reservation: %w", err)
+		}
+
+		reservation.Status = domain.ReservationStatusReleased
+		released = reservation
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return released, nil
+}
@@ -0,0 +1,191 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/modules/product/domain"
+	productdb "golang_modular_monolith/internal/modules/product/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"gorm.io/gorm"
+)
+
+// ProductModel represents the product database model
+type ProductModel struct {
+	ID            string    `gorm:"primaryKey;type:varchar(36)"`
+	TenantID      string    `gorm:"type:varchar(64);not null;default:'';uniqueIndex:idx_products_tenant_sku"`
+	SKU           string    `gorm:"column:sku;type:varchar(64);not null;uniqueIndex:idx_products_tenant_sku"`
+	Name          string    `gorm:"type:varchar(255);not null"`
+	Price         int64     `gorm:"not null;default:0"`
+	Currency      string    `gorm:"type:char(3);not null"`
+	Status        string    `gorm:"type:varchar(16);not null;default:active"`
+	StockOnHand   int       `gorm:"not null;default:0"`
+	StockReserved int       `gorm:"not null;default:0"`
+	Version       int       `gorm:"not null;default:0"`
+	CreatedAt     time.Time `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt     time.Time `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (ProductModel) TableName() string {
+	return "products"
+}
+
+// ToEntity converts database model to domain entity
+func (m *ProductModel) ToEntity() *domain.Product {
+	product := &domain.Product{
+		BaseAggregateRoot: shareddomain.NewBaseAggregateRootWithID(m.ID),
+		SKU:               m.SKU,
+		Name:              m.Name,
+		Price:             shareddomain.Money{Amount: m.Price, Currency: m.Currency},
+		Status:            domain.ProductStatus(m.Status),
+		StockOnHand:       m.StockOnHand,
+		StockReserved:     m.StockReserved,
+	}
+
+	// Set version and timestamps from database
+	product.Version = m.Version
+	product.CreatedAt = m.CreatedAt
+	product.UpdatedAt = m.UpdatedAt
+
+	return product
+}
+
+// FromEntity converts domain entity to database model
+func (m *ProductModel) FromEntity(product *domain.Product) {
+	m.ID = product.GetID()
+	m.SKU = product.SKU
+	m.Name = product.Name
+	m.Price = product.Price.Amount
+	m.Currency = product.Price.Currency
+	m.Status = string(product.Status)
+	m.StockOnHand = product.StockOnHand
+	m.StockReserved = product.StockReserved
+	m.Version = product.GetVersion()
+	m.CreatedAt = product.GetCreatedAt()
+	m.UpdatedAt = product.GetUpdatedAt()
+}
+
+// PostgreSQLProductRepository implements ProductRepository using PostgreSQL
+type PostgreSQLProductRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLProductRepository creates a new PostgreSQL product repository
+func NewPostgreSQLProductRepository(db *gorm.DB) *PostgreSQLProductRepository {
+	return &PostgreSQLProductRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLProductRepositoryFromManager creates repository using database manager
+func NewPostgreSQLProductRepositoryFromManager() (*PostgreSQLProductRepository, error) {
+	db, err := productdb.GetProductDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product database: %w", err)
+	}
+
+	return &PostgreSQLProductRepository{
+		db: db,
+	}, nil
+}
+
+// Save saves a product (create or update)
+func (r *PostgreSQLProductRepository) Save(ctx context.Context, product *domain.Product) error {
+	model := &ProductModel{}
+	model.FromEntity(product)
+
+	result := r.db.WithContext(ctx).Save(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save product: %w", result.Error)
+	}
+
+	// Clear uncommitted events after successful save
+	product.ClearUncommittedEvents()
+
+	return nil
+}
+
+// GetByID retrieves a product by ID
+func (r *PostgreSQLProductRepository) GetByID(ctx context.Context, id string) (*domain.Product, error) {
+	var model ProductModel
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get product by ID: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// ExistsBySKU checks if a product exists by SKU
+func (r *PostgreSQLProductRepository) ExistsBySKU(ctx context.Context, sku string) (bool, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&ProductModel{}).
+		Where("sku = ?", sku).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to check product existence by SKU: %w", result.Error)
+	}
+
+	return count > 0, nil
+}
+
+// List retrieves a page of products matching the parameters
+func (r *PostgreSQLProductRepository) List(ctx context.Context, params domain.ListProductsParams) (*domain.ProductListResult, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := r.db.WithContext(ctx).Model(&ProductModel{})
+
+	statuses := make([]string, len(params.Statuses))
+	for i, status := range params.Statuses {
+		statuses[i] = string(status)
+	}
+	query = query.Where("status IN ?", statuses)
+
+	if search := strings.TrimSpace(params.Query); search != "" {
+		query = query.Where("(sku LIKE ? OR name ILIKE ?)",
+			escapeLike(strings.ToUpper(search))+"%",
+			"%"+escapeLike(search)+"%")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count products: %w", err)
+	}
+
+	var models []ProductModel
+	offset := (params.Page - 1) * params.Limit
+	if err := query.
+		Order(fmt.Sprintf("%s %s, id ASC", params.SortBy, params.SortOrder)).
+		Offset(offset).
+		Limit(params.Limit).
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list products: %w", err)
+	}
+
+	products := make([]*domain.Product, len(models))
+	for i := range models {
+		products[i] = models[i].ToEntity()
+	}
+
+	return &domain.ProductListResult{
+		Products:   products,
+		Pagination: domain.NewPaginationResult(params.Page, params.Limit, total),
+	}, nil
+}
+
+// escapeLike escapes the LIKE wildcards of a user supplied search term
+func escapeLike(value string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(value)
+}
@@ -0,0 +1,3 @@
+-- Drop inventory tables
+DROP TABLE IF EXISTS "public"."inventory_reservations";
+DROP TABLE IF EXISTS "public"."products";
@@ -0,0 +1,26 @@
+-- Create products table (stock levels in units)
+CREATE TABLE IF NOT EXISTS "public"."products" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "sku" VARCHAR(64) NOT NULL,
+    "name" VARCHAR(255) NOT NULL,
+    "stock_on_hand" INTEGER NOT NULL DEFAULT 0 CHECK ("stock_on_hand" >= 0),
+    "stock_reserved" INTEGER NOT NULL DEFAULT 0 CHECK ("stock_reserved" >= 0),
+    "version" INTEGER NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "chk_products_reserved_within_stock" CHECK ("stock_reserved" <= "stock_on_hand")
+);
+
+-- Create inventory reservations table (one reservation per order)
+CREATE TABLE IF NOT EXISTS "public"."inventory_reservations" (
+    "order_id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "items" JSONB NOT NULL DEFAULT '[]'::jsonb,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'reserved',
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for better performance
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_sku ON "public"."products" ("sku");
+CREATE INDEX IF NOT EXISTS idx_products_name ON "public"."products" ("name");
+CREATE INDEX IF NOT EXISTS idx_inventory_reservations_status ON "public"."inventory_reservations" ("status");
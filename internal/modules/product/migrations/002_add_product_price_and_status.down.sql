@@ -0,0 +1,6 @@
+-- Remove product price and status
+DROP INDEX IF EXISTS "public"."idx_products_status_name";
+
+ALTER TABLE "public"."products" DROP COLUMN IF EXISTS "status";
+ALTER TABLE "public"."products" DROP COLUMN IF EXISTS "currency";
+ALTER TABLE "public"."products" DROP COLUMN IF EXISTS "price";
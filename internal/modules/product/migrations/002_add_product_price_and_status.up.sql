@@ -0,0 +1,10 @@
+-- Add list price (in the currency's minor unit) and catalog status to products
+-- Existing products keep selling: they become active with a zero price until one is set
+ALTER TABLE "public"."products" ADD COLUMN IF NOT EXISTS "price" BIGINT NOT NULL DEFAULT 0 CHECK ("price" >= 0);
+ALTER TABLE "public"."products" ADD COLUMN IF NOT EXISTS "currency" CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE "public"."products" ADD COLUMN IF NOT EXISTS "status" VARCHAR(16) NOT NULL DEFAULT 'active' CHECK ("status" IN ('active', 'inactive', 'archived'));
+
+-- The currency is always set by the application from now on
+ALTER TABLE "public"."products" ALTER COLUMN "currency" DROP DEFAULT;
+
+CREATE INDEX IF NOT EXISTS idx_products_status_name ON "public"."products" ("status", "name");
@@ -0,0 +1,2 @@
+-- Remove the lines reserved on top of reservations
+ALTER TABLE "public"."inventory_reservations" DROP COLUMN IF EXISTS "line_ids";
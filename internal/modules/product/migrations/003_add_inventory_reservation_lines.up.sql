@@ -0,0 +1,3 @@
+-- Lines added to an order after its reservation are reserved on top of it; line_ids lists them so
+-- that a line is reserved once however often its event is delivered
+ALTER TABLE "public"."inventory_reservations" ADD COLUMN IF NOT EXISTS "line_ids" JSONB NOT NULL DEFAULT '[]'::jsonb;
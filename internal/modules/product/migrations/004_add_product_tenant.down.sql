@@ -0,0 +1,9 @@
+-- Drop the tenant of reservations
+ALTER TABLE "public"."inventory_reservations" DROP COLUMN IF EXISTS "tenant_id";
+
+-- Restore globally unique SKUs; fails while two tenants share a SKU
+DROP INDEX IF EXISTS idx_products_tenant_sku;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_sku ON "public"."products" ("sku");
+
+-- Drop the tenant of products
+ALTER TABLE "public"."products" DROP COLUMN IF EXISTS "tenant_id";
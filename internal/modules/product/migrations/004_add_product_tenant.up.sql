@@ -0,0 +1,9 @@
+-- Products belong to the tenant they were created in; products created without multi-tenancy have none
+ALTER TABLE "public"."products" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+
+-- SKUs are unique within a tenant
+DROP INDEX IF EXISTS idx_products_sku;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_tenant_sku ON "public"."products" ("tenant_id", "sku");
+
+-- Reservations carry the tenant of their order
+ALTER TABLE "public"."inventory_reservations" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
@@ -0,0 +1,120 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/modules/product/infrastructure/eventhandlers"
+	producthttp "golang_modular_monolith/internal/modules/product/infrastructure/http"
+	"golang_modular_monolith/internal/modules/product/infrastructure/http/handlers"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/di"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+	"golang_modular_monolith/internal/shared/infrastructure/registry"
+)
+
+// Auto-register product module on package import
+func init() {
+	registry.RegisterModule("product", func() domain.Module {
+		return NewProductModule()
+	})
+}
+
+// ProductModule implements the Module interface
+type ProductModule struct {
+	name        string
+	logger      *zap.Logger
+	handler     *handlers.ProductHandler
+	orderEvents *eventhandlers.OrderEventsHandler
+
+	// Dependencies
+	eventBus domain.EventBus
+}
+
+// NewProductModule creates a new product module
+func NewProductModule() *ProductModule {
+	return &ProductModule{
+		name: "product",
+	}
+}
+
+// Name returns the module name
+func (m *ProductModule) Name() string {
+	return m.name
+}
+
+// Initialize initializes the product module with dependencies
+func (m *ProductModule) Initialize(deps domain.ModuleDependencies) error {
+	m.logger = deps.Logger
+	m.logger.Info("initializing module")
+
+	// Store event bus
+	m.eventBus = deps.EventBus
+
+	// Construct repositories and handlers from their constructors
+	container := newContainer(m.eventBus)
+	var err error
+	if m.handler, err = di.Resolve[*handlers.ProductHandler](container); err != nil {
+		return fmt.Errorf("failed to create product handler: %w", err)
+	}
+	if m.orderEvents, err = di.Resolve[*eventhandlers.OrderEventsHandler](container); err != nil {
+		return fmt.Errorf("failed to create order events handler: %w", err)
+	}
+
+	m.logger.Info("module initialized")
+	return nil
+}
+
+// RegisterRoutes registers HTTP routes for the product module
+func (m *ProductModule) RegisterRoutes(router *gin.RouterGroup) {
+	m.logger.Info("registering routes")
+
+	producthttp.RegisterProductRoutes(router, m.handler)
+}
+
+// APIOperations implements openapi.Documented
+func (m *ProductModule) APIOperations() []*openapi.Operation {
+	return producthttp.APIOperations()
+}
+
+// Health checks if the product module is healthy
+func (m *ProductModule) Health(ctx context.Context) error {
+	// Check if handler is initialized
+	if m.handler == nil {
+		return fmt.Errorf("product handler not initialized")
+	}
+
+	return nil
+}
+
+// Start starts the product module (optional lifecycle method)
+func (m *ProductModule) Start(ctx context.Context) error {
+	m.logger.Info("starting module")
+
+	// Reserve stock whenever an order is created and release it when the order is cancelled
+	if err := m.eventBus.Subscribe(m.orderEvents); err != nil {
+		return fmt.Errorf("failed to subscribe order events handler: %w", err)
+	}
+
+	m.logger.Info("module started")
+	return nil
+}
+
+// Stop stops the product module (optional lifecycle method)
+func (m *ProductModule) Stop(ctx context.Context) error {
+	m.logger.Info("stopping module")
+
+	// Unregister event handlers
+	if m.orderEvents != nil {
+		if err := m.eventBus.Unsubscribe(m.orderEvents); err != nil {
+			return fmt.Errorf("failed to unsubscribe order events handler: %w", err)
+		}
+	}
+
+	m.logger.Info("module stopped")
+	return nil
+}
@@ -0,0 +1,104 @@
+// Package publicapi is the product module's contract for other modules.
+// Other modules depend on this package only, never on product internals.
+package publicapi
+
+import (
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// Inventory event types other modules may subscribe to
+const (
+	InventoryReservedEventType            = "inventory.reserved"
+	InventoryReservationRejectedEventType = "inventory.reservation_rejected"
+	InventoryReleasedEventType            = "inventory.released"
+)
+
+// Reasons an inventory reservation can be rejected
+const (
+	RejectReasonInsufficientStock  = "insufficient_stock"
+	RejectReasonUnknownProduct     = "unknown_product"
+	RejectReasonProductUnavailable = "product_unavailable"
+	RejectReasonInvalidRequest     = "invalid_request"
+)
+
+// StockShortage describes a product that could not be reserved
+type StockShortage struct {
+	ProductID string `json:"product_id"`
+	Requested int    `json:"requested"`
+	Available int    `json:"available"`
+}
+
+// InventoryReservedEvent is published when stock has been reserved for an order
+type InventoryReservedEvent struct {
+	shareddomain.BaseDomainEvent
+	OrderID string `json:"order_id"`
+}
+
+// NewInventoryReservedEvent creates a new inventory reserved event
+func NewInventoryReservedEvent(orderID string) InventoryReservedEvent {
+	eventData := map[string]interface{}{
+		"order_id": orderID,
+	}
+
+	return InventoryReservedEvent{
+		BaseDomainEvent: shareddomain.NewBaseDomainEvent(
+			orderID,
+			"inventory_reservation",
+			InventoryReservedEventType,
+			eventData,
+		),
+		OrderID: orderID,
+	}
+}
+
+// InventoryReservationRejectedEvent is published when stock could not be reserved for an order
+type InventoryReservationRejectedEvent struct {
+	shareddomain.BaseDomainEvent
+	OrderID   string          `json:"order_id"`
+	Reason    string          `json:"reason"`
+	Shortages []StockShortage `json:"shortages,omitempty"`
+}
+
+// NewInventoryReservationRejectedEvent creates a new inventory reservation rejected event
+func NewInventoryReservationRejectedEvent(orderID, reason string, shortages []StockShortage) InventoryReservationRejectedEvent {
+	eventData := map[string]interface{}{
+		"order_id":  orderID,
+		"reason":    reason,
+		"shortages": shortages,
+	}
+
+	return InventoryReservationRejectedEvent{
+		BaseDomainEvent: shareddomain.NewBaseDomainEvent(
+			orderID,
+			"inventory_reservation",
+			InventoryReservationRejectedEventType,
+			eventData,
+		),
+		OrderID:   orderID,
+		Reason:    reason,
+		Shortages: shortages,
+	}
+}
+
+// InventoryReleasedEvent is published when the stock reserved for an order has been returned
+type InventoryReleasedEvent struct {
+	shareddomain.BaseDomainEvent
+	OrderID string `json:"order_id"`
+}
+
+// NewInventoryReleasedEvent creates a new inventory released event
+func NewInventoryReleasedEvent(orderID string) InventoryReleasedEvent {
+	eventData := map[string]interface{}{
+		"order_id": orderID,
+	}
+
+	return InventoryReleasedEvent{
+		BaseDomainEvent: shareddomain.NewBaseDomainEvent(
+			orderID,
+			"inventory_reservation",
+			InventoryReleasedEventType,
+			eventData,
+		),
+		OrderID: orderID,
+	}
+}
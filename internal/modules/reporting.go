@@ -0,0 +1,6 @@
+//go:build !no_reporting
+
+package modules
+
+// Excluded from builds tagged no_reporting
+import _ "golang_modular_monolith/internal/modules/reporting"
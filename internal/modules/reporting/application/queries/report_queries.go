@@ -0,0 +1,84 @@
+package queries
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/modules/reporting/domain"
+)
+
+// RangeQuery is the time range and buckets of a report query
+type RangeQuery struct {
+	Period   string     `json:"period"`
+	From     *time.Time `json:"from,omitempty"`
+	To       *time.Time `json:"to,omitempty"`
+	Timezone string     `json:"timezone"`
+}
+
+// toParams converts the range to repository parameters
+func (q RangeQuery) toParams() domain.RangeParams {
+	return domain.RangeParams{
+		Period:   domain.Period(q.Period),
+		From:     q.From,
+		To:       q.To,
+		Timezone: q.Timezone,
+	}
+}
+
+// GetRevenueQuery represents a query for the revenue of each period and currency
+type GetRevenueQuery struct {
+	RangeQuery
+	Currency string `json:"currency"`
+}
+
+// ToParams converts the query to repository parameters
+func (q *GetRevenueQuery) ToParams() domain.RevenueParams {
+	return domain.RevenueParams{RangeParams: q.toParams(), Currency: q.Currency}
+}
+
+// GetRevenueResult represents the result of GetRevenueQuery
+type GetRevenueResult struct {
+	Period   domain.Period       `json:"period"`
+	Timezone string              `json:"timezone"`
+	From     time.Time           `json:"from"`
+	To       time.Time           `json:"to"`
+	Rows     []domain.RevenueRow `json:"rows"`
+}
+
+// GetGrowthQuery represents a query for the new customers and orders of each period
+type GetGrowthQuery struct {
+	RangeQuery
+}
+
+// ToParams converts the query to repository parameters
+func (q *GetGrowthQuery) ToParams() domain.GrowthParams {
+	return domain.GrowthParams{RangeParams: q.toParams()}
+}
+
+// GetGrowthResult represents the result of GetGrowthQuery
+type GetGrowthResult struct {
+	Period   domain.Period      `json:"period"`
+	Timezone string             `json:"timezone"`
+	From     time.Time          `json:"from"`
+	To       time.Time          `json:"to"`
+	Rows     []domain.GrowthRow `json:"rows"`
+}
+
+// GetTopCustomersQuery represents a query for the customers with the most revenue in a currency
+type GetTopCustomersQuery struct {
+	RangeQuery
+	Currency string `json:"currency"`
+	Limit    int    `json:"limit"`
+}
+
+// ToParams converts the query to repository parameters
+func (q *GetTopCustomersQuery) ToParams() domain.TopCustomersParams {
+	return domain.TopCustomersParams{RangeParams: q.toParams(), Currency: q.Currency, Limit: q.Limit}
+}
+
+// GetTopCustomersResult represents the result of GetTopCustomersQuery
+type GetTopCustomersResult struct {
+	Currency  string               `json:"currency"`
+	From      time.Time            `json:"from"`
+	To        time.Time            `json:"to"`
+	Customers []domain.TopCustomer `json:"customers"`
+}
@@ -0,0 +1,106 @@
+package queryhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/reporting/application/queries"
+	"golang_modular_monolith/internal/modules/reporting/domain"
+)
+
+// GetRevenueHandler handles GetRevenueQuery
+type GetRevenueHandler struct {
+	repo domain.ReportRepository
+}
+
+// NewGetRevenueHandler creates a new GetRevenueHandler
+func NewGetRevenueHandler(repo domain.ReportRepository) *GetRevenueHandler {
+	return &GetRevenueHandler{
+		repo: repo,
+	}
+}
+
+// Handle handles the GetRevenueQuery
+func (h *GetRevenueHandler) Handle(ctx context.Context, query *queries.GetRevenueQuery) (*queries.GetRevenueResult, error) {
+	params := query.ToParams()
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	rows, err := h.repo.Revenue(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queries.GetRevenueResult{
+		Period:   params.Period,
+		Timezone: params.Timezone,
+		From:     *params.From,
+		To:       *params.To,
+		Rows:     rows,
+	}, nil
+}
+
+// GetGrowthHandler handles GetGrowthQuery
+type GetGrowthHandler struct {
+	repo domain.ReportRepository
+}
+
+// NewGetGrowthHandler creates a new GetGrowthHandler
+func NewGetGrowthHandler(repo domain.ReportRepository) *GetGrowthHandler {
+	return &GetGrowthHandler{
+		repo: repo,
+	}
+}
+
+// Handle handles the GetGrowthQuery
+func (h *GetGrowthHandler) Handle(ctx context.Context, query *queries.GetGrowthQuery) (*queries.GetGrowthResult, error) {
+	params := query.ToParams()
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	rows, err := h.repo.Growth(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queries.GetGrowthResult{
+		Period:   params.Period,
+		Timezone: params.Timezone,
+		From:     *params.From,
+		To:       *params.To,
+		Rows:     rows,
+	}, nil
+}
+
+// GetTopCustomersHandler handles GetTopCustomersQuery
+type GetTopCustomersHandler struct {
+	repo domain.ReportRepository
+}
+
+// NewGetTopCustomersHandler creates a new GetTopCustomersHandler
+func NewGetTopCustomersHandler(repo domain.ReportRepository) *GetTopCustomersHandler {
+	return &GetTopCustomersHandler{
+		repo: repo,
+	}
+}
+
+// Handle handles the GetTopCustomersQuery
+func (h *GetTopCustomersHandler) Handle(ctx context.Context, query *queries.GetTopCustomersQuery) (*queries.GetTopCustomersResult, error) {
+	params := query.ToParams()
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	customers, err := h.repo.TopCustomers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queries.GetTopCustomersResult{
+		Currency:  params.Currency,
+		From:      *params.From,
+		To:        *params.To,
+		Customers: customers,
+	}, nil
+}
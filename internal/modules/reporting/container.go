@@ -0,0 +1,43 @@
+package reporting
+
+import (
+	"time"
+
+	queryhandlers "golang_modular_monolith/internal/modules/reporting/application/query_handlers"
+	reportingdomain "golang_modular_monolith/internal/modules/reporting/domain"
+	reportingdb "golang_modular_monolith/internal/modules/reporting/infrastructure/database"
+	"golang_modular_monolith/internal/modules/reporting/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/reporting/infrastructure/persistence"
+	"golang_modular_monolith/internal/modules/reporting/infrastructure/projections"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/di"
+)
+
+// newContainer registers the constructors of the reporting module's repository, projection and
+// handlers; nothing is constructed until it is resolved, so callers may replace providers first
+// Reports are kept in the module's cache for cacheTTL, and computed on every request when it is zero
+func newContainer(cache domain.Cache, cacheTTL time.Duration) *di.Container {
+	c := di.New()
+	c.Provide(reportingdb.GetReportingDB)
+
+	// Repositories
+	c.Provide(persistence.NewPostgreSQLReportRepository, di.As[reportingdomain.ReportRepository]())
+	if cacheTTL > 0 {
+		di.Decorate(c, func(repo reportingdomain.ReportRepository) (reportingdomain.ReportRepository, error) {
+			return persistence.NewCachedReportRepository(repo, cache, cacheTTL), nil
+		})
+	}
+
+	// Reporting tables projection
+	c.Provide(projections.NewReportingProjection)
+
+	// Query handlers
+	c.Provide(queryhandlers.NewGetRevenueHandler)
+	c.Provide(queryhandlers.NewGetGrowthHandler)
+	c.Provide(queryhandlers.NewGetTopCustomersHandler)
+
+	// HTTP handlers
+	c.Provide(handlers.NewReportHandler)
+	return c
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Period is the length of the buckets a report groups its figures by
+type Period string
+
+// Report periods; weeks start on Monday
+const (
+	PeriodDay   Period = "day"
+	PeriodWeek  Period = "week"
+	PeriodMonth Period = "month"
+)
+
+// IsValid checks if the period is known
+func (p Period) IsValid() bool {
+	switch p {
+	case PeriodDay, PeriodWeek, PeriodMonth:
+		return true
+	}
+	return false
+}
+
+// defaultSpan is the range a report covers, up to now, when no lower bound is requested: 31 buckets
+// of days, 12 of weeks or 12 of months
+func (p Period) defaultSpan(to time.Time) time.Time {
+	switch p {
+	case PeriodWeek:
+		return to.AddDate(0, 0, -7*12)
+	case PeriodMonth:
+		return to.AddDate(0, -12, 0)
+	default:
+		return to.AddDate(0, 0, -31)
+	}
+}
+
+// ReportRepository answers reports from the reporting tables, which the reporting projections
+// maintain from the events of the customer and order modules
+type ReportRepository interface {
+	// Revenue returns the revenue of each period and currency, oldest period first
+	Revenue(ctx context.Context, params RevenueParams) ([]RevenueRow, error)
+
+	// Growth returns the new customers and the orders of each period, oldest period first
+	Growth(ctx context.Context, params GrowthParams) ([]GrowthRow, error)
+
+	// TopCustomers returns the customers with the most revenue in a currency, highest first
+	TopCustomers(ctx context.Context, params TopCustomersParams) ([]TopCustomer, error)
+}
+
+// RangeParams are the time range and buckets of a report
+type RangeParams struct {
+	Period   Period     `json:"period"`
+	From     *time.Time `json:"from,omitempty"`
+	To       *time.Time `json:"to,omitempty"`
+	Timezone string     `json:"timezone"` // IANA time zone periods start in, UTC by default
+}
+
+// Validate applies defaults and validates the range
+func (p *RangeParams) Validate() error {
+	var validationErrors domain.ValidationErrors
+
+	if p.Period == "" {
+		p.Period = PeriodDay
+	}
+	if !p.Period.IsValid() {
+		validationErrors.AddWithValue("period", "period must be one of: day, week, month", string(p.Period))
+	}
+
+	if p.Timezone == "" {
+		p.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(p.Timezone); err != nil {
+		validationErrors.AddWithValue("tz", "tz must be a valid IANA time zone", p.Timezone)
+	}
+
+	// Up to now; rounded to the next minute so that repeated reports cover the same range
+	if p.To == nil {
+		to := time.Now().UTC().Truncate(time.Minute).Add(time.Minute)
+		p.To = &to
+	}
+	if p.From == nil {
+		from := p.Period.defaultSpan(*p.To)
+		p.From = &from
+	}
+	if p.From.After(*p.To) {
+		validationErrors.Add("from", "from must not be later than to")
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+	return nil
+}
+
+// RevenueParams represents parameters for the revenue report
+type RevenueParams struct {
+	RangeParams
+	Currency string `json:"currency,omitempty"` // all currencies when empty
+}
+
+// RevenueRow represents the revenue of one period in one currency
+// Orders count in the period they were placed in, refunds in the period they were approved in
+type RevenueRow struct {
+	PeriodStart string       `json:"period_start"` // YYYY-MM-DD
+	Currency    string       `json:"currency"`
+	OrderCount  int64        `json:"order_count"`
+	Gross       domain.Money `json:"gross"`     // total of the orders placed
+	Cancelled   domain.Money `json:"cancelled"` // total of those orders that were cancelled since
+	Refunded    domain.Money `json:"refunded"`  // amount of the returns approved
+	Net         domain.Money `json:"net"`       // gross less cancelled and refunded
+}
+
+// GrowthParams represents parameters for the new customers and orders report
+type GrowthParams struct {
+	RangeParams
+}
+
+// GrowthRow represents the new customers and the orders of one period
+type GrowthRow struct {
+	PeriodStart       string `json:"period_start"` // YYYY-MM-DD
+	NewCustomers      int64  `json:"new_customers"`
+	Orders            int64  `json:"orders"`
+	OrderingCustomers int64  `json:"ordering_customers"` // distinct customers placing the orders
+	FirstOrders       int64  `json:"first_orders"`       // orders that were their customer's first
+}
+
+// TopCustomersParams represents parameters for the top customers report
+type TopCustomersParams struct {
+	RangeParams
+	Currency string `json:"currency"`
+	Limit    int    `json:"limit"`
+}
+
+// Validate applies defaults and validates the parameters; revenue is only ranked within a currency
+func (p *TopCustomersParams) Validate() error {
+	var validationErrors domain.ValidationErrors
+	if err := p.RangeParams.Validate(); err != nil {
+		var rangeErrors domain.ValidationErrors
+		if !errors.As(err, &rangeErrors) {
+			return err
+		}
+		validationErrors = append(validationErrors, rangeErrors...)
+	}
+
+	if p.Currency == "" {
+		validationErrors.Add("currency", "currency is required")
+	}
+
+	if p.Limit <= 0 {
+		p.Limit = 10
+	}
+	if p.Limit > 100 {
+		p.Limit = 100
+	}
+
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+	return nil
+}
+
+// TopCustomer represents the orders and revenue of one customer
+// Name and email are those the customer was created with
+type TopCustomer struct {
+	CustomerID string       `json:"customer_id"`
+	Name       string       `json:"name"`
+	Email      string       `json:"email"`
+	OrderCount int64        `json:"order_count"`
+	Revenue    domain.Money `json:"revenue"` // total of the orders not cancelled, less refunds
+}
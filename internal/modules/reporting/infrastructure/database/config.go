@@ -0,0 +1,18 @@
+package database
+
+import (
+	"golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// ReportingDatabaseName is the identifier for reporting database
+	ReportingDatabaseName = "reporting"
+)
+
+// GetReportingDB returns the reporting database connection
+func GetReportingDB() (*gorm.DB, error) {
+	manager := database.GetGlobalManager()
+	return manager.GetConnection(ReportingDatabaseName)
+}
@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang_modular_monolith/internal/modules/reporting/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/reporting/application/query_handlers"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReportHandler handles HTTP requests for reports
+type ReportHandler struct {
+	getRevenueHandler      *queryhandlers.GetRevenueHandler
+	getGrowthHandler       *queryhandlers.GetGrowthHandler
+	getTopCustomersHandler *queryhandlers.GetTopCustomersHandler
+}
+
+// NewReportHandler creates a new report handler
+func NewReportHandler(
+	getRevenueHandler *queryhandlers.GetRevenueHandler,
+	getGrowthHandler *queryhandlers.GetGrowthHandler,
+	getTopCustomersHandler *queryhandlers.GetTopCustomersHandler,
+) *ReportHandler {
+	return &ReportHandler{
+		getRevenueHandler:      getRevenueHandler,
+		getGrowthHandler:       getGrowthHandler,
+		getTopCustomersHandler: getTopCustomersHandler,
+	}
+}
+
+// GetRevenue handles GET /reports/revenue
+func (h *ReportHandler) GetRevenue(c *gin.Context) {
+	rangeQuery, err := h.getRangeQuery(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	result, err := h.getRevenueHandler.Handle(c.Request.Context(), &queries.GetRevenueQuery{
+		RangeQuery: *rangeQuery,
+		Currency:   c.Query("currency"),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetGrowth handles GET /reports/growth
+func (h *ReportHandler) GetGrowth(c *gin.Context) {
+	rangeQuery, err := h.getRangeQuery(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	result, err := h.getGrowthHandler.Handle(c.Request.Context(), &queries.GetGrowthQuery{
+		RangeQuery: *rangeQuery,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetTopCustomers handles GET /reports/top-customers
+func (h *ReportHandler) GetTopCustomers(c *gin.Context) {
+	rangeQuery, err := h.getRangeQuery(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	limit := 0
+	if str := c.Query("limit"); str != "" {
+		if limit, err = strconv.Atoi(str); err != nil {
+			h.handleError(c, shareddomain.NewValidationErrorWithValue("limit", "limit must be an integer", str))
+			return
+		}
+	}
+
+	result, err := h.getTopCustomersHandler.Handle(c.Request.Context(), &queries.GetTopCustomersQuery{
+		RangeQuery: *rangeQuery,
+		Currency:   c.Query("currency"),
+		Limit:      limit,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// getRangeQuery reads the period, range and time zone of a report
+func (h *ReportHandler) getRangeQuery(c *gin.Context) (*queries.RangeQuery, error) {
+	query := &queries.RangeQuery{
+		Period:   c.Query("period"),
+		Timezone: c.Query("tz"),
+	}
+
+	var err error
+	if query.From, err = h.getTimeParam(c, "from", false); err != nil {
+		return nil, err
+	}
+	if query.To, err = h.getTimeParam(c, "to", true); err != nil {
+		return nil, err
+	}
+	return query, nil
+}
+
+// getTimeParam parses an RFC 3339 timestamp, or a date in the tz time zone; a date upper bound
+// covers the whole day
+func (h *ReportHandler) getTimeParam(c *gin.Context, key string, upperBound bool) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	location := time.UTC
+	if tz := c.Query("tz"); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, shareddomain.NewValidationErrorWithValue("tz", "tz must be a valid IANA time zone", tz)
+		}
+		location = loc
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return &t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, location); err == nil {
+		if upperBound {
+			t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		return &t, nil
+	}
+
+	return nil, shareddomain.NewValidationErrorWithValue(key, key+" must be an RFC 3339 timestamp or a YYYY-MM-DD date", value)
+}
+
+// handleError handles errors and returns appropriate HTTP responses
+func (h *ReportHandler) handleError(c *gin.Context, err error) {
+	var validationErrs shareddomain.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErrs.Error(),
+				"details": validationErrs,
+			},
+		})
+		return
+	}
+
+	var validationErr shareddomain.ValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    shareddomain.ErrCodeValidationFailed,
+				"message": validationErr.Message,
+				"field":   validationErr.Field,
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": "An internal error occurred",
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/reporting/application/queries"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+)
+
+// APIOperations documents the routes registered by RegisterReportRoutes
+func APIOperations() []*openapi.Operation {
+	return []*openapi.Operation{
+		reportRange(openapi.Get("/reports/revenue", "Revenue by period")).
+			Describe("Orders count in the period they were placed in, refunds in the period their return was approved in").
+			Query("currency", "string", "ISO 4217 currency; all currencies when omitted").
+			Returns(queries.GetRevenueResult{}),
+		reportRange(openapi.Get("/reports/growth", "New customers and orders by period")).
+			Returns(queries.GetGrowthResult{}),
+		reportRange(openapi.Get("/reports/top-customers", "Customers with the most revenue")).
+			Describe("Revenue is the total of the orders not cancelled, less refunds, in one currency").
+			Query("currency", "string", "ISO 4217 currency, required").
+			Query("limit", "integer", "Number of customers, 10 by default and at most 100").
+			Returns(queries.GetTopCustomersResult{}),
+	}
+}
+
+// reportRange documents the range parameters shared by the reports
+func reportRange(operation *openapi.Operation) *openapi.Operation {
+	return operation.
+		Query("period", "string", "day, week or month").
+		Query("from", "string", "RFC 3339 timestamp or date; 31 days, 12 weeks or 12 months before to by default").
+		Query("to", "string", "RFC 3339 timestamp or date; now by default").
+		Query("tz", "string", "IANA time zone periods and dates are in, UTC by default").
+		Requires("reports:read")
+}
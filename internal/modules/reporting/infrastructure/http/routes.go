@@ -0,0 +1,18 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/reporting/infrastructure/http/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterReportRoutes registers report routes
+func RegisterReportRoutes(router *gin.RouterGroup, reportHandler *handlers.ReportHandler) {
+	// Report routes
+	reports := router.Group("/reports")
+	{
+		reports.GET("/revenue", reportHandler.GetRevenue)
+		reports.GET("/growth", reportHandler.GetGrowth)
+		reports.GET("/top-customers", reportHandler.GetTopCustomers)
+	}
+}
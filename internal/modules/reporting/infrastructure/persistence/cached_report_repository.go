@@ -0,0 +1,55 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"golang_modular_monolith/internal/modules/reporting/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/cache"
+)
+
+// CachedReportRepository keeps the reports of a repository for a while, since the same dashboards
+// request them over and over; reports are at most ttl behind the reporting tables
+type CachedReportRepository struct {
+	repo  domain.ReportRepository
+	cache shareddomain.Cache
+	ttl   time.Duration
+}
+
+// NewCachedReportRepository wraps the repository with the module's cache
+func NewCachedReportRepository(repo domain.ReportRepository, cache shareddomain.Cache, ttl time.Duration) *CachedReportRepository {
+	return &CachedReportRepository{repo: repo, cache: cache, ttl: ttl}
+}
+
+// Revenue returns the revenue report, cached
+func (r *CachedReportRepository) Revenue(ctx context.Context, params domain.RevenueParams) ([]domain.RevenueRow, error) {
+	return cached(ctx, r, "revenue", params, func(ctx context.Context) ([]domain.RevenueRow, error) {
+		return r.repo.Revenue(ctx, params)
+	})
+}
+
+// Growth returns the growth report, cached
+func (r *CachedReportRepository) Growth(ctx context.Context, params domain.GrowthParams) ([]domain.GrowthRow, error) {
+	return cached(ctx, r, "growth", params, func(ctx context.Context) ([]domain.GrowthRow, error) {
+		return r.repo.Growth(ctx, params)
+	})
+}
+
+// TopCustomers returns the top customers report, cached
+func (r *CachedReportRepository) TopCustomers(ctx context.Context, params domain.TopCustomersParams) ([]domain.TopCustomer, error) {
+	return cached(ctx, r, "top_customers", params, func(ctx context.Context) ([]domain.TopCustomer, error) {
+		return r.repo.TopCustomers(ctx, params)
+	})
+}
+
+// cached returns the report cached under its name, tenant and parameters, or loads it
+func cached[T any](ctx context.Context, r *CachedReportRepository, report string, params any, load func(context.Context) (T, error)) (T, error) {
+	key, err := json.Marshal(params)
+	if err != nil {
+		return load(ctx)
+	}
+	tenantID, _ := shareddomain.TenantIDFromContext(ctx)
+	return cache.GetOrLoad(ctx, r.cache, report+":"+tenantID+":"+string(key), r.ttl, load)
+}
@@ -0,0 +1,48 @@
+package persistence
+
+import "time"
+
+// ReportCustomerModel is a customer as the reporting tables know it, from customer.created
+type ReportCustomerModel struct {
+	CustomerID string    `gorm:"primaryKey;type:varchar(36)"`
+	TenantID   string    `gorm:"type:varchar(64);not null;default:''"`
+	Name       string    `gorm:"type:varchar(255);not null;default:''"`
+	Email      string    `gorm:"type:varchar(255);not null;default:''"`
+	CreatedAt  time.Time `gorm:"type:timestamp with time zone;not null"`
+}
+
+// TableName returns the table name for GORM
+func (ReportCustomerModel) TableName() string {
+	return "report_customers"
+}
+
+// ReportOrderModel is an order as the reporting tables know it, from order.created and order.cancelled
+type ReportOrderModel struct {
+	OrderID     string     `gorm:"primaryKey;type:varchar(36)"`
+	TenantID    string     `gorm:"type:varchar(64);not null;default:''"`
+	CustomerID  string     `gorm:"type:varchar(36);not null"`
+	Currency    string     `gorm:"type:varchar(3);not null"`
+	Total       int64      `gorm:"not null"`
+	PlacedAt    time.Time  `gorm:"type:timestamp with time zone;not null"`
+	CancelledAt *time.Time `gorm:"type:timestamp with time zone"`
+}
+
+// TableName returns the table name for GORM
+func (ReportOrderModel) TableName() string {
+	return "report_orders"
+}
+
+// ReportRefundModel is a return refunded from an order, from order.return_approved
+type ReportRefundModel struct {
+	ReturnID   string    `gorm:"primaryKey;type:varchar(36)"`
+	TenantID   string    `gorm:"type:varchar(64);not null;default:''"`
+	OrderID    string    `gorm:"type:varchar(36);not null"`
+	Currency   string    `gorm:"type:varchar(3);not null"`
+	Amount     int64     `gorm:"not null"`
+	ApprovedAt time.Time `gorm:"type:timestamp with time zone;not null"`
+}
+
+// TableName returns the table name for GORM
+func (ReportRefundModel) TableName() string {
+	return "report_refunds"
+}
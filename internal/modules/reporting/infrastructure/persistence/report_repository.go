@@ -0,0 +1,202 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/reporting/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"gorm.io/gorm"
+)
+
+// PostgreSQLReportRepository implements ReportRepository using PostgreSQL
+type PostgreSQLReportRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLReportRepository creates a new PostgreSQL report repository
+func NewPostgreSQLReportRepository(db *gorm.DB) *PostgreSQLReportRepository {
+	return &PostgreSQLReportRepository{
+		db: db,
+	}
+}
+
+// revenueRow is a row of the revenue query
+type revenueRow struct {
+	PeriodStart string
+	Currency    string
+	OrderCount  int64
+	Gross       int64
+	Cancelled   int64
+	Refunded    int64
+}
+
+// Revenue returns the revenue of each period and currency
+func (r *PostgreSQLReportRepository) Revenue(ctx context.Context, params domain.RevenueParams) ([]domain.RevenueRow, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	var rows []revenueRow
+	err := r.db.WithContext(ctx).Raw(`
+		WITH orders AS (
+			SELECT to_char(date_trunc(@period, placed_at AT TIME ZONE @tz), 'YYYY-MM-DD') AS period_start,
+				currency,
+				COUNT(*) AS order_count,
+				SUM(total) AS gross,
+				COALESCE(SUM(total) FILTER (WHERE cancelled_at IS NOT NULL), 0) AS cancelled
+			FROM report_orders
+			WHERE placed_at BETWEEN @from AND @to AND (@currency = '' OR currency = @currency)
+				AND (@tenant = '' OR tenant_id = @tenant)
+			GROUP BY 1, 2
+		), refunds AS (
+			SELECT to_char(date_trunc(@period, approved_at AT TIME ZONE @tz), 'YYYY-MM-DD') AS period_start,
+				currency,
+				SUM(amount) AS refunded
+			FROM report_refunds
+			WHERE approved_at BETWEEN @from AND @to AND (@currency = '' OR currency = @currency)
+				AND (@tenant = '' OR tenant_id = @tenant)
+			GROUP BY 1, 2
+		)
+		SELECT period_start, currency,
+			COALESCE(orders.order_count, 0) AS order_count,
+			COALESCE(orders.gross, 0) AS gross,
+			COALESCE(orders.cancelled, 0) AS cancelled,
+			COALESCE(refunds.refunded, 0) AS refunded
+		FROM orders FULL OUTER JOIN refunds USING (period_start, currency)
+		ORDER BY period_start, currency`,
+		r.rangeArgs(ctx, params.RangeParams, map[string]interface{}{"currency": params.Currency}),
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to report revenue: %w", err)
+	}
+
+	result := make([]domain.RevenueRow, len(rows))
+	for i, row := range rows {
+		money := func(amount int64) shareddomain.Money {
+			return shareddomain.Money{Amount: amount, Currency: row.Currency}
+		}
+		result[i] = domain.RevenueRow{
+			PeriodStart: row.PeriodStart,
+			Currency:    row.Currency,
+			OrderCount:  row.OrderCount,
+			Gross:       money(row.Gross),
+			Cancelled:   money(row.Cancelled),
+			Refunded:    money(row.Refunded),
+			Net:         money(row.Gross - row.Cancelled - row.Refunded),
+		}
+	}
+	return result, nil
+}
+
+// Growth returns the new customers and the orders of each period
+func (r *PostgreSQLReportRepository) Growth(ctx context.Context, params domain.GrowthParams) ([]domain.GrowthRow, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	var rows []domain.GrowthRow
+	err := r.db.WithContext(ctx).Raw(`
+		WITH customers AS (
+			SELECT to_char(date_trunc(@period, created_at AT TIME ZONE @tz), 'YYYY-MM-DD') AS period_start,
+				COUNT(*) AS new_customers
+			FROM report_customers
+			WHERE created_at BETWEEN @from AND @to AND (@tenant = '' OR tenant_id = @tenant)
+			GROUP BY 1
+		), orders AS (
+			SELECT to_char(date_trunc(@period, o.placed_at AT TIME ZONE @tz), 'YYYY-MM-DD') AS period_start,
+				COUNT(*) AS orders,
+				COUNT(DISTINCT o.customer_id) AS ordering_customers,
+				COUNT(*) FILTER (WHERE NOT EXISTS (
+					SELECT 1 FROM report_orders earlier
+					WHERE earlier.customer_id = o.customer_id AND earlier.placed_at < o.placed_at
+				)) AS first_orders
+			FROM report_orders o
+			WHERE o.placed_at BETWEEN @from AND @to AND (@tenant = '' OR o.tenant_id = @tenant)
+			GROUP BY 1
+		)
+		SELECT period_start,
+			COALESCE(customers.new_customers, 0) AS new_customers,
+			COALESCE(orders.orders, 0) AS orders,
+			COALESCE(orders.ordering_customers, 0) AS ordering_customers,
+			COALESCE(orders.first_orders, 0) AS first_orders
+		FROM customers FULL OUTER JOIN orders USING (period_start)
+		ORDER BY period_start`,
+		r.rangeArgs(ctx, params.RangeParams, nil),
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to report growth: %w", err)
+	}
+
+	if rows == nil {
+		rows = []domain.GrowthRow{}
+	}
+	return rows, nil
+}
+
+// topCustomerRow is a row of the top customers query
+type topCustomerRow struct {
+	CustomerID string
+	Name       string
+	Email      string
+	OrderCount int64
+	Revenue    int64
+}
+
+// TopCustomers returns the customers with the most revenue in a currency
+func (r *PostgreSQLReportRepository) TopCustomers(ctx context.Context, params domain.TopCustomersParams) ([]domain.TopCustomer, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	var rows []topCustomerRow
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT o.customer_id,
+			COALESCE(c.name, '') AS name,
+			COALESCE(c.email, '') AS email,
+			COUNT(*) AS order_count,
+			SUM(o.total - COALESCE(refunds.refunded, 0)) AS revenue
+		FROM report_orders o
+		LEFT JOIN (
+			SELECT order_id, SUM(amount) AS refunded FROM report_refunds GROUP BY order_id
+		) refunds ON refunds.order_id = o.order_id
+		LEFT JOIN report_customers c ON c.customer_id = o.customer_id
+		WHERE o.cancelled_at IS NULL AND o.currency = @currency AND o.placed_at BETWEEN @from AND @to
+			AND (@tenant = '' OR o.tenant_id = @tenant)
+		GROUP BY o.customer_id, c.name, c.email
+		ORDER BY revenue DESC, o.customer_id
+		LIMIT @limit`,
+		r.rangeArgs(ctx, params.RangeParams, map[string]interface{}{"currency": params.Currency, "limit": params.Limit}),
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to report top customers: %w", err)
+	}
+
+	customers := make([]domain.TopCustomer, len(rows))
+	for i, row := range rows {
+		customers[i] = domain.TopCustomer{
+			CustomerID: row.CustomerID,
+			Name:       row.Name,
+			Email:      row.Email,
+			OrderCount: row.OrderCount,
+			Revenue:    shareddomain.Money{Amount: row.Revenue, Currency: params.Currency},
+		}
+	}
+	return customers, nil
+}
+
+// rangeArgs returns the named arguments of a report's range and tenant, with the report's own
+// Reports cover the tenant of ctx, or every tenant when ctx serves none
+func (r *PostgreSQLReportRepository) rangeArgs(ctx context.Context, params domain.RangeParams, args map[string]interface{}) map[string]interface{} {
+	if args == nil {
+		args = map[string]interface{}{}
+	}
+	tenantID, _ := shareddomain.TenantIDFromContext(ctx)
+	args["tenant"] = tenantID
+	args["period"] = string(params.Period)
+	args["tz"] = params.Timezone
+	args["from"] = *params.From
+	args["to"] = *params.To
+	return args
+}
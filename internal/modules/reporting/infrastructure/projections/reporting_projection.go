@@ -0,0 +1,133 @@
+package projections
+
+import (
+	"context"
+	"fmt"
+
+	customerapi "golang_modular_monolith/internal/modules/customer/publicapi"
+	orderapi "golang_modular_monolith/internal/modules/order/publicapi"
+	"golang_modular_monolith/internal/modules/reporting/infrastructure/persistence"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	shareddb "golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReportingProjection maintains the reporting tables from the public events of the customer and
+// order modules
+// Every change is keyed by the ID of its customer, order or return, so replaying events is harmless
+type ReportingProjection struct {
+	db *gorm.DB
+}
+
+// NewReportingProjection creates a new reporting projection
+func NewReportingProjection(db *gorm.DB) *ReportingProjection {
+	return &ReportingProjection{
+		db: db,
+	}
+}
+
+// CanHandle reports whether the projection is interested in the event type
+func (p *ReportingProjection) CanHandle(eventType string) bool {
+	switch eventType {
+	case customerapi.CustomerCreatedEventType,
+		orderapi.OrderCreatedEventType,
+		orderapi.OrderLineAddedEventType,
+		orderapi.OrderCancelledEventType,
+		orderapi.OrderReturnApprovedEventType:
+		return true
+	}
+	return false
+}
+
+// Handle records the event in the reporting tables
+func (p *ReportingProjection) Handle(event shareddomain.DomainEvent) error {
+	ctx := shareddomain.WithEventActor(context.Background(), event)
+	switch e := event.(type) {
+	case customerapi.CustomerCreated:
+		return p.onCustomerCreated(ctx, e)
+	case orderapi.OrderCreated:
+		return p.onOrderCreated(ctx, e)
+	case orderapi.OrderLineAdded:
+		return p.onOrderLineAdded(ctx, e)
+	case orderapi.OrderCancelled:
+		return p.onOrderCancelled(ctx, e)
+	case orderapi.ReturnApproved:
+		return p.onReturnApproved(ctx, e)
+	default:
+		return fmt.Errorf("unsupported event %T for reporting projection", event)
+	}
+}
+
+// onCustomerCreated records a new customer
+func (p *ReportingProjection) onCustomerCreated(ctx context.Context, event customerapi.CustomerCreated) error {
+	customer := &persistence.ReportCustomerModel{
+		CustomerID: event.GetCustomerID(),
+		Name:       event.GetName(),
+		Email:      event.GetEmail(),
+		CreatedAt:  event.GetOccurredAt().UTC(),
+	}
+
+	if err := shareddb.Conn(ctx, p.db).Clauses(clause.OnConflict{DoNothing: true}).Create(customer).Error; err != nil {
+		return fmt.Errorf("failed to record customer %s for reporting: %w", customer.CustomerID, err)
+	}
+	return nil
+}
+
+// onOrderCreated records a new order
+func (p *ReportingProjection) onOrderCreated(ctx context.Context, event orderapi.OrderCreated) error {
+	total := event.GetTotal()
+	order := &persistence.ReportOrderModel{
+		OrderID:    event.GetOrderID(),
+		CustomerID: event.GetCustomerID(),
+		Currency:   total.Currency,
+		Total:      total.Amount,
+		PlacedAt:   event.GetOccurredAt().UTC(),
+	}
+
+	if err := shareddb.Conn(ctx, p.db).Clauses(clause.OnConflict{DoNothing: true}).Create(order).Error; err != nil {
+		return fmt.Errorf("failed to record order %s for reporting: %w", order.OrderID, err)
+	}
+	return nil
+}
+
+// onOrderLineAdded records the new total of an order a line was added to
+// Totals only grow as lines are added, so keeping the greatest one makes replays harmless
+func (p *ReportingProjection) onOrderLineAdded(ctx context.Context, event orderapi.OrderLineAdded) error {
+	result := shareddb.Conn(ctx, p.db).Model(&persistence.ReportOrderModel{}).
+		Where("order_id = ?", event.GetOrderID()).
+		Update("total", gorm.Expr("GREATEST(total, ?)", event.GetTotal().Amount))
+	if result.Error != nil {
+		return fmt.Errorf("failed to update total of order %s for reporting: %w", event.GetOrderID(), result.Error)
+	}
+	return nil
+}
+
+// onOrderCancelled marks an order cancelled; orders placed before reporting tracked them are skipped
+func (p *ReportingProjection) onOrderCancelled(ctx context.Context, event orderapi.OrderCancelled) error {
+	result := shareddb.Conn(ctx, p.db).Model(&persistence.ReportOrderModel{}).
+		Where("order_id = ? AND cancelled_at IS NULL", event.GetOrderID()).
+		Update("cancelled_at", event.GetOccurredAt().UTC())
+	if result.Error != nil {
+		return fmt.Errorf("failed to cancel order %s for reporting: %w", event.GetOrderID(), result.Error)
+	}
+	return nil
+}
+
+// onReturnApproved records the refund of a return
+func (p *ReportingProjection) onReturnApproved(ctx context.Context, event orderapi.ReturnApproved) error {
+	amount := event.GetRefundAmount()
+	refund := &persistence.ReportRefundModel{
+		ReturnID:   event.GetReturnID(),
+		OrderID:    event.GetOrderID(),
+		Currency:   amount.Currency,
+		Amount:     amount.Amount,
+		ApprovedAt: event.GetOccurredAt().UTC(),
+	}
+
+	if err := shareddb.Conn(ctx, p.db).Clauses(clause.OnConflict{DoNothing: true}).Create(refund).Error; err != nil {
+		return fmt.Errorf("failed to record refund of return %s for reporting: %w", refund.ReturnID, err)
+	}
+	return nil
+}
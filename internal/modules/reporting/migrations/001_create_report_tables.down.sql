@@ -0,0 +1,4 @@
+-- Drop reporting tables
+DROP TABLE IF EXISTS "public"."report_refunds";
+DROP TABLE IF EXISTS "public"."report_orders";
+DROP TABLE IF EXISTS "public"."report_customers";
@@ -0,0 +1,33 @@
+-- Customers as the reporting tables know them, from customer.created
+CREATE TABLE IF NOT EXISTS "public"."report_customers" (
+    "customer_id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "name" VARCHAR(255) NOT NULL DEFAULT '',
+    "email" VARCHAR(255) NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Orders, from order.created and order.cancelled
+CREATE TABLE IF NOT EXISTS "public"."report_orders" (
+    "order_id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "customer_id" VARCHAR(36) NOT NULL,
+    "currency" VARCHAR(3) NOT NULL,
+    "total" BIGINT NOT NULL,
+    "placed_at" TIMESTAMP WITH TIME ZONE NOT NULL,
+    "cancelled_at" TIMESTAMP WITH TIME ZONE
+);
+
+-- Refunds of approved returns, from order.return_approved
+CREATE TABLE IF NOT EXISTS "public"."report_refunds" (
+    "return_id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "order_id" VARCHAR(36) NOT NULL,
+    "currency" VARCHAR(3) NOT NULL,
+    "amount" BIGINT NOT NULL,
+    "approved_at" TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Reports filter by time range; first orders look up earlier orders of the same customer
+CREATE INDEX IF NOT EXISTS "idx_report_customers_created_at" ON "public"."report_customers" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_report_orders_placed_at" ON "public"."report_orders" ("placed_at");
+CREATE INDEX IF NOT EXISTS "idx_report_orders_customer_placed_at" ON "public"."report_orders" ("customer_id", "placed_at");
+CREATE INDEX IF NOT EXISTS "idx_report_refunds_approved_at" ON "public"."report_refunds" ("approved_at");
+CREATE INDEX IF NOT EXISTS "idx_report_refunds_order_id" ON "public"."report_refunds" ("order_id");
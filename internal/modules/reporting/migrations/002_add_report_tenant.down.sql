@@ -0,0 +1,8 @@
+-- Drop the tenant of the reporting tables
+DROP INDEX IF EXISTS "idx_report_refunds_tenant_approved_at";
+DROP INDEX IF EXISTS "idx_report_orders_tenant_placed_at";
+DROP INDEX IF EXISTS "idx_report_customers_tenant_created_at";
+
+ALTER TABLE "public"."report_refunds" DROP COLUMN IF EXISTS "tenant_id";
+ALTER TABLE "public"."report_orders" DROP COLUMN IF EXISTS "tenant_id";
+ALTER TABLE "public"."report_customers" DROP COLUMN IF EXISTS "tenant_id";
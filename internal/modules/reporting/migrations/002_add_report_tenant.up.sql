@@ -0,0 +1,9 @@
+-- The reporting tables carry the tenant of the events they were built from, so that reports are
+-- scoped to it; rows from events without a tenant have none
+ALTER TABLE "public"."report_customers" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE "public"."report_orders" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE "public"."report_refunds" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS "idx_report_customers_tenant_created_at" ON "public"."report_customers" ("tenant_id", "created_at");
+CREATE INDEX IF NOT EXISTS "idx_report_orders_tenant_placed_at" ON "public"."report_orders" ("tenant_id", "placed_at");
+CREATE INDEX IF NOT EXISTS "idx_report_refunds_tenant_approved_at" ON "public"."report_refunds" ("tenant_id", "approved_at");
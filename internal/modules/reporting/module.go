@@ -0,0 +1,133 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	reportinghttp "golang_modular_monolith/internal/modules/reporting/infrastructure/http"
+	"golang_modular_monolith/internal/modules/reporting/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/reporting/infrastructure/projections"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/di"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+	"golang_modular_monolith/internal/shared/infrastructure/registry"
+)
+
+// Auto-register reporting module on package import
+func init() {
+	registry.RegisterModule("reporting", func() domain.Module {
+		return NewReportingModule()
+	})
+}
+
+// ReportingModule implements the Module interface
+// It keeps its own tables of customers, orders and refunds, built from the events of the customer
+// and order modules, so that reports do not query their transactional read models
+type ReportingModule struct {
+	name       string
+	logger     *zap.Logger
+	handler    *handlers.ReportHandler
+	projection *projections.ReportingProjection
+
+	// Dependencies
+	eventBus domain.EventBus
+}
+
+// reportingSettings is the reporting section of the module config
+type reportingSettings struct {
+	// CacheTTL is how long reports are cached when features.caching_enabled is set
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
+// NewReportingModule creates a new reporting module
+func NewReportingModule() *ReportingModule {
+	return &ReportingModule{
+		name: "reporting",
+	}
+}
+
+// Name returns the module name
+func (m *ReportingModule) Name() string {
+	return m.name
+}
+
+// Initialize initializes the reporting module with dependencies
+func (m *ReportingModule) Initialize(deps domain.ModuleDependencies) error {
+	m.logger = deps.Logger
+	m.logger.Info("initializing module")
+
+	// Store event bus
+	m.eventBus = deps.EventBus
+
+	settings := reportingSettings{CacheTTL: time.Minute}
+	if err := deps.Config.Decode("", &settings); err != nil {
+		return fmt.Errorf("invalid reporting config: %w", err)
+	}
+	if settings.CacheTTL < 0 {
+		return fmt.Errorf("invalid reporting config: cache_ttl must not be negative")
+	}
+
+	// Construct the repository, projection and handlers from their constructors
+	container := newContainer(deps.Cache, settings.CacheTTL)
+	var err error
+	if m.handler, err = di.Resolve[*handlers.ReportHandler](container); err != nil {
+		return fmt.Errorf("failed to create report handler: %w", err)
+	}
+	if m.projection, err = di.Resolve[*projections.ReportingProjection](container); err != nil {
+		return fmt.Errorf("failed to create reporting projection: %w", err)
+	}
+
+	m.logger.Info("module initialized")
+	return nil
+}
+
+// RegisterRoutes registers HTTP routes for the reporting module
+func (m *ReportingModule) RegisterRoutes(router *gin.RouterGroup) {
+	m.logger.Info("registering routes")
+	reportinghttp.RegisterReportRoutes(router, m.handler)
+}
+
+// APIOperations implements openapi.Documented
+func (m *ReportingModule) APIOperations() []*openapi.Operation {
+	return reportinghttp.APIOperations()
+}
+
+// Health checks if the reporting module is healthy
+func (m *ReportingModule) Health(ctx context.Context) error {
+	if m.handler == nil {
+		return fmt.Errorf("report handler not initialized")
+	}
+
+	return nil
+}
+
+// Start subscribes the reporting projection to customer and order events
+func (m *ReportingModule) Start(ctx context.Context) error {
+	m.logger.Info("starting module")
+
+	if err := m.eventBus.Subscribe(m.projection); err != nil {
+		return fmt.Errorf("failed to subscribe reporting projection: %w", err)
+	}
+
+	m.logger.Info("module started")
+	return nil
+}
+
+// Stop unsubscribes the reporting projection
+func (m *ReportingModule) Stop(ctx context.Context) error {
+	m.logger.Info("stopping module")
+
+	if m.projection != nil {
+		if err := m.eventBus.Unsubscribe(m.projection); err != nil {
+			return fmt.Errorf("failed to unsubscribe reporting projection: %w", err)
+		}
+	}
+
+	m.logger.Info("module stopped")
+	return nil
+}
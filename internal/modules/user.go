@@ -0,0 +1,6 @@
+//go:build !no_user
+
+package modules
+
+// Excluded from builds tagged no_user
+import _ "golang_modular_monolith/internal/modules/user"
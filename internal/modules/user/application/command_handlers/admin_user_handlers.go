@@ -0,0 +1,383 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateUserHandler handles CreateUserCommand
+type CreateUserHandler struct {
+	repo         domain.UserRepository
+	hasher       domain.PasswordHasher
+	policy       domain.PasswordPolicy
+	defaultRole  string
+	verification *EmailVerificationIssuer
+	eventBus     shareddomain.EventBus
+}
+
+// NewCreateUserHandler creates a new CreateUserHandler
+func NewCreateUserHandler(
+	repo domain.UserRepository,
+	hasher domain.PasswordHasher,
+	policy domain.PasswordPolicy,
+	defaultRole string,
+	verification *EmailVerificationIssuer,
+	eventBus shareddomain.EventBus,
+) *CreateUserHandler {
+	return &CreateUserHandler{
+		repo:         repo,
+		hasher:       hasher,
+		policy:       policy,
+		defaultRole:  defaultRole,
+		verification: verification,
+		eventBus:     eventBus,
+	}
+}
+
+// Handle handles the CreateUserCommand
+// Users whose email is not marked as verified get a verification email, like registered users
+func (h *CreateUserHandler) Handle(ctx context.Context, cmd *commands.CreateUserCommand) (*commands.UserResult, error) {
+	email, err := domain.NewEmail(cmd.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.policy.Validate(cmd.Password); err != nil {
+		return nil, err
+	}
+
+	// Check if email is unique
+	exists, err := h.repo.ExistsByEmail(ctx, email.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check email uniqueness: %w", err)
+	}
+
+	if exists {
+		return nil, shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeAlreadyExists,
+			"user with this email already exists",
+			"email",
+		)
+	}
+
+	passwordHash, err := h.hasher.Hash(cmd.Password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	user, err := domain.RegisterUser(email.Value, cmd.Name, passwordHash, h.defaultRole)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.EmailVerified {
+		user.VerifyEmail()
+	}
+	if cmd.RequirePasswordChange {
+		user.RequirePasswordChange()
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, user); err != nil {
+		return nil, err
+	}
+
+	if !user.IsEmailVerified() {
+		// The account exists at this point; the user can ask for another email if this one fails
+		if err := h.verification.Issue(ctx, user); err != nil {
+			fmt.Printf("Warning: failed to send verification email to user %s: %v\n", user.GetID(), err)
+		}
+	}
+
+	return toUserResult(user), nil
+}
+
+// DeactivateUserHandler handles DeactivateUserCommand
+type DeactivateUserHandler struct {
+	repo          domain.UserRepository
+	roles         domain.RoleRepository
+	refreshTokens domain.RefreshTokenRepository
+	sessions      domain.SessionStore
+	events        domain.SecurityEventRepository
+	eventBus      shareddomain.EventBus
+}
+
+// NewDeactivateUserHandler creates a new DeactivateUserHandler
+// sessions may be nil when cookie sessions are disabled
+func NewDeactivateUserHandler(
+	repo domain.UserRepository,
+	roles domain.RoleRepository,
+	refreshTokens domain.RefreshTokenRepository,
+	sessions domain.SessionStore,
+	events domain.SecurityEventRepository,
+	eventBus shareddomain.EventBus,
+) *DeactivateUserHandler {
+	return &DeactivateUserHandler{
+		repo:          repo,
+		roles:         roles,
+		refreshTokens: refreshTokens,
+		sessions:      sessions,
+		events:        events,
+		eventBus:      eventBus,
+	}
+}
+
+// Handle handles the DeactivateUserCommand
+// The last active admin cannot be deactivated, so the system always stays manageable
+func (h *DeactivateUserHandler) Handle(ctx context.Context, cmd *commands.DeactivateUserCommand) (*commands.UserResult, error) {
+	user, err := getUser(ctx, h.repo, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsActive() {
+		return toUserResult(user), nil
+	}
+
+	if user.HasRole(domain.RoleAdmin) {
+		admins, err := h.roles.CountActiveUsersWithRole(ctx, domain.RoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if admins <= 1 {
+			return nil, shareddomain.NewBusinessRuleError("last_admin", "the last active admin cannot be deactivated")
+		}
+	}
+
+	user.Deactivate()
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, user); err != nil {
+		return nil, err
+	}
+	recordUserSecurityEvent(ctx, h.events, domain.SecurityEventAccountDeactivated, user, "")
+
+	if err := revokeUserSessions(ctx, h.refreshTokens, h.sessions, user.GetID(), domain.RevokeReasonDeactivated); err != nil {
+		return nil, err
+	}
+
+	return toUserResult(user), nil
+}
+
+// ActivateUserHandler handles ActivateUserCommand
+type ActivateUserHandler struct {
+	repo     domain.UserRepository
+	events   domain.SecurityEventRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewActivateUserHandler creates a new ActivateUserHandler
+func NewActivateUserHandler(repo domain.UserRepository, events domain.SecurityEventRepository, eventBus shareddomain.EventBus) *ActivateUserHandler {
+	return &ActivateUserHandler{
+		repo:     repo,
+		events:   events,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the ActivateUserCommand
+// Activating an active account succeeds without changes
+func (h *ActivateUserHandler) Handle(ctx context.Context, cmd *commands.ActivateUserCommand) (*commands.UserResult, error) {
+	user, err := getUser(ctx, h.repo, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsActive() {
+		return toUserResult(user), nil
+	}
+
+	user.Activate()
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, user); err != nil {
+		return nil, err
+	}
+	recordUserSecurityEvent(ctx, h.events, domain.SecurityEventAccountActivated, user, "")
+
+	return toUserResult(user), nil
+}
+
+// RequirePasswordChangeHandler handles RequirePasswordChangeCommand
+type RequirePasswordChangeHandler struct {
+	repo          domain.UserRepository
+	refreshTokens domain.RefreshTokenRepository
+	sessions      domain.SessionStore
+	events        domain.SecurityEventRepository
+	eventBus      shareddomain.EventBus
+}
+
+// NewRequirePasswordChangeHandler creates a new RequirePasswordChangeHandler
+// sessions may be nil when cookie sessions are disabled
+func NewRequirePasswordChangeHandler(
+	repo domain.UserRepository,
+	refreshTokens domain.RefreshTokenRepository,
+	sessions domain.SessionStore,
+	events domain.SecurityEventRepository,
+	eventBus shareddomain.EventBus,
+) *RequirePasswordChangeHandler {
+	return &RequirePasswordChangeHandler{
+		repo:          repo,
+		refreshTokens: refreshTokens,
+		sessions:      sessions,
+		events:        events,
+		eventBus:      eventBus,
+	}
+}
+
+// Handle handles the RequirePasswordChangeCommand
+// The user's sessions end even when a change was already required
+func (h *RequirePasswordChangeHandler) Handle(ctx context.Context, cmd *commands.RequirePasswordChangeCommand) (*commands.UserResult, error) {
+	user, err := getUser(ctx, h.repo, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.RequirePasswordChange()
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, user); err != nil {
+		return nil, err
+	}
+	recordUserSecurityEvent(ctx, h.events, domain.SecurityEventPasswordChangeRequired, user, "")
+
+	if err := revokeUserSessions(ctx, h.refreshTokens, h.sessions, user.GetID(), domain.RevokeReasonPasswordChange); err != nil {
+		return nil, err
+	}
+
+	return toUserResult(user), nil
+}
+
+// ChangePasswordHandler handles ChangePasswordCommand
+type ChangePasswordHandler struct {
+	credentials   *CredentialChecker
+	policy        domain.PasswordPolicy
+	refreshTokens domain.RefreshTokenRepository
+	sessions      domain.SessionStore
+}
+
+// NewChangePasswordHandler creates a new ChangePasswordHandler
+// sessions may be nil when cookie sessions are disabled
+func NewChangePasswordHandler(
+	credentials *CredentialChecker,
+	policy domain.PasswordPolicy,
+	refreshTokens domain.RefreshTokenRepository,
+	sessions domain.SessionStore,
+) *ChangePasswordHandler {
+	return &ChangePasswordHandler{
+		credentials:   credentials,
+		policy:        policy,
+		refreshTokens: refreshTokens,
+		sessions:      sessions,
+	}
+}
+
+// Handle handles the ChangePasswordCommand
+// The current password is checked like a login, including the lockout policy; the new password
+// must differ from it. Every session of the user ends, so other devices have to log in again
+func (h *ChangePasswordHandler) Handle(ctx context.Context, cmd *commands.ChangePasswordCommand) (*commands.UserResult, error) {
+	if err := h.policy.Validate(cmd.NewPassword); err != nil {
+		return nil, err
+	}
+
+	user, err := h.credentials.authenticate(ctx, cmd.Email, cmd.CurrentPassword, cmd.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	unchanged, err := h.credentials.hasher.Verify(user.PasswordHash, cmd.NewPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify password: %w", err)
+	}
+	if unchanged {
+		return nil, shareddomain.NewValidationError("new_password", "new password must differ from the current password")
+	}
+
+	passwordHash, err := h.credentials.hasher.Hash(cmd.NewPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+	if err := user.ChangePassword(passwordHash); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.credentials.repo, h.credentials.eventBus, user); err != nil {
+		return nil, err
+	}
+	recordUserSecurityEvent(ctx, h.credentials.events, domain.SecurityEventPasswordChanged, user, "")
+
+	if err := revokeUserSessions(ctx, h.refreshTokens, h.sessions, user.GetID(), domain.RevokeReasonPasswordChange); err != nil {
+		return nil, err
+	}
+
+	return toUserResult(user), nil
+}
+
+// ImpersonateUserHandler handles ImpersonateUserCommand
+type ImpersonateUserHandler struct {
+	repo   domain.UserRepository
+	roles  domain.RoleRepository
+	tokens domain.TokenIssuer
+	events domain.SecurityEventRepository
+	ttl    time.Duration
+}
+
+// NewImpersonateUserHandler creates a new ImpersonateUserHandler
+// ttl is the lifetime of impersonation tokens
+func NewImpersonateUserHandler(
+	repo domain.UserRepository,
+	roles domain.RoleRepository,
+	tokens domain.TokenIssuer,
+	events domain.SecurityEventRepository,
+	ttl time.Duration,
+) *ImpersonateUserHandler {
+	return &ImpersonateUserHandler{
+		repo:   repo,
+		roles:  roles,
+		tokens: tokens,
+		events: events,
+		ttl:    ttl,
+	}
+}
+
+// Handle handles the ImpersonateUserCommand
+// Users who may impersonate others cannot be impersonated, so impersonation never gains permissions;
+// impersonations cannot be chained. The impersonation is recorded with its reason in the audit log
+func (h *ImpersonateUserHandler) Handle(ctx context.Context, cmd *commands.ImpersonateUserCommand) (*commands.ImpersonationResult, error) {
+	if actor, ok := shareddomain.ActorFromContext(ctx); ok && actor.IsImpersonated() {
+		return nil, shareddomain.NewDomainError(shareddomain.ErrCodeForbidden, "not allowed while impersonating a user")
+	}
+
+	user, err := getUser(ctx, h.repo, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	reason, err := domain.ValidateImpersonation(cmd.ImpersonatorID, user, cmd.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	privileged, err := domain.UserHasPermission(ctx, h.roles, user, domain.PermissionUsersImpersonate)
+	if err != nil {
+		return nil, err
+	}
+	if privileged {
+		return nil, shareddomain.NewBusinessRuleError("privileged_user", "users who may impersonate others cannot be impersonated")
+	}
+
+	token, err := h.tokens.IssueImpersonationToken(ctx, user, cmd.ImpersonatorID, h.ttl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue impersonation token: %w", err)
+	}
+	recordUserSecurityEvent(ctx, h.events, domain.SecurityEventImpersonationStarted, user, reason)
+
+	return &commands.ImpersonationResult{
+		AccessToken:    token.Token,
+		TokenType:      "Bearer",
+		ExpiresIn:      int64(time.Until(token.ExpiresAt).Seconds()),
+		ExpiresAt:      token.ExpiresAt,
+		ImpersonatorID: cmd.ImpersonatorID,
+		User:           *toUserResult(user),
+	}, nil
+}
@@ -0,0 +1,190 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CredentialChecker verifies email and password logins and the second step of two-factor logins
+// Shared by token and cookie session logins; it also enforces the lockout policy, locking
+// accounts after repeated failures and blocking client IPs that fail too often, and records
+// logins and failed password checks as security events
+type CredentialChecker struct {
+	repo     domain.UserRepository
+	hasher   domain.PasswordHasher
+	lockout  domain.LockoutPolicy
+	throttle domain.LoginThrottle
+	events   domain.SecurityEventRepository
+	eventBus shareddomain.EventBus
+
+	// Second login step of users with two-factor authentication
+	totp         domain.TOTP
+	challenges   domain.TwoFactorChallengeRepository
+	challengeTTL time.Duration
+
+	// dummyHash is verified when the email is unknown, so response times do not reveal registered emails
+	dummyHash string
+}
+
+// NewCredentialChecker creates a new CredentialChecker
+func NewCredentialChecker(
+	repo domain.UserRepository,
+	hasher domain.PasswordHasher,
+	lockout domain.LockoutPolicy,
+	throttle domain.LoginThrottle,
+	totp domain.TOTP,
+	challenges domain.TwoFactorChallengeRepository,
+	challengeTTL time.Duration,
+	events domain.SecurityEventRepository,
+	eventBus shareddomain.EventBus,
+) (*CredentialChecker, error) {
+	dummyHash, err := hasher.Hash("login-timing-equalizer")
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare credential checker: %w", err)
+	}
+
+	return &CredentialChecker{
+		repo:         repo,
+		hasher:       hasher,
+		lockout:      lockout,
+		throttle:     throttle,
+		events:       events,
+		eventBus:     eventBus,
+		totp:         totp,
+		challenges:   challenges,
+		challengeTTL: challengeTTL,
+		dummyHash:    dummyHash,
+	}, nil
+}
+
+// check returns the active user with the email and password for a login
+// Users who must change their password are refused until they have done so (ChangePasswordCommand).
+// Logins of users with two-factor authentication are recorded once the second step succeeds
+func (c *CredentialChecker) check(ctx context.Context, email, password, ip string) (*domain.User, error) {
+	user, err := c.authenticate(ctx, email, password, ip)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.PasswordChangeRequired {
+		return nil, c.fail(ctx, user, email, "password_change_required", passwordChangeRequired())
+	}
+
+	if !user.IsTwoFactorEnabled() {
+		recordUserSecurityEvent(ctx, c.events, domain.SecurityEventLoginSucceeded, user, "password")
+	}
+
+	return user, nil
+}
+
+// authenticate returns the active user with the email and password
+// Unknown emails and wrong passwords produce the same error. Locked accounts and blocked
+// client IPs are refused before the password is verified. Failed login counts of users with
+// two-factor authentication are only cleared once the second step succeeds
+func (c *CredentialChecker) authenticate(ctx context.Context, email, password, ip string) (*domain.User, error) {
+	now := time.Now().UTC()
+	if retryAt, blocked := c.throttle.BlockedUntil(ip, now); blocked {
+		return nil, c.fail(ctx, nil, email, "too_many_attempts", domain.TooManyAttemptsError{RetryAt: retryAt})
+	}
+
+	user, err := c.findUser(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	if user != nil && user.IsLocked(now) {
+		return nil, c.fail(ctx, user, email, "account_locked", domain.AccountLockedError{LockedUntil: *user.LockedUntil})
+	}
+
+	passwordHash := c.dummyHash
+	if user != nil {
+		passwordHash = user.PasswordHash
+	}
+
+	valid, err := c.hasher.Verify(passwordHash, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify password: %w", err)
+	}
+	if user == nil || !valid {
+		return nil, c.recordFailure(ctx, user, email, ip, now, "invalid_credentials", invalidCredentials())
+	}
+
+	if !user.IsActive() {
+		return nil, c.fail(ctx, user, email, "account_disabled", disabledAccount())
+	}
+
+	if !user.IsTwoFactorEnabled() && user.RecordSuccessfulLogin() {
+		if err := saveAndPublish(ctx, c.repo, c.eventBus, user); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+// recordFailure counts a failed login against the client IP and, for known emails, the account
+// It returns the error for the failed login: failure, unless the account got locked
+func (c *CredentialChecker) recordFailure(ctx context.Context, user *domain.User, email, ip string, now time.Time, detail string, failure error) error {
+	c.throttle.RecordFailure(ip, now)
+	c.recordLoginFailure(ctx, user, email, detail)
+
+	if user == nil {
+		return failure
+	}
+
+	locked := user.RecordFailedLogin(c.lockout, now, ip)
+	if err := saveAndPublish(ctx, c.repo, c.eventBus, user); err != nil {
+		return err
+	}
+
+	if locked {
+		recordUserSecurityEvent(ctx, c.events, domain.SecurityEventAccountLocked, user, fmt.Sprintf("locked until %s", user.LockedUntil.Format(time.RFC3339)))
+		return domain.AccountLockedError{LockedUntil: *user.LockedUntil}
+	}
+	return failure
+}
+
+// fail records a refused login that does not count as a failed attempt and returns failure
+func (c *CredentialChecker) fail(ctx context.Context, user *domain.User, email, detail string, failure error) error {
+	c.recordLoginFailure(ctx, user, email, detail)
+	return failure
+}
+
+// recordLoginFailure records a failed login of a user, or of an email no user has when user is nil
+func (c *CredentialChecker) recordLoginFailure(ctx context.Context, user *domain.User, email, detail string) {
+	if user != nil {
+		recordUserSecurityEvent(ctx, c.events, domain.SecurityEventLoginFailed, user, detail)
+		return
+	}
+	recordSecurityEvent(ctx, c.events, domain.SecurityEventLoginFailed, "", email, detail)
+}
+
+// findUser returns the user with the email, or nil when there is none
+func (c *CredentialChecker) findUser(ctx context.Context, email string) (*domain.User, error) {
+	normalized, err := domain.NewEmail(email)
+	if err != nil {
+		return nil, nil
+	}
+
+	user, err := c.repo.GetByEmail(ctx, normalized.Value)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+// invalidCredentials returns the error for an unknown email or wrong password
+func invalidCredentials() error {
+	return shareddomain.NewDomainError(
+		shareddomain.ErrCodeUnauthorized,
+		"invalid email or password",
+	)
+}
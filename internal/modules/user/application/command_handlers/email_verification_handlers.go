@@ -0,0 +1,139 @@
+package commandhandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	"golang_modular_monolith/internal/shared/application"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// EmailVerificationIssuer issues verification tokens and requests the verification email
+// The email itself is sent by whoever subscribes to user.email_verification_requested
+type EmailVerificationIssuer struct {
+	tokens   domain.EmailVerificationTokenRepository
+	eventBus shareddomain.EventBus
+	ttl      time.Duration
+}
+
+// NewEmailVerificationIssuer creates a new EmailVerificationIssuer
+func NewEmailVerificationIssuer(tokens domain.EmailVerificationTokenRepository, eventBus shareddomain.EventBus, ttl time.Duration) *EmailVerificationIssuer {
+	return &EmailVerificationIssuer{
+		tokens:   tokens,
+		eventBus: eventBus,
+		ttl:      ttl,
+	}
+}
+
+// Issue stores a new verification token for the user's email and publishes the event carrying it
+// Earlier tokens stay valid until they expire
+func (i *EmailVerificationIssuer) Issue(ctx context.Context, user *domain.User) error {
+	token, plain, err := domain.NewEmailVerificationToken(user, i.ttl)
+	if err != nil {
+		return err
+	}
+	if err := i.tokens.Create(ctx, token); err != nil {
+		return err
+	}
+
+	if err := application.PublishEvents(ctx, i.eventBus, domain.NewUserEmailVerificationRequestedEvent(user, token, plain)); err != nil {
+		return fmt.Errorf("failed to request verification email: %w", err)
+	}
+
+	return nil
+}
+
+// VerifyEmailHandler handles VerifyEmailCommand
+type VerifyEmailHandler struct {
+	users    domain.UserRepository
+	tokens   domain.EmailVerificationTokenRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewVerifyEmailHandler creates a new VerifyEmailHandler
+func NewVerifyEmailHandler(users domain.UserRepository, tokens domain.EmailVerificationTokenRepository, eventBus shareddomain.EventBus) *VerifyEmailHandler {
+	return &VerifyEmailHandler{
+		users:    users,
+		tokens:   tokens,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the VerifyEmailCommand
+// Unknown, expired and used tokens, and tokens sent to a previous email, produce the same error
+func (h *VerifyEmailHandler) Handle(ctx context.Context, cmd *commands.VerifyEmailCommand) (*commands.UserResult, error) {
+	if cmd.Token == "" {
+		return nil, invalidVerificationToken()
+	}
+
+	token, err := h.tokens.GetByHash(ctx, domain.HashEmailVerificationToken(cmd.Token))
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, invalidVerificationToken()
+		}
+		return nil, err
+	}
+
+	user, err := h.users.GetByID(ctx, token.UserID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, invalidVerificationToken()
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !token.IsUsable(user, time.Now()) {
+		return nil, invalidVerificationToken()
+	}
+	if err := h.tokens.MarkUsed(ctx, token); err != nil {
+		if errors.Is(err, domain.ErrVerificationTokenUsed) {
+			return nil, invalidVerificationToken()
+		}
+		return nil, err
+	}
+
+	user.VerifyEmail()
+
+	if err := saveAndPublish(ctx, h.users, h.eventBus, user); err != nil {
+		return nil, err
+	}
+
+	return toUserResult(user), nil
+}
+
+// ResendVerificationEmailHandler handles ResendVerificationEmailCommand
+type ResendVerificationEmailHandler struct {
+	users        domain.UserRepository
+	verification *EmailVerificationIssuer
+}
+
+// NewResendVerificationEmailHandler creates a new ResendVerificationEmailHandler
+func NewResendVerificationEmailHandler(users domain.UserRepository, verification *EmailVerificationIssuer) *ResendVerificationEmailHandler {
+	return &ResendVerificationEmailHandler{
+		users:        users,
+		verification: verification,
+	}
+}
+
+// Handle handles the ResendVerificationEmailCommand
+func (h *ResendVerificationEmailHandler) Handle(ctx context.Context, cmd *commands.ResendVerificationEmailCommand) error {
+	user, err := getUser(ctx, h.users, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if user.IsEmailVerified() {
+		return shareddomain.NewBusinessRuleError("email_already_verified", "email address is already verified")
+	}
+
+	return h.verification.Issue(ctx, user)
+}
+
+// invalidVerificationToken returns the error for a verification token that cannot be used
+func invalidVerificationToken() error {
+	return shareddomain.NewValidationError("token", "invalid or expired verification token")
+}
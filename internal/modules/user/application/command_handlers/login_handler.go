@@ -0,0 +1,58 @@
+package commandhandlers
+
+import (
+	"context"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+)
+
+// LoginHandler handles LoginCommand
+type LoginHandler struct {
+	credentials   *CredentialChecker
+	tokens        domain.TokenIssuer
+	refreshTokens domain.RefreshTokenRepository
+	refreshTTL    time.Duration
+}
+
+// NewLoginHandler creates a new LoginHandler
+func NewLoginHandler(
+	credentials *CredentialChecker,
+	tokens domain.TokenIssuer,
+	refreshTokens domain.RefreshTokenRepository,
+	refreshTTL time.Duration,
+) *LoginHandler {
+	return &LoginHandler{
+		credentials:   credentials,
+		tokens:        tokens,
+		refreshTokens: refreshTokens,
+		refreshTTL:    refreshTTL,
+	}
+}
+
+// Handle handles the LoginCommand
+// Unknown emails and wrong passwords produce the same error; repeated failures lock the account
+// Each login starts a new refresh token family bound to the device. Users with two-factor
+// authentication get a challenge instead, completed by CompleteTwoFactorLoginCommand
+func (h *LoginHandler) Handle(ctx context.Context, cmd *commands.LoginCommand) (*commands.LoginResult, error) {
+	deviceID, err := domain.NewDeviceID(cmd.DeviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.credentials.check(ctx, cmd.Email, cmd.Password, cmd.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsTwoFactorEnabled() {
+		challenge, err := h.credentials.beginTwoFactor(ctx, user, deviceID)
+		if err != nil {
+			return nil, err
+		}
+		return &commands.LoginResult{TwoFactorChallenge: challenge}, nil
+	}
+
+	return issueLogin(ctx, h.tokens, h.refreshTokens, h.refreshTTL, user, deviceID)
+}
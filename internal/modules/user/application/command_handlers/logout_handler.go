@@ -0,0 +1,40 @@
+package commandhandlers
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// LogoutHandler handles LogoutCommand
+type LogoutHandler struct {
+	refreshTokens domain.RefreshTokenRepository
+}
+
+// NewLogoutHandler creates a new LogoutHandler
+func NewLogoutHandler(refreshTokens domain.RefreshTokenRepository) *LogoutHandler {
+	return &LogoutHandler{
+		refreshTokens: refreshTokens,
+	}
+}
+
+// Handle handles the LogoutCommand
+// The token's whole family is revoked, so every token rotated from the same login stops working
+// Unknown tokens are ignored so that logging out twice succeeds
+func (h *LogoutHandler) Handle(ctx context.Context, cmd *commands.LogoutCommand) error {
+	if cmd.RefreshToken == "" {
+		return shareddomain.NewValidationError("refresh_token", "refresh token is required")
+	}
+
+	token, err := h.refreshTokens.GetByHash(ctx, domain.HashRefreshToken(cmd.RefreshToken))
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	return h.refreshTokens.RevokeFamily(ctx, token.FamilyID, domain.RevokeReasonLogout)
+}
@@ -0,0 +1,120 @@
+package commandhandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// RefreshTokenHandler handles RefreshTokenCommand
+type RefreshTokenHandler struct {
+	users         domain.UserRepository
+	refreshTokens domain.RefreshTokenRepository
+	tokens        domain.TokenIssuer
+	events        domain.SecurityEventRepository
+	refreshTTL    time.Duration
+}
+
+// NewRefreshTokenHandler creates a new RefreshTokenHandler
+func NewRefreshTokenHandler(
+	users domain.UserRepository,
+	refreshTokens domain.RefreshTokenRepository,
+	tokens domain.TokenIssuer,
+	events domain.SecurityEventRepository,
+	refreshTTL time.Duration,
+) *RefreshTokenHandler {
+	return &RefreshTokenHandler{
+		users:         users,
+		refreshTokens: refreshTokens,
+		tokens:        tokens,
+		events:        events,
+		refreshTTL:    refreshTTL,
+	}
+}
+
+// Handle handles the RefreshTokenCommand
+// A token that was already rotated, or that is presented from another device, is treated as
+// stolen: its whole family is revoked, which also logs out whoever holds the latest token
+func (h *RefreshTokenHandler) Handle(ctx context.Context, cmd *commands.RefreshTokenCommand) (*commands.LoginResult, error) {
+	if cmd.RefreshToken == "" {
+		return nil, invalidRefreshToken()
+	}
+
+	current, err := h.refreshTokens.GetByHash(ctx, domain.HashRefreshToken(cmd.RefreshToken))
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, invalidRefreshToken()
+		}
+		return nil, err
+	}
+
+	if current.RevokedAt != nil {
+		return nil, invalidRefreshToken()
+	}
+	if current.RotatedAt != nil {
+		return nil, h.revokeFamily(ctx, current, domain.RevokeReasonReuseDetected)
+	}
+	if current.IsExpired(time.Now()) {
+		return nil, invalidRefreshToken()
+	}
+	if cmd.DeviceID != current.DeviceID {
+		return nil, h.revokeFamily(ctx, current, domain.RevokeReasonDeviceMismatch)
+	}
+
+	user, err := h.users.GetByID(ctx, current.UserID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, invalidRefreshToken()
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if !user.IsActive() {
+		return nil, disabledAccount()
+	}
+
+	next, plainRefresh, err := domain.NewRefreshToken(user.GetID(), current.FamilyID, current.DeviceID, h.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.refreshTokens.Rotate(ctx, current, next); err != nil {
+		if errors.Is(err, domain.ErrRefreshTokenUsed) {
+			// Another request rotated the token first
+			return nil, h.revokeFamily(ctx, current, domain.RevokeReasonReuseDetected)
+		}
+		return nil, err
+	}
+
+	result, err := toLoginResult(ctx, h.tokens, user, next, plainRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	recordUserSecurityEvent(ctx, h.events, domain.SecurityEventTokenRefreshed, user, "")
+	return result, nil
+}
+
+// revokeFamily revokes the token's family and returns the error to report to the client
+func (h *RefreshTokenHandler) revokeFamily(ctx context.Context, token *domain.RefreshToken, reason string) error {
+	fmt.Printf("Warning: revoking refresh token family %s of user %s: %s\n", token.FamilyID, token.UserID, reason)
+	recordSecurityEvent(ctx, h.events, domain.SecurityEventTokenReuseDetected, token.UserID, "", reason)
+
+	if err := h.refreshTokens.RevokeFamily(ctx, token.FamilyID, reason); err != nil {
+		return err
+	}
+
+	return invalidRefreshToken()
+}
+
+// invalidRefreshToken returns the error for a refresh token that cannot be used
+// Unknown, expired and revoked tokens produce the same error
+func invalidRefreshToken() error {
+	return shareddomain.NewDomainError(
+		shareddomain.ErrCodeUnauthorized,
+		"invalid or expired refresh token",
+	)
+}
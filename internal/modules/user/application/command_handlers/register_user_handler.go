@@ -0,0 +1,88 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// RegisterUserHandler handles RegisterUserCommand
+type RegisterUserHandler struct {
+	repo         domain.UserRepository
+	hasher       domain.PasswordHasher
+	policy       domain.PasswordPolicy
+	defaultRole  string
+	verification *EmailVerificationIssuer
+	eventBus     shareddomain.EventBus
+}
+
+// NewRegisterUserHandler creates a new RegisterUserHandler
+func NewRegisterUserHandler(
+	repo domain.UserRepository,
+	hasher domain.PasswordHasher,
+	policy domain.PasswordPolicy,
+	defaultRole string,
+	verification *EmailVerificationIssuer,
+	eventBus shareddomain.EventBus,
+) *RegisterUserHandler {
+	return &RegisterUserHandler{
+		repo:         repo,
+		hasher:       hasher,
+		policy:       policy,
+		defaultRole:  defaultRole,
+		verification: verification,
+		eventBus:     eventBus,
+	}
+}
+
+// Handle handles the RegisterUserCommand
+// New users get the default role and a verification email
+func (h *RegisterUserHandler) Handle(ctx context.Context, cmd *commands.RegisterUserCommand) (*commands.UserResult, error) {
+	email, err := domain.NewEmail(cmd.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.policy.Validate(cmd.Password); err != nil {
+		return nil, err
+	}
+
+	// Check if email is unique
+	exists, err := h.repo.ExistsByEmail(ctx, email.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check email uniqueness: %w", err)
+	}
+
+	if exists {
+		return nil, shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeAlreadyExists,
+			"user with this email already exists",
+			"email",
+		)
+	}
+
+	passwordHash, err := h.hasher.Hash(cmd.Password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// Create user
+	user, err := domain.RegisterUser(email.Value, cmd.Name, passwordHash, h.defaultRole)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, user); err != nil {
+		return nil, err
+	}
+
+	// The account exists at this point; the user can ask for another email if this one fails
+	if err := h.verification.Issue(ctx, user); err != nil {
+		fmt.Printf("Warning: failed to send verification email to user %s: %v\n", user.GetID(), err)
+	}
+
+	return toUserResult(user), nil
+}
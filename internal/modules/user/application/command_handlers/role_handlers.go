@@ -0,0 +1,260 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateRoleHandler handles CreateRoleCommand
+type CreateRoleHandler struct {
+	roles domain.RoleRepository
+}
+
+// NewCreateRoleHandler creates a new CreateRoleHandler
+func NewCreateRoleHandler(roles domain.RoleRepository) *CreateRoleHandler {
+	return &CreateRoleHandler{
+		roles: roles,
+	}
+}
+
+// Handle handles the CreateRoleCommand
+func (h *CreateRoleHandler) Handle(ctx context.Context, cmd *commands.CreateRoleCommand) (*commands.RoleResult, error) {
+	role, err := domain.NewRole(cmd.Name, cmd.Description, cmd.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if name is unique
+	if _, err := h.roles.GetByName(ctx, role.Name); err == nil {
+		return nil, shareddomain.NewDomainErrorWithField(
+			shareddomain.ErrCodeAlreadyExists,
+			fmt.Sprintf("role %s already exists", role.Name),
+			"name",
+		)
+	} else if !shareddomain.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to check role uniqueness: %w", err)
+	}
+
+	if err := h.roles.Save(ctx, role); err != nil {
+		return nil, err
+	}
+
+	return toRoleResult(role), nil
+}
+
+// UpdateRoleHandler handles UpdateRoleCommand
+type UpdateRoleHandler struct {
+	roles domain.RoleRepository
+}
+
+// NewUpdateRoleHandler creates a new UpdateRoleHandler
+func NewUpdateRoleHandler(roles domain.RoleRepository) *UpdateRoleHandler {
+	return &UpdateRoleHandler{
+		roles: roles,
+	}
+}
+
+// Handle handles the UpdateRoleCommand
+func (h *UpdateRoleHandler) Handle(ctx context.Context, cmd *commands.UpdateRoleCommand) (*commands.RoleResult, error) {
+	role, err := getRole(ctx, h.roles, cmd.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := role.Update(cmd.Description, cmd.Permissions); err != nil {
+		return nil, err
+	}
+
+	if err := h.roles.Save(ctx, role); err != nil {
+		return nil, err
+	}
+
+	return toRoleResult(role), nil
+}
+
+// DeleteRoleHandler handles DeleteRoleCommand
+type DeleteRoleHandler struct {
+	roles domain.RoleRepository
+}
+
+// NewDeleteRoleHandler creates a new DeleteRoleHandler
+func NewDeleteRoleHandler(roles domain.RoleRepository) *DeleteRoleHandler {
+	return &DeleteRoleHandler{
+		roles: roles,
+	}
+}
+
+// Handle handles the DeleteRoleCommand
+// The role is removed from every user that holds it
+func (h *DeleteRoleHandler) Handle(ctx context.Context, cmd *commands.DeleteRoleCommand) error {
+	role, err := getRole(ctx, h.roles, cmd.Name)
+	if err != nil {
+		return err
+	}
+
+	if role.IsBuiltIn() {
+		return shareddomain.NewBusinessRuleError(
+			"builtin_role",
+			fmt.Sprintf("built-in role %s cannot be deleted", role.Name),
+		)
+	}
+
+	return h.roles.Delete(ctx, role.Name)
+}
+
+// AssignRoleHandler handles AssignRoleCommand
+type AssignRoleHandler struct {
+	users    domain.UserRepository
+	roles    domain.RoleRepository
+	events   domain.SecurityEventRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewAssignRoleHandler creates a new AssignRoleHandler
+func NewAssignRoleHandler(
+	users domain.UserRepository,
+	roles domain.RoleRepository,
+	events domain.SecurityEventRepository,
+	eventBus shareddomain.EventBus,
+) *AssignRoleHandler {
+	return &AssignRoleHandler{
+		users:    users,
+		roles:    roles,
+		events:   events,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the AssignRoleCommand
+func (h *AssignRoleHandler) Handle(ctx context.Context, cmd *commands.AssignRoleCommand) (*commands.UserResult, error) {
+	roleName := strings.TrimSpace(strings.ToLower(cmd.Role))
+	if _, err := h.roles.GetByName(ctx, roleName); err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewValidationErrorWithValue("role", "unknown role", cmd.Role)
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	user, err := getUser(ctx, h.users, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.HasRole(roleName) {
+		return toUserResult(user), nil
+	}
+
+	user.AssignRole(roleName)
+
+	if err := saveAndPublish(ctx, h.users, h.eventBus, user); err != nil {
+		return nil, err
+	}
+	recordUserSecurityEvent(ctx, h.events, domain.SecurityEventRoleAssigned, user, roleName)
+
+	return toUserResult(user), nil
+}
+
+// UnassignRoleHandler handles UnassignRoleCommand
+type UnassignRoleHandler struct {
+	users    domain.UserRepository
+	roles    domain.RoleRepository
+	events   domain.SecurityEventRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewUnassignRoleHandler creates a new UnassignRoleHandler
+func NewUnassignRoleHandler(
+	users domain.UserRepository,
+	roles domain.RoleRepository,
+	events domain.SecurityEventRepository,
+	eventBus shareddomain.EventBus,
+) *UnassignRoleHandler {
+	return &UnassignRoleHandler{
+		users:    users,
+		roles:    roles,
+		events:   events,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the UnassignRoleCommand
+// The admin role cannot be taken from the last active admin, so the system always stays manageable
+func (h *UnassignRoleHandler) Handle(ctx context.Context, cmd *commands.UnassignRoleCommand) (*commands.UserResult, error) {
+	roleName := strings.TrimSpace(strings.ToLower(cmd.Role))
+
+	user, err := getUser(ctx, h.users, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasRole(roleName) {
+		return toUserResult(user), nil
+	}
+
+	if roleName == domain.RoleAdmin && user.IsActive() {
+		admins, err := h.roles.CountActiveUsersWithRole(ctx, domain.RoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if admins <= 1 {
+			return nil, shareddomain.NewBusinessRuleError("last_admin", "the admin role cannot be taken from the last active admin")
+		}
+	}
+
+	user.UnassignRole(roleName)
+
+	if err := saveAndPublish(ctx, h.users, h.eventBus, user); err != nil {
+		return nil, err
+	}
+	recordUserSecurityEvent(ctx, h.events, domain.SecurityEventRoleUnassigned, user, roleName)
+
+	return toUserResult(user), nil
+}
+
+// getRole retrieves a role, translating a missing role into a not found domain error
+func getRole(ctx context.Context, roles domain.RoleRepository, name string) (*domain.Role, error) {
+	role, err := roles.GetByName(ctx, name)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("role %s not found", name),
+			)
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	return role, nil
+}
+
+// getUser retrieves a user, translating a missing user into a not found domain error
+func getUser(ctx context.Context, users domain.UserRepository, id string) (*domain.User, error) {
+	user, err := users.GetByID(ctx, id)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("user with ID %s not found", id),
+			)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+// toRoleResult converts a role to a command result
+func toRoleResult(role *domain.Role) *commands.RoleResult {
+	return &commands.RoleResult{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
@@ -0,0 +1,33 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// recordUserSecurityEvent stores a security event about a user
+func recordUserSecurityEvent(ctx context.Context, events domain.SecurityEventRepository, eventType string, user *domain.User, detail string) {
+	recordSecurityEvent(ctx, events, eventType, user.GetID(), user.Email.Value, detail)
+}
+
+// recordSecurityEvent stores a security event; userID is empty for emails no user has
+// The client IP, user agent, request ID and actor are taken from ctx; without an actor, as on logins, the user
+// is their own actor. Failures are logged rather than returned, so auditing never fails a request
+func recordSecurityEvent(ctx context.Context, events domain.SecurityEventRepository, eventType, userID, email, detail string) {
+	actor := ""
+	if current, ok := shareddomain.ActorFromContext(ctx); ok {
+		actor = current.String()
+	} else if userID != "" {
+		actor = shareddomain.Actor{Type: shareddomain.ActorTypeUser, ID: userID}.String()
+	}
+
+	client := shareddomain.ClientInfoFromContext(ctx)
+	event := domain.NewSecurityEvent(eventType, userID, email, actor, client.IPAddress, client.UserAgent, detail)
+	event.RequestID = shareddomain.RequestIDFromContext(ctx)
+	if err := events.Record(ctx, event); err != nil {
+		fmt.Printf("Warning: failed to record security event %s of user %q: %v\n", eventType, userID, err)
+	}
+}
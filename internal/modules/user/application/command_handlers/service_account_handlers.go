@@ -0,0 +1,247 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateServiceAccountHandler handles CreateServiceAccountCommand
+type CreateServiceAccountHandler struct {
+	accounts domain.ServiceAccountRepository
+	users    domain.UserRepository
+	roles    domain.RoleRepository
+}
+
+// NewCreateServiceAccountHandler creates a new CreateServiceAccountHandler
+func NewCreateServiceAccountHandler(
+	accounts domain.ServiceAccountRepository,
+	users domain.UserRepository,
+	roles domain.RoleRepository,
+) *CreateServiceAccountHandler {
+	return &CreateServiceAccountHandler{
+		accounts: accounts,
+		users:    users,
+		roles:    roles,
+	}
+}
+
+// Handle handles the CreateServiceAccountCommand
+// Like API keys, accounts cannot be granted more than their creator holds
+func (h *CreateServiceAccountHandler) Handle(ctx context.Context, cmd *commands.CreateServiceAccountCommand) (*commands.ServiceAccountSecretResult, error) {
+	account, secret, err := domain.NewServiceAccount(cmd.Name, cmd.Description, cmd.Scopes, cmd.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkGrantable(ctx, h.users, h.roles, cmd.CreatedBy, account.Scopes); err != nil {
+		return nil, err
+	}
+
+	if err := h.accounts.Save(ctx, account); err != nil {
+		return nil, err
+	}
+
+	return toServiceAccountSecretResult(account, secret), nil
+}
+
+// RotateServiceAccountSecretHandler handles RotateServiceAccountSecretCommand
+type RotateServiceAccountSecretHandler struct {
+	accounts domain.ServiceAccountRepository
+}
+
+// NewRotateServiceAccountSecretHandler creates a new RotateServiceAccountSecretHandler
+func NewRotateServiceAccountSecretHandler(accounts domain.ServiceAccountRepository) *RotateServiceAccountSecretHandler {
+	return &RotateServiceAccountSecretHandler{
+		accounts: accounts,
+	}
+}
+
+// Handle handles the RotateServiceAccountSecretCommand
+// The old secret stops working at once; tokens already issued stay valid until they expire
+func (h *RotateServiceAccountSecretHandler) Handle(ctx context.Context, cmd *commands.RotateServiceAccountSecretCommand) (*commands.ServiceAccountSecretResult, error) {
+	account, err := getServiceAccount(ctx, h.accounts, cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !account.IsActive() {
+		return nil, shareddomain.NewBusinessRuleError("service_account_disabled", "the secret of a disabled service account cannot be rotated")
+	}
+
+	secret, err := account.RotateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := h.accounts.Save(ctx, account); err != nil {
+		return nil, err
+	}
+
+	return toServiceAccountSecretResult(account, secret), nil
+}
+
+// DisableServiceAccountHandler handles DisableServiceAccountCommand
+type DisableServiceAccountHandler struct {
+	accounts domain.ServiceAccountRepository
+}
+
+// NewDisableServiceAccountHandler creates a new DisableServiceAccountHandler
+func NewDisableServiceAccountHandler(accounts domain.ServiceAccountRepository) *DisableServiceAccountHandler {
+	return &DisableServiceAccountHandler{
+		accounts: accounts,
+	}
+}
+
+// Handle handles the DisableServiceAccountCommand
+// Disabling a disabled account succeeds and keeps the original time
+func (h *DisableServiceAccountHandler) Handle(ctx context.Context, cmd *commands.DisableServiceAccountCommand) (*commands.ServiceAccountResult, error) {
+	account, err := getServiceAccount(ctx, h.accounts, cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.IsActive() {
+		account.Disable()
+		if err := h.accounts.Save(ctx, account); err != nil {
+			return nil, err
+		}
+	}
+
+	return toServiceAccountResult(account), nil
+}
+
+// IssueServiceAccountTokenHandler handles IssueServiceAccountTokenCommand
+type IssueServiceAccountTokenHandler struct {
+	accounts domain.ServiceAccountRepository
+	tokens   domain.TokenIssuer
+	throttle domain.LoginThrottle
+	events   domain.SecurityEventRepository
+	tokenTTL time.Duration
+}
+
+// NewIssueServiceAccountTokenHandler creates a new IssueServiceAccountTokenHandler
+// Failed exchanges count against the client IP in the same throttle as failed logins
+func NewIssueServiceAccountTokenHandler(
+	accounts domain.ServiceAccountRepository,
+	tokens domain.TokenIssuer,
+	throttle domain.LoginThrottle,
+	events domain.SecurityEventRepository,
+	tokenTTL time.Duration,
+) *IssueServiceAccountTokenHandler {
+	return &IssueServiceAccountTokenHandler{
+		accounts: accounts,
+		tokens:   tokens,
+		throttle: throttle,
+		events:   events,
+		tokenTTL: tokenTTL,
+	}
+}
+
+// Handle handles the IssueServiceAccountTokenCommand
+// Unknown clients, wrong secrets and disabled accounts produce the same error
+func (h *IssueServiceAccountTokenHandler) Handle(ctx context.Context, cmd *commands.IssueServiceAccountTokenCommand) (*commands.ServiceAccountTokenResult, error) {
+	now := time.Now().UTC()
+	if retryAt, blocked := h.throttle.BlockedUntil(cmd.IPAddress, now); blocked {
+		recordSecurityEvent(ctx, h.events, domain.SecurityEventServiceAuthFailed, "", "", "too_many_attempts")
+		return nil, domain.TooManyAttemptsError{RetryAt: retryAt}
+	}
+
+	account, err := h.accounts.GetByID(ctx, cmd.ClientID)
+	if err != nil && !shareddomain.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+
+	if account == nil || !strings.HasPrefix(cmd.ClientSecret, domain.ServiceAccountSecretPrefix) || !account.VerifySecret(cmd.ClientSecret) {
+		h.throttle.RecordFailure(cmd.IPAddress, now)
+		h.recordEvent(ctx, account, domain.SecurityEventServiceAuthFailed, "invalid_client")
+		return nil, invalidClient()
+	}
+	if !account.IsActive() {
+		h.recordEvent(ctx, account, domain.SecurityEventServiceAuthFailed, "account_disabled")
+		return nil, invalidClient()
+	}
+
+	scopes, err := account.TokenScopes(cmd.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := h.tokens.IssueServiceAccountToken(ctx, account, scopes, h.tokenTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue access token: %w", err)
+	}
+
+	// Failing to record the last use must not fail the exchange
+	if err := h.accounts.TouchLastUsed(ctx, account.ID, now); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+	scope := strings.Join(scopes, " ")
+	h.recordEvent(ctx, account, domain.SecurityEventServiceTokenIssued, scope)
+
+	return &commands.ServiceAccountTokenResult{
+		AccessToken: token.Token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(time.Until(token.ExpiresAt).Seconds()),
+		ExpiresAt:   token.ExpiresAt,
+		Scope:       scope,
+	}, nil
+}
+
+// recordEvent records a security event with the account, when known, as its actor
+func (h *IssueServiceAccountTokenHandler) recordEvent(ctx context.Context, account *domain.ServiceAccount, eventType, detail string) {
+	if account != nil {
+		ctx = shareddomain.WithActor(ctx, shareddomain.Actor{Type: shareddomain.ActorTypeServiceAccount, ID: account.ID})
+	}
+	recordSecurityEvent(ctx, h.events, eventType, "", "", detail)
+}
+
+// getServiceAccount retrieves a service account, translating a missing account into a not found domain error
+func getServiceAccount(ctx context.Context, accounts domain.ServiceAccountRepository, id string) (*domain.ServiceAccount, error) {
+	account, err := accounts.GetByID(ctx, id)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("service account with ID %s not found", id),
+			)
+		}
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+	return account, nil
+}
+
+// invalidClient returns the error for a client credentials exchange that cannot be accepted
+func invalidClient() error {
+	return shareddomain.NewDomainError(
+		shareddomain.ErrCodeUnauthorized,
+		"invalid client credentials",
+	)
+}
+
+// toServiceAccountResult converts a service account to a command result
+func toServiceAccountResult(account *domain.ServiceAccount) *commands.ServiceAccountResult {
+	return &commands.ServiceAccountResult{
+		ID:          account.ID,
+		Name:        account.Name,
+		Description: account.Description,
+		Scopes:      account.Scopes,
+		CreatedBy:   account.CreatedBy,
+		LastUsedAt:  account.LastUsedAt,
+		DisabledAt:  account.DisabledAt,
+		CreatedAt:   account.CreatedAt,
+		UpdatedAt:   account.UpdatedAt,
+	}
+}
+
+// toServiceAccountSecretResult converts a service account and its plain secret to a command result
+func toServiceAccountSecretResult(account *domain.ServiceAccount, secret string) *commands.ServiceAccountSecretResult {
+	return &commands.ServiceAccountSecretResult{
+		ServiceAccountResult: *toServiceAccountResult(account),
+		ClientID:             account.ID,
+		ClientSecret:         secret,
+	}
+}
@@ -0,0 +1,106 @@
+package commandhandlers
+
+import (
+	"context"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// CreateSessionHandler handles CreateSessionCommand
+type CreateSessionHandler struct {
+	credentials *CredentialChecker
+	sessions    domain.SessionStore
+	idleTimeout time.Duration
+	maxLifetime time.Duration
+}
+
+// NewCreateSessionHandler creates a new CreateSessionHandler
+func NewCreateSessionHandler(
+	credentials *CredentialChecker,
+	sessions domain.SessionStore,
+	idleTimeout time.Duration,
+	maxLifetime time.Duration,
+) *CreateSessionHandler {
+	return &CreateSessionHandler{
+		credentials: credentials,
+		sessions:    sessions,
+		idleTimeout: idleTimeout,
+		maxLifetime: maxLifetime,
+	}
+}
+
+// Handle handles the CreateSessionCommand
+// Unknown emails and wrong passwords produce the same error; repeated failures lock the account
+// Users with two-factor authentication get a challenge instead, completed by CompleteTwoFactorLoginCommand
+func (h *CreateSessionHandler) Handle(ctx context.Context, cmd *commands.CreateSessionCommand) (*commands.SessionResult, error) {
+	user, err := h.credentials.check(ctx, cmd.Email, cmd.Password, cmd.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsTwoFactorEnabled() {
+		challenge, err := h.credentials.beginTwoFactor(ctx, user, "")
+		if err != nil {
+			return nil, err
+		}
+		return &commands.SessionResult{TwoFactorChallenge: challenge}, nil
+	}
+
+	return startSession(ctx, h.sessions, h.idleTimeout, h.maxLifetime, user)
+}
+
+// EndSessionHandler handles EndSessionCommand
+type EndSessionHandler struct {
+	sessions domain.SessionStore
+}
+
+// NewEndSessionHandler creates a new EndSessionHandler
+func NewEndSessionHandler(sessions domain.SessionStore) *EndSessionHandler {
+	return &EndSessionHandler{
+		sessions: sessions,
+	}
+}
+
+// Handle handles the EndSessionCommand
+// Unknown and expired sessions are ignored so that logging out twice succeeds
+func (h *EndSessionHandler) Handle(ctx context.Context, cmd *commands.EndSessionCommand) error {
+	if cmd.SessionID == "" {
+		return shareddomain.NewValidationError("session_id", "session ID is required")
+	}
+
+	session, err := h.sessions.Get(ctx, domain.HashSessionID(cmd.SessionID))
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	if cmd.AllSessions {
+		return h.sessions.DeleteByUser(ctx, session.UserID)
+	}
+	return h.sessions.Delete(ctx, session)
+}
+
+// startSession stores a new cookie session for the user
+func startSession(ctx context.Context, sessions domain.SessionStore, idleTimeout, maxLifetime time.Duration, user *domain.User) (*commands.SessionResult, error) {
+	session, sessionID, err := domain.NewSession(user, maxLifetime)
+	if err != nil {
+		return nil, err
+	}
+	// The session is only valid in the tenant the user logged in to
+	session.TenantID, _ = shareddomain.TenantIDFromContext(ctx)
+	if err := sessions.Create(ctx, session, idleTimeout); err != nil {
+		return nil, err
+	}
+
+	return &commands.SessionResult{
+		SessionID:    sessionID,
+		ExpiresAt:    session.IdleExpiry(idleTimeout),
+		MaxExpiresAt: session.ExpiresAt,
+		User:         *toUserResult(user),
+	}, nil
+}
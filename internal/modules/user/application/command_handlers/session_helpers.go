@@ -0,0 +1,66 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// issueLogin starts a new refresh token family for the user's device and issues an access token
+func issueLogin(
+	ctx context.Context,
+	tokens domain.TokenIssuer,
+	refreshTokens domain.RefreshTokenRepository,
+	refreshTTL time.Duration,
+	user *domain.User,
+	deviceID string,
+) (*commands.LoginResult, error) {
+	refresh, plainRefresh, err := domain.NewRefreshToken(user.GetID(), "", deviceID, refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+	if err := refreshTokens.Create(ctx, refresh); err != nil {
+		return nil, err
+	}
+
+	return toLoginResult(ctx, tokens, user, refresh, plainRefresh)
+}
+
+// toLoginResult issues an access token for the user and combines it with the stored refresh token
+func toLoginResult(ctx context.Context, tokens domain.TokenIssuer, user *domain.User, refresh *domain.RefreshToken, plainRefresh string) (*commands.LoginResult, error) {
+	token, err := tokens.IssueAccessToken(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue access token: %w", err)
+	}
+
+	return &commands.LoginResult{
+		AccessToken:           token.Token,
+		TokenType:             "Bearer",
+		ExpiresIn:             int64(time.Until(token.ExpiresAt).Seconds()),
+		ExpiresAt:             token.ExpiresAt,
+		RefreshToken:          plainRefresh,
+		RefreshTokenExpiresAt: refresh.ExpiresAt,
+		DeviceID:              refresh.DeviceID,
+		User:                  *toUserResult(user),
+	}, nil
+}
+
+// disabledAccount returns the error for a user whose account is disabled
+func disabledAccount() error {
+	return shareddomain.NewDomainError(
+		shareddomain.ErrCodeForbidden,
+		"user account is disabled",
+	)
+}
+
+// passwordChangeRequired returns the error for a user who must change their password before logging in
+func passwordChangeRequired() error {
+	return shareddomain.NewDomainError(
+		domain.ErrCodePasswordChangeRequired,
+		"the password must be changed before logging in",
+	)
+}
@@ -0,0 +1,182 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// BeginTwoFactorEnrollmentHandler handles BeginTwoFactorEnrollmentCommand
+type BeginTwoFactorEnrollmentHandler struct {
+	repo domain.UserRepository
+	totp domain.TOTP
+}
+
+// NewBeginTwoFactorEnrollmentHandler creates a new BeginTwoFactorEnrollmentHandler
+func NewBeginTwoFactorEnrollmentHandler(repo domain.UserRepository, totp domain.TOTP) *BeginTwoFactorEnrollmentHandler {
+	return &BeginTwoFactorEnrollmentHandler{
+		repo: repo,
+		totp: totp,
+	}
+}
+
+// Handle handles the BeginTwoFactorEnrollmentCommand
+// The secret takes effect once EnableTwoFactorCommand confirms it
+func (h *BeginTwoFactorEnrollmentHandler) Handle(ctx context.Context, cmd *commands.BeginTwoFactorEnrollmentCommand) (*commands.TwoFactorEnrollmentResult, error) {
+	user, err := getUser(ctx, h.repo, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := h.totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := user.BeginTwoFactorEnrollment(secret); err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.Save(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to save user: %w", err)
+	}
+
+	return &commands.TwoFactorEnrollmentResult{
+		Secret:          secret,
+		ProvisioningURI: h.totp.ProvisioningURI(secret, user.Email.Value),
+	}, nil
+}
+
+// EnableTwoFactorHandler handles EnableTwoFactorCommand
+type EnableTwoFactorHandler struct {
+	repo     domain.UserRepository
+	totp     domain.TOTP
+	eventBus shareddomain.EventBus
+}
+
+// NewEnableTwoFactorHandler creates a new EnableTwoFactorHandler
+func NewEnableTwoFactorHandler(repo domain.UserRepository, totp domain.TOTP, eventBus shareddomain.EventBus) *EnableTwoFactorHandler {
+	return &EnableTwoFactorHandler{
+		repo:     repo,
+		totp:     totp,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the EnableTwoFactorCommand
+// Returns the recovery codes, which are not stored in plain text and cannot be shown again
+func (h *EnableTwoFactorHandler) Handle(ctx context.Context, cmd *commands.EnableTwoFactorCommand) (*commands.RecoveryCodesResult, error) {
+	user, err := getUser(ctx, h.repo, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := domain.NewRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := user.EnableTwoFactor(h.totp, cmd.Code, hashes, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, user); err != nil {
+		return nil, err
+	}
+
+	return &commands.RecoveryCodesResult{RecoveryCodes: codes}, nil
+}
+
+// DisableTwoFactorHandler handles DisableTwoFactorCommand
+type DisableTwoFactorHandler struct {
+	repo     domain.UserRepository
+	totp     domain.TOTP
+	policy   domain.TwoFactorPolicy
+	eventBus shareddomain.EventBus
+}
+
+// NewDisableTwoFactorHandler creates a new DisableTwoFactorHandler
+func NewDisableTwoFactorHandler(
+	repo domain.UserRepository,
+	totp domain.TOTP,
+	policy domain.TwoFactorPolicy,
+	eventBus shareddomain.EventBus,
+) *DisableTwoFactorHandler {
+	return &DisableTwoFactorHandler{
+		repo:     repo,
+		totp:     totp,
+		policy:   policy,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the DisableTwoFactorCommand
+// Users whose roles require two-factor authentication cannot disable it
+func (h *DisableTwoFactorHandler) Handle(ctx context.Context, cmd *commands.DisableTwoFactorCommand) error {
+	user, err := getUser(ctx, h.repo, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if !user.IsTwoFactorEnabled() {
+		return shareddomain.NewBusinessRuleError("two_factor_disabled", "two-factor authentication is not enabled")
+	}
+	if h.policy.Requires(user) {
+		return shareddomain.NewBusinessRuleError("two_factor_required", "two-factor authentication is required for your role")
+	}
+	if !user.VerifySecondFactor(h.totp, cmd.Code, time.Now()) {
+		return shareddomain.NewValidationError("code", "invalid two-factor code")
+	}
+
+	user.DisableTwoFactor()
+
+	return saveAndPublish(ctx, h.repo, h.eventBus, user)
+}
+
+// RegenerateRecoveryCodesHandler handles RegenerateRecoveryCodesCommand
+type RegenerateRecoveryCodesHandler struct {
+	repo     domain.UserRepository
+	totp     domain.TOTP
+	eventBus shareddomain.EventBus
+}
+
+// NewRegenerateRecoveryCodesHandler creates a new RegenerateRecoveryCodesHandler
+func NewRegenerateRecoveryCodesHandler(repo domain.UserRepository, totp domain.TOTP, eventBus shareddomain.EventBus) *RegenerateRecoveryCodesHandler {
+	return &RegenerateRecoveryCodesHandler{
+		repo:     repo,
+		totp:     totp,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the RegenerateRecoveryCodesCommand
+// The previous recovery codes stop working
+func (h *RegenerateRecoveryCodesHandler) Handle(ctx context.Context, cmd *commands.RegenerateRecoveryCodesCommand) (*commands.RecoveryCodesResult, error) {
+	user, err := getUser(ctx, h.repo, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsTwoFactorEnabled() {
+		return nil, shareddomain.NewBusinessRuleError("two_factor_disabled", "two-factor authentication is not enabled")
+	}
+	if !user.VerifySecondFactor(h.totp, cmd.Code, time.Now()) {
+		return nil, shareddomain.NewValidationError("code", "invalid two-factor code")
+	}
+
+	codes, hashes, err := domain.NewRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := user.ReplaceRecoveryCodes(hashes); err != nil {
+		return nil, err
+	}
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, user); err != nil {
+		return nil, err
+	}
+
+	return &commands.RecoveryCodesResult{RecoveryCodes: codes}, nil
+}
@@ -0,0 +1,176 @@
+package commandhandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// beginTwoFactor starts the second login step of a user whose password was verified
+// deviceID is remembered for the refresh token issued when the login completes
+func (c *CredentialChecker) beginTwoFactor(ctx context.Context, user *domain.User, deviceID string) (*commands.TwoFactorChallengeResult, error) {
+	challenge, token, err := domain.NewTwoFactorChallenge(user, deviceID, c.challengeTTL)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.challenges.Create(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	return &commands.TwoFactorChallengeResult{
+		ChallengeToken: token,
+		ExpiresAt:      challenge.ExpiresAt,
+	}, nil
+}
+
+// completeTwoFactor verifies the code for a login challenge and returns the user with the challenge
+// Wrong codes count as failed logins; a challenge stops working after MaxTwoFactorChallengeAttempts
+// wrong codes, so the password has to be entered again
+func (c *CredentialChecker) completeTwoFactor(ctx context.Context, cmd *commands.CompleteTwoFactorLoginCommand) (*domain.User, *domain.TwoFactorChallenge, error) {
+	now := time.Now().UTC()
+	if retryAt, blocked := c.throttle.BlockedUntil(cmd.IPAddress, now); blocked {
+		return nil, nil, c.fail(ctx, nil, "", "too_many_attempts", domain.TooManyAttemptsError{RetryAt: retryAt})
+	}
+
+	challenge, err := c.challenges.GetByHash(ctx, domain.HashTwoFactorChallengeToken(cmd.ChallengeToken))
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, nil, invalidChallenge()
+		}
+		return nil, nil, fmt.Errorf("failed to get two-factor challenge: %w", err)
+	}
+	if !challenge.IsUsable(now) {
+		return nil, nil, invalidChallenge()
+	}
+
+	user, err := c.repo.GetByID(ctx, challenge.UserID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, nil, invalidChallenge()
+		}
+		return nil, nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if !user.IsTwoFactorEnabled() {
+		return nil, nil, invalidChallenge()
+	}
+	if user.IsLocked(now) {
+		return nil, nil, c.fail(ctx, user, "", "account_locked", domain.AccountLockedError{LockedUntil: *user.LockedUntil})
+	}
+	if !user.IsActive() {
+		return nil, nil, c.fail(ctx, user, "", "account_disabled", disabledAccount())
+	}
+	if user.PasswordChangeRequired {
+		return nil, nil, c.fail(ctx, user, "", "password_change_required", passwordChangeRequired())
+	}
+
+	if !user.VerifySecondFactor(c.totp, cmd.Code, now) {
+		if err := c.challenges.RecordAttempt(ctx, challenge); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, c.recordFailure(ctx, user, "", cmd.IPAddress, now, "invalid_two_factor_code", invalidSecondFactor())
+	}
+
+	if err := c.challenges.MarkUsed(ctx, challenge); err != nil {
+		if errors.Is(err, domain.ErrTwoFactorChallengeUsed) {
+			return nil, nil, invalidChallenge()
+		}
+		return nil, nil, err
+	}
+
+	// Save the accepted time step or used recovery code along with the cleared failure counts
+	user.RecordSuccessfulLogin()
+	if err := saveAndPublish(ctx, c.repo, c.eventBus, user); err != nil {
+		return nil, nil, err
+	}
+	recordUserSecurityEvent(ctx, c.events, domain.SecurityEventLoginSucceeded, user, "two_factor")
+
+	return user, challenge, nil
+}
+
+// CompleteTwoFactorLoginHandler handles CompleteTwoFactorLoginCommand for token logins
+type CompleteTwoFactorLoginHandler struct {
+	credentials   *CredentialChecker
+	tokens        domain.TokenIssuer
+	refreshTokens domain.RefreshTokenRepository
+	refreshTTL    time.Duration
+}
+
+// NewCompleteTwoFactorLoginHandler creates a new CompleteTwoFactorLoginHandler
+func NewCompleteTwoFactorLoginHandler(
+	credentials *CredentialChecker,
+	tokens domain.TokenIssuer,
+	refreshTokens domain.RefreshTokenRepository,
+	refreshTTL time.Duration,
+) *CompleteTwoFactorLoginHandler {
+	return &CompleteTwoFactorLoginHandler{
+		credentials:   credentials,
+		tokens:        tokens,
+		refreshTokens: refreshTokens,
+		refreshTTL:    refreshTTL,
+	}
+}
+
+// Handle handles the CompleteTwoFactorLoginCommand
+// The refresh token is bound to the device given when the login started
+func (h *CompleteTwoFactorLoginHandler) Handle(ctx context.Context, cmd *commands.CompleteTwoFactorLoginCommand) (*commands.LoginResult, error) {
+	user, challenge, err := h.credentials.completeTwoFactor(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return issueLogin(ctx, h.tokens, h.refreshTokens, h.refreshTTL, user, challenge.DeviceID)
+}
+
+// CompleteTwoFactorSessionHandler handles CompleteTwoFactorLoginCommand for cookie session logins
+type CompleteTwoFactorSessionHandler struct {
+	credentials *CredentialChecker
+	sessions    domain.SessionStore
+	idleTimeout time.Duration
+	maxLifetime time.Duration
+}
+
+// NewCompleteTwoFactorSessionHandler creates a new CompleteTwoFactorSessionHandler
+func NewCompleteTwoFactorSessionHandler(
+	credentials *CredentialChecker,
+	sessions domain.SessionStore,
+	idleTimeout time.Duration,
+	maxLifetime time.Duration,
+) *CompleteTwoFactorSessionHandler {
+	return &CompleteTwoFactorSessionHandler{
+		credentials: credentials,
+		sessions:    sessions,
+		idleTimeout: idleTimeout,
+		maxLifetime: maxLifetime,
+	}
+}
+
+// Handle handles the CompleteTwoFactorLoginCommand
+func (h *CompleteTwoFactorSessionHandler) Handle(ctx context.Context, cmd *commands.CompleteTwoFactorLoginCommand) (*commands.SessionResult, error) {
+	user, _, err := h.credentials.completeTwoFactor(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return startSession(ctx, h.sessions, h.idleTimeout, h.maxLifetime, user)
+}
+
+// invalidChallenge returns the error for an unknown, expired or used login challenge
+func invalidChallenge() error {
+	return shareddomain.NewDomainError(
+		shareddomain.ErrCodeUnauthorized,
+		"invalid or expired two-factor challenge, log in again",
+	)
+}
+
+// invalidSecondFactor returns the error for a wrong TOTP or recovery code
+func invalidSecondFactor() error {
+	return shareddomain.NewDomainError(
+		shareddomain.ErrCodeUnauthorized,
+		"invalid two-factor code",
+	)
+}
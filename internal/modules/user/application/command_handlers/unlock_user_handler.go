@@ -0,0 +1,46 @@
+package commandhandlers
+
+import (
+	"context"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// UnlockUserHandler handles UnlockUserCommand
+type UnlockUserHandler struct {
+	repo     domain.UserRepository
+	eventBus shareddomain.EventBus
+}
+
+// NewUnlockUserHandler creates a new UnlockUserHandler
+func NewUnlockUserHandler(repo domain.UserRepository, eventBus shareddomain.EventBus) *UnlockUserHandler {
+	return &UnlockUserHandler{
+		repo:     repo,
+		eventBus: eventBus,
+	}
+}
+
+// Handle handles the UnlockUserCommand
+// Unlocking an account that is not locked succeeds without changes
+func (h *UnlockUserHandler) Handle(ctx context.Context, cmd *commands.UnlockUserCommand) (*commands.UserResult, error) {
+	user, err := getUser(ctx, h.repo, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if !user.IsLocked(now) && user.FailedLoginAttempts == 0 {
+		return toUserResult(user), nil
+	}
+
+	user.Unlock(now)
+
+	if err := saveAndPublish(ctx, h.repo, h.eventBus, user); err != nil {
+		return nil, err
+	}
+
+	return toUserResult(user), nil
+}
@@ -0,0 +1,73 @@
+package commandhandlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/domain"
+	"golang_modular_monolith/internal/shared/application"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// saveAndPublish persists a changed user and publishes its uncommitted events
+func saveAndPublish(ctx context.Context, repo domain.UserRepository, eventBus shareddomain.EventBus, user *domain.User) error {
+	// Capture events before the repository clears them on save
+	events := user.GetUncommittedEvents()
+
+	if err := repo.Save(ctx, user); err != nil {
+		return fmt.Errorf("failed to save user: %w", err)
+	}
+
+	for _, event := range events {
+		if err := application.PublishEvents(ctx, eventBus, event); err != nil {
+			// Log error but don't fail the operation
+			fmt.Printf("Warning: failed to publish event %T for user %s: %v\n", event, user.GetID(), err)
+		}
+	}
+
+	return nil
+}
+
+// revokeUserSessions ends every session of a user: refresh tokens are revoked and, when cookie
+// sessions are enabled (sessions is not nil), sessions are deleted
+// Access tokens already issued stay valid until they expire
+func revokeUserSessions(ctx context.Context, refreshTokens domain.RefreshTokenRepository, sessions domain.SessionStore, userID, reason string) error {
+	if err := refreshTokens.RevokeByUser(ctx, userID, reason); err != nil {
+		return err
+	}
+
+	if sessions != nil {
+		if err := sessions.DeleteByUser(ctx, userID); err != nil {
+			return fmt.Errorf("failed to end sessions: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// toUserResult converts a user to a command result
+// LockedUntil is only set while the account is locked
+func toUserResult(user *domain.User) *commands.UserResult {
+	var lockedUntil *time.Time
+	if user.IsLocked(time.Now()) {
+		lockedUntil = user.LockedUntil
+	}
+
+	return &commands.UserResult{
+		ID:                     user.GetID(),
+		Email:                  user.Email.Value,
+		Name:                   user.Name,
+		Status:                 string(user.Status),
+		Roles:                  user.Roles,
+		EmailVerified:          user.IsEmailVerified(),
+		EmailVerifiedAt:        user.EmailVerifiedAt,
+		LockedUntil:            lockedUntil,
+		TwoFactorEnabled:       user.IsTwoFactorEnabled(),
+		PasswordChangeRequired: user.PasswordChangeRequired,
+		Version:                user.GetVersion(),
+		CreatedAt:              user.GetCreatedAt(),
+		UpdatedAt:              user.GetUpdatedAt(),
+	}
+}
@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// CreateUserCommand represents a command by an administrator to create a user account
+// The account gets the default role; further roles are assigned with AssignRoleCommand
+// Password is the initial password, which the user must change at the first login unless
+// RequirePasswordChange is false
+type CreateUserCommand struct {
+	application.BaseCommand
+	Email                 string `json:"email" validate:"required,email,max=255"`
+	Name                  string `json:"name" validate:"max=255"`
+	Password              string `json:"-" validate:"required"`
+	EmailVerified         bool   `json:"email_verified"`
+	RequirePasswordChange bool   `json:"require_password_change"`
+}
+
+// NewCreateUserCommand creates a new create user command
+func NewCreateUserCommand(email, name, password string) CreateUserCommand {
+	return CreateUserCommand{
+		BaseCommand:           application.NewBaseCommand("create_user"),
+		Email:                 email,
+		Name:                  name,
+		Password:              password,
+		RequirePasswordChange: true,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c CreateUserCommand) RequiredPermission() string {
+	return domain.PermissionUsersManage
+}
+
+// DeactivateUserCommand represents a command to disable a user account and end its sessions
+type DeactivateUserCommand struct {
+	application.BaseCommand
+	UserID string `json:"user_id" validate:"required"`
+}
+
+// NewDeactivateUserCommand creates a new deactivate user command
+func NewDeactivateUserCommand(userID string) DeactivateUserCommand {
+	return DeactivateUserCommand{
+		BaseCommand: application.NewBaseCommand("deactivate_user"),
+		UserID:      userID,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c DeactivateUserCommand) RequiredPermission() string {
+	return domain.PermissionUsersManage
+}
+
+// ActivateUserCommand represents a command to enable a disabled user account again
+type ActivateUserCommand struct {
+	application.BaseCommand
+	UserID string `json:"user_id" validate:"required"`
+}
+
+// NewActivateUserCommand creates a new activate user command
+func NewActivateUserCommand(userID string) ActivateUserCommand {
+	return ActivateUserCommand{
+		BaseCommand: application.NewBaseCommand("activate_user"),
+		UserID:      userID,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c ActivateUserCommand) RequiredPermission() string {
+	return domain.PermissionUsersManage
+}
+
+// RequirePasswordChangeCommand represents a command to force a password reset:
+// the user's sessions end and the next login requires choosing a new password
+type RequirePasswordChangeCommand struct {
+	application.BaseCommand
+	UserID string `json:"user_id" validate:"required"`
+}
+
+// NewRequirePasswordChangeCommand creates a new require password change command
+func NewRequirePasswordChangeCommand(userID string) RequirePasswordChangeCommand {
+	return RequirePasswordChangeCommand{
+		BaseCommand: application.NewBaseCommand("require_password_change"),
+		UserID:      userID,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c RequirePasswordChangeCommand) RequiredPermission() string {
+	return domain.PermissionUsersManage
+}
+
+// ImpersonateUserCommand represents a command by an administrator to obtain a token acting as a user
+// ImpersonatorID is the administrator; Reason is kept in the audit log
+type ImpersonateUserCommand struct {
+	application.BaseCommand
+	UserID         string `json:"user_id" validate:"required"`
+	ImpersonatorID string `json:"impersonator_id" validate:"required"`
+	Reason         string `json:"reason" validate:"required,max=255"`
+}
+
+// NewImpersonateUserCommand creates a new impersonate user command
+func NewImpersonateUserCommand(userID, impersonatorID, reason string) ImpersonateUserCommand {
+	return ImpersonateUserCommand{
+		BaseCommand:    application.NewBaseCommand("impersonate_user"),
+		UserID:         userID,
+		ImpersonatorID: impersonatorID,
+		Reason:         reason,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c ImpersonateUserCommand) RequiredPermission() string {
+	return domain.PermissionUsersImpersonate
+}
+
+// ImpersonationResult represents the access token issued for an impersonation
+// It cannot be refreshed and expires after the configured impersonation lifetime
+type ImpersonationResult struct {
+	AccessToken    string     `json:"access_token"`
+	TokenType      string     `json:"token_type"`
+	ExpiresIn      int64      `json:"expires_in"` // seconds
+	ExpiresAt      time.Time  `json:"expires_at"`
+	ImpersonatorID string     `json:"impersonator_id"`
+	User           UserResult `json:"user"`
+}
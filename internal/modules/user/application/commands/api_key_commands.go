@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// CreateAPIKeyCommand represents a command to issue an API key
+// CreatedBy is the user issuing the key; each scope must be a permission that user holds
+type CreateAPIKeyCommand struct {
+	application.BaseCommand
+	Name      string     `json:"name" validate:"required,max=100"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedBy string     `json:"created_by" validate:"required"`
+}
+
+// NewCreateAPIKeyCommand creates a new create API key command
+func NewCreateAPIKeyCommand(name string, scopes []string, expiresAt *time.Time, createdBy string) CreateAPIKeyCommand {
+	return CreateAPIKeyCommand{
+		BaseCommand: application.NewBaseCommand("create_api_key"),
+		Name:        name,
+		Scopes:      scopes,
+		ExpiresAt:   expiresAt,
+		CreatedBy:   createdBy,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c CreateAPIKeyCommand) RequiredPermission() string {
+	return domain.PermissionAPIKeysManage
+}
+
+// RequiredPolicies implements authz.PolicyCommand
+// Only users with a verified email address may issue machine credentials
+func (c CreateAPIKeyCommand) RequiredPolicies() []string {
+	return []string{domain.PolicyVerifiedEmail}
+}
+
+// RevokeAPIKeyCommand represents a command to revoke an API key
+type RevokeAPIKeyCommand struct {
+	application.BaseCommand
+	ID string `json:"id" validate:"required"`
+}
+
+// NewRevokeAPIKeyCommand creates a new revoke API key command
+func NewRevokeAPIKeyCommand(id string) RevokeAPIKeyCommand {
+	return RevokeAPIKeyCommand{
+		BaseCommand: application.NewBaseCommand("revoke_api_key"),
+		ID:          id,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c RevokeAPIKeyCommand) RequiredPermission() string {
+	return domain.PermissionAPIKeysManage
+}
+
+// APIKeyResult represents the state of an API key returned by API key commands
+type APIKeyResult struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	CreatedBy  string     `json:"created_by,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreateAPIKeyResult represents the result of CreateAPIKeyCommand
+// Key is the plain API key; it is returned only here and cannot be recovered later
+type CreateAPIKeyResult struct {
+	APIKeyResult
+	Key string `json:"key"`
+}
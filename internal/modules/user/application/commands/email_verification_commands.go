@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// VerifyEmailCommand represents a command to confirm an email address with a verification token
+type VerifyEmailCommand struct {
+	application.BaseCommand
+	Token string `json:"-" validate:"required"`
+}
+
+// NewVerifyEmailCommand creates a new verify email command
+func NewVerifyEmailCommand(token string) VerifyEmailCommand {
+	return VerifyEmailCommand{
+		BaseCommand: application.NewBaseCommand("verify_email"),
+		Token:       token,
+	}
+}
+
+// ResendVerificationEmailCommand represents a command to send a new verification email to a user
+type ResendVerificationEmailCommand struct {
+	application.BaseCommand
+	UserID string `json:"user_id" validate:"required"`
+}
+
+// NewResendVerificationEmailCommand creates a new resend verification email command
+func NewResendVerificationEmailCommand(userID string) ResendVerificationEmailCommand {
+	return ResendVerificationEmailCommand{
+		BaseCommand: application.NewBaseCommand("resend_verification_email"),
+		UserID:      userID,
+	}
+}
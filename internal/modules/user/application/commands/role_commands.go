@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// CreateRoleCommand represents a command to create a role
+type CreateRoleCommand struct {
+	application.BaseCommand
+	Name        string   `json:"name" validate:"required,max=64"`
+	Description string   `json:"description" validate:"max=255"`
+	Permissions []string `json:"permissions"`
+}
+
+// NewCreateRoleCommand creates a new create role command
+func NewCreateRoleCommand(name, description string, permissions []string) CreateRoleCommand {
+	return CreateRoleCommand{
+		BaseCommand: application.NewBaseCommand("create_role"),
+		Name:        name,
+		Description: description,
+		Permissions: permissions,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c CreateRoleCommand) RequiredPermission() string {
+	return domain.PermissionRolesManage
+}
+
+// UpdateRoleCommand represents a command to change a role
+// Nil fields are left unchanged; a non-nil Permissions replaces every permission of the role
+type UpdateRoleCommand struct {
+	application.BaseCommand
+	Name        string   `json:"name" validate:"required"`
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=255"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// NewUpdateRoleCommand creates a new update role command
+func NewUpdateRoleCommand(name string) UpdateRoleCommand {
+	return UpdateRoleCommand{
+		BaseCommand: application.NewBaseCommand("update_role"),
+		Name:        name,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c UpdateRoleCommand) RequiredPermission() string {
+	return domain.PermissionRolesManage
+}
+
+// DeleteRoleCommand represents a command to delete a role
+type DeleteRoleCommand struct {
+	application.BaseCommand
+	Name string `json:"name" validate:"required"`
+}
+
+// NewDeleteRoleCommand creates a new delete role command
+func NewDeleteRoleCommand(name string) DeleteRoleCommand {
+	return DeleteRoleCommand{
+		BaseCommand: application.NewBaseCommand("delete_role"),
+		Name:        name,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c DeleteRoleCommand) RequiredPermission() string {
+	return domain.PermissionRolesManage
+}
+
+// AssignRoleCommand represents a command to give a user a role
+type AssignRoleCommand struct {
+	application.BaseCommand
+	UserID string `json:"user_id" validate:"required"`
+	Role   string `json:"role" validate:"required"`
+}
+
+// NewAssignRoleCommand creates a new assign role command
+func NewAssignRoleCommand(userID, role string) AssignRoleCommand {
+	return AssignRoleCommand{
+		BaseCommand: application.NewBaseCommand("assign_role"),
+		UserID:      userID,
+		Role:        role,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c AssignRoleCommand) RequiredPermission() string {
+	return domain.PermissionUsersAssignRoles
+}
+
+// UnassignRoleCommand represents a command to take a role away from a user
+type UnassignRoleCommand struct {
+	application.BaseCommand
+	UserID string `json:"user_id" validate:"required"`
+	Role   string `json:"role" validate:"required"`
+}
+
+// NewUnassignRoleCommand creates a new unassign role command
+func NewUnassignRoleCommand(userID, role string) UnassignRoleCommand {
+	return UnassignRoleCommand{
+		BaseCommand: application.NewBaseCommand("unassign_role"),
+		UserID:      userID,
+		Role:        role,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c UnassignRoleCommand) RequiredPermission() string {
+	return domain.PermissionUsersAssignRoles
+}
+
+// RoleResult represents the state of a role returned by role commands
+type RoleResult struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
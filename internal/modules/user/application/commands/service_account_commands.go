@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// CreateServiceAccountCommand represents a command to create a service account
+// CreatedBy is the user creating the account; each scope must be a permission that user holds
+type CreateServiceAccountCommand struct {
+	application.BaseCommand
+	Name        string   `json:"name" validate:"required,max=100"`
+	Description string   `json:"description,omitempty" validate:"max=255"`
+	Scopes      []string `json:"scopes" validate:"required,min=1"`
+	CreatedBy   string   `json:"created_by" validate:"required"`
+}
+
+// NewCreateServiceAccountCommand creates a new create service account command
+func NewCreateServiceAccountCommand(name, description string, scopes []string, createdBy string) CreateServiceAccountCommand {
+	return CreateServiceAccountCommand{
+		BaseCommand: application.NewBaseCommand("create_service_account"),
+		Name:        name,
+		Description: description,
+		Scopes:      scopes,
+		CreatedBy:   createdBy,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c CreateServiceAccountCommand) RequiredPermission() string {
+	return domain.PermissionServiceAccountsManage
+}
+
+// RequiredPolicies implements authz.PolicyCommand
+// Only users with a verified email address may issue machine credentials
+func (c CreateServiceAccountCommand) RequiredPolicies() []string {
+	return []string{domain.PolicyVerifiedEmail}
+}
+
+// RotateServiceAccountSecretCommand represents a command to replace a service account's secret
+type RotateServiceAccountSecretCommand struct {
+	application.BaseCommand
+	ID string `json:"id" validate:"required"`
+}
+
+// NewRotateServiceAccountSecretCommand creates a new rotate service account secret command
+func NewRotateServiceAccountSecretCommand(id string) RotateServiceAccountSecretCommand {
+	return RotateServiceAccountSecretCommand{
+		BaseCommand: application.NewBaseCommand("rotate_service_account_secret"),
+		ID:          id,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c RotateServiceAccountSecretCommand) RequiredPermission() string {
+	return domain.PermissionServiceAccountsManage
+}
+
+// DisableServiceAccountCommand represents a command to disable a service account
+type DisableServiceAccountCommand struct {
+	application.BaseCommand
+	ID string `json:"id" validate:"required"`
+}
+
+// NewDisableServiceAccountCommand creates a new disable service account command
+func NewDisableServiceAccountCommand(id string) DisableServiceAccountCommand {
+	return DisableServiceAccountCommand{
+		BaseCommand: application.NewBaseCommand("disable_service_account"),
+		ID:          id,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c DisableServiceAccountCommand) RequiredPermission() string {
+	return domain.PermissionServiceAccountsManage
+}
+
+// IssueServiceAccountTokenCommand represents a client credentials exchange of a service account
+// Scopes narrows the token to some of the account's scopes; empty requests all of them
+// IPAddress is the client's address, used to throttle failed exchanges per IP like failed logins
+type IssueServiceAccountTokenCommand struct {
+	application.BaseCommand
+	ClientID     string   `json:"client_id" validate:"required"`
+	ClientSecret string   `json:"-" validate:"required"`
+	Scopes       []string `json:"scopes,omitempty"`
+	IPAddress    string   `json:"ip_address"`
+}
+
+// NewIssueServiceAccountTokenCommand creates a new issue service account token command
+func NewIssueServiceAccountTokenCommand(clientID, clientSecret string, scopes []string) IssueServiceAccountTokenCommand {
+	return IssueServiceAccountTokenCommand{
+		BaseCommand:  application.NewBaseCommand("issue_service_account_token"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       scopes,
+	}
+}
+
+// ServiceAccountResult represents the state of a service account returned by service account commands
+type ServiceAccountResult struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Scopes      []string   `json:"scopes"`
+	CreatedBy   string     `json:"created_by,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	DisabledAt  *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ServiceAccountSecretResult represents the result of CreateServiceAccountCommand and RotateServiceAccountSecretCommand
+// ClientSecret is the plain secret; it is returned only here and cannot be recovered later
+type ServiceAccountSecretResult struct {
+	ServiceAccountResult
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// ServiceAccountTokenResult represents the result of IssueServiceAccountTokenCommand
+// Scope lists the token's scopes separated by spaces, as in OAuth 2.0 token responses
+type ServiceAccountTokenResult struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	ExpiresIn   int64     `json:"expires_in"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	Scope       string    `json:"scope"`
+}
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// CreateSessionCommand represents a command to start a cookie session with email and password
+// IPAddress is the client's address, used to throttle failed logins per IP
+type CreateSessionCommand struct {
+	application.BaseCommand
+	Email     string `json:"email" validate:"required"`
+	Password  string `json:"-" validate:"required"`
+	IPAddress string `json:"ip_address"`
+}
+
+// NewCreateSessionCommand creates a new create session command
+func NewCreateSessionCommand(email, password string) CreateSessionCommand {
+	return CreateSessionCommand{
+		BaseCommand: application.NewBaseCommand("create_session"),
+		Email:       email,
+		Password:    password,
+	}
+}
+
+// EndSessionCommand represents a command to end a cookie session
+// With AllSessions set, every session of the session's user ends, e.g. after a suspected compromise
+type EndSessionCommand struct {
+	application.BaseCommand
+	SessionID   string `json:"-" validate:"required"`
+	AllSessions bool   `json:"all_sessions"`
+}
+
+// NewEndSessionCommand creates a new end session command
+func NewEndSessionCommand(sessionID string, allSessions bool) EndSessionCommand {
+	return EndSessionCommand{
+		BaseCommand: application.NewBaseCommand("end_session"),
+		SessionID:   sessionID,
+		AllSessions: allSessions,
+	}
+}
+
+// SessionResult represents a session started by CreateSessionCommand
+// SessionID is the plain ID for the session cookie and is never serialized
+// Logins of users with two-factor authentication only return TwoFactorChallenge; the session starts
+// once CompleteTwoFactorLoginCommand succeeds
+type SessionResult struct {
+	TwoFactorChallenge *TwoFactorChallengeResult `json:"-"`
+
+	SessionID    string     `json:"-"`
+	ExpiresAt    time.Time  `json:"expires_at"`     // idle expiry; requests made with the session extend it
+	MaxExpiresAt time.Time  `json:"max_expires_at"` // the session ends at this time regardless of activity
+	User         UserResult `json:"user"`
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// BeginTwoFactorEnrollmentCommand represents a command to generate a TOTP secret for a user
+type BeginTwoFactorEnrollmentCommand struct {
+	application.BaseCommand
+	UserID string `json:"user_id" validate:"required"`
+}
+
+// NewBeginTwoFactorEnrollmentCommand creates a new begin two-factor enrollment command
+func NewBeginTwoFactorEnrollmentCommand(userID string) BeginTwoFactorEnrollmentCommand {
+	return BeginTwoFactorEnrollmentCommand{
+		BaseCommand: application.NewBaseCommand("begin_two_factor_enrollment"),
+		UserID:      userID,
+	}
+}
+
+// EnableTwoFactorCommand represents a command to confirm enrollment with a code from the new secret
+type EnableTwoFactorCommand struct {
+	application.BaseCommand
+	UserID string `json:"user_id" validate:"required"`
+	Code   string `json:"-" validate:"required"`
+}
+
+// NewEnableTwoFactorCommand creates a new enable two-factor command
+func NewEnableTwoFactorCommand(userID, code string) EnableTwoFactorCommand {
+	return EnableTwoFactorCommand{
+		BaseCommand: application.NewBaseCommand("enable_two_factor"),
+		UserID:      userID,
+		Code:        code,
+	}
+}
+
+// DisableTwoFactorCommand represents a command to turn two-factor authentication off
+// Code is a current TOTP code or an unused recovery code
+type DisableTwoFactorCommand struct {
+	application.BaseCommand
+	UserID string `json:"user_id" validate:"required"`
+	Code   string `json:"-" validate:"required"`
+}
+
+// NewDisableTwoFactorCommand creates a new disable two-factor command
+func NewDisableTwoFactorCommand(userID, code string) DisableTwoFactorCommand {
+	return DisableTwoFactorCommand{
+		BaseCommand: application.NewBaseCommand("disable_two_factor"),
+		UserID:      userID,
+		Code:        code,
+	}
+}
+
+// RegenerateRecoveryCodesCommand represents a command to replace a user's recovery codes
+// Code is a current TOTP code or an unused recovery code
+type RegenerateRecoveryCodesCommand struct {
+	application.BaseCommand
+	UserID string `json:"user_id" validate:"required"`
+	Code   string `json:"-" validate:"required"`
+}
+
+// NewRegenerateRecoveryCodesCommand creates a new regenerate recovery codes command
+func NewRegenerateRecoveryCodesCommand(userID, code string) RegenerateRecoveryCodesCommand {
+	return RegenerateRecoveryCodesCommand{
+		BaseCommand: application.NewBaseCommand("regenerate_recovery_codes"),
+		UserID:      userID,
+		Code:        code,
+	}
+}
+
+// CompleteTwoFactorLoginCommand represents the second login step of users with two-factor authentication
+// Code is a TOTP code or an unused recovery code; IPAddress is used to throttle failed logins per IP
+type CompleteTwoFactorLoginCommand struct {
+	application.BaseCommand
+	ChallengeToken string `json:"-" validate:"required"`
+	Code           string `json:"-" validate:"required"`
+	IPAddress      string `json:"ip_address"`
+}
+
+// NewCompleteTwoFactorLoginCommand creates a new complete two-factor login command
+func NewCompleteTwoFactorLoginCommand(challengeToken, code string) CompleteTwoFactorLoginCommand {
+	return CompleteTwoFactorLoginCommand{
+		BaseCommand:    application.NewBaseCommand("complete_two_factor_login"),
+		ChallengeToken: challengeToken,
+		Code:           code,
+	}
+}
+
+// TwoFactorEnrollmentResult represents a generated TOTP secret awaiting confirmation
+// ProvisioningURI is the otpauth:// URI that authenticator apps import, usually as a QR code
+type TwoFactorEnrollmentResult struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// RecoveryCodesResult represents newly issued recovery codes; they are only returned once
+type RecoveryCodesResult struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// TwoFactorChallengeResult represents the pending second step of a login
+// The challenge token is sent with a code to complete the login
+type TwoFactorChallengeResult struct {
+	ChallengeToken string    `json:"challenge_token"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
@@ -0,0 +1,151 @@
+package commands
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// RegisterUserCommand represents a command to register a new user account
+// Password is excluded from JSON so the command never carries it into logs or stored payloads
+type RegisterUserCommand struct {
+	application.BaseCommand
+	Email    string `json:"email" validate:"required,email,max=255"`
+	Name     string `json:"name" validate:"max=255"`
+	Password string `json:"-" validate:"required"`
+}
+
+// NewRegisterUserCommand creates a new register user command
+func NewRegisterUserCommand(email, name, password string) RegisterUserCommand {
+	return RegisterUserCommand{
+		BaseCommand: application.NewBaseCommand("register_user"),
+		Email:       email,
+		Name:        name,
+		Password:    password,
+	}
+}
+
+// LoginCommand represents a command to authenticate a user with email and password
+// DeviceID binds the issued refresh token to the client; one is generated when it is empty
+// IPAddress is the client's address, used to throttle failed logins per IP
+type LoginCommand struct {
+	application.BaseCommand
+	Email     string `json:"email" validate:"required"`
+	Password  string `json:"-" validate:"required"`
+	DeviceID  string `json:"device_id" validate:"max=128"`
+	IPAddress string `json:"ip_address"`
+}
+
+// NewLoginCommand creates a new login command
+func NewLoginCommand(email, password, deviceID string) LoginCommand {
+	return LoginCommand{
+		BaseCommand: application.NewBaseCommand("login"),
+		Email:       email,
+		Password:    password,
+		DeviceID:    deviceID,
+	}
+}
+
+// ChangePasswordCommand represents a command to replace a user's password, authenticated by the current one
+// It is also how users whose password change is required get to log in again
+type ChangePasswordCommand struct {
+	application.BaseCommand
+	Email           string `json:"email" validate:"required"`
+	CurrentPassword string `json:"-" validate:"required"`
+	NewPassword     string `json:"-" validate:"required"`
+	IPAddress       string `json:"ip_address"`
+}
+
+// NewChangePasswordCommand creates a new change password command
+func NewChangePasswordCommand(email, currentPassword, newPassword string) ChangePasswordCommand {
+	return ChangePasswordCommand{
+		BaseCommand:     application.NewBaseCommand("change_password"),
+		Email:           email,
+		CurrentPassword: currentPassword,
+		NewPassword:     newPassword,
+	}
+}
+
+// RefreshTokenCommand represents a command to exchange a refresh token for new tokens
+// The refresh token is rotated: the presented token stops working once the command succeeds
+type RefreshTokenCommand struct {
+	application.BaseCommand
+	RefreshToken string `json:"-" validate:"required"`
+	DeviceID     string `json:"device_id" validate:"required,max=128"`
+}
+
+// NewRefreshTokenCommand creates a new refresh token command
+func NewRefreshTokenCommand(refreshToken, deviceID string) RefreshTokenCommand {
+	return RefreshTokenCommand{
+		BaseCommand:  application.NewBaseCommand("refresh_token"),
+		RefreshToken: refreshToken,
+		DeviceID:     deviceID,
+	}
+}
+
+// LogoutCommand represents a command to end the session a refresh token belongs to
+type LogoutCommand struct {
+	application.BaseCommand
+	RefreshToken string `json:"-" validate:"required"`
+}
+
+// NewLogoutCommand creates a new logout command
+func NewLogoutCommand(refreshToken string) LogoutCommand {
+	return LogoutCommand{
+		BaseCommand:  application.NewBaseCommand("logout"),
+		RefreshToken: refreshToken,
+	}
+}
+
+// LoginResult represents the tokens issued by a successful login or refresh
+// Logins of users with two-factor authentication only return TwoFactorChallenge; tokens are issued
+// once CompleteTwoFactorLoginCommand succeeds
+type LoginResult struct {
+	TwoFactorChallenge *TwoFactorChallengeResult `json:"-"`
+
+	AccessToken           string     `json:"access_token"`
+	TokenType             string     `json:"token_type"`
+	ExpiresIn             int64      `json:"expires_in"` // seconds
+	ExpiresAt             time.Time  `json:"expires_at"`
+	RefreshToken          string     `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time  `json:"refresh_token_expires_at"`
+	DeviceID              string     `json:"device_id"`
+	User                  UserResult `json:"user"`
+}
+
+// UnlockUserCommand represents a command to lift an account lock caused by failed logins
+type UnlockUserCommand struct {
+	application.BaseCommand
+	UserID string `json:"user_id" validate:"required"`
+}
+
+// NewUnlockUserCommand creates a new unlock user command
+func NewUnlockUserCommand(userID string) UnlockUserCommand {
+	return UnlockUserCommand{
+		BaseCommand: application.NewBaseCommand("unlock_user"),
+		UserID:      userID,
+	}
+}
+
+// RequiredPermission implements authz.ProtectedCommand
+func (c UnlockUserCommand) RequiredPermission() string {
+	return domain.PermissionUsersUnlock
+}
+
+// UserResult represents the state of a user returned by user commands
+type UserResult struct {
+	ID                     string     `json:"id"`
+	Email                  string     `json:"email"`
+	Name                   string     `json:"name,omitempty"`
+	Status                 string     `json:"status"`
+	Roles                  []string   `json:"roles"`
+	EmailVerified          bool       `json:"email_verified"`
+	EmailVerifiedAt        *time.Time `json:"email_verified_at,omitempty"`
+	LockedUntil            *time.Time `json:"locked_until,omitempty"`
+	TwoFactorEnabled       bool       `json:"two_factor_enabled"`
+	PasswordChangeRequired bool       `json:"password_change_required"`
+	Version                int        `json:"version"`
+	CreatedAt              time.Time  `json:"created_at"`
+	UpdatedAt              time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,28 @@
+package queries
+
+import (
+	"time"
+)
+
+// GetRoleQuery represents a query to get a role by name
+type GetRoleQuery struct {
+	Name string `json:"name"`
+}
+
+// ListRolesQuery represents a query to list all roles
+type ListRolesQuery struct{}
+
+// RoleDTO represents a role and its permissions
+type RoleDTO struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions"`
+	BuiltIn     bool      `json:"built_in"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ListRolesResult represents the result of ListRolesQuery
+type ListRolesResult struct {
+	Roles []RoleDTO `json:"roles"`
+}
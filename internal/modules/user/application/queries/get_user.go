@@ -0,0 +1,31 @@
+package queries
+
+import (
+	"time"
+)
+
+// GetUserQuery represents a query to get a user by ID
+type GetUserQuery struct {
+	ID string `json:"id"`
+}
+
+// UserDTO represents a user account without its credentials
+type UserDTO struct {
+	ID                     string     `json:"id"`
+	Email                  string     `json:"email"`
+	Name                   string     `json:"name,omitempty"`
+	Status                 string     `json:"status"`
+	Roles                  []string   `json:"roles"`
+	EmailVerified          bool       `json:"email_verified"`
+	EmailVerifiedAt        *time.Time `json:"email_verified_at,omitempty"`
+	TwoFactorEnabled       bool       `json:"two_factor_enabled"`
+	PasswordChangeRequired bool       `json:"password_change_required"`
+	Version                int        `json:"version"`
+	CreatedAt              time.Time  `json:"created_at"`
+	UpdatedAt              time.Time  `json:"updated_at"`
+}
+
+// GetUserResult represents the result of GetUserQuery
+type GetUserResult struct {
+	User UserDTO `json:"user"`
+}
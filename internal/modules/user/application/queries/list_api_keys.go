@@ -0,0 +1,30 @@
+package queries
+
+import (
+	"time"
+)
+
+// ListAPIKeysQuery represents a query to list API keys
+// Revoked keys are left out unless IncludeRevoked is set
+type ListAPIKeysQuery struct {
+	IncludeRevoked bool `json:"include_revoked"`
+}
+
+// APIKeyDTO represents an API key without its secret value
+type APIKeyDTO struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	CreatedBy  string     `json:"created_by,omitempty"`
+	Active     bool       `json:"active"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// ListAPIKeysResult represents the result of ListAPIKeysQuery
+type ListAPIKeysResult struct {
+	APIKeys []APIKeyDTO `json:"api_keys"`
+}
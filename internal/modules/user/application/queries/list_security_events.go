@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+)
+
+// ListSecurityEventsQuery represents a query to list security events with pagination, newest first
+type ListSecurityEventsQuery struct {
+	Page      int        `json:"page"`
+	Limit     int        `json:"limit"`
+	UserID    string     `json:"user_id,omitempty"`
+	Type      string     `json:"type,omitempty"`
+	RequestID string     `json:"request_id,omitempty"`
+	Since     *time.Time `json:"since,omitempty"`
+	Until     *time.Time `json:"until,omitempty"`
+}
+
+// ListSecurityEventsResult represents the result of ListSecurityEventsQuery
+type ListSecurityEventsResult struct {
+	domain.SecurityEventListResult
+}
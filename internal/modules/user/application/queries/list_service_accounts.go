@@ -0,0 +1,30 @@
+package queries
+
+import (
+	"time"
+)
+
+// ListServiceAccountsQuery represents a query to list service accounts
+// Disabled accounts are left out unless IncludeDisabled is set
+type ListServiceAccountsQuery struct {
+	IncludeDisabled bool `json:"include_disabled"`
+}
+
+// ServiceAccountDTO represents a service account without its secret
+type ServiceAccountDTO struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Scopes      []string   `json:"scopes"`
+	CreatedBy   string     `json:"created_by,omitempty"`
+	Active      bool       `json:"active"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	DisabledAt  *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ListServiceAccountsResult represents the result of ListServiceAccountsQuery
+type ListServiceAccountsResult struct {
+	ServiceAccounts []ServiceAccountDTO `json:"service_accounts"`
+}
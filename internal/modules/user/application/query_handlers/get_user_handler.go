@@ -0,0 +1,53 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/user/application/queries"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// GetUserHandler handles GetUserQuery
+type GetUserHandler struct {
+	repo domain.UserRepository
+}
+
+// NewGetUserHandler creates a new GetUserHandler
+func NewGetUserHandler(repo domain.UserRepository) *GetUserHandler {
+	return &GetUserHandler{
+		repo: repo,
+	}
+}
+
+// Handle handles the GetUserQuery
+func (h *GetUserHandler) Handle(ctx context.Context, query *queries.GetUserQuery) (*queries.GetUserResult, error) {
+	user, err := h.repo.GetByID(ctx, query.ID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("user with ID %s not found", query.ID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return &queries.GetUserResult{
+		User: queries.UserDTO{
+			ID:                     user.GetID(),
+			Email:                  user.Email.Value,
+			Name:                   user.Name,
+			Status:                 string(user.Status),
+			Roles:                  user.Roles,
+			EmailVerified:          user.IsEmailVerified(),
+			EmailVerifiedAt:        user.EmailVerifiedAt,
+			TwoFactorEnabled:       user.IsTwoFactorEnabled(),
+			PasswordChangeRequired: user.PasswordChangeRequired,
+			Version:                user.GetVersion(),
+			CreatedAt:              user.GetCreatedAt(),
+			UpdatedAt:              user.GetUpdatedAt(),
+		},
+	}, nil
+}
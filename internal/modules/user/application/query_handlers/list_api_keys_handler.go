@@ -0,0 +1,49 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/queries"
+	"golang_modular_monolith/internal/modules/user/domain"
+)
+
+// ListAPIKeysHandler handles ListAPIKeysQuery
+type ListAPIKeysHandler struct {
+	apiKeys domain.APIKeyRepository
+}
+
+// NewListAPIKeysHandler creates a new ListAPIKeysHandler
+func NewListAPIKeysHandler(apiKeys domain.APIKeyRepository) *ListAPIKeysHandler {
+	return &ListAPIKeysHandler{
+		apiKeys: apiKeys,
+	}
+}
+
+// Handle handles the ListAPIKeysQuery
+func (h *ListAPIKeysHandler) Handle(ctx context.Context, query *queries.ListAPIKeysQuery) (*queries.ListAPIKeysResult, error) {
+	keys, err := h.apiKeys.List(ctx, query.IncludeRevoked)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	now := time.Now()
+	result := &queries.ListAPIKeysResult{APIKeys: make([]queries.APIKeyDTO, len(keys))}
+	for i, key := range keys {
+		result.APIKeys[i] = queries.APIKeyDTO{
+			ID:         key.ID,
+			Name:       key.Name,
+			Prefix:     key.Prefix,
+			Scopes:     key.Scopes,
+			CreatedBy:  key.CreatedBy,
+			Active:     key.IsActive(now),
+			ExpiresAt:  key.ExpiresAt,
+			LastUsedAt: key.LastUsedAt,
+			RevokedAt:  key.RevokedAt,
+			CreatedAt:  key.CreatedAt,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,43 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/user/application/queries"
+	"golang_modular_monolith/internal/modules/user/domain"
+)
+
+// ListSecurityEventsHandler handles ListSecurityEventsQuery
+type ListSecurityEventsHandler struct {
+	events domain.SecurityEventRepository
+}
+
+// NewListSecurityEventsHandler creates a new ListSecurityEventsHandler
+func NewListSecurityEventsHandler(events domain.SecurityEventRepository) *ListSecurityEventsHandler {
+	return &ListSecurityEventsHandler{
+		events: events,
+	}
+}
+
+// Handle handles the ListSecurityEventsQuery
+func (h *ListSecurityEventsHandler) Handle(ctx context.Context, query *queries.ListSecurityEventsQuery) (*queries.ListSecurityEventsResult, error) {
+	filter := domain.SecurityEventFilter{
+		Page:      query.Page,
+		Limit:     query.Limit,
+		UserID:    query.UserID,
+		Type:      query.Type,
+		RequestID: query.RequestID,
+		Since:     query.Since,
+		Until:     query.Until,
+	}
+
+	result, err := h.events.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list security events: %w", err)
+	}
+
+	return &queries.ListSecurityEventsResult{
+		SecurityEventListResult: *result,
+	}, nil
+}
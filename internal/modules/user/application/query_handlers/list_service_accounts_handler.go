@@ -0,0 +1,47 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/user/application/queries"
+	"golang_modular_monolith/internal/modules/user/domain"
+)
+
+// ListServiceAccountsHandler handles ListServiceAccountsQuery
+type ListServiceAccountsHandler struct {
+	accounts domain.ServiceAccountRepository
+}
+
+// NewListServiceAccountsHandler creates a new ListServiceAccountsHandler
+func NewListServiceAccountsHandler(accounts domain.ServiceAccountRepository) *ListServiceAccountsHandler {
+	return &ListServiceAccountsHandler{
+		accounts: accounts,
+	}
+}
+
+// Handle handles the ListServiceAccountsQuery
+func (h *ListServiceAccountsHandler) Handle(ctx context.Context, query *queries.ListServiceAccountsQuery) (*queries.ListServiceAccountsResult, error) {
+	accounts, err := h.accounts.List(ctx, query.IncludeDisabled)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+
+	result := &queries.ListServiceAccountsResult{ServiceAccounts: make([]queries.ServiceAccountDTO, len(accounts))}
+	for i, account := range accounts {
+		result.ServiceAccounts[i] = queries.ServiceAccountDTO{
+			ID:          account.ID,
+			Name:        account.Name,
+			Description: account.Description,
+			Scopes:      account.Scopes,
+			CreatedBy:   account.CreatedBy,
+			Active:      account.IsActive(),
+			LastUsedAt:  account.LastUsedAt,
+			DisabledAt:  account.DisabledAt,
+			CreatedAt:   account.CreatedAt,
+			UpdatedAt:   account.UpdatedAt,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package queryhandlers
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/user/application/queries"
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// GetRoleHandler handles GetRoleQuery
+type GetRoleHandler struct {
+	roles domain.RoleRepository
+}
+
+// NewGetRoleHandler creates a new GetRoleHandler
+func NewGetRoleHandler(roles domain.RoleRepository) *GetRoleHandler {
+	return &GetRoleHandler{
+		roles: roles,
+	}
+}
+
+// Handle handles the GetRoleQuery
+func (h *GetRoleHandler) Handle(ctx context.Context, query *queries.GetRoleQuery) (*queries.RoleDTO, error) {
+	role, err := h.roles.GetByName(ctx, query.Name)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, shareddomain.NewDomainError(
+				shareddomain.ErrCodeNotFound,
+				fmt.Sprintf("role %s not found", query.Name),
+			)
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	dto := toRoleDTO(role)
+	return &dto, nil
+}
+
+// ListRolesHandler handles ListRolesQuery
+type ListRolesHandler struct {
+	roles domain.RoleRepository
+}
+
+// NewListRolesHandler creates a new ListRolesHandler
+func NewListRolesHandler(roles domain.RoleRepository) *ListRolesHandler {
+	return &ListRolesHandler{
+		roles: roles,
+	}
+}
+
+// Handle handles the ListRolesQuery
+func (h *ListRolesHandler) Handle(ctx context.Context, query *queries.ListRolesQuery) (*queries.ListRolesResult, error) {
+	roles, err := h.roles.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	result := &queries.ListRolesResult{Roles: make([]queries.RoleDTO, len(roles))}
+	for i, role := range roles {
+		result.Roles[i] = toRoleDTO(role)
+	}
+
+	return result, nil
+}
+
+// toRoleDTO converts a role to its DTO
+func toRoleDTO(role *domain.Role) queries.RoleDTO {
+	return queries.RoleDTO{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		BuiltIn:     role.IsBuiltIn(),
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
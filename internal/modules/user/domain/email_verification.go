@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultEmailVerificationTTL is how long a verification link stays valid
+const DefaultEmailVerificationTTL = 48 * time.Hour
+
+// DefaultEmailVerificationURL is the page of verification links, which posts their token to
+// /users/verify-email
+const DefaultEmailVerificationURL = "http://localhost:3000/verify-email"
+
+// ErrVerificationTokenUsed is returned when a verification token was used concurrently
+var ErrVerificationTokenUsed = errors.New("verification token already used")
+
+// EmailVerificationToken proves that a user controls an email address
+// Only the SHA-256 hash of the token is stored. The token is bound to the address it was sent
+// to, so it stops working if the user's email changes before it is used.
+type EmailVerificationToken struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	Email     string     `json:"email"`
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// NewEmailVerificationToken creates a verification token for the user's current email
+// and returns it with its plain value
+func NewEmailVerificationToken(user *User, ttl time.Duration) (*EmailVerificationToken, string, error) {
+	plain, err := newSecret()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate verification token: %w", err)
+	}
+
+	now := time.Now().UTC()
+	return &EmailVerificationToken{
+		ID:        uuid.New().String(),
+		UserID:    user.GetID(),
+		Email:     user.Email.Value,
+		TokenHash: HashEmailVerificationToken(plain),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, plain, nil
+}
+
+// HashEmailVerificationToken returns the stored form of a verification token
+func HashEmailVerificationToken(token string) string {
+	return hashSecret(token)
+}
+
+// IsUsable checks if the token can still verify the given user
+func (t *EmailVerificationToken) IsUsable(user *User, now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt) && t.UserID == user.GetID() && t.Email == user.Email.Value
+}
@@ -0,0 +1,406 @@
+package domain
+
+import (
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// User domain event types
+const (
+	UserRegisteredEventType     = "user.registered"
+	UserRoleAssignedEventType   = "user.role_assigned"
+	UserRoleUnassignedEventType = "user.role_unassigned"
+
+	UserEmailVerificationRequestedEventType = "user.email_verification_requested"
+	UserEmailVerifiedEventType              = "user.email_verified"
+
+	UserAccountLockedEventType   = "user.account_locked"
+	UserAccountUnlockedEventType = "user.account_unlocked"
+
+	UserTwoFactorEnabledEventType  = "user.two_factor_enabled"
+	UserTwoFactorDisabledEventType = "user.two_factor_disabled"
+
+	UserDeactivatedEventType = "user.deactivated"
+	UserActivatedEventType   = "user.activated"
+
+	UserPasswordChangeRequiredEventType = "user.password_change_required"
+	UserPasswordChangedEventType        = "user.password_changed"
+)
+
+// UserRegisteredEvent represents the event when a user account is registered
+type UserRegisteredEvent struct {
+	domain.BaseDomainEvent
+	UserID string   `json:"user_id"`
+	Email  string   `json:"email"`
+	Name   string   `json:"name,omitempty"`
+	Roles  []string `json:"roles"`
+}
+
+// NewUserRegisteredEvent creates a new user registered event
+func NewUserRegisteredEvent(user *User) UserRegisteredEvent {
+	eventData := map[string]interface{}{
+		"user_id": user.GetID(),
+		"email":   user.Email.Value,
+		"name":    user.Name,
+		"roles":   user.Roles,
+	}
+
+	return UserRegisteredEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserRegisteredEventType,
+			eventData,
+		),
+		UserID: user.GetID(),
+		Email:  user.Email.Value,
+		Name:   user.Name,
+		Roles:  user.Roles,
+	}
+}
+
+// UserRoleAssignedEvent represents the event when a role is assigned to a user
+type UserRoleAssignedEvent struct {
+	domain.BaseDomainEvent
+	UserID string   `json:"user_id"`
+	Role   string   `json:"role"`
+	Roles  []string `json:"roles"`
+}
+
+// NewUserRoleAssignedEvent creates a new user role assigned event
+func NewUserRoleAssignedEvent(user *User, role string) UserRoleAssignedEvent {
+	roles := append([]string(nil), user.Roles...)
+	eventData := map[string]interface{}{
+		"user_id": user.GetID(),
+		"role":    role,
+		"roles":   roles,
+	}
+
+	return UserRoleAssignedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserRoleAssignedEventType,
+			eventData,
+		),
+		UserID: user.GetID(),
+		Role:   role,
+		Roles:  roles,
+	}
+}
+
+// UserRoleUnassignedEvent represents the event when a role is taken away from a user
+type UserRoleUnassignedEvent struct {
+	domain.BaseDomainEvent
+	UserID string   `json:"user_id"`
+	Role   string   `json:"role"`
+	Roles  []string `json:"roles"`
+}
+
+// NewUserRoleUnassignedEvent creates a new user role unassigned event
+func NewUserRoleUnassignedEvent(user *User, role string) UserRoleUnassignedEvent {
+	roles := append([]string(nil), user.Roles...)
+	eventData := map[string]interface{}{
+		"user_id": user.GetID(),
+		"role":    role,
+		"roles":   roles,
+	}
+
+	return UserRoleUnassignedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserRoleUnassignedEventType,
+			eventData,
+		),
+		UserID: user.GetID(),
+		Role:   role,
+		Roles:  roles,
+	}
+}
+
+// UserEmailVerificationRequestedEvent represents the event when a verification email must be sent
+// Token is the plain verification token for the link in the email, so this event must only
+// reach the mailer and never be exposed through webhooks or logs
+type UserEmailVerificationRequestedEvent struct {
+	domain.BaseDomainEvent
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name,omitempty"`
+	Token     string    `json:"-"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewUserEmailVerificationRequestedEvent creates a new user email verification requested event
+func NewUserEmailVerificationRequestedEvent(user *User, token *EmailVerificationToken, plainToken string) UserEmailVerificationRequestedEvent {
+	eventData := map[string]interface{}{
+		"user_id":    user.GetID(),
+		"email":      token.Email,
+		"name":       user.Name,
+		"expires_at": token.ExpiresAt,
+	}
+
+	return UserEmailVerificationRequestedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserEmailVerificationRequestedEventType,
+			eventData,
+		),
+		UserID:    user.GetID(),
+		Email:     token.Email,
+		Name:      user.Name,
+		Token:     plainToken,
+		ExpiresAt: token.ExpiresAt,
+	}
+}
+
+// UserEmailVerifiedEvent represents the event when a user confirms their email address
+type UserEmailVerifiedEvent struct {
+	domain.BaseDomainEvent
+	UserID     string    `json:"user_id"`
+	Email      string    `json:"email"`
+	VerifiedAt time.Time `json:"verified_at"`
+}
+
+// NewUserEmailVerifiedEvent creates a new user email verified event
+func NewUserEmailVerifiedEvent(user *User) UserEmailVerifiedEvent {
+	eventData := map[string]interface{}{
+		"user_id":     user.GetID(),
+		"email":       user.Email.Value,
+		"verified_at": *user.EmailVerifiedAt,
+	}
+
+	return UserEmailVerifiedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserEmailVerifiedEventType,
+			eventData,
+		),
+		UserID:     user.GetID(),
+		Email:      user.Email.Value,
+		VerifiedAt: *user.EmailVerifiedAt,
+	}
+}
+
+// UserAccountLockedEvent represents the event when an account is locked after too many failed logins
+type UserAccountLockedEvent struct {
+	domain.BaseDomainEvent
+	UserID         string    `json:"user_id"`
+	Email          string    `json:"email"`
+	FailedAttempts int       `json:"failed_attempts"`
+	LockCount      int       `json:"lock_count"`
+	LockedUntil    time.Time `json:"locked_until"`
+	IPAddress      string    `json:"ip_address,omitempty"`
+}
+
+// NewUserAccountLockedEvent creates a new user account locked event
+func NewUserAccountLockedEvent(user *User, failedAttempts int, ip string) UserAccountLockedEvent {
+	eventData := map[string]interface{}{
+		"user_id":         user.GetID(),
+		"email":           user.Email.Value,
+		"failed_attempts": failedAttempts,
+		"lock_count":      user.LockCount,
+		"locked_until":    *user.LockedUntil,
+		"ip_address":      ip,
+	}
+
+	return UserAccountLockedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserAccountLockedEventType,
+			eventData,
+		),
+		UserID:         user.GetID(),
+		Email:          user.Email.Value,
+		FailedAttempts: failedAttempts,
+		LockCount:      user.LockCount,
+		LockedUntil:    *user.LockedUntil,
+		IPAddress:      ip,
+	}
+}
+
+// UserAccountUnlockedEvent represents the event when an administrator unlocks an account
+type UserAccountUnlockedEvent struct {
+	domain.BaseDomainEvent
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// NewUserAccountUnlockedEvent creates a new user account unlocked event
+func NewUserAccountUnlockedEvent(user *User) UserAccountUnlockedEvent {
+	eventData := map[string]interface{}{
+		"user_id": user.GetID(),
+		"email":   user.Email.Value,
+	}
+
+	return UserAccountUnlockedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserAccountUnlockedEventType,
+			eventData,
+		),
+		UserID: user.GetID(),
+		Email:  user.Email.Value,
+	}
+}
+
+// UserTwoFactorEnabledEvent represents the event when a user enables two-factor authentication
+type UserTwoFactorEnabledEvent struct {
+	domain.BaseDomainEvent
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// NewUserTwoFactorEnabledEvent creates a new user two-factor enabled event
+func NewUserTwoFactorEnabledEvent(user *User) UserTwoFactorEnabledEvent {
+	eventData := map[string]interface{}{
+		"user_id": user.GetID(),
+		"email":   user.Email.Value,
+	}
+
+	return UserTwoFactorEnabledEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserTwoFactorEnabledEventType,
+			eventData,
+		),
+		UserID: user.GetID(),
+		Email:  user.Email.Value,
+	}
+}
+
+// UserTwoFactorDisabledEvent represents the event when a user disables two-factor authentication
+type UserTwoFactorDisabledEvent struct {
+	domain.BaseDomainEvent
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// NewUserTwoFactorDisabledEvent creates a new user two-factor disabled event
+func NewUserTwoFactorDisabledEvent(user *User) UserTwoFactorDisabledEvent {
+	eventData := map[string]interface{}{
+		"user_id": user.GetID(),
+		"email":   user.Email.Value,
+	}
+
+	return UserTwoFactorDisabledEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserTwoFactorDisabledEventType,
+			eventData,
+		),
+		UserID: user.GetID(),
+		Email:  user.Email.Value,
+	}
+}
+
+// UserDeactivatedEvent represents the event when an administrator deactivates a user account
+type UserDeactivatedEvent struct {
+	domain.BaseDomainEvent
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// NewUserDeactivatedEvent creates a new user deactivated event
+func NewUserDeactivatedEvent(user *User) UserDeactivatedEvent {
+	eventData := map[string]interface{}{
+		"user_id": user.GetID(),
+		"email":   user.Email.Value,
+	}
+
+	return UserDeactivatedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserDeactivatedEventType,
+			eventData,
+		),
+		UserID: user.GetID(),
+		Email:  user.Email.Value,
+	}
+}
+
+// UserActivatedEvent represents the event when an administrator reactivates a user account
+type UserActivatedEvent struct {
+	domain.BaseDomainEvent
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// NewUserActivatedEvent creates a new user activated event
+func NewUserActivatedEvent(user *User) UserActivatedEvent {
+	eventData := map[string]interface{}{
+		"user_id": user.GetID(),
+		"email":   user.Email.Value,
+	}
+
+	return UserActivatedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserActivatedEventType,
+			eventData,
+		),
+		UserID: user.GetID(),
+		Email:  user.Email.Value,
+	}
+}
+
+// UserPasswordChangeRequiredEvent represents the event when an administrator requires a user to change their password
+type UserPasswordChangeRequiredEvent struct {
+	domain.BaseDomainEvent
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// NewUserPasswordChangeRequiredEvent creates a new user password change required event
+func NewUserPasswordChangeRequiredEvent(user *User) UserPasswordChangeRequiredEvent {
+	eventData := map[string]interface{}{
+		"user_id": user.GetID(),
+		"email":   user.Email.Value,
+	}
+
+	return UserPasswordChangeRequiredEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserPasswordChangeRequiredEventType,
+			eventData,
+		),
+		UserID: user.GetID(),
+		Email:  user.Email.Value,
+	}
+}
+
+// UserPasswordChangedEvent represents the event when a user changes their password
+type UserPasswordChangedEvent struct {
+	domain.BaseDomainEvent
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// NewUserPasswordChangedEvent creates a new user password changed event
+func NewUserPasswordChangedEvent(user *User) UserPasswordChangedEvent {
+	eventData := map[string]interface{}{
+		"user_id": user.GetID(),
+		"email":   user.Email.Value,
+	}
+
+	return UserPasswordChangedEvent{
+		BaseDomainEvent: domain.NewBaseDomainEvent(
+			user.GetID(),
+			"user",
+			UserPasswordChangedEventType,
+			eventData,
+		),
+		UserID: user.GetID(),
+		Email:  user.Email.Value,
+	}
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Impersonation token lifetimes
+// Impersonation tokens cannot be refreshed; the administrator asks for a new one when it expires
+const (
+	DefaultImpersonationTTL = 15 * time.Minute
+	MaxImpersonationTTL     = time.Hour
+)
+
+// MaxImpersonationReasonLength is the maximum length of the reason given for an impersonation
+const MaxImpersonationReasonLength = 255
+
+// ValidateImpersonation checks if an administrator may act as the target user
+// Only active users other than the administrator can be impersonated, and the reason, kept in the
+// audit log, is required. Whether the target holds permissions beyond the impersonator's is checked
+// by the caller, which has the roles
+func ValidateImpersonation(impersonatorID string, target *User, reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "", domain.NewValidationError("reason", "reason is required")
+	}
+	if len(reason) > MaxImpersonationReasonLength {
+		return "", domain.NewValidationError("reason", fmt.Sprintf("reason must not exceed %d characters", MaxImpersonationReasonLength))
+	}
+
+	if target.GetID() == impersonatorID {
+		return "", domain.NewBusinessRuleError("self_impersonation", "users cannot impersonate themselves")
+	}
+	if !target.IsActive() {
+		return "", domain.NewBusinessRuleError("inactive_user", "inactive users cannot be impersonated")
+	}
+
+	return reason, nil
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Login protection error codes
+const (
+	ErrCodeAccountLocked   = "ACCOUNT_LOCKED"
+	ErrCodeTooManyAttempts = "TOO_MANY_ATTEMPTS"
+)
+
+// LockoutPolicy configures brute-force protection of logins
+// An account is locked after MaxFailedAttempts consecutive failures. Each further lock doubles
+// the lock duration, starting at BaseLockDuration and capped at MaxLockDuration. Independently,
+// a client IP is blocked for IPWindow once IPMaxFailedAttempts failures happen within IPWindow.
+type LockoutPolicy struct {
+	MaxFailedAttempts   int
+	BaseLockDuration    time.Duration
+	MaxLockDuration     time.Duration
+	IPMaxFailedAttempts int
+	IPWindow            time.Duration
+}
+
+// DefaultLockoutPolicy returns the lockout policy used when none is configured
+func DefaultLockoutPolicy() LockoutPolicy {
+	return LockoutPolicy{
+		MaxFailedAttempts:   5,
+		BaseLockDuration:    time.Minute,
+		MaxLockDuration:     time.Hour,
+		IPMaxFailedAttempts: 20,
+		IPWindow:            15 * time.Minute,
+	}
+}
+
+// Validate checks that the policy's limits are usable
+func (p LockoutPolicy) Validate() error {
+	if p.MaxFailedAttempts < 1 {
+		return fmt.Errorf("max_failed_attempts must be at least 1, got %d", p.MaxFailedAttempts)
+	}
+	if p.IPMaxFailedAttempts < 1 {
+		return fmt.Errorf("ip_max_failed_attempts must be at least 1, got %d", p.IPMaxFailedAttempts)
+	}
+	if p.BaseLockDuration <= 0 || p.MaxLockDuration < p.BaseLockDuration {
+		return fmt.Errorf("lock durations must satisfy 0 < base_lock_duration <= max_lock_duration, got %s and %s", p.BaseLockDuration, p.MaxLockDuration)
+	}
+	if p.IPWindow <= 0 {
+		return fmt.Errorf("ip_window must be positive, got %s", p.IPWindow)
+	}
+	return nil
+}
+
+// LockDuration returns how long the lockCount-th consecutive lock lasts
+func (p LockoutPolicy) LockDuration(lockCount int) time.Duration {
+	duration := p.BaseLockDuration
+	for i := 1; i < lockCount && duration < p.MaxLockDuration; i++ {
+		duration *= 2
+	}
+	if duration > p.MaxLockDuration {
+		return p.MaxLockDuration
+	}
+	return duration
+}
+
+// LoginThrottle counts failed logins per client IP
+type LoginThrottle interface {
+	// BlockedUntil returns when the IP may try again, or false when it is not blocked
+	BlockedUntil(ip string, now time.Time) (time.Time, bool)
+
+	// RecordFailure counts a failed login from the IP
+	RecordFailure(ip string, now time.Time)
+}
+
+// AccountLockedError is returned when a locked account tries to sign in
+type AccountLockedError struct {
+	LockedUntil time.Time
+}
+
+// Error implements the error interface
+func (e AccountLockedError) Error() string {
+	return fmt.Sprintf("account is locked until %s after too many failed logins", e.LockedUntil.UTC().Format(time.RFC3339))
+}
+
+// TooManyAttemptsError is returned when a client IP is blocked after too many failed logins
+type TooManyAttemptsError struct {
+	RetryAt time.Time
+}
+
+// Error implements the error interface
+func (e TooManyAttemptsError) Error() string {
+	return fmt.Sprintf("too many failed logins, try again after %s", e.RetryAt.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Password length limits
+// The upper bound keeps hashing cost predictable; bcrypt additionally only reads the first 72 bytes
+const (
+	DefaultPasswordMinLength = 8
+	MaxPasswordLength        = 72
+)
+
+// ErrCodePasswordChangeRequired is returned by logins of users who must choose a new password first
+const ErrCodePasswordChangeRequired = "PASSWORD_CHANGE_REQUIRED"
+
+// PasswordPolicy describes the passwords users may choose
+type PasswordPolicy struct {
+	MinLength int
+}
+
+// DefaultPasswordPolicy returns the policy used when none is configured
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{MinLength: DefaultPasswordMinLength}
+}
+
+// Validate checks a plain password against the policy
+func (p PasswordPolicy) Validate(password string) error {
+	minLength := p.MinLength
+	if minLength <= 0 {
+		minLength = DefaultPasswordMinLength
+	}
+
+	if password == "" {
+		return domain.NewValidationError("password", "password is required")
+	}
+	if utf8.RuneCountInString(password) < minLength {
+		return domain.NewValidationError("password", fmt.Sprintf("password must be at least %d characters", minLength))
+	}
+	if len(password) > MaxPasswordLength {
+		return domain.NewValidationError("password", fmt.Sprintf("password must not exceed %d bytes", MaxPasswordLength))
+	}
+
+	return nil
+}
+
+// PasswordHasher hashes and verifies user passwords
+type PasswordHasher interface {
+	// Hash returns the encoded hash of a password, including its algorithm, parameters and salt
+	Hash(password string) (string, error)
+
+	// Verify checks a password against an encoded hash
+	Verify(encodedHash, password string) (bool, error)
+}
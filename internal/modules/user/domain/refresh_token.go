@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Refresh token revocation reasons
+const (
+	RevokeReasonLogout         = "logout"
+	RevokeReasonReuseDetected  = "reuse_detected"  // a rotated token was presented again
+	RevokeReasonDeviceMismatch = "device_mismatch" // the token was presented from another device
+	RevokeReasonPasswordChange = "password_change" // the password was changed or a change was required
+	RevokeReasonDeactivated    = "deactivated"     // the user account was deactivated
+)
+
+// Refresh token limits
+const (
+	DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+	MaxDeviceIDLength      = 128 // maximum length of a client-supplied device ID
+)
+
+// ErrRefreshTokenUsed is returned when a refresh token was rotated or revoked concurrently
+var ErrRefreshTokenUsed = errors.New("refresh token already used")
+
+// RefreshToken is a long-lived credential that is exchanged for a new access token
+// Only the SHA-256 hash of the token is stored. Every refresh rotates the token: the
+// presented token is retired and a new one is issued in the same family, so a retired
+// token that shows up again reveals theft and revokes the whole family.
+type RefreshToken struct {
+	ID            string     `json:"id"`
+	UserID        string     `json:"user_id"`
+	FamilyID      string     `json:"family_id"` // shared by all tokens rotated from the same login
+	DeviceID      string     `json:"device_id"`
+	TokenHash     string     `json:"-"`
+	ExpiresAt     time.Time  `json:"expires_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	RotatedAt     *time.Time `json:"rotated_at,omitempty"`
+	ReplacedByID  string     `json:"replaced_by_id,omitempty"`
+	RevokedAt     *time.Time `json:"revoked_at,omitempty"`
+	RevokedReason string     `json:"revoked_reason,omitempty"`
+}
+
+// NewDeviceID validates a client-supplied device ID, generating one when it is empty
+func NewDeviceID(deviceID string) (string, error) {
+	deviceID = strings.TrimSpace(deviceID)
+	if deviceID == "" {
+		return uuid.New().String(), nil
+	}
+	if len(deviceID) > MaxDeviceIDLength {
+		return "", domain.NewValidationError("device_id", fmt.Sprintf("device ID must not exceed %d characters", MaxDeviceIDLength))
+	}
+	return deviceID, nil
+}
+
+// NewRefreshToken creates a refresh token and returns it with its plain value
+// An empty familyID starts a new family (a new login)
+func NewRefreshToken(userID, familyID, deviceID string, ttl time.Duration) (*RefreshToken, string, error) {
+	plain, err := newSecret()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	id := uuid.New().String()
+	if familyID == "" {
+		familyID = id
+	}
+
+	now := time.Now().UTC()
+	return &RefreshToken{
+		ID:        id,
+		UserID:    userID,
+		FamilyID:  familyID,
+		DeviceID:  deviceID,
+		TokenHash: HashRefreshToken(plain),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, plain, nil
+}
+
+// HashRefreshToken returns the stored form of a refresh token
+func HashRefreshToken(token string) string {
+	return hashSecret(token)
+}
+
+// IsRetired checks if the token was already rotated or revoked
+func (t *RefreshToken) IsRetired() bool {
+	return t.RotatedAt != nil || t.RevokedAt != nil
+}
+
+// IsExpired checks if the token is past its expiry
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
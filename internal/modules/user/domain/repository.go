@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// UserRepository defines the interface for user persistence
+type UserRepository interface {
+	// Save saves a user (create or update)
+	Save(ctx context.Context, user *User) error
+
+	// GetByID retrieves a user by ID
+	GetByID(ctx context.Context, id string) (*User, error)
+
+	// GetByEmail retrieves a user by normalized email
+	GetByEmail(ctx context.Context, email string) (*User, error)
+
+	// ExistsByEmail checks if a user exists by normalized email
+	ExistsByEmail(ctx context.Context, email string) (bool, error)
+}
+
+// RefreshTokenRepository defines the interface for refresh token persistence
+type RefreshTokenRepository interface {
+	// Create stores a new refresh token
+	Create(ctx context.Context, token *RefreshToken) error
+
+	// GetByHash retrieves a refresh token by the hash of its value
+	GetByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+
+	// Rotate retires current in favor of next and stores next atomically
+	// Returns ErrRefreshTokenUsed when current was rotated or revoked in the meantime
+	Rotate(ctx context.Context, current, next *RefreshToken) error
+
+	// RevokeFamily revokes every active token rotated from the same login
+	RevokeFamily(ctx context.Context, familyID, reason string) error
+
+	// RevokeByUser revokes every active token of a user
+	RevokeByUser(ctx context.Context, userID, reason string) error
+}
+
+// RoleRepository defines the interface for role persistence
+type RoleRepository interface {
+	// Save saves a role and its permissions (create or update)
+	Save(ctx context.Context, role *Role) error
+
+	// GetByName retrieves a role by name
+	GetByName(ctx context.Context, name string) (*Role, error)
+
+	// GetByNames retrieves the roles with the given names, skipping unknown names
+	GetByNames(ctx context.Context, names []string) ([]*Role, error)
+
+	// List retrieves all roles ordered by name
+	List(ctx context.Context) ([]*Role, error)
+
+	// Delete deletes a role, removing it from every user
+	Delete(ctx context.Context, name string) error
+
+	// CountActiveUsersWithRole counts the active users holding a role
+	CountActiveUsersWithRole(ctx context.Context, name string) (int64, error)
+}
+
+// APIKeyRepository defines the interface for API key persistence
+type APIKeyRepository interface {
+	// Save saves an API key (create or update)
+	Save(ctx context.Context, key *APIKey) error
+
+	// GetByID retrieves an API key by ID
+	GetByID(ctx context.Context, id string) (*APIKey, error)
+
+	// GetByHash retrieves an API key by the hash of its value
+	GetByHash(ctx context.Context, keyHash string) (*APIKey, error)
+
+	// List retrieves API keys, newest first
+	List(ctx context.Context, includeRevoked bool) ([]*APIKey, error)
+
+	// TouchLastUsed records that a key was used, at most about once a minute per key
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
+
+// SessionStore defines the interface for cookie session storage
+// Stores expire sessions themselves, so an expired session is reported as not found
+type SessionStore interface {
+	// Create stores a new session that expires after idleTimeout without use
+	Create(ctx context.Context, session *Session, idleTimeout time.Duration) error
+
+	// Get retrieves a session by the hash of its ID
+	Get(ctx context.Context, idHash string) (*Session, error)
+
+	// Touch records a request on the session and extends its idle expiry
+	Touch(ctx context.Context, session *Session, idleTimeout time.Duration) error
+
+	// Delete removes a session
+	Delete(ctx context.Context, session *Session) error
+
+	// DeleteByUser removes every session of a user
+	DeleteByUser(ctx context.Context, userID string) error
+}
+
+// EmailVerificationTokenRepository defines the interface for email verification token persistence
+type EmailVerificationTokenRepository interface {
+	// Create stores a new verification token
+	Create(ctx context.Context, token *EmailVerificationToken) error
+
+	// GetByHash retrieves a verification token by the hash of its value
+	GetByHash(ctx context.Context, tokenHash string) (*EmailVerificationToken, error)
+
+	// MarkUsed marks an unused token as used, returning ErrVerificationTokenUsed when it was used already
+	MarkUsed(ctx context.Context, token *EmailVerificationToken) error
+}
+
+// TwoFactorChallengeRepository defines the interface for login challenge persistence
+type TwoFactorChallengeRepository interface {
+	// Create stores a new challenge
+	Create(ctx context.Context, challenge *TwoFactorChallenge) error
+
+	// GetByHash retrieves a challenge by the hash of its token
+	GetByHash(ctx context.Context, tokenHash string) (*TwoFactorChallenge, error)
+
+	// RecordAttempt counts a wrong code entered for the challenge
+	RecordAttempt(ctx context.Context, challenge *TwoFactorChallenge) error
+
+	// MarkUsed marks an unused challenge as used, returning ErrTwoFactorChallengeUsed when it was used already
+	MarkUsed(ctx context.Context, challenge *TwoFactorChallenge) error
+}
+
+// SecurityEventRepository defines the interface for security event persistence
+type SecurityEventRepository interface {
+	// Record stores a security event
+	Record(ctx context.Context, event *SecurityEvent) error
+
+	// List retrieves a page of the events matching the filter, newest first
+	List(ctx context.Context, filter SecurityEventFilter) (*SecurityEventListResult, error)
+}
+
+// ServiceAccountRepository defines the interface for service account persistence
+type ServiceAccountRepository interface {
+	// Save saves a service account (create or update)
+	Save(ctx context.Context, account *ServiceAccount) error
+
+	// GetByID retrieves a service account by ID
+	GetByID(ctx context.Context, id string) (*ServiceAccount, error)
+
+	// List retrieves service accounts ordered by name
+	List(ctx context.Context, includeDisabled bool) ([]*ServiceAccount, error)
+
+	// TouchLastUsed records that an account obtained a token, at most about once a minute per account
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Built-in roles, created by the migrations
+// admin holds every permission; user is the default role of registered users
+const (
+	RoleAdmin = "admin"
+	RoleUser  = "user"
+)
+
+// PermissionAll grants every permission; "<resource>:*" grants every action on a resource
+const PermissionAll = "*"
+
+// Permissions checked by the user module
+const (
+	PermissionRolesRead             = "roles:read"
+	PermissionRolesManage           = "roles:manage"
+	PermissionUsersAssignRoles      = "users:assign_roles"
+	PermissionUsersUnlock           = "users:unlock"
+	PermissionUsersManage           = "users:manage"
+	PermissionUsersImpersonate      = "users:impersonate"
+	PermissionAPIKeysRead           = "api_keys:read"
+	PermissionAPIKeysManage         = "api_keys:manage"
+	PermissionSecurityEventsRead    = "security_events:read"
+	PermissionServiceAccountsRead   = "service_accounts:read"
+	PermissionServiceAccountsManage = "service_accounts:manage"
+)
+
+// PolicyVerifiedEmail requires a user with a verified email address (authz.PolicyVerifiedEmail)
+const PolicyVerifiedEmail = "policy:verified_email"
+
+var (
+	// roleNameRegex restricts role names to lower-case identifiers
+	roleNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,63}$`)
+	// permissionRegex matches "<resource>:<action>" permissions and their wildcards
+	permissionRegex = regexp.MustCompile(`^(\*|[a-z][a-z0-9_]*:(\*|[a-z][a-z0-9_]*))$`)
+)
+
+// MaxRoleDescriptionLength is the maximum length of a role description
+const MaxRoleDescriptionLength = 255
+
+// Role is a named set of permissions assigned to users
+type Role struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NewRole creates a new role
+func NewRole(name, description string, permissions []string) (*Role, error) {
+	var validationErrors domain.ValidationErrors
+
+	name = strings.TrimSpace(strings.ToLower(name))
+	if !roleNameRegex.MatchString(name) {
+		validationErrors.AddWithValue("name", "role name must be a lower-case identifier of at most 64 characters", name)
+	}
+
+	description = strings.TrimSpace(description)
+	if len(description) > MaxRoleDescriptionLength {
+		validationErrors.Add("description", fmt.Sprintf("description must not exceed %d characters", MaxRoleDescriptionLength))
+	}
+
+	normalized, err := NormalizePermissions(permissions)
+	if err != nil {
+		if permissionErrs, ok := err.(domain.ValidationErrors); ok {
+			validationErrors = append(validationErrors, permissionErrs...)
+		} else {
+			return nil, err
+		}
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	now := time.Now().UTC()
+	return &Role{
+		Name:        name,
+		Description: description,
+		Permissions: normalized,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// NormalizePermissions validates permissions and returns them sorted without duplicates
+func NormalizePermissions(permissions []string) ([]string, error) {
+	var validationErrors domain.ValidationErrors
+
+	seen := make(map[string]bool, len(permissions))
+	normalized := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		permission = strings.TrimSpace(strings.ToLower(permission))
+		if !permissionRegex.MatchString(permission) {
+			validationErrors.AddWithValue("permissions", "permission must be \"*\" or \"<resource>:<action>\"", permission)
+			continue
+		}
+		if strings.HasPrefix(permission, "policy:") {
+			validationErrors.AddWithValue("permissions", "policies are checked, not granted", permission)
+			continue
+		}
+		if !seen[permission] {
+			seen[permission] = true
+			normalized = append(normalized, permission)
+		}
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// Update changes the role's description and, when permissions is not nil, its permissions
+func (r *Role) Update(description *string, permissions []string) error {
+	if description != nil {
+		trimmed := strings.TrimSpace(*description)
+		if len(trimmed) > MaxRoleDescriptionLength {
+			return domain.NewValidationError("description", fmt.Sprintf("description must not exceed %d characters", MaxRoleDescriptionLength))
+		}
+		r.Description = trimmed
+	}
+
+	if permissions != nil {
+		if r.Name == RoleAdmin {
+			return domain.NewBusinessRuleError("builtin_role", "the permissions of the admin role cannot be changed")
+		}
+
+		normalized, err := NormalizePermissions(permissions)
+		if err != nil {
+			return err
+		}
+		r.Permissions = normalized
+	}
+
+	r.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// IsBuiltIn checks if the role is created by the migrations and cannot be deleted
+func (r *Role) IsBuiltIn() bool {
+	return r.Name == RoleAdmin || r.Name == RoleUser
+}
+
+// Grants checks if the role holds a permission, directly or through a wildcard
+func (r *Role) Grants(permission string) bool {
+	return PermissionGranted(r.Permissions, permission)
+}
+
+// PermissionGranted checks if a permission is among the granted ones, directly or through a wildcard
+func PermissionGranted(granted []string, permission string) bool {
+	resource, _, _ := strings.Cut(permission, ":")
+	for _, g := range granted {
+		if g == PermissionAll || g == permission || g == resource+":*" {
+			return true
+		}
+	}
+	return false
+}
+
+// UserHasPermission checks if an active user holds a permission through any of their roles
+func UserHasPermission(ctx context.Context, roles RoleRepository, user *User, permission string) (bool, error) {
+	if !user.IsActive() {
+		return false, nil
+	}
+
+	userRoles, err := roles.GetByNames(ctx, user.Roles)
+	if err != nil {
+		return false, err
+	}
+
+	for _, role := range userRoles {
+		if role.Grants(permission) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
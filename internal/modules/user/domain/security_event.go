@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Security event types
+const (
+	SecurityEventLoginSucceeded         = "login_succeeded"
+	SecurityEventLoginFailed            = "login_failed"
+	SecurityEventAccountLocked          = "account_locked"
+	SecurityEventTokenRefreshed         = "token_refreshed"
+	SecurityEventTokenReuseDetected     = "token_reuse_detected"
+	SecurityEventPasswordChanged        = "password_changed"
+	SecurityEventPasswordChangeRequired = "password_change_required"
+	SecurityEventRoleAssigned           = "role_assigned"
+	SecurityEventRoleUnassigned         = "role_unassigned"
+	SecurityEventAccountDeactivated     = "account_deactivated"
+	SecurityEventAccountActivated       = "account_activated"
+	SecurityEventServiceTokenIssued     = "service_account_token_issued"
+	SecurityEventServiceAuthFailed      = "service_account_auth_failed"
+	SecurityEventImpersonationStarted   = "impersonation_started"
+)
+
+// IsSecurityEventType checks if a type is one of the recorded security event types
+func IsSecurityEventType(eventType string) bool {
+	switch eventType {
+	case SecurityEventLoginSucceeded, SecurityEventLoginFailed, SecurityEventAccountLocked,
+		SecurityEventTokenRefreshed, SecurityEventTokenReuseDetected,
+		SecurityEventPasswordChanged, SecurityEventPasswordChangeRequired,
+		SecurityEventRoleAssigned, SecurityEventRoleUnassigned,
+		SecurityEventAccountDeactivated, SecurityEventAccountActivated,
+		SecurityEventServiceTokenIssued, SecurityEventServiceAuthFailed,
+		SecurityEventImpersonationStarted:
+		return true
+	}
+	return false
+}
+
+// SecurityEvent is an audit record of an authentication or authorization change
+// Unlike domain events, security events are kept for review, including failures that change no
+// state. UserID is empty for failed logins with an unknown email, when Email holds what was entered,
+// and for events of service accounts, which are their Actor.
+// Actor is who caused the event (shared domain.Actor), empty when nobody could be identified;
+// Detail is a short reason such as the failure cause or the role concerned.
+type SecurityEvent struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	UserID     string    `json:"user_id,omitempty"`
+	Email      string    `json:"email,omitempty"`
+	Actor      string    `json:"actor,omitempty"`
+	IPAddress  string    `json:"ip_address,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+	Detail     string    `json:"detail,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// NewSecurityEvent creates a security event that occurred now
+func NewSecurityEvent(eventType, userID, email, actor, ipAddress, userAgent, detail string) *SecurityEvent {
+	return &SecurityEvent{
+		ID:         uuid.New().String(),
+		Type:       eventType,
+		UserID:     userID,
+		Email:      email,
+		Actor:      actor,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		Detail:     detail,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+// SecurityEventFilter selects a page of security events; empty fields match every event
+type SecurityEventFilter struct {
+	// Pagination
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+
+	// Filtering
+	UserID    string `json:"user_id,omitempty"`
+	Type      string `json:"type,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+
+	// Date filtering (Since inclusive, Until exclusive)
+	Since *time.Time `json:"since,omitempty"`
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// Validate validates the filter, defaulting the pagination
+func (f *SecurityEventFilter) Validate() error {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+
+	if f.Limit <= 0 {
+		f.Limit = 50
+	}
+
+	// Maximum limit
+	if f.Limit > 100 {
+		f.Limit = 100
+	}
+
+	if f.Type != "" && !IsSecurityEventType(f.Type) {
+		return domain.NewValidationErrorWithValue("type", "unknown security event type", f.Type)
+	}
+
+	if f.Since != nil && f.Until != nil && !f.Since.Before(*f.Until) {
+		return domain.NewValidationError("since", "since must be earlier than until")
+	}
+
+	return nil
+}
+
+// GetOffset calculates the offset for pagination
+func (f *SecurityEventFilter) GetOffset() int {
+	return (f.Page - 1) * f.Limit
+}
+
+// SecurityEventListResult represents a page of security events
+type SecurityEventListResult struct {
+	Events     []*SecurityEvent `json:"events"`
+	Pagination PaginationResult `json:"pagination"`
+}
+
+// PaginationResult represents pagination information
+type PaginationResult struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"total_pages"`
+	HasNext    bool  `json:"has_next"`
+	HasPrev    bool  `json:"has_prev"`
+}
+
+// NewPaginationResult creates a new pagination result
+func NewPaginationResult(page, limit int, total int64) PaginationResult {
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return PaginationResult{
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+}
@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// ServiceAccountSecretPrefix starts every service account secret, so leaked secrets are easy to recognize
+const ServiceAccountSecretPrefix = "mms_"
+
+// Service account limits
+const (
+	MaxServiceAccountNameLength        = 100
+	MaxServiceAccountDescriptionLength = 255
+)
+
+// Service account token lifetimes
+const (
+	DefaultServiceTokenTTL = 15 * time.Minute
+	MaxServiceTokenTTL     = time.Hour
+)
+
+// ServiceAccount is a non-human principal such as a batch job or operational tooling
+// It holds a long-lived secret that is exchanged for short-lived access tokens carrying a subset
+// of the account's scopes; the ID doubles as the client ID. Only the SHA-256 hash of the secret is
+// stored. Unlike API keys, the secret itself is never accepted by API routes.
+type ServiceAccount struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	SecretHash  string     `json:"-"`
+	Scopes      []string   `json:"scopes"`
+	CreatedBy   string     `json:"created_by,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	DisabledAt  *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// NewServiceAccount creates a service account and returns it with its plain secret
+// The plain secret is shown once
+func NewServiceAccount(name, description string, scopes []string, createdBy string) (*ServiceAccount, string, error) {
+	var validationErrors domain.ValidationErrors
+
+	name = strings.Join(strings.Fields(name), " ")
+	if name == "" {
+		validationErrors.Add("name", "name is required")
+	} else if len(name) > MaxServiceAccountNameLength {
+		validationErrors.Add("name", fmt.Sprintf("name must not exceed %d characters", MaxServiceAccountNameLength))
+	}
+
+	description = strings.TrimSpace(description)
+	if len(description) > MaxServiceAccountDescriptionLength {
+		validationErrors.Add("description", fmt.Sprintf("description must not exceed %d characters", MaxServiceAccountDescriptionLength))
+	}
+
+	normalized, err := NormalizePermissions(scopes)
+	if err != nil {
+		if scopeErrs, ok := err.(domain.ValidationErrors); ok {
+			for _, scopeErr := range scopeErrs {
+				scopeErr.Field = "scopes"
+				validationErrors = append(validationErrors, scopeErr)
+			}
+		} else {
+			return nil, "", err
+		}
+	} else if len(normalized) == 0 {
+		validationErrors.Add("scopes", "at least one scope is required")
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, "", validationErrors
+	}
+
+	now := time.Now().UTC()
+	account := &ServiceAccount{
+		ID:          uuid.New().String(),
+		Name:        name,
+		Description: description,
+		Scopes:      normalized,
+		CreatedBy:   createdBy,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	plain, err := account.RotateSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return account, plain, nil
+}
+
+// HashServiceAccountSecret returns the stored form of a service account secret
+func HashServiceAccountSecret(secret string) string {
+	return hashSecret(secret)
+}
+
+// RotateSecret replaces the account's secret and returns the new plain secret
+// Tokens issued with the old secret stay valid until they expire
+func (a *ServiceAccount) RotateSecret() (string, error) {
+	secret, err := newSecret()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate service account secret: %w", err)
+	}
+	plain := ServiceAccountSecretPrefix + secret
+
+	a.SecretHash = HashServiceAccountSecret(plain)
+	a.UpdatedAt = time.Now().UTC()
+	return plain, nil
+}
+
+// VerifySecret checks a plain secret against the stored hash in constant time
+func (a *ServiceAccount) VerifySecret(secret string) bool {
+	hash := HashServiceAccountSecret(secret)
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(a.SecretHash)) == 1
+}
+
+// IsActive checks if the account can obtain and use tokens
+func (a *ServiceAccount) IsActive() bool {
+	return a.DisabledAt == nil
+}
+
+// Disable stops the account from obtaining tokens and makes its issued tokens useless;
+// disabling a disabled account has no effect
+func (a *ServiceAccount) Disable() {
+	if a.DisabledAt != nil {
+		return
+	}
+	now := time.Now().UTC()
+	a.DisabledAt = &now
+	a.UpdatedAt = now
+}
+
+// TokenScopes returns the scopes of a token requested with the given scopes
+// Every requested scope must be granted by the account; no requested scope means all of them
+func (a *ServiceAccount) TokenScopes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return a.Scopes, nil
+	}
+
+	normalized, err := NormalizePermissions(requested)
+	if err != nil {
+		return nil, err
+	}
+	for _, scope := range normalized {
+		if !PermissionGranted(a.Scopes, scope) {
+			return nil, domain.NewDomainErrorWithField(
+				domain.ErrCodeForbidden,
+				fmt.Sprintf("scope %s is not granted to the service account", scope),
+				"scope",
+			)
+		}
+	}
+	return normalized, nil
+}
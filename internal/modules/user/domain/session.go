@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Session stores, selected with user.authentication.session_store
+const (
+	SessionStoreJWT   = "jwt"   // bearer access tokens with rotating refresh tokens
+	SessionStoreRedis = "redis" // cookie sessions stored in Redis, for browser clients
+)
+
+// Session lifetimes
+const (
+	DefaultSessionIdleTimeout = 24 * time.Hour
+	DefaultSessionMaxLifetime = 7 * 24 * time.Hour
+)
+
+// Session is a server-side login of a browser client
+// The client holds the plain session ID in a cookie; only its SHA-256 hash is stored.
+// Sessions expire after IdleTimeout without requests (sliding expiry) and at ExpiresAt at the latest.
+type Session struct {
+	IDHash     string    `json:"id_hash"`
+	UserID     string    `json:"user_id"`
+	Email      string    `json:"email"`
+	TenantID   string    `json:"tenant_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// NewSession creates a session for the user and returns it with its plain ID
+func NewSession(user *User, maxLifetime time.Duration) (*Session, string, error) {
+	plain, err := newSecret()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate session ID: %w", err)
+	}
+
+	now := time.Now().UTC()
+	return &Session{
+		IDHash:     HashSessionID(plain),
+		UserID:     user.GetID(),
+		Email:      user.Email.Value,
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(maxLifetime),
+	}, plain, nil
+}
+
+// HashSessionID returns the stored form of a session ID
+func HashSessionID(id string) string {
+	return hashSecret(id)
+}
+
+// IdleExpiry returns when the session expires if no further request arrives
+func (s *Session) IdleExpiry(idleTimeout time.Duration) time.Time {
+	expiry := s.LastSeenAt.Add(idleTimeout)
+	if expiry.After(s.ExpiresAt) {
+		return s.ExpiresAt
+	}
+	return expiry
+}
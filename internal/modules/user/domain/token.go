@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// AccessToken is a signed token identifying an authenticated user or service account
+type AccessToken struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// TokenIssuer issues access tokens for authenticated users and service accounts
+// Tokens issued while ctx serves a tenant are valid in that tenant only
+type TokenIssuer interface {
+	// IssueAccessToken creates an access token for the user
+	IssueAccessToken(ctx context.Context, user *User) (AccessToken, error)
+
+	// IssueServiceAccountToken creates an access token for the service account holding the scopes
+	// that expires after ttl
+	IssueServiceAccountToken(ctx context.Context, account *ServiceAccount, scopes []string, ttl time.Duration) (AccessToken, error)
+
+	// IssueImpersonationToken creates an access token for the user that names the impersonating
+	// user and expires after ttl
+	IssueImpersonationToken(ctx context.Context, user *User, impersonatorID string, ttl time.Duration) (AccessToken, error)
+}
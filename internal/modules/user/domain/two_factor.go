@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Two-factor authentication defaults
+const (
+	// DefaultTwoFactorChallengeTTL is how long the second login step may take
+	DefaultTwoFactorChallengeTTL = 5 * time.Minute
+
+	// MaxTwoFactorChallengeAttempts is the number of wrong codes a login challenge accepts
+	MaxTwoFactorChallengeAttempts = 5
+
+	// RecoveryCodeCount is the number of recovery codes issued on enrollment
+	RecoveryCodeCount = 10
+)
+
+// recoveryCodeAlphabet has 32 characters, so random bytes map onto it without bias
+// It leaves out i, l, o and 1, which are easily confused
+const recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz023456789"
+
+// ErrTwoFactorChallengeUsed is returned when a login challenge was completed concurrently
+var ErrTwoFactorChallengeUsed = errors.New("two-factor challenge already used")
+
+// TOTP generates and verifies time-based one-time passwords (RFC 6238)
+type TOTP interface {
+	// GenerateSecret returns a new base32-encoded shared secret
+	GenerateSecret() (string, error)
+
+	// ProvisioningURI returns the otpauth:// URI that authenticator apps import, usually as a QR code
+	ProvisioningURI(secret, accountName string) string
+
+	// Verify checks a code against the secret and returns the time step it belongs to
+	Verify(secret, code string, now time.Time) (int64, bool)
+}
+
+// TwoFactorPolicy decides which users must use two-factor authentication
+type TwoFactorPolicy struct {
+	RequiredRoles []string
+}
+
+// Requires checks if the user holds a role that requires two-factor authentication
+func (p TwoFactorPolicy) Requires(user *User) bool {
+	for _, role := range p.RequiredRoles {
+		if user.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewRecoveryCodes generates single-use recovery codes and returns them with their stored hashes
+func NewRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, RecoveryCodeCount)
+	hashes := make([]string, RecoveryCodeCount)
+
+	random := make([]byte, 10)
+	for i := range codes {
+		if _, err := rand.Read(random); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+
+		var code strings.Builder
+		for j, b := range random {
+			if j == 5 {
+				code.WriteByte('-')
+			}
+			code.WriteByte(recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)])
+		}
+
+		codes[i] = code.String()
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode returns the stored form of a recovery code
+// Case, spaces and dashes are ignored, so codes can be typed as they are read
+func HashRecoveryCode(code string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(code)))
+	return hashSecret(normalized)
+}
+
+// TwoFactorChallenge is the pending second step of a login whose password was verified
+// Only the SHA-256 hash of the challenge token is stored
+type TwoFactorChallenge struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	TokenHash string     `json:"-"`
+	DeviceID  string     `json:"device_id,omitempty"`
+	Attempts  int        `json:"attempts"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// NewTwoFactorChallenge creates a login challenge for the user and returns it with its plain token
+// DeviceID carries the device of token logins to the issued refresh token
+func NewTwoFactorChallenge(user *User, deviceID string, ttl time.Duration) (*TwoFactorChallenge, string, error) {
+	plain, err := newSecret()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate two-factor challenge: %w", err)
+	}
+
+	now := time.Now().UTC()
+	return &TwoFactorChallenge{
+		ID:        uuid.New().String(),
+		UserID:    user.GetID(),
+		TokenHash: HashTwoFactorChallengeToken(plain),
+		DeviceID:  deviceID,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, plain, nil
+}
+
+// HashTwoFactorChallengeToken returns the stored form of a challenge token
+func HashTwoFactorChallengeToken(token string) string {
+	return hashSecret(token)
+}
+
+// IsUsable checks if the challenge can still complete a login
+func (c *TwoFactorChallenge) IsUsable(now time.Time) bool {
+	return c.UsedAt == nil && now.Before(c.ExpiresAt) && c.Attempts < MaxTwoFactorChallengeAttempts
+}
+
+// consumeRecoveryCode removes a matching recovery code from the hashes
+func consumeRecoveryCode(hashes []string, code string) ([]string, bool) {
+	hash := HashRecoveryCode(code)
+	for i, stored := range hashes {
+		if subtle.ConstantTimeCompare([]byte(stored), []byte(hash)) == 1 {
+			remaining := make([]string, 0, len(hashes)-1)
+			remaining = append(remaining, hashes[:i]...)
+			return append(remaining, hashes[i+1:]...), true
+		}
+	}
+	return hashes, false
+}
@@ -0,0 +1,427 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// UserStatus represents the status of a user account
+type UserStatus string
+
+const (
+	UserStatusActive   UserStatus = "active"
+	UserStatusDisabled UserStatus = "disabled"
+)
+
+// IsValid checks if the user status is known
+func (s UserStatus) IsValid() bool {
+	switch s {
+	case UserStatusActive, UserStatusDisabled:
+		return true
+	}
+	return false
+}
+
+// MaxUserNameLength is the maximum length of a user's display name
+const MaxUserNameLength = 255
+
+// emailRegex matches normalized (trimmed, lower-cased) email addresses
+var emailRegex = regexp.MustCompile(`^[a-z0-9._%+\-]+@[a-z0-9.\-]+\.[a-z]{2,}$`)
+
+// Email represents a user's login email value object
+type Email struct {
+	Value string `json:"value"`
+}
+
+// NewEmail creates a new email value object
+func NewEmail(email string) (Email, error) {
+	email = strings.TrimSpace(strings.ToLower(email))
+	if email == "" {
+		return Email{}, domain.NewValidationError("email", "email is required")
+	}
+
+	if len(email) > 255 || !emailRegex.MatchString(email) {
+		return Email{}, domain.NewValidationErrorWithValue("email", "invalid email format", email)
+	}
+
+	return Email{Value: email}, nil
+}
+
+// String returns the email as string
+func (e Email) String() string {
+	return e.Value
+}
+
+// User represents the user account aggregate root
+// PasswordHash is an encoded hash produced by a PasswordHasher; the plain password is never stored
+// Roles holds the names of the user's roles, sorted
+type User struct {
+	domain.BaseAggregateRoot
+	Email           Email      `json:"email"`
+	Name            string     `json:"name"`
+	PasswordHash    string     `json:"-"`
+	Status          UserStatus `json:"status"`
+	Roles           []string   `json:"roles"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+
+	// PasswordChangeRequired blocks logins until the user chooses a new password,
+	// e.g. after an administrator created the account or forced a password reset
+	PasswordChangeRequired bool `json:"password_change_required"`
+
+	// Brute-force protection: consecutive failed logins, and the current lock
+	// LockCount counts consecutive locks, so each lock lasts longer than the previous one
+	FailedLoginAttempts int        `json:"-"`
+	LockCount           int        `json:"-"`
+	LockedUntil         *time.Time `json:"locked_until,omitempty"`
+
+	// Two-factor authentication: the confirmed TOTP secret, a secret awaiting confirmation during
+	// enrollment, the last accepted time step (codes cannot be replayed) and unused recovery codes
+	TwoFactorSecret        string     `json:"-"`
+	TwoFactorPendingSecret string     `json:"-"`
+	TwoFactorEnabledAt     *time.Time `json:"two_factor_enabled_at,omitempty"`
+	TwoFactorLastStep      int64      `json:"-"`
+	RecoveryCodeHashes     []string   `json:"-"`
+}
+
+// RegisterUser creates a new active user account with the given roles
+// The password must already satisfy the password policy and be hashed
+func RegisterUser(email, name, passwordHash string, roles ...string) (*User, error) {
+	// Validate input
+	var validationErrors domain.ValidationErrors
+
+	userEmail, err := NewEmail(email)
+	if err != nil {
+		if validationErr, ok := err.(domain.ValidationError); ok {
+			validationErrors = append(validationErrors, validationErr)
+		} else {
+			return nil, err
+		}
+	}
+
+	name = strings.Join(strings.Fields(name), " ")
+	if len(name) > MaxUserNameLength {
+		validationErrors.Add("name", fmt.Sprintf("name must not exceed %d characters", MaxUserNameLength))
+	}
+
+	if passwordHash == "" {
+		validationErrors.Add("password", "password is required")
+	}
+
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	// Create user
+	user := &User{
+		BaseAggregateRoot: domain.NewBaseAggregateRoot(),
+		Email:             userEmail,
+		Name:              name,
+		PasswordHash:      passwordHash,
+		Status:            UserStatusActive,
+		Roles:             []string{},
+	}
+	for _, role := range roles {
+		user.addRole(role)
+	}
+
+	// Add domain event
+	user.AddEvent(NewUserRegisteredEvent(user))
+
+	return user, nil
+}
+
+// IsActive checks if the user can sign in
+func (u *User) IsActive() bool {
+	return u.Status == UserStatusActive
+}
+
+// IsEmailVerified checks if the user confirmed their email address
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// VerifyEmail marks the user's email address as confirmed
+// Verifying a verified email has no effect
+func (u *User) VerifyEmail() {
+	if u.IsEmailVerified() {
+		return
+	}
+
+	now := time.Now().UTC()
+	u.EmailVerifiedAt = &now
+	u.IncrementVersion()
+
+	// Add domain event
+	u.AddEvent(NewUserEmailVerifiedEvent(u))
+}
+
+// IsTwoFactorEnabled checks if logins of the user require a second factor
+func (u *User) IsTwoFactorEnabled() bool {
+	return u.TwoFactorEnabledAt != nil
+}
+
+// BeginTwoFactorEnrollment stores a TOTP secret that EnableTwoFactor confirms
+// Beginning again replaces the pending secret
+func (u *User) BeginTwoFactorEnrollment(secret string) error {
+	if u.IsTwoFactorEnabled() {
+		return domain.NewBusinessRuleError("two_factor_enabled", "two-factor authentication is already enabled")
+	}
+
+	u.TwoFactorPendingSecret = secret
+	u.IncrementVersion()
+	return nil
+}
+
+// EnableTwoFactor confirms the pending TOTP secret with a code generated from it
+// recoveryCodeHashes replace any previous recovery codes
+func (u *User) EnableTwoFactor(totp TOTP, code string, recoveryCodeHashes []string, now time.Time) error {
+	if u.IsTwoFactorEnabled() {
+		return domain.NewBusinessRuleError("two_factor_enabled", "two-factor authentication is already enabled")
+	}
+	if u.TwoFactorPendingSecret == "" {
+		return domain.NewBusinessRuleError("two_factor_not_enrolled", "two-factor enrollment has not been started")
+	}
+
+	step, ok := totp.Verify(u.TwoFactorPendingSecret, code, now)
+	if !ok {
+		return domain.NewValidationError("code", "invalid two-factor code")
+	}
+
+	enabledAt := now.UTC()
+	u.TwoFactorSecret = u.TwoFactorPendingSecret
+	u.TwoFactorPendingSecret = ""
+	u.TwoFactorEnabledAt = &enabledAt
+	u.TwoFactorLastStep = step
+	u.RecoveryCodeHashes = recoveryCodeHashes
+	u.IncrementVersion()
+
+	// Add domain event
+	u.AddEvent(NewUserTwoFactorEnabledEvent(u))
+	return nil
+}
+
+// DisableTwoFactor removes the TOTP secret and recovery codes
+// Disabling when two-factor authentication is off has no effect
+func (u *User) DisableTwoFactor() {
+	if !u.IsTwoFactorEnabled() && u.TwoFactorPendingSecret == "" {
+		return
+	}
+
+	wasEnabled := u.IsTwoFactorEnabled()
+	u.TwoFactorSecret = ""
+	u.TwoFactorPendingSecret = ""
+	u.TwoFactorEnabledAt = nil
+	u.TwoFactorLastStep = 0
+	u.RecoveryCodeHashes = nil
+	u.IncrementVersion()
+
+	if wasEnabled {
+		// Add domain event
+		u.AddEvent(NewUserTwoFactorDisabledEvent(u))
+	}
+}
+
+// VerifySecondFactor checks a TOTP code or an unused recovery code
+// Accepted TOTP time steps and recovery codes cannot be used again
+func (u *User) VerifySecondFactor(totp TOTP, code string, now time.Time) bool {
+	if !u.IsTwoFactorEnabled() {
+		return false
+	}
+
+	code = strings.TrimSpace(code)
+	if step, ok := totp.Verify(u.TwoFactorSecret, code, now); ok {
+		if step <= u.TwoFactorLastStep {
+			return false
+		}
+		u.TwoFactorLastStep = step
+		u.IncrementVersion()
+		return true
+	}
+
+	remaining, ok := consumeRecoveryCode(u.RecoveryCodeHashes, code)
+	if !ok {
+		return false
+	}
+	u.RecoveryCodeHashes = remaining
+	u.IncrementVersion()
+	return true
+}
+
+// ReplaceRecoveryCodes invalidates the unused recovery codes in favor of new ones
+func (u *User) ReplaceRecoveryCodes(hashes []string) error {
+	if !u.IsTwoFactorEnabled() {
+		return domain.NewBusinessRuleError("two_factor_disabled", "two-factor authentication is not enabled")
+	}
+
+	u.RecoveryCodeHashes = hashes
+	u.IncrementVersion()
+	return nil
+}
+
+// IsLocked checks if the account is locked after too many failed logins
+func (u *User) IsLocked(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
+}
+
+// RecordFailedLogin counts a failed login, locking the account once the policy's threshold is reached
+// It reports whether the account was locked
+func (u *User) RecordFailedLogin(policy LockoutPolicy, now time.Time, ip string) bool {
+	u.FailedLoginAttempts++
+	u.IncrementVersion()
+
+	if u.FailedLoginAttempts < policy.MaxFailedAttempts {
+		return false
+	}
+
+	attempts := u.FailedLoginAttempts
+	u.LockCount++
+	lockedUntil := now.UTC().Add(policy.LockDuration(u.LockCount))
+	u.LockedUntil = &lockedUntil
+	u.FailedLoginAttempts = 0
+
+	// Add domain event
+	u.AddEvent(NewUserAccountLockedEvent(u, attempts, ip))
+	return true
+}
+
+// RecordSuccessfulLogin clears the failed login count and the lock history
+// It reports whether anything changed, so unchanged users need not be saved
+func (u *User) RecordSuccessfulLogin() bool {
+	if u.FailedLoginAttempts == 0 && u.LockCount == 0 && u.LockedUntil == nil {
+		return false
+	}
+
+	u.FailedLoginAttempts = 0
+	u.LockCount = 0
+	u.LockedUntil = nil
+	u.IncrementVersion()
+	return true
+}
+
+// Unlock lifts a lock and clears the failed login count
+// Unlocking an account that is not locked has no effect
+func (u *User) Unlock(now time.Time) {
+	if !u.IsLocked(now) && u.FailedLoginAttempts == 0 {
+		return
+	}
+
+	u.FailedLoginAttempts = 0
+	u.LockCount = 0
+	u.LockedUntil = nil
+	u.IncrementVersion()
+
+	// Add domain event
+	u.AddEvent(NewUserAccountUnlockedEvent(u))
+}
+
+// Deactivate disables the account so the user can no longer sign in
+// Deactivating a disabled account has no effect
+func (u *User) Deactivate() {
+	if !u.IsActive() {
+		return
+	}
+
+	u.Status = UserStatusDisabled
+	u.IncrementVersion()
+
+	// Add domain event
+	u.AddEvent(NewUserDeactivatedEvent(u))
+}
+
+// Activate enables a disabled account again
+// Activating an active account has no effect
+func (u *User) Activate() {
+	if u.IsActive() {
+		return
+	}
+
+	u.Status = UserStatusActive
+	u.IncrementVersion()
+
+	// Add domain event
+	u.AddEvent(NewUserActivatedEvent(u))
+}
+
+// RequirePasswordChange makes the user choose a new password before the next login
+// Requiring a change that is already required has no effect
+func (u *User) RequirePasswordChange() {
+	if u.PasswordChangeRequired {
+		return
+	}
+
+	u.PasswordChangeRequired = true
+	u.IncrementVersion()
+
+	// Add domain event
+	u.AddEvent(NewUserPasswordChangeRequiredEvent(u))
+}
+
+// ChangePassword replaces the password hash and clears a required password change
+// The new password must already satisfy the password policy and be hashed
+func (u *User) ChangePassword(passwordHash string) error {
+	if passwordHash == "" {
+		return domain.NewValidationError("password", "password is required")
+	}
+
+	u.PasswordHash = passwordHash
+	u.PasswordChangeRequired = false
+	u.IncrementVersion()
+
+	// Add domain event
+	u.AddEvent(NewUserPasswordChangedEvent(u))
+	return nil
+}
+
+// HasRole checks if the user holds a role
+func (u *User) HasRole(role string) bool {
+	for _, existing := range u.Roles {
+		if existing == role {
+			return true
+		}
+	}
+	return false
+}
+
+// AssignRole gives the user a role
+func (u *User) AssignRole(role string) {
+	if !u.addRole(role) {
+		return
+	}
+
+	u.IncrementVersion()
+
+	// Add domain event
+	u.AddEvent(NewUserRoleAssignedEvent(u, role))
+}
+
+// UnassignRole takes a role away from the user
+func (u *User) UnassignRole(role string) {
+	for i, existing := range u.Roles {
+		if existing != role {
+			continue
+		}
+
+		u.Roles = append(u.Roles[:i:i], u.Roles[i+1:]...)
+		u.IncrementVersion()
+
+		// Add domain event
+		u.AddEvent(NewUserRoleUnassignedEvent(u, role))
+		return
+	}
+}
+
+// addRole adds a role to the sorted role list, reporting whether it was missing
+func (u *User) addRole(role string) bool {
+	if u.HasRole(role) {
+		return false
+	}
+
+	u.Roles = append(u.Roles, role)
+	sort.Strings(u.Roles)
+	return true
+}
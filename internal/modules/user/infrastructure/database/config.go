@@ -0,0 +1,18 @@
+package database
+
+import (
+	"golang_modular_monolith/internal/shared/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// UserDatabaseName is the identifier for user database
+	UserDatabaseName = "user"
+)
+
+// GetUserDB returns the user database connection
+func GetUserDB() (*gorm.DB, error) {
+	manager := database.GetGlobalManager()
+	return manager.GetConnection(UserDatabaseName)
+}
@@ -0,0 +1,94 @@
+package eventhandlers
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"net/url"
+	"strings"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// VerificationEmailHandler sends the verification email of user.email_verification_requested
+type VerificationEmailHandler struct {
+	mailer  shareddomain.Mailer
+	linkURL *url.URL
+}
+
+// NewVerificationEmailHandler creates a handler sending verification links to linkURL, which
+// receives the token in its token query parameter
+func NewVerificationEmailHandler(mailer shareddomain.Mailer, linkURL string) (*VerificationEmailHandler, error) {
+	link, err := url.Parse(linkURL)
+	if err != nil || !link.IsAbs() {
+		return nil, fmt.Errorf("email verification url must be an absolute url, got %q", linkURL)
+	}
+	return &VerificationEmailHandler{
+		mailer:  mailer,
+		linkURL: link,
+	}, nil
+}
+
+// CanHandle reports whether the handler is interested in the event type
+func (h *VerificationEmailHandler) CanHandle(eventType string) bool {
+	return eventType == domain.UserEmailVerificationRequestedEventType
+}
+
+// Handle sends the email with the verification link
+// The event ID doubles as the Message-ID, so mail clients recognize the duplicate sent for a
+// redelivered event
+func (h *VerificationEmailHandler) Handle(event shareddomain.DomainEvent) error {
+	requested, ok := event.(domain.UserEmailVerificationRequestedEvent)
+	if !ok {
+		return fmt.Errorf("unsupported event %T for verification email handler", event)
+	}
+
+	link := h.link(requested.Token)
+	greeting := "Hello,"
+	if requested.Name != "" {
+		greeting = "Hello " + requested.Name + ","
+	}
+	expires := requested.ExpiresAt.UTC().Format("2006-01-02 15:04 MST")
+
+	text := greeting + "\n\n" +
+		"Please confirm your email address by opening this link:\n\n" +
+		link + "\n\n" +
+		"The link expires on " + expires + ". If you did not create an account, ignore this email.\n"
+
+	var html strings.Builder
+	if err := verificationEmailHTML.Execute(&html, map[string]string{
+		"Greeting": greeting,
+		"Link":     link,
+		"Expires":  expires,
+	}); err != nil {
+		return fmt.Errorf("failed to render verification email: %w", err)
+	}
+
+	ctx := shareddomain.WithEventActor(context.Background(), event)
+	if _, err := h.mailer.Send(ctx, shareddomain.Email{
+		ID:      event.GetEventID(),
+		To:      []string{requested.Email},
+		Subject: "Confirm your email address",
+		Text:    text,
+		HTML:    html.String(),
+	}); err != nil {
+		return fmt.Errorf("failed to send verification email to user %s: %w", requested.UserID, err)
+	}
+	return nil
+}
+
+// link returns the verification link carrying the token
+func (h *VerificationEmailHandler) link(token string) string {
+	link := *h.linkURL
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String()
+}
+
+var verificationEmailHTML = template.Must(template.New("verification").Parse(`<p>{{.Greeting}}</p>
+<p>Please confirm your email address:</p>
+<p><a href="{{.Link}}">Confirm my email address</a></p>
+<p>The link expires on {{.Expires}}. If you did not create an account, ignore this email.</p>
+`))
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"net/http"
+
+	commandhandlers "golang_modular_monolith/internal/modules/user/application/command_handlers"
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminUserHandler handles HTTP requests of administrators provisioning user accounts
+type AdminUserHandler struct {
+	createUserHandler            *commandhandlers.CreateUserHandler
+	deactivateUserHandler        *commandhandlers.DeactivateUserHandler
+	activateUserHandler          *commandhandlers.ActivateUserHandler
+	requirePasswordChangeHandler *commandhandlers.RequirePasswordChangeHandler
+	impersonateUserHandler       *commandhandlers.ImpersonateUserHandler
+}
+
+// NewAdminUserHandler creates a new admin user handler
+func NewAdminUserHandler(
+	createUserHandler *commandhandlers.CreateUserHandler,
+	deactivateUserHandler *commandhandlers.DeactivateUserHandler,
+	activateUserHandler *commandhandlers.ActivateUserHandler,
+	requirePasswordChangeHandler *commandhandlers.RequirePasswordChangeHandler,
+	impersonateUserHandler *commandhandlers.ImpersonateUserHandler,
+) *AdminUserHandler {
+	return &AdminUserHandler{
+		createUserHandler:            createUserHandler,
+		deactivateUserHandler:        deactivateUserHandler,
+		activateUserHandler:          activateUserHandler,
+		requirePasswordChangeHandler: requirePasswordChangeHandler,
+		impersonateUserHandler:       impersonateUserHandler,
+	}
+}
+
+// CreateUserRequest represents the request body for creating a user
+// RequirePasswordChange defaults to true, so the user replaces the initial password at the first login
+type CreateUserRequest struct {
+	Email                 string `json:"email" binding:"required,email,max=255"`
+	Name                  string `json:"name" binding:"max=255"`
+	Password              string `json:"password" binding:"required"`
+	EmailVerified         bool   `json:"email_verified"`
+	RequirePasswordChange *bool  `json:"require_password_change"`
+}
+
+// CreateUser handles POST /users
+func (h *AdminUserHandler) CreateUser(c *gin.Context) {
+	var req CreateUserRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewCreateUserCommand(req.Email, req.Name, req.Password)
+	cmd.EmailVerified = req.EmailVerified
+	if req.RequirePasswordChange != nil {
+		cmd.RequirePasswordChange = *req.RequirePasswordChange
+	}
+
+	result, err := h.createUserHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// DeactivateUser handles POST /users/:id/deactivate
+func (h *AdminUserHandler) DeactivateUser(c *gin.Context) {
+	cmd := commands.NewDeactivateUserCommand(c.Param("id"))
+
+	result, err := h.deactivateUserHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ActivateUser handles POST /users/:id/activate
+func (h *AdminUserHandler) ActivateUser(c *gin.Context) {
+	cmd := commands.NewActivateUserCommand(c.Param("id"))
+
+	result, err := h.activateUserHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// RequirePasswordChange handles POST /users/:id/password-reset
+func (h *AdminUserHandler) RequirePasswordChange(c *gin.Context) {
+	cmd := commands.NewRequirePasswordChangeCommand(c.Param("id"))
+
+	result, err := h.requirePasswordChangeHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ImpersonateUserRequest represents the request body for impersonating a user
+type ImpersonateUserRequest struct {
+	Reason string `json:"reason" binding:"required,max=255"`
+}
+
+// ImpersonateUser handles POST /users/:id/impersonate
+// The returned token acts as the user until it expires; requests made with it carry the
+// X-Impersonated-By response header
+func (h *AdminUserHandler) ImpersonateUser(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req ImpersonateUserRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewImpersonateUserCommand(c.Param("id"), principal.UserID, req.Reason)
+
+	result, err := h.impersonateUserHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
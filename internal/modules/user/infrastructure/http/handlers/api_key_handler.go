@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	commandhandlers "golang_modular_monolith/internal/modules/user/application/command_handlers"
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/user/application/query_handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHandler handles HTTP requests for API keys
+type APIKeyHandler struct {
+	// Command handlers
+	createAPIKeyHandler *commandhandlers.CreateAPIKeyHandler
+	revokeAPIKeyHandler *commandhandlers.RevokeAPIKeyHandler
+
+	// Query handlers
+	listAPIKeysHandler *queryhandlers.ListAPIKeysHandler
+}
+
+// NewAPIKeyHandler creates a new API key handler
+func NewAPIKeyHandler(
+	createAPIKeyHandler *commandhandlers.CreateAPIKeyHandler,
+	revokeAPIKeyHandler *commandhandlers.RevokeAPIKeyHandler,
+	listAPIKeysHandler *queryhandlers.ListAPIKeysHandler,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		createAPIKeyHandler: createAPIKeyHandler,
+		revokeAPIKeyHandler: revokeAPIKeyHandler,
+		listAPIKeysHandler:  listAPIKeysHandler,
+	}
+}
+
+// CreateAPIKeyRequest represents the request body for issuing an API key
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,max=100"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreateAPIKey handles POST /api-keys
+// Keys are issued by users; API keys cannot issue further keys
+// The plain key is in the response only; it must not be cached by browsers or proxies
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req CreateAPIKeyRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewCreateAPIKeyCommand(req.Name, req.Scopes, req.ExpiresAt, principal.UserID)
+
+	result, err := h.createAPIKeyHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ListAPIKeys handles GET /api-keys
+// Revoked keys are included with ?include_revoked=true
+func (h *APIKeyHandler) ListAPIKeys(c *gin.Context) {
+	includeRevoked, _ := strconv.ParseBool(c.Query("include_revoked"))
+
+	result, err := h.listAPIKeysHandler.Handle(c.Request.Context(), &queries.ListAPIKeysQuery{IncludeRevoked: includeRevoked})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result.APIKeys,
+	})
+}
+
+// RevokeAPIKey handles DELETE /api-keys/:id
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	cmd := commands.NewRevokeAPIKeyCommand(c.Param("id"))
+
+	result, err := h.revokeAPIKeyHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
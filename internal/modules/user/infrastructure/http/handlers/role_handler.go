@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"net/http"
+
+	commandhandlers "golang_modular_monolith/internal/modules/user/application/command_handlers"
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/user/application/query_handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RoleHandler handles HTTP requests for roles and role assignments
+type RoleHandler struct {
+	// Command handlers
+	createRoleHandler   *commandhandlers.CreateRoleHandler
+	updateRoleHandler   *commandhandlers.UpdateRoleHandler
+	deleteRoleHandler   *commandhandlers.DeleteRoleHandler
+	assignRoleHandler   *commandhandlers.AssignRoleHandler
+	unassignRoleHandler *commandhandlers.UnassignRoleHandler
+
+	// Query handlers
+	getRoleHandler   *queryhandlers.GetRoleHandler
+	listRolesHandler *queryhandlers.ListRolesHandler
+}
+
+// NewRoleHandler creates a new role handler
+func NewRoleHandler(
+	createRoleHandler *commandhandlers.CreateRoleHandler,
+	updateRoleHandler *commandhandlers.UpdateRoleHandler,
+	deleteRoleHandler *commandhandlers.DeleteRoleHandler,
+	assignRoleHandler *commandhandlers.AssignRoleHandler,
+	unassignRoleHandler *commandhandlers.UnassignRoleHandler,
+	getRoleHandler *queryhandlers.GetRoleHandler,
+	listRolesHandler *queryhandlers.ListRolesHandler,
+) *RoleHandler {
+	return &RoleHandler{
+		createRoleHandler:   createRoleHandler,
+		updateRoleHandler:   updateRoleHandler,
+		deleteRoleHandler:   deleteRoleHandler,
+		assignRoleHandler:   assignRoleHandler,
+		unassignRoleHandler: unassignRoleHandler,
+		getRoleHandler:      getRoleHandler,
+		listRolesHandler:    listRolesHandler,
+	}
+}
+
+// CreateRoleRequest represents the request body for creating a role
+type CreateRoleRequest struct {
+	Name        string   `json:"name" binding:"required,max=64"`
+	Description string   `json:"description" binding:"max=255"`
+	Permissions []string `json:"permissions"`
+}
+
+// CreateRole handles POST /roles
+func (h *RoleHandler) CreateRole(c *gin.Context) {
+	var req CreateRoleRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewCreateRoleCommand(req.Name, req.Description, req.Permissions)
+
+	result, err := h.createRoleHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// UpdateRoleRequest represents the request body for updating a role
+// Omitted fields are left unchanged; permissions replaces the role's whole permission list
+type UpdateRoleRequest struct {
+	Description *string  `json:"description" binding:"omitempty,max=255"`
+	Permissions []string `json:"permissions"`
+}
+
+// UpdateRole handles PATCH /roles/:name
+func (h *RoleHandler) UpdateRole(c *gin.Context) {
+	var req UpdateRoleRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewUpdateRoleCommand(c.Param("name"))
+	cmd.Description = req.Description
+	cmd.Permissions = req.Permissions
+
+	result, err := h.updateRoleHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// DeleteRole handles DELETE /roles/:name
+func (h *RoleHandler) DeleteRole(c *gin.Context) {
+	cmd := commands.NewDeleteRoleCommand(c.Param("name"))
+
+	if err := h.deleteRoleHandler.Handle(c.Request.Context(), &cmd); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+	})
+}
+
+// GetRole handles GET /roles/:name
+func (h *RoleHandler) GetRole(c *gin.Context) {
+	result, err := h.getRoleHandler.Handle(c.Request.Context(), &queries.GetRoleQuery{Name: c.Param("name")})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ListRoles handles GET /roles
+func (h *RoleHandler) ListRoles(c *gin.Context) {
+	result, err := h.listRolesHandler.Handle(c.Request.Context(), &queries.ListRolesQuery{})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result.Roles,
+	})
+}
+
+// AssignRole handles PUT /users/:id/roles/:role
+func (h *RoleHandler) AssignRole(c *gin.Context) {
+	cmd := commands.NewAssignRoleCommand(c.Param("id"), c.Param("role"))
+
+	result, err := h.assignRoleHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// UnassignRole handles DELETE /users/:id/roles/:role
+func (h *RoleHandler) UnassignRole(c *gin.Context) {
+	cmd := commands.NewUnassignRoleCommand(c.Param("id"), c.Param("role"))
+
+	result, err := h.unassignRoleHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/user/application/query_handlers"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SecurityEventHandler handles HTTP requests for the security audit log
+type SecurityEventHandler struct {
+	// Query handlers
+	listSecurityEventsHandler *queryhandlers.ListSecurityEventsHandler
+}
+
+// NewSecurityEventHandler creates a new security event handler
+func NewSecurityEventHandler(listSecurityEventsHandler *queryhandlers.ListSecurityEventsHandler) *SecurityEventHandler {
+	return &SecurityEventHandler{
+		listSecurityEventsHandler: listSecurityEventsHandler,
+	}
+}
+
+// ListSecurityEvents handles GET /admin/security-events
+// Filters: ?user_id=, ?type=, ?request_id=, and ?since= (inclusive) / ?until= (exclusive) as RFC 3339 timestamps;
+// ?page= and ?limit= page through the events, newest first
+func (h *SecurityEventHandler) ListSecurityEvents(c *gin.Context) {
+	query := &queries.ListSecurityEventsQuery{
+		Page:      getIntQuery(c, "page", 1),
+		Limit:     getIntQuery(c, "limit", 50),
+		UserID:    c.Query("user_id"),
+		Type:      c.Query("type"),
+		RequestID: c.Query("request_id"),
+	}
+
+	var err error
+	if query.Since, err = getTimestampQuery(c, "since"); err != nil {
+		handleError(c, err)
+		return
+	}
+	if query.Until, err = getTimestampQuery(c, "until"); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	result, err := h.listSecurityEventsHandler.Handle(c.Request.Context(), query)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"data":       result.Events,
+		"pagination": result.Pagination,
+	})
+}
+
+// getIntQuery gets an integer query parameter with default value
+func getIntQuery(c *gin.Context, key string, defaultValue int) int {
+	if str := c.Query(key); str != "" {
+		if val, err := strconv.Atoi(str); err == nil {
+			return val
+		}
+	}
+	return defaultValue
+}
+
+// getTimestampQuery parses an optional RFC 3339 timestamp query parameter
+func getTimestampQuery(c *gin.Context, key string) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil, shareddomain.NewValidationErrorWithValue(key, key+" must be an RFC 3339 timestamp", value)
+	}
+	return &t, nil
+}
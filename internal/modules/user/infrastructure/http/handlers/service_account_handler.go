@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	commandhandlers "golang_modular_monolith/internal/modules/user/application/command_handlers"
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/user/application/query_handlers"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ServiceAccountHandler handles HTTP requests for service accounts and their token exchange
+type ServiceAccountHandler struct {
+	// Command handlers
+	createServiceAccountHandler  *commandhandlers.CreateServiceAccountHandler
+	rotateSecretHandler          *commandhandlers.RotateServiceAccountSecretHandler
+	disableServiceAccountHandler *commandhandlers.DisableServiceAccountHandler
+	issueTokenHandler            *commandhandlers.IssueServiceAccountTokenHandler
+
+	// Query handlers
+	listServiceAccountsHandler *queryhandlers.ListServiceAccountsHandler
+}
+
+// NewServiceAccountHandler creates a new service account handler
+func NewServiceAccountHandler(
+	createServiceAccountHandler *commandhandlers.CreateServiceAccountHandler,
+	rotateSecretHandler *commandhandlers.RotateServiceAccountSecretHandler,
+	disableServiceAccountHandler *commandhandlers.DisableServiceAccountHandler,
+	issueTokenHandler *commandhandlers.IssueServiceAccountTokenHandler,
+	listServiceAccountsHandler *queryhandlers.ListServiceAccountsHandler,
+) *ServiceAccountHandler {
+	return &ServiceAccountHandler{
+		createServiceAccountHandler:  createServiceAccountHandler,
+		rotateSecretHandler:          rotateSecretHandler,
+		disableServiceAccountHandler: disableServiceAccountHandler,
+		issueTokenHandler:            issueTokenHandler,
+		listServiceAccountsHandler:   listServiceAccountsHandler,
+	}
+}
+
+// CreateServiceAccountRequest represents the request body for creating a service account
+type CreateServiceAccountRequest struct {
+	Name        string   `json:"name" binding:"required,max=100"`
+	Description string   `json:"description" binding:"max=255"`
+	Scopes      []string `json:"scopes" binding:"required,min=1"`
+}
+
+// CreateServiceAccount handles POST /service-accounts
+// Accounts are created by users; machine clients cannot create further accounts
+// The client secret is in the response only; it must not be cached by browsers or proxies
+func (h *ServiceAccountHandler) CreateServiceAccount(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req CreateServiceAccountRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewCreateServiceAccountCommand(req.Name, req.Description, req.Scopes, principal.UserID)
+
+	result, err := h.createServiceAccountHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ListServiceAccounts handles GET /service-accounts
+// Disabled accounts are included with ?include_disabled=true
+func (h *ServiceAccountHandler) ListServiceAccounts(c *gin.Context) {
+	includeDisabled, _ := strconv.ParseBool(c.Query("include_disabled"))
+
+	result, err := h.listServiceAccountsHandler.Handle(c.Request.Context(), &queries.ListServiceAccountsQuery{IncludeDisabled: includeDisabled})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result.ServiceAccounts,
+	})
+}
+
+// RotateSecret handles POST /service-accounts/:id/secret
+func (h *ServiceAccountHandler) RotateSecret(c *gin.Context) {
+	cmd := commands.NewRotateServiceAccountSecretCommand(c.Param("id"))
+
+	result, err := h.rotateSecretHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// DisableServiceAccount handles DELETE /service-accounts/:id
+// Accounts are disabled rather than deleted, so their audit records stay attributable
+func (h *ServiceAccountHandler) DisableServiceAccount(c *gin.Context) {
+	cmd := commands.NewDisableServiceAccountCommand(c.Param("id"))
+
+	result, err := h.disableServiceAccountHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// IssueTokenRequest represents the request body of a client credentials exchange
+// Scope optionally narrows the token to some of the account's scopes, separated by spaces
+type IssueTokenRequest struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	Scope        string `json:"scope"`
+}
+
+// IssueToken handles POST /auth/token
+// The credentials are sent in the body or as HTTP Basic credentials (client ID and secret),
+// in which case the body may be omitted
+func (h *ServiceAccountHandler) IssueToken(c *gin.Context) {
+	var req IssueTokenRequest
+	if c.Request.ContentLength != 0 {
+		if err := validation.BindJSON(c, &req); err != nil {
+			handleError(c, err)
+			return
+		}
+	}
+	if clientID, clientSecret, ok := c.Request.BasicAuth(); ok && req.ClientID == "" && req.ClientSecret == "" {
+		req.ClientID, req.ClientSecret = clientID, clientSecret
+	}
+	if req.ClientID == "" || req.ClientSecret == "" {
+		handleError(c, shareddomain.NewDomainError(
+			shareddomain.ErrCodeInvalidInput,
+			"client_id and client_secret are required",
+		))
+		return
+	}
+
+	cmd := commands.NewIssueServiceAccountTokenCommand(req.ClientID, req.ClientSecret, strings.Fields(req.Scope))
+	cmd.IPAddress = c.ClientIP()
+
+	result, err := h.issueTokenHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
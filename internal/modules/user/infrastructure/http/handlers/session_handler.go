@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	commandhandlers "golang_modular_monolith/internal/modules/user/application/command_handlers"
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SessionCookie holds the attributes of the session cookie
+// The cookie is always HttpOnly so scripts cannot read the session ID
+type SessionCookie struct {
+	Name     string
+	Domain   string
+	Secure   bool
+	SameSite http.SameSite
+}
+
+// SessionHandler handles HTTP requests for cookie sessions
+// Used instead of the token endpoints when the session store is redis
+type SessionHandler struct {
+	createSessionHandler    *commandhandlers.CreateSessionHandler
+	twoFactorSessionHandler *commandhandlers.CompleteTwoFactorSessionHandler
+	endSessionHandler       *commandhandlers.EndSessionHandler
+	cookie                  SessionCookie
+}
+
+// NewSessionHandler creates a new session handler
+func NewSessionHandler(
+	createSessionHandler *commandhandlers.CreateSessionHandler,
+	twoFactorSessionHandler *commandhandlers.CompleteTwoFactorSessionHandler,
+	endSessionHandler *commandhandlers.EndSessionHandler,
+	cookie SessionCookie,
+) *SessionHandler {
+	return &SessionHandler{
+		createSessionHandler:    createSessionHandler,
+		twoFactorSessionHandler: twoFactorSessionHandler,
+		endSessionHandler:       endSessionHandler,
+		cookie:                  cookie,
+	}
+}
+
+// SessionLoginRequest represents the request body for starting a session
+type SessionLoginRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// Login handles POST /auth/login, setting the session cookie
+func (h *SessionHandler) Login(c *gin.Context) {
+	var req SessionLoginRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewCreateSessionCommand(req.Email, req.Password)
+	cmd.IPAddress = c.ClientIP()
+
+	result, err := h.createSessionHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if result.TwoFactorChallenge != nil {
+		writeTwoFactorChallenge(c, result.TwoFactorChallenge)
+		return
+	}
+
+	h.writeSession(c, result)
+}
+
+// CompleteTwoFactorLogin handles POST /auth/login/2fa, setting the session cookie
+func (h *SessionHandler) CompleteTwoFactorLogin(c *gin.Context) {
+	cmd, ok := bindTwoFactorLogin(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.twoFactorSessionHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	h.writeSession(c, result)
+}
+
+// writeSession sets the cookie of a started session and writes the session
+func (h *SessionHandler) writeSession(c *gin.Context, result *commands.SessionResult) {
+	h.setCookie(c, result.SessionID, result.MaxExpiresAt)
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// Logout handles POST /auth/logout, ending the session and clearing its cookie
+func (h *SessionHandler) Logout(c *gin.Context) {
+	h.endSession(c, false)
+}
+
+// LogoutAll handles POST /auth/logout-all, ending every session of the current user
+func (h *SessionHandler) LogoutAll(c *gin.Context) {
+	h.endSession(c, true)
+}
+
+// endSession ends the request's session; requests without a session cookie succeed
+func (h *SessionHandler) endSession(c *gin.Context, allSessions bool) {
+	if sessionID, err := c.Cookie(h.cookie.Name); err == nil && sessionID != "" {
+		cmd := commands.NewEndSessionCommand(sessionID, allSessions)
+		if err := h.endSessionHandler.Handle(c.Request.Context(), &cmd); err != nil {
+			handleError(c, err)
+			return
+		}
+	}
+
+	h.setCookie(c, "", time.Time{})
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+	})
+}
+
+// setCookie writes the session cookie; an empty value deletes it
+func (h *SessionHandler) setCookie(c *gin.Context, value string, expiresAt time.Time) {
+	cookie := &http.Cookie{
+		Name:     h.cookie.Name,
+		Value:    value,
+		Path:     "/",
+		Domain:   h.cookie.Domain,
+		Expires:  expiresAt,
+		Secure:   h.cookie.Secure,
+		HttpOnly: true,
+		SameSite: h.cookie.SameSite,
+	}
+	if value == "" {
+		cookie.MaxAge = -1
+	}
+
+	http.SetCookie(c.Writer, cookie)
+}
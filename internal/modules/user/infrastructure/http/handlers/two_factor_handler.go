@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"net/http"
+
+	commandhandlers "golang_modular_monolith/internal/modules/user/application/command_handlers"
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TwoFactorHandler handles HTTP requests for two-factor enrollment of the current user
+type TwoFactorHandler struct {
+	beginEnrollmentHandler         *commandhandlers.BeginTwoFactorEnrollmentHandler
+	enableHandler                  *commandhandlers.EnableTwoFactorHandler
+	disableHandler                 *commandhandlers.DisableTwoFactorHandler
+	regenerateRecoveryCodesHandler *commandhandlers.RegenerateRecoveryCodesHandler
+}
+
+// NewTwoFactorHandler creates a new two-factor handler
+func NewTwoFactorHandler(
+	beginEnrollmentHandler *commandhandlers.BeginTwoFactorEnrollmentHandler,
+	enableHandler *commandhandlers.EnableTwoFactorHandler,
+	disableHandler *commandhandlers.DisableTwoFactorHandler,
+	regenerateRecoveryCodesHandler *commandhandlers.RegenerateRecoveryCodesHandler,
+) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		beginEnrollmentHandler:         beginEnrollmentHandler,
+		enableHandler:                  enableHandler,
+		disableHandler:                 disableHandler,
+		regenerateRecoveryCodesHandler: regenerateRecoveryCodesHandler,
+	}
+}
+
+// TwoFactorCodeRequest represents a request body carrying a TOTP or recovery code
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required,max=32"`
+}
+
+// BeginEnrollment handles POST /users/me/2fa/enroll
+func (h *TwoFactorHandler) BeginEnrollment(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.NewBeginTwoFactorEnrollmentCommand(principal.UserID)
+
+	result, err := h.beginEnrollmentHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// Enable handles POST /users/me/2fa/enable
+func (h *TwoFactorHandler) Enable(c *gin.Context) {
+	principal, req, ok := bindTwoFactorCode(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.NewEnableTwoFactorCommand(principal.UserID, req.Code)
+
+	result, err := h.enableHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// Disable handles POST /users/me/2fa/disable
+func (h *TwoFactorHandler) Disable(c *gin.Context) {
+	principal, req, ok := bindTwoFactorCode(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.NewDisableTwoFactorCommand(principal.UserID, req.Code)
+
+	if err := h.disableHandler.Handle(c.Request.Context(), &cmd); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+	})
+}
+
+// RegenerateRecoveryCodes handles POST /users/me/2fa/recovery-codes
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	principal, req, ok := bindTwoFactorCode(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.NewRegenerateRecoveryCodesCommand(principal.UserID, req.Code)
+
+	result, err := h.regenerateRecoveryCodesHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// TwoFactorLoginRequest represents the request body for the second login step
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required,max=32"`
+}
+
+// bindTwoFactorLogin reads the second login step into a command, writing an error response on failure
+func bindTwoFactorLogin(c *gin.Context) (commands.CompleteTwoFactorLoginCommand, bool) {
+	var req TwoFactorLoginRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return commands.CompleteTwoFactorLoginCommand{}, false
+	}
+
+	cmd := commands.NewCompleteTwoFactorLoginCommand(req.ChallengeToken, req.Code)
+	cmd.IPAddress = c.ClientIP()
+	return cmd, true
+}
+
+// writeTwoFactorChallenge answers a login whose password was verified but needs a second factor
+func writeTwoFactorChallenge(c *gin.Context, challenge *commands.TwoFactorChallengeResult) {
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"two_factor_required": true,
+			"challenge_token":     challenge.ChallengeToken,
+			"expires_at":          challenge.ExpiresAt,
+		},
+	})
+}
+
+// bindTwoFactorCode returns the current user and the code in the request body, writing an error response on failure
+func bindTwoFactorCode(c *gin.Context) (*auth.Principal, TwoFactorCodeRequest, bool) {
+	var req TwoFactorCodeRequest
+
+	principal, ok := currentUser(c)
+	if !ok {
+		return nil, req, false
+	}
+
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return nil, req, false
+	}
+
+	return principal, req, true
+}
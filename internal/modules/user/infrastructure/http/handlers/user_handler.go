@@ -0,0 +1,342 @@
+package handlers
+
+import (
+	"net/http"
+
+	commandhandlers "golang_modular_monolith/internal/modules/user/application/command_handlers"
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/application/queries"
+	queryhandlers "golang_modular_monolith/internal/modules/user/application/query_handlers"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UserHandler handles HTTP requests for user operations
+type UserHandler struct {
+	// Command handlers
+	registerUserHandler   *commandhandlers.RegisterUserHandler
+	loginHandler          *commandhandlers.LoginHandler
+	twoFactorLoginHandler *commandhandlers.CompleteTwoFactorLoginHandler
+	refreshTokenHandler   *commandhandlers.RefreshTokenHandler
+	logoutHandler         *commandhandlers.LogoutHandler
+	verifyEmailHandler    *commandhandlers.VerifyEmailHandler
+	resendEmailHandler    *commandhandlers.ResendVerificationEmailHandler
+	unlockUserHandler     *commandhandlers.UnlockUserHandler
+	changePasswordHandler *commandhandlers.ChangePasswordHandler
+
+	// Query handlers
+	getUserHandler *queryhandlers.GetUserHandler
+}
+
+// NewUserHandler creates a new user handler
+func NewUserHandler(
+	registerUserHandler *commandhandlers.RegisterUserHandler,
+	loginHandler *commandhandlers.LoginHandler,
+	twoFactorLoginHandler *commandhandlers.CompleteTwoFactorLoginHandler,
+	refreshTokenHandler *commandhandlers.RefreshTokenHandler,
+	logoutHandler *commandhandlers.LogoutHandler,
+	verifyEmailHandler *commandhandlers.VerifyEmailHandler,
+	resendEmailHandler *commandhandlers.ResendVerificationEmailHandler,
+	unlockUserHandler *commandhandlers.UnlockUserHandler,
+	changePasswordHandler *commandhandlers.ChangePasswordHandler,
+	getUserHandler *queryhandlers.GetUserHandler,
+) *UserHandler {
+	return &UserHandler{
+		registerUserHandler:   registerUserHandler,
+		loginHandler:          loginHandler,
+		twoFactorLoginHandler: twoFactorLoginHandler,
+		refreshTokenHandler:   refreshTokenHandler,
+		logoutHandler:         logoutHandler,
+		verifyEmailHandler:    verifyEmailHandler,
+		resendEmailHandler:    resendEmailHandler,
+		unlockUserHandler:     unlockUserHandler,
+		changePasswordHandler: changePasswordHandler,
+		getUserHandler:        getUserHandler,
+	}
+}
+
+// RegisterUserRequest represents the request body for registering a user
+// The password policy (minimum length) is enforced by the command handler
+type RegisterUserRequest struct {
+	Email    string `json:"email" binding:"required,email,max=255"`
+	Name     string `json:"name" binding:"max=255"`
+	Password string `json:"password" binding:"required"`
+}
+
+// RegisterUser handles POST /users/register
+func (h *UserHandler) RegisterUser(c *gin.Context) {
+	var req RegisterUserRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewRegisterUserCommand(req.Email, req.Name, req.Password)
+
+	result, err := h.registerUserHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// VerifyEmailRequest represents the request body for confirming an email address
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// VerifyEmail handles POST /users/verify-email
+func (h *UserHandler) VerifyEmail(c *gin.Context) {
+	var req VerifyEmailRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewVerifyEmailCommand(req.Token)
+
+	result, err := h.verifyEmailHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ResendVerificationEmail handles POST /users/me/verification-email
+func (h *UserHandler) ResendVerificationEmail(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.NewResendVerificationEmailCommand(principal.UserID)
+
+	if err := h.resendEmailHandler.Handle(c.Request.Context(), &cmd); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+	})
+}
+
+// UnlockUser handles POST /users/:id/unlock
+func (h *UserHandler) UnlockUser(c *gin.Context) {
+	cmd := commands.NewUnlockUserCommand(c.Param("id"))
+
+	result, err := h.unlockUserHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ChangePasswordRequest represents the request body for changing a password
+// The password policy (minimum length) is enforced by the command handler
+type ChangePasswordRequest struct {
+	Email           string `json:"email" binding:"required"`
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required"`
+}
+
+// ChangePassword handles POST /auth/password
+func (h *UserHandler) ChangePassword(c *gin.Context) {
+	var req ChangePasswordRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewChangePasswordCommand(req.Email, req.CurrentPassword, req.NewPassword)
+	cmd.IPAddress = c.ClientIP()
+
+	result, err := h.changePasswordHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// DeviceIDHeader can carry the device ID instead of the request body
+const DeviceIDHeader = "X-Device-ID"
+
+// LoginRequest represents the request body for logging in
+// DeviceID identifies the client the refresh token is bound to; one is generated when omitted
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+	DeviceID string `json:"device_id" binding:"max=128"`
+}
+
+// Login handles POST /auth/login
+func (h *UserHandler) Login(c *gin.Context) {
+	var req LoginRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewLoginCommand(req.Email, req.Password, deviceID(c, req.DeviceID))
+	cmd.IPAddress = c.ClientIP()
+
+	result, err := h.loginHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if result.TwoFactorChallenge != nil {
+		writeTwoFactorChallenge(c, result.TwoFactorChallenge)
+		return
+	}
+
+	h.writeTokens(c, result)
+}
+
+// CompleteTwoFactorLogin handles POST /auth/login/2fa
+func (h *UserHandler) CompleteTwoFactorLogin(c *gin.Context) {
+	cmd, ok := bindTwoFactorLogin(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.twoFactorLoginHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	h.writeTokens(c, result)
+}
+
+// RefreshTokenRequest represents the request body for refreshing an access token
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+	DeviceID     string `json:"device_id" binding:"max=128"`
+}
+
+// RefreshToken handles POST /auth/refresh
+func (h *UserHandler) RefreshToken(c *gin.Context) {
+	var req RefreshTokenRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewRefreshTokenCommand(req.RefreshToken, deviceID(c, req.DeviceID))
+
+	result, err := h.refreshTokenHandler.Handle(c.Request.Context(), &cmd)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	h.writeTokens(c, result)
+}
+
+// LogoutRequest represents the request body for logging out
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// Logout handles POST /auth/logout
+func (h *UserHandler) Logout(c *gin.Context) {
+	var req LogoutRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cmd := commands.NewLogoutCommand(req.RefreshToken)
+
+	if err := h.logoutHandler.Handle(c.Request.Context(), &cmd); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+	})
+}
+
+// writeTokens writes issued tokens; they must not be cached by browsers or proxies
+func (h *UserHandler) writeTokens(c *gin.Context, result *commands.LoginResult) {
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// deviceID returns the device ID from the request body, falling back to the X-Device-ID header
+func deviceID(c *gin.Context, fromBody string) string {
+	if fromBody != "" {
+		return fromBody
+	}
+	return c.GetHeader(DeviceIDHeader)
+}
+
+// GetCurrentUser handles GET /users/me
+func (h *UserHandler) GetCurrentUser(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.getUserHandler.Handle(c.Request.Context(), &queries.GetUserQuery{ID: principal.UserID})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result.User,
+	})
+}
+
+// currentUser returns the principal of a request authenticated as a user
+// It writes the error response and returns false for anonymous and machine client requests
+func currentUser(c *gin.Context) (*auth.Principal, bool) {
+	principal, ok := auth.CurrentPrincipal(c)
+	if !ok {
+		handleError(c, shareddomain.NewDomainError(
+			shareddomain.ErrCodeUnauthorized,
+			"authentication required",
+		))
+		return nil, false
+	}
+	if principal.IsAPIKey() || principal.IsServiceAccount() {
+		handleError(c, shareddomain.NewDomainError(
+			shareddomain.ErrCodeForbidden,
+			"API keys and service accounts do not belong to a user",
+		))
+		return nil, false
+	}
+
+	return principal, true
+}
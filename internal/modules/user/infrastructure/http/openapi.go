@@ -0,0 +1,187 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/user/application/commands"
+	"golang_modular_monolith/internal/modules/user/application/queries"
+	"golang_modular_monolith/internal/modules/user/domain"
+	"golang_modular_monolith/internal/modules/user/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
+)
+
+// twoFactorChallenge describes the login answer of users with two-factor authentication enabled
+const twoFactorChallenge = "Users with two-factor authentication get {two_factor_required, challenge_token, expires_at} instead; " +
+	"the challenge token and a code complete the login at POST /auth/login/2fa"
+
+// APIOperations documents the routes registered by RegisterUserRoutes
+// sessions selects the cookie session endpoints over the token endpoints, like a non-nil sessionHandler
+func APIOperations(sessions bool) []*openapi.Operation {
+	operations := authOperations(sessions)
+
+	return append(operations,
+		// Users
+		openapi.Post("/users", "Create a user").
+			Body(handlers.CreateUserRequest{}).
+			Created(commands.UserResult{}).
+			Requires(domain.PermissionUsersManage),
+		openapi.Post("/users/register", "Register").
+			Body(handlers.RegisterUserRequest{}).
+			Created(commands.UserResult{}),
+		openapi.Post("/users/verify-email", "Verify an email address").
+			Body(handlers.VerifyEmailRequest{}).
+			Returns(commands.UserResult{}),
+		openapi.Get("/users/me", "Get the current user").
+			Returns(queries.UserDTO{}).
+			Authenticated(),
+		openapi.Post("/users/me/verification-email", "Resend the verification email").
+			Describe("Answers 202 Accepted").
+			Authenticated(),
+		openapi.Post("/users/me/2fa/enroll", "Begin two-factor enrollment").
+			Returns(commands.TwoFactorEnrollmentResult{}).
+			Authenticated(),
+		openapi.Post("/users/me/2fa/enable", "Enable two-factor authentication").
+			Body(handlers.TwoFactorCodeRequest{}).
+			Returns(commands.RecoveryCodesResult{}).
+			Authenticated(),
+		openapi.Post("/users/me/2fa/disable", "Disable two-factor authentication").
+			Body(handlers.TwoFactorCodeRequest{}).
+			Authenticated(),
+		openapi.Post("/users/me/2fa/recovery-codes", "Regenerate recovery codes").
+			Body(handlers.TwoFactorCodeRequest{}).
+			Returns(commands.RecoveryCodesResult{}).
+			Authenticated(),
+		openapi.Post("/users/:id/unlock", "Unlock a user").
+			Returns(commands.UserResult{}).
+			Requires(domain.PermissionUsersUnlock),
+		openapi.Post("/users/:id/deactivate", "Deactivate a user").
+			Returns(commands.UserResult{}).
+			Requires(domain.PermissionUsersManage),
+		openapi.Post("/users/:id/activate", "Activate a user").
+			Returns(commands.UserResult{}).
+			Requires(domain.PermissionUsersManage),
+		openapi.Post("/users/:id/password-reset", "Require a password change").
+			Returns(commands.UserResult{}).
+			Requires(domain.PermissionUsersManage),
+		openapi.Post("/users/:id/impersonate", "Impersonate a user").
+			Describe("Issues a short-lived access token acting as the user; the reason is recorded in the security events").
+			Body(handlers.ImpersonateUserRequest{}).
+			Returns(commands.ImpersonationResult{}).
+			Requires(domain.PermissionUsersImpersonate),
+		openapi.Put("/users/:id/roles/:role", "Assign a role").
+			Returns(commands.UserResult{}).
+			Requires(domain.PermissionUsersAssignRoles),
+		openapi.Delete("/users/:id/roles/:role", "Unassign a role").
+			Returns(commands.UserResult{}).
+			Requires(domain.PermissionUsersAssignRoles),
+
+		// Roles
+		openapi.Get("/roles", "List roles").
+			Returns([]queries.RoleDTO{}).
+			Requires(domain.PermissionRolesRead),
+		openapi.Get("/roles/:name", "Get a role").
+			Returns(queries.RoleDTO{}).
+			Requires(domain.PermissionRolesRead),
+		openapi.Post("/roles", "Create a role").
+			Body(handlers.CreateRoleRequest{}).
+			Created(commands.RoleResult{}).
+			Requires(domain.PermissionRolesManage),
+		openapi.Patch("/roles/:name", "Update a role").
+			Body(handlers.UpdateRoleRequest{}).
+			Returns(commands.RoleResult{}).
+			Requires(domain.PermissionRolesManage),
+		openapi.Delete("/roles/:name", "Delete a role").
+			Requires(domain.PermissionRolesManage),
+
+		// API keys
+		openapi.Get("/api-keys", "List API keys").
+			Query("include_revoked", "boolean", "Include revoked keys").
+			Returns([]queries.APIKeyDTO{}).
+			Requires(domain.PermissionAPIKeysRead),
+		openapi.Post("/api-keys", "Create an API key").
+			Describe("The key is only returned once. Requires a verified email address").
+			Body(handlers.CreateAPIKeyRequest{}).
+			Created(commands.CreateAPIKeyResult{}).
+			Requires(domain.PermissionAPIKeysManage),
+		openapi.Delete("/api-keys/:id", "Revoke an API key").
+			Returns(commands.APIKeyResult{}).
+			Requires(domain.PermissionAPIKeysManage),
+
+		// Service accounts
+		openapi.Get("/service-accounts", "List service accounts").
+			Query("include_disabled", "boolean", "Include disabled service accounts").
+			Returns([]queries.ServiceAccountDTO{}).
+			Requires(domain.PermissionServiceAccountsRead),
+		openapi.Post("/service-accounts", "Create a service account").
+			Describe("The client secret is only returned once. Requires a verified email address").
+			Body(handlers.CreateServiceAccountRequest{}).
+			Created(commands.ServiceAccountSecretResult{}).
+			Requires(domain.PermissionServiceAccountsManage),
+		openapi.Post("/service-accounts/:id/secret", "Rotate a service account secret").
+			Returns(commands.ServiceAccountSecretResult{}).
+			Requires(domain.PermissionServiceAccountsManage),
+		openapi.Delete("/service-accounts/:id", "Disable a service account").
+			Returns(commands.ServiceAccountResult{}).
+			Requires(domain.PermissionServiceAccountsManage),
+
+		// Administration
+		openapi.Get("/admin/security-events", "List security events").
+			Query("page", "integer", "Page number, from 1").
+			Query("limit", "integer", "Page size").
+			Query("user_id", "string", "User ID").
+			Query("type", "string", "Event type").
+			Query("request_id", "string", "ID of the request that produced the events (X-Request-ID)").
+			Query("since", "string", "RFC 3339 timestamp, inclusive").
+			Query("until", "string", "RFC 3339 timestamp, exclusive").
+			Paginated([]*domain.SecurityEvent{}, domain.PaginationResult{}).
+			Requires(domain.PermissionSecurityEventsRead),
+	)
+}
+
+// authOperations documents the /auth routes of the token or session endpoints
+func authOperations(sessions bool) []*openapi.Operation {
+	operations := []*openapi.Operation{
+		openapi.Post("/auth/password", "Change the password").
+			Describe("Also completes a password change required by an administrator").
+			Body(handlers.ChangePasswordRequest{}).
+			Returns(commands.UserResult{}),
+		openapi.Post("/auth/token", "Issue a service account token").
+			Describe("Exchanges client credentials, in the body or as HTTP Basic credentials, for a short-lived access token").
+			Body(handlers.IssueTokenRequest{}).
+			Returns(commands.ServiceAccountTokenResult{}),
+	}
+
+	if sessions {
+		return append(operations,
+			openapi.Post("/auth/login", "Log in").
+				Describe("Sets the session cookie. "+twoFactorChallenge).
+				Body(handlers.SessionLoginRequest{}).
+				Returns(commands.SessionResult{}),
+			openapi.Post("/auth/login/2fa", "Complete a two-factor login").
+				Describe("Sets the session cookie").
+				Body(handlers.TwoFactorLoginRequest{}).
+				Returns(commands.SessionResult{}),
+			openapi.Post("/auth/logout", "Log out").
+				Describe("Ends the session and clears its cookie"),
+			openapi.Post("/auth/logout-all", "Log out everywhere").
+				Describe("Ends every session of the current user"),
+		)
+	}
+
+	return append(operations,
+		openapi.Post("/auth/login", "Log in").
+			Describe(twoFactorChallenge).
+			Header(handlers.DeviceIDHeader, "Device the refresh token is bound to, instead of device_id").
+			Body(handlers.LoginRequest{}).
+			Returns(commands.LoginResult{}),
+		openapi.Post("/auth/login/2fa", "Complete a two-factor login").
+			Body(handlers.TwoFactorLoginRequest{}).
+			Returns(commands.LoginResult{}),
+		openapi.Post("/auth/refresh", "Refresh an access token").
+			Describe("Rotates the refresh token").
+			Header(handlers.DeviceIDHeader, "Device the refresh token is bound to, instead of device_id").
+			Body(handlers.RefreshTokenRequest{}).
+			Returns(commands.LoginResult{}),
+		openapi.Post("/auth/logout", "Log out").
+			Describe("Revokes the refresh token").
+			Body(handlers.LogoutRequest{}),
+	)
+}
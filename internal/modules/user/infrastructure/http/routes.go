@@ -0,0 +1,108 @@
+package http
+
+import (
+	"golang_modular_monolith/internal/modules/user/domain"
+	"golang_modular_monolith/internal/modules/user/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/authz"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterUserRoutes registers user, role, API key, service account, security audit and authentication routes
+// Registration, the login endpoints, password changes and the service account token exchange are public;
+// the other routes require a bearer token, API key or session
+// A non-nil sessionHandler replaces the token endpoints with cookie session endpoints
+// Two-factor enrollment routes are only registered when twoFactorHandler is non-nil
+func RegisterUserRoutes(
+	router *gin.RouterGroup,
+	userHandler *handlers.UserHandler,
+	sessionHandler *handlers.SessionHandler,
+	twoFactorHandler *handlers.TwoFactorHandler,
+	adminUserHandler *handlers.AdminUserHandler,
+	roleHandler *handlers.RoleHandler,
+	apiKeyHandler *handlers.APIKeyHandler,
+	serviceAccountHandler *handlers.ServiceAccountHandler,
+	securityEventHandler *handlers.SecurityEventHandler,
+	tokens *auth.TokenService,
+	authorizer authz.Authorizer,
+) {
+	authenticated := auth.Middleware(tokens)
+	// Credentials and machine clients are managed by users themselves, never by an impersonating administrator
+	notImpersonated := auth.DenyImpersonation()
+	require := func(permission string) gin.HandlerFunc {
+		return authz.RequirePermission(authorizer, permission)
+	}
+
+	// Authentication routes
+	authRoutes := router.Group("/auth")
+	if sessionHandler != nil {
+		authRoutes.POST("/login", sessionHandler.Login)
+		authRoutes.POST("/login/2fa", sessionHandler.CompleteTwoFactorLogin)
+		authRoutes.POST("/logout", sessionHandler.Logout)
+		authRoutes.POST("/logout-all", sessionHandler.LogoutAll)
+	} else {
+		authRoutes.POST("/login", userHandler.Login)
+		authRoutes.POST("/login/2fa", userHandler.CompleteTwoFactorLogin)
+		authRoutes.POST("/refresh", userHandler.RefreshToken)
+		authRoutes.POST("/logout", userHandler.Logout)
+	}
+	authRoutes.POST("/password", userHandler.ChangePassword)
+	authRoutes.POST("/token", serviceAccountHandler.IssueToken)
+
+	// User routes
+	users := router.Group("/users")
+	{
+		users.POST("", authenticated, require(domain.PermissionUsersManage), adminUserHandler.CreateUser)
+		users.POST("/register", userHandler.RegisterUser)
+		users.POST("/verify-email", userHandler.VerifyEmail)
+		users.GET("/me", authenticated, userHandler.GetCurrentUser)
+		users.POST("/me/verification-email", authenticated, userHandler.ResendVerificationEmail)
+		if twoFactorHandler != nil {
+			users.POST("/me/2fa/enroll", authenticated, notImpersonated, twoFactorHandler.BeginEnrollment)
+			users.POST("/me/2fa/enable", authenticated, notImpersonated, twoFactorHandler.Enable)
+			users.POST("/me/2fa/disable", authenticated, notImpersonated, twoFactorHandler.Disable)
+			users.POST("/me/2fa/recovery-codes", authenticated, notImpersonated, twoFactorHandler.RegenerateRecoveryCodes)
+		}
+		users.POST("/:id/unlock", authenticated, require(domain.PermissionUsersUnlock), userHandler.UnlockUser)
+		users.POST("/:id/deactivate", authenticated, require(domain.PermissionUsersManage), adminUserHandler.DeactivateUser)
+		users.POST("/:id/activate", authenticated, require(domain.PermissionUsersManage), adminUserHandler.ActivateUser)
+		users.POST("/:id/password-reset", authenticated, require(domain.PermissionUsersManage), adminUserHandler.RequirePasswordChange)
+		users.POST("/:id/impersonate", authenticated, notImpersonated, require(domain.PermissionUsersImpersonate), adminUserHandler.ImpersonateUser)
+		users.PUT("/:id/roles/:role", authenticated, require(domain.PermissionUsersAssignRoles), roleHandler.AssignRole)
+		users.DELETE("/:id/roles/:role", authenticated, require(domain.PermissionUsersAssignRoles), roleHandler.UnassignRole)
+	}
+
+	// Role routes
+	roles := router.Group("/roles", authenticated)
+	{
+		roles.GET("", require(domain.PermissionRolesRead), roleHandler.ListRoles)
+		roles.GET("/:name", require(domain.PermissionRolesRead), roleHandler.GetRole)
+		roles.POST("", require(domain.PermissionRolesManage), roleHandler.CreateRole)
+		roles.PATCH("/:name", require(domain.PermissionRolesManage), roleHandler.UpdateRole)
+		roles.DELETE("/:name", require(domain.PermissionRolesManage), roleHandler.DeleteRole)
+	}
+
+	// API key routes
+	apiKeys := router.Group("/api-keys", authenticated)
+	{
+		apiKeys.GET("", require(domain.PermissionAPIKeysRead), apiKeyHandler.ListAPIKeys)
+		apiKeys.POST("", notImpersonated, require(domain.PermissionAPIKeysManage), authz.RequireVerifiedEmail(authorizer), apiKeyHandler.CreateAPIKey)
+		apiKeys.DELETE("/:id", notImpersonated, require(domain.PermissionAPIKeysManage), apiKeyHandler.RevokeAPIKey)
+	}
+
+	// Service account routes
+	serviceAccounts := router.Group("/service-accounts", authenticated)
+	{
+		serviceAccounts.GET("", require(domain.PermissionServiceAccountsRead), serviceAccountHandler.ListServiceAccounts)
+		serviceAccounts.POST("", notImpersonated, require(domain.PermissionServiceAccountsManage), authz.RequireVerifiedEmail(authorizer), serviceAccountHandler.CreateServiceAccount)
+		serviceAccounts.POST("/:id/secret", notImpersonated, require(domain.PermissionServiceAccountsManage), serviceAccountHandler.RotateSecret)
+		serviceAccounts.DELETE("/:id", notImpersonated, require(domain.PermissionServiceAccountsManage), serviceAccountHandler.DisableServiceAccount)
+	}
+
+	// Admin routes
+	admin := router.Group("/admin", authenticated)
+	{
+		admin.GET("/security-events", require(domain.PermissionSecurityEventsRead), securityEventHandler.ListSecurityEvents)
+	}
+}
@@ -0,0 +1,170 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	userdb "golang_modular_monolith/internal/modules/user/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"gorm.io/gorm"
+)
+
+// lastUsedResolution is how stale last_used_at may get before a request updates it,
+// so busy keys don't cause a write on every request
+const lastUsedResolution = time.Minute
+
+// APIKeyModel represents the API key database model
+type APIKeyModel struct {
+	ID         string     `gorm:"primaryKey;type:varchar(36)"`
+	TenantID   string     `gorm:"type:varchar(64);not null;default:''"`
+	Name       string     `gorm:"type:varchar(100);not null"`
+	Prefix     string     `gorm:"type:varchar(16);not null"`
+	KeyHash    string     `gorm:"type:char(64);not null;unique"`
+	Scopes     StringList `gorm:"type:jsonb;not null"`
+	CreatedBy  *string    `gorm:"type:varchar(36)"`
+	ExpiresAt  *time.Time `gorm:"type:timestamp with time zone"`
+	LastUsedAt *time.Time `gorm:"type:timestamp with time zone"`
+	RevokedAt  *time.Time `gorm:"type:timestamp with time zone"`
+	CreatedAt  time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (APIKeyModel) TableName() string {
+	return "api_keys"
+}
+
+// ToEntity converts database model to domain entity
+func (m *APIKeyModel) ToEntity() *domain.APIKey {
+	key := &domain.APIKey{
+		ID:         m.ID,
+		Name:       m.Name,
+		Prefix:     m.Prefix,
+		KeyHash:    m.KeyHash,
+		Scopes:     []string(m.Scopes),
+		TenantID:   m.TenantID,
+		ExpiresAt:  m.ExpiresAt,
+		LastUsedAt: m.LastUsedAt,
+		RevokedAt:  m.RevokedAt,
+		CreatedAt:  m.CreatedAt,
+	}
+	if m.CreatedBy != nil {
+		key.CreatedBy = *m.CreatedBy
+	}
+
+	return key
+}
+
+// FromEntity converts domain entity to database model
+func (m *APIKeyModel) FromEntity(key *domain.APIKey) {
+	m.ID = key.ID
+	m.Name = key.Name
+	m.Prefix = key.Prefix
+	m.KeyHash = key.KeyHash
+	m.Scopes = StringList(key.Scopes)
+	m.TenantID = key.TenantID
+	m.ExpiresAt = key.ExpiresAt
+	m.LastUsedAt = key.LastUsedAt
+	m.RevokedAt = key.RevokedAt
+	m.CreatedAt = key.CreatedAt
+	m.CreatedBy = nil
+	if key.CreatedBy != "" {
+		m.CreatedBy = &key.CreatedBy
+	}
+}
+
+// PostgreSQLAPIKeyRepository implements APIKeyRepository using PostgreSQL
+type PostgreSQLAPIKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLAPIKeyRepository creates a new PostgreSQL API key repository
+func NewPostgreSQLAPIKeyRepository(db *gorm.DB) *PostgreSQLAPIKeyRepository {
+	return &PostgreSQLAPIKeyRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLAPIKeyRepositoryFromManager creates repository using database manager
+func NewPostgreSQLAPIKeyRepositoryFromManager() (*PostgreSQLAPIKeyRepository, error) {
+	db, err := userdb.GetUserDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user database: %w", err)
+	}
+
+	return &PostgreSQLAPIKeyRepository{
+		db: db,
+	}, nil
+}
+
+// Save saves an API key (create or update)
+func (r *PostgreSQLAPIKeyRepository) Save(ctx context.Context, key *domain.APIKey) error {
+	model := &APIKeyModel{}
+	model.FromEntity(key)
+
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return fmt.Errorf("failed to save API key: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an API key by ID
+func (r *PostgreSQLAPIKeyRepository) GetByID(ctx context.Context, id string) (*domain.APIKey, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+// GetByHash retrieves an API key by the hash of its value
+func (r *PostgreSQLAPIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	return r.first(ctx, "key_hash = ?", keyHash)
+}
+
+// List retrieves API keys, newest first
+func (r *PostgreSQLAPIKeyRepository) List(ctx context.Context, includeRevoked bool) ([]*domain.APIKey, error) {
+	query := r.db.WithContext(ctx).Order("created_at DESC")
+	if !includeRevoked {
+		query = query.Where("revoked_at IS NULL")
+	}
+
+	var models []APIKeyModel
+	if err := query.Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	keys := make([]*domain.APIKey, len(models))
+	for i := range models {
+		keys[i] = models[i].ToEntity()
+	}
+	return keys, nil
+}
+
+// TouchLastUsed records that a key was used
+// The conditional update skips keys whose last use was recorded less than a minute ago
+func (r *PostgreSQLAPIKeyRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&APIKeyModel{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", id, usedAt.Add(-lastUsedResolution)).
+		Update("last_used_at", usedAt)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update API key last use: %w", result.Error)
+	}
+
+	return nil
+}
+
+// first retrieves the API key matching a condition
+func (r *PostgreSQLAPIKeyRepository) first(ctx context.Context, query string, args ...interface{}) (*domain.APIKey, error) {
+	var model APIKeyModel
+	result := r.db.WithContext(ctx).Where(query, args...).First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get API key: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
@@ -0,0 +1,55 @@
+package persistence
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+)
+
+// AuditedSecurityEventRepository records security events in the shared audit log as well, as
+// "security.<type>" actions on the user concerned
+type AuditedSecurityEventRepository struct {
+	domain.SecurityEventRepository
+	audit shareddomain.AuditWriter
+}
+
+// NewAuditedSecurityEventRepository wraps a security event repository
+func NewAuditedSecurityEventRepository(events domain.SecurityEventRepository, audit shareddomain.AuditWriter) *AuditedSecurityEventRepository {
+	return &AuditedSecurityEventRepository{
+		SecurityEventRepository: events,
+		audit:                   audit,
+	}
+}
+
+// Record stores a security event and records it in the audit log
+// Failures to record in the audit log are logged, since the event itself is stored
+func (r *AuditedSecurityEventRepository) Record(ctx context.Context, event *domain.SecurityEvent) error {
+	if err := r.SecurityEventRepository.Record(ctx, event); err != nil {
+		return err
+	}
+
+	entry := shareddomain.AuditEntry{
+		ID:         event.ID,
+		Action:     "security." + event.Type,
+		Actor:      event.Actor,
+		RequestID:  event.RequestID,
+		IPAddress:  event.IPAddress,
+		Outcome:    shareddomain.AuditSuccess,
+		Detail:     event.Detail,
+		OccurredAt: event.OccurredAt,
+	}
+	if event.UserID != "" {
+		entry.AggregateType, entry.AggregateID = "user", event.UserID
+	}
+	switch event.Type {
+	case domain.SecurityEventLoginFailed, domain.SecurityEventServiceAuthFailed, domain.SecurityEventTokenReuseDetected:
+		entry.Outcome = shareddomain.AuditFailure
+	}
+	if err := r.audit.Write(ctx, entry); err != nil {
+		zap.L().Warn("failed to record security event in the audit log", zap.String("type", event.Type), zap.Error(err))
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	userdb "golang_modular_monolith/internal/modules/user/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"gorm.io/gorm"
+)
+
+// EmailVerificationTokenModel represents the email verification token database model
+type EmailVerificationTokenModel struct {
+	ID        string     `gorm:"primaryKey;type:varchar(36)"`
+	UserID    string     `gorm:"type:varchar(36);not null;index"`
+	Email     string     `gorm:"type:varchar(255);not null"`
+	TokenHash string     `gorm:"type:char(64);not null;unique"`
+	ExpiresAt time.Time  `gorm:"type:timestamp with time zone;not null"`
+	UsedAt    *time.Time `gorm:"type:timestamp with time zone"`
+	CreatedAt time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (EmailVerificationTokenModel) TableName() string {
+	return "email_verification_tokens"
+}
+
+// ToEntity converts database model to domain entity
+func (m *EmailVerificationTokenModel) ToEntity() *domain.EmailVerificationToken {
+	return &domain.EmailVerificationToken{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Email:     m.Email,
+		TokenHash: m.TokenHash,
+		ExpiresAt: m.ExpiresAt,
+		UsedAt:    m.UsedAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// FromEntity converts domain entity to database model
+func (m *EmailVerificationTokenModel) FromEntity(token *domain.EmailVerificationToken) {
+	m.ID = token.ID
+	m.UserID = token.UserID
+	m.Email = token.Email
+	m.TokenHash = token.TokenHash
+	m.ExpiresAt = token.ExpiresAt
+	m.UsedAt = token.UsedAt
+	m.CreatedAt = token.CreatedAt
+}
+
+// PostgreSQLEmailVerificationTokenRepository implements EmailVerificationTokenRepository using PostgreSQL
+type PostgreSQLEmailVerificationTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLEmailVerificationTokenRepository creates a new PostgreSQL email verification token repository
+func NewPostgreSQLEmailVerificationTokenRepository(db *gorm.DB) *PostgreSQLEmailVerificationTokenRepository {
+	return &PostgreSQLEmailVerificationTokenRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLEmailVerificationTokenRepositoryFromManager creates repository using database manager
+func NewPostgreSQLEmailVerificationTokenRepositoryFromManager() (*PostgreSQLEmailVerificationTokenRepository, error) {
+	db, err := userdb.GetUserDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user database: %w", err)
+	}
+
+	return &PostgreSQLEmailVerificationTokenRepository{
+		db: db,
+	}, nil
+}
+
+// Create stores a new verification token
+func (r *PostgreSQLEmailVerificationTokenRepository) Create(ctx context.Context, token *domain.EmailVerificationToken) error {
+	model := &EmailVerificationTokenModel{}
+	model.FromEntity(token)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to create verification token: %w", err)
+	}
+
+	return nil
+}
+
+// GetByHash retrieves a verification token by the hash of its value
+func (r *PostgreSQLEmailVerificationTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.EmailVerificationToken, error) {
+	var model EmailVerificationTokenModel
+	result := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get verification token: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// MarkUsed marks an unused token as used
+// The conditional update lets only one of several concurrent verifications with the same token win
+func (r *PostgreSQLEmailVerificationTokenRepository) MarkUsed(ctx context.Context, token *domain.EmailVerificationToken) error {
+	now := time.Now().UTC()
+
+	result := r.db.WithContext(ctx).Model(&EmailVerificationTokenModel{}).
+		Where("id = ? AND used_at IS NULL", token.ID).
+		Update("used_at", now)
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark verification token used: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrVerificationTokenUsed
+	}
+
+	token.UsedAt = &now
+	return nil
+}
@@ -0,0 +1,140 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis key prefixes of the session store
+const (
+	sessionKeyPrefix      = "user:session:"  // session by ID hash
+	userSessionsKeyPrefix = "user:sessions:" // set of a user's session ID hashes
+)
+
+// RedisSessionStore implements SessionStore using Redis
+// Each session is a JSON value whose TTL is its idle expiry; a per-user set indexes the sessions
+// so that all of them can be removed at once. Set members of expired sessions are pruned lazily.
+type RedisSessionStore struct {
+	client *redis.Client
+}
+
+// NewRedisSessionStore creates a new Redis session store
+func NewRedisSessionStore(client *redis.Client) *RedisSessionStore {
+	return &RedisSessionStore{
+		client: client,
+	}
+}
+
+// Create stores a new session that expires after idleTimeout without use
+func (s *RedisSessionStore) Create(ctx context.Context, session *domain.Session, idleTimeout time.Duration) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to marshal session: %w", err)
+	}
+
+	userKey := userSessionsKeyPrefix + session.UserID
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, sessionKeyPrefix+session.IDHash, data, ttlUntil(session.IdleExpiry(idleTimeout)))
+		pipe.SAdd(ctx, userKey, session.IDHash)
+		pipe.ExpireAt(ctx, userKey, session.ExpiresAt)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return nil
+}
+
+// Get retrieves a session by the hash of its ID
+func (s *RedisSessionStore) Get(ctx context.Context, idHash string) (*domain.Session, error) {
+	data, err := s.client.Get(ctx, sessionKeyPrefix+idHash).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	var session domain.Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal session: %w", err)
+	}
+
+	return &session, nil
+}
+
+// Touch records a request on the session and extends its idle expiry
+// A session removed in the meantime is not recreated
+func (s *RedisSessionStore) Touch(ctx context.Context, session *domain.Session, idleTimeout time.Duration) error {
+	session.LastSeenAt = time.Now().UTC()
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to marshal session: %w", err)
+	}
+
+	err = s.client.SetArgs(ctx, sessionKeyPrefix+session.IDHash, data, redis.SetArgs{
+		Mode: "XX",
+		TTL:  ttlUntil(session.IdleExpiry(idleTimeout)),
+	}).Err()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("failed to touch session: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a session
+func (s *RedisSessionStore) Delete(ctx context.Context, session *domain.Session) error {
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, sessionKeyPrefix+session.IDHash)
+		pipe.SRem(ctx, userSessionsKeyPrefix+session.UserID, session.IDHash)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteByUser removes every session of a user
+func (s *RedisSessionStore) DeleteByUser(ctx context.Context, userID string) error {
+	userKey := userSessionsKeyPrefix + userID
+
+	idHashes, err := s.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to list user sessions: %w", err)
+	}
+
+	keys := make([]string, 0, len(idHashes)+1)
+	for _, idHash := range idHashes {
+		keys = append(keys, sessionKeyPrefix+idHash)
+	}
+	keys = append(keys, userKey)
+
+	if err := s.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("failed to delete user sessions: %w", err)
+	}
+
+	return nil
+}
+
+// ttlUntil returns the TTL of a key that expires at the given time
+// Redis rejects non-positive TTLs, so keys that are already due get the shortest one
+func ttlUntil(expiresAt time.Time) time.Duration {
+	ttl := time.Until(expiresAt)
+	if ttl < time.Millisecond {
+		return time.Millisecond
+	}
+	return ttl
+}
@@ -0,0 +1,195 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	userdb "golang_modular_monolith/internal/modules/user/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"gorm.io/gorm"
+)
+
+// RefreshTokenModel represents the refresh token database model
+type RefreshTokenModel struct {
+	ID            string     `gorm:"primaryKey;type:varchar(36)"`
+	UserID        string     `gorm:"type:varchar(36);not null;index"`
+	FamilyID      string     `gorm:"type:varchar(36);not null;index"`
+	DeviceID      string     `gorm:"type:varchar(128);not null"`
+	TokenHash     string     `gorm:"type:char(64);not null;unique"`
+	ExpiresAt     time.Time  `gorm:"type:timestamp with time zone;not null"`
+	CreatedAt     time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	RotatedAt     *time.Time `gorm:"type:timestamp with time zone"`
+	ReplacedByID  *string    `gorm:"type:varchar(36)"`
+	RevokedAt     *time.Time `gorm:"type:timestamp with time zone"`
+	RevokedReason *string    `gorm:"type:varchar(32)"`
+}
+
+// TableName returns the table name for GORM
+func (RefreshTokenModel) TableName() string {
+	return "refresh_tokens"
+}
+
+// ToEntity converts database model to domain entity
+func (m *RefreshTokenModel) ToEntity() *domain.RefreshToken {
+	token := &domain.RefreshToken{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		FamilyID:  m.FamilyID,
+		DeviceID:  m.DeviceID,
+		TokenHash: m.TokenHash,
+		ExpiresAt: m.ExpiresAt,
+		CreatedAt: m.CreatedAt,
+		RotatedAt: m.RotatedAt,
+		RevokedAt: m.RevokedAt,
+	}
+	if m.ReplacedByID != nil {
+		token.ReplacedByID = *m.ReplacedByID
+	}
+	if m.RevokedReason != nil {
+		token.RevokedReason = *m.RevokedReason
+	}
+
+	return token
+}
+
+// FromEntity converts domain entity to database model
+func (m *RefreshTokenModel) FromEntity(token *domain.RefreshToken) {
+	m.ID = token.ID
+	m.UserID = token.UserID
+	m.FamilyID = token.FamilyID
+	m.DeviceID = token.DeviceID
+	m.TokenHash = token.TokenHash
+	m.ExpiresAt = token.ExpiresAt
+	m.CreatedAt = token.CreatedAt
+	m.RotatedAt = token.RotatedAt
+	m.RevokedAt = token.RevokedAt
+	m.ReplacedByID = nil
+	if token.ReplacedByID != "" {
+		m.ReplacedByID = &token.ReplacedByID
+	}
+	m.RevokedReason = nil
+	if token.RevokedReason != "" {
+		m.RevokedReason = &token.RevokedReason
+	}
+}
+
+// PostgreSQLRefreshTokenRepository implements RefreshTokenRepository using PostgreSQL
+type PostgreSQLRefreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLRefreshTokenRepository creates a new PostgreSQL refresh token repository
+func NewPostgreSQLRefreshTokenRepository(db *gorm.DB) *PostgreSQLRefreshTokenRepository {
+	return &PostgreSQLRefreshTokenRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLRefreshTokenRepositoryFromManager creates repository using database manager
+func NewPostgreSQLRefreshTokenRepositoryFromManager() (*PostgreSQLRefreshTokenRepository, error) {
+	db, err := userdb.GetUserDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user database: %w", err)
+	}
+
+	return &PostgreSQLRefreshTokenRepository{
+		db: db,
+	}, nil
+}
+
+// Create stores a new refresh token
+func (r *PostgreSQLRefreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
+	model := &RefreshTokenModel{}
+	model.FromEntity(token)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	return nil
+}
+
+// GetByHash retrieves a refresh token by the hash of its value
+func (r *PostgreSQLRefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	var model RefreshTokenModel
+	result := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get refresh token: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// Rotate retires current in favor of next and stores next atomically
+// The conditional update lets only one of several concurrent refreshes with the same token win
+func (r *PostgreSQLRefreshTokenRepository) Rotate(ctx context.Context, current, next *domain.RefreshToken) error {
+	now := time.Now().UTC()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&RefreshTokenModel{}).
+			Where("id = ? AND rotated_at IS NULL AND revoked_at IS NULL", current.ID).
+			Updates(map[string]interface{}{
+				"rotated_at":     now,
+				"replaced_by_id": next.ID,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to rotate refresh token: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrRefreshTokenUsed
+		}
+
+		model := &RefreshTokenModel{}
+		model.FromEntity(next)
+		if err := tx.Create(model).Error; err != nil {
+			return fmt.Errorf("failed to create refresh token: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	current.RotatedAt = &now
+	current.ReplacedByID = next.ID
+	return nil
+}
+
+// RevokeFamily revokes every active token rotated from the same login
+func (r *PostgreSQLRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID, reason string) error {
+	result := r.db.WithContext(ctx).Model(&RefreshTokenModel{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now().UTC(),
+			"revoked_reason": reason,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", result.Error)
+	}
+
+	return nil
+}
+
+// RevokeByUser revokes every active token of a user
+func (r *PostgreSQLRefreshTokenRepository) RevokeByUser(ctx context.Context, userID, reason string) error {
+	result := r.db.WithContext(ctx).Model(&RefreshTokenModel{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now().UTC(),
+			"revoked_reason": reason,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke refresh tokens of user: %w", result.Error)
+	}
+
+	return nil
+}
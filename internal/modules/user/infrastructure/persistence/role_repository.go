@@ -0,0 +1,208 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	userdb "golang_modular_monolith/internal/modules/user/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/tenancy"
+
+	"gorm.io/gorm"
+)
+
+// RoleModel represents the role database model
+type RoleModel struct {
+	Name        string                `gorm:"primaryKey;type:varchar(64)"`
+	Description string                `gorm:"type:varchar(255);not null;default:''"`
+	Permissions []RolePermissionModel `gorm:"foreignKey:RoleName;references:Name"`
+	CreatedAt   time.Time             `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt   time.Time             `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (RoleModel) TableName() string {
+	return "roles"
+}
+
+// RolePermissionModel represents a permission granted by a role
+type RolePermissionModel struct {
+	RoleName   string `gorm:"primaryKey;type:varchar(64)"`
+	Permission string `gorm:"primaryKey;type:varchar(128)"`
+}
+
+// TableName returns the table name for GORM
+func (RolePermissionModel) TableName() string {
+	return "role_permissions"
+}
+
+// ToEntity converts database model to domain entity
+func (m *RoleModel) ToEntity() *domain.Role {
+	permissions := make([]string, len(m.Permissions))
+	for i, permission := range m.Permissions {
+		permissions[i] = permission.Permission
+	}
+
+	return &domain.Role{
+		Name:        m.Name,
+		Description: m.Description,
+		Permissions: permissions,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+// PostgreSQLRoleRepository implements RoleRepository using PostgreSQL
+type PostgreSQLRoleRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLRoleRepository creates a new PostgreSQL role repository
+func NewPostgreSQLRoleRepository(db *gorm.DB) *PostgreSQLRoleRepository {
+	return &PostgreSQLRoleRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLRoleRepositoryFromManager creates repository using database manager
+func NewPostgreSQLRoleRepositoryFromManager() (*PostgreSQLRoleRepository, error) {
+	db, err := userdb.GetUserDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user database: %w", err)
+	}
+
+	return &PostgreSQLRoleRepository{
+		db: db,
+	}, nil
+}
+
+// Save saves a role and replaces its permissions (create or update)
+func (r *PostgreSQLRoleRepository) Save(ctx context.Context, role *domain.Role) error {
+	model := &RoleModel{
+		Name:        role.Name,
+		Description: role.Description,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Permissions").Save(model).Error; err != nil {
+			return fmt.Errorf("failed to save role: %w", err)
+		}
+
+		if err := tx.Where("role_name = ?", role.Name).Delete(&RolePermissionModel{}).Error; err != nil {
+			return fmt.Errorf("failed to clear role permissions: %w", err)
+		}
+
+		if len(role.Permissions) == 0 {
+			return nil
+		}
+
+		permissions := make([]RolePermissionModel, len(role.Permissions))
+		for i, permission := range role.Permissions {
+			permissions[i] = RolePermissionModel{RoleName: role.Name, Permission: permission}
+		}
+		if err := tx.Create(&permissions).Error; err != nil {
+			return fmt.Errorf("failed to save role permissions: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetByName retrieves a role by name
+func (r *PostgreSQLRoleRepository) GetByName(ctx context.Context, name string) (*domain.Role, error) {
+	var model RoleModel
+	result := r.db.WithContext(ctx).
+		Preload("Permissions", orderByPermission).
+		Where("name = ?", name).
+		First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get role: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// GetByNames retrieves the roles with the given names, skipping unknown names
+func (r *PostgreSQLRoleRepository) GetByNames(ctx context.Context, names []string) ([]*domain.Role, error) {
+	if len(names) == 0 {
+		return []*domain.Role{}, nil
+	}
+
+	var models []RoleModel
+	result := r.db.WithContext(ctx).
+		Preload("Permissions", orderByPermission).
+		Where("name IN ?", names).
+		Order("name").
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", result.Error)
+	}
+
+	return toRoles(models), nil
+}
+
+// List retrieves all roles ordered by name
+func (r *PostgreSQLRoleRepository) List(ctx context.Context) ([]*domain.Role, error) {
+	var models []RoleModel
+	result := r.db.WithContext(ctx).
+		Preload("Permissions", orderByPermission).
+		Order("name").
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", result.Error)
+	}
+
+	return toRoles(models), nil
+}
+
+// Delete deletes a role; its permissions and assignments are removed by the foreign keys
+func (r *PostgreSQLRoleRepository) Delete(ctx context.Context, name string) error {
+	result := r.db.WithContext(ctx).Where("name = ?", name).Delete(&RoleModel{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete role: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return shareddomain.ErrNotFound
+	}
+
+	return nil
+}
+
+// CountActiveUsersWithRole counts the active users holding a role, within the tenant of ctx when it
+// serves one
+func (r *PostgreSQLRoleRepository) CountActiveUsersWithRole(ctx context.Context, name string) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&UserRoleModel{}).
+		Joins("JOIN users ON users.id = user_roles.user_id").
+		Where("user_roles.role_name = ? AND users.status = ?", name, string(domain.UserStatusActive)).
+		Scopes(tenancy.Scope(ctx, "users.tenant_id")).
+		Count(&count)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to count users with role: %w", result.Error)
+	}
+
+	return count, nil
+}
+
+// orderByPermission sorts preloaded permissions
+func orderByPermission(db *gorm.DB) *gorm.DB {
+	return db.Order("permission")
+}
+
+// toRoles converts database models to domain entities
+func toRoles(models []RoleModel) []*domain.Role {
+	roles := make([]*domain.Role, len(models))
+	for i := range models {
+		roles[i] = models[i].ToEntity()
+	}
+	return roles
+}
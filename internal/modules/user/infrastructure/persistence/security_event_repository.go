@@ -0,0 +1,157 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	userdb "golang_modular_monolith/internal/modules/user/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// SecurityEventModel represents the security event database model
+type SecurityEventModel struct {
+	ID         string    `gorm:"primaryKey;type:varchar(36)"`
+	Type       string    `gorm:"type:varchar(50);not null;index"`
+	UserID     string    `gorm:"type:varchar(36);not null;default:'';index"`
+	Email      string    `gorm:"type:varchar(255);not null;default:''"`
+	Actor      string    `gorm:"type:varchar(100);not null"`
+	IPAddress  string    `gorm:"type:varchar(45);not null;default:''"`
+	UserAgent  string    `gorm:"type:varchar(512);not null;default:''"`
+	Detail     string    `gorm:"type:varchar(255);not null;default:''"`
+	RequestID  string    `gorm:"type:varchar(128);not null;default:''"`
+	OccurredAt time.Time `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP;index"`
+}
+
+// TableName returns the table name for GORM
+func (SecurityEventModel) TableName() string {
+	return "security_events"
+}
+
+// ToEntity converts database model to domain entity
+func (m *SecurityEventModel) ToEntity() *domain.SecurityEvent {
+	return &domain.SecurityEvent{
+		ID:         m.ID,
+		Type:       m.Type,
+		UserID:     m.UserID,
+		Email:      m.Email,
+		Actor:      m.Actor,
+		IPAddress:  m.IPAddress,
+		UserAgent:  m.UserAgent,
+		Detail:     m.Detail,
+		RequestID:  m.RequestID,
+		OccurredAt: m.OccurredAt,
+	}
+}
+
+// FromEntity converts domain entity to database model
+// Values longer than their columns are truncated; the user agent is sent by the client
+func (m *SecurityEventModel) FromEntity(event *domain.SecurityEvent) {
+	m.ID = event.ID
+	m.Type = event.Type
+	m.UserID = event.UserID
+	m.Email = truncate(event.Email, 255)
+	m.Actor = truncate(event.Actor, 100)
+	m.IPAddress = truncate(event.IPAddress, 45)
+	m.UserAgent = truncate(event.UserAgent, 512)
+	m.Detail = truncate(event.Detail, 255)
+	m.RequestID = truncate(event.RequestID, 128)
+	m.OccurredAt = event.OccurredAt
+}
+
+// PostgreSQLSecurityEventRepository implements SecurityEventRepository using PostgreSQL
+type PostgreSQLSecurityEventRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLSecurityEventRepository creates a new PostgreSQL security event repository
+func NewPostgreSQLSecurityEventRepository(db *gorm.DB) *PostgreSQLSecurityEventRepository {
+	return &PostgreSQLSecurityEventRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLSecurityEventRepositoryFromManager creates repository using database manager
+func NewPostgreSQLSecurityEventRepositoryFromManager() (*PostgreSQLSecurityEventRepository, error) {
+	db, err := userdb.GetUserDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user database: %w", err)
+	}
+
+	return &PostgreSQLSecurityEventRepository{
+		db: db,
+	}, nil
+}
+
+// Record stores a security event
+func (r *PostgreSQLSecurityEventRepository) Record(ctx context.Context, event *domain.SecurityEvent) error {
+	model := &SecurityEventModel{}
+	model.FromEntity(event)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to record security event: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves a page of the events matching the filter, newest first
+func (r *PostgreSQLSecurityEventRepository) List(ctx context.Context, filter domain.SecurityEventFilter) (*domain.SecurityEventListResult, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := r.db.WithContext(ctx).Model(&SecurityEventModel{})
+
+	// Apply filters
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.RequestID != "" {
+		query = query.Where("request_id = ?", filter.RequestID)
+	}
+	if filter.Since != nil {
+		query = query.Where("occurred_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		query = query.Where("occurred_at < ?", *filter.Until)
+	}
+
+	// Count total records
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count security events: %w", err)
+	}
+
+	var models []SecurityEventModel
+	if err := query.Order("occurred_at DESC, id").Offset(filter.GetOffset()).Limit(filter.Limit).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list security events: %w", err)
+	}
+
+	events := make([]*domain.SecurityEvent, len(models))
+	for i := range models {
+		events[i] = models[i].ToEntity()
+	}
+
+	return &domain.SecurityEventListResult{
+		Events:     events,
+		Pagination: domain.NewPaginationResult(filter.Page, filter.Limit, total),
+	}, nil
+}
+
+// truncate shortens s to at most limit bytes without splitting a UTF-8 character
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit]
+}
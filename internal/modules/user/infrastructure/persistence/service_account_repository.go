@@ -0,0 +1,153 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	userdb "golang_modular_monolith/internal/modules/user/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"gorm.io/gorm"
+)
+
+// ServiceAccountModel represents the service account database model
+type ServiceAccountModel struct {
+	ID          string     `gorm:"primaryKey;type:varchar(36)"`
+	Name        string     `gorm:"type:varchar(100);not null;index"`
+	Description string     `gorm:"type:varchar(255);not null;default:''"`
+	SecretHash  string     `gorm:"type:char(64);not null"`
+	Scopes      StringList `gorm:"type:jsonb;not null"`
+	CreatedBy   *string    `gorm:"type:varchar(36)"`
+	LastUsedAt  *time.Time `gorm:"type:timestamp with time zone"`
+	DisabledAt  *time.Time `gorm:"type:timestamp with time zone"`
+	CreatedAt   time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt   time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (ServiceAccountModel) TableName() string {
+	return "service_accounts"
+}
+
+// ToEntity converts database model to domain entity
+func (m *ServiceAccountModel) ToEntity() *domain.ServiceAccount {
+	account := &domain.ServiceAccount{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		SecretHash:  m.SecretHash,
+		Scopes:      []string(m.Scopes),
+		LastUsedAt:  m.LastUsedAt,
+		DisabledAt:  m.DisabledAt,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+	if m.CreatedBy != nil {
+		account.CreatedBy = *m.CreatedBy
+	}
+
+	return account
+}
+
+// FromEntity converts domain entity to database model
+func (m *ServiceAccountModel) FromEntity(account *domain.ServiceAccount) {
+	m.ID = account.ID
+	m.Name = account.Name
+	m.Description = account.Description
+	m.SecretHash = account.SecretHash
+	m.Scopes = StringList(account.Scopes)
+	m.LastUsedAt = account.LastUsedAt
+	m.DisabledAt = account.DisabledAt
+	m.CreatedAt = account.CreatedAt
+	m.UpdatedAt = account.UpdatedAt
+	m.CreatedBy = nil
+	if account.CreatedBy != "" {
+		m.CreatedBy = &account.CreatedBy
+	}
+}
+
+// PostgreSQLServiceAccountRepository implements ServiceAccountRepository using PostgreSQL
+type PostgreSQLServiceAccountRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLServiceAccountRepository creates a new PostgreSQL service account repository
+func NewPostgreSQLServiceAccountRepository(db *gorm.DB) *PostgreSQLServiceAccountRepository {
+	return &PostgreSQLServiceAccountRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLServiceAccountRepositoryFromManager creates repository using database manager
+func NewPostgreSQLServiceAccountRepositoryFromManager() (*PostgreSQLServiceAccountRepository, error) {
+	db, err := userdb.GetUserDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user database: %w", err)
+	}
+
+	return &PostgreSQLServiceAccountRepository{
+		db: db,
+	}, nil
+}
+
+// Save saves a service account (create or update)
+func (r *PostgreSQLServiceAccountRepository) Save(ctx context.Context, account *domain.ServiceAccount) error {
+	model := &ServiceAccountModel{}
+	model.FromEntity(account)
+
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return fmt.Errorf("failed to save service account: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a service account by ID
+func (r *PostgreSQLServiceAccountRepository) GetByID(ctx context.Context, id string) (*domain.ServiceAccount, error) {
+	var model ServiceAccountModel
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get service account: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// List retrieves service accounts ordered by name
+func (r *PostgreSQLServiceAccountRepository) List(ctx context.Context, includeDisabled bool) ([]*domain.ServiceAccount, error) {
+	query := r.db.WithContext(ctx).Order("name, id")
+	if !includeDisabled {
+		query = query.Where("disabled_at IS NULL")
+	}
+
+	var models []ServiceAccountModel
+	if err := query.Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+
+	accounts := make([]*domain.ServiceAccount, len(models))
+	for i := range models {
+		accounts[i] = models[i].ToEntity()
+	}
+	return accounts, nil
+}
+
+// TouchLastUsed records that an account obtained a token
+// The conditional update skips accounts whose last use was recorded less than a minute ago
+func (r *PostgreSQLServiceAccountRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&ServiceAccountModel{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", id, usedAt.Add(-lastUsedResolution)).
+		Update("last_used_at", usedAt)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update service account last use: %w", result.Error)
+	}
+
+	return nil
+}
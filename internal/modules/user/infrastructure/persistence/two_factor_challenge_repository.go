@@ -0,0 +1,141 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	userdb "golang_modular_monolith/internal/modules/user/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"gorm.io/gorm"
+)
+
+// TwoFactorChallengeModel represents the two-factor challenge database model
+type TwoFactorChallengeModel struct {
+	ID        string     `gorm:"primaryKey;type:varchar(36)"`
+	UserID    string     `gorm:"type:varchar(36);not null;index"`
+	TokenHash string     `gorm:"type:char(64);not null;unique"`
+	DeviceID  string     `gorm:"type:varchar(128);not null;default:''"`
+	Attempts  int        `gorm:"not null;default:0"`
+	ExpiresAt time.Time  `gorm:"type:timestamp with time zone;not null"`
+	UsedAt    *time.Time `gorm:"type:timestamp with time zone"`
+	CreatedAt time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (TwoFactorChallengeModel) TableName() string {
+	return "two_factor_challenges"
+}
+
+// ToEntity converts database model to domain entity
+func (m *TwoFactorChallengeModel) ToEntity() *domain.TwoFactorChallenge {
+	return &domain.TwoFactorChallenge{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		TokenHash: m.TokenHash,
+		DeviceID:  m.DeviceID,
+		Attempts:  m.Attempts,
+		ExpiresAt: m.ExpiresAt,
+		UsedAt:    m.UsedAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// FromEntity converts domain entity to database model
+func (m *TwoFactorChallengeModel) FromEntity(challenge *domain.TwoFactorChallenge) {
+	m.ID = challenge.ID
+	m.UserID = challenge.UserID
+	m.TokenHash = challenge.TokenHash
+	m.DeviceID = challenge.DeviceID
+	m.Attempts = challenge.Attempts
+	m.ExpiresAt = challenge.ExpiresAt
+	m.UsedAt = challenge.UsedAt
+	m.CreatedAt = challenge.CreatedAt
+}
+
+// PostgreSQLTwoFactorChallengeRepository implements TwoFactorChallengeRepository using PostgreSQL
+type PostgreSQLTwoFactorChallengeRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLTwoFactorChallengeRepository creates a new PostgreSQL two-factor challenge repository
+func NewPostgreSQLTwoFactorChallengeRepository(db *gorm.DB) *PostgreSQLTwoFactorChallengeRepository {
+	return &PostgreSQLTwoFactorChallengeRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLTwoFactorChallengeRepositoryFromManager creates repository using database manager
+func NewPostgreSQLTwoFactorChallengeRepositoryFromManager() (*PostgreSQLTwoFactorChallengeRepository, error) {
+	db, err := userdb.GetUserDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user database: %w", err)
+	}
+
+	return &PostgreSQLTwoFactorChallengeRepository{
+		db: db,
+	}, nil
+}
+
+// Create stores a new challenge
+func (r *PostgreSQLTwoFactorChallengeRepository) Create(ctx context.Context, challenge *domain.TwoFactorChallenge) error {
+	model := &TwoFactorChallengeModel{}
+	model.FromEntity(challenge)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to create two-factor challenge: %w", err)
+	}
+
+	return nil
+}
+
+// GetByHash retrieves a challenge by the hash of its token
+func (r *PostgreSQLTwoFactorChallengeRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.TwoFactorChallenge, error) {
+	var model TwoFactorChallengeModel
+	result := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get two-factor challenge: %w", result.Error)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// RecordAttempt counts a wrong code entered for the challenge
+// The counter is incremented in the database, so concurrent attempts are all counted
+func (r *PostgreSQLTwoFactorChallengeRepository) RecordAttempt(ctx context.Context, challenge *domain.TwoFactorChallenge) error {
+	result := r.db.WithContext(ctx).Model(&TwoFactorChallengeModel{}).
+		Where("id = ?", challenge.ID).
+		Update("attempts", gorm.Expr("attempts + 1"))
+	if result.Error != nil {
+		return fmt.Errorf("failed to record two-factor attempt: %w", result.Error)
+	}
+
+	challenge.Attempts++
+	return nil
+}
+
+// MarkUsed marks an unused challenge as used
+// The conditional update lets only one of several concurrent logins with the same challenge win
+func (r *PostgreSQLTwoFactorChallengeRepository) MarkUsed(ctx context.Context, challenge *domain.TwoFactorChallenge) error {
+	now := time.Now().UTC()
+
+	result := r.db.WithContext(ctx).Model(&TwoFactorChallengeModel{}).
+		Where("id = ? AND used_at IS NULL AND attempts < ?", challenge.ID, domain.MaxTwoFactorChallengeAttempts).
+		Update("used_at", now)
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark two-factor challenge used: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrTwoFactorChallengeUsed
+	}
+
+	challenge.UsedAt = &now
+	return nil
+}
@@ -0,0 +1,255 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	userdb "golang_modular_monolith/internal/modules/user/infrastructure/database"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserModel represents the user database model
+// Users belong to the tenant they registered in; emails are unique within a tenant
+type UserModel struct {
+	ID                     string     `gorm:"primaryKey;type:varchar(36)"`
+	TenantID               string     `gorm:"type:varchar(64);not null;default:'';uniqueIndex:idx_users_tenant_email"`
+	Email                  string     `gorm:"type:varchar(255);not null;uniqueIndex:idx_users_tenant_email"`
+	Name                   string     `gorm:"type:varchar(255);not null;default:''"`
+	PasswordHash           string     `gorm:"type:varchar(255);not null"`
+	Status                 string     `gorm:"type:varchar(16);not null;default:active"`
+	EmailVerifiedAt        *time.Time `gorm:"type:timestamp with time zone"`
+	PasswordChangeRequired bool       `gorm:"not null;default:false"`
+	FailedLoginAttempts    int        `gorm:"not null;default:0"`
+	LockCount              int        `gorm:"not null;default:0"`
+	LockedUntil            *time.Time `gorm:"type:timestamp with time zone"`
+	TwoFactorSecret        string     `gorm:"type:varchar(64);not null;default:''"`
+	TwoFactorPendingSecret string     `gorm:"type:varchar(64);not null;default:''"`
+	TwoFactorEnabledAt     *time.Time `gorm:"type:timestamp with time zone"`
+	TwoFactorLastStep      int64      `gorm:"not null;default:0"`
+	RecoveryCodeHashes     StringList `gorm:"type:jsonb;not null"`
+	Version                int        `gorm:"not null;default:0"`
+	CreatedAt              time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt              time.Time  `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (UserModel) TableName() string {
+	return "users"
+}
+
+// UserRoleModel represents the assignment of a role to a user
+type UserRoleModel struct {
+	UserID     string    `gorm:"primaryKey;type:varchar(36)"`
+	RoleName   string    `gorm:"primaryKey;type:varchar(64)"`
+	AssignedAt time.Time `gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName returns the table name for GORM
+func (UserRoleModel) TableName() string {
+	return "user_roles"
+}
+
+// ToEntity converts database model to domain entity
+func (m *UserModel) ToEntity(roles []string) (*domain.User, error) {
+	email, err := domain.NewEmail(m.Email)
+	if err != nil {
+		return nil, fmt.Errorf("invalid email in database: %w", err)
+	}
+
+	user := &domain.User{
+		BaseAggregateRoot:      shareddomain.NewBaseAggregateRootWithID(m.ID),
+		Email:                  email,
+		Name:                   m.Name,
+		PasswordHash:           m.PasswordHash,
+		Status:                 domain.UserStatus(m.Status),
+		Roles:                  roles,
+		EmailVerifiedAt:        m.EmailVerifiedAt,
+		PasswordChangeRequired: m.PasswordChangeRequired,
+		FailedLoginAttempts:    m.FailedLoginAttempts,
+		LockCount:              m.LockCount,
+		LockedUntil:            m.LockedUntil,
+		TwoFactorSecret:        m.TwoFactorSecret,
+		TwoFactorPendingSecret: m.TwoFactorPendingSecret,
+		TwoFactorEnabledAt:     m.TwoFactorEnabledAt,
+		TwoFactorLastStep:      m.TwoFactorLastStep,
+		RecoveryCodeHashes:     m.RecoveryCodeHashes,
+	}
+
+	// Set version and timestamps from database
+	user.Version = m.Version
+	user.CreatedAt = m.CreatedAt
+	user.UpdatedAt = m.UpdatedAt
+
+	return user, nil
+}
+
+// FromEntity converts domain entity to database model
+func (m *UserModel) FromEntity(user *domain.User) {
+	m.ID = user.GetID()
+	m.Email = user.Email.Value
+	m.Name = user.Name
+	m.PasswordHash = user.PasswordHash
+	m.Status = string(user.Status)
+	m.EmailVerifiedAt = user.EmailVerifiedAt
+	m.PasswordChangeRequired = user.PasswordChangeRequired
+	m.FailedLoginAttempts = user.FailedLoginAttempts
+	m.LockCount = user.LockCount
+	m.LockedUntil = user.LockedUntil
+	m.TwoFactorSecret = user.TwoFactorSecret
+	m.TwoFactorPendingSecret = user.TwoFactorPendingSecret
+	m.TwoFactorEnabledAt = user.TwoFactorEnabledAt
+	m.TwoFactorLastStep = user.TwoFactorLastStep
+	m.RecoveryCodeHashes = user.RecoveryCodeHashes
+	m.Version = user.GetVersion()
+	m.CreatedAt = user.GetCreatedAt()
+	m.UpdatedAt = user.GetUpdatedAt()
+}
+
+// PostgreSQLUserRepository implements UserRepository using PostgreSQL
+type PostgreSQLUserRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgreSQLUserRepository creates a new PostgreSQL user repository
+func NewPostgreSQLUserRepository(db *gorm.DB) *PostgreSQLUserRepository {
+	return &PostgreSQLUserRepository{
+		db: db,
+	}
+}
+
+// NewPostgreSQLUserRepositoryFromManager creates repository using database manager
+func NewPostgreSQLUserRepositoryFromManager() (*PostgreSQLUserRepository, error) {
+	db, err := userdb.GetUserDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user database: %w", err)
+	}
+
+	return &PostgreSQLUserRepository{
+		db: db,
+	}, nil
+}
+
+// Save saves a user and its role assignments (create or update)
+func (r *PostgreSQLUserRepository) Save(ctx context.Context, user *domain.User) error {
+	model := &UserModel{}
+	model.FromEntity(user)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(model).Error; err != nil {
+			// A concurrent registration may have claimed the email after the uniqueness check
+			if isUniqueViolationError(err) {
+				return shareddomain.NewDomainErrorWithField(
+					shareddomain.ErrCodeAlreadyExists,
+					"user with this email already exists",
+					"email",
+				)
+			}
+			return fmt.Errorf("failed to save user: %w", err)
+		}
+
+		return saveUserRoles(tx, user)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Clear uncommitted events after successful save
+	user.ClearUncommittedEvents()
+
+	return nil
+}
+
+// GetByID retrieves a user by ID
+func (r *PostgreSQLUserRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
+	var model UserModel
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get user by ID: %w", result.Error)
+	}
+
+	return r.toEntity(ctx, &model)
+}
+
+// GetByEmail retrieves a user by normalized email
+func (r *PostgreSQLUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	var model UserModel
+	result := r.db.WithContext(ctx).Where("email = ?", email).First(&model)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, shareddomain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get user by email: %w", result.Error)
+	}
+
+	return r.toEntity(ctx, &model)
+}
+
+// ExistsByEmail checks if a user exists by normalized email
+func (r *PostgreSQLUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&UserModel{}).
+		Where("email = ?", email).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to check user existence by email: %w", result.Error)
+	}
+
+	return count > 0, nil
+}
+
+// toEntity loads the user's roles and converts the model to a domain entity
+func (r *PostgreSQLUserRepository) toEntity(ctx context.Context, model *UserModel) (*domain.User, error) {
+	roles := []string{}
+	result := r.db.WithContext(ctx).Model(&UserRoleModel{}).
+		Where("user_id = ?", model.ID).
+		Order("role_name").
+		Pluck("role_name", &roles)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get user roles: %w", result.Error)
+	}
+
+	return model.ToEntity(roles)
+}
+
+// saveUserRoles replaces the stored role assignments with the user's roles
+func saveUserRoles(tx *gorm.DB, user *domain.User) error {
+	removed := tx.Where("user_id = ?", user.GetID())
+	if len(user.Roles) > 0 {
+		removed = removed.Where("role_name NOT IN ?", user.Roles)
+	}
+	if err := removed.Delete(&UserRoleModel{}).Error; err != nil {
+		return fmt.Errorf("failed to remove user roles: %w", err)
+	}
+
+	if len(user.Roles) == 0 {
+		return nil
+	}
+
+	assignments := make([]UserRoleModel, len(user.Roles))
+	for i, role := range user.Roles {
+		assignments[i] = UserRoleModel{UserID: user.GetID(), RoleName: role, AssignedAt: time.Now().UTC()}
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&assignments).Error; err != nil {
+		return fmt.Errorf("failed to assign user roles: %w", err)
+	}
+
+	return nil
+}
+
+// isUniqueViolationError checks if the error is a PostgreSQL unique_violation (SQLSTATE 23505)
+func isUniqueViolationError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "SQLSTATE 23505")
+}
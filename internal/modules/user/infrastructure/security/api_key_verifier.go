@@ -0,0 +1,59 @@
+package security
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+)
+
+// APIKeyVerifier implements auth.APIKeyVerifier from the stored API keys
+type APIKeyVerifier struct {
+	apiKeys domain.APIKeyRepository
+	logger  *zap.Logger
+}
+
+// NewAPIKeyVerifier creates a new API key verifier
+func NewAPIKeyVerifier(apiKeys domain.APIKeyRepository, logger *zap.Logger) *APIKeyVerifier {
+	return &APIKeyVerifier{
+		apiKeys: apiKeys,
+		logger:  logger,
+	}
+}
+
+// VerifyAPIKey implements auth.APIKeyVerifier
+// The principal carries the key's scopes, which the authorizer checks instead of user roles
+func (v *APIKeyVerifier) VerifyAPIKey(ctx context.Context, key string) (*auth.Principal, error) {
+	if !strings.HasPrefix(key, domain.APIKeyPrefix) {
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	apiKey, err := v.apiKeys.GetByHash(ctx, domain.HashAPIKey(key))
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, auth.ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if !apiKey.IsActive(now) {
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	// Failing to record the last use must not fail the request
+	if err := v.apiKeys.TouchLastUsed(ctx, apiKey.ID, now); err != nil {
+		v.logger.Warn("failed to record API key use", zap.String("api_key_id", apiKey.ID), zap.Error(err))
+	}
+
+	return &auth.Principal{
+		APIKeyID: apiKey.ID,
+		Scopes:   apiKey.Scopes,
+		TenantID: apiKey.TenantID,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package security
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepThreshold is the number of tracked IPs above which expired entries are dropped
+const sweepThreshold = 10000
+
+// MemoryLoginThrottle implements domain.LoginThrottle in memory
+// Failures are counted per IP in fixed windows; an IP reaching the limit is blocked until
+// a full window has passed. Counts are per process, so each instance throttles on its own
+type MemoryLoginThrottle struct {
+	maxFailures int
+	window      time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*throttleEntry
+}
+
+// throttleEntry tracks the failed logins of one IP
+type throttleEntry struct {
+	failures     int
+	windowStart  time.Time
+	blockedUntil time.Time
+}
+
+// NewMemoryLoginThrottle creates a new in-memory login throttle
+func NewMemoryLoginThrottle(maxFailures int, window time.Duration) *MemoryLoginThrottle {
+	return &MemoryLoginThrottle{
+		maxFailures: maxFailures,
+		window:      window,
+		entries:     make(map[string]*throttleEntry),
+	}
+}
+
+// BlockedUntil implements domain.LoginThrottle
+func (t *MemoryLoginThrottle) BlockedUntil(ip string, now time.Time) (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entry, ok := t.entries[ip]
+	if !ok || !now.Before(entry.blockedUntil) {
+		return time.Time{}, false
+	}
+	return entry.blockedUntil, true
+}
+
+// RecordFailure implements domain.LoginThrottle
+func (t *MemoryLoginThrottle) RecordFailure(ip string, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entry, ok := t.entries[ip]
+	if !ok || !now.Before(entry.windowStart.Add(t.window)) {
+		if len(t.entries) >= sweepThreshold {
+			t.sweep(now)
+		}
+		entry = &throttleEntry{windowStart: now}
+		t.entries[ip] = entry
+	}
+
+	entry.failures++
+	if entry.failures >= t.maxFailures {
+		entry.blockedUntil = now.Add(t.window)
+	}
+}
+
+// sweep drops the entries whose window and block have both ended
+func (t *MemoryLoginThrottle) sweep(now time.Time) {
+	for ip, entry := range t.entries {
+		if !now.Before(entry.windowStart.Add(t.window)) && !now.Before(entry.blockedUntil) {
+			delete(t.entries, ip)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+)
+
+// Supported password hashing algorithms
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+)
+
+// ErrUnknownHashFormat is returned when an encoded hash was not produced by a supported algorithm
+var ErrUnknownHashFormat = errors.New("unknown password hash format")
+
+// NewPasswordHasher creates a hasher that hashes new passwords with the given algorithm
+// Verification recognizes every supported format, so switching algorithms keeps existing users able to sign in
+func NewPasswordHasher(algorithm string) (domain.PasswordHasher, error) {
+	argon := NewArgon2idHasher(DefaultArgon2idParams())
+	bcryptHasher := NewBcryptHasher(bcrypt.DefaultCost)
+
+	switch strings.ToLower(strings.TrimSpace(algorithm)) {
+	case "", AlgorithmArgon2id:
+		return &multiHasher{primary: argon, argon2id: argon, bcrypt: bcryptHasher}, nil
+	case AlgorithmBcrypt:
+		return &multiHasher{primary: bcryptHasher, argon2id: argon, bcrypt: bcryptHasher}, nil
+	default:
+		return nil, fmt.Errorf("unsupported password hashing algorithm %q (want %s or %s)", algorithm, AlgorithmArgon2id, AlgorithmBcrypt)
+	}
+}
+
+// multiHasher hashes with the configured algorithm and verifies any supported format
+type multiHasher struct {
+	primary  domain.PasswordHasher
+	argon2id *Argon2idHasher
+	bcrypt   *BcryptHasher
+}
+
+// Hash implements domain.PasswordHasher
+func (h *multiHasher) Hash(password string) (string, error) {
+	return h.primary.Hash(password)
+}
+
+// Verify implements domain.PasswordHasher
+func (h *multiHasher) Verify(encodedHash, password string) (bool, error) {
+	switch {
+	case strings.HasPrefix(encodedHash, "$argon2id$"):
+		return h.argon2id.Verify(encodedHash, password)
+	case strings.HasPrefix(encodedHash, "$2a$"), strings.HasPrefix(encodedHash, "$2b$"), strings.HasPrefix(encodedHash, "$2y$"):
+		return h.bcrypt.Verify(encodedHash, password)
+	default:
+		return false, ErrUnknownHashFormat
+	}
+}
+
+// Argon2idParams are the cost parameters of Argon2id hashing
+type Argon2idParams struct {
+	Memory      uint32 // KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2idParams returns the second recommended option of RFC 9106 (64 MiB, 3 passes)
+func DefaultArgon2idParams() Argon2idParams {
+	return Argon2idParams{
+		Memory:      64 * 1024,
+		Iterations:  3,
+		Parallelism: 4,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+// Argon2idHasher hashes passwords with Argon2id
+// Hashes are encoded in the PHC string format: $argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>
+type Argon2idHasher struct {
+	params Argon2idParams
+}
+
+// NewArgon2idHasher creates a new Argon2id hasher
+func NewArgon2idHasher(params Argon2idParams) *Argon2idHasher {
+	return &Argon2idHasher{
+		params: params,
+	}
+}
+
+// Hash implements domain.PasswordHasher
+func (h *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify implements domain.PasswordHasher
+// The parameters stored in the hash are used, so hashes created with older parameters still verify
+func (h *Argon2idHasher) Verify(encodedHash, password string) (bool, error) {
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return false, ErrUnknownHashFormat
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, ErrUnknownHashFormat
+	}
+
+	var params Argon2idParams
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return false, ErrUnknownHashFormat
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, ErrUnknownHashFormat
+	}
+	expected, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(expected) == 0 {
+		return false, ErrUnknownHashFormat
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(expected)))
+
+	return subtle.ConstantTimeCompare(key, expected) == 1, nil
+}
+
+// BcryptHasher hashes passwords with bcrypt
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher creates a new bcrypt hasher
+func NewBcryptHasher(cost int) *BcryptHasher {
+	return &BcryptHasher{
+		cost: cost,
+	}
+}
+
+// Hash implements domain.PasswordHasher
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
+
+// Verify implements domain.PasswordHasher
+func (h *BcryptHasher) Verify(encodedHash, password string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encodedHash), []byte(password))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return false, ErrUnknownHashFormat
+}
@@ -0,0 +1,155 @@
+package security
+
+import (
+	"context"
+	"fmt"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/authz"
+)
+
+// RBACAuthorizer implements authz.Authorizer from the roles assigned to users and the scopes of
+// API keys and service accounts
+// Roles and service accounts are read on every check, so role changes, narrowed scopes and disabled
+// accounts apply to tokens that were already issued
+type RBACAuthorizer struct {
+	users           domain.UserRepository
+	roles           domain.RoleRepository
+	serviceAccounts domain.ServiceAccountRepository
+	twoFactor       domain.TwoFactorPolicy
+}
+
+// NewRBACAuthorizer creates a new RBAC authorizer
+// Users whose roles require two-factor authentication hold no permissions until they enable it
+func NewRBACAuthorizer(
+	users domain.UserRepository,
+	roles domain.RoleRepository,
+	serviceAccounts domain.ServiceAccountRepository,
+	twoFactor domain.TwoFactorPolicy,
+) *RBACAuthorizer {
+	return &RBACAuthorizer{
+		users:           users,
+		roles:           roles,
+		serviceAccounts: serviceAccounts,
+		twoFactor:       twoFactor,
+	}
+}
+
+// Authorize implements authz.Authorizer
+// Disabled and deleted users hold no permissions; API keys hold exactly their scopes, service
+// account tokens the scopes they were issued with that their account still holds
+// Policies are checked against the user itself, whatever their roles
+// Impersonated users hold their own permissions for as long as the impersonator may impersonate
+func (a *RBACAuthorizer) Authorize(ctx context.Context, principal *auth.Principal, permission string) error {
+	if principal == nil {
+		return authz.Forbidden(permission)
+	}
+	if principal.IsAPIKey() {
+		// Policies describe users, so API keys never satisfy them
+		if !authz.IsPolicy(permission) && domain.PermissionGranted(principal.Scopes, permission) {
+			return nil
+		}
+		return authz.Forbidden(permission)
+	}
+	if principal.IsServiceAccount() {
+		return a.authorizeServiceAccount(ctx, principal, permission)
+	}
+	if principal.UserID == "" {
+		return authz.Forbidden(permission)
+	}
+	if principal.IsImpersonated() {
+		if err := a.checkImpersonator(ctx, principal.ImpersonatorID); err != nil {
+			return err
+		}
+	}
+
+	user, err := a.users.GetByID(ctx, principal.UserID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return authz.Forbidden(permission)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if user.IsActive() && a.twoFactor.Requires(user) && !user.IsTwoFactorEnabled() {
+		return shareddomain.NewDomainError(shareddomain.ErrCodeForbidden, "two-factor authentication is required for your role, enable it first")
+	}
+
+	if authz.IsPolicy(permission) {
+		return checkPolicy(user, permission)
+	}
+
+	granted, err := domain.UserHasPermission(ctx, a.roles, user, permission)
+	if err != nil {
+		return err
+	}
+	if !granted {
+		return authz.Forbidden(permission)
+	}
+
+	return nil
+}
+
+// authorizeServiceAccount checks a permission of a service account token
+func (a *RBACAuthorizer) authorizeServiceAccount(ctx context.Context, principal *auth.Principal, permission string) error {
+	// Policies describe users, so service accounts never satisfy them
+	if authz.IsPolicy(permission) || !domain.PermissionGranted(principal.Scopes, permission) {
+		return authz.Forbidden(permission)
+	}
+
+	account, err := a.serviceAccounts.GetByID(ctx, principal.ServiceAccountID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return authz.Forbidden(permission)
+		}
+		return fmt.Errorf("failed to get service account: %w", err)
+	}
+	if !account.IsActive() || !domain.PermissionGranted(account.Scopes, permission) {
+		return authz.Forbidden(permission)
+	}
+
+	return nil
+}
+
+// checkImpersonator checks if the administrator behind an impersonation token may still impersonate
+// Deactivating the administrator or removing the permission ends their impersonations
+func (a *RBACAuthorizer) checkImpersonator(ctx context.Context, impersonatorID string) error {
+	revoked := shareddomain.NewDomainError(shareddomain.ErrCodeForbidden, "the impersonation is no longer allowed")
+
+	impersonator, err := a.users.GetByID(ctx, impersonatorID)
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return revoked
+		}
+		return fmt.Errorf("failed to get impersonator: %w", err)
+	}
+
+	granted, err := domain.UserHasPermission(ctx, a.roles, impersonator, domain.PermissionUsersImpersonate)
+	if err != nil {
+		return err
+	}
+	if !granted {
+		return revoked
+	}
+
+	return nil
+}
+
+// checkPolicy checks if an active user satisfies a policy
+func checkPolicy(user *domain.User, policy string) error {
+	if !user.IsActive() {
+		return authz.Forbidden(policy)
+	}
+
+	switch policy {
+	case authz.PolicyVerifiedEmail:
+		if !user.IsEmailVerified() {
+			return shareddomain.NewDomainError(shareddomain.ErrCodeForbidden, "email address is not verified")
+		}
+		return nil
+	default:
+		return authz.Forbidden(policy)
+	}
+}
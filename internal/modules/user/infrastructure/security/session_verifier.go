@@ -0,0 +1,55 @@
+package security
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+)
+
+// SessionVerifier implements auth.SessionVerifier from the session store
+type SessionVerifier struct {
+	sessions    domain.SessionStore
+	idleTimeout time.Duration
+	logger      *zap.Logger
+}
+
+// NewSessionVerifier creates a new session verifier
+func NewSessionVerifier(sessions domain.SessionStore, idleTimeout time.Duration, logger *zap.Logger) *SessionVerifier {
+	return &SessionVerifier{
+		sessions:    sessions,
+		idleTimeout: idleTimeout,
+		logger:      logger,
+	}
+}
+
+// VerifySession implements auth.SessionVerifier
+// Each verified request slides the session's idle expiry forward
+func (v *SessionVerifier) VerifySession(ctx context.Context, sessionID string) (*auth.Principal, error) {
+	session, err := v.sessions.Get(ctx, domain.HashSessionID(sessionID))
+	if err != nil {
+		if shareddomain.IsNotFoundError(err) {
+			return nil, auth.ErrInvalidSession
+		}
+		return nil, err
+	}
+
+	if !time.Now().Before(session.ExpiresAt) {
+		return nil, auth.ErrInvalidSession
+	}
+
+	// Failing to extend the session must not fail the request
+	if err := v.sessions.Touch(ctx, session, v.idleTimeout); err != nil {
+		v.logger.Warn("failed to extend session", zap.String("user_id", session.UserID), zap.Error(err))
+	}
+
+	return &auth.Principal{
+		UserID:   session.UserID,
+		Email:    session.Email,
+		TenantID: session.TenantID,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package security
+
+import (
+	"context"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+)
+
+// JWTTokenIssuer issues JWT access tokens through the shared token service
+type JWTTokenIssuer struct {
+	tokens *auth.TokenService
+}
+
+// NewJWTTokenIssuer creates a new JWT token issuer
+func NewJWTTokenIssuer(tokens *auth.TokenService) *JWTTokenIssuer {
+	return &JWTTokenIssuer{
+		tokens: tokens,
+	}
+}
+
+// IssueAccessToken implements domain.TokenIssuer
+func (i *JWTTokenIssuer) IssueAccessToken(ctx context.Context, user *domain.User) (domain.AccessToken, error) {
+	token, expiresAt, err := i.tokens.Issue(auth.Principal{
+		UserID:   user.GetID(),
+		Email:    user.Email.Value,
+		TenantID: tenantOf(ctx),
+	})
+	if err != nil {
+		return domain.AccessToken{}, err
+	}
+
+	return domain.AccessToken{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// IssueImpersonationToken implements domain.TokenIssuer
+func (i *JWTTokenIssuer) IssueImpersonationToken(ctx context.Context, user *domain.User, impersonatorID string, ttl time.Duration) (domain.AccessToken, error) {
+	token, expiresAt, err := i.tokens.IssueWithExpiry(auth.Principal{
+		UserID:         user.GetID(),
+		Email:          user.Email.Value,
+		ImpersonatorID: impersonatorID,
+		TenantID:       tenantOf(ctx),
+	}, ttl)
+	if err != nil {
+		return domain.AccessToken{}, err
+	}
+
+	return domain.AccessToken{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// IssueServiceAccountToken implements domain.TokenIssuer
+func (i *JWTTokenIssuer) IssueServiceAccountToken(ctx context.Context, account *domain.ServiceAccount, scopes []string, ttl time.Duration) (domain.AccessToken, error) {
+	token, expiresAt, err := i.tokens.IssueWithExpiry(auth.Principal{
+		ServiceAccountID: account.ID,
+		Scopes:           scopes,
+		TenantID:         tenantOf(ctx),
+	}, ttl)
+	if err != nil {
+		return domain.AccessToken{}, err
+	}
+
+	return domain.AccessToken{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// tenantOf returns the tenant served by ctx, "" when it serves none
+func tenantOf(ctx context.Context) string {
+	tenantID, _ := shareddomain.TenantIDFromContext(ctx)
+	return tenantID
+}
@@ -0,0 +1,130 @@
+package security
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang_modular_monolith/internal/modules/user/domain"
+)
+
+// rfcSecret is the SHA1 seed of the RFC 4226 and RFC 6238 test vectors, "12345678901234567890"
+var rfcSecret = totpEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestHOTP_RFC4226Vectors(t *testing.T) {
+	// RFC 4226 Appendix D
+	want := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+	for counter, code := range want {
+		if got := hotp([]byte("12345678901234567890"), int64(counter)); got != code {
+			t.Errorf("hotp(counter %d) = %s, want %s", counter, got, code)
+		}
+	}
+}
+
+func TestTOTPGenerator_RFC6238Vectors(t *testing.T) {
+	// RFC 6238 Appendix B, SHA1 mode; the RFC lists 8 digits, of which 6-digit codes are the last 6
+	tests := []struct {
+		unix int64
+		code string
+		step int64
+	}{
+		{unix: 59, code: "94287082", step: 0x1},
+		{unix: 1111111109, code: "07081804", step: 0x23523EC},
+		{unix: 1111111111, code: "14050471", step: 0x23523ED},
+		{unix: 1234567890, code: "89005924", step: 0x273EF07},
+		{unix: 2000000000, code: "69279037", step: 0x3F940AA},
+		{unix: 20000000000, code: "65353130", step: 0x27BC86AA},
+	}
+
+	generator := NewTOTPGenerator("Monolith")
+	for _, tt := range tests {
+		code := tt.code[len(tt.code)-totpDigits:]
+		step, ok := generator.Verify(rfcSecret, code, time.Unix(tt.unix, 0))
+		if !ok {
+			t.Errorf("Verify(%s at %d) rejected the code", code, tt.unix)
+			continue
+		}
+		if step != tt.step {
+			t.Errorf("Verify(%s at %d) step = %#x, want %#x", code, tt.unix, step, tt.step)
+		}
+	}
+}
+
+func TestTOTPGenerator_Window(t *testing.T) {
+	generator := NewTOTPGenerator("Monolith")
+	now := time.Unix(1111111111, 0)
+	step := now.Unix() / totpPeriod
+	key := []byte("12345678901234567890")
+
+	tests := []struct {
+		name   string
+		offset int64
+		valid  bool
+	}{
+		{name: "two periods early", offset: -2},
+		{name: "one period early", offset: -1, valid: true},
+		{name: "current period", offset: 0, valid: true},
+		{name: "one period late", offset: 1, valid: true},
+		{name: "two periods late", offset: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := generator.Verify(rfcSecret, hotp(key, step+tt.offset), now)
+			if ok != tt.valid {
+				t.Fatalf("Verify() ok = %v, want %v", ok, tt.valid)
+			}
+			if ok && got != step+tt.offset {
+				t.Fatalf("Verify() step = %d, want %d", got, step+tt.offset)
+			}
+		})
+	}
+
+	// Secrets are accepted in lower case, codes of other lengths and broken secrets are not
+	if _, ok := generator.Verify(strings.ToLower(rfcSecret), hotp(key, step), now); !ok {
+		t.Error("Verify() rejected a lower-case secret")
+	}
+	if _, ok := generator.Verify(rfcSecret, "0"+hotp(key, step), now); ok {
+		t.Error("Verify() accepted a 7-digit code")
+	}
+	if _, ok := generator.Verify("not base32!", hotp(key, step), now); ok {
+		t.Error("Verify() accepted a code of an invalid secret")
+	}
+}
+
+func TestTOTPGenerator_SecondFactorCannotBeReplayed(t *testing.T) {
+	generator := NewTOTPGenerator("Monolith")
+	key := []byte("12345678901234567890")
+	enrolledAt := time.Unix(1111111111, 0)
+	step := enrolledAt.Unix() / totpPeriod
+
+	user, err := domain.RegisterUser("jane@example.com", "Jane", "hash")
+	if err != nil {
+		t.Fatalf("register user: %v", err)
+	}
+	if err := user.BeginTwoFactorEnrollment(rfcSecret); err != nil {
+		t.Fatalf("begin enrollment: %v", err)
+	}
+	if err := user.EnableTwoFactor(generator, hotp(key, step), nil, enrolledAt); err != nil {
+		t.Fatalf("enable two-factor: %v", err)
+	}
+
+	// The code confirming the enrollment cannot log in
+	if user.VerifySecondFactor(generator, hotp(key, step), enrolledAt) {
+		t.Fatal("the enrollment code was accepted again")
+	}
+
+	// A later code is accepted once, then neither it nor an older code within the window is
+	loginAt := enrolledAt.Add(totpPeriod * time.Second)
+	if !user.VerifySecondFactor(generator, hotp(key, step+1), loginAt) {
+		t.Fatal("a fresh code was rejected")
+	}
+	if user.VerifySecondFactor(generator, hotp(key, step+1), loginAt) {
+		t.Fatal("a used code was accepted again")
+	}
+	if user.VerifySecondFactor(generator, hotp(key, step), loginAt) {
+		t.Fatal("a code older than the last accepted one was accepted")
+	}
+	if !user.VerifySecondFactor(generator, hotp(key, step+2), loginAt) {
+		t.Fatal("the code of the next period was rejected")
+	}
+}
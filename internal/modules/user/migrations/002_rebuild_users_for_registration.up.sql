@@ -0,0 +1,20 @@
+-- The skeleton users table used serial IDs; users are now aggregates with UUID IDs.
+-- The module never wrote to it, so it is recreated rather than converted.
+DROP TRIGGER IF EXISTS update_users_updated_at ON users;
+DROP TABLE IF EXISTS users;
+
+-- Create users table (emails are stored trimmed and lower-cased)
+CREATE TABLE IF NOT EXISTS "public"."users" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "email" VARCHAR(255) NOT NULL,
+    "name" VARCHAR(255) NOT NULL DEFAULT '',
+    "password_hash" VARCHAR(255) NOT NULL,
+    "status" VARCHAR(16) NOT NULL DEFAULT 'active' CHECK ("status" IN ('active', 'disabled')),
+    "version" INTEGER NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for better performance
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON "public"."users" ("email");
+CREATE INDEX IF NOT EXISTS idx_users_status ON "public"."users" ("status");
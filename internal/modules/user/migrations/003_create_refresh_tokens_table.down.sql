@@ -0,0 +1,2 @@
+-- Drop refresh_tokens table
+DROP TABLE IF EXISTS "public"."refresh_tokens";
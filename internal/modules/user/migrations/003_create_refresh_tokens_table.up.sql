@@ -0,0 +1,22 @@
+-- Create refresh_tokens table
+-- Only the SHA-256 hash of each token is stored. Tokens rotated from the same login share a family_id,
+-- so presenting a rotated token again revokes the whole family.
+CREATE TABLE IF NOT EXISTS "public"."refresh_tokens" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "user_id" VARCHAR(36) NOT NULL REFERENCES "public"."users" ("id") ON DELETE CASCADE,
+    "family_id" VARCHAR(36) NOT NULL,
+    "device_id" VARCHAR(128) NOT NULL,
+    "token_hash" CHAR(64) NOT NULL,
+    "expires_at" TIMESTAMP WITH TIME ZONE NOT NULL,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "rotated_at" TIMESTAMP WITH TIME ZONE,
+    "replaced_by_id" VARCHAR(36),
+    "revoked_at" TIMESTAMP WITH TIME ZONE,
+    "revoked_reason" VARCHAR(32)
+);
+
+-- Create indexes for better performance
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refresh_tokens_token_hash ON "public"."refresh_tokens" ("token_hash");
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON "public"."refresh_tokens" ("family_id");
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON "public"."refresh_tokens" ("user_id");
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_expires_at ON "public"."refresh_tokens" ("expires_at");
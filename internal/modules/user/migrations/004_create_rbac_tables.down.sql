@@ -0,0 +1,4 @@
+-- Drop RBAC tables
+DROP TABLE IF EXISTS "public"."user_roles";
+DROP TABLE IF EXISTS "public"."role_permissions";
+DROP TABLE IF EXISTS "public"."roles";
@@ -0,0 +1,41 @@
+-- Create roles table
+CREATE TABLE IF NOT EXISTS "public"."roles" (
+    "name" VARCHAR(64) NOT NULL PRIMARY KEY,
+    "description" VARCHAR(255) NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create role_permissions table
+-- Permissions are "<resource>:<action>"; "*" and "<resource>:*" are wildcards
+CREATE TABLE IF NOT EXISTS "public"."role_permissions" (
+    "role_name" VARCHAR(64) NOT NULL REFERENCES "public"."roles" ("name") ON DELETE CASCADE,
+    "permission" VARCHAR(128) NOT NULL,
+    PRIMARY KEY ("role_name", "permission")
+);
+
+-- Create user_roles table
+CREATE TABLE IF NOT EXISTS "public"."user_roles" (
+    "user_id" VARCHAR(36) NOT NULL REFERENCES "public"."users" ("id") ON DELETE CASCADE,
+    "role_name" VARCHAR(64) NOT NULL REFERENCES "public"."roles" ("name") ON DELETE CASCADE,
+    "assigned_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("user_id", "role_name")
+);
+
+-- Create indexes for better performance
+CREATE INDEX IF NOT EXISTS idx_user_roles_role_name ON "public"."user_roles" ("role_name");
+
+-- Built-in roles: admin holds every permission, user is the default role of registered users
+INSERT INTO "public"."roles" ("name", "description") VALUES
+    ('admin', 'Full access to every resource'),
+    ('user', 'Default role of registered users')
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "public"."role_permissions" ("role_name", "permission") VALUES
+    ('admin', '*')
+ON CONFLICT DO NOTHING;
+
+-- Users registered before roles existed get the default role
+INSERT INTO "public"."user_roles" ("user_id", "role_name")
+SELECT "id", 'user' FROM "public"."users"
+ON CONFLICT DO NOTHING;
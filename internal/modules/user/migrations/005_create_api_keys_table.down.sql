@@ -0,0 +1,2 @@
+-- Drop api_keys table
+DROP TABLE IF EXISTS "public"."api_keys";
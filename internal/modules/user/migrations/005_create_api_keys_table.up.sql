@@ -0,0 +1,19 @@
+-- Create api_keys table
+-- API keys authenticate machine clients through the X-API-Key header. Only the SHA-256 hash of each key
+-- is stored; prefix keeps the first characters of the key so administrators can tell keys apart.
+CREATE TABLE IF NOT EXISTS "public"."api_keys" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "name" VARCHAR(100) NOT NULL,
+    "prefix" VARCHAR(16) NOT NULL,
+    "key_hash" CHAR(64) NOT NULL,
+    "scopes" JSONB NOT NULL DEFAULT '[]',
+    "created_by" VARCHAR(36),
+    "expires_at" TIMESTAMP WITH TIME ZONE,
+    "last_used_at" TIMESTAMP WITH TIME ZONE,
+    "revoked_at" TIMESTAMP WITH TIME ZONE,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for better performance
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_key_hash ON "public"."api_keys" ("key_hash");
+CREATE INDEX IF NOT EXISTS idx_api_keys_created_at ON "public"."api_keys" ("created_at");
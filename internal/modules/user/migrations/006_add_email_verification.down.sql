@@ -0,0 +1,3 @@
+-- Drop email verification
+DROP TABLE IF EXISTS "public"."email_verification_tokens";
+ALTER TABLE "public"."users" DROP COLUMN IF EXISTS "email_verified_at";
@@ -0,0 +1,22 @@
+-- Track email verification
+-- Users registered before verification existed are treated as verified, so they keep access
+-- to routes that require a verified email
+ALTER TABLE "public"."users" ADD COLUMN IF NOT EXISTS "email_verified_at" TIMESTAMP WITH TIME ZONE;
+
+UPDATE "public"."users" SET "email_verified_at" = "created_at" WHERE "email_verified_at" IS NULL;
+
+-- Create email_verification_tokens table
+-- Only the SHA-256 hash of each token is stored; email is the address the token was sent to
+CREATE TABLE IF NOT EXISTS "public"."email_verification_tokens" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "user_id" VARCHAR(36) NOT NULL REFERENCES "public"."users" ("id") ON DELETE CASCADE,
+    "email" VARCHAR(255) NOT NULL,
+    "token_hash" CHAR(64) NOT NULL,
+    "expires_at" TIMESTAMP WITH TIME ZONE NOT NULL,
+    "used_at" TIMESTAMP WITH TIME ZONE,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for better performance
+CREATE UNIQUE INDEX IF NOT EXISTS idx_email_verification_tokens_token_hash ON "public"."email_verification_tokens" ("token_hash");
+CREATE INDEX IF NOT EXISTS idx_email_verification_tokens_user_id ON "public"."email_verification_tokens" ("user_id");
@@ -0,0 +1,4 @@
+-- Drop account lockout
+ALTER TABLE "public"."users" DROP COLUMN IF EXISTS "locked_until";
+ALTER TABLE "public"."users" DROP COLUMN IF EXISTS "lock_count";
+ALTER TABLE "public"."users" DROP COLUMN IF EXISTS "failed_login_attempts";
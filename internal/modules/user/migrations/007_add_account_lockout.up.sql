@@ -0,0 +1,5 @@
+-- Track failed logins for account lockout
+-- lock_count counts consecutive locks, so each lock lasts longer than the previous one
+ALTER TABLE "public"."users" ADD COLUMN IF NOT EXISTS "failed_login_attempts" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE "public"."users" ADD COLUMN IF NOT EXISTS "lock_count" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE "public"."users" ADD COLUMN IF NOT EXISTS "locked_until" TIMESTAMP WITH TIME ZONE;
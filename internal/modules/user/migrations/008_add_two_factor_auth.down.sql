@@ -0,0 +1,7 @@
+-- Drop two-factor authentication
+DROP TABLE IF EXISTS "public"."two_factor_challenges";
+ALTER TABLE "public"."users" DROP COLUMN IF EXISTS "recovery_code_hashes";
+ALTER TABLE "public"."users" DROP COLUMN IF EXISTS "two_factor_last_step";
+ALTER TABLE "public"."users" DROP COLUMN IF EXISTS "two_factor_enabled_at";
+ALTER TABLE "public"."users" DROP COLUMN IF EXISTS "two_factor_pending_secret";
+ALTER TABLE "public"."users" DROP COLUMN IF EXISTS "two_factor_secret";
@@ -0,0 +1,25 @@
+-- Track two-factor authentication
+-- two_factor_last_step is the last accepted TOTP time step, so codes cannot be replayed;
+-- recovery_code_hashes holds the SHA-256 hashes of unused recovery codes
+ALTER TABLE "public"."users" ADD COLUMN IF NOT EXISTS "two_factor_secret" VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE "public"."users" ADD COLUMN IF NOT EXISTS "two_factor_pending_secret" VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE "public"."users" ADD COLUMN IF NOT EXISTS "two_factor_enabled_at" TIMESTAMP WITH TIME ZONE;
+ALTER TABLE "public"."users" ADD COLUMN IF NOT EXISTS "two_factor_last_step" BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE "public"."users" ADD COLUMN IF NOT EXISTS "recovery_code_hashes" JSONB NOT NULL DEFAULT '[]';
+
+-- Create two_factor_challenges table
+-- A challenge is the pending second step of a login; only the SHA-256 hash of its token is stored
+CREATE TABLE IF NOT EXISTS "public"."two_factor_challenges" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "user_id" VARCHAR(36) NOT NULL REFERENCES "public"."users" ("id") ON DELETE CASCADE,
+    "token_hash" CHAR(64) NOT NULL,
+    "device_id" VARCHAR(128) NOT NULL DEFAULT '',
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "expires_at" TIMESTAMP WITH TIME ZONE NOT NULL,
+    "used_at" TIMESTAMP WITH TIME ZONE,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for better performance
+CREATE UNIQUE INDEX IF NOT EXISTS idx_two_factor_challenges_token_hash ON "public"."two_factor_challenges" ("token_hash");
+CREATE INDEX IF NOT EXISTS idx_two_factor_challenges_user_id ON "public"."two_factor_challenges" ("user_id");
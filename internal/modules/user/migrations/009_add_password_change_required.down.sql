@@ -0,0 +1,2 @@
+-- Drop required password changes
+ALTER TABLE "public"."users" DROP COLUMN IF EXISTS "password_change_required";
@@ -0,0 +1,3 @@
+-- Users who must choose a new password before they can log in again,
+-- e.g. accounts created by an administrator or after a forced password reset
+ALTER TABLE "public"."users" ADD COLUMN IF NOT EXISTS "password_change_required" BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+-- Drop security_events table
+DROP TABLE IF EXISTS "public"."security_events";
@@ -0,0 +1,19 @@
+-- Create security_events table
+-- An append-only audit log of logins, token refreshes, password and role changes;
+-- user_id has no foreign key so the records outlive the accounts they concern
+CREATE TABLE IF NOT EXISTS "public"."security_events" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "type" VARCHAR(50) NOT NULL,
+    "user_id" VARCHAR(36) NOT NULL DEFAULT '',
+    "email" VARCHAR(255) NOT NULL DEFAULT '',
+    "actor" VARCHAR(100) NOT NULL,
+    "ip_address" VARCHAR(45) NOT NULL DEFAULT '',
+    "user_agent" VARCHAR(512) NOT NULL DEFAULT '',
+    "detail" VARCHAR(255) NOT NULL DEFAULT '',
+    "occurred_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for better performance
+CREATE INDEX IF NOT EXISTS idx_security_events_occurred_at ON "public"."security_events" ("occurred_at");
+CREATE INDEX IF NOT EXISTS idx_security_events_user_id_occurred_at ON "public"."security_events" ("user_id", "occurred_at");
+CREATE INDEX IF NOT EXISTS idx_security_events_type_occurred_at ON "public"."security_events" ("type", "occurred_at");
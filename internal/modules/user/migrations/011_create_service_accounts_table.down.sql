@@ -0,0 +1,2 @@
+-- Drop service_accounts table
+DROP TABLE IF EXISTS "public"."service_accounts";
@@ -0,0 +1,18 @@
+-- Create service_accounts table
+-- Service accounts are non-human principals that exchange a long-lived secret for short-lived access tokens
+-- at POST /auth/token. Only the SHA-256 hash of the secret is stored; the ID doubles as the client ID.
+CREATE TABLE IF NOT EXISTS "public"."service_accounts" (
+    "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+    "name" VARCHAR(100) NOT NULL,
+    "description" VARCHAR(255) NOT NULL DEFAULT '',
+    "secret_hash" CHAR(64) NOT NULL,
+    "scopes" JSONB NOT NULL DEFAULT '[]',
+    "created_by" VARCHAR(36),
+    "last_used_at" TIMESTAMP WITH TIME ZONE,
+    "disabled_at" TIMESTAMP WITH TIME ZONE,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for better performance
+CREATE INDEX IF NOT EXISTS idx_service_accounts_name ON "public"."service_accounts" ("name");
@@ -0,0 +1,5 @@
+-- Drop request ID index
+DROP INDEX IF EXISTS idx_security_events_request_id;
+
+-- Drop request ID column
+ALTER TABLE "public"."security_events" DROP COLUMN IF EXISTS "request_id";
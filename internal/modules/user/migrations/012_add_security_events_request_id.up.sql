@@ -0,0 +1,6 @@
+-- Add the ID of the request that produced each security event, to correlate it with logs and domain events
+ALTER TABLE "public"."security_events"
+    ADD COLUMN IF NOT EXISTS "request_id" VARCHAR(128) NOT NULL DEFAULT '';
+
+-- Index for tracing a request
+CREATE INDEX IF NOT EXISTS idx_security_events_request_id ON "public"."security_events" ("request_id") WHERE "request_id" <> '';
@@ -0,0 +1,6 @@
+-- Restore globally unique emails; fails while two tenants share an email
+DROP INDEX IF EXISTS idx_users_tenant_email;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON "public"."users" ("email");
+
+-- Drop tenant column
+ALTER TABLE "public"."users" DROP COLUMN IF EXISTS "tenant_id";
@@ -0,0 +1,7 @@
+-- Users belong to the tenant they registered in; users created without multi-tenancy have none
+ALTER TABLE "public"."users"
+    ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+
+-- Emails are unique within a tenant
+DROP INDEX IF EXISTS idx_users_email;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_tenant_email ON "public"."users" ("tenant_id", "email");
@@ -0,0 +1,3 @@
+-- Drop the tenant of API keys
+DROP INDEX IF EXISTS idx_api_keys_tenant_id;
+ALTER TABLE "public"."api_keys" DROP COLUMN IF EXISTS "tenant_id";
@@ -0,0 +1,3 @@
+-- API keys belong to the tenant they were created in; keys created without multi-tenancy have none
+ALTER TABLE "public"."api_keys" ADD COLUMN IF NOT EXISTS "tenant_id" VARCHAR(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_api_keys_tenant_id ON "public"."api_keys" ("tenant_id");
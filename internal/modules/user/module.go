@@ -2,11 +2,28 @@ package user
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	commandhandlers "golang_modular_monolith/internal/modules/user/application/command_handlers"
+	queryhandlers "golang_modular_monolith/internal/modules/user/application/query_handlers"
+	userdomain "golang_modular_monolith/internal/modules/user/domain"
+	"golang_modular_monolith/internal/modules/user/infrastructure/eventhandlers"
+	userhttp "golang_modular_monolith/internal/modules/user/infrastructure/http"
+	"golang_modular_monolith/internal/modules/user/infrastructure/http/handlers"
+	"golang_modular_monolith/internal/modules/user/infrastructure/persistence"
+	"golang_modular_monolith/internal/modules/user/infrastructure/security"
 
 	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/authz"
+	"golang_modular_monolith/internal/shared/infrastructure/openapi"
 	"golang_modular_monolith/internal/shared/infrastructure/registry"
 )
 
@@ -19,10 +36,23 @@ func init() {
 
 // UserModule implements the Module interface
 type UserModule struct {
-	name string
+	name             string
+	logger           *zap.Logger
+	handler          *handlers.UserHandler
+	sessionHandler   *handlers.SessionHandler
+	twoFactorHandler *handlers.TwoFactorHandler
+	adminUserHandler *handlers.AdminUserHandler
+	roleHandler      *handlers.RoleHandler
+	apiKeyHandler    *handlers.APIKeyHandler
+	serviceHandler   *handlers.ServiceAccountHandler
+	securityHandler  *handlers.SecurityEventHandler
+	tokens           *auth.TokenService
+	authorizer       authz.Authorizer
+	verificationMail *eventhandlers.VerificationEmailHandler
 
 	// Dependencies
-	eventBus domain.EventBus
+	eventBus    domain.EventBus
+	redisClient *redis.Client
 }
 
 // NewUserModule creates a new user module
@@ -39,65 +69,611 @@ func (m *UserModule) Name() string {
 
 // Initialize initializes the user module with dependencies
 func (m *UserModule) Initialize(deps domain.ModuleDependencies) error {
-	log.Printf("🔧 Initializing %s module...", m.name)
+	m.logger = deps.Logger
+	m.logger.Info("initializing module")
 
 	// Store event bus
 	m.eventBus = deps.EventBus
 
-	// TODO: Initialize user-specific dependencies
-	// - User repositories
-	// - User domain services
-	// - User command/query handlers
-	// - User HTTP handlers
+	// Create repositories using factory pattern
+	userRepo, err := persistence.NewPostgreSQLUserRepositoryFromManager()
+	if err != nil {
+		return fmt.Errorf("failed to create user repository: %w", err)
+	}
+
+	refreshTokenRepo, err := persistence.NewPostgreSQLRefreshTokenRepositoryFromManager()
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token repository: %w", err)
+	}
+
+	roleRepo, err := persistence.NewPostgreSQLRoleRepositoryFromManager()
+	if err != nil {
+		return fmt.Errorf("failed to create role repository: %w", err)
+	}
+
+	apiKeyRepo, err := persistence.NewPostgreSQLAPIKeyRepositoryFromManager()
+	if err != nil {
+		return fmt.Errorf("failed to create API key repository: %w", err)
+	}
+
+	verificationTokenRepo, err := persistence.NewPostgreSQLEmailVerificationTokenRepositoryFromManager()
+	if err != nil {
+		return fmt.Errorf("failed to create email verification token repository: %w", err)
+	}
+
+	twoFactorChallengeRepo, err := persistence.NewPostgreSQLTwoFactorChallengeRepositoryFromManager()
+	if err != nil {
+		return fmt.Errorf("failed to create two-factor challenge repository: %w", err)
+	}
+
+	postgresSecurityEventRepo, err := persistence.NewPostgreSQLSecurityEventRepositoryFromManager()
+	if err != nil {
+		return fmt.Errorf("failed to create security event repository: %w", err)
+	}
+	securityEventRepo := persistence.NewAuditedSecurityEventRepository(postgresSecurityEventRepo, deps.Audit)
+
+	serviceAccountRepo, err := persistence.NewPostgreSQLServiceAccountRepositoryFromManager()
+	if err != nil {
+		return fmt.Errorf("failed to create service account repository: %w", err)
+	}
+
+	// Create password hashing from the security and authentication settings
+	algorithm := loadPasswordHashing(deps.Config)
+	passwordHasher, err := security.NewPasswordHasher(algorithm)
+	if err != nil {
+		return fmt.Errorf("invalid password hashing config: %w", err)
+	}
+	m.logger.Info("password hashing configured", zap.String("algorithm", algorithm))
+
+	passwordPolicy, err := loadPasswordPolicy(deps.Config)
+	if err != nil {
+		return fmt.Errorf("invalid password policy config: %w", err)
+	}
+
+	// Access tokens are signed by the shared token service, which also backs the auth middleware
+	m.tokens, err = auth.GetTokenService()
+	if err != nil {
+		return fmt.Errorf("failed to get token service: %w", err)
+	}
+
+	refreshTTL, err := loadRefreshTokenTTL(deps.Config)
+	if err != nil {
+		return fmt.Errorf("invalid refresh token config: %w", err)
+	}
+	serviceTokenTTL, err := loadServiceTokenTTL(deps.Config)
+	if err != nil {
+		return fmt.Errorf("invalid service account token config: %w", err)
+	}
+	impersonationTTL, err := loadImpersonationTTL(deps.Config)
+	if err != nil {
+		return fmt.Errorf("invalid impersonation config: %w", err)
+	}
+	m.logger.Info("token lifetimes configured",
+		zap.Duration("access", m.tokens.Expiry()),
+		zap.Duration("refresh", refreshTTL),
+		zap.Duration("service_account", serviceTokenTTL),
+		zap.Duration("impersonation", impersonationTTL),
+	)
+
+	twoFactor, err := loadTwoFactorSettings(deps.Config)
+	if err != nil {
+		return fmt.Errorf("invalid two-factor config: %w", err)
+	}
+	if twoFactor.enabled {
+		m.logger.Info("two-factor authentication enabled", zap.Strings("required_for_roles", twoFactor.policy.RequiredRoles))
+	}
+
+	// Check permissions against the users' roles and share the authorizer with other modules
+	// With RBAC disabled no authorizer is registered, so permission-guarded routes are refused
+	rbac := loadAuthorizationSettings(deps.Config)
+	if rbac.enabled {
+		if err := authz.Register(deps.PublicAPIs, security.NewRBACAuthorizer(userRepo, roleRepo, serviceAccountRepo, twoFactor.policy)); err != nil {
+			return fmt.Errorf("failed to register authorizer: %w", err)
+		}
+		m.logger.Info("RBAC enabled", zap.String("default_role", rbac.defaultRole))
+	} else {
+		m.logger.Warn("user authorization.rbac_enabled is false, permission-guarded routes are refused")
+	}
+	m.authorizer = authz.Lazy(deps.PublicAPIs)
+
+	// Let the shared auth middleware accept X-API-Key headers from machine clients
+	auth.SetAPIKeyVerifier(security.NewAPIKeyVerifier(apiKeyRepo, m.logger))
+
+	// Lock accounts and throttle client IPs after repeated failed logins
+	lockout, err := loadLockoutPolicy(deps.Config)
+	if err != nil {
+		return fmt.Errorf("invalid lockout config: %w", err)
+	}
+	loginThrottle := security.NewMemoryLoginThrottle(lockout.IPMaxFailedAttempts, lockout.IPWindow)
+	m.logger.Info("account lockout configured",
+		zap.Int("max_failed_attempts", lockout.MaxFailedAttempts),
+		zap.Duration("base_lock_duration", lockout.BaseLockDuration),
+		zap.Duration("max_lock_duration", lockout.MaxLockDuration),
+		zap.Int("ip_max_failed_attempts", lockout.IPMaxFailedAttempts),
+		zap.Duration("ip_window", lockout.IPWindow),
+	)
+
+	verificationTTL, err := durationSetting(deps.Config.Section("authentication"), "email_verification_ttl", userdomain.DefaultEmailVerificationTTL)
+	if err != nil {
+		return fmt.Errorf("invalid email verification config: %w", err)
+	}
+	verificationIssuer := commandhandlers.NewEmailVerificationIssuer(verificationTokenRepo, m.eventBus, verificationTTL)
+
+	// Send the verification emails the issuer requests through the module's mailer
+	if m.verificationMail, err = eventhandlers.NewVerificationEmailHandler(deps.Mailer, loadEmailVerificationURL(deps.Config)); err != nil {
+		return fmt.Errorf("invalid email verification config: %w", err)
+	}
+	if err := m.eventBus.Subscribe(m.verificationMail); err != nil {
+		return fmt.Errorf("failed to subscribe verification email handler: %w", err)
+	}
+
+	// Logins of users with two-factor authentication need a TOTP or recovery code as a second step
+	totp := security.NewTOTPGenerator(twoFactor.issuer)
+	credentials, err := commandhandlers.NewCredentialChecker(
+		userRepo,
+		passwordHasher,
+		lockout,
+		loginThrottle,
+		totp,
+		twoFactorChallengeRepo,
+		twoFactor.challengeTTL,
+		securityEventRepo,
+		m.eventBus,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Create command handlers
+	registerUserHandler := commandhandlers.NewRegisterUserHandler(userRepo, passwordHasher, passwordPolicy, rbac.defaultRole, verificationIssuer, m.eventBus)
+	tokenIssuer := security.NewJWTTokenIssuer(m.tokens)
+	loginHandler := commandhandlers.NewLoginHandler(credentials, tokenIssuer, refreshTokenRepo, refreshTTL)
+	twoFactorLoginHandler := commandhandlers.NewCompleteTwoFactorLoginHandler(credentials, tokenIssuer, refreshTokenRepo, refreshTTL)
+	refreshTokenHandler := commandhandlers.NewRefreshTokenHandler(userRepo, refreshTokenRepo, tokenIssuer, securityEventRepo, refreshTTL)
+	logoutHandler := commandhandlers.NewLogoutHandler(refreshTokenRepo)
+	verifyEmailHandler := commandhandlers.NewVerifyEmailHandler(userRepo, verificationTokenRepo, m.eventBus)
+	resendVerificationEmailHandler := commandhandlers.NewResendVerificationEmailHandler(userRepo, verificationIssuer)
+	unlockUserHandler := commandhandlers.NewUnlockUserHandler(userRepo, m.eventBus)
+
+	// Users enroll in two-factor authentication themselves, once the feature is enabled
+	if twoFactor.enabled {
+		m.twoFactorHandler = handlers.NewTwoFactorHandler(
+			commandhandlers.NewBeginTwoFactorEnrollmentHandler(userRepo, totp),
+			commandhandlers.NewEnableTwoFactorHandler(userRepo, totp, m.eventBus),
+			commandhandlers.NewDisableTwoFactorHandler(userRepo, totp, twoFactor.policy, m.eventBus),
+			commandhandlers.NewRegenerateRecoveryCodesHandler(userRepo, totp, m.eventBus),
+		)
+	}
+
+	// Browser clients can use cookie sessions stored in Redis instead of tokens
+	sessions, err := loadSessionSettings(deps.Config)
+	if err != nil {
+		return fmt.Errorf("invalid session config: %w", err)
+	}
+	var sessionStore userdomain.SessionStore
+	if sessions.store == userdomain.SessionStoreRedis {
+		if sessionStore, err = m.initializeSessions(sessions, credentials); err != nil {
+			return err
+		}
+	}
+
+	// Administrators provision accounts; their changes end the affected user's sessions
+	changePasswordHandler := commandhandlers.NewChangePasswordHandler(credentials, passwordPolicy, refreshTokenRepo, sessionStore)
+	m.adminUserHandler = handlers.NewAdminUserHandler(
+		commandhandlers.NewCreateUserHandler(userRepo, passwordHasher, passwordPolicy, rbac.defaultRole, verificationIssuer, m.eventBus),
+		commandhandlers.NewDeactivateUserHandler(userRepo, roleRepo, refreshTokenRepo, sessionStore, securityEventRepo, m.eventBus),
+		commandhandlers.NewActivateUserHandler(userRepo, securityEventRepo, m.eventBus),
+		commandhandlers.NewRequirePasswordChangeHandler(userRepo, refreshTokenRepo, sessionStore, securityEventRepo, m.eventBus),
+		commandhandlers.NewImpersonateUserHandler(userRepo, roleRepo, tokenIssuer, securityEventRepo, impersonationTTL),
+	)
+
+	createRoleHandler := commandhandlers.NewCreateRoleHandler(roleRepo)
+	updateRoleHandler := commandhandlers.NewUpdateRoleHandler(roleRepo)
+	deleteRoleHandler := commandhandlers.NewDeleteRoleHandler(roleRepo)
+	assignRoleHandler := commandhandlers.NewAssignRoleHandler(userRepo, roleRepo, securityEventRepo, m.eventBus)
+	unassignRoleHandler := commandhandlers.NewUnassignRoleHandler(userRepo, roleRepo, securityEventRepo, m.eventBus)
+	createAPIKeyHandler := commandhandlers.NewCreateAPIKeyHandler(apiKeyRepo, userRepo, roleRepo)
+	revokeAPIKeyHandler := commandhandlers.NewRevokeAPIKeyHandler(apiKeyRepo)
 
-	log.Printf("✅ %s module initialized successfully (skeleton)", m.name)
+	// Create query handlers
+	getUserHandler := queryhandlers.NewGetUserHandler(userRepo)
+	getRoleHandler := queryhandlers.NewGetRoleHandler(roleRepo)
+	listRolesHandler := queryhandlers.NewListRolesHandler(roleRepo)
+	listAPIKeysHandler := queryhandlers.NewListAPIKeysHandler(apiKeyRepo)
+	listSecurityEventsHandler := queryhandlers.NewListSecurityEventsHandler(securityEventRepo)
+
+	// Create HTTP handlers
+	m.handler = handlers.NewUserHandler(
+		registerUserHandler,
+		loginHandler,
+		twoFactorLoginHandler,
+		refreshTokenHandler,
+		logoutHandler,
+		verifyEmailHandler,
+		resendVerificationEmailHandler,
+		unlockUserHandler,
+		changePasswordHandler,
+		getUserHandler,
+	)
+	m.roleHandler = handlers.NewRoleHandler(
+		createRoleHandler,
+		updateRoleHandler,
+		deleteRoleHandler,
+		assignRoleHandler,
+		unassignRoleHandler,
+		getRoleHandler,
+		listRolesHandler,
+	)
+	m.apiKeyHandler = handlers.NewAPIKeyHandler(
+		createAPIKeyHandler,
+		revokeAPIKeyHandler,
+		listAPIKeysHandler,
+	)
+	m.serviceHandler = handlers.NewServiceAccountHandler(
+		commandhandlers.NewCreateServiceAccountHandler(serviceAccountRepo, userRepo, roleRepo),
+		commandhandlers.NewRotateServiceAccountSecretHandler(serviceAccountRepo),
+		commandhandlers.NewDisableServiceAccountHandler(serviceAccountRepo),
+		commandhandlers.NewIssueServiceAccountTokenHandler(serviceAccountRepo, tokenIssuer, loginThrottle, securityEventRepo, serviceTokenTTL),
+		queryhandlers.NewListServiceAccountsHandler(serviceAccountRepo),
+	)
+	m.securityHandler = handlers.NewSecurityEventHandler(listSecurityEventsHandler)
+
+	m.logger.Info("module initialized")
 	return nil
 }
 
 // RegisterRoutes registers HTTP routes for the user module
 func (m *UserModule) RegisterRoutes(router *gin.RouterGroup) {
-	log.Printf("🌐 Registering routes for %s module", m.name)
+	m.logger.Info("registering routes")
 
-	// TODO: Register user routes
-	userGroup := router.Group("/users")
-	{
-		userGroup.GET("/", func(c *gin.Context) {
-			c.JSON(200, gin.H{
-				"message": "User module is working!",
-				"module":  m.name,
-				"status":  "skeleton",
-			})
-		})
-	}
+	userhttp.RegisterUserRoutes(router, m.handler, m.sessionHandler, m.twoFactorHandler, m.adminUserHandler, m.roleHandler, m.apiKeyHandler, m.serviceHandler, m.securityHandler, m.tokens, m.authorizer)
+}
+
+// APIOperations implements openapi.Documented
+func (m *UserModule) APIOperations() []*openapi.Operation {
+	return userhttp.APIOperations(m.sessionHandler != nil)
 }
 
 // Health checks if the user module is healthy
 func (m *UserModule) Health(ctx context.Context) error {
-	// TODO: Add real health checks
-	// - Database connectivity
-	// - External service dependencies
+	// Check if handler is initialized
+	if m.handler == nil {
+		return fmt.Errorf("user handler not initialized")
+	}
 
 	return nil
 }
 
 // Start starts the user module (optional lifecycle method)
 func (m *UserModule) Start(ctx context.Context) error {
-	log.Printf("🚀 Starting %s module", m.name)
-
-	// TODO: Start user-specific services
-	// - Background workers
-	// - Event handlers
-
-	log.Printf("✅ %s module started successfully (skeleton)", m.name)
+	m.logger.Info("starting module")
+	m.logger.Info("module started")
 	return nil
 }
 
 // Stop stops the user module (optional lifecycle method)
 func (m *UserModule) Stop(ctx context.Context) error {
-	log.Printf("🛑 Stopping %s module", m.name)
+	m.logger.Info("stopping module")
 
-	// TODO: Cleanup user resources
-
-	log.Printf("✅ %s module stopped successfully", m.name)
+	if m.verificationMail != nil {
+		if err := m.eventBus.Unsubscribe(m.verificationMail); err != nil {
+			return fmt.Errorf("failed to unsubscribe verification email handler: %w", err)
+		}
+	}
+	if m.redisClient != nil {
+		if err := m.redisClient.Close(); err != nil {
+			m.logger.Warn("failed to close session store", zap.Error(err))
+		}
+	}
+	m.logger.Info("module stopped")
 	return nil
 }
+
+// initializeSessions connects to the Redis session store and creates the session handlers
+// The shared auth middleware then accepts the session cookie on every authenticated route
+func (m *UserModule) initializeSessions(settings sessionSettings, credentials *commandhandlers.CredentialChecker) (userdomain.SessionStore, error) {
+	m.redisClient = redis.NewClient(&redis.Options{
+		Addr:     settings.redisAddr,
+		Password: settings.redisPassword,
+		DB:       settings.redisDB,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := m.redisClient.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("failed to connect to session store at %s: %w", settings.redisAddr, err)
+	}
+
+	store := persistence.NewRedisSessionStore(m.redisClient)
+	m.sessionHandler = handlers.NewSessionHandler(
+		commandhandlers.NewCreateSessionHandler(credentials, store, settings.idleTimeout, settings.maxLifetime),
+		commandhandlers.NewCompleteTwoFactorSessionHandler(credentials, store, settings.idleTimeout, settings.maxLifetime),
+		commandhandlers.NewEndSessionHandler(store),
+		settings.cookie,
+	)
+	auth.SetSessionVerifier(security.NewSessionVerifier(store, settings.idleTimeout, m.logger), settings.cookie.Name)
+
+	m.logger.Info("session store configured", zap.String("redis_addr", settings.redisAddr), zap.Duration("idle_timeout", settings.idleTimeout), zap.Duration("max_lifetime", settings.maxLifetime))
+	return store, nil
+}
+
+// loadPasswordHashing reads user.security.password_hashing, defaulting to argon2id
+func loadPasswordHashing(cfg domain.ModuleConfig) string {
+	settings := cfg.Section("security")
+	if algorithm, ok := settings["password_hashing"].(string); ok && algorithm != "" {
+		return algorithm
+	}
+	return security.AlgorithmArgon2id
+}
+
+// loadPasswordPolicy reads user.authentication.password_min_length
+func loadPasswordPolicy(cfg domain.ModuleConfig) (userdomain.PasswordPolicy, error) {
+	policy := userdomain.DefaultPasswordPolicy()
+
+	var err error
+	if policy.MinLength, err = intSetting(cfg.Section("authentication"), "password_min_length", policy.MinLength); err != nil {
+		return policy, err
+	}
+
+	if policy.MinLength < 1 || policy.MinLength > userdomain.MaxPasswordLength {
+		return policy, fmt.Errorf("password_min_length must be between 1 and %d, got %d", userdomain.MaxPasswordLength, policy.MinLength)
+	}
+
+	return policy, nil
+}
+
+// loadEmailVerificationURL reads user.authentication.email_verification_url, the page verifying the
+// token of verification links
+func loadEmailVerificationURL(cfg domain.ModuleConfig) string {
+	if link, ok := cfg.Section("authentication")["email_verification_url"].(string); ok && link != "" {
+		return link
+	}
+	return userdomain.DefaultEmailVerificationURL
+}
+
+// loadRefreshTokenTTL reads user.authentication.refresh_token_ttl, a duration such as "720h"
+func loadRefreshTokenTTL(cfg domain.ModuleConfig) (time.Duration, error) {
+	return durationSetting(cfg.Section("authentication"), "refresh_token_ttl", userdomain.DefaultRefreshTokenTTL)
+}
+
+// loadServiceTokenTTL reads user.authentication.service_token_ttl, the lifetime of service account tokens
+func loadServiceTokenTTL(cfg domain.ModuleConfig) (time.Duration, error) {
+	ttl, err := durationSetting(cfg.Section("authentication"), "service_token_ttl", userdomain.DefaultServiceTokenTTL)
+	if err != nil {
+		return 0, err
+	}
+	if ttl <= 0 || ttl > userdomain.MaxServiceTokenTTL {
+		return 0, fmt.Errorf("service_token_ttl must be positive and at most %s, got %s", userdomain.MaxServiceTokenTTL, ttl)
+	}
+	return ttl, nil
+}
+
+// loadImpersonationTTL reads user.authentication.impersonation_ttl, the lifetime of impersonation tokens
+func loadImpersonationTTL(cfg domain.ModuleConfig) (time.Duration, error) {
+	ttl, err := durationSetting(cfg.Section("authentication"), "impersonation_ttl", userdomain.DefaultImpersonationTTL)
+	if err != nil {
+		return 0, err
+	}
+	if ttl <= 0 || ttl > userdomain.MaxImpersonationTTL {
+		return 0, fmt.Errorf("impersonation_ttl must be positive and at most %s, got %s", userdomain.MaxImpersonationTTL, ttl)
+	}
+	return ttl, nil
+}
+
+// loadLockoutPolicy reads user.security.lockout
+func loadLockoutPolicy(cfg domain.ModuleConfig) (userdomain.LockoutPolicy, error) {
+	policy := userdomain.DefaultLockoutPolicy()
+	settings, _ := cfg.Section("security")["lockout"].(map[string]interface{})
+
+	var err error
+	if policy.MaxFailedAttempts, err = intSetting(settings, "max_failed_attempts", policy.MaxFailedAttempts); err != nil {
+		return policy, err
+	}
+	if policy.BaseLockDuration, err = durationSetting(settings, "base_lock_duration", policy.BaseLockDuration); err != nil {
+		return policy, err
+	}
+	if policy.MaxLockDuration, err = durationSetting(settings, "max_lock_duration", policy.MaxLockDuration); err != nil {
+		return policy, err
+	}
+	if policy.IPMaxFailedAttempts, err = intSetting(settings, "ip_max_failed_attempts", policy.IPMaxFailedAttempts); err != nil {
+		return policy, err
+	}
+	if policy.IPWindow, err = durationSetting(settings, "ip_window", policy.IPWindow); err != nil {
+		return policy, err
+	}
+
+	return policy, policy.Validate()
+}
+
+// twoFactorSettings holds the two-factor authentication settings
+type twoFactorSettings struct {
+	enabled      bool
+	issuer       string
+	challengeTTL time.Duration
+	policy       userdomain.TwoFactorPolicy
+}
+
+// loadTwoFactorSettings reads user.security.two_factor_enabled and user.security.two_factor
+// Enrollment and per-role enforcement need two_factor_enabled; users who already enrolled
+// keep getting the second login step either way
+func loadTwoFactorSettings(cfg domain.ModuleConfig) (twoFactorSettings, error) {
+	result := twoFactorSettings{
+		issuer:       "Modular Monolith",
+		challengeTTL: userdomain.DefaultTwoFactorChallengeTTL,
+	}
+
+	securitySettings := cfg.Section("security")
+	if enabled, ok := securitySettings["two_factor_enabled"].(bool); ok {
+		result.enabled = enabled
+	}
+
+	settings, _ := securitySettings["two_factor"].(map[string]interface{})
+	if issuer, ok := settings["issuer"].(string); ok && strings.TrimSpace(issuer) != "" {
+		result.issuer = strings.TrimSpace(issuer)
+	}
+
+	var err error
+	if result.challengeTTL, err = durationSetting(settings, "challenge_ttl", result.challengeTTL); err != nil {
+		return result, err
+	}
+
+	if !result.enabled {
+		return result, nil
+	}
+
+	switch roles := settings["required_roles"].(type) {
+	case nil:
+	case []interface{}:
+		for _, role := range roles {
+			name, ok := role.(string)
+			if !ok || strings.TrimSpace(name) == "" {
+				return result, fmt.Errorf("required_roles must be a list of role names, got %v", role)
+			}
+			result.policy.RequiredRoles = append(result.policy.RequiredRoles, strings.TrimSpace(strings.ToLower(name)))
+		}
+	default:
+		return result, fmt.Errorf("required_roles must be a list of role names, got %v", roles)
+	}
+
+	return result, nil
+}
+
+// authorizationSettings holds the user.authorization settings
+type authorizationSettings struct {
+	enabled     bool
+	defaultRole string
+}
+
+// loadAuthorizationSettings reads user.authorization, enabling RBAC with the "user" default role
+// unless configured otherwise
+func loadAuthorizationSettings(cfg domain.ModuleConfig) authorizationSettings {
+	result := authorizationSettings{enabled: true, defaultRole: userdomain.RoleUser}
+
+	settings := cfg.Section("authorization")
+	if enabled, ok := settings["rbac_enabled"].(bool); ok {
+		result.enabled = enabled
+	}
+	if role, ok := settings["default_role"].(string); ok && strings.TrimSpace(role) != "" {
+		result.defaultRole = strings.TrimSpace(strings.ToLower(role))
+	}
+
+	return result
+}
+
+// sessionSettings holds the session store settings
+type sessionSettings struct {
+	store         string
+	idleTimeout   time.Duration
+	maxLifetime   time.Duration
+	redisAddr     string
+	redisPassword string
+	redisDB       int
+	cookie        handlers.SessionCookie
+}
+
+// loadSessionSettings reads user.authentication.session_store and session_timeout and the user.sessions section
+// The store defaults to jwt; the other settings only apply to the redis store
+func loadSessionSettings(cfg domain.ModuleConfig) (sessionSettings, error) {
+	result := sessionSettings{
+		store:       userdomain.SessionStoreJWT,
+		idleTimeout: userdomain.DefaultSessionIdleTimeout,
+		maxLifetime: userdomain.DefaultSessionMaxLifetime,
+		redisAddr:   "localhost:6379",
+		cookie: handlers.SessionCookie{
+			Name:     "session_id",
+			Secure:   true,
+			SameSite: http.SameSiteLaxMode,
+		},
+	}
+
+	authentication := cfg.Section("authentication")
+	if store, ok := authentication["session_store"].(string); ok && store != "" {
+		result.store = strings.ToLower(store)
+	}
+	switch result.store {
+	case userdomain.SessionStoreJWT, userdomain.SessionStoreRedis:
+	default:
+		return result, fmt.Errorf("session_store must be %s or %s, got %q", userdomain.SessionStoreJWT, userdomain.SessionStoreRedis, result.store)
+	}
+
+	var err error
+	if result.idleTimeout, err = durationSetting(authentication, "session_timeout", result.idleTimeout); err != nil {
+		return result, err
+	}
+
+	settings := cfg.Section("sessions")
+	if result.maxLifetime, err = durationSetting(settings, "max_lifetime", result.maxLifetime); err != nil {
+		return result, err
+	}
+	if addr, ok := settings["redis_addr"].(string); ok && addr != "" {
+		result.redisAddr = addr
+	}
+	if password, ok := settings["redis_password"].(string); ok {
+		result.redisPassword = password
+	}
+	if result.redisDB, err = intSetting(settings, "redis_db", result.redisDB); err != nil {
+		return result, err
+	}
+
+	if name, ok := settings["cookie_name"].(string); ok && name != "" {
+		result.cookie.Name = name
+	}
+	if cookieDomain, ok := settings["cookie_domain"].(string); ok {
+		result.cookie.Domain = cookieDomain
+	}
+	if secure, ok := settings["cookie_secure"].(bool); ok {
+		result.cookie.Secure = secure
+	}
+	if sameSite, ok := settings["cookie_same_site"].(string); ok && sameSite != "" {
+		switch strings.ToLower(sameSite) {
+		case "lax":
+			result.cookie.SameSite = http.SameSiteLaxMode
+		case "strict":
+			result.cookie.SameSite = http.SameSiteStrictMode
+		case "none":
+			// Browsers only accept SameSite=None on secure cookies
+			result.cookie.SameSite = http.SameSiteNoneMode
+			result.cookie.Secure = true
+		default:
+			return result, fmt.Errorf("cookie_same_site must be lax, strict or none, got %q", sameSite)
+		}
+	}
+
+	if result.maxLifetime < result.idleTimeout {
+		return result, fmt.Errorf("max_lifetime (%s) must not be shorter than session_timeout (%s)", result.maxLifetime, result.idleTimeout)
+	}
+
+	return result, nil
+}
+
+// intSetting reads a number, falling back to a default when unset
+func intSetting(settings map[string]interface{}, key string, fallback int) (int, error) {
+	switch value := settings[key].(type) {
+	case nil:
+		return fallback, nil
+	case int:
+		return value, nil
+	case float64:
+		return int(value), nil
+	default:
+		return 0, fmt.Errorf("%s must be a number, got %v", key, value)
+	}
+}
+
+// durationSetting reads a positive duration such as "24h", falling back to a default when unset
+func durationSetting(settings map[string]interface{}, key string, fallback time.Duration) (time.Duration, error) {
+	value, ok := settings[key].(string)
+	if !ok || value == "" {
+		return fallback, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a duration, got %q", key, value)
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("%s must be positive, got %s", key, duration)
+	}
+
+	return duration, nil
+}
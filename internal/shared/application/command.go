@@ -18,123 +18,101 @@ type CommandHandler[T Command] interface {
 	Handle(ctx context.Context, cmd T) error
 }
 
+// CommandFunc executes a command, whatever its type
+type CommandFunc func(ctx context.Context, cmd Command) error
+
 // CommandBus represents the command bus interface
 type CommandBus interface {
 	// Execute executes a command
 	Execute(ctx context.Context, cmd Command) error
 
-	// RegisterHandler registers a command handler
-	RegisterHandler(cmdType reflect.Type, handler interface{}) error
-
-	// RegisterHandlerFunc registers a command handler function
-	RegisterHandlerFunc(cmdType reflect.Type, handlerFunc interface{}) error
+	// RegisterHandler registers the function executing the commands named name
+	RegisterHandler(name string, handler CommandFunc) error
 }
 
 // InMemoryCommandBus is an in-memory implementation of CommandBus
+// Commands are dispatched by name to the typed handler registered for them, without reflection
 type InMemoryCommandBus struct {
-	handlers map[reflect.Type]interface{}
+	handlers map[string]CommandFunc
 	mutex    sync.RWMutex
 }
 
 // NewInMemoryCommandBus creates a new in-memory command bus
 func NewInMemoryCommandBus() *InMemoryCommandBus {
 	return &InMemoryCommandBus{
-		handlers: make(map[reflect.Type]interface{}),
+		handlers: make(map[string]CommandFunc),
 	}
 }
 
 // Execute executes a command
 func (bus *InMemoryCommandBus) Execute(ctx context.Context, cmd Command) error {
-	bus.mutex.RLock()
-	defer bus.mutex.RUnlock()
-
-	cmdType := reflect.TypeOf(cmd)
-	handler, exists := bus.handlers[cmdType]
-	if !exists {
-		return fmt.Errorf("no handler registered for command %s", cmdType.Name())
+	if cmd == nil {
+		return fmt.Errorf("command is nil")
 	}
 
-	// Use reflection to call the handler
-	handlerValue := reflect.ValueOf(handler)
-	handlerType := handlerValue.Type()
-
-	// Check if it's a method (Handle)
-	if handlerValue.Kind() == reflect.Ptr {
-		method := handlerValue.MethodByName("Handle")
-		if !method.IsValid() {
-			return fmt.Errorf("handler for command %s does not have Handle method", cmdType.Name())
-		}
-
-		// Call Handle method
-		results := method.Call([]reflect.Value{
-			reflect.ValueOf(ctx),
-			reflect.ValueOf(cmd),
-		})
-
-		if len(results) > 0 && !results[0].IsNil() {
-			return results[0].Interface().(error)
-		}
-		return nil
+	bus.mutex.RLock()
+	handler, exists := bus.handlers[cmd.CommandName()]
+	bus.mutex.RUnlock()
+	if !exists {
+		return fmt.Errorf("no handler registered for command %s", cmd.CommandName())
 	}
+	return handler(ctx, cmd)
+}
 
-	// Check if it's a function
-	if handlerType.Kind() == reflect.Func {
-		results := handlerValue.Call([]reflect.Value{
-			reflect.ValueOf(ctx),
-			reflect.ValueOf(cmd),
-		})
-
-		if len(results) > 0 && !results[0].IsNil() {
-			return results[0].Interface().(error)
-		}
-		return nil
+// RegisterHandler registers the function executing the commands named name
+func (bus *InMemoryCommandBus) RegisterHandler(name string, handler CommandFunc) error {
+	if name == "" {
+		return fmt.Errorf("command name is required")
 	}
 
-	return fmt.Errorf("invalid handler type for command %s", cmdType.Name())
-}
-
-// RegisterHandler registers a command handler
-func (bus *InMemoryCommandBus) RegisterHandler(cmdType reflect.Type, handler interface{}) error {
 	bus.mutex.Lock()
 	defer bus.mutex.Unlock()
 
-	if _, exists := bus.handlers[cmdType]; exists {
-		return fmt.Errorf("handler already registered for command %s", cmdType.Name())
+	if _, exists := bus.handlers[name]; exists {
+		return fmt.Errorf("handler already registered for command %s", name)
 	}
 
-	bus.handlers[cmdType] = handler
+	bus.handlers[name] = handler
 	return nil
 }
 
-// RegisterHandlerFunc registers a command handler function
-func (bus *InMemoryCommandBus) RegisterHandlerFunc(cmdType reflect.Type, handlerFunc interface{}) error {
-	return bus.RegisterHandler(cmdType, handlerFunc)
-}
-
-// Helper function to register handler with type inference
+// RegisterCommandHandler registers the handler of the commands of type T, under the name T carries
 func RegisterCommandHandler[T Command](bus CommandBus, handler CommandHandler[T]) error {
-	var cmd T
-	cmdType := reflect.TypeOf(cmd)
+	return RegisterCommandHandlerFunc(bus, handler.Handle)
+}
 
-	// Remove pointer if it's a pointer type
-	if cmdType.Kind() == reflect.Ptr {
-		cmdType = cmdType.Elem()
+// RegisterCommandHandlerFunc registers a function handling the commands of type T, under the name T
+// carries
+func RegisterCommandHandlerFunc[T Command](bus CommandBus, handlerFunc func(context.Context, T) error) error {
+	zero, err := zeroOf[T]()
+	if err != nil {
+		return err
+	}
+	name := zero.CommandName()
+	if name == "" {
+		return fmt.Errorf("command %T has no name of its own: declare CommandName on it rather than naming it in a constructor", zero)
 	}
 
-	return bus.RegisterHandler(cmdType, handler)
+	return bus.RegisterHandler(name, func(ctx context.Context, cmd Command) error {
+		typed, ok := cmd.(T)
+		if !ok {
+			return fmt.Errorf("command %s is a %T, not a %T", name, cmd, zero)
+		}
+		return handlerFunc(ctx, typed)
+	})
 }
 
-// Helper function to register handler function with type inference
-func RegisterCommandHandlerFunc[T Command](bus CommandBus, handlerFunc func(context.Context, T) error) error {
-	var cmd T
-	cmdType := reflect.TypeOf(cmd)
-
-	// Remove pointer if it's a pointer type
-	if cmdType.Kind() == reflect.Ptr {
-		cmdType = cmdType.Elem()
+// zeroOf returns the zero value of T to read its name from; for a pointer type, it points to the zero
+// value of the element, so methods with value receivers can be called on it
+func zeroOf[T any]() (T, error) {
+	var zero T
+	switch t := reflect.TypeFor[T](); t.Kind() {
+	case reflect.Interface:
+		return zero, fmt.Errorf("%s is an interface: handlers are registered for concrete types", t)
+	case reflect.Ptr:
+		return reflect.New(t.Elem()).Interface().(T), nil
 	}
-
-	return bus.RegisterHandlerFunc(cmdType, handlerFunc)
+	return zero, nil
 }
 
 // BaseCommand provides a base implementation for commands
@@ -223,26 +201,44 @@ func (bus *MiddlewareCommandBus) Use(middleware CommandMiddleware) {
 
 // Execute executes a command with middleware
 func (bus *MiddlewareCommandBus) Execute(ctx context.Context, cmd Command) error {
-	return bus.executeWithMiddleware(ctx, cmd, 0)
+	return bus.executeWithMiddleware(ctx, cmd, 0, bus.bus.Execute)
+}
+
+// Run executes a command with middleware, running handler in place of the handler registered on the
+// bus; handlers returning a result are run this way, see RunCommand
+func (bus *MiddlewareCommandBus) Run(ctx context.Context, cmd Command, handler CommandFunc) error {
+	return bus.executeWithMiddleware(ctx, cmd, 0, handler)
 }
 
-func (bus *MiddlewareCommandBus) executeWithMiddleware(ctx context.Context, cmd Command, index int) error {
+func (bus *MiddlewareCommandBus) executeWithMiddleware(ctx context.Context, cmd Command, index int, handler CommandFunc) error {
 	if index >= len(bus.middlewares) {
-		return bus.bus.Execute(ctx, cmd)
+		return handler(ctx, cmd)
 	}
 
 	middleware := bus.middlewares[index]
 	return middleware.Execute(ctx, cmd, func(ctx context.Context, cmd Command) error {
-		return bus.executeWithMiddleware(ctx, cmd, index+1)
+		return bus.executeWithMiddleware(ctx, cmd, index+1, handler)
+	})
+}
+
+// RunCommand runs handle for cmd through the middleware of bus and returns its result
+func RunCommand[C Command, R any](ctx context.Context, bus *MiddlewareCommandBus, cmd C, handle func(context.Context, C) (R, error)) (R, error) {
+	var result R
+	err := bus.Run(ctx, cmd, func(ctx context.Context, _ Command) error {
+		var err error
+		result, err = handle(ctx, cmd)
+		return err
 	})
+	return result, err
 }
 
-// RegisterHandler registers a command handler
-func (bus *MiddlewareCommandBus) RegisterHandler(cmdType reflect.Type, handler interface{}) error {
-	return bus.bus.RegisterHandler(cmdType, handler)
+// CommandDispatcher is implemented by modules executing their commands on a MiddlewareCommandBus,
+// so that the application can add middleware to it, e.g. a transaction of the module's database
+type CommandDispatcher interface {
+	CommandBus() *MiddlewareCommandBus
 }
 
-// RegisterHandlerFunc registers a command handler function
-func (bus *MiddlewareCommandBus) RegisterHandlerFunc(cmdType reflect.Type, handlerFunc interface{}) error {
-	return bus.bus.RegisterHandlerFunc(cmdType, handlerFunc)
+// RegisterHandler registers the function executing the commands named name
+func (bus *MiddlewareCommandBus) RegisterHandler(name string, handler CommandFunc) error {
+	return bus.bus.RegisterHandler(name, handler)
 }
@@ -0,0 +1,186 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type pingCommand struct {
+	Target string
+}
+
+func (pingCommand) CommandName() string { return "ping" }
+
+type constructedCommand struct {
+	BaseCommand
+}
+
+type pingHandler struct {
+	pinged []string
+}
+
+func (h *pingHandler) Handle(_ context.Context, cmd pingCommand) error {
+	h.pinged = append(h.pinged, cmd.Target)
+	return nil
+}
+
+func TestRegisterCommandHandler_NamesHandlerAfterCommandType(t *testing.T) {
+	bus := NewInMemoryCommandBus()
+	handler := &pingHandler{}
+	if err := RegisterCommandHandler[pingCommand](bus, handler); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	if err := bus.Execute(context.Background(), pingCommand{Target: "db"}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if len(handler.pinged) != 1 || handler.pinged[0] != "db" {
+		t.Fatalf("pinged = %v, want [db]", handler.pinged)
+	}
+	if err := RegisterCommandHandler[pingCommand](bus, handler); err == nil {
+		t.Fatal("registering ping twice succeeded")
+	}
+}
+
+func TestRegisterCommandHandler_PointerCommands(t *testing.T) {
+	bus := NewInMemoryCommandBus()
+	var executed *pingCommand
+	err := RegisterCommandHandlerFunc(bus, func(_ context.Context, cmd *pingCommand) error {
+		executed = cmd
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	cmd := &pingCommand{Target: "cache"}
+	if err := bus.Execute(context.Background(), cmd); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if executed != cmd {
+		t.Fatalf("handler got %v, want %v", executed, cmd)
+	}
+	if err := bus.Execute(context.Background(), pingCommand{}); err == nil {
+		t.Fatal("executing a ping value on the handler of *ping succeeded")
+	}
+}
+
+func TestRegisterCommandHandler_RejectsCommandsWithoutName(t *testing.T) {
+	bus := NewInMemoryCommandBus()
+	err := RegisterCommandHandlerFunc(bus, func(context.Context, constructedCommand) error { return nil })
+	if err == nil {
+		t.Fatal("registering a command named only by its constructor succeeded")
+	}
+	err = RegisterCommandHandlerFunc(bus, func(context.Context, Command) error { return nil })
+	if err == nil {
+		t.Fatal("registering the Command interface succeeded")
+	}
+}
+
+func TestMiddlewareCommandBus_RunsMiddlewareInOrder(t *testing.T) {
+	bus := NewMiddlewareCommandBus(NewInMemoryCommandBus())
+	var calls []string
+	for _, name := range []string{"outer", "inner"} {
+		bus.Use(CommandMiddlewareFunc(func(ctx context.Context, cmd Command, next func(context.Context, Command) error) error {
+			calls = append(calls, name)
+			return next(ctx, cmd)
+		}))
+	}
+	failed := errors.New("failed")
+	if err := RegisterCommandHandlerFunc(bus, func(context.Context, pingCommand) error { return failed }); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	if err := bus.Execute(context.Background(), pingCommand{}); !errors.Is(err, failed) {
+		t.Fatalf("execute = %v, want %v", err, failed)
+	}
+	if len(calls) != 2 || calls[0] != "outer" || calls[1] != "inner" {
+		t.Fatalf("middleware calls = %v, want [outer inner]", calls)
+	}
+}
+
+func TestRunCommand_ReturnsResultThroughMiddleware(t *testing.T) {
+	bus := NewMiddlewareCommandBus(NewInMemoryCommandBus())
+	var seen string
+	bus.Use(CommandMiddlewareFunc(func(ctx context.Context, cmd Command, next func(context.Context, Command) error) error {
+		seen = cmd.CommandName()
+		return next(ctx, cmd)
+	}))
+
+	result, err := RunCommand(context.Background(), bus, pingCommand{Target: "db"}, func(_ context.Context, cmd pingCommand) (string, error) {
+		return "pong " + cmd.Target, nil
+	})
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if result != "pong db" {
+		t.Fatalf("result = %q, want %q", result, "pong db")
+	}
+	if seen != "ping" {
+		t.Fatalf("middleware saw %q, want ping", seen)
+	}
+}
+
+func BenchmarkInMemoryCommandBus_Execute(b *testing.B) {
+	bus := NewInMemoryCommandBus()
+	if err := RegisterCommandHandlerFunc(bus, func(context.Context, pingCommand) error { return nil }); err != nil {
+		b.Fatalf("register: %v", err)
+	}
+	ctx := context.Background()
+	cmd := pingCommand{Target: "db"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := bus.Execute(ctx, cmd); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// reflectCommandBus is the reflection-based dispatch the typed registry replaced, kept to compare allocations
+type reflectCommandBus struct {
+	handlers map[reflect.Type]interface{}
+	mu       sync.RWMutex
+}
+
+func (b *reflectCommandBus) Execute(ctx context.Context, cmd Command) error {
+	b.mu.RLock()
+	handler, exists := b.handlers[reflect.TypeOf(cmd)]
+	b.mu.RUnlock()
+	if !exists {
+		return errors.New("no handler registered for command: " + cmd.CommandName())
+	}
+
+	handlerValue := reflect.ValueOf(handler)
+	args := []reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(cmd)}
+	var results []reflect.Value
+	if handlerValue.Kind() == reflect.Ptr {
+		results = handlerValue.MethodByName("Handle").Call(args)
+	} else {
+		results = handlerValue.Call(args)
+	}
+	if results[0].IsNil() {
+		return nil
+	}
+	return results[0].Interface().(error)
+}
+
+func BenchmarkReflectCommandBus_Execute(b *testing.B) {
+	bus := &reflectCommandBus{handlers: map[reflect.Type]interface{}{
+		reflect.TypeOf(pingCommand{}): func(context.Context, pingCommand) error { return nil },
+	}}
+	ctx := context.Background()
+	cmd := pingCommand{Target: "db"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := bus.Execute(ctx, cmd); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
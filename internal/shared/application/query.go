@@ -0,0 +1,188 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Query represents a query in CQRS pattern
+type Query interface {
+	// QueryName returns the name of the query
+	QueryName() string
+}
+
+// QueryHandler handles queries of type T, returning results of type R
+type QueryHandler[T Query, R any] interface {
+	Handle(ctx context.Context, query T) (R, error)
+}
+
+// QueryFunc executes a query, whatever its type, returning its result untyped
+type QueryFunc func(ctx context.Context, query Query) (interface{}, error)
+
+// QueryBus represents the query bus interface
+type QueryBus interface {
+	// Execute executes a query and returns its result
+	Execute(ctx context.Context, query Query) (interface{}, error)
+
+	// RegisterHandler registers the function executing the queries named name
+	RegisterHandler(name string, handler QueryFunc) error
+}
+
+// InMemoryQueryBus is an in-memory implementation of QueryBus
+// Like commands, queries are dispatched by name to the typed handler registered for them
+type InMemoryQueryBus struct {
+	handlers map[string]QueryFunc
+	mutex    sync.RWMutex
+}
+
+// NewInMemoryQueryBus creates a new in-memory query bus
+func NewInMemoryQueryBus() *InMemoryQueryBus {
+	return &InMemoryQueryBus{
+		handlers: make(map[string]QueryFunc),
+	}
+}
+
+// Execute executes a query
+func (bus *InMemoryQueryBus) Execute(ctx context.Context, query Query) (interface{}, error) {
+	if query == nil {
+		return nil, fmt.Errorf("query is nil")
+	}
+
+	bus.mutex.RLock()
+	handler, exists := bus.handlers[query.QueryName()]
+	bus.mutex.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("no handler registered for query %s", query.QueryName())
+	}
+	return handler(ctx, query)
+}
+
+// RegisterHandler registers the function executing the queries named name
+func (bus *InMemoryQueryBus) RegisterHandler(name string, handler QueryFunc) error {
+	if name == "" {
+		return fmt.Errorf("query name is required")
+	}
+
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+
+	if _, exists := bus.handlers[name]; exists {
+		return fmt.Errorf("handler already registered for query %s", name)
+	}
+
+	bus.handlers[name] = handler
+	return nil
+}
+
+// RegisterQueryHandler registers the handler of the queries of type T, under the name T carries
+func RegisterQueryHandler[T Query, R any](bus QueryBus, handler QueryHandler[T, R]) error {
+	return RegisterQueryHandlerFunc(bus, handler.Handle)
+}
+
+// RegisterQueryHandlerFunc registers a function handling the queries of type T, under the name T
+// carries
+func RegisterQueryHandlerFunc[T Query, R any](bus QueryBus, handlerFunc func(context.Context, T) (R, error)) error {
+	zero, err := zeroOf[T]()
+	if err != nil {
+		return err
+	}
+	name := zero.QueryName()
+	if name == "" {
+		return fmt.Errorf("query %T has no name of its own: declare QueryName on it rather than naming it in a constructor", zero)
+	}
+
+	return bus.RegisterHandler(name, func(ctx context.Context, query Query) (interface{}, error) {
+		typed, ok := query.(T)
+		if !ok {
+			return nil, fmt.Errorf("query %s is a %T, not a %T", name, query, zero)
+		}
+		return handlerFunc(ctx, typed)
+	})
+}
+
+// ExecuteQuery executes a query on the bus and returns its result as R
+func ExecuteQuery[R any](ctx context.Context, bus QueryBus, query Query) (R, error) {
+	var zero R
+	result, err := bus.Execute(ctx, query)
+	if err != nil {
+		return zero, err
+	}
+	if result == nil {
+		return zero, nil
+	}
+	typed, ok := result.(R)
+	if !ok {
+		return zero, fmt.Errorf("query %s returned %T, not %s", query.QueryName(), result, reflect.TypeFor[R]())
+	}
+	return typed, nil
+}
+
+// BaseQuery provides a base implementation for queries
+type BaseQuery struct {
+	name string
+}
+
+// NewBaseQuery creates a new base query
+func NewBaseQuery(name string) BaseQuery {
+	return BaseQuery{name: name}
+}
+
+// QueryName returns the name of the query
+func (q BaseQuery) QueryName() string {
+	return q.name
+}
+
+// QueryMiddleware represents middleware for query processing
+type QueryMiddleware interface {
+	Execute(ctx context.Context, query Query, next QueryFunc) (interface{}, error)
+}
+
+// QueryMiddlewareFunc is a function type that implements QueryMiddleware
+type QueryMiddlewareFunc func(ctx context.Context, query Query, next QueryFunc) (interface{}, error)
+
+// Execute implements QueryMiddleware interface
+func (f QueryMiddlewareFunc) Execute(ctx context.Context, query Query, next QueryFunc) (interface{}, error) {
+	return f(ctx, query, next)
+}
+
+// MiddlewareQueryBus wraps a query bus with middleware support
+type MiddlewareQueryBus struct {
+	bus         QueryBus
+	middlewares []QueryMiddleware
+}
+
+// NewMiddlewareQueryBus creates a new middleware query bus
+func NewMiddlewareQueryBus(bus QueryBus) *MiddlewareQueryBus {
+	return &MiddlewareQueryBus{
+		bus:         bus,
+		middlewares: make([]QueryMiddleware, 0),
+	}
+}
+
+// Use adds middleware to the query bus
+func (bus *MiddlewareQueryBus) Use(middleware QueryMiddleware) {
+	bus.middlewares = append(bus.middlewares, middleware)
+}
+
+// Execute executes a query with middleware
+func (bus *MiddlewareQueryBus) Execute(ctx context.Context, query Query) (interface{}, error) {
+	return bus.executeWithMiddleware(ctx, query, 0)
+}
+
+func (bus *MiddlewareQueryBus) executeWithMiddleware(ctx context.Context, query Query, index int) (interface{}, error) {
+	if index >= len(bus.middlewares) {
+		return bus.bus.Execute(ctx, query)
+	}
+
+	middleware := bus.middlewares[index]
+	return middleware.Execute(ctx, query, func(ctx context.Context, query Query) (interface{}, error) {
+		return bus.executeWithMiddleware(ctx, query, index+1)
+	})
+}
+
+// RegisterHandler registers the function executing the queries named name
+func (bus *MiddlewareQueryBus) RegisterHandler(name string, handler QueryFunc) error {
+	return bus.bus.RegisterHandler(name, handler)
+}
@@ -0,0 +1,58 @@
+package application
+
+import (
+	"context"
+	"testing"
+)
+
+type countQuery struct {
+	Status string
+}
+
+func (countQuery) QueryName() string { return "count" }
+
+type otherCountQuery struct {
+	BaseQuery
+}
+
+func (otherCountQuery) QueryName() string { return "count" }
+
+func TestRegisterQueryHandler_DispatchesByQueryName(t *testing.T) {
+	bus := NewInMemoryQueryBus()
+	err := RegisterQueryHandlerFunc(bus, func(_ context.Context, query countQuery) (int, error) {
+		return len(query.Status), nil
+	})
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	count, err := ExecuteQuery[int](context.Background(), bus, countQuery{Status: "active"})
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if count != 6 {
+		t.Fatalf("count = %d, want 6", count)
+	}
+	if _, err := ExecuteQuery[string](context.Background(), bus, countQuery{}); err == nil {
+		t.Fatal("reading an int result as a string succeeded")
+	}
+}
+
+func TestRegisterQueryHandler_RejectsDuplicateNames(t *testing.T) {
+	bus := NewInMemoryQueryBus()
+	if err := RegisterQueryHandlerFunc(bus, func(context.Context, countQuery) (int, error) { return 0, nil }); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if err := RegisterQueryHandlerFunc(bus, func(context.Context, otherCountQuery) (int, error) { return 0, nil }); err == nil {
+		t.Fatal("registering a second query named count succeeded")
+	}
+	if err := RegisterQueryHandlerFunc(bus, func(context.Context, BaseQuery) (int, error) { return 0, nil }); err == nil {
+		t.Fatal("registering a query named only by its constructor succeeded")
+	}
+}
+
+func TestInMemoryQueryBus_UnknownQuery(t *testing.T) {
+	if _, err := NewInMemoryQueryBus().Execute(context.Background(), countQuery{}); err == nil {
+		t.Fatal("executing a query without handler succeeded")
+	}
+}
@@ -0,0 +1,92 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// UnitOfWork runs several repository operations as one transaction
+type UnitOfWork interface {
+	// Do runs fn in a transaction, committed when fn returns nil and rolled back otherwise
+	// Repositories called with the context passed to fn take part in the transaction; Do called
+	// again with that context joins it. Events published with PublishEvents in fn are published
+	// once the outermost transaction commits, and dropped when it rolls back
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// TransactionMiddleware runs the handler of every command executed on a MiddlewareCommandBus in a
+// transaction of uow
+func TransactionMiddleware(uow UnitOfWork) CommandMiddleware {
+	return CommandMiddlewareFunc(func(ctx context.Context, cmd Command, next func(context.Context, Command) error) error {
+		return uow.Do(ctx, func(ctx context.Context) error {
+			return next(ctx, cmd)
+		})
+	})
+}
+
+// eventBufferKey is the context key of the events held back by DeferEvents
+type eventBufferKey struct{}
+
+// pendingEvent is an event held back until the unit of work it was published in commits
+type pendingEvent struct {
+	bus   domain.EventBus
+	event domain.DomainEvent
+}
+
+// eventBuffer collects the events published in a unit of work
+type eventBuffer struct {
+	mu     sync.Mutex
+	events []pendingEvent
+}
+
+// PublishEvents publishes events on bus, stamped with the actor of ctx
+// Inside a unit of work the events are held back until its transaction commits, so subscribers
+// never see changes that are rolled back
+func PublishEvents(ctx context.Context, bus domain.EventBus, events ...domain.DomainEvent) error {
+	buffer, deferred := ctx.Value(eventBufferKey{}).(*eventBuffer)
+	if deferred {
+		buffer.mu.Lock()
+		defer buffer.mu.Unlock()
+	}
+
+	var errs []error
+	for _, event := range events {
+		event = domain.StampActor(ctx, event)
+		if deferred {
+			buffer.events = append(buffer.events, pendingEvent{bus: bus, event: event})
+			continue
+		}
+		if err := bus.Publish(event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DeferEvents returns a context in which PublishEvents holds events back, and a function publishing
+// them; when ctx already holds events back, it returns ctx and a function doing nothing, leaving
+// the events to the outermost unit of work
+func DeferEvents(ctx context.Context) (context.Context, func() error) {
+	if _, ok := ctx.Value(eventBufferKey{}).(*eventBuffer); ok {
+		return ctx, func() error { return nil }
+	}
+
+	buffer := &eventBuffer{}
+	return context.WithValue(ctx, eventBufferKey{}, buffer), func() error {
+		buffer.mu.Lock()
+		events := buffer.events
+		buffer.events = nil
+		buffer.mu.Unlock()
+
+		var errs []error
+		for _, pending := range events {
+			if err := pending.bus.Publish(pending.event); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
@@ -0,0 +1,60 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+type recordingBus struct {
+	published []domain.DomainEvent
+}
+
+func (b *recordingBus) Publish(event domain.DomainEvent) error {
+	b.published = append(b.published, event)
+	return nil
+}
+
+func (b *recordingBus) PublishAll(events []domain.DomainEvent) error {
+	b.published = append(b.published, events...)
+	return nil
+}
+
+func (b *recordingBus) Subscribe(domain.EventHandler) error   { return nil }
+func (b *recordingBus) Unsubscribe(domain.EventHandler) error { return nil }
+
+func TestPublishEvents_PublishesAtOnceOutsideUnitOfWork(t *testing.T) {
+	bus := &recordingBus{}
+	event := domain.NewBaseDomainEvent("thing-1", "thing", "thing.happened", nil)
+
+	if err := PublishEvents(context.Background(), bus, event); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+	if len(bus.published) != 1 {
+		t.Fatalf("published %d events, want 1", len(bus.published))
+	}
+}
+
+func TestDeferEvents_HoldsEventsUntilOutermostFlush(t *testing.T) {
+	bus := &recordingBus{}
+	ctx, publish := DeferEvents(context.Background())
+	inner, publishInner := DeferEvents(ctx)
+
+	if err := PublishEvents(inner, bus, domain.NewBaseDomainEvent("thing-1", "thing", "thing.happened", nil)); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+	if err := publishInner(); err != nil {
+		t.Fatalf("inner flush: %v", err)
+	}
+	if len(bus.published) != 0 {
+		t.Fatalf("published %d events before the outermost flush, want 0", len(bus.published))
+	}
+
+	if err := publish(); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+	if len(bus.published) != 1 {
+		t.Fatalf("published %d events, want 1", len(bus.published))
+	}
+}
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"context"
+	"reflect"
+	"strings"
+)
+
+// Actor types
+const (
+	ActorTypeUser           = "user"            // a user authenticated with an access token or session
+	ActorTypeAPIKey         = "api_key"         // a machine client authenticated with an API key
+	ActorTypeServiceAccount = "service_account" // a machine client authenticated with a service account token
+	ActorTypeSystem         = "system"          // the application itself, e.g. event handlers and background jobs
+)
+
+// impersonatorSeparator separates an impersonated user's actor from the impersonating user's ID
+const impersonatorSeparator = ";impersonated_by:"
+
+// Actor identifies who triggered a change
+// It is set on the request context by the auth middleware and stamped onto published events
+// ImpersonatorID is set when an administrator acts as the user, so audits name both
+type Actor struct {
+	Type           string `json:"type"`
+	ID             string `json:"id,omitempty"`
+	ImpersonatorID string `json:"impersonator_id,omitempty"`
+}
+
+// SystemActor is the actor of changes no caller requested
+func SystemActor() Actor {
+	return Actor{Type: ActorTypeSystem}
+}
+
+// String formats the actor as "<type>:<id>", or just the type when there is no ID
+// Impersonated users are formatted as "user:<id>;impersonated_by:<impersonator id>"
+func (a Actor) String() string {
+	if a.ID == "" {
+		return a.Type
+	}
+	if a.IsImpersonated() {
+		return a.Type + ":" + a.ID + impersonatorSeparator + a.ImpersonatorID
+	}
+	return a.Type + ":" + a.ID
+}
+
+// ParseActor parses an actor formatted by String
+func ParseActor(s string) (Actor, bool) {
+	s, impersonatorID, impersonated := strings.Cut(s, impersonatorSeparator)
+	actorType, id, _ := strings.Cut(s, ":")
+	if impersonated {
+		return Actor{Type: actorType, ID: id, ImpersonatorID: impersonatorID},
+			actorType == ActorTypeUser && id != "" && impersonatorID != ""
+	}
+	switch actorType {
+	case ActorTypeUser, ActorTypeAPIKey, ActorTypeServiceAccount:
+		return Actor{Type: actorType, ID: id}, id != ""
+	case ActorTypeSystem:
+		return Actor{Type: actorType, ID: id}, true
+	}
+	return Actor{}, false
+}
+
+// IsSystem checks if the actor is the application itself
+func (a Actor) IsSystem() bool {
+	return a.Type == ActorTypeSystem
+}
+
+// IsImpersonated checks if an administrator acts as the user
+func (a Actor) IsImpersonated() bool {
+	return a.ImpersonatorID != ""
+}
+
+// actorKey is the context key of the actor
+type actorKey struct{}
+
+// WithActor returns a copy of ctx carrying the actor
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor of ctx, or false when none was set
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	return actor, ok
+}
+
+// ActorOrSystem returns the actor of ctx, falling back to the system actor
+func ActorOrSystem(ctx context.Context) Actor {
+	if actor, ok := ActorFromContext(ctx); ok {
+		return actor
+	}
+	return SystemActor()
+}
+
+// WithEventActor returns a copy of ctx carrying the actor that triggered the event, the ID of its request,
+// its trace and its tenant
+// Event handlers use it so that changes they cascade are attributed to the original actor and request
+func WithEventActor(ctx context.Context, event DomainEvent) context.Context {
+	if traced, ok := event.(interface{ GetTraceContext() map[string]string }); ok {
+		ctx = withTraceContext(ctx, traced.GetTraceContext())
+	}
+	if requested, ok := event.(interface{ GetRequestID() string }); ok && requested.GetRequestID() != "" {
+		ctx = WithRequestID(ctx, requested.GetRequestID())
+	}
+	if tenanted, ok := event.(interface{ GetTenantID() string }); ok && tenanted.GetTenantID() != "" {
+		ctx = WithTenantID(ctx, tenanted.GetTenantID())
+	}
+
+	triggered, ok := event.(interface{ GetTriggeredBy() string })
+	if !ok {
+		return ctx
+	}
+	actor, ok := ParseActor(triggered.GetTriggeredBy())
+	if !ok {
+		return ctx
+	}
+	return WithActor(ctx, actor)
+}
+
+// StampActor returns the event with TriggeredBy set to the actor of ctx, or the system actor,
+// RequestID to the request ID of ctx, TraceContext to the trace of ctx and TenantID to its tenant
+// Events are values, so a stamped copy of the same concrete type is returned and subscribers
+// switching on event types are unaffected; events already stamped or without a
+// BaseDomainEvent are returned unchanged
+func StampActor(ctx context.Context, event DomainEvent) DomainEvent {
+	value := reflect.ValueOf(event)
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return event
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return event
+	}
+
+	base, ok := value.Type().FieldByName("BaseDomainEvent")
+	if !ok || base.Type != reflect.TypeOf(BaseDomainEvent{}) {
+		return event
+	}
+	if value.FieldByIndex(base.Index).FieldByName("TriggeredBy").String() != "" {
+		return event
+	}
+
+	stamped := reflect.New(value.Type())
+	stamped.Elem().Set(value)
+	stampedBase := stamped.Elem().FieldByIndex(base.Index)
+	stampedBase.FieldByName("TriggeredBy").SetString(ActorOrSystem(ctx).String())
+	stampedBase.FieldByName("RequestID").SetString(RequestIDFromContext(ctx))
+	if traceContext := traceContextOf(ctx); len(traceContext) > 0 {
+		stampedBase.FieldByName("TraceContext").Set(reflect.ValueOf(traceContext))
+	}
+	if tenantID, ok := TenantIDFromContext(ctx); ok {
+		stampedBase.FieldByName("TenantID").SetString(tenantID)
+	}
+
+	if reflect.TypeOf(event).Kind() == reflect.Ptr {
+		return stamped.Interface().(DomainEvent)
+	}
+	return stamped.Elem().Interface().(DomainEvent)
+}
@@ -31,6 +31,10 @@ type BaseAggregateRoot struct {
 	CreatedAt         time.Time     `json:"created_at"`
 	UpdatedAt         time.Time     `json:"updated_at"`
 	uncommittedEvents []DomainEvent `json:"-"`
+
+	// storedVersion is the version in storage, valid when stored is set
+	storedVersion int
+	stored        bool
 }
 
 // NewBaseAggregateRoot creates a new base aggregate root
@@ -83,6 +87,24 @@ func (a *BaseAggregateRoot) IncrementVersion() {
 	a.UpdatedAt = time.Now()
 }
 
+// RestoreVersion sets the version of an aggregate loaded from storage
+func (a *BaseAggregateRoot) RestoreVersion(version int) {
+	a.Version = version
+	a.MarkStored()
+}
+
+// MarkStored records the current version as the one in storage, once the aggregate is saved
+func (a *BaseAggregateRoot) MarkStored() {
+	a.storedVersion = a.Version
+	a.stored = true
+}
+
+// StoredVersion returns the version in storage, which an optimistic update expects to replace, and
+// whether the aggregate was stored at all
+func (a *BaseAggregateRoot) StoredVersion() (int, bool) {
+	return a.storedVersion, a.stored
+}
+
 // AddEvent adds a domain event to the uncommitted events
 func (a *BaseAggregateRoot) AddEvent(event DomainEvent) {
 	a.uncommittedEvents = append(a.uncommittedEvents, event)
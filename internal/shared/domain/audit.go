@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Audit outcomes
+const (
+	AuditSuccess = "success"
+	AuditFailure = "failure"
+)
+
+// AuditEntry records who did what to which aggregate, kept for review
+// Unlike domain events, entries are also recorded for failures that change no state
+type AuditEntry struct {
+	ID     string `json:"id"`
+	Module string `json:"module"`
+	// Action is what was done, e.g. "CreateCustomer" or "security.login_failed"
+	Action        string    `json:"action"`
+	AggregateType string    `json:"aggregate_type,omitempty"`
+	AggregateID   string    `json:"aggregate_id,omitempty"`
+	Actor         string    `json:"actor,omitempty"`
+	RequestID     string    `json:"request_id,omitempty"`
+	IPAddress     string    `json:"ip_address,omitempty"`
+	Outcome       string    `json:"outcome"`
+	Detail        string    `json:"detail,omitempty"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+// NewAuditEntry creates a successful entry of an action that occurred now, by the actor and client
+// of the request of ctx
+func NewAuditEntry(ctx context.Context, action string) AuditEntry {
+	entry := AuditEntry{
+		ID:         uuid.New().String(),
+		Action:     action,
+		RequestID:  RequestIDFromContext(ctx),
+		IPAddress:  ClientInfoFromContext(ctx).IPAddress,
+		Outcome:    AuditSuccess,
+		OccurredAt: time.Now().UTC(),
+	}
+	if actor, ok := ActorFromContext(ctx); ok {
+		entry.Actor = actor.String()
+	}
+	return entry
+}
+
+// AuditWriter records audit entries
+// Callers log rather than return its errors, so that auditing never fails an operation
+type AuditWriter interface {
+	Write(ctx context.Context, entry AuditEntry) error
+}
+
+// moduleAuditWriter records the entries of a module
+type moduleAuditWriter struct {
+	writer AuditWriter
+	module string
+}
+
+// Write records the entry as the module's unless it names its module
+func (w moduleAuditWriter) Write(ctx context.Context, entry AuditEntry) error {
+	if entry.Module == "" {
+		entry.Module = w.module
+	}
+	return w.writer.Write(ctx, entry)
+}
+
+// discardAuditWriter drops entries, for modules initialized without an audit log
+type discardAuditWriter struct{}
+
+// Write drops the entry
+func (discardAuditWriter) Write(context.Context, AuditEntry) error {
+	return nil
+}
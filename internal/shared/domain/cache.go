@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Cache keeps values for a while, e.g. read models that are expensive to compute
+// Modules receive their own namespace of the shared cache when features.caching_enabled is set,
+// and a cache that keeps nothing otherwise, so they can use it without checking the flag
+type Cache interface {
+	// Get returns the value stored under key; ok is false when it is missing or expired
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	// Set stores the value under key for ttl; zero keeps it until it is evicted
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes the values stored under keys
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// noCache keeps nothing, for modules without caching
+type noCache struct{}
+
+// Get always misses
+func (noCache) Get(context.Context, string) ([]byte, bool, error) {
+	return nil, false, nil
+}
+
+// Set drops the value
+func (noCache) Set(context.Context, string, []byte, time.Duration) error {
+	return nil
+}
+
+// Delete has nothing to remove
+func (noCache) Delete(context.Context, ...string) error {
+	return nil
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "context"
+
+// ClientInfo describes the client a request came from
+// It is set on the request context by the HTTP server, for audit records of the request
+type ClientInfo struct {
+	IPAddress string `json:"ip_address,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+}
+
+// clientInfoKey is the context key of the client info
+type clientInfoKey struct{}
+
+// WithClientInfo returns a copy of ctx carrying the client info
+func WithClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, info)
+}
+
+// ClientInfoFromContext returns the client info of ctx, or an empty one when none was set
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	info, _ := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return info
+}
@@ -85,8 +85,11 @@ const (
 	ErrCodeUnauthorized        = "UNAUTHORIZED"
 	ErrCodeForbidden           = "FORBIDDEN"
 	ErrCodeConcurrencyConflict = "CONCURRENCY_CONFLICT"
+	ErrCodePreconditionFailed  = "PRECONDITION_FAILED"
 	ErrCodeInvalidState        = "INVALID_STATE"
 	ErrCodeBusinessRule        = "BUSINESS_RULE_VIOLATION"
+	ErrCodeRequestTooLarge     = "REQUEST_TOO_LARGE"
+	ErrCodeRequestTimeout      = "REQUEST_TIMEOUT"
 )
 
 // ValidationError represents a validation error
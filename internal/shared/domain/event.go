@@ -39,6 +39,14 @@ type BaseDomainEvent struct {
 	EventVersion  int         `json:"event_version"`
 	OccurredAt    time.Time   `json:"occurred_at"`
 	EventData     interface{} `json:"event_data"`
+	// TriggeredBy is the actor whose request produced the event, stamped when it is published (StampActor)
+	TriggeredBy string `json:"triggered_by,omitempty"`
+	// RequestID is the ID of the request that produced the event, stamped with TriggeredBy
+	RequestID string `json:"request_id,omitempty"`
+	// TraceContext holds the W3C trace context of the request that produced the event, stamped with TriggeredBy
+	TraceContext map[string]string `json:"trace_context,omitempty"`
+	// TenantID is the tenant of the request that produced the event, stamped with TriggeredBy
+	TenantID string `json:"tenant_id,omitempty"`
 }
 
 // NewBaseDomainEvent creates a new base domain event
@@ -89,6 +97,26 @@ func (e BaseDomainEvent) GetEventData() interface{} {
 	return e.EventData
 }
 
+// GetTriggeredBy returns the actor whose request produced the event, e.g. "user:<id>" or "system"
+func (e BaseDomainEvent) GetTriggeredBy() string {
+	return e.TriggeredBy
+}
+
+// GetRequestID returns the ID of the request that produced the event, or "" for events raised outside a request
+func (e BaseDomainEvent) GetRequestID() string {
+	return e.RequestID
+}
+
+// GetTraceContext returns the W3C trace context of the request that produced the event, or nil when it was not traced
+func (e BaseDomainEvent) GetTraceContext() map[string]string {
+	return e.TraceContext
+}
+
+// GetTenantID returns the tenant of the request that produced the event, or "" when it served no tenant
+func (e BaseDomainEvent) GetTenantID() string {
+	return e.TenantID
+}
+
 // EventHandler defines how to handle domain events
 type EventHandler interface {
 	Handle(event DomainEvent) error
@@ -0,0 +1,69 @@
+package domain
+
+import "sync"
+
+// EventHandlerLoad is the number of events the handlers of a module are handling, out of its limit
+type EventHandlerLoad struct {
+	InFlight int `json:"in_flight"`
+	Limit    int `json:"limit"`
+}
+
+// limitedEventBus bounds the events the handlers subscribed through it process at once, so that a
+// module's slow handlers cannot occupy every publisher; publishing beyond the limit waits for a
+// handler of the module to finish
+type limitedEventBus struct {
+	EventBus
+	slots chan struct{}
+
+	mu       sync.Mutex
+	wrappers map[EventHandler]EventHandler
+}
+
+// newLimitedEventBus wraps bus so that the handlers subscribed through it handle at most limit
+// events at once
+func newLimitedEventBus(bus EventBus, limit int) *limitedEventBus {
+	return &limitedEventBus{
+		EventBus: bus,
+		slots:    make(chan struct{}, limit),
+		wrappers: make(map[EventHandler]EventHandler),
+	}
+}
+
+// Subscribe subscribes handler behind the bus's limit
+func (b *limitedEventBus) Subscribe(handler EventHandler) error {
+	wrapper := &limitedEventHandler{EventHandler: handler, slots: b.slots}
+	if err := b.EventBus.Subscribe(wrapper); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.wrappers[handler] = wrapper
+	b.mu.Unlock()
+	return nil
+}
+
+// Unsubscribe removes a handler subscribed through the bus
+func (b *limitedEventBus) Unsubscribe(handler EventHandler) error {
+	b.mu.Lock()
+	wrapper, ok := b.wrappers[handler]
+	delete(b.wrappers, handler)
+	b.mu.Unlock()
+
+	if !ok {
+		return b.EventBus.Unsubscribe(handler)
+	}
+	return b.EventBus.Unsubscribe(wrapper)
+}
+
+// limitedEventHandler handles events once a slot of its module is free
+type limitedEventHandler struct {
+	EventHandler
+	slots chan struct{}
+}
+
+// Handle waits for a slot and handles the event
+func (h *limitedEventHandler) Handle(event DomainEvent) error {
+	h.slots <- struct{}{}
+	defer func() { <-h.slots }()
+	return h.EventHandler.Handle(event)
+}
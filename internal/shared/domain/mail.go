@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrMailRejected is wrapped by the errors of emails refused for good, e.g. for an invalid
+// recipient; sending them again fails the same way
+var ErrMailRejected = errors.New("email rejected")
+
+// ErrMailUnavailable is returned by the mailer of modules initialized without outbound email
+var ErrMailUnavailable = errors.New("outbound email is not configured")
+
+// Email is an email sent by a module
+type Email struct {
+	// ID identifies the email in its Message-ID, e.g. the notification it delivers; a random ID is
+	// used when it is empty
+	ID      string
+	To      []string
+	ReplyTo string
+	Subject string
+	// Text is the plain text body; HTML, when set, is sent as its alternative
+	Text string
+	HTML string
+}
+
+// Mailer sends the emails of a module from the module's sender address
+type Mailer interface {
+	// Send sends the email, returning its Message-ID
+	// It returns an error wrapping ErrMailRejected when the email is refused for good
+	Send(ctx context.Context, email Email) (string, error)
+}
+
+// noMailer sends nothing, for modules initialized without outbound email
+type noMailer struct{}
+
+// Send always fails
+func (noMailer) Send(context.Context, Email) (string, error) {
+	return "", ErrMailUnavailable
+}
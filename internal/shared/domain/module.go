@@ -2,9 +2,16 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
 )
 
 // Module represents a business module in the system
@@ -28,24 +35,179 @@ type Module interface {
 	Stop(ctx context.Context) error
 }
 
+// VersionedModule is implemented by modules whose routes differ between API versions
+// Other modules register the same routes with RegisterRoutes under every version they are served under
+type VersionedModule interface {
+	Module
+
+	// RegisterVersionRoutes registers the routes of a route set, as named by http.versions.<version>.handlers
+	// (e.g. "v2"), returning an error for route sets the module does not have
+	RegisterVersionRoutes(handlers string, router *gin.RouterGroup) error
+}
+
+// DependentModule is implemented by modules that need other modules to be initialized and started first,
+// e.g. because they use their public APIs during initialization
+type DependentModule interface {
+	Module
+
+	// DependsOn returns the names of the modules this module depends on
+	DependsOn() []string
+}
+
+// InitHooks is implemented by modules running code around their initialization
+type InitHooks interface {
+	Module
+
+	// PreInit runs before the module's Initialize, e.g. to validate its environment
+	PreInit(ctx context.Context) error
+
+	// PostInit runs once every module is initialized, e.g. to use other modules' public APIs
+	PostInit(ctx context.Context) error
+}
+
+// StartHooks is implemented by modules running code around their start
+type StartHooks interface {
+	Module
+
+	// PreStart runs before the module's Start
+	PreStart(ctx context.Context) error
+
+	// PostStart runs once every module is started, e.g. to begin background work
+	PostStart(ctx context.Context) error
+}
+
+// StopHooks is implemented by modules running code around their stop
+type StopHooks interface {
+	Module
+
+	// PreStop runs before any module is stopped, e.g. to stop taking work from other modules
+	PreStop(ctx context.Context) error
+
+	// PostStop runs after the module's Stop, e.g. to flush what it buffered
+	PostStop(ctx context.Context) error
+}
+
+// LifecycleTimeouts bounds each call of a lifecycle phase, hooks included; zero means no limit
+// A call still running when its timeout elapses fails the phase
+type LifecycleTimeouts struct {
+	Init  time.Duration
+	Start time.Duration
+	Stop  time.Duration
+}
+
+// FailurePolicy tells how the registry handles a module failing to initialize or start
+type FailurePolicy string
+
+const (
+	// FailAbort fails the startup of the service, the default
+	FailAbort FailurePolicy = "abort"
+	// FailSkip leaves the module out of the registry, as if it were disabled
+	FailSkip FailurePolicy = "skip"
+	// FailDegrade keeps the module registered, serving no routes and reporting its failure as its health
+	FailDegrade FailurePolicy = "degrade"
+)
+
+// Lifecycle phases a module can fail in
+const (
+	PhaseInit  = "init"
+	PhaseStart = "start"
+)
+
+// ModuleFailure records a module that failed under the skip or degrade policy
+type ModuleFailure struct {
+	Module string
+	Phase  string
+	Policy FailurePolicy
+	Err    error
+	At     time.Time
+}
+
+// RouteGroup is a group a module's routes are registered in, with the route set served in it
+type RouteGroup struct {
+	Handlers string
+	Group    *gin.RouterGroup
+}
+
 // ModuleDependencies contains shared dependencies for modules
 type ModuleDependencies struct {
-	EventBus EventBus
-	Config   interface{} // Module-specific config
+	EventBus   EventBus
+	Config     ModuleConfig       // The module's own resolved config
+	PublicAPIs *PublicAPIRegistry // Public APIs modules expose to each other
+	Requests   *RequestBus        // Queries modules answer for each other
+	Logger     *zap.Logger        // The module's logger, tagged with its name
+	Audit      AuditWriter        // Audit log; entries are recorded as the module's
+	Cache      Cache              // The module's cache; it keeps nothing unless caching is enabled
+	Mailer     Mailer             // Outbound email, sent from the module's address
+	// ModuleLogger creates the logger of a module, e.g. at the module's own level; without it, each
+	// module logs through Logger
+	ModuleLogger func(module string) *zap.Logger
+	// ModuleCache returns the cache of a module, nil when the module does not cache; without it,
+	// each module uses Cache
+	ModuleCache func(module string) Cache
+	// ModuleMailer returns the mailer of a module; without it, each module uses Mailer
+	ModuleMailer func(module string) Mailer
+}
+
+// ModuleConfig is the configuration of one module, merged from its module.yaml and
+// config/modules.yaml; custom settings are the section named after the module, e.g. customer:
+type ModuleConfig interface {
+	// Settings returns the module's custom settings, nil when none are configured
+	Settings() map[string]interface{}
+	// Section returns the named section of the custom settings, nil when it is not configured
+	Section(name string) map[string]interface{}
+	// Decode decodes the named section of the custom settings into the struct out points to,
+	// by mapstructure tags; an empty name decodes all custom settings, and fields not configured
+	// keep their values, so out can be filled with defaults first
+	Decode(section string, out interface{}) error
 }
 
+// emptyModuleConfig is the config of modules initialized without one
+type emptyModuleConfig struct{}
+
+func (emptyModuleConfig) Settings() map[string]interface{}      { return nil }
+func (emptyModuleConfig) Section(string) map[string]interface{} { return nil }
+func (emptyModuleConfig) Decode(string, interface{}) error      { return nil }
+
 // ModuleRegistry manages module registration and lifecycle
 type ModuleRegistry struct {
-	modules map[string]Module
+	modules      map[string]Module
+	dependencies map[string][]string
+	configs      map[string]ModuleConfig
+	timeouts     LifecycleTimeouts
+	policies     map[string]FailurePolicy
+	eventLimits  map[string]int
+	eventBuses   map[string]*limitedEventBus
+	failures     map[string]ModuleFailure
+	failuresMu   sync.RWMutex
+	publicAPIs   *PublicAPIRegistry
+	requests     *RequestBus
 }
 
 // NewModuleRegistry creates a new module registry
 func NewModuleRegistry() *ModuleRegistry {
 	return &ModuleRegistry{
-		modules: make(map[string]Module),
+		modules:      make(map[string]Module),
+		dependencies: make(map[string][]string),
+		configs:      make(map[string]ModuleConfig),
+		policies:     make(map[string]FailurePolicy),
+		eventLimits:  make(map[string]int),
+		eventBuses:   make(map[string]*limitedEventBus),
+		failures:     make(map[string]ModuleFailure),
+		publicAPIs:   NewPublicAPIRegistry(),
+		requests:     NewRequestBus(),
 	}
 }
 
+// PublicAPIs returns the registry of public APIs shared between the registered modules
+func (r *ModuleRegistry) PublicAPIs() *PublicAPIRegistry {
+	return r.publicAPIs
+}
+
+// Requests returns the request bus shared between the registered modules
+func (r *ModuleRegistry) Requests() *RequestBus {
+	return r.requests
+}
+
 // Register registers a module
 func (r *ModuleRegistry) Register(module Module) {
 	r.modules[module.Name()] = module
@@ -62,25 +224,304 @@ func (r *ModuleRegistry) GetAllModules() map[string]Module {
 	return r.modules
 }
 
-// GetModuleNames returns all registered module names
+// GetModuleNames returns all registered module names, sorted
 func (r *ModuleRegistry) GetModuleNames() []string {
 	names := make([]string, 0, len(r.modules))
 	for name := range r.modules {
 		names = append(names, name)
 	}
+	sort.Strings(names)
 	return names
 }
 
-// InitializeAll initializes all registered modules
-func (r *ModuleRegistry) InitializeAll(deps ModuleDependencies) error {
-	for name, module := range r.modules {
-		if err := module.Initialize(deps); err != nil {
-			return fmt.Errorf("failed to initialize module %s: %w", name, err)
+// AddDependencies declares modules that module depends on, in addition to those of DependentModule
+func (r *ModuleRegistry) AddDependencies(module string, dependsOn ...string) {
+	r.dependencies[module] = append(r.dependencies[module], dependsOn...)
+}
+
+// SetTimeouts sets the timeouts of the lifecycle phases
+func (r *ModuleRegistry) SetTimeouts(timeouts LifecycleTimeouts) {
+	r.timeouts = timeouts
+}
+
+// SetConfig sets the config module receives in Initialize
+func (r *ModuleRegistry) SetConfig(module string, config ModuleConfig) {
+	r.configs[module] = config
+}
+
+// SetFailurePolicy sets how the registry handles module failing to initialize or start, FailAbort
+// when not set
+func (r *ModuleRegistry) SetFailurePolicy(module string, policy FailurePolicy) {
+	r.policies[module] = policy
+}
+
+// SetEventHandlerLimit limits the events the handlers a module subscribes handle at once; zero or
+// less removes the limit
+func (r *ModuleRegistry) SetEventHandlerLimit(module string, limit int) {
+	r.eventLimits[module] = limit
+}
+
+// EventHandlerLoad returns the events the handlers of each module with an event handler limit are
+// handling, and the limit
+func (r *ModuleRegistry) EventHandlerLoad() map[string]EventHandlerLoad {
+	load := make(map[string]EventHandlerLoad, len(r.eventBuses))
+	for name, bus := range r.eventBuses {
+		load[name] = EventHandlerLoad{InFlight: len(bus.slots), Limit: cap(bus.slots)}
+	}
+	return load
+}
+
+// Failures returns the modules that failed under the skip or degrade policy, sorted by name
+func (r *ModuleRegistry) Failures() []ModuleFailure {
+	r.failuresMu.RLock()
+	defer r.failuresMu.RUnlock()
+
+	failures := make([]ModuleFailure, 0, len(r.failures))
+	for _, failure := range r.failures {
+		failures = append(failures, failure)
+	}
+	sort.Slice(failures, func(i, j int) bool { return failures[i].Module < failures[j].Module })
+	return failures
+}
+
+// Failure returns the failure of a module, if it failed under the skip or degrade policy
+func (r *ModuleRegistry) Failure(module string) (ModuleFailure, bool) {
+	r.failuresMu.RLock()
+	defer r.failuresMu.RUnlock()
+
+	failure, ok := r.failures[module]
+	return failure, ok
+}
+
+// DependenciesOf returns the modules a registered module depends on, sorted and without duplicates
+func (r *ModuleRegistry) DependenciesOf(name string) []string {
+	seen := make(map[string]bool)
+	var names []string
+	add := func(dependencies []string) {
+		for _, dependency := range dependencies {
+			if dependency != "" && !seen[dependency] {
+				seen[dependency] = true
+				names = append(names, dependency)
+			}
+		}
+	}
+
+	add(r.dependencies[name])
+	if dependent, ok := r.modules[name].(DependentModule); ok {
+		add(dependent.DependsOn())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Order returns the registered module names with every module after the modules it depends on,
+// breaking ties by name so that the order is the same on every run
+// It fails when a module depends on a module that is not registered, or when dependencies form a cycle
+func (r *ModuleRegistry) Order() ([]string, error) {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(r.modules))
+	order := make([]string, 0, len(r.modules))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			cycle := append(path[indexOf(path, name):], name)
+			return fmt.Errorf("module dependency cycle: %s", strings.Join(cycle, " -> "))
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		for _, dependency := range r.DependenciesOf(name) {
+			if _, ok := r.modules[dependency]; !ok {
+				return fmt.Errorf("module %s depends on module %s, which is not enabled", name, dependency)
+			}
+			if err := visit(dependency, path); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		order = append(order, name)
+		return nil
+	}
+
+	for _, name := range r.GetModuleNames() {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// indexOf returns the index of name in names, or 0 when it is absent
+func indexOf(names []string, name string) int {
+	for i, candidate := range names {
+		if candidate == name {
+			return i
+		}
+	}
+	return 0
+}
+
+// InitializeAll initializes all registered modules, each after the modules it depends on, then runs
+// their PostInit hooks in the same order
+// Modules share the registry's public APIs and request bus unless deps provides its own, and
+// receive the config set with SetConfig
+// A module failing under FailSkip or FailDegrade is recorded in Failures, and the modules depending
+// on it fail in turn; the others fail the initialization
+func (r *ModuleRegistry) InitializeAll(ctx context.Context, deps ModuleDependencies) error {
+	if deps.PublicAPIs == nil {
+		deps.PublicAPIs = r.publicAPIs
+	}
+	if deps.Requests == nil {
+		deps.Requests = r.requests
+	}
+	if deps.Audit == nil {
+		deps.Audit = discardAuditWriter{}
+	}
+
+	order, err := r.Order()
+	if err != nil {
+		return err
+	}
+	for _, name := range order {
+		if err := r.initialize(ctx, name, deps); err != nil {
+			if err := r.fail(name, PhaseInit, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, name := range order {
+		if _, failed := r.Failure(name); failed {
+			continue
+		}
+		if hooks, ok := r.modules[name].(InitHooks); ok {
+			if err := runPhase(ctx, r.timeouts.Init, hooks.PostInit); err != nil {
+				if err := r.fail(name, PhaseInit, fmt.Errorf("post-init hook of module %s failed: %w", name, err)); err != nil {
+					return err
+				}
+			}
 		}
 	}
+	r.removeFailed()
 	return nil
 }
 
+// initialize runs the PreInit hook and Initialize of a module, failing when a module it depends on failed
+func (r *ModuleRegistry) initialize(ctx context.Context, name string, deps ModuleDependencies) error {
+	if err := r.checkDependencies(name); err != nil {
+		return err
+	}
+
+	module := r.modules[name]
+	if hooks, ok := module.(InitHooks); ok {
+		if err := runPhase(ctx, r.timeouts.Init, hooks.PreInit); err != nil {
+			return fmt.Errorf("pre-init hook of module %s failed: %w", name, err)
+		}
+	}
+
+	// Each module receives its own config, logger, audit writer, cache and mailer, and an event bus bounded by its budget
+	if limit := r.eventLimits[name]; limit > 0 && deps.EventBus != nil {
+		bus := newLimitedEventBus(deps.EventBus, limit)
+		r.eventBuses[name] = bus
+		deps.EventBus = bus
+	}
+	if deps.ModuleLogger != nil {
+		deps.Logger = deps.ModuleLogger(name)
+	} else {
+		if deps.Logger == nil {
+			deps.Logger = zap.L()
+		}
+		deps.Logger = deps.Logger.With(zap.String("module", name))
+	}
+	deps.Audit = moduleAuditWriter{writer: deps.Audit, module: name}
+	if deps.ModuleCache != nil {
+		deps.Cache = deps.ModuleCache(name)
+	}
+	if deps.Cache == nil {
+		deps.Cache = noCache{}
+	}
+	if deps.ModuleMailer != nil {
+		deps.Mailer = deps.ModuleMailer(name)
+	}
+	if deps.Mailer == nil {
+		deps.Mailer = noMailer{}
+	}
+	deps.Config = r.configs[name]
+	if deps.Config == nil {
+		deps.Config = emptyModuleConfig{}
+	}
+	initialize := func(context.Context) error { return module.Initialize(deps) }
+	if err := runPhase(ctx, r.timeouts.Init, initialize); err != nil {
+		return fmt.Errorf("failed to initialize module %s: %w", name, err)
+	}
+	return nil
+}
+
+// checkDependencies fails when a module that module depends on failed
+func (r *ModuleRegistry) checkDependencies(module string) error {
+	for _, dependency := range r.DependenciesOf(module) {
+		if _, failed := r.Failure(dependency); failed {
+			return fmt.Errorf("module %s depends on module %s, which failed", module, dependency)
+		}
+	}
+	return nil
+}
+
+// fail applies the failure policy of a module to err: it returns err under FailAbort, and otherwise
+// records the failure and returns nil
+func (r *ModuleRegistry) fail(module, phase string, err error) error {
+	policy := r.policies[module]
+	if policy == "" || policy == FailAbort {
+		return err
+	}
+
+	r.failuresMu.Lock()
+	r.failures[module] = ModuleFailure{Module: module, Phase: phase, Policy: policy, Err: err, At: time.Now().UTC()}
+	r.failuresMu.Unlock()
+	zap.L().Error("module failed, continuing without it",
+		zap.String("module", module), zap.String("phase", phase), zap.String("policy", string(policy)), zap.Error(err))
+	return nil
+}
+
+// removeFailed takes the failed modules out of the lifecycle and withdraws their public APIs and
+// request endpoints: skipped modules are unregistered and degraded modules replaced by a stand-in
+// reporting their failure
+func (r *ModuleRegistry) removeFailed() {
+	for _, failure := range r.Failures() {
+		if _, ok := r.modules[failure.Module].(failedModule); ok {
+			continue
+		}
+		delete(r.dependencies, failure.Module)
+		r.publicAPIs.Unregister(failure.Module)
+		r.requests.UnhandleModule(failure.Module)
+		if failure.Policy == FailSkip {
+			delete(r.modules, failure.Module)
+			continue
+		}
+		r.modules[failure.Module] = failedModule{name: failure.Module, err: failure.Err}
+	}
+}
+
+// failedModule stands in for a degraded module: it serves no routes and reports its failure as its health
+type failedModule struct {
+	name string
+	err  error
+}
+
+func (m failedModule) Name() string                        { return m.name }
+func (m failedModule) Initialize(ModuleDependencies) error { return nil }
+func (m failedModule) RegisterRoutes(*gin.RouterGroup)     {}
+func (m failedModule) Health(context.Context) error        { return m.err }
+func (m failedModule) Start(context.Context) error         { return nil }
+func (m failedModule) Stop(context.Context) error          { return nil }
+
 // RegisterAllRoutes registers routes for all modules
 func (r *ModuleRegistry) RegisterAllRoutes(router *gin.RouterGroup) {
 	for _, module := range r.modules {
@@ -88,31 +529,213 @@ func (r *ModuleRegistry) RegisterAllRoutes(router *gin.RouterGroup) {
 	}
 }
 
-// StartAll starts all modules
-func (r *ModuleRegistry) StartAll(ctx context.Context) error {
+// RegisterAllRoutesWithMiddleware registers routes for all modules, each in its own group,
+// so the middleware returned for a module only applies to that module's routes
+func (r *ModuleRegistry) RegisterAllRoutesWithMiddleware(router *gin.RouterGroup, middleware func(module string) []gin.HandlerFunc) {
 	for name, module := range r.modules {
-		if err := module.Start(ctx); err != nil {
-			return fmt.Errorf("failed to start module %s: %w", name, err)
+		group := router.Group("")
+		group.Use(middleware(name)...)
+		module.RegisterRoutes(group)
+	}
+}
+
+// RegisterAllRoutesInGroups registers routes for all modules, each in the group returned for it,
+// so modules can be mounted under their own prefix and middleware
+// Modules for which group returns nil register no routes
+func (r *ModuleRegistry) RegisterAllRoutesInGroups(group func(module string) *gin.RouterGroup) {
+	for name, module := range r.modules {
+		if moduleGroup := group(name); moduleGroup != nil {
+			module.RegisterRoutes(moduleGroup)
 		}
 	}
-	return nil
 }
 
-// StopAll stops all modules
-func (r *ModuleRegistry) StopAll(ctx context.Context) error {
+// RegisterAllVersionedRoutes registers routes for all modules in each group returned for them, one per
+// API version, so modules can serve several versions side by side
+// Versioned modules register the route set of each group; other modules register their routes in every group
+func (r *ModuleRegistry) RegisterAllVersionedRoutes(groups func(module string) []RouteGroup) error {
 	for name, module := range r.modules {
-		if err := module.Stop(ctx); err != nil {
-			return fmt.Errorf("failed to stop module %s: %w", name, err)
+		for _, group := range groups(name) {
+			versioned, ok := module.(VersionedModule)
+			if !ok {
+				module.RegisterRoutes(group.Group)
+				continue
+			}
+			if err := versioned.RegisterVersionRoutes(group.Handlers, group.Group); err != nil {
+				return fmt.Errorf("failed to register %s routes of module %s: %w", group.Handlers, name, err)
+			}
 		}
 	}
 	return nil
 }
 
-// HealthCheckAll checks health of all modules
-func (r *ModuleRegistry) HealthCheckAll(ctx context.Context) map[string]error {
-	results := make(map[string]error)
-	for name, module := range r.modules {
-		results[name] = module.Health(ctx)
+// StartAll starts all modules, each after the modules it depends on, then runs their PostStart hooks
+// in the same order
+// When a module fails to start or a PostStart hook fails under FailAbort, the modules already started
+// are stopped in reverse order and the error is returned together with any error stopping them;
+// under the other policies the module is recorded as failed, stopped if it started, and the modules
+// depending on it fail in turn
+func (r *ModuleRegistry) StartAll(ctx context.Context) error {
+	order, err := r.Order()
+	if err != nil {
+		return err
+	}
+
+	started := make([]string, 0, len(order))
+	for _, name := range order {
+		if _, failed := r.Failure(name); failed {
+			continue
+		}
+		if err := r.start(ctx, name); err != nil {
+			if err := r.fail(name, PhaseStart, err); err != nil {
+				return errors.Join(err, r.rollback(ctx, started))
+			}
+			continue
+		}
+		started = append(started, name)
+	}
+
+	for _, name := range append([]string(nil), started...) {
+		if hooks, ok := r.modules[name].(StartHooks); ok {
+			if err := runPhase(ctx, r.timeouts.Start, hooks.PostStart); err != nil {
+				err = fmt.Errorf("post-start hook of module %s failed: %w", name, err)
+				if err := r.fail(name, PhaseStart, err); err != nil {
+					return errors.Join(err, r.rollback(ctx, started))
+				}
+				// The module started, so it is stopped before being left out
+				started = slices.DeleteFunc(started, func(started string) bool { return started == name })
+				if err := r.stop(context.WithoutCancel(ctx), []string{name}); err != nil {
+					zap.L().Error("failed to stop module after its post-start hook failed", zap.String("module", name), zap.Error(err))
+				}
+			}
+		}
+	}
+	r.removeFailed()
+	return nil
+}
+
+// start runs the PreStart hook and Start of a module, failing when a module it depends on failed
+func (r *ModuleRegistry) start(ctx context.Context, name string) error {
+	if err := r.checkDependencies(name); err != nil {
+		return err
+	}
+
+	module := r.modules[name]
+	if hooks, ok := module.(StartHooks); ok {
+		if err := runPhase(ctx, r.timeouts.Start, hooks.PreStart); err != nil {
+			return fmt.Errorf("pre-start hook of module %s failed: %w", name, err)
+		}
+	}
+	if err := runPhase(ctx, r.timeouts.Start, module.Start); err != nil {
+		return fmt.Errorf("failed to start module %s: %w", name, err)
+	}
+	return nil
+}
+
+// rollback stops the started modules in reverse order, even when ctx is canceled
+func (r *ModuleRegistry) rollback(ctx context.Context, started []string) error {
+	return r.stop(context.WithoutCancel(ctx), started)
+}
+
+// StopAll stops all modules in the reverse of their start order, so that no module is stopped
+// before the modules depending on it; the pre-stop hooks of every module run first
+// A module failing to stop does not keep the others from stopping; the errors are returned together
+func (r *ModuleRegistry) StopAll(ctx context.Context) error {
+	order, err := r.Order()
+	if err != nil {
+		return err
+	}
+	return r.stop(ctx, order)
+}
+
+// stop stops the named modules in reverse order, after running the pre-stop hooks of all of them
+func (r *ModuleRegistry) stop(ctx context.Context, names []string) error {
+	var errs []error
+	for i := len(names) - 1; i >= 0; i-- {
+		if hooks, ok := r.modules[names[i]].(StopHooks); ok {
+			if err := runPhase(ctx, r.timeouts.Stop, hooks.PreStop); err != nil {
+				errs = append(errs, fmt.Errorf("pre-stop hook of module %s failed: %w", names[i], err))
+			}
+		}
+	}
+	for i := len(names) - 1; i >= 0; i-- {
+		module := r.modules[names[i]]
+		if err := runPhase(ctx, r.timeouts.Stop, module.Stop); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop module %s: %w", names[i], err))
+		}
+		if hooks, ok := module.(StopHooks); ok {
+			if err := runPhase(ctx, r.timeouts.Stop, hooks.PostStop); err != nil {
+				errs = append(errs, fmt.Errorf("post-stop hook of module %s failed: %w", names[i], err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// runPhase calls fn with ctx bounded by timeout, returning once the timeout elapses even when fn
+// ignores its context
+func runPhase(ctx context.Context, timeout time.Duration, fn func(ctx context.Context) error) error {
+	if timeout <= 0 {
+		return fn(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- fn(ctx) }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("timed out after %s", timeout)
+		}
+		return ctx.Err()
+	}
+}
+
+// ModuleHealth is the outcome of one module's Health
+type ModuleHealth struct {
+	Name    string
+	Latency time.Duration
+	// Err is nil when the module is healthy
+	Err error
+}
+
+// HealthCheckAll checks the health of all modules concurrently and returns the results sorted by
+// name; a module not answering before ctx is done is reported with the context's error
+func (r *ModuleRegistry) HealthCheckAll(ctx context.Context) []ModuleHealth {
+	names := r.GetModuleNames()
+	sort.Strings(names)
+
+	results := make([]ModuleHealth, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		module := r.modules[name]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			done := make(chan error, 1)
+			go func() {
+				defer func() {
+					if recovered := recover(); recovered != nil {
+						done <- fmt.Errorf("health check panicked: %v", recovered)
+					}
+				}()
+				done <- module.Health(ctx)
+			}()
+
+			var err error
+			select {
+			case err = <-done:
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+			results[i] = ModuleHealth{Name: name, Latency: time.Since(start), Err: err}
+		}()
 	}
+	wg.Wait()
 	return results
 }
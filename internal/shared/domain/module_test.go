@@ -0,0 +1,244 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// fakeModule records its lifecycle calls in a log shared by the modules of a test
+type fakeModule struct {
+	name      string
+	dependsOn []string
+	startErr  error
+	log       *[]string
+}
+
+func (m *fakeModule) Name() string                        { return m.name }
+func (m *fakeModule) Initialize(ModuleDependencies) error { return nil }
+func (m *fakeModule) RegisterRoutes(*gin.RouterGroup)     {}
+func (m *fakeModule) Health(context.Context) error        { return nil }
+func (m *fakeModule) DependsOn() []string                 { return m.dependsOn }
+
+func (m *fakeModule) Start(context.Context) error {
+	*m.log = append(*m.log, "start "+m.name)
+	return m.startErr
+}
+
+func (m *fakeModule) Stop(context.Context) error {
+	*m.log = append(*m.log, "stop "+m.name)
+	return nil
+}
+
+// moduleSpec describes a fakeModule of a test table
+type moduleSpec struct {
+	name      string
+	dependsOn []string
+	fails     bool
+	policy    FailurePolicy
+}
+
+// newTestRegistry registers the modules of specs, logging their lifecycle calls to log
+func newTestRegistry(specs []moduleSpec, log *[]string) *ModuleRegistry {
+	registry := NewModuleRegistry()
+	for _, spec := range specs {
+		module := &fakeModule{name: spec.name, dependsOn: spec.dependsOn, log: log}
+		if spec.fails {
+			module.startErr = errors.New("boom")
+		}
+		registry.Register(module)
+		if spec.policy != "" {
+			registry.SetFailurePolicy(spec.name, spec.policy)
+		}
+	}
+	return registry
+}
+
+func TestModuleRegistryOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		modules []moduleSpec
+		// configured are dependencies added with AddDependencies, by module
+		configured map[string][]string
+		want       []string
+		err        string
+	}{
+		{
+			name:    "independent modules by name",
+			modules: []moduleSpec{{name: "c"}, {name: "a"}, {name: "b"}},
+			want:    []string{"a", "b", "c"},
+		},
+		{
+			name:    "chain",
+			modules: []moduleSpec{{name: "a", dependsOn: []string{"b"}}, {name: "b", dependsOn: []string{"c"}}, {name: "c"}},
+			want:    []string{"c", "b", "a"},
+		},
+		{
+			name: "diamond",
+			modules: []moduleSpec{
+				{name: "a", dependsOn: []string{"c", "b"}},
+				{name: "b", dependsOn: []string{"d"}},
+				{name: "c", dependsOn: []string{"d"}},
+				{name: "d"},
+			},
+			want: []string{"d", "b", "c", "a"},
+		},
+		{
+			name:       "configured dependencies",
+			modules:    []moduleSpec{{name: "a"}, {name: "b"}},
+			configured: map[string][]string{"a": {"b"}},
+			want:       []string{"b", "a"},
+		},
+		{
+			name:    "missing dependency",
+			modules: []moduleSpec{{name: "a", dependsOn: []string{"x"}}},
+			err:     "module a depends on module x, which is not enabled",
+		},
+		{
+			name:    "cycle",
+			modules: []moduleSpec{{name: "a", dependsOn: []string{"b"}}, {name: "b", dependsOn: []string{"c"}}, {name: "c", dependsOn: []string{"a"}}},
+			err:     "module dependency cycle: a -> b -> c -> a",
+		},
+		{
+			name:    "self dependency",
+			modules: []moduleSpec{{name: "a", dependsOn: []string{"a"}}},
+			err:     "module dependency cycle: a -> a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := newTestRegistry(tt.modules, new([]string))
+			for module, dependencies := range tt.configured {
+				registry.AddDependencies(module, dependencies...)
+			}
+
+			order, err := registry.Order()
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("got error %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Order returned %v", err)
+			}
+			if !slices.Equal(order, tt.want) {
+				t.Fatalf("got order %v, want %v", order, tt.want)
+			}
+		})
+	}
+}
+
+func TestModuleRegistryStartAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		modules []moduleSpec
+		// log is the lifecycle calls StartAll makes, in order
+		log []string
+		err string
+		// failed and remaining are the modules recorded as failed and those left registered
+		failed    []string
+		remaining []string
+	}{
+		{
+			name:      "dependencies first",
+			modules:   []moduleSpec{{name: "a", dependsOn: []string{"b"}}, {name: "b"}},
+			log:       []string{"start b", "start a"},
+			remaining: []string{"a", "b"},
+		},
+		{
+			name:    "abort stops the started modules in reverse",
+			modules: []moduleSpec{{name: "a"}, {name: "b"}, {name: "c", fails: true}, {name: "d"}},
+			log:     []string{"start a", "start b", "start c", "stop b", "stop a"},
+			err:     "failed to start module c: boom",
+		},
+		{
+			name:      "skip leaves the module out",
+			modules:   []moduleSpec{{name: "a"}, {name: "b", fails: true, policy: FailSkip}, {name: "c"}},
+			log:       []string{"start a", "start b", "start c"},
+			failed:    []string{"b"},
+			remaining: []string{"a", "c"},
+		},
+		{
+			name:      "degrade keeps the module registered",
+			modules:   []moduleSpec{{name: "a"}, {name: "b", fails: true, policy: FailDegrade}},
+			log:       []string{"start a", "start b"},
+			failed:    []string{"b"},
+			remaining: []string{"a", "b"},
+		},
+		{
+			name: "dependents of a skipped module fail under their own policy",
+			modules: []moduleSpec{
+				{name: "a", dependsOn: []string{"b"}, policy: FailSkip},
+				{name: "b", fails: true, policy: FailSkip},
+				{name: "c"},
+			},
+			log:       []string{"start b", "start c"},
+			failed:    []string{"a", "b"},
+			remaining: []string{"c"},
+		},
+		{
+			name: "an aborting dependent of a skipped module rolls back",
+			modules: []moduleSpec{
+				{name: "a"},
+				{name: "b", fails: true, policy: FailSkip},
+				{name: "c", dependsOn: []string{"b"}},
+			},
+			log: []string{"start a", "start b", "stop a"},
+			err: "module c depends on module b, which failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var log []string
+			registry := newTestRegistry(tt.modules, &log)
+
+			err := registry.StartAll(context.Background())
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("got error %v, want %q", err, tt.err)
+				}
+			} else if err != nil {
+				t.Fatalf("StartAll returned %v", err)
+			}
+			if !slices.Equal(log, tt.log) {
+				t.Fatalf("got lifecycle calls %v, want %v", log, tt.log)
+			}
+			if tt.err != "" {
+				return
+			}
+
+			var failed []string
+			for _, failure := range registry.Failures() {
+				failed = append(failed, failure.Module)
+			}
+			slices.Sort(failed)
+			if !slices.Equal(failed, tt.failed) {
+				t.Fatalf("got failed modules %v, want %v", failed, tt.failed)
+			}
+			if remaining := registry.GetModuleNames(); !slices.Equal(remaining, tt.remaining) {
+				t.Fatalf("got registered modules %v, want %v", remaining, tt.remaining)
+			}
+		})
+	}
+}
+
+func TestModuleRegistryDegradedModuleReportsFailure(t *testing.T) {
+	var log []string
+	registry := newTestRegistry([]moduleSpec{{name: "a", fails: true, policy: FailDegrade}}, &log)
+	if err := registry.StartAll(context.Background()); err != nil {
+		t.Fatalf("StartAll returned %v", err)
+	}
+
+	module, ok := registry.GetModule("a")
+	if !ok {
+		t.Fatal("the degraded module was unregistered")
+	}
+	if err := module.Health(context.Background()); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("got health %v, want the start failure", err)
+	}
+}
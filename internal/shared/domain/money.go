@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// currencyRegex matches ISO 4217 alphabetic currency codes
+var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// currencyExponents lists ISO 4217 currencies whose minor unit is not 1/100
+var currencyExponents = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// basisPointsPerUnit is the number of basis points in a rate of 1 (100%)
+const basisPointsPerUnit = 10000
+
+// NormalizeCurrency validates an ISO 4217 currency code and returns it upper-cased
+func NormalizeCurrency(code string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(code))
+	if !currencyRegex.MatchString(normalized) {
+		return "", NewValidationErrorWithValue("currency", "currency must be an ISO 4217 code", code)
+	}
+	return normalized, nil
+}
+
+// CurrencyExponent returns the number of decimal digits of the currency's minor unit
+func CurrencyExponent(currency string) int {
+	if exponent, ok := currencyExponents[currency]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// RoundingMode determines how fractional minor units are rounded
+type RoundingMode string
+
+const (
+	// RoundHalfUp rounds halves away from zero (commercial rounding)
+	RoundHalfUp RoundingMode = "half_up"
+	// RoundHalfEven rounds halves to the nearest even minor unit (banker's rounding)
+	RoundHalfEven RoundingMode = "half_even"
+	// RoundDown truncates towards zero
+	RoundDown RoundingMode = "down"
+)
+
+// IsValid checks if the rounding mode is known
+func (m RoundingMode) IsValid() bool {
+	switch m {
+	case RoundHalfUp, RoundHalfEven, RoundDown:
+		return true
+	}
+	return false
+}
+
+// Money represents an amount of money value object
+// Amount is an integer in the currency's minor unit (e.g. cents), never a float
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// NewMoney creates a new money value object
+func NewMoney(amount int64, currency string) (Money, error) {
+	normalized, err := NormalizeCurrency(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, Currency: normalized}, nil
+}
+
+// ZeroMoney returns a zero amount in the given currency
+func ZeroMoney(currency string) Money {
+	return Money{Currency: currency}
+}
+
+// Add returns the sum of two amounts in the same currency
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	if (other.Amount > 0 && m.Amount > math.MaxInt64-other.Amount) ||
+		(other.Amount < 0 && m.Amount < math.MinInt64-other.Amount) {
+		return Money{}, m.overflowError()
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Subtract returns the difference of two amounts in the same currency
+func (m Money) Subtract(other Money) (Money, error) {
+	if other.Amount == math.MinInt64 {
+		return Money{}, m.overflowError()
+	}
+	return m.Add(Money{Amount: -other.Amount, Currency: other.Currency})
+}
+
+// Multiply returns the amount multiplied by an integer quantity
+func (m Money) Multiply(quantity int64) (Money, error) {
+	product := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(quantity))
+	if !product.IsInt64() {
+		return Money{}, m.overflowError()
+	}
+	return Money{Amount: product.Int64(), Currency: m.Currency}, nil
+}
+
+// MultiplyBasisPoints returns the amount multiplied by a rate expressed in basis points
+// (1 bp = 0.01%), rounded to the currency's minor unit using the given rounding mode
+func (m Money) MultiplyBasisPoints(basisPoints int64, mode RoundingMode) (Money, error) {
+	numerator := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(basisPoints))
+	quotient, remainder := new(big.Int).QuoRem(numerator, big.NewInt(basisPointsPerUnit), new(big.Int))
+
+	if remainder.Sign() != 0 {
+		// Compare twice the remainder with the divisor to detect halves exactly
+		twice := new(big.Int).Abs(remainder)
+		twice.Lsh(twice, 1)
+		cmp := twice.Cmp(big.NewInt(basisPointsPerUnit))
+
+		roundAway := false
+		switch mode {
+		case RoundHalfUp:
+			roundAway = cmp >= 0
+		case RoundHalfEven:
+			roundAway = cmp > 0 || (cmp == 0 && quotient.Bit(0) == 1)
+		case RoundDown:
+			roundAway = false
+		default:
+			return Money{}, NewValidationErrorWithValue("rounding", "unknown rounding mode", string(mode))
+		}
+
+		if roundAway {
+			quotient.Add(quotient, big.NewInt(int64(numerator.Sign())))
+		}
+	}
+
+	if !quotient.IsInt64() {
+		return Money{}, m.overflowError()
+	}
+	return Money{Amount: quotient.Int64(), Currency: m.Currency}, nil
+}
+
+// Prorate returns the share part/whole of the amount, rounded down to the currency's minor unit
+// Summing the shares of consecutive cumulative parts never exceeds the amount, so it suits splitting
+// a total across partial refunds
+func (m Money) Prorate(part, whole int64) (Money, error) {
+	if whole <= 0 || part < 0 || part > whole {
+		return Money{}, NewDomainError(ErrCodeInvalidInput, fmt.Sprintf("invalid proration %d/%d", part, whole))
+	}
+
+	numerator := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(part))
+	quotient := new(big.Int).Quo(numerator, big.NewInt(whole))
+	if !quotient.IsInt64() {
+		return Money{}, m.overflowError()
+	}
+	return Money{Amount: quotient.Int64(), Currency: m.Currency}, nil
+}
+
+// IsZero checks if the amount is zero
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// IsNegative checks if the amount is below zero
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Equals checks if two amounts are equal in value and currency
+func (m Money) Equals(other Money) bool {
+	return m.Amount == other.Amount && m.Currency == other.Currency
+}
+
+// String returns the amount in major units followed by the currency (e.g. "12.34 USD")
+func (m Money) String() string {
+	exponent := CurrencyExponent(m.Currency)
+	if exponent == 0 {
+		return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+	}
+
+	sign := ""
+	amount := new(big.Int).SetInt64(m.Amount)
+	if amount.Sign() < 0 {
+		sign = "-"
+		amount.Abs(amount)
+	}
+
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+	major, minor := new(big.Int).QuoRem(amount, divisor, new(big.Int))
+	return fmt.Sprintf("%s%s.%0*d %s", sign, major, exponent, minor, m.Currency)
+}
+
+// UnmarshalJSON decodes {"amount": 1234, "currency": "USD"} and validates the currency
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Amount   int64  `json:"amount"`
+		Currency string `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("invalid money value: %w", err)
+	}
+
+	money, err := NewMoney(raw.Amount, raw.Currency)
+	if err != nil {
+		return err
+	}
+
+	*m = money
+	return nil
+}
+
+// checkCurrency rejects arithmetic across currencies
+func (m Money) checkCurrency(other Money) error {
+	if m.Currency != other.Currency {
+		return NewBusinessRuleError(
+			"currency_mismatch",
+			fmt.Sprintf("cannot combine amounts in %s and %s", m.Currency, other.Currency),
+		)
+	}
+	return nil
+}
+
+// overflowError reports an amount outside the representable range
+func (m Money) overflowError() error {
+	return NewDomainError(ErrCodeInvalidInput, fmt.Sprintf("amount in %s is out of range", m.Currency))
+}
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMoneyMultiplyBasisPoints(t *testing.T) {
+	tests := []struct {
+		name        string
+		amount      int64
+		basisPoints int64
+		mode        RoundingMode
+		want        int64
+		code        string
+	}{
+		{name: "exact", amount: 1000, basisPoints: 1000, mode: RoundHalfUp, want: 100},
+		{name: "half up rounds half away from zero", amount: 1005, basisPoints: 1000, mode: RoundHalfUp, want: 101},
+		{name: "half even rounds half to even below", amount: 1005, basisPoints: 1000, mode: RoundHalfEven, want: 100},
+		{name: "half even rounds half to even above", amount: 1015, basisPoints: 1000, mode: RoundHalfEven, want: 102},
+		{name: "half even rounds above half up", amount: 1006, basisPoints: 1000, mode: RoundHalfEven, want: 101},
+		{name: "half up rounds below half down", amount: 1004, basisPoints: 1000, mode: RoundHalfUp, want: 100},
+		{name: "down truncates", amount: 1009, basisPoints: 1000, mode: RoundDown, want: 100},
+		{name: "negative half up", amount: -1005, basisPoints: 1000, mode: RoundHalfUp, want: -101},
+		{name: "negative half even below", amount: -1005, basisPoints: 1000, mode: RoundHalfEven, want: -100},
+		{name: "negative half even above", amount: -1015, basisPoints: 1000, mode: RoundHalfEven, want: -102},
+		{name: "negative down truncates towards zero", amount: -1009, basisPoints: 1000, mode: RoundDown, want: -100},
+		{name: "negative rate", amount: 1005, basisPoints: -1000, mode: RoundHalfUp, want: -101},
+		{name: "sub-unit result", amount: 1, basisPoints: 5000, mode: RoundHalfUp, want: 1},
+		{name: "full rate of the largest amount", amount: math.MaxInt64, basisPoints: basisPointsPerUnit, mode: RoundHalfUp, want: math.MaxInt64},
+		{name: "full rate of the smallest amount", amount: math.MinInt64, basisPoints: basisPointsPerUnit, mode: RoundHalfUp, want: math.MinInt64},
+		{name: "overflow", amount: math.MaxInt64, basisPoints: 2 * basisPointsPerUnit, mode: RoundHalfUp, code: ErrCodeInvalidInput},
+		{name: "negative overflow", amount: math.MinInt64, basisPoints: 2 * basisPointsPerUnit, mode: RoundDown, code: ErrCodeInvalidInput},
+		{name: "unknown mode", amount: 1005, basisPoints: 1000, mode: "ceiling", code: ErrCodeValidationFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Money{Amount: tt.amount, Currency: "USD"}.MultiplyBasisPoints(tt.basisPoints, tt.mode)
+			if tt.code != "" {
+				assertErrorCode(t, err, tt.code)
+				return
+			}
+			if err != nil {
+				t.Fatalf("MultiplyBasisPoints returned %v", err)
+			}
+			if got.Amount != tt.want || got.Currency != "USD" {
+				t.Fatalf("got %d %s, want %d USD", got.Amount, got.Currency, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyProrate(t *testing.T) {
+	tests := []struct {
+		name        string
+		amount      int64
+		part, whole int64
+		want        int64
+		code        string
+	}{
+		{name: "whole", amount: 1000, part: 3, whole: 3, want: 1000},
+		{name: "nothing", amount: 1000, part: 0, whole: 3, want: 0},
+		{name: "rounds down", amount: 1000, part: 2, whole: 3, want: 666},
+		{name: "negative rounds towards zero", amount: -1000, part: 2, whole: 3, want: -666},
+		{name: "intermediate product beyond int64", amount: math.MaxInt64, part: math.MaxInt64 - 1, whole: math.MaxInt64, want: math.MaxInt64 - 1},
+		{name: "zero whole", amount: 1000, part: 0, whole: 0, code: ErrCodeInvalidInput},
+		{name: "negative part", amount: 1000, part: -1, whole: 3, code: ErrCodeInvalidInput},
+		{name: "part over whole", amount: 1000, part: 4, whole: 3, code: ErrCodeInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Money{Amount: tt.amount, Currency: "USD"}.Prorate(tt.part, tt.whole)
+			if tt.code != "" {
+				assertErrorCode(t, err, tt.code)
+				return
+			}
+			if err != nil {
+				t.Fatalf("Prorate returned %v", err)
+			}
+			if got.Amount != tt.want {
+				t.Fatalf("got %d, want %d", got.Amount, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyProrateDistributesRemainder(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		parts  []int64
+		want   []int64
+	}{
+		{name: "thirds", amount: 100, parts: []int64{1, 1, 1}, want: []int64{33, 33, 34}},
+		{name: "uneven parts", amount: 1000, parts: []int64{1, 2, 4}, want: []int64{142, 286, 572}},
+		{name: "negative thirds", amount: -100, parts: []int64{1, 1, 1}, want: []int64{-33, -33, -34}},
+		{name: "more parts than minor units", amount: 2, parts: []int64{1, 1, 1}, want: []int64{0, 1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var whole int64
+			for _, part := range tt.parts {
+				whole += part
+			}
+
+			// Each share is the proration of the cumulative parts less what earlier shares took
+			money := Money{Amount: tt.amount, Currency: "USD"}
+			var cumulative, taken, sum int64
+			for i, part := range tt.parts {
+				cumulative += part
+				prorated, err := money.Prorate(cumulative, whole)
+				if err != nil {
+					t.Fatalf("Prorate(%d, %d) returned %v", cumulative, whole, err)
+				}
+				share := prorated.Amount - taken
+				taken = prorated.Amount
+				if share != tt.want[i] {
+					t.Fatalf("share %d is %d, want %d", i, share, tt.want[i])
+				}
+				sum += share
+			}
+			if sum != tt.amount {
+				t.Fatalf("shares sum to %d, want %d", sum, tt.amount)
+			}
+		})
+	}
+}
+
+// assertErrorCode fails unless err is a DomainError, or a ValidationError for VALIDATION_FAILED, with code
+func assertErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+
+	var validationErr ValidationError
+	if code == ErrCodeValidationFailed && errors.As(err, &validationErr) {
+		return
+	}
+	var domainErr DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != code {
+		t.Fatalf("got error %v, want %s", err, code)
+	}
+}
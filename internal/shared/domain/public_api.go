@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"fmt"
+	"sync"
+)
+
+// PublicAPIRegistry holds the public APIs modules expose to each other
+// A module registers its API under its own name during initialization; other modules
+// look it up by that name instead of importing the module's repositories
+type PublicAPIRegistry struct {
+	mu   sync.RWMutex
+	apis map[string]interface{}
+}
+
+// NewPublicAPIRegistry creates a new public API registry
+func NewPublicAPIRegistry() *PublicAPIRegistry {
+	return &PublicAPIRegistry{
+		apis: make(map[string]interface{}),
+	}
+}
+
+// Register makes a module's public API available to other modules
+func (r *PublicAPIRegistry) Register(module string, api interface{}) error {
+	if module == "" {
+		return fmt.Errorf("module name is required")
+	}
+	if api == nil {
+		return fmt.Errorf("public API of module %s is nil", module)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.apis[module]; exists {
+		return fmt.Errorf("public API of module %s is already registered", module)
+	}
+	r.apis[module] = api
+	return nil
+}
+
+// Unregister removes a module's public API
+func (r *PublicAPIRegistry) Unregister(module string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.apis, module)
+}
+
+// Get returns the public API registered by a module
+func (r *PublicAPIRegistry) Get(module string) (interface{}, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	api, exists := r.apis[module]
+	return api, exists
+}
+
+// GetModuleNames returns the names of modules that registered a public API
+func (r *PublicAPIRegistry) GetModuleNames() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.apis))
+	for name := range r.apis {
+		names = append(names, name)
+	}
+	return names
+}
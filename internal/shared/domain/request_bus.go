@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrNoRequestHandler is returned when no module handles the endpoint a request is sent to,
+// e.g. because the module is disabled
+var ErrNoRequestHandler = errors.New("no handler for request endpoint")
+
+// RequestHandler answers a request sent to an endpoint of the request bus
+type RequestHandler func(ctx context.Context, request any) (any, error)
+
+// RequestMiddleware wraps the handler of an endpoint, e.g. to record metrics or break circuits
+type RequestMiddleware func(endpoint string, next RequestHandler) RequestHandler
+
+// RequestBus carries queries between modules in the process: a module handles the endpoints of its
+// public API, named "<module>.<query>" (e.g. "customer.GetSummary"), and other modules send requests
+// to them by name, so that an endpoint can later be served by another process without its callers
+// changing
+type RequestBus struct {
+	mu         sync.RWMutex
+	handlers   map[string]RequestHandler
+	middleware []RequestMiddleware
+}
+
+// NewRequestBus creates a request bus without handlers
+func NewRequestBus() *RequestBus {
+	return &RequestBus{handlers: make(map[string]RequestHandler)}
+}
+
+// Use adds middleware to the requests sent from now on; the first middleware added is the outermost
+func (b *RequestBus) Use(middleware ...RequestMiddleware) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.middleware = append(b.middleware, middleware...)
+}
+
+// Handle registers the handler of an endpoint
+func (b *RequestBus) Handle(endpoint string, handler RequestHandler) error {
+	if _, _, ok := strings.Cut(endpoint, "."); !ok {
+		return fmt.Errorf("request endpoint %q must be named <module>.<query>", endpoint)
+	}
+	if handler == nil {
+		return fmt.Errorf("handler of request endpoint %s is nil", endpoint)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, exists := b.handlers[endpoint]; exists {
+		return fmt.Errorf("request endpoint %s is already handled", endpoint)
+	}
+	b.handlers[endpoint] = handler
+	return nil
+}
+
+// UnhandleModule removes the handlers of a module's endpoints
+func (b *RequestBus) UnhandleModule(module string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for endpoint := range b.handlers {
+		if strings.HasPrefix(endpoint, module+".") {
+			delete(b.handlers, endpoint)
+		}
+	}
+}
+
+// Endpoints returns the handled endpoints, sorted
+func (b *RequestBus) Endpoints() []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	endpoints := make([]string, 0, len(b.handlers))
+	for endpoint := range b.handlers {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
+
+// Send sends a request to an endpoint through the middleware and returns its response
+func (b *RequestBus) Send(ctx context.Context, endpoint string, request any) (any, error) {
+	b.mu.RLock()
+	handler, exists := b.handlers[endpoint]
+	middleware := b.middleware
+	b.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("%s: %w", endpoint, ErrNoRequestHandler)
+	}
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i](endpoint, handler)
+	}
+	return handler(ctx, request)
+}
+
+// Endpoint is a typed endpoint of the request bus, declared by the module handling it in its
+// publicapi package together with its request and response types
+type Endpoint[Req, Resp any] struct {
+	Name string
+}
+
+// NewEndpoint declares an endpoint named "<module>.<query>"
+func NewEndpoint[Req, Resp any](name string) Endpoint[Req, Resp] {
+	return Endpoint[Req, Resp]{Name: name}
+}
+
+// HandleRequest registers the typed handler of an endpoint
+func HandleRequest[Req, Resp any](bus *RequestBus, endpoint Endpoint[Req, Resp], handler func(ctx context.Context, request Req) (Resp, error)) error {
+	return bus.Handle(endpoint.Name, func(ctx context.Context, request any) (any, error) {
+		typed, ok := request.(Req)
+		if !ok {
+			return nil, fmt.Errorf("request endpoint %s expects %T, got %T", endpoint.Name, typed, request)
+		}
+		return handler(ctx, typed)
+	})
+}
+
+// SendRequest sends a typed request to an endpoint and returns its typed response
+func SendRequest[Req, Resp any](ctx context.Context, bus *RequestBus, endpoint Endpoint[Req, Resp], request Req) (Resp, error) {
+	var zero Resp
+	if bus == nil {
+		return zero, fmt.Errorf("%s: %w", endpoint.Name, ErrNoRequestHandler)
+	}
+
+	response, err := bus.Send(ctx, endpoint.Name, request)
+	if err != nil || response == nil {
+		return zero, err
+	}
+	typed, ok := response.(Resp)
+	if !ok {
+		return zero, fmt.Errorf("request endpoint %s answered %T, expected %T", endpoint.Name, response, zero)
+	}
+	return typed, nil
+}
+
+// IsExpectedError reports whether err is an answer of the domain, such as a resource not being
+// found, rather than a failure of the module answering; middleware does not count such errors
+// against the module's health
+func IsExpectedError(err error) bool {
+	var domainErr DomainError
+	if errors.As(err, &domainErr) {
+		return true
+	}
+	for _, expected := range []error{ErrNotFound, ErrAlreadyExists, ErrInvalidInput, ErrUnauthorized, ErrForbidden, ErrConcurrencyConflict, ErrInvalidState} {
+		if errors.Is(err, expected) {
+			return true
+		}
+	}
+	return false
+}
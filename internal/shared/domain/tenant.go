@@ -0,0 +1,37 @@
+package domain
+
+import "context"
+
+// tenantIDKey is the context key of the tenant ID
+type tenantIDKey struct{}
+
+// WithTenantID returns a copy of ctx carrying the ID of the tenant being served
+// It is set by the tenant middleware, and scopes the queries of tenant-aware repositories
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantIDKey{}, tenantID)
+}
+
+// TenantIDFromContext returns the tenant ID of ctx, or false when ctx serves no tenant, e.g. when
+// multi-tenancy is disabled or in background jobs working across tenants
+func TenantIDFromContext(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(tenantIDKey{}).(string)
+	return tenantID, ok && tenantID != ""
+}
+
+// tenantFeaturesKey is the context key of the feature flags of the tenant
+type tenantFeaturesKey struct{}
+
+// WithTenantFeatures returns a copy of ctx carrying the feature flags the tenant overrides
+func WithTenantFeatures(ctx context.Context, features map[string]bool) context.Context {
+	return context.WithValue(ctx, tenantFeaturesKey{}, features)
+}
+
+// TenantFeatureEnabled reports whether the tenant of ctx has the named feature, or fallback when
+// the tenant does not override it
+func TenantFeatureEnabled(ctx context.Context, name string, fallback bool) bool {
+	features, _ := ctx.Value(tenantFeaturesKey{}).(map[string]bool)
+	if enabled, ok := features[name]; ok {
+		return enabled
+	}
+	return fallback
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "context"
+
+// TracePropagator carries the trace of a context on events, as W3C trace context headers
+// It is set by the tracing infrastructure at startup; without one, events carry no trace context
+type TracePropagator interface {
+	// Inject returns the trace context of ctx, or nil when ctx is not traced
+	Inject(ctx context.Context) map[string]string
+
+	// Extract returns a copy of ctx continuing the trace of carrier
+	Extract(ctx context.Context, carrier map[string]string) context.Context
+}
+
+// tracePropagator is the propagator used by StampActor and WithEventActor
+var tracePropagator TracePropagator
+
+// SetTracePropagator sets the propagator stamping trace contexts onto events
+// It must be called before events are published
+func SetTracePropagator(propagator TracePropagator) {
+	tracePropagator = propagator
+}
+
+// traceContextOf returns the trace context of ctx, or nil when no propagator is set
+func traceContextOf(ctx context.Context) map[string]string {
+	if tracePropagator == nil {
+		return nil
+	}
+	return tracePropagator.Inject(ctx)
+}
+
+// withTraceContext returns a copy of ctx continuing the trace of carrier
+func withTraceContext(ctx context.Context, carrier map[string]string) context.Context {
+	if tracePropagator == nil || len(carrier) == 0 {
+		return ctx
+	}
+	return tracePropagator.Extract(ctx, carrier)
+}
@@ -0,0 +1,45 @@
+package apiversion
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Deprecation headers
+const (
+	HeaderDeprecation = "Deprecation"
+	HeaderSunset      = "Sunset"
+	HeaderLink        = "Link"
+)
+
+// Middleware announces the deprecation of a version on every response of its routes
+// Deprecation holds the date the version was deprecated as "@<unix seconds>" (RFC 9745), or "true"
+// when no date is configured; Sunset holds the date it stops being served (RFC 8594)
+// It is only mounted on deprecated versions
+func Middleware(version Version) gin.HandlerFunc {
+	deprecation := "true"
+	if !version.DeprecatedAt.IsZero() {
+		deprecation = "@" + strconv.FormatInt(version.DeprecatedAt.Unix(), 10)
+	}
+	var sunset string
+	if !version.Sunset.IsZero() {
+		sunset = version.Sunset.UTC().Format(http.TimeFormat)
+	}
+	var link string
+	if version.Link != "" {
+		link = "<" + version.Link + `>; rel="deprecation"; type="text/html"`
+	}
+
+	return func(c *gin.Context) {
+		c.Header(HeaderDeprecation, deprecation)
+		if sunset != "" {
+			c.Header(HeaderSunset, sunset)
+		}
+		if link != "" {
+			c.Writer.Header().Add(HeaderLink, link)
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,114 @@
+package apiversion
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+)
+
+// Version is an API version a module is served under
+type Version struct {
+	// Name identifies the version, e.g. "v2"
+	Name string
+	// Prefix is the path the module's routes are mounted under for the version, e.g. "/api/v2"
+	Prefix string
+	// Handlers names the route set of the module served under the version
+	Handlers     string
+	Deprecated   bool
+	DeprecatedAt time.Time
+	Sunset       time.Time
+	Link         string
+}
+
+// FromConfig returns the API versions of a module's HTTP configuration, sorted by prefix
+// Without http.versions the module is served under http.prefix alone, as the version named after
+// the prefix's last segment (e.g. "v1" for "/api/v1")
+func FromConfig(httpConfig config.HTTPConfig) ([]Version, error) {
+	if len(httpConfig.Versions) == 0 {
+		prefix := httpConfig.GetPrefix()
+		if !strings.HasPrefix(prefix, "/") {
+			return nil, fmt.Errorf("http.prefix must start with \"/\", got %q", prefix)
+		}
+		name := path.Base(prefix)
+		return []Version{{Name: name, Prefix: prefix, Handlers: name}}, nil
+	}
+
+	names := make([]string, 0, len(httpConfig.Versions))
+	for name := range httpConfig.Versions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	versions := make([]Version, 0, len(names))
+	prefixes := make(map[string]string, len(names))
+	for _, name := range names {
+		version, err := parseVersion(name, httpConfig.Versions[name])
+		if err != nil {
+			return nil, err
+		}
+		if other, taken := prefixes[version.Prefix]; taken {
+			return nil, fmt.Errorf("http.versions %s and %s have the same prefix %q", other, name, version.Prefix)
+		}
+		prefixes[version.Prefix] = name
+		versions = append(versions, version)
+	}
+
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Prefix < versions[j].Prefix })
+	return versions, nil
+}
+
+// parseVersion validates the configuration of a version
+func parseVersion(name string, versionConfig config.HTTPVersionConfig) (Version, error) {
+	version := Version{
+		Name:       name,
+		Prefix:     strings.TrimSuffix(strings.TrimSpace(versionConfig.Prefix), "/"),
+		Handlers:   versionConfig.Handlers,
+		Deprecated: versionConfig.Deprecated,
+		Link:       versionConfig.Link,
+	}
+	if version.Prefix == "" {
+		version.Prefix = "/api/" + name
+	}
+	if !strings.HasPrefix(version.Prefix, "/") {
+		return Version{}, fmt.Errorf("http.versions.%s.prefix must start with \"/\", got %q", name, version.Prefix)
+	}
+	if version.Handlers == "" {
+		version.Handlers = name
+	}
+
+	var err error
+	if version.DeprecatedAt, err = parseDate(versionConfig.DeprecatedAt); err != nil {
+		return Version{}, fmt.Errorf("invalid http.versions.%s.deprecated_at: %w", name, err)
+	}
+	if version.Sunset, err = parseDate(versionConfig.Sunset); err != nil {
+		return Version{}, fmt.Errorf("invalid http.versions.%s.sunset: %w", name, err)
+	}
+	if !version.DeprecatedAt.IsZero() || !version.Sunset.IsZero() {
+		version.Deprecated = true
+	}
+	if !version.DeprecatedAt.IsZero() && !version.Sunset.IsZero() && version.Sunset.Before(version.DeprecatedAt) {
+		return Version{}, fmt.Errorf("http.versions.%s.sunset is before its deprecated_at", name)
+	}
+
+	return version, nil
+}
+
+// parseDate parses a "2006-01-02" or RFC 3339 date; an empty value is the zero time
+func parseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		return date, nil
+	}
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither a YYYY-MM-DD nor an RFC 3339 date", value)
+	}
+	return date, nil
+}
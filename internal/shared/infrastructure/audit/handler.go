@@ -0,0 +1,101 @@
+package audit
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Path is where the audit log is served
+const Path = "/admin/audit"
+
+// Permission is required to read the audit log
+const Permission = "audit:read"
+
+// Handler serves a page of the audit log, newest first
+// Filters: ?module=, ?action=, ?actor=, ?aggregate_type=, ?aggregate_id=, ?request_id=, and ?since=
+// (inclusive) / ?until= (exclusive) as RFC 3339 timestamps; ?page= and ?limit= page through the entries
+func Handler(store Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filter := Filter{
+			Page:          intQuery(c, "page", 1),
+			Limit:         intQuery(c, "limit", 50),
+			Module:        c.Query("module"),
+			Action:        c.Query("action"),
+			Actor:         c.Query("actor"),
+			AggregateType: c.Query("aggregate_type"),
+			AggregateID:   c.Query("aggregate_id"),
+			RequestID:     c.Query("request_id"),
+		}
+
+		var err error
+		if filter.Since, err = timestampQuery(c, "since"); err != nil {
+			handleError(c, err)
+			return
+		}
+		if filter.Until, err = timestampQuery(c, "until"); err != nil {
+			handleError(c, err)
+			return
+		}
+
+		page, err := store.Query(c.Request.Context(), filter)
+		if err != nil {
+			handleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success":    true,
+			"data":       page.Entries,
+			"pagination": page.Pagination,
+		})
+	}
+}
+
+// handleError answers 400 for invalid filters and 500 otherwise
+func handleError(c *gin.Context, err error) {
+	var validationErr domain.ValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   gin.H{"code": "VALIDATION_ERROR", "message": err.Error()},
+		})
+		return
+	}
+
+	zap.L().Error("failed to query audit log", zap.Error(err))
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error":   gin.H{"code": "INTERNAL_ERROR", "message": "failed to query audit log"},
+	})
+}
+
+// intQuery gets an integer query parameter with default value
+func intQuery(c *gin.Context, key string, defaultValue int) int {
+	if str := c.Query(key); str != "" {
+		if val, err := strconv.Atoi(str); err == nil {
+			return val
+		}
+	}
+	return defaultValue
+}
+
+// timestampQuery parses an optional RFC 3339 timestamp query parameter
+func timestampQuery(c *gin.Context, key string) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil, domain.NewValidationErrorWithValue(key, key+" must be an RFC 3339 timestamp", value)
+	}
+	return &t, nil
+}
@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/application"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// AuditedCommand is implemented by commands that name the aggregate they change
+type AuditedCommand interface {
+	application.Command
+
+	// AuditAggregate returns the type and ID of the aggregate the command changes
+	AuditAggregate() (aggregateType, aggregateID string)
+}
+
+// CommandMiddleware records every command executed on a MiddlewareCommandBus of a module, with
+// its outcome; failures to record are logged, never returned
+func CommandMiddleware(writer domain.AuditWriter, module string) application.CommandMiddleware {
+	return application.CommandMiddlewareFunc(func(ctx context.Context, cmd application.Command, next func(context.Context, application.Command) error) error {
+		err := next(ctx, cmd)
+
+		entry := domain.NewAuditEntry(ctx, cmd.CommandName())
+		entry.Module = module
+		if audited, ok := cmd.(AuditedCommand); ok {
+			entry.AggregateType, entry.AggregateID = audited.AuditAggregate()
+		}
+		if err != nil {
+			entry.Outcome = domain.AuditFailure
+			entry.Detail = err.Error()
+		}
+		if writeErr := writer.Write(ctx, entry); writeErr != nil {
+			zap.L().Warn("failed to record audit entry",
+				zap.String("module", module), zap.String("action", entry.Action), zap.Error(writeErr))
+		}
+		return err
+	})
+}
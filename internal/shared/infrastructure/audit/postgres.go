@@ -0,0 +1,180 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// schema creates the audit log table; the log belongs to no module, so it is not created by the
+// migrations of the database it is kept in
+const schema = `
+CREATE TABLE IF NOT EXISTS audit_log (
+    id VARCHAR(36) PRIMARY KEY,
+    module VARCHAR(50) NOT NULL,
+    action VARCHAR(100) NOT NULL,
+    aggregate_type VARCHAR(50) NOT NULL DEFAULT '',
+    aggregate_id VARCHAR(100) NOT NULL DEFAULT '',
+    actor VARCHAR(100) NOT NULL DEFAULT '',
+    request_id VARCHAR(128) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    outcome VARCHAR(10) NOT NULL,
+    detail VARCHAR(512) NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_audit_log_occurred_at ON audit_log (occurred_at);
+CREATE INDEX IF NOT EXISTS idx_audit_log_actor ON audit_log (actor, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_audit_log_aggregate ON audit_log (aggregate_type, aggregate_id, occurred_at);
+`
+
+// entryModel represents the audit entry database model
+type entryModel struct {
+	ID            string `gorm:"primaryKey"`
+	Module        string
+	Action        string
+	AggregateType string
+	AggregateID   string
+	Actor         string
+	RequestID     string
+	IPAddress     string
+	Outcome       string
+	Detail        string
+	OccurredAt    time.Time
+}
+
+// TableName returns the table name for GORM
+func (entryModel) TableName() string {
+	return "audit_log"
+}
+
+// toEntry converts the model to an entry
+func (m *entryModel) toEntry() domain.AuditEntry {
+	return domain.AuditEntry{
+		ID:            m.ID,
+		Module:        m.Module,
+		Action:        m.Action,
+		AggregateType: m.AggregateType,
+		AggregateID:   m.AggregateID,
+		Actor:         m.Actor,
+		RequestID:     m.RequestID,
+		IPAddress:     m.IPAddress,
+		Outcome:       m.Outcome,
+		Detail:        m.Detail,
+		OccurredAt:    m.OccurredAt,
+	}
+}
+
+// fromEntry converts an entry to the model
+// Values longer than their columns are truncated; details may quote client input
+func (m *entryModel) fromEntry(entry domain.AuditEntry) {
+	m.ID = entry.ID
+	m.Module = truncate(entry.Module, 50)
+	m.Action = truncate(entry.Action, 100)
+	m.AggregateType = truncate(entry.AggregateType, 50)
+	m.AggregateID = truncate(entry.AggregateID, 100)
+	m.Actor = truncate(entry.Actor, 100)
+	m.RequestID = truncate(entry.RequestID, 128)
+	m.IPAddress = truncate(entry.IPAddress, 45)
+	m.Outcome = entry.Outcome
+	m.Detail = truncate(entry.Detail, 512)
+	m.OccurredAt = entry.OccurredAt
+}
+
+// PostgresStore keeps the audit log in the audit_log table of a PostgreSQL database
+type PostgresStore struct {
+	db *gorm.DB
+}
+
+// NewPostgresStore creates the store, creating its table when it does not exist
+func NewPostgresStore(ctx context.Context, db *gorm.DB) (*PostgresStore, error) {
+	if err := db.WithContext(ctx).Exec(schema).Error; err != nil {
+		return nil, fmt.Errorf("failed to create audit log table: %w", err)
+	}
+	return &PostgresStore{db: db}, nil
+}
+
+// Write records an entry
+func (s *PostgresStore) Write(ctx context.Context, entry domain.AuditEntry) error {
+	model := &entryModel{}
+	model.fromEntry(entry)
+
+	if err := s.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to record audit entry: %w", err)
+	}
+	return nil
+}
+
+// Query returns a page of the entries matching the filter, newest first
+func (s *PostgresStore) Query(ctx context.Context, filter Filter) (*Page, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := s.db.WithContext(ctx).Model(&entryModel{})
+
+	// Apply filters
+	for column, value := range map[string]string{
+		"module":         filter.Module,
+		"action":         filter.Action,
+		"actor":          filter.Actor,
+		"aggregate_type": filter.AggregateType,
+		"aggregate_id":   filter.AggregateID,
+		"request_id":     filter.RequestID,
+	} {
+		if value != "" {
+			query = query.Where(column+" = ?", value)
+		}
+	}
+	if filter.Since != nil {
+		query = query.Where("occurred_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		query = query.Where("occurred_at < ?", *filter.Until)
+	}
+
+	// Count total records
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count audit entries: %w", err)
+	}
+
+	var models []entryModel
+	if err := query.Order("occurred_at DESC, id").Offset(filter.GetOffset()).Limit(filter.Limit).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to query audit entries: %w", err)
+	}
+
+	entries := make([]domain.AuditEntry, len(models))
+	for i := range models {
+		entries[i] = models[i].toEntry()
+	}
+
+	return &Page{
+		Entries:    entries,
+		Pagination: newPagination(filter.Page, filter.Limit, total),
+	}, nil
+}
+
+// Purge deletes the entries that occurred before the time
+func (s *PostgresStore) Purge(ctx context.Context, before time.Time) (int64, error) {
+	result := s.db.WithContext(ctx).Where("occurred_at < ?", before).Delete(&entryModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to purge audit entries: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// truncate shortens s to at most limit bytes without splitting a UTF-8 character
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit]
+}
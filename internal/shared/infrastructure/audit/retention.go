@@ -0,0 +1,43 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/infrastructure/lock"
+	"golang_modular_monolith/internal/shared/infrastructure/worker"
+)
+
+// PurgeInterval is how often entries older than the retention period are purged
+const PurgeInterval = time.Hour
+
+// StartRetention starts a worker purging the entries older than retention every PurgeInterval,
+// the first time right away
+// Each purge runs under the lock "audit_retention" and is skipped while another instance holds it
+func StartRetention(workers *worker.Manager, locker *lock.Locker, store Store, retention time.Duration) error {
+	return workers.Go("audit_retention", func(ctx context.Context) error {
+		ticker := time.NewTicker(PurgeInterval)
+		defer ticker.Stop()
+
+		for {
+			_, err := locker.TryWithLock(ctx, "audit_retention", func(ctx context.Context) error {
+				purged, err := store.Purge(ctx, time.Now().Add(-retention))
+				if purged > 0 {
+					zap.L().Info("audit entries purged", zap.Int64("entries", purged), zap.Duration("retention", retention))
+				}
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+}
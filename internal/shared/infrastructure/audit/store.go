@@ -0,0 +1,176 @@
+// Package audit keeps the audit log of the application: who did what to which aggregate, recorded
+// by the command audit middleware, the security events of the user module and the modules
+// themselves through ModuleDependencies.Audit, queried by administrators and purged after its
+// retention period.
+package audit
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Store records and queries audit entries
+type Store interface {
+	domain.AuditWriter
+
+	// Query returns a page of the entries matching the filter, newest first
+	Query(ctx context.Context, filter Filter) (*Page, error)
+	// Purge deletes the entries that occurred before the time, returning how many were deleted
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Filter selects a page of audit entries; empty fields match every entry
+type Filter struct {
+	// Pagination
+	Page  int
+	Limit int
+
+	// Filtering
+	Module        string
+	Action        string
+	Actor         string
+	AggregateType string
+	AggregateID   string
+	RequestID     string
+
+	// Date filtering (Since inclusive, Until exclusive)
+	Since *time.Time
+	Until *time.Time
+}
+
+// Validate validates the filter, defaulting the pagination
+func (f *Filter) Validate() error {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+
+	if f.Limit <= 0 {
+		f.Limit = 50
+	}
+
+	// Maximum limit
+	if f.Limit > 100 {
+		f.Limit = 100
+	}
+
+	if f.Since != nil && f.Until != nil && !f.Since.Before(*f.Until) {
+		return domain.NewValidationError("since", "since must be earlier than until")
+	}
+
+	return nil
+}
+
+// GetOffset calculates the offset for pagination
+func (f *Filter) GetOffset() int {
+	return (f.Page - 1) * f.Limit
+}
+
+// matches reports whether an entry matches the filter
+func (f *Filter) matches(entry domain.AuditEntry) bool {
+	return (f.Module == "" || entry.Module == f.Module) &&
+		(f.Action == "" || entry.Action == f.Action) &&
+		(f.Actor == "" || entry.Actor == f.Actor) &&
+		(f.AggregateType == "" || entry.AggregateType == f.AggregateType) &&
+		(f.AggregateID == "" || entry.AggregateID == f.AggregateID) &&
+		(f.RequestID == "" || entry.RequestID == f.RequestID) &&
+		(f.Since == nil || !entry.OccurredAt.Before(*f.Since)) &&
+		(f.Until == nil || entry.OccurredAt.Before(*f.Until))
+}
+
+// Page is a page of audit entries
+type Page struct {
+	Entries    []domain.AuditEntry `json:"entries"`
+	Pagination Pagination          `json:"pagination"`
+}
+
+// Pagination represents pagination information
+type Pagination struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"total_pages"`
+	HasNext    bool  `json:"has_next"`
+	HasPrev    bool  `json:"has_prev"`
+}
+
+// newPagination creates the pagination of a page
+func newPagination(page, limit int, total int64) Pagination {
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return Pagination{
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+}
+
+// MemoryStore keeps the audit log in memory, for development and when no database is configured
+// for it; entries are lost on restart
+type MemoryStore struct {
+	mu      sync.RWMutex
+	entries []domain.AuditEntry
+}
+
+// NewMemoryStore creates an empty in-memory audit log
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Write records an entry
+func (s *MemoryStore) Write(_ context.Context, entry domain.AuditEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+// Query returns a page of the entries matching the filter, newest first
+func (s *MemoryStore) Query(_ context.Context, filter Filter) (*Page, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	var matched []domain.AuditEntry
+	for _, entry := range s.entries {
+		if filter.matches(entry) {
+			matched = append(matched, entry)
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].OccurredAt.After(matched[j].OccurredAt) })
+	start := min(filter.GetOffset(), len(matched))
+	end := min(start+filter.Limit, len(matched))
+	return &Page{
+		Entries:    append([]domain.AuditEntry{}, matched[start:end]...),
+		Pagination: newPagination(filter.Page, filter.Limit, int64(len(matched))),
+	}, nil
+}
+
+// Purge deletes the entries that occurred before the time
+func (s *MemoryStore) Purge(_ context.Context, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.entries[:0]
+	for _, entry := range s.entries {
+		if !entry.OccurredAt.Before(before) {
+			kept = append(kept, entry)
+		}
+	}
+	purged := int64(len(s.entries) - len(kept))
+	clear(s.entries[len(kept):])
+	s.entries = kept
+	return purged, nil
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// APIKeyHeader is the header machine clients send their API key in
+const APIKeyHeader = "X-API-Key"
+
+// ErrInvalidAPIKey is returned for unknown, revoked and expired API keys
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// APIKeyVerifier resolves API keys to principals
+type APIKeyVerifier interface {
+	// VerifyAPIKey returns the principal of an active API key, or ErrInvalidAPIKey
+	VerifyAPIKey(ctx context.Context, key string) (*Principal, error)
+}
+
+var (
+	apiKeysMu sync.RWMutex
+	apiKeys   APIKeyVerifier
+)
+
+// SetAPIKeyVerifier installs the verifier used for X-API-Key headers
+// Called by the module that stores API keys; until then API keys are rejected
+func SetAPIKeyVerifier(verifier APIKeyVerifier) {
+	apiKeysMu.Lock()
+	defer apiKeysMu.Unlock()
+	apiKeys = verifier
+}
+
+// getAPIKeyVerifier returns the installed verifier, or nil
+func getAPIKeyVerifier() APIKeyVerifier {
+	apiKeysMu.RLock()
+	defer apiKeysMu.RUnlock()
+	return apiKeys
+}
+
+// APIKeyMiddleware requires a valid X-API-Key header
+// Use Middleware for routes that also accept user access tokens
+func APIKeyMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(APIKeyHeader)
+		if key == "" {
+			abortUnauthorized(c, "missing API key")
+			return
+		}
+
+		if authenticateAPIKey(c, key) {
+			c.Next()
+		}
+	}
+}
+
+// authenticateAPIKey verifies an API key and stores its principal, or aborts the request
+func authenticateAPIKey(c *gin.Context, key string) bool {
+	verifier := getAPIKeyVerifier()
+	if verifier == nil {
+		abortUnauthorized(c, "API keys are not accepted")
+		return false
+	}
+
+	principal, err := verifier.VerifyAPIKey(c.Request.Context(), key)
+	if err != nil {
+		if errors.Is(err, ErrInvalidAPIKey) {
+			abortUnauthorized(c, "invalid API key")
+			return false
+		}
+		zap.L().Warn("failed to verify API key", zap.Error(err))
+		abortInternalError(c)
+		return false
+	}
+
+	setPrincipal(c, principal)
+	return true
+}
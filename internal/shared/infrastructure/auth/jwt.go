@@ -0,0 +1,309 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+)
+
+// MinSigningKeyLength is the minimum length of the HMAC-SHA256 signing key in bytes
+const MinSigningKeyLength = 32
+
+// clockSkew is the tolerance applied to exp and nbf checks
+const clockSkew = 30 * time.Second
+
+var (
+	// ErrInvalidToken is returned for malformed tokens, bad signatures and unexpected claims
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrTokenExpired is returned for tokens past their expiry
+	ErrTokenExpired = errors.New("token expired")
+)
+
+// TokenConfig holds the settings of issued access tokens
+type TokenConfig struct {
+	SigningKey []byte
+	Issuer     string
+	Audience   string // optional; checked when set
+	Expiry     time.Duration
+}
+
+// Claims are the JWT claims of an access token
+// Tokens of service accounts carry client_id, equal to the subject, and their space-separated scopes;
+// impersonation tokens carry the impersonating user as the act claim (RFC 8693), and tokens issued in
+// a tenant carry its ID as the tenant claim
+type Claims struct {
+	Issuer    string      `json:"iss"`
+	Subject   string      `json:"sub"`
+	Audience  string      `json:"aud,omitempty"`
+	IssuedAt  int64       `json:"iat"`
+	NotBefore int64       `json:"nbf"`
+	ExpiresAt int64       `json:"exp"`
+	ID        string      `json:"jti"`
+	Email     string      `json:"email,omitempty"`
+	ClientID  string      `json:"client_id,omitempty"`
+	Scope     string      `json:"scope,omitempty"`
+	Actor     *ActorClaim `json:"act,omitempty"`
+	Tenant    string      `json:"tenant,omitempty"`
+}
+
+// ActorClaim identifies the party acting on behalf of the subject
+type ActorClaim struct {
+	Subject string `json:"sub"`
+}
+
+// header is the fixed JOSE header of issued tokens
+type header struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ"`
+}
+
+// encodedHeader is the base64url encoding of the HS256 JOSE header
+var encodedHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// TokenService issues and verifies HS256-signed JWT access tokens
+type TokenService struct {
+	config TokenConfig
+	now    func() time.Time
+}
+
+// NewTokenService creates a new token service
+func NewTokenService(config TokenConfig) (*TokenService, error) {
+	if len(config.SigningKey) < MinSigningKeyLength {
+		return nil, fmt.Errorf("signing key must be at least %d bytes", MinSigningKeyLength)
+	}
+	if config.Issuer == "" {
+		return nil, fmt.Errorf("issuer is required")
+	}
+	if config.Expiry <= 0 {
+		return nil, fmt.Errorf("expiry must be positive")
+	}
+
+	return &TokenService{
+		config: config,
+		now:    time.Now,
+	}, nil
+}
+
+// Expiry returns the lifetime of issued tokens
+func (s *TokenService) Expiry() time.Duration {
+	return s.config.Expiry
+}
+
+// Issue creates a signed access token for the principal
+func (s *TokenService) Issue(principal Principal) (string, time.Time, error) {
+	return s.IssueWithExpiry(principal, s.config.Expiry)
+}
+
+// IssueWithExpiry creates a signed access token for a user or service account principal
+// that expires after expiry instead of the configured lifetime
+func (s *TokenService) IssueWithExpiry(principal Principal, expiry time.Duration) (string, time.Time, error) {
+	if expiry <= 0 {
+		return "", time.Time{}, fmt.Errorf("expiry must be positive")
+	}
+	if principal.IsAPIKey() {
+		return "", time.Time{}, fmt.Errorf("access tokens cannot be issued for API keys")
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate token ID: %w", err)
+	}
+
+	now := s.now()
+	expiresAt := now.Add(expiry)
+	claims := Claims{
+		Issuer:    s.config.Issuer,
+		Subject:   principal.UserID,
+		Audience:  s.config.Audience,
+		IssuedAt:  now.Unix(),
+		NotBefore: now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+		ID:        hex.EncodeToString(id),
+		Email:     principal.Email,
+		Tenant:    principal.TenantID,
+	}
+	if principal.IsServiceAccount() {
+		claims.Subject = principal.ServiceAccountID
+		claims.ClientID = principal.ServiceAccountID
+		claims.Scope = strings.Join(principal.Scopes, " ")
+	} else if principal.IsImpersonated() {
+		if principal.ImpersonatorID == principal.UserID {
+			return "", time.Time{}, fmt.Errorf("users cannot impersonate themselves")
+		}
+		claims.Actor = &ActorClaim{Subject: principal.ImpersonatorID}
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to encode token claims: %w", err)
+	}
+
+	signingInput := encodedHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signingInput + "." + s.sign(signingInput), time.Unix(claims.ExpiresAt, 0).UTC(), nil
+}
+
+// Verify checks a token's signature and claims and returns its principal
+func (s *TokenService) Verify(token string) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	// Only HS256 is accepted, so "alg: none" and algorithm confusion are rejected
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var h header
+	if err := json.Unmarshal(headerJSON, &h); err != nil || h.Algorithm != "HS256" {
+		return nil, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	expected, _ := base64.RawURLEncoding.DecodeString(s.sign(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, expected) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if claims.Issuer != s.config.Issuer || claims.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+	if s.config.Audience != "" && claims.Audience != s.config.Audience {
+		return nil, ErrInvalidToken
+	}
+
+	now := s.now()
+	if claims.NotBefore != 0 && now.Add(clockSkew).Before(time.Unix(claims.NotBefore, 0)) {
+		return nil, ErrInvalidToken
+	}
+	if !now.Add(-clockSkew).Before(time.Unix(claims.ExpiresAt, 0)) {
+		return nil, ErrTokenExpired
+	}
+
+	if claims.ClientID != "" {
+		if claims.ClientID != claims.Subject || claims.Actor != nil {
+			return nil, ErrInvalidToken
+		}
+		return &Principal{
+			ServiceAccountID: claims.Subject,
+			TokenID:          claims.ID,
+			Scopes:           strings.Fields(claims.Scope),
+			TenantID:         claims.Tenant,
+		}, nil
+	}
+
+	principal := &Principal{
+		UserID:   claims.Subject,
+		Email:    claims.Email,
+		TokenID:  claims.ID,
+		TenantID: claims.Tenant,
+	}
+	if claims.Actor != nil {
+		if claims.Actor.Subject == "" || claims.Actor.Subject == claims.Subject {
+			return nil, ErrInvalidToken
+		}
+		principal.ImpersonatorID = claims.Actor.Subject
+	}
+
+	return principal, nil
+}
+
+// sign returns the base64url HMAC-SHA256 signature of the signing input
+func (s *TokenService) sign(signingInput string) string {
+	mac := hmac.New(sha256.New, s.config.SigningKey)
+	mac.Write([]byte(signingInput))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// LoadTokenConfig reads auth.jwt from the application config
+// Outside production a missing signing key is replaced by a random one, so tokens do not survive restarts
+func LoadTokenConfig(cfg *config.Config) (TokenConfig, error) {
+	jwtConfig := cfg.Auth.JWT
+
+	expiry := 15 * time.Minute
+	if jwtConfig.Expiry != "" {
+		parsed, err := time.ParseDuration(jwtConfig.Expiry)
+		if err != nil {
+			return TokenConfig{}, fmt.Errorf("invalid auth.jwt.expiry %q: %w", jwtConfig.Expiry, err)
+		}
+		expiry = parsed
+	}
+
+	signingKey := []byte(jwtConfig.SigningKey)
+	if len(signingKey) == 0 {
+		if cfg.IsProduction() {
+			return TokenConfig{}, fmt.Errorf("auth.jwt.signing_key is required in production")
+		}
+
+		signingKey = make([]byte, MinSigningKeyLength)
+		if _, err := rand.Read(signingKey); err != nil {
+			return TokenConfig{}, fmt.Errorf("failed to generate signing key: %w", err)
+		}
+		zap.L().Warn("auth.jwt.signing_key is not set; using a random key, issued tokens are invalidated on restart")
+	}
+
+	return TokenConfig{
+		SigningKey: signingKey,
+		Issuer:     jwtConfig.Issuer,
+		Audience:   jwtConfig.Audience,
+		Expiry:     expiry,
+	}, nil
+}
+
+var (
+	globalTokens *TokenService
+	tokensMu     sync.RWMutex
+)
+
+// InitializeWithConfig creates the global token service from the application config
+func InitializeWithConfig(cfg *config.Config) (*TokenService, error) {
+	tokenConfig, err := LoadTokenConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := NewTokenService(tokenConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth.jwt config: %w", err)
+	}
+
+	tokensMu.Lock()
+	defer tokensMu.Unlock()
+	globalTokens = tokens
+	return tokens, nil
+}
+
+// GetTokenService returns the global token service
+func GetTokenService() (*TokenService, error) {
+	tokensMu.RLock()
+	defer tokensMu.RUnlock()
+
+	if globalTokens == nil {
+		return nil, fmt.Errorf("token service not initialized")
+	}
+	return globalTokens, nil
+}
@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSigningKey = []byte("0123456789abcdef0123456789abcdef")
+
+// testTokens returns a token service whose clock is fixed at now
+func testTokens(t *testing.T, now time.Time) *TokenService {
+	t.Helper()
+	tokens, err := NewTokenService(TokenConfig{
+		SigningKey: testSigningKey,
+		Issuer:     "monolith",
+		Audience:   "api",
+		Expiry:     15 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("new token service: %v", err)
+	}
+	tokens.now = func() time.Time { return now }
+	return tokens
+}
+
+// forge encodes and signs a token with an arbitrary header, claims and key
+func forge(t *testing.T, header string, claims map[string]interface{}, key []byte) string {
+	t.Helper()
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("encode claims: %v", err)
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestTokenService_Verify(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	const hs256 = `{"alg":"HS256","typ":"JWT"}`
+
+	// claims returns valid user claims with the overrides applied; nil overrides remove a claim
+	claims := func(overrides map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"iss": "monolith",
+			"sub": "user-1",
+			"aud": "api",
+			"iat": now.Unix(),
+			"nbf": now.Unix(),
+			"exp": now.Add(15 * time.Minute).Unix(),
+			"jti": "token-1",
+		}
+		for name, value := range overrides {
+			if value == nil {
+				delete(c, name)
+				continue
+			}
+			c[name] = value
+		}
+		return c
+	}
+	valid := forge(t, hs256, claims(nil), testSigningKey)
+	parts := strings.Split(valid, ".")
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+		wantSub string
+	}{
+		{name: "valid", token: valid, wantSub: "user-1"},
+		{name: "alg none", token: forge(t, `{"alg":"none","typ":"JWT"}`, claims(nil), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "alg none unsigned", token: base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." + parts[1] + ".", wantErr: ErrInvalidToken},
+		{name: "alg HS512", token: forge(t, `{"alg":"HS512","typ":"JWT"}`, claims(nil), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "alg RS256", token: forge(t, `{"alg":"RS256","typ":"JWT"}`, claims(nil), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "alg missing", token: forge(t, `{"typ":"JWT"}`, claims(nil), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "signed with another key", token: forge(t, hs256, claims(nil), []byte("fedcba9876543210fedcba9876543210")), wantErr: ErrInvalidToken},
+		{name: "signature of other claims", token: parts[0] + "." + strings.Split(forge(t, hs256, claims(map[string]interface{}{"sub": "admin"}), testSigningKey), ".")[1] + "." + parts[2], wantErr: ErrInvalidToken},
+		{name: "signature missing", token: parts[0] + "." + parts[1] + ".", wantErr: ErrInvalidToken},
+		{name: "signature not base64url", token: parts[0] + "." + parts[1] + ".***", wantErr: ErrInvalidToken},
+		{name: "expired", token: forge(t, hs256, claims(map[string]interface{}{"exp": now.Add(-time.Minute).Unix()}), testSigningKey), wantErr: ErrTokenExpired},
+		{name: "expired within clock skew", token: forge(t, hs256, claims(map[string]interface{}{"exp": now.Add(-clockSkew + time.Second).Unix()}), testSigningKey), wantSub: "user-1"},
+		{name: "expired at clock skew", token: forge(t, hs256, claims(map[string]interface{}{"exp": now.Add(-clockSkew).Unix()}), testSigningKey), wantErr: ErrTokenExpired},
+		{name: "exp missing", token: forge(t, hs256, claims(map[string]interface{}{"exp": nil}), testSigningKey), wantErr: ErrTokenExpired},
+		{name: "not yet valid", token: forge(t, hs256, claims(map[string]interface{}{"nbf": now.Add(time.Minute).Unix()}), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "not yet valid within clock skew", token: forge(t, hs256, claims(map[string]interface{}{"nbf": now.Add(clockSkew).Unix()}), testSigningKey), wantSub: "user-1"},
+		{name: "nbf missing", token: forge(t, hs256, claims(map[string]interface{}{"nbf": nil}), testSigningKey), wantSub: "user-1"},
+		{name: "wrong issuer", token: forge(t, hs256, claims(map[string]interface{}{"iss": "elsewhere"}), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "issuer missing", token: forge(t, hs256, claims(map[string]interface{}{"iss": nil}), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "wrong audience", token: forge(t, hs256, claims(map[string]interface{}{"aud": "admin"}), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "audience missing", token: forge(t, hs256, claims(map[string]interface{}{"aud": nil}), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "subject missing", token: forge(t, hs256, claims(map[string]interface{}{"sub": nil}), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "self impersonation", token: forge(t, hs256, claims(map[string]interface{}{"act": map[string]string{"sub": "user-1"}}), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "client ID of another subject", token: forge(t, hs256, claims(map[string]interface{}{"client_id": "svc-1"}), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "empty", token: "", wantErr: ErrInvalidToken},
+		{name: "two segments", token: parts[0] + "." + parts[1], wantErr: ErrInvalidToken},
+		{name: "four segments", token: valid + "." + parts[2], wantErr: ErrInvalidToken},
+		{name: "header not base64url", token: "***." + parts[1] + "." + parts[2], wantErr: ErrInvalidToken},
+		{name: "header not JSON", token: forge(t, `HS256`, claims(nil), testSigningKey), wantErr: ErrInvalidToken},
+		{name: "payload not JSON", token: forgeRaw(parts[0], "not json"), wantErr: ErrInvalidToken},
+		{name: "padded signature", token: valid + "=", wantErr: ErrInvalidToken},
+	}
+
+	tokens := testTokens(t, now)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := tokens.Verify(tt.token)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if principal.UserID != tt.wantSub {
+				t.Fatalf("Verify() user = %q, want %q", principal.UserID, tt.wantSub)
+			}
+		})
+	}
+}
+
+// forgeRaw signs a raw payload under an encoded header with the test key
+func forgeRaw(encodedHeader, payload string) string {
+	signingInput := encodedHeader + "." + base64.RawURLEncoding.EncodeToString([]byte(payload))
+	mac := hmac.New(sha256.New, testSigningKey)
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestTokenService_IssueVerifyRoundTrip(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tokens := testTokens(t, now)
+
+	tests := []struct {
+		name      string
+		principal Principal
+	}{
+		{name: "user", principal: Principal{UserID: "user-1", Email: "jane@example.com", TenantID: "acme"}},
+		{name: "service account", principal: Principal{ServiceAccountID: "svc-1", Scopes: []string{"orders:read", "orders:write"}}},
+		{name: "impersonation", principal: Principal{UserID: "user-1", ImpersonatorID: "admin-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, expiresAt, err := tokens.Issue(tt.principal)
+			if err != nil {
+				t.Fatalf("Issue() error = %v", err)
+			}
+			if !expiresAt.Equal(now.Add(15 * time.Minute)) {
+				t.Fatalf("Issue() expiry = %v, want %v", expiresAt, now.Add(15*time.Minute))
+			}
+
+			principal, err := tokens.Verify(token)
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if principal.UserID != tt.principal.UserID || principal.ServiceAccountID != tt.principal.ServiceAccountID ||
+				principal.ImpersonatorID != tt.principal.ImpersonatorID || principal.TenantID != tt.principal.TenantID ||
+				strings.Join(principal.Scopes, " ") != strings.Join(tt.principal.Scopes, " ") {
+				t.Fatalf("Verify() = %+v, want %+v", principal, tt.principal)
+			}
+		})
+	}
+
+	// Tokens are rejected once past their expiry and the clock skew
+	token, _, err := tokens.Issue(Principal{UserID: "user-1"})
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	later := testTokens(t, now.Add(15*time.Minute+clockSkew))
+	if _, err := later.Verify(token); !errors.Is(err, ErrTokenExpired) {
+		t.Fatalf("Verify() after expiry error = %v, want %v", err, ErrTokenExpired)
+	}
+}
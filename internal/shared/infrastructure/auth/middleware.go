@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// PrincipalContextKey is the gin context key of the request principal
+const PrincipalContextKey = "auth.principal"
+
+// ImpersonatorHeader is the response header naming the administrator behind an impersonated request,
+// so clients can show that they act as another user
+const ImpersonatorHeader = "X-Impersonated-By"
+
+// Middleware requires a valid "Authorization: Bearer <token>" header, X-API-Key header or session cookie
+// Session cookies are accepted once a SessionVerifier is installed; they rely on the cookie's
+// SameSite attribute against cross-site requests
+// The principal is stored in the request context (PrincipalFromContext) and the gin context (CurrentPrincipal)
+func Middleware(tokens *TokenService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if Authenticate(c, tokens) {
+			c.Next()
+		}
+	}
+}
+
+// Authenticate loads the principal of the request like Middleware, without continuing the handler chain
+// It returns false after aborting the request with 401; a principal loaded earlier in the chain is kept
+func Authenticate(c *gin.Context, tokens *TokenService) bool {
+	if _, ok := CurrentPrincipal(c); ok {
+		return true
+	}
+
+	if key := c.GetHeader(APIKeyHeader); key != "" {
+		return authenticateAPIKey(c, key)
+	}
+
+	token, ok := bearerToken(c.GetHeader("Authorization"))
+	if !ok {
+		if verifier, id, ok := sessionID(c); ok {
+			return authenticateSession(c, verifier, id)
+		}
+		abortUnauthorized(c, "missing bearer token")
+		return false
+	}
+
+	principal, err := tokens.Verify(token)
+	if err != nil {
+		message := "invalid token"
+		if errors.Is(err, ErrTokenExpired) {
+			message = "token expired"
+		}
+		abortUnauthorized(c, message)
+		return false
+	}
+
+	setPrincipal(c, principal)
+	return true
+}
+
+// DenyImpersonation refuses requests of impersonated principals with 403
+// It guards actions an administrator must not take in a user's name, such as changing their credentials
+// Routes must authenticate the request before this middleware
+func DenyImpersonation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if principal, ok := CurrentPrincipal(c); ok && principal.IsImpersonated() {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    domain.ErrCodeForbidden,
+					"message": "not allowed while impersonating a user",
+				},
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// setPrincipal stores the authenticated principal in the gin and request contexts,
+// and its actor in the request context (domain.ActorFromContext)
+func setPrincipal(c *gin.Context, principal *Principal) {
+	c.Set(PrincipalContextKey, principal)
+	if principal.IsImpersonated() {
+		c.Header(ImpersonatorHeader, principal.ImpersonatorID)
+	}
+	ctx := WithPrincipal(c.Request.Context(), principal)
+	c.Request = c.Request.WithContext(domain.WithActor(ctx, principal.Actor()))
+}
+
+// CurrentPrincipal returns the principal loaded by Middleware
+func CurrentPrincipal(c *gin.Context) (*Principal, bool) {
+	value, exists := c.Get(PrincipalContextKey)
+	if !exists {
+		return nil, false
+	}
+	principal, ok := value.(*Principal)
+	return principal, ok
+}
+
+// RequestPrincipal returns the principal loaded by Middleware, or else the principal of valid
+// credentials of the request, an API key, bearer token or session cookie, without requiring any,
+// e.g. to read the tenant of a request before its route authenticates it
+// A principal found is stored like Middleware stores it, so that the route does not verify it again
+func RequestPrincipal(c *gin.Context, tokens *TokenService) (*Principal, bool) {
+	if principal, ok := CurrentPrincipal(c); ok {
+		return principal, true
+	}
+
+	var principal *Principal
+	var err error
+	if key := c.GetHeader(APIKeyHeader); key != "" {
+		verifier := getAPIKeyVerifier()
+		if verifier == nil {
+			return nil, false
+		}
+		principal, err = verifier.VerifyAPIKey(c.Request.Context(), key)
+	} else if token, ok := bearerToken(c.GetHeader("Authorization")); ok {
+		if tokens == nil {
+			return nil, false
+		}
+		principal, err = tokens.Verify(token)
+	} else if verifier, id, ok := sessionID(c); ok {
+		principal, err = verifier.VerifySession(c.Request.Context(), id)
+	} else {
+		return nil, false
+	}
+	if err != nil {
+		return nil, false
+	}
+
+	setPrincipal(c, principal)
+	return principal, true
+}
+
+// bearerToken extracts the token from an Authorization header
+func bearerToken(authorization string) (string, bool) {
+	scheme, token, found := strings.Cut(strings.TrimSpace(authorization), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// abortUnauthorized writes a 401 response in the API's error format
+func abortUnauthorized(c *gin.Context, message string) {
+	c.Header("WWW-Authenticate", `Bearer realm="api"`)
+	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    domain.ErrCodeUnauthorized,
+			"message": message,
+		},
+	})
+}
+
+// abortInternalError writes a 500 response in the API's error format
+func abortInternalError(c *gin.Context) {
+	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": "An internal error occurred",
+		},
+	})
+}
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Principal is the authenticated caller of a request
+// Users authenticate with JWT access tokens; machine clients authenticate with API keys or with
+// service account tokens, in which case APIKeyID or ServiceAccountID and Scopes are set instead
+// of UserID and Email
+// ImpersonatorID is set on the tokens administrators obtain to act as a user; such principals
+// are the user, except where DenyImpersonation guards a route
+// TenantID is the tenant the token was issued in, the API key created in or the session started in,
+// when multi-tenancy is enabled
+type Principal struct {
+	UserID           string   `json:"user_id,omitempty"`
+	Email            string   `json:"email,omitempty"`
+	ImpersonatorID   string   `json:"impersonator_id,omitempty"`
+	TokenID          string   `json:"-"`
+	APIKeyID         string   `json:"api_key_id,omitempty"`
+	ServiceAccountID string   `json:"service_account_id,omitempty"`
+	Scopes           []string `json:"scopes,omitempty"`
+	TenantID         string   `json:"tenant_id,omitempty"`
+}
+
+// IsAPIKey checks if the principal authenticated with an API key
+func (p *Principal) IsAPIKey() bool {
+	return p.APIKeyID != ""
+}
+
+// IsServiceAccount checks if the principal authenticated with a service account token
+func (p *Principal) IsServiceAccount() bool {
+	return p.ServiceAccountID != ""
+}
+
+// IsImpersonated checks if an administrator acts as the user through an impersonation token
+func (p *Principal) IsImpersonated() bool {
+	return p.ImpersonatorID != ""
+}
+
+// Actor returns the actor recorded for changes the principal requests
+func (p *Principal) Actor() domain.Actor {
+	switch {
+	case p.IsAPIKey():
+		return domain.Actor{Type: domain.ActorTypeAPIKey, ID: p.APIKeyID}
+	case p.IsServiceAccount():
+		return domain.Actor{Type: domain.ActorTypeServiceAccount, ID: p.ServiceAccountID}
+	}
+	return domain.Actor{Type: domain.ActorTypeUser, ID: p.UserID, ImpersonatorID: p.ImpersonatorID}
+}
+
+// principalKey is the context key of the request principal
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the principal
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal of an authenticated request
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ErrInvalidSession is returned for unknown and expired sessions
+var ErrInvalidSession = errors.New("invalid session")
+
+// SessionVerifier resolves session cookies to principals
+type SessionVerifier interface {
+	// VerifySession returns the principal of an active session, or ErrInvalidSession
+	// Verifying a session counts as activity and extends its idle expiry
+	VerifySession(ctx context.Context, sessionID string) (*Principal, error)
+}
+
+var (
+	sessionsMu    sync.RWMutex
+	sessions      SessionVerifier
+	sessionCookie string
+)
+
+// SetSessionVerifier installs the verifier used for session cookies with the given name
+// Called by the module that stores sessions; until then session cookies are ignored
+func SetSessionVerifier(verifier SessionVerifier, cookieName string) {
+	sessionsMu.Lock()
+	defer sessionsMu.Unlock()
+	sessions = verifier
+	sessionCookie = cookieName
+}
+
+// getSessionVerifier returns the installed verifier and its cookie name, or nil
+func getSessionVerifier() (SessionVerifier, string) {
+	sessionsMu.RLock()
+	defer sessionsMu.RUnlock()
+	return sessions, sessionCookie
+}
+
+// sessionID returns the session cookie of the request when sessions are accepted
+func sessionID(c *gin.Context) (SessionVerifier, string, bool) {
+	verifier, cookieName := getSessionVerifier()
+	if verifier == nil {
+		return nil, "", false
+	}
+
+	id, err := c.Cookie(cookieName)
+	if err != nil || id == "" {
+		return nil, "", false
+	}
+	return verifier, id, true
+}
+
+// authenticateSession verifies a session cookie and stores its principal, or aborts the request
+func authenticateSession(c *gin.Context, verifier SessionVerifier, id string) bool {
+	principal, err := verifier.VerifySession(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, ErrInvalidSession) {
+			abortUnauthorized(c, "session expired")
+			return false
+		}
+		zap.L().Warn("failed to verify session", zap.Error(err))
+		abortInternalError(c)
+		return false
+	}
+
+	setPrincipal(c, principal)
+	return true
+}
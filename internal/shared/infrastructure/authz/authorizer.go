@@ -0,0 +1,94 @@
+// Package authz decides whether an authenticated principal may perform an action.
+// Permissions are named "<resource>:<action>" (e.g. "customers:delete"). The user module
+// provides the Authorizer from its roles and registers it as a public API; other modules
+// consult it through route guards and the command bus middleware.
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+)
+
+// PublicAPIName is the name the authorizer is registered under in the public API registry
+const PublicAPIName = "authz"
+
+// PolicyVerifiedEmail requires the principal to be a user with a verified email address
+// Policies are conditions on the principal rather than permissions granted by roles or scopes;
+// they are checked through Authorize, so guards and commands require them like permissions
+const PolicyVerifiedEmail = "policy:verified_email"
+
+// policyPrefix starts the names of policies
+const policyPrefix = "policy:"
+
+// IsPolicy checks if a permission names a policy
+func IsPolicy(permission string) bool {
+	return strings.HasPrefix(permission, policyPrefix)
+}
+
+// ErrUnavailable is returned when no authorizer is registered, e.g. when the user module is disabled
+// Requests are denied rather than allowed in that case
+var ErrUnavailable = errors.New("authorization is not available")
+
+// Authorizer checks permissions of authenticated principals
+type Authorizer interface {
+	// Authorize returns nil when the principal holds the permission
+	// and a FORBIDDEN domain error when it does not
+	Authorize(ctx context.Context, principal *auth.Principal, permission string) error
+}
+
+// Forbidden returns the error for a principal that lacks a permission
+func Forbidden(permission string) error {
+	return domain.NewDomainError(
+		domain.ErrCodeForbidden,
+		fmt.Sprintf("missing permission %s", permission),
+	)
+}
+
+// Register makes the authorizer available to other modules
+func Register(apis *domain.PublicAPIRegistry, authorizer Authorizer) error {
+	return apis.Register(PublicAPIName, authorizer)
+}
+
+// Lookup returns the authorizer registered with the module registry
+func Lookup(apis *domain.PublicAPIRegistry) (Authorizer, error) {
+	if apis == nil {
+		return nil, ErrUnavailable
+	}
+
+	registered, exists := apis.Get(PublicAPIName)
+	if !exists {
+		return nil, ErrUnavailable
+	}
+
+	authorizer, ok := registered.(Authorizer)
+	if !ok {
+		return nil, fmt.Errorf("authorizer has unexpected type %T", registered)
+	}
+
+	return authorizer, nil
+}
+
+// Lazy returns an Authorizer that resolves the registered implementation on each call,
+// so consumers do not depend on module initialization order
+func Lazy(apis *domain.PublicAPIRegistry) Authorizer {
+	return lazyAuthorizer{apis: apis}
+}
+
+// lazyAuthorizer delegates to the registered authorizer
+type lazyAuthorizer struct {
+	apis *domain.PublicAPIRegistry
+}
+
+// Authorize implements Authorizer
+func (l lazyAuthorizer) Authorize(ctx context.Context, principal *auth.Principal, permission string) error {
+	authorizer, err := Lookup(l.apis)
+	if err != nil {
+		return err
+	}
+	return authorizer.Authorize(ctx, principal, permission)
+}
@@ -0,0 +1,61 @@
+package authz
+
+import (
+	"context"
+
+	"golang_modular_monolith/internal/shared/application"
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+)
+
+// ProtectedCommand is implemented by commands that require a permission
+type ProtectedCommand interface {
+	application.Command
+
+	// RequiredPermission returns the permission needed to execute the command
+	RequiredPermission() string
+}
+
+// PolicyCommand is implemented by commands that require policies, such as PolicyVerifiedEmail
+type PolicyCommand interface {
+	application.Command
+
+	// RequiredPolicies returns the policies the principal must satisfy to execute the command
+	RequiredPolicies() []string
+}
+
+// CommandMiddleware authorizes protected and policy commands on a MiddlewareCommandBus
+// The principal is taken from the context, as stored by auth.Middleware; other commands pass through
+func CommandMiddleware(authorizer Authorizer) application.CommandMiddleware {
+	return application.CommandMiddlewareFunc(func(ctx context.Context, cmd application.Command, next func(context.Context, application.Command) error) error {
+		required := requirements(cmd)
+		if len(required) == 0 {
+			return next(ctx, cmd)
+		}
+
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			return domain.NewDomainError(domain.ErrCodeUnauthorized, "authentication required")
+		}
+
+		for _, permission := range required {
+			if err := authorizer.Authorize(ctx, principal, permission); err != nil {
+				return err
+			}
+		}
+
+		return next(ctx, cmd)
+	})
+}
+
+// requirements returns the permission and policies a command requires
+func requirements(cmd application.Command) []string {
+	var required []string
+	if protected, ok := cmd.(ProtectedCommand); ok && protected.RequiredPermission() != "" {
+		required = append(required, protected.RequiredPermission())
+	}
+	if policed, ok := cmd.(PolicyCommand); ok {
+		required = append(required, policed.RequiredPolicies()...)
+	}
+	return required
+}
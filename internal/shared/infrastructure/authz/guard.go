@@ -0,0 +1,71 @@
+package authz
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+)
+
+// RequirePermission guards a route with a permission
+// It must run after auth.Middleware, which loads the principal
+func RequirePermission(authorizer Authorizer, permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := auth.CurrentPrincipal(c)
+		if !ok {
+			abort(c, http.StatusUnauthorized, domain.ErrCodeUnauthorized, "authentication required")
+			return
+		}
+
+		if !authorize(c, authorizer, principal, permission) {
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireVerifiedEmail guards a route so that only users with a verified email address pass
+// It must run after auth.Middleware, which loads the principal
+func RequireVerifiedEmail(authorizer Authorizer) gin.HandlerFunc {
+	return RequirePermission(authorizer, PolicyVerifiedEmail)
+}
+
+// authorize checks a permission of the principal and aborts the request when it is not held
+func authorize(c *gin.Context, authorizer Authorizer, principal *auth.Principal, permission string) bool {
+	err := authorizer.Authorize(c.Request.Context(), principal, permission)
+	if err == nil {
+		return true
+	}
+
+	var domainErr domain.DomainError
+	switch {
+	case errors.As(err, &domainErr) && domainErr.Code == domain.ErrCodeForbidden:
+		abort(c, http.StatusForbidden, domainErr.Code, domainErr.Message)
+	case errors.Is(err, ErrUnavailable):
+		abort(c, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", err.Error())
+	default:
+		zap.L().Warn("failed to authorize",
+			zap.String("permission", permission),
+			zap.String("actor", principal.Actor().String()),
+			zap.Error(err),
+		)
+		abort(c, http.StatusInternalServerError, "INTERNAL_ERROR", "An internal error occurred")
+	}
+	return false
+}
+
+// abort writes an error response in the API's error format
+func abort(c *gin.Context, status int, code, message string) {
+	c.AbortWithStatusJSON(status, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
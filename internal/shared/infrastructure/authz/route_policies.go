@@ -0,0 +1,124 @@
+package authz
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+)
+
+// RoutePolicies maps routes to the permissions and policies they require
+// Routes are keyed by "<METHOD> <path>", with the path as registered relative to the API group
+type RoutePolicies map[string][]string
+
+// ParseRoutePolicies parses the http.routes section of a module's configuration
+// Keys are "<path> <METHOD>" (e.g. "/customers/:id DELETE"), values the permissions a route requires;
+// keys are case-insensitive because the configuration loader lower-cases them
+func ParseRoutePolicies(declared map[string][]string) (RoutePolicies, error) {
+	policies := make(RoutePolicies, len(declared))
+	for key, permissions := range declared {
+		fields := strings.Fields(key)
+		if len(fields) != 2 || !strings.HasPrefix(fields[0], "/") {
+			return nil, fmt.Errorf("route %q must be \"<path> <METHOD>\"", key)
+		}
+
+		method := strings.ToUpper(fields[1])
+		if !isHTTPMethod(method) {
+			return nil, fmt.Errorf("route %q has unknown method %s", key, fields[1])
+		}
+
+		if len(permissions) == 0 {
+			return nil, fmt.Errorf("route %q must require at least one permission", key)
+		}
+
+		required := make([]string, 0, len(permissions))
+		for _, permission := range permissions {
+			permission = strings.TrimSpace(strings.ToLower(permission))
+			if permission == "" || strings.ContainsAny(permission, " \t") {
+				return nil, fmt.Errorf("route %q has invalid permission %q", key, permission)
+			}
+			required = append(required, permission)
+		}
+
+		route := routeKey(method, fields[0])
+		if _, exists := policies[route]; exists {
+			return nil, fmt.Errorf("route %q is declared more than once", key)
+		}
+		policies[route] = required
+	}
+
+	return policies, nil
+}
+
+// Middleware guards the declared routes of a module group; other routes pass through
+// Requests to a declared route are authenticated like auth.Middleware and must hold every permission
+// basePath is the path of the group the module's routes are registered under, e.g. "/api/v1"
+func (p RoutePolicies) Middleware(authorizer Authorizer, tokens *auth.TokenService, basePath string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		required, ok := p[routeKey(c.Request.Method, strings.TrimPrefix(c.FullPath(), basePath))]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		if !auth.Authenticate(c, tokens) {
+			return
+		}
+		principal, _ := auth.CurrentPrincipal(c)
+
+		for _, permission := range required {
+			if !authorize(c, authorizer, principal, permission) {
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// Required returns the permissions declared for a route, with the path as registered relative to the
+// module's prefix
+func (p RoutePolicies) Required(method, path string) []string {
+	return p[routeKey(method, path)]
+}
+
+// Unmatched returns the declared routes that are not registered under any of the base paths, sorted
+// A misspelled route would otherwise leave the route it meant to guard unprotected
+func (p RoutePolicies) Unmatched(routes gin.RoutesInfo, basePaths ...string) []string {
+	registered := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		for _, basePath := range basePaths {
+			if path, ok := strings.CutPrefix(route.Path, basePath); ok {
+				registered[routeKey(route.Method, path)] = true
+			}
+		}
+	}
+
+	var unmatched []string
+	for route := range p {
+		if !registered[route] {
+			unmatched = append(unmatched, route)
+		}
+	}
+	sort.Strings(unmatched)
+	return unmatched
+}
+
+// routeKey builds the lookup key of a route
+func routeKey(method, path string) string {
+	return method + " " + strings.ToLower(path)
+}
+
+// isHTTPMethod checks if a method can be routed
+func isHTTPMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+		http.MethodPatch, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+// Package awssig signs requests to AWS APIs and S3-compatible services with Signature Version 4,
+// either in the Authorization header or, for presigned URLs, in the query string.
+package awssig
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UnsignedPayload is signed in place of the payload hash when the body is streamed, e.g. S3 uploads
+const UnsignedPayload = "UNSIGNED-PAYLOAD"
+
+// algorithm names Signature Version 4 in signatures
+const algorithm = "AWS4-HMAC-SHA256"
+
+// Credentials authenticate requests to AWS
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is set with temporary credentials
+	SessionToken string
+}
+
+// Scope is the region and service a request is signed for, e.g. us-east-1 and s3
+type Scope struct {
+	Region  string
+	Service string
+}
+
+// PayloadHash returns the hash of a request body signed by Sign
+func PayloadHash(payload []byte) string {
+	return sha256Hex(payload)
+}
+
+// Sign signs a request in its Authorization header; payloadHash is PayloadHash of the body, or
+// UnsignedPayload
+// The signed headers are host, content-type and the x-amz-* headers
+func Sign(req *http.Request, payloadHash string, credentials Credentials, scope Scope, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if credentials.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", credentials.SessionToken)
+	}
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		lower := strings.ToLower(name)
+		if lower == "content-type" || strings.HasPrefix(lower, "x-amz-") {
+			headers[lower] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	canonicalHeaders, signedHeaders := canonicalizeHeaders(headers)
+
+	credentialScope := scope.credentialScope(now)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalPath(req.URL),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	signature := signature(credentials, scope, now, canonicalRequest)
+
+	req.Header.Set("Authorization", algorithm+" Credential="+credentials.AccessKeyID+"/"+credentialScope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+// Presign returns a URL granting method on target until expires elapsed, signed in its query string
+// headers are the headers the client must send with the request, e.g. Content-Type; they are signed
+// along with host, and the payload is not
+func Presign(method string, target *url.URL, headers map[string]string, credentials Credentials, scope Scope, now time.Time, expires time.Duration) string {
+	now = now.UTC()
+
+	signed := map[string]string{"host": target.Host}
+	for name, value := range headers {
+		signed[strings.ToLower(name)] = strings.TrimSpace(value)
+	}
+	canonicalHeaders, signedHeaders := canonicalizeHeaders(signed)
+
+	query := target.Query()
+	query.Set("X-Amz-Algorithm", algorithm)
+	query.Set("X-Amz-Credential", credentials.AccessKeyID+"/"+scope.credentialScope(now))
+	query.Set("X-Amz-Date", now.Format("20060102T150405Z"))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expires/time.Second)))
+	query.Set("X-Amz-SignedHeaders", signedHeaders)
+	if credentials.SessionToken != "" {
+		query.Set("X-Amz-Security-Token", credentials.SessionToken)
+	}
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		canonicalPath(target),
+		canonicalQuery(query),
+		canonicalHeaders,
+		signedHeaders,
+		UnsignedPayload,
+	}, "\n")
+	query.Set("X-Amz-Signature", signature(credentials, scope, now, canonicalRequest))
+
+	presigned := *target
+	presigned.RawQuery = canonicalQuery(query)
+	return presigned.String()
+}
+
+// credentialScope returns the scope of signatures made on the day of now
+func (s Scope) credentialScope(now time.Time) string {
+	return now.Format("20060102") + "/" + s.Region + "/" + s.Service + "/aws4_request"
+}
+
+// signature signs a canonical request with the key derived for the scope and day
+func signature(credentials Credentials, scope Scope, now time.Time, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{
+		algorithm,
+		now.Format("20060102T150405Z"),
+		scope.credentialScope(now),
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+credentials.SecretAccessKey), now.Format("20060102"))
+	key = hmacSHA256(key, scope.Region)
+	key = hmacSHA256(key, scope.Service)
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// canonicalizeHeaders returns the canonical headers and the signed header list of lowercase headers
+func canonicalizeHeaders(headers map[string]string) (string, string) {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name + ":" + headers[name] + "\n")
+	}
+	return canonical.String(), strings.Join(names, ";")
+}
+
+// canonicalPath returns the URI-encoded path of a URL
+func canonicalPath(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+// canonicalQuery encodes a query sorted by name, escaping everything but unreserved characters
+func canonicalQuery(query url.Values) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, 0, len(query))
+	for _, name := range names {
+		values := append([]string(nil), query[name]...)
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, escape(name)+"="+escape(value))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escape percent-encodes s as AWS requires: spaces as %20 and every reserved character encoded
+func escape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+// sha256Hex returns the hex-encoded SHA-256 digest of data
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// hmacSHA256 returns the HMAC-SHA256 of data with key
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
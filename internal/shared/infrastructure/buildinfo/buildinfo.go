@@ -0,0 +1,47 @@
+// Package buildinfo identifies the running binary: its version, commit and build time, injected at
+// build time with -ldflags "-X golang_modular_monolith/internal/shared/infrastructure/buildinfo.Version=...".
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Set with -ldflags -X at build time; see the build target of the Makefile
+var (
+	Version   = ""
+	Commit    = ""
+	BuildTime = ""
+)
+
+// Info identifies the running binary
+type Info struct {
+	Version   string `json:"version,omitempty"`
+	Commit    string `json:"commit,omitempty"`
+	BuildTime string `json:"build_time,omitempty"`
+	GoVersion string `json:"go_version"`
+	Modified  bool   `json:"modified,omitempty"`
+}
+
+// Get returns the build info; without ldflags, the commit and build time are taken from the VCS
+// stamp Go records in binaries built from a repository
+func Get() Info {
+	info := Info{Version: Version, Commit: Commit, BuildTime: BuildTime, GoVersion: runtime.Version()}
+	if build, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range build.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.Commit == "" {
+					info.Commit = setting.Value
+				}
+			case "vcs.time":
+				if info.BuildTime == "" {
+					info.BuildTime = setting.Value
+				}
+			case "vcs.modified":
+				info.Modified = setting.Value == "true"
+			}
+		}
+	}
+	return info
+}
@@ -0,0 +1,98 @@
+// Package cache provides the caches modules keep read models in: an in-process cache and a Redis
+// cache shared by the instances, namespaced per module, with helpers to cache JSON values and to
+// drop entries when domain events change what they were computed from.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Cache is the cache modules receive through their dependencies
+type Cache = domain.Cache
+
+// namespaced prefixes the keys of a namespace and counts its hits, misses and writes
+type namespaced struct {
+	cache  Cache
+	prefix string
+	stats  *stats
+}
+
+// Namespace returns the part of the cache whose keys start with name, so that modules sharing a
+// cache cannot read or overwrite each other's entries; its requests are reported under name
+func Namespace(cache Cache, name string) Cache {
+	return &namespaced{cache: cache, prefix: name + ":", stats: statsOf(name)}
+}
+
+// Get returns the value stored under key in the namespace
+func (n *namespaced) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, ok, err := n.cache.Get(ctx, n.prefix+key)
+	switch {
+	case err != nil:
+		n.stats.errors.Add(1)
+	case ok:
+		n.stats.hits.Add(1)
+	default:
+		n.stats.misses.Add(1)
+	}
+	return value, ok, err
+}
+
+// Set stores the value under key in the namespace
+func (n *namespaced) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	n.stats.sets.Add(1)
+	return n.cache.Set(ctx, n.prefix+key, value, ttl)
+}
+
+// Delete removes the values stored under keys in the namespace
+func (n *namespaced) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = n.prefix + key
+	}
+	n.stats.deletes.Add(uint64(len(keys)))
+	return n.cache.Delete(ctx, prefixed...)
+}
+
+// GetJSON returns the value stored as JSON under key; ok is false when it is missing
+func GetJSON[T any](ctx context.Context, cache Cache, key string) (value T, ok bool, err error) {
+	data, ok, err := cache.Get(ctx, key)
+	if err != nil || !ok {
+		return value, false, err
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return value, false, fmt.Errorf("failed to decode cached %s: %w", key, err)
+	}
+	return value, true, nil
+}
+
+// SetJSON stores the value as JSON under key for ttl
+func SetJSON(ctx context.Context, cache Cache, key string, value any, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s for the cache: %w", key, err)
+	}
+	return cache.Set(ctx, key, data, ttl)
+}
+
+// GetOrLoad returns the value cached under key, or loads it and caches it for ttl
+// The cache only speeds up reads: when it fails, the value is loaded as if it missed
+func GetOrLoad[T any](ctx context.Context, cache Cache, key string, ttl time.Duration, load func(context.Context) (T, error)) (T, error) {
+	if value, ok, err := GetJSON[T](ctx, cache, key); err == nil && ok {
+		return value, nil
+	}
+
+	value, err := load(ctx)
+	if err != nil {
+		return value, err
+	}
+	_ = SetJSON(ctx, cache, key, value, ttl)
+	return value, nil
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+)
+
+// Backend names
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+)
+
+// Shared is the cache modules share, closed on shutdown
+type Shared interface {
+	Cache
+	Close() error
+}
+
+// NewFromConfig creates the cache of global.cache, shared by the modules that enable caching
+func NewFromConfig(cfg *config.Config) (Shared, error) {
+	var settings config.CacheGlobalConfig
+	if cfg.Modules != nil {
+		settings = cfg.Modules.Global.Cache
+	}
+
+	switch settings.Backend {
+	case "", BackendMemory:
+		return NewMemoryCache(settings.MaxCost)
+	case BackendRedis:
+		addr := settings.Redis.Addr
+		if addr == "" {
+			addr = "localhost:6379"
+		}
+		client := redis.NewClient(&redis.Options{
+			Addr:     addr,
+			Password: settings.Redis.Password,
+			DB:       settings.Redis.DB,
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := client.Ping(ctx).Err(); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("failed to connect to cache server at %s: %w", addr, err)
+		}
+		return NewRedisCache(client), nil
+	default:
+		return nil, fmt.Errorf("cache backend %q is unknown", settings.Backend)
+	}
+}
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"context"
+	"slices"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Invalidator is an event handler deleting the cache entries an event makes stale
+type Invalidator struct {
+	cache      Cache
+	keys       func(domain.DomainEvent) []string
+	eventTypes []string
+}
+
+// InvalidateOn returns a handler deleting the keys of each event of the given types, e.g. to
+// subscribe to the event bus:
+//
+//	deps.EventBus.Subscribe(cache.InvalidateOn(deps.Cache, cache.AggregateKey("customer"), "customer.updated"))
+//
+// Without event types, every event is handled
+func InvalidateOn(cache Cache, keys func(domain.DomainEvent) []string, eventTypes ...string) *Invalidator {
+	return &Invalidator{cache: cache, keys: keys, eventTypes: eventTypes}
+}
+
+// Handle deletes the keys of the event
+func (i *Invalidator) Handle(event domain.DomainEvent) error {
+	keys := i.keys(event)
+	if len(keys) == 0 {
+		return nil
+	}
+	return i.cache.Delete(context.Background(), keys...)
+}
+
+// CanHandle reports whether the event type makes entries stale
+func (i *Invalidator) CanHandle(eventType string) bool {
+	return len(i.eventTypes) == 0 || slices.Contains(i.eventTypes, eventType)
+}
+
+// Key returns the key of the entry of an aggregate, e.g. "customer:42"
+func Key(prefix, aggregateID string) string {
+	return prefix + ":" + aggregateID
+}
+
+// AggregateKey returns the keys of events to invalidate the entry of their aggregate, see Key
+func AggregateKey(prefix string) func(domain.DomainEvent) []string {
+	return func(event domain.DomainEvent) []string {
+		return []string{Key(prefix, event.GetAggregateID())}
+	}
+}
@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/ristretto/v2"
+)
+
+// DefaultMaxCost is the size of the in-memory cache, in bytes of values, when none is configured
+const DefaultMaxCost = 64 << 20
+
+// MemoryCache keeps values in the process, evicting the least useful ones once they exceed the
+// maximum size; each instance of the application has its own
+type MemoryCache struct {
+	cache *ristretto.Cache[string, []byte]
+}
+
+// NewMemoryCache creates an in-memory cache of at most maxCost bytes of values
+func NewMemoryCache(maxCost int64) (*MemoryCache, error) {
+	if maxCost <= 0 {
+		maxCost = DefaultMaxCost
+	}
+	cache, err := ristretto.NewCache(&ristretto.Config[string, []byte]{
+		// Ten counters per entry of 1KB on average, as ristretto recommends
+		NumCounters: max(maxCost/100, 1000),
+		MaxCost:     maxCost,
+		BufferItems: 64,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create in-memory cache: %w", err)
+	}
+	return &MemoryCache{cache: cache}, nil
+}
+
+// Get returns the value stored under key
+func (m *MemoryCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	value, ok := m.cache.Get(key)
+	return value, ok, nil
+}
+
+// Set stores the value under key for ttl
+// The write is applied before Set returns, so a Get that follows sees it, unless the value does
+// not fit the cache
+func (m *MemoryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	m.cache.SetWithTTL(key, value, int64(len(value))+int64(len(key)), ttl)
+	m.cache.Wait()
+	return nil
+}
+
+// Delete removes the values stored under keys
+func (m *MemoryCache) Delete(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		m.cache.Del(key)
+	}
+	return nil
+}
+
+// Close stops the goroutines of the cache and drops its values
+func (m *MemoryCache) Close() error {
+	m.cache.Close()
+	return nil
+}
@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	requestsDesc = prometheus.NewDesc(
+		"cache_requests_total",
+		"Number of cache reads of each namespace, by result: hit, miss or error",
+		[]string{"namespace", "result"}, nil,
+	)
+	writesDesc = prometheus.NewDesc(
+		"cache_writes_total",
+		"Number of cache writes of each namespace, by operation: set or delete",
+		[]string{"namespace", "operation"}, nil,
+	)
+)
+
+// stats counts the requests of one namespace
+type stats struct {
+	hits, misses, errors atomic.Uint64
+	sets, deletes        atomic.Uint64
+}
+
+var (
+	mu         sync.Mutex
+	namespaces = make(map[string]*stats)
+)
+
+// statsOf returns the counters of a namespace, shared by every cache of that namespace
+func statsOf(name string) *stats {
+	mu.Lock()
+	defer mu.Unlock()
+
+	s, ok := namespaces[name]
+	if !ok {
+		s = &stats{}
+		namespaces[name] = s
+	}
+	return s
+}
+
+// collector exposes the counters of every namespace as Prometheus metrics
+type collector struct{}
+
+// Collector returns a Prometheus collector of the reads and writes of every cache namespace
+func Collector() prometheus.Collector {
+	return collector{}
+}
+
+// Describe implements prometheus.Collector
+func (collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- requestsDesc
+	ch <- writesDesc
+}
+
+// Collect implements prometheus.Collector
+func (collector) Collect(ch chan<- prometheus.Metric) {
+	mu.Lock()
+	names := make([]string, 0, len(namespaces))
+	for name := range namespaces {
+		names = append(names, name)
+	}
+	mu.Unlock()
+	sort.Strings(names)
+
+	for _, name := range names {
+		s := statsOf(name)
+		ch <- prometheus.MustNewConstMetric(requestsDesc, prometheus.CounterValue, float64(s.hits.Load()), name, "hit")
+		ch <- prometheus.MustNewConstMetric(requestsDesc, prometheus.CounterValue, float64(s.misses.Load()), name, "miss")
+		ch <- prometheus.MustNewConstMetric(requestsDesc, prometheus.CounterValue, float64(s.errors.Load()), name, "error")
+		ch <- prometheus.MustNewConstMetric(writesDesc, prometheus.CounterValue, float64(s.sets.Load()), name, "set")
+		ch <- prometheus.MustNewConstMetric(writesDesc, prometheus.CounterValue, float64(s.deletes.Load()), name, "delete")
+	}
+}
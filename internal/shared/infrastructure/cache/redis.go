@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisCache keeps values in a Redis server shared by the instances of the application, so that an
+// entry one instance deletes is gone for all of them
+type RedisCache struct {
+	client *redis.Client
+}
+
+// NewRedisCache creates a cache of the values kept by the Redis server of client
+func NewRedisCache(client *redis.Client) *RedisCache {
+	return &RedisCache{client: client}
+}
+
+// Get returns the value stored under key
+func (r *RedisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := r.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get %s from the cache: %w", key, err)
+	}
+	return value, true, nil
+}
+
+// Set stores the value under key for ttl
+func (r *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := r.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set %s in the cache: %w", key, err)
+	}
+	return nil
+}
+
+// Delete removes the values stored under keys
+func (r *RedisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if err := r.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("failed to delete %d keys from the cache: %w", len(keys), err)
+	}
+	return nil
+}
+
+// Close closes the connections to the server
+func (r *RedisCache) Close() error {
+	return r.client.Close()
+}
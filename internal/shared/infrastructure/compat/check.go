@@ -0,0 +1,110 @@
+package compat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+	"golang_modular_monolith/internal/shared/infrastructure/database"
+	"golang_modular_monolith/internal/shared/infrastructure/migration"
+)
+
+// ParseDependency parses a module.depends_on entry, a module name optionally followed by a version
+// constraint, e.g. "customer ^1.0"; the constraint is nil when the entry has none
+func ParseDependency(entry string) (string, *Constraint, error) {
+	name, rest, _ := strings.Cut(strings.TrimSpace(entry), " ")
+	if name == "" {
+		return "", nil, fmt.Errorf("empty dependency")
+	}
+	if strings.TrimSpace(rest) == "" {
+		return name, nil, nil
+	}
+
+	constraint, err := ParseConstraint(rest)
+	if err != nil {
+		return "", nil, fmt.Errorf("dependency %s: %w", name, err)
+	}
+	return name, &constraint, nil
+}
+
+// CheckDependencies verifies that the modules every enabled module depends on satisfy the version
+// constraints of its module.depends_on
+// Dependencies that are not enabled are left to the module registry, which refuses them
+func CheckDependencies(modules *config.ModulesConfig) error {
+	var errs []error
+	for _, name := range enabledModules(modules) {
+		for _, entry := range modules.Modules[name].Module.DependsOn {
+			dependency, constraint, err := ParseDependency(entry)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("module %s: %w", name, err))
+				continue
+			}
+			dependencyConfig, ok := modules.Modules[dependency]
+			if constraint == nil || !ok || !dependencyConfig.Enabled {
+				continue
+			}
+
+			if dependencyConfig.Module.Version == "" {
+				errs = append(errs, fmt.Errorf("module %s requires %s %s, which declares no module.version", name, dependency, constraint))
+				continue
+			}
+			version, err := ParseVersion(dependencyConfig.Module.Version)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("module %s: %w", dependency, err))
+				continue
+			}
+			if !constraint.Matches(version) {
+				errs = append(errs, fmt.Errorf("module %s requires %s %s, found %s", name, dependency, constraint, version))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CheckSchemas verifies that the database of every enabled module declaring module.schema_version
+// has its migrations applied up to that version, and that none failed halfway
+// A database ahead of the module is only logged, since migrations are expected to stay compatible
+// with the previous release during rolling deploys
+func CheckSchemas(ctx context.Context, modules *config.ModulesConfig) error {
+	manager := database.GetGlobalManager()
+
+	var errs []error
+	for _, name := range enabledModules(modules) {
+		moduleConfig := modules.Modules[name]
+		required := moduleConfig.Module.SchemaVersion
+		if required == 0 || !moduleConfig.Migration.Enabled {
+			continue
+		}
+
+		db, err := manager.GetConnection(name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("module %s: %w", name, err))
+			continue
+		}
+		applied, dirty, err := migration.AppliedVersion(ctx, db)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("module %s: %w", name, err))
+		case dirty:
+			errs = append(errs, fmt.Errorf("module %s: migration %d failed halfway, fix the database and force its version", name, applied))
+		case applied < required:
+			errs = append(errs, fmt.Errorf("module %s requires schema version %d, database is at %d; run its migrations", name, required, applied))
+		case applied > required:
+			zap.L().Warn("module database schema is ahead of the module",
+				zap.String("module", name), zap.Uint("schema_version", required), zap.Uint("applied_version", applied))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// enabledModules returns the names of the enabled modules, sorted
+func enabledModules(modules *config.ModulesConfig) []string {
+	names := modules.GetEnabledModules()
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,146 @@
+// Package compat checks at startup that modules are compatible with their database schema and
+// with the versions of the modules they depend on.
+package compat
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a module version, MAJOR.MINOR.PATCH
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// String returns the version as MAJOR.MINOR.PATCH
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Compare returns -1, 0 or 1 as v is lower than, equal to or greater than other
+func (v Version) Compare(other Version) int {
+	for _, diff := range []int{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch} {
+		if diff < 0 {
+			return -1
+		}
+		if diff > 0 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// ParseVersion parses a version such as "1.2.3" or "v1.2"; missing parts are zero
+func ParseVersion(text string) (Version, error) {
+	version, _, err := parseVersion(text)
+	return version, err
+}
+
+// parseVersion parses a version and returns how many of its parts were given
+func parseVersion(text string) (Version, int, error) {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(text), "v"), ".")
+	if len(parts) > 3 {
+		return Version{}, 0, fmt.Errorf("invalid version %q, expected MAJOR.MINOR.PATCH", text)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, 0, fmt.Errorf("invalid version %q, expected MAJOR.MINOR.PATCH", text)
+		}
+		numbers[i] = n
+	}
+	return Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, len(parts), nil
+}
+
+// comparator is a single bound of a constraint, e.g. >=1.2.0
+type comparator struct {
+	op      string
+	version Version
+}
+
+// matches reports whether v satisfies the comparator
+func (c comparator) matches(v Version) bool {
+	cmp := v.Compare(c.version)
+	switch c.op {
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	default:
+		return cmp == 0
+	}
+}
+
+// Constraint is a set of bounds a version must all satisfy, e.g. ">=1.2.0 <2.0.0"
+type Constraint struct {
+	text        string
+	comparators []comparator
+}
+
+// String returns the constraint as written
+func (c Constraint) String() string {
+	return c.text
+}
+
+// Matches reports whether v satisfies every bound of the constraint
+func (c Constraint) Matches(v Version) bool {
+	for _, comparator := range c.comparators {
+		if !comparator.matches(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseConstraint parses bounds separated by spaces or commas; each is a version preceded by one of
+// =, >, >=, <, <= or one of
+//   - ^, allowing changes that keep the major version (the minor version for 0.x), e.g. ^1.2 is >=1.2.0 <2.0.0
+//   - ~, allowing patch changes, or minor changes when only the major version is given, e.g. ~1.2 is >=1.2.0 <1.3.0
+//
+// A bare version must match exactly
+func ParseConstraint(text string) (Constraint, error) {
+	constraint := Constraint{text: strings.TrimSpace(text)}
+	fields := strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == ' ' })
+	if len(fields) == 0 {
+		return Constraint{}, fmt.Errorf("empty version constraint")
+	}
+
+	for _, field := range fields {
+		op := strings.TrimRight(field, "0123456789.v")
+		version, parts, err := parseVersion(field[len(op):])
+		if err != nil {
+			return Constraint{}, fmt.Errorf("invalid version constraint %q: %w", field, err)
+		}
+
+		switch op {
+		case "", "=", ">", ">=", "<", "<=":
+			constraint.comparators = append(constraint.comparators, comparator{op: op, version: version})
+		case "^":
+			upper := Version{Major: version.Major + 1}
+			if version.Major == 0 && parts > 1 {
+				upper = Version{Minor: version.Minor + 1}
+			}
+			constraint.comparators = append(constraint.comparators,
+				comparator{op: ">=", version: version}, comparator{op: "<", version: upper})
+		case "~":
+			upper := Version{Major: version.Major, Minor: version.Minor + 1}
+			if parts == 1 {
+				upper = Version{Major: version.Major + 1}
+			}
+			constraint.comparators = append(constraint.comparators,
+				comparator{op: ">=", version: version}, comparator{op: "<", version: upper})
+		default:
+			return Constraint{}, fmt.Errorf("invalid version constraint %q: unknown operator %q", field, op)
+		}
+	}
+	return constraint, nil
+}
@@ -0,0 +1,130 @@
+// Package conditional implements conditional requests: responses of read routes carry an ETag and
+// requests repeating it in If-None-Match are answered 304 Not Modified without a body, and writes
+// of versioned resources name the version they expect in If-Match.
+package conditional
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Headers of conditional requests
+const (
+	HeaderETag        = "ETag"
+	HeaderIfNoneMatch = "If-None-Match"
+	HeaderIfMatch     = "If-Match"
+)
+
+// ETag computes a weak ETag from the body of successful GET and HEAD responses, unless the handler
+// set one, e.g. a VersionETag, and answers 304 Not Modified when it matches the request's
+// If-None-Match header
+// The response is buffered, so the middleware suits JSON reads rather than streamed downloads;
+// the handler still runs, so it saves bandwidth rather than database work
+func ETag() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		writer := &bufferedWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		if writer.status != http.StatusOK {
+			writer.flush()
+			return
+		}
+
+		etag := writer.Header().Get(HeaderETag)
+		if etag == "" {
+			etag = Weak(writer.body.Bytes())
+			writer.Header().Set(HeaderETag, etag)
+		}
+		if Matches(c.GetHeader(HeaderIfNoneMatch), etag) {
+			writer.Header().Del("Content-Type")
+			writer.Header().Del("Content-Length")
+			writer.ResponseWriter.WriteHeader(http.StatusNotModified)
+			writer.ResponseWriter.WriteHeaderNow()
+			return
+		}
+		writer.flush()
+	}
+}
+
+// Weak returns the weak ETag of a representation
+// It is weak because equal JSON may be encoded differently, e.g. after a gzip middleware
+func Weak(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `W/"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`
+}
+
+// Matches checks an If-None-Match header against an ETag with the weak comparison of RFC 9110
+func Matches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedWriter holds the status and body written by the handlers until the ETag is known
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status  int
+	body    bytes.Buffer
+	written bool
+}
+
+func (w *bufferedWriter) WriteHeader(status int) {
+	if !w.written {
+		w.status = status
+	}
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {
+	w.written = true
+}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	w.written = true
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	w.written = true
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.written
+}
+
+// flush writes the buffered response
+func (w *bufferedWriter) flush() {
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.body.Len() > 0 {
+		_, _ = w.ResponseWriter.Write(w.body.Bytes())
+	} else {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
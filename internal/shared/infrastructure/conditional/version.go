@@ -0,0 +1,59 @@
+package conditional
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// VersionETag returns the strong ETag of the representation of a resource at a version: the version,
+// which writes repeat in If-Match, then a digest of the representation, so that reads still see
+// changes that leave the version alone, e.g. of projected statistics
+func VersionETag(version int, representation []byte) string {
+	sum := sha256.Sum256(representation)
+	return `"` + strconv.Itoa(version) + "-" + base64.RawURLEncoding.EncodeToString(sum[:12]) + `"`
+}
+
+// IfMatchVersion returns the version the If-Match header of a write expects, nil when the header is
+// absent or "*"; it takes VersionETags, or the bare version in quotes, e.g. "3"
+// Weak ETags and ETags that name no version, such as those of list responses, never match and fail
+// with PRECONDITION_FAILED
+func IfMatchVersion(c *gin.Context) (*int, error) {
+	header := strings.TrimSpace(c.GetHeader(HeaderIfMatch))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	var versions []int
+	for _, etag := range strings.Split(header, ",") {
+		etag = strings.TrimSpace(etag)
+		if len(etag) < 2 || !strings.HasPrefix(etag, `"`) || !strings.HasSuffix(etag, `"`) {
+			continue
+		}
+		number, _, _ := strings.Cut(etag[1:len(etag)-1], "-")
+		if version, err := strconv.Atoi(number); err == nil && version >= 0 {
+			versions = append(versions, version)
+		}
+	}
+
+	switch len(versions) {
+	case 0:
+		return nil, domain.NewDomainError(
+			domain.ErrCodePreconditionFailed,
+			fmt.Sprintf("%s %s names no version of the resource; repeat the ETag of a GET", HeaderIfMatch, header),
+		)
+	case 1:
+		return &versions[0], nil
+	}
+	return nil, domain.NewDomainErrorWithField(
+		domain.ErrCodeInvalidInput,
+		HeaderIfMatch+" must name a single version",
+		HeaderIfMatch,
+	)
+}
@@ -0,0 +1,69 @@
+package conditional
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+func TestIfMatchVersion(t *testing.T) {
+	etag := VersionETag(3, []byte(`{"id":"c1"}`))
+
+	tests := []struct {
+		name    string
+		ifMatch string
+		want    *int
+		code    string
+	}{
+		{name: "absent"},
+		{name: "any", ifMatch: "*"},
+		{name: "version ETag", ifMatch: etag, want: intPtr(3)},
+		{name: "bare version", ifMatch: `"7"`, want: intPtr(7)},
+		{name: "among ETags naming no version", ifMatch: `W/"abc", ` + etag, want: intPtr(3)},
+		{name: "weak version ETag", ifMatch: "W/" + etag, code: domain.ErrCodePreconditionFailed},
+		{name: "representation ETag", ifMatch: Weak([]byte("{}")), code: domain.ErrCodePreconditionFailed},
+		{name: "unquoted", ifMatch: "3", code: domain.ErrCodePreconditionFailed},
+		{name: "negative", ifMatch: `"-1"`, code: domain.ErrCodePreconditionFailed},
+		{name: "two versions", ifMatch: `"3", "4"`, code: domain.ErrCodeInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("PUT", "/customers/c1", nil)
+			if tt.ifMatch != "" {
+				c.Request.Header.Set(HeaderIfMatch, tt.ifMatch)
+			}
+
+			got, err := IfMatchVersion(c)
+			if tt.code != "" {
+				var domainErr domain.DomainError
+				if !errors.As(err, &domainErr) || domainErr.Code != tt.code {
+					t.Fatalf("IfMatchVersion() error = %v, want %s", err, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("IfMatchVersion() error = %v", err)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Fatalf("IfMatchVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionETag_ChangesWithRepresentation(t *testing.T) {
+	first := VersionETag(3, []byte(`{"order_count":1}`))
+	if first != VersionETag(3, []byte(`{"order_count":1}`)) {
+		t.Fatal("VersionETag() differs for the same representation")
+	}
+	if first == VersionETag(3, []byte(`{"order_count":2}`)) {
+		t.Fatal("VersionETag() is the same for another representation at the same version")
+	}
+}
+
+func intPtr(v int) *int { return &v }
@@ -4,15 +4,22 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 // Config holds all configuration for the application
 type Config struct {
-	App       AppConfig                 `mapstructure:"app"`
-	Databases map[string]DatabaseConfig `mapstructure:"databases"`
-	Modules   *ModulesConfig            `mapstructure:"modules"`
+	App         AppConfig                 `mapstructure:"app"`
+	Server      ServerConfig              `mapstructure:"server"`
+	Auth        AuthConfig                `mapstructure:"auth"`
+	Logging     LoggingConfig             `mapstructure:"logging"`
+	Tracing     TracingConfig             `mapstructure:"tracing"`
+	Debug       DebugConfig               `mapstructure:"debug"`
+	Idempotency IdempotencyConfig         `mapstructure:"idempotency"`
+	Databases   map[string]DatabaseConfig `mapstructure:"databases"`
+	Modules     *ModulesConfig            `mapstructure:"modules"`
 }
 
 // AppConfig holds application-specific configuration
@@ -24,6 +31,167 @@ type AppConfig struct {
 	GinMode     string `mapstructure:"gin_mode"`
 }
 
+// ServerConfig holds the limits protecting the HTTP server
+// Timeouts are durations such as "15s": ReadTimeout bounds reading a whole request including its body,
+// WriteTimeout writing the response and IdleTimeout keep-alive connections between requests
+// MaxBodyBytes is the default request body limit, which modules override per route with http.body_limits
+// HTTP2 serves HTTP/2 to clients that negotiate it, over TLS or as h2c over plaintext
+type ServerConfig struct {
+	ReadTimeout       string    `mapstructure:"read_timeout"`
+	ReadHeaderTimeout string    `mapstructure:"read_header_timeout"`
+	WriteTimeout      string    `mapstructure:"write_timeout"`
+	IdleTimeout       string    `mapstructure:"idle_timeout"`
+	MaxHeaderBytes    int       `mapstructure:"max_header_bytes"`
+	MaxBodyBytes      int64     `mapstructure:"max_body_bytes"`
+	HTTP2             bool      `mapstructure:"http2"`
+	TLS               TLSConfig `mapstructure:"tls"`
+	// ShutdownTimeout bounds the graceful shutdown on SIGINT or SIGTERM: in-flight requests, then
+	// the modules, workers and database connections
+	ShutdownTimeout string `mapstructure:"shutdown_timeout"`
+	// TrustedProxies lists the addresses or CIDRs of the load balancers in front of the server;
+	// the client IP is read from RemoteIPHeaders only on requests coming from them
+	TrustedProxies  []string `mapstructure:"trusted_proxies"`
+	RemoteIPHeaders []string `mapstructure:"remote_ip_headers"`
+	// AdminPort serves the probes, metrics and debug endpoints on a listener of their own
+	// instead of app.port; empty keeps them on app.port
+	AdminPort string `mapstructure:"admin_port"`
+}
+
+// TLSConfig holds the settings of TLS termination by the server, which then listens on app.port for HTTPS
+// The certificate comes from CertFile and KeyFile, or from Let's Encrypt when Autocert is enabled
+// RedirectHTTP starts a plaintext listener on HTTPPort redirecting to HTTPS, which also answers
+// Let's Encrypt HTTP challenges
+type TLSConfig struct {
+	Enabled      bool           `mapstructure:"enabled"`
+	CertFile     string         `mapstructure:"cert_file"`
+	KeyFile      string         `mapstructure:"key_file"`
+	Autocert     AutocertConfig `mapstructure:"autocert"`
+	RedirectHTTP bool           `mapstructure:"redirect_http"`
+	HTTPPort     string         `mapstructure:"http_port"`
+}
+
+// AutocertConfig holds the settings of certificates obtained from Let's Encrypt
+// Certificates are issued for Domains only and cached in CacheDir across restarts
+type AutocertConfig struct {
+	Enabled  bool     `mapstructure:"enabled"`
+	Domains  []string `mapstructure:"domains"`
+	CacheDir string   `mapstructure:"cache_dir"`
+	Email    string   `mapstructure:"email"`
+}
+
+// Validate checks that TLS, when enabled, has a source of certificates
+func (tc TLSConfig) Validate() error {
+	if !tc.Enabled {
+		return nil
+	}
+	if tc.Autocert.Enabled {
+		if len(tc.Autocert.Domains) == 0 {
+			return fmt.Errorf("server tls autocert requires at least one domain")
+		}
+		if tc.Autocert.CacheDir == "" {
+			return fmt.Errorf("server tls autocert requires a cache_dir")
+		}
+	} else if tc.CertFile == "" || tc.KeyFile == "" {
+		return fmt.Errorf("server tls requires cert_file and key_file, or autocert")
+	}
+	if tc.RedirectHTTP && tc.HTTPPort == "" {
+		return fmt.Errorf("server tls redirect_http requires an http_port")
+	}
+	return nil
+}
+
+// ServerTimeouts holds the parsed timeouts of ServerConfig
+type ServerTimeouts struct {
+	Read       time.Duration
+	ReadHeader time.Duration
+	Write      time.Duration
+	Idle       time.Duration
+	Shutdown   time.Duration
+}
+
+// GetTimeouts parses the server timeouts
+func (sc ServerConfig) GetTimeouts() (ServerTimeouts, error) {
+	var timeouts ServerTimeouts
+	for _, timeout := range []struct {
+		name  string
+		value string
+		into  *time.Duration
+	}{
+		{"read_timeout", sc.ReadTimeout, &timeouts.Read},
+		{"read_header_timeout", sc.ReadHeaderTimeout, &timeouts.ReadHeader},
+		{"write_timeout", sc.WriteTimeout, &timeouts.Write},
+		{"idle_timeout", sc.IdleTimeout, &timeouts.Idle},
+		{"shutdown_timeout", sc.ShutdownTimeout, &timeouts.Shutdown},
+	} {
+		duration, err := time.ParseDuration(timeout.value)
+		if err != nil || duration <= 0 {
+			return ServerTimeouts{}, fmt.Errorf("server %s must be a positive duration, got %q", timeout.name, timeout.value)
+		}
+		*timeout.into = duration
+	}
+	return timeouts, nil
+}
+
+// AuthConfig holds authentication configuration
+type AuthConfig struct {
+	JWT JWTConfig `mapstructure:"jwt"`
+}
+
+// JWTConfig holds the settings of the JWT access tokens issued at login
+// SigningKey is an HMAC-SHA256 secret (AUTH_JWT_SIGNING_KEY); Expiry is a duration such as "15m"
+type JWTConfig struct {
+	SigningKey string `mapstructure:"signing_key"`
+	Issuer     string `mapstructure:"issuer"`
+	Audience   string `mapstructure:"audience"`
+	Expiry     string `mapstructure:"expiry"`
+}
+
+// LoggingConfig holds the settings of the application logger
+// Level is debug, info, warn or error; Format is json, or console for human-readable development output
+type LoggingConfig struct {
+	Level  string `mapstructure:"level"`
+	Format string `mapstructure:"format"`
+}
+
+// TracingConfig holds the OpenTelemetry settings used when features.tracing_enabled is set
+// Endpoint is the host:port of an OTLP/HTTP collector; SampleRatio is the fraction of new traces recorded,
+// from 0 to 1, while requests from traced callers follow their caller's decision
+type TracingConfig struct {
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+// DebugConfig holds the settings of the profiling and runtime endpoints under /admin/debug
+// Enabled defaults to true in development only; the endpoints always require the debug:read permission
+type DebugConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// IdempotencyConfig holds the settings of the routes modules declare in http.idempotent
+// TTL is how long the first response for an Idempotency-Key is replayed, a duration such as "24h"
+// Store is memory (per instance, the default) or database, keeping the responses of each module's
+// routes in the idempotency_keys table of the module's own database
+type IdempotencyConfig struct {
+	TTL   string `mapstructure:"ttl"`
+	Store string `mapstructure:"store"`
+}
+
+// Idempotency stores
+const (
+	IdempotencyStoreMemory   = "memory"
+	IdempotencyStoreDatabase = "database"
+)
+
+// GetTTL parses the idempotency TTL
+func (ic IdempotencyConfig) GetTTL() (time.Duration, error) {
+	ttl, err := time.ParseDuration(ic.TTL)
+	if err != nil || ttl <= 0 {
+		return 0, fmt.Errorf("idempotency ttl must be a positive duration, got %q", ic.TTL)
+	}
+	return ttl, nil
+}
+
 // DatabaseConfig holds database configuration
 type DatabaseConfig struct {
 	Host     string `mapstructure:"host"`
@@ -32,6 +200,10 @@ type DatabaseConfig struct {
 	Password string `mapstructure:"password"`
 	Name     string `mapstructure:"name"`
 	SSLMode  string `mapstructure:"sslmode"`
+	// Pool settings; zero keeps the driver's default
+	MaxOpenConns    int           `mapstructure:"max_open_conns"`
+	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
 }
 
 // LoadConfig loads configuration from environment variables, Vault, and config files
@@ -56,6 +228,9 @@ func LoadConfig() (*Config, error) {
 		log.Println("No config file found, using environment variables and defaults")
 	}
 
+	// Defaults depending on the environment, known once the config file is read
+	viper.SetDefault("debug.enabled", viper.GetString("app.environment") == "development")
+
 	// Load modules configuration
 	modulesConfig, err := LoadModulesConfig()
 	if err != nil {
@@ -103,6 +278,47 @@ func setDefaults() {
 	viper.SetDefault("app.port", "8080")
 	viper.SetDefault("app.gin_mode", "debug")
 
+	// Server defaults
+	viper.SetDefault("server.read_timeout", "15s")
+	viper.SetDefault("server.read_header_timeout", "5s")
+	viper.SetDefault("server.write_timeout", "30s")
+	viper.SetDefault("server.idle_timeout", "60s")
+	viper.SetDefault("server.shutdown_timeout", "30s")
+	viper.SetDefault("server.max_header_bytes", 1<<20)
+	viper.SetDefault("server.max_body_bytes", 1<<20)
+	viper.SetDefault("server.http2", true)
+	viper.SetDefault("server.tls.enabled", false)
+	viper.SetDefault("server.tls.cert_file", "")
+	viper.SetDefault("server.tls.key_file", "")
+	viper.SetDefault("server.tls.autocert.enabled", false)
+	viper.SetDefault("server.tls.autocert.domains", []string{})
+	viper.SetDefault("server.tls.autocert.cache_dir", "./certs")
+	viper.SetDefault("server.tls.autocert.email", "")
+	viper.SetDefault("server.tls.redirect_http", false)
+	viper.SetDefault("server.tls.http_port", "80")
+	viper.SetDefault("server.trusted_proxies", []string{})
+	viper.SetDefault("server.remote_ip_headers", []string{"X-Forwarded-For", "X-Real-IP"})
+	viper.SetDefault("server.admin_port", "")
+
+	// Auth defaults
+	viper.SetDefault("auth.jwt.signing_key", "")
+	viper.SetDefault("auth.jwt.issuer", "modular-monolith")
+	viper.SetDefault("auth.jwt.audience", "")
+	viper.SetDefault("auth.jwt.expiry", "15m")
+
+	// Logging defaults
+	viper.SetDefault("logging.level", "info")
+	viper.SetDefault("logging.format", "json")
+
+	// Tracing defaults
+	viper.SetDefault("tracing.endpoint", "localhost:4318")
+	viper.SetDefault("tracing.insecure", true)
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+
+	// Idempotency defaults
+	viper.SetDefault("idempotency.ttl", "24h")
+	viper.SetDefault("idempotency.store", IdempotencyStoreMemory)
+
 	// Set dynamic database defaults based on modules configuration
 	setDynamicDatabaseDefaults()
 }
@@ -185,6 +401,64 @@ func validateConfig(config *Config) error {
 		return fmt.Errorf("app port is required")
 	}
 
+	if _, err := config.Server.GetTimeouts(); err != nil {
+		return err
+	}
+	if config.Server.MaxHeaderBytes <= 0 {
+		return fmt.Errorf("server max_header_bytes must be positive")
+	}
+	if config.Server.MaxBodyBytes <= 0 {
+		return fmt.Errorf("server max_body_bytes must be positive")
+	}
+	if err := config.Server.TLS.Validate(); err != nil {
+		return err
+	}
+	if port := config.Server.AdminPort; port != "" && (port == config.App.Port || (config.Server.TLS.RedirectHTTP && port == config.Server.TLS.HTTPPort)) {
+		return fmt.Errorf("server admin_port %s is already used by the public listener", port)
+	}
+
+	if config.Modules != nil {
+		rateLimiting := config.Modules.Global.HTTP.RateLimiting
+		if rateLimiting.Enabled && rateLimiting.RequestsPerMinute <= 0 {
+			return fmt.Errorf("rate_limiting requests_per_minute must be positive, got %d", rateLimiting.RequestsPerMinute)
+		}
+		if _, err := config.Modules.Global.Lifecycle.GetTimeouts(); err != nil {
+			return err
+		}
+		if err := config.Modules.ValidateFailurePolicies(); err != nil {
+			return err
+		}
+		if err := config.Modules.ValidateResources(); err != nil {
+			return err
+		}
+		if _, err := config.Modules.Global.Audit.GetRetention(); err != nil {
+			return err
+		}
+		if _, err := config.Modules.Global.Usage.GetFlushInterval(); err != nil {
+			return err
+		}
+		slowQuery := config.Modules.Global.Database.SlowQuery
+		if _, err := slowQuery.GetThreshold(); err != nil {
+			return err
+		}
+		if slowQuery.SampleRatio < 0 || slowQuery.SampleRatio > 1 {
+			return fmt.Errorf("database slow_query sample ratio must be between 0 and 1, got %v", slowQuery.SampleRatio)
+		}
+	}
+
+	if _, err := config.Idempotency.GetTTL(); err != nil {
+		return err
+	}
+	switch config.Idempotency.Store {
+	case IdempotencyStoreMemory, IdempotencyStoreDatabase:
+	default:
+		return fmt.Errorf("idempotency store must be %s or %s, got %q", IdempotencyStoreMemory, IdempotencyStoreDatabase, config.Idempotency.Store)
+	}
+
+	if config.Tracing.SampleRatio < 0 || config.Tracing.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample ratio must be between 0 and 1, got %v", config.Tracing.SampleRatio)
+	}
+
 	// Validate database configs
 	for name, dbConfig := range config.Databases {
 		if dbConfig.Host == "" {
@@ -406,6 +680,13 @@ func convertModulesConfigToDatabaseConfig(config *Config, modulesConfig *Modules
 				dbConfig.SSLMode = "disable"
 			}
 
+			// Pool settings of the module, else the global defaults, capped by the module's budget
+			pool, err := modulePoolSettings(moduleConfig, modulesConfig.Global.Database)
+			if err != nil {
+				return fmt.Errorf("invalid database pool of module %s: %w", moduleName, err)
+			}
+			dbConfig.MaxOpenConns, dbConfig.MaxIdleConns, dbConfig.ConnMaxLifetime = pool.MaxOpenConns, pool.MaxIdleConns, pool.ConnMaxLifetime
+
 			config.Databases[moduleName] = dbConfig
 			log.Printf("🔧 Converted database config for module: %s", moduleName)
 		}
@@ -413,3 +694,38 @@ func convertModulesConfigToDatabaseConfig(config *Config, modulesConfig *Modules
 
 	return nil
 }
+
+// modulePoolSettings returns the pool settings of a module's database: its own, else the global
+// defaults, with the open connections capped by resources.max_db_connections
+func modulePoolSettings(module ModuleConfig, global DatabaseGlobalConfig) (DatabaseConfig, error) {
+	pool := DatabaseConfig{
+		MaxOpenConns: module.Database.MaxOpenConns,
+		MaxIdleConns: module.Database.MaxIdleConns,
+	}
+	if pool.MaxOpenConns == 0 {
+		pool.MaxOpenConns = global.DefaultMaxOpenConns
+	}
+	if pool.MaxIdleConns == 0 {
+		pool.MaxIdleConns = global.DefaultMaxIdleConns
+	}
+
+	lifetime := module.Database.ConnMaxLifetime
+	if lifetime == "" {
+		lifetime = global.DefaultConnMaxLifetime
+	}
+	if lifetime != "" {
+		duration, err := time.ParseDuration(lifetime)
+		if err != nil {
+			return DatabaseConfig{}, fmt.Errorf("conn_max_lifetime %q: %w", lifetime, err)
+		}
+		pool.ConnMaxLifetime = duration
+	}
+
+	if budget := module.Resources.MaxDBConnections; budget > 0 && (pool.MaxOpenConns == 0 || pool.MaxOpenConns > budget) {
+		pool.MaxOpenConns = budget
+	}
+	if pool.MaxOpenConns > 0 && pool.MaxIdleConns > pool.MaxOpenConns {
+		pool.MaxIdleConns = pool.MaxOpenConns
+	}
+	return pool, nil
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/go-viper/mapstructure/v2"
+)
+
+// ResolvedModuleConfig is the configuration a module receives in Initialize: its merged
+// ModuleConfig, and its custom settings, the section named after the module
+type ResolvedModuleConfig struct {
+	Name string
+	ModuleConfig
+}
+
+// ResolveModule returns the resolved configuration of a module
+func (mc *ModulesConfig) ResolveModule(moduleName string) ResolvedModuleConfig {
+	return ResolvedModuleConfig{Name: moduleName, ModuleConfig: mc.Modules[moduleName]}
+}
+
+// Settings returns the module's custom settings, nil when none are configured
+func (rc ResolvedModuleConfig) Settings() map[string]interface{} {
+	settings, _ := rc.Custom[rc.Name].(map[string]interface{})
+	return settings
+}
+
+// Section returns the named section of the module's custom settings, nil when it is not configured
+func (rc ResolvedModuleConfig) Section(name string) map[string]interface{} {
+	section, _ := rc.Settings()[name].(map[string]interface{})
+	return section
+}
+
+// Decode decodes the named section of the module's custom settings into the struct out points to
+// Durations are parsed from strings such as "30s", scalars are converted weakly (YAML and env
+// substitutions yield strings), and fields not configured keep their values
+func (rc ResolvedModuleConfig) Decode(section string, out interface{}) error {
+	input := rc.Settings()
+	if section != "" {
+		input = rc.Section(section)
+	}
+	if input == nil {
+		return nil
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToSliceHookFunc(","),
+		),
+		WeaklyTypedInput: true,
+		Result:           out,
+	})
+	if err != nil {
+		return err
+	}
+	if err := decoder.Decode(input); err != nil {
+		if section == "" {
+			return fmt.Errorf("invalid %s settings: %w", rc.Name, err)
+		}
+		return fmt.Errorf("invalid %s.%s settings: %w", rc.Name, section, err)
+	}
+	return nil
+}
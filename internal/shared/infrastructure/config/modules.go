@@ -27,12 +27,16 @@ type FlexibleModulesConfig struct {
 
 // ModuleConfig represents configuration for a single module
 type ModuleConfig struct {
-	Enabled   bool                 `yaml:"enabled" mapstructure:"enabled"`
-	Database  ModuleDatabaseConfig `yaml:"database" mapstructure:"database"`
-	Migration MigrationConfig      `yaml:"migration" mapstructure:"migration"`
-	Vault     ModuleVaultConfig    `yaml:"vault" mapstructure:"vault"`
-	HTTP      HTTPConfig           `yaml:"http" mapstructure:"http"`
-	Features  FeatureConfig        `yaml:"features" mapstructure:"features"`
+	Enabled   bool                  `yaml:"enabled" mapstructure:"enabled"`
+	Database  ModuleDatabaseConfig  `yaml:"database" mapstructure:"database"`
+	Migration MigrationConfig       `yaml:"migration" mapstructure:"migration"`
+	Vault     ModuleVaultConfig     `yaml:"vault" mapstructure:"vault"`
+	HTTP      HTTPConfig            `yaml:"http" mapstructure:"http"`
+	Features  FeatureConfig         `yaml:"features" mapstructure:"features"`
+	Health    ModuleHealthConfig    `yaml:"health" mapstructure:"health"`
+	Lifecycle ModuleLifecycleConfig `yaml:"lifecycle" mapstructure:"lifecycle"`
+	Resources ModuleResourcesConfig `yaml:"resources" mapstructure:"resources"`
+	Logging   ModuleLoggingConfig   `yaml:"logging" mapstructure:"logging"`
 	// Module-specific metadata
 	Module ModuleMetadata `yaml:"module" mapstructure:"module"`
 	// Custom module-specific settings (stored as map for flexibility)
@@ -44,6 +48,23 @@ type ModuleMetadata struct {
 	Name        string `yaml:"name" mapstructure:"name"`
 	Version     string `yaml:"version" mapstructure:"version"`
 	Description string `yaml:"description" mapstructure:"description"`
+	// DependsOn names the modules that must be enabled, initialized and started before this one,
+	// each optionally followed by a constraint on its version, e.g. "customer ^1.0"
+	DependsOn []string `yaml:"depends_on" mapstructure:"depends_on"`
+	// SchemaVersion is the migration version the module's code requires; startup is refused while
+	// the module database is behind it. Zero skips the check
+	SchemaVersion uint `yaml:"schema_version" mapstructure:"schema_version"`
+}
+
+// DependencyNames returns the names of the modules in DependsOn, without their version constraints
+func (mm ModuleMetadata) DependencyNames() []string {
+	names := make([]string, 0, len(mm.DependsOn))
+	for _, dependency := range mm.DependsOn {
+		if fields := strings.Fields(dependency); len(fields) > 0 {
+			names = append(names, fields[0])
+		}
+	}
+	return names
 }
 
 // ModuleDatabaseConfig represents database configuration for a module
@@ -71,11 +92,86 @@ type ModuleVaultConfig struct {
 	Enabled bool   `yaml:"enabled" mapstructure:"enabled"`
 }
 
+// DefaultHTTPPrefix is the path module routes are mounted under when http.prefix is not set
+const DefaultHTTPPrefix = "/api/v1"
+
 // HTTPConfig represents HTTP configuration for a module
 type HTTPConfig struct {
+	// Prefix is the path the module's routes are mounted under, e.g. "/api/v1" serves the
+	// customer module's "/customers" routes at "/api/v1/customers"
 	Prefix     string   `yaml:"prefix" mapstructure:"prefix"`
 	Enabled    bool     `yaml:"enabled" mapstructure:"enabled"`
 	Middleware []string `yaml:"middleware" mapstructure:"middleware"`
+	// Routes declares the permissions required per route, keyed by "<path> <METHOD>"
+	// relative to Prefix, e.g. "/customers POST": ["customers:write"]
+	Routes map[string][]string `yaml:"routes" mapstructure:"routes"`
+	// BodyLimits overrides the server's max_body_bytes per route, keyed like Routes,
+	// e.g. "/products/import POST": 10485760
+	BodyLimits map[string]int64 `yaml:"body_limits" mapstructure:"body_limits"`
+	// Idempotent lists the routes replaying their first response to retries with the same
+	// Idempotency-Key, as "<path> <METHOD>" relative to Prefix, e.g. "/customers POST"
+	Idempotent []string `yaml:"idempotent" mapstructure:"idempotent"`
+	// Versions serves the module under several API versions, keyed by version name (e.g. "v1"),
+	// instead of Prefix alone
+	Versions map[string]HTTPVersionConfig `yaml:"versions" mapstructure:"versions"`
+}
+
+// HTTPVersionConfig maps an API version of a module to the path it is served under and its lifecycle
+// Dates are "2006-01-02" or RFC 3339; a deprecated version answers with Deprecation and Sunset headers
+type HTTPVersionConfig struct {
+	Prefix string `yaml:"prefix" mapstructure:"prefix"`
+	// Handlers names the route set of the module served under the version, defaulting to the
+	// version name, so that e.g. v2 can keep serving v1's routes until they change
+	Handlers     string `yaml:"handlers" mapstructure:"handlers"`
+	Deprecated   bool   `yaml:"deprecated" mapstructure:"deprecated"`
+	DeprecatedAt string `yaml:"deprecated_at" mapstructure:"deprecated_at"`
+	Sunset       string `yaml:"sunset" mapstructure:"sunset"`
+	// Link is the URL of the migration guide, sent as a Link header with rel="deprecation"
+	Link string `yaml:"link" mapstructure:"link"`
+}
+
+// GetPrefix returns the path the module's routes are mounted under, with default fallback
+func (hc HTTPConfig) GetPrefix() string {
+	prefix := strings.TrimSuffix(strings.TrimSpace(hc.Prefix), "/")
+	if prefix == "" {
+		return DefaultHTTPPrefix
+	}
+	return prefix
+}
+
+// ModuleHealthConfig represents how a module's health affects the readiness of the service
+type ModuleHealthConfig struct {
+	// Critical modules, the default, make the service unready while they or their database are
+	// down; the others are reported by /readyz without affecting its status
+	Critical *bool `yaml:"critical" mapstructure:"critical"`
+}
+
+// IsCritical reports whether the module must be healthy for the service to be ready
+func (hc ModuleHealthConfig) IsCritical() bool {
+	return hc.Critical == nil || *hc.Critical
+}
+
+// ModuleLifecycleConfig represents lifecycle settings of a module
+type ModuleLifecycleConfig struct {
+	// FailurePolicy overrides global.lifecycle.failure_policy for the module
+	FailurePolicy string `yaml:"failure_policy" mapstructure:"failure_policy"`
+}
+
+// ModuleResourcesConfig caps the shared resources a module may use, so that a misbehaving module
+// cannot starve the others; zero leaves a resource unlimited
+type ModuleResourcesConfig struct {
+	// MaxDBConnections caps the open connections of the module's database pool
+	MaxDBConnections int `yaml:"max_db_connections" mapstructure:"max_db_connections"`
+	// MaxBackgroundJobs caps the workers the module runs at once
+	MaxBackgroundJobs int `yaml:"max_background_jobs" mapstructure:"max_background_jobs"`
+	// MaxEventHandlers caps the events the module's handlers process at once
+	MaxEventHandlers int `yaml:"max_event_handlers" mapstructure:"max_event_handlers"`
+}
+
+// ModuleLoggingConfig represents logging settings of a module
+type ModuleLoggingConfig struct {
+	// Level overrides logging.level for the module's logger: debug, info, warn or error
+	Level string `yaml:"level" mapstructure:"level"`
 }
 
 // FeatureConfig represents feature flags for a module
@@ -90,16 +186,302 @@ type GlobalConfig struct {
 	Vault    VaultGlobalConfig    `yaml:"vault" mapstructure:"vault"`
 	HTTP     HTTPGlobalConfig     `yaml:"http" mapstructure:"http"`
 	Features FeatureGlobalConfig  `yaml:"features" mapstructure:"features"`
+	// Lifecycle bounds each call of a module's lifecycle phases
+	Lifecycle LifecycleGlobalConfig `yaml:"lifecycle" mapstructure:"lifecycle"`
+	// Plugins lists the paths of out-of-tree modules built with -buildmode=plugin
+	Plugins []string            `yaml:"plugins" mapstructure:"plugins"`
+	Audit   AuditGlobalConfig   `yaml:"audit" mapstructure:"audit"`
+	Usage   UsageGlobalConfig   `yaml:"usage" mapstructure:"usage"`
+	Lock    LockGlobalConfig    `yaml:"lock" mapstructure:"lock"`
+	Cache   CacheGlobalConfig   `yaml:"cache" mapstructure:"cache"`
+	Search  SearchGlobalConfig  `yaml:"search" mapstructure:"search"`
+	Tenancy TenancyGlobalConfig `yaml:"tenancy" mapstructure:"tenancy"`
+	Mail    MailGlobalConfig    `yaml:"mail" mapstructure:"mail"`
+	// Retention schedules the retention policies the modules declare
+	Retention RetentionGlobalConfig `yaml:"retention" mapstructure:"retention"`
+}
+
+// AuditGlobalConfig represents the settings of the audit log
+// Database names the module database keeping the log, which is kept in memory without it;
+// Retention is how long entries are kept, forever when empty
+type AuditGlobalConfig struct {
+	Database  string `yaml:"database" mapstructure:"database"`
+	Retention string `yaml:"retention" mapstructure:"retention"`
+}
+
+// GetRetention parses the retention period, zero when entries are kept forever
+func (ac AuditGlobalConfig) GetRetention() (time.Duration, error) {
+	if ac.Retention == "" {
+		return 0, nil
+	}
+	retention, err := time.ParseDuration(ac.Retention)
+	if err != nil || retention <= 0 {
+		return 0, fmt.Errorf("audit retention must be a positive duration, got %q", ac.Retention)
+	}
+	return retention, nil
+}
+
+// UsageGlobalConfig represents the settings of usage analytics
+// Database names the module database keeping the daily request counts, which are kept in memory
+// without it; FlushInterval is how often the counts are written to it, 1m when empty
+type UsageGlobalConfig struct {
+	Database      string `yaml:"database" mapstructure:"database"`
+	FlushInterval string `yaml:"flush_interval" mapstructure:"flush_interval"`
+}
+
+// GetFlushInterval parses the flush interval, zero when it is not set
+func (uc UsageGlobalConfig) GetFlushInterval() (time.Duration, error) {
+	if uc.FlushInterval == "" {
+		return 0, nil
+	}
+	interval, err := time.ParseDuration(uc.FlushInterval)
+	if err != nil || interval <= 0 {
+		return 0, fmt.Errorf("usage flush_interval must be a positive duration, got %q", uc.FlushInterval)
+	}
+	return interval, nil
+}
+
+// LockGlobalConfig represents the settings of the locks shared by the instances of the application
+// Backend is memory (one instance only, the default), postgres or redis; Database names the module
+// database holding advisory locks, and Redis the server holding lock keys for TTL past their last
+// renewal, 30s when empty
+type LockGlobalConfig struct {
+	Backend  string          `yaml:"backend" mapstructure:"backend"`
+	Database string          `yaml:"database" mapstructure:"database"`
+	Redis    LockRedisConfig `yaml:"redis" mapstructure:"redis"`
+	TTL      string          `yaml:"ttl" mapstructure:"ttl"`
+}
+
+// LockRedisConfig represents the Redis server of the redis lock backend
+type LockRedisConfig struct {
+	Addr     string `yaml:"addr" mapstructure:"addr"`
+	Password string `yaml:"password" mapstructure:"password"`
+	DB       int    `yaml:"db" mapstructure:"db"`
+}
+
+// GetTTL parses the TTL of Redis locks, zero when it is not set
+func (lc LockGlobalConfig) GetTTL() (time.Duration, error) {
+	if lc.TTL == "" {
+		return 0, nil
+	}
+	ttl, err := time.ParseDuration(lc.TTL)
+	if err != nil || ttl <= 0 {
+		return 0, fmt.Errorf("lock ttl must be a positive duration, got %q", lc.TTL)
+	}
+	return ttl, nil
+}
+
+// CacheGlobalConfig represents the settings of the cache shared by the modules enabling caching
+// Backend is memory (per instance, the default) holding at most MaxCost bytes of values, 64MB when
+// zero, or redis, shared by the instances
+type CacheGlobalConfig struct {
+	Backend string           `yaml:"backend" mapstructure:"backend"`
+	MaxCost int64            `yaml:"max_cost" mapstructure:"max_cost"`
+	Redis   CacheRedisConfig `yaml:"redis" mapstructure:"redis"`
+}
+
+// CacheRedisConfig represents the Redis server of the redis cache backend
+type CacheRedisConfig struct {
+	Addr     string `yaml:"addr" mapstructure:"addr"`
+	Password string `yaml:"password" mapstructure:"password"`
+	DB       int    `yaml:"db" mapstructure:"db"`
+}
+
+// SearchGlobalConfig represents the search engine modules index their read models in
+// Backend is elasticsearch or opensearch, at URL; without it, modules search their databases.
+// Index names start with IndexPrefix, and requests time out after Timeout, 5s when empty
+type SearchGlobalConfig struct {
+	Backend     string `yaml:"backend" mapstructure:"backend"`
+	URL         string `yaml:"url" mapstructure:"url"`
+	Username    string `yaml:"username" mapstructure:"username"`
+	Password    string `yaml:"password" mapstructure:"password"`
+	IndexPrefix string `yaml:"index_prefix" mapstructure:"index_prefix"`
+	Timeout     string `yaml:"timeout" mapstructure:"timeout"`
+	Retries     int    `yaml:"retries" mapstructure:"retries"`
+}
+
+// GetTimeout parses the timeout of search requests, zero when it is not set
+func (sc SearchGlobalConfig) GetTimeout() (time.Duration, error) {
+	if sc.Timeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(sc.Timeout)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("search timeout must be a positive duration, got %q", sc.Timeout)
+	}
+	return timeout, nil
+}
+
+// TenancyGlobalConfig represents the settings of multi-tenancy
+// When enabled, the tenant middleware of a module's routes resolves the tenant of each request with
+// Resolvers (header, query, subdomain and claim, all of them when empty) and answers requests naming no
+// tenant, an unknown tenant or different tenants with an error. Header is the tenant header,
+// X-Tenant-ID when empty, and BaseDomain the domain under which tenants are served as subdomains
+type TenancyGlobalConfig struct {
+	Enabled    bool           `yaml:"enabled" mapstructure:"enabled"`
+	Resolvers  []string       `yaml:"resolvers" mapstructure:"resolvers"`
+	Header     string         `yaml:"header" mapstructure:"header"`
+	BaseDomain string         `yaml:"base_domain" mapstructure:"base_domain"`
+	Tenants    []TenantConfig `yaml:"tenants" mapstructure:"tenants"`
+}
+
+// TenantConfig represents a tenant of the registry
+// Subdomain defaults to the ID; Modules lists the modules the tenant may use, all of them when empty,
+// and Features overrides the feature flags of its requests by name
+type TenantConfig struct {
+	ID        string          `yaml:"id" mapstructure:"id"`
+	Name      string          `yaml:"name" mapstructure:"name"`
+	Subdomain string          `yaml:"subdomain" mapstructure:"subdomain"`
+	Disabled  bool            `yaml:"disabled" mapstructure:"disabled"`
+	Modules   []string        `yaml:"modules" mapstructure:"modules"`
+	Features  map[string]bool `yaml:"features" mapstructure:"features"`
+}
+
+// MailGlobalConfig represents the outbound email of the modules
+// Transport is sandbox (the default), capturing emails in Sandbox.Path where /admin/mail shows them,
+// or smtp, delivering them through SMTP; Environments replaces both for the app.environment it is
+// keyed by. Each module sends from its address in Senders, or From. An instance sends at most
+// RateLimit emails per second, without limit when zero, and signs them with DKIM when configured
+type MailGlobalConfig struct {
+	Transport    string                           `yaml:"transport" mapstructure:"transport"`
+	From         string                           `yaml:"from" mapstructure:"from"`
+	Senders      map[string]string                `yaml:"senders" mapstructure:"senders"`
+	RateLimit    float64                          `yaml:"rate_limit" mapstructure:"rate_limit"`
+	SMTP         MailSMTPConfig                   `yaml:"smtp" mapstructure:"smtp"`
+	DKIM         MailDKIMConfig                   `yaml:"dkim" mapstructure:"dkim"`
+	Sandbox      MailSandboxConfig                `yaml:"sandbox" mapstructure:"sandbox"`
+	Environments map[string]MailEnvironmentConfig `yaml:"environments" mapstructure:"environments"`
+}
+
+// MailSMTPConfig represents the SMTP server of the smtp transport
+// ImplicitTLS connects over TLS (port 465), otherwise STARTTLS is used when the server offers it;
+// Timeout bounds the exchange of each email, 30s when empty
+type MailSMTPConfig struct {
+	Host        string `yaml:"host" mapstructure:"host"`
+	Port        int    `yaml:"port" mapstructure:"port"`
+	Username    string `yaml:"username" mapstructure:"username"`
+	Password    string `yaml:"password" mapstructure:"password"`
+	ImplicitTLS bool   `yaml:"implicit_tls" mapstructure:"implicit_tls"`
+	Timeout     string `yaml:"timeout" mapstructure:"timeout"`
+}
+
+// GetTimeout parses the SMTP timeout, zero when it is not set
+func (sc MailSMTPConfig) GetTimeout() (time.Duration, error) {
+	if sc.Timeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(sc.Timeout)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("mail smtp timeout must be a positive duration, got %q", sc.Timeout)
+	}
+	return timeout, nil
+}
+
+// MailDKIMConfig represents the DKIM signature of outbound emails, made when Domain is set
+// The RSA or Ed25519 key is the PEM of PrivateKey, or of the file at PrivateKeyFile, published in
+// DNS at <Selector>._domainkey.<Domain>
+type MailDKIMConfig struct {
+	Domain         string `yaml:"domain" mapstructure:"domain"`
+	Selector       string `yaml:"selector" mapstructure:"selector"`
+	PrivateKey     string `yaml:"private_key" mapstructure:"private_key"`
+	PrivateKeyFile string `yaml:"private_key_file" mapstructure:"private_key_file"`
+}
+
+// MailSandboxConfig represents the store of the sandbox transport
+// Path is the directory of the captured emails, ./data/mail when empty, keeping the latest Keep of
+// them, 500 when zero
+type MailSandboxConfig struct {
+	Path string `yaml:"path" mapstructure:"path"`
+	Keep int    `yaml:"keep" mapstructure:"keep"`
+}
+
+// MailEnvironmentConfig represents the transport of one environment
+type MailEnvironmentConfig struct {
+	Transport string         `yaml:"transport" mapstructure:"transport"`
+	SMTP      MailSMTPConfig `yaml:"smtp" mapstructure:"smtp"`
+}
+
+// ForEnvironment returns the settings of an environment: its transport and SMTP server, when set,
+// replace those configured for all environments
+func (mc MailGlobalConfig) ForEnvironment(environment string) MailGlobalConfig {
+	override, ok := mc.Environments[environment]
+	if !ok {
+		return mc
+	}
+	if override.Transport != "" {
+		mc.Transport = override.Transport
+	}
+	if override.SMTP.Host != "" {
+		mc.SMTP = override.SMTP
+	}
+	return mc
+}
+
+// RetentionGlobalConfig represents the scheduling of the modules' retention policies
+// When enabled, each policy is applied every Interval, 24h when empty, removing BatchSize records per
+// transaction, 500 when zero; DryRun only reports what is due. Archive keeps a copy of archived
+// records besides the cold tables of the modules
+type RetentionGlobalConfig struct {
+	Enabled   bool                   `yaml:"enabled" mapstructure:"enabled"`
+	Interval  string                 `yaml:"interval" mapstructure:"interval"`
+	BatchSize int                    `yaml:"batch_size" mapstructure:"batch_size"`
+	DryRun    bool                   `yaml:"dry_run" mapstructure:"dry_run"`
+	Archive   RetentionArchiveConfig `yaml:"archive" mapstructure:"archive"`
+}
+
+// RetentionArchiveConfig represents the object archive of retention policies
+// Backend is local, writing under Path (./data/archive when empty), or s3; archived records are
+// stored as gzipped JSON lines. Without a backend, records are only moved to cold tables
+type RetentionArchiveConfig struct {
+	Backend string            `yaml:"backend" mapstructure:"backend"`
+	Path    string            `yaml:"path" mapstructure:"path"`
+	S3      RetentionS3Config `yaml:"s3" mapstructure:"s3"`
+}
+
+// RetentionS3Config represents the bucket of the s3 archive
+// Endpoint defaults to the AWS endpoint of the region; keys start with Prefix, and each upload
+// attempt is bounded by Timeout, 30s when empty
+type RetentionS3Config struct {
+	Bucket          string `yaml:"bucket" mapstructure:"bucket"`
+	Region          string `yaml:"region" mapstructure:"region"`
+	Endpoint        string `yaml:"endpoint" mapstructure:"endpoint"`
+	PathStyle       bool   `yaml:"path_style" mapstructure:"path_style"`
+	AccessKeyID     string `yaml:"access_key_id" mapstructure:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key" mapstructure:"secret_access_key"`
+	Prefix          string `yaml:"prefix" mapstructure:"prefix"`
+	Timeout         string `yaml:"timeout" mapstructure:"timeout"`
+	Retries         int    `yaml:"retries" mapstructure:"retries"`
 }
 
 // DatabaseGlobalConfig represents global database settings
 type DatabaseGlobalConfig struct {
-	DefaultMaxOpenConns    int    `yaml:"default_max_open_conns" mapstructure:"default_max_open_conns"`
-	DefaultMaxIdleConns    int    `yaml:"default_max_idle_conns" mapstructure:"default_max_idle_conns"`
-	DefaultConnMaxLifetime string `yaml:"default_conn_max_lifetime" mapstructure:"default_conn_max_lifetime"`
-	HealthCheckInterval    string `yaml:"health_check_interval" mapstructure:"health_check_interval"`
-	ConnectionTimeout      string `yaml:"connection_timeout" mapstructure:"connection_timeout"`
-	DatabasePrefix         string `yaml:"database_prefix" mapstructure:"database_prefix"`
+	DefaultMaxOpenConns    int             `yaml:"default_max_open_conns" mapstructure:"default_max_open_conns"`
+	DefaultMaxIdleConns    int             `yaml:"default_max_idle_conns" mapstructure:"default_max_idle_conns"`
+	DefaultConnMaxLifetime string          `yaml:"default_conn_max_lifetime" mapstructure:"default_conn_max_lifetime"`
+	HealthCheckInterval    string          `yaml:"health_check_interval" mapstructure:"health_check_interval"`
+	ConnectionTimeout      string          `yaml:"connection_timeout" mapstructure:"connection_timeout"`
+	DatabasePrefix         string          `yaml:"database_prefix" mapstructure:"database_prefix"`
+	SlowQuery              SlowQueryConfig `yaml:"slow_query" mapstructure:"slow_query"`
+}
+
+// SlowQueryConfig represents the logging of slow database queries
+// Queries running longer than Threshold are counted, and SampleRatio of them logged, from 0 to 1,
+// zero logging all of them; an empty Threshold disables it
+type SlowQueryConfig struct {
+	Threshold   string  `yaml:"threshold" mapstructure:"threshold"`
+	SampleRatio float64 `yaml:"sample_ratio" mapstructure:"sample_ratio"`
+}
+
+// GetThreshold parses the threshold, zero when it is not set
+func (sc SlowQueryConfig) GetThreshold() (time.Duration, error) {
+	if sc.Threshold == "" {
+		return 0, nil
+	}
+	threshold, err := time.ParseDuration(sc.Threshold)
+	if err != nil || threshold <= 0 {
+		return 0, fmt.Errorf("database slow_query threshold must be a positive duration, got %q", sc.Threshold)
+	}
+	return threshold, nil
 }
 
 // VaultGlobalConfig represents global Vault settings
@@ -109,10 +491,24 @@ type VaultGlobalConfig struct {
 	Enabled    bool   `yaml:"enabled" mapstructure:"enabled"`
 }
 
+// DefaultMiddleware is the middleware of modules when neither the module nor global.http lists any
+var DefaultMiddleware = []string{"cors", "logging", "recovery", "request_id"}
+
 // HTTPGlobalConfig represents global HTTP settings
+// DefaultMiddleware is the http.middleware list of modules that declare none
 type HTTPGlobalConfig struct {
 	DefaultMiddleware []string        `yaml:"default_middleware" mapstructure:"default_middleware"`
 	RateLimiting      RateLimitConfig `yaml:"rate_limiting" mapstructure:"rate_limiting"`
+	// IPRules restricts paths to, or blocks them from, client IP ranges
+	IPRules []IPRuleConfig `yaml:"ip_rules" mapstructure:"ip_rules"`
+}
+
+// IPRuleConfig limits the clients of Path and the paths below it by CIDR or address
+// Clients in Deny are rejected; when Allow is not empty, only clients in it are accepted
+type IPRuleConfig struct {
+	Path  string   `yaml:"path" mapstructure:"path"`
+	Allow []string `yaml:"allow" mapstructure:"allow"`
+	Deny  []string `yaml:"deny" mapstructure:"deny"`
 }
 
 // RateLimitConfig represents rate limiting configuration
@@ -121,6 +517,71 @@ type RateLimitConfig struct {
 	RequestsPerMinute int  `yaml:"requests_per_minute" mapstructure:"requests_per_minute"`
 }
 
+// Default lifecycle timeouts
+const (
+	DefaultInitTimeout  = 30 * time.Second
+	DefaultStartTimeout = 30 * time.Second
+	DefaultStopTimeout  = 30 * time.Second
+)
+
+// LifecycleGlobalConfig holds the timeouts of the module lifecycle phases, durations such as "30s"
+// Each bounds a single call, e.g. one module's Start or one of its hooks
+type LifecycleGlobalConfig struct {
+	InitTimeout  string `yaml:"init_timeout" mapstructure:"init_timeout"`
+	StartTimeout string `yaml:"start_timeout" mapstructure:"start_timeout"`
+	StopTimeout  string `yaml:"stop_timeout" mapstructure:"stop_timeout"`
+	// FailurePolicy tells what happens when a module fails to initialize or start: abort, the
+	// default, fails startup; skip leaves the module out; degrade keeps it registered as down
+	FailurePolicy string `yaml:"failure_policy" mapstructure:"failure_policy"`
+}
+
+// Failure policies of modules
+const (
+	FailurePolicyAbort   = "abort"
+	FailurePolicySkip    = "skip"
+	FailurePolicyDegrade = "degrade"
+)
+
+// validateFailurePolicy fails for policies other than abort, skip and degrade; empty is allowed
+func validateFailurePolicy(where, policy string) error {
+	switch policy {
+	case "", FailurePolicyAbort, FailurePolicySkip, FailurePolicyDegrade:
+		return nil
+	}
+	return fmt.Errorf("%s failure_policy must be abort, skip or degrade, got %q", where, policy)
+}
+
+// LifecycleTimeouts holds the parsed timeouts of LifecycleGlobalConfig
+type LifecycleTimeouts struct {
+	Init  time.Duration
+	Start time.Duration
+	Stop  time.Duration
+}
+
+// GetTimeouts parses the lifecycle timeouts, with defaults for those not set
+func (lc LifecycleGlobalConfig) GetTimeouts() (LifecycleTimeouts, error) {
+	timeouts := LifecycleTimeouts{Init: DefaultInitTimeout, Start: DefaultStartTimeout, Stop: DefaultStopTimeout}
+	for _, timeout := range []struct {
+		name  string
+		value string
+		into  *time.Duration
+	}{
+		{"init_timeout", lc.InitTimeout, &timeouts.Init},
+		{"start_timeout", lc.StartTimeout, &timeouts.Start},
+		{"stop_timeout", lc.StopTimeout, &timeouts.Stop},
+	} {
+		if timeout.value == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(timeout.value)
+		if err != nil || duration <= 0 {
+			return LifecycleTimeouts{}, fmt.Errorf("lifecycle %s must be a positive duration, got %q", timeout.name, timeout.value)
+		}
+		*timeout.into = duration
+	}
+	return timeouts, nil
+}
+
 // FeatureGlobalConfig represents global feature flags
 type FeatureGlobalConfig struct {
 	EventsEnabled  bool `yaml:"events_enabled" mapstructure:"events_enabled"`
@@ -442,7 +903,7 @@ func createDefaultModuleConfig(moduleName string) *ModuleConfig {
 			Enabled: false,
 		},
 		HTTP: HTTPConfig{
-			Prefix:  fmt.Sprintf("/api/v1/%ss", moduleName),
+			Prefix:  DefaultHTTPPrefix,
 			Enabled: true,
 		},
 		Features: FeatureConfig{
@@ -600,6 +1061,32 @@ func mergeModuleConfig(base, override ModuleConfig) ModuleConfig {
 	if len(override.HTTP.Middleware) > 0 {
 		result.HTTP.Middleware = override.HTTP.Middleware
 	}
+	if len(override.HTTP.Routes) > 0 {
+		routes := make(map[string][]string, len(base.HTTP.Routes)+len(override.HTTP.Routes))
+		for route, permissions := range base.HTTP.Routes {
+			routes[route] = permissions
+		}
+		for route, permissions := range override.HTTP.Routes {
+			routes[route] = permissions
+		}
+		result.HTTP.Routes = routes
+	}
+	if len(override.HTTP.BodyLimits) > 0 {
+		limits := make(map[string]int64, len(base.HTTP.BodyLimits)+len(override.HTTP.BodyLimits))
+		for route, limit := range base.HTTP.BodyLimits {
+			limits[route] = limit
+		}
+		for route, limit := range override.HTTP.BodyLimits {
+			limits[route] = limit
+		}
+		result.HTTP.BodyLimits = limits
+	}
+	if len(override.HTTP.Idempotent) > 0 {
+		result.HTTP.Idempotent = override.HTTP.Idempotent
+	}
+	if len(override.HTTP.Versions) > 0 {
+		result.HTTP.Versions = override.HTTP.Versions
+	}
 
 	// Merge features
 	if override.Features.EventsEnabled != base.Features.EventsEnabled {
@@ -609,6 +1096,25 @@ func mergeModuleConfig(base, override ModuleConfig) ModuleConfig {
 		result.Features.CachingEnabled = override.Features.CachingEnabled
 	}
 
+	if override.Resources.MaxDBConnections != 0 {
+		result.Resources.MaxDBConnections = override.Resources.MaxDBConnections
+	}
+	if override.Resources.MaxBackgroundJobs != 0 {
+		result.Resources.MaxBackgroundJobs = override.Resources.MaxBackgroundJobs
+	}
+	if override.Resources.MaxEventHandlers != 0 {
+		result.Resources.MaxEventHandlers = override.Resources.MaxEventHandlers
+	}
+	if override.Logging.Level != "" {
+		result.Logging.Level = override.Logging.Level
+	}
+	if override.Lifecycle.FailurePolicy != "" {
+		result.Lifecycle.FailurePolicy = override.Lifecycle.FailurePolicy
+	}
+	if override.Health.Critical != nil {
+		result.Health.Critical = override.Health.Critical
+	}
+
 	// Merge metadata
 	if override.Module.Name != "" {
 		result.Module.Name = override.Module.Name
@@ -619,6 +1125,12 @@ func mergeModuleConfig(base, override ModuleConfig) ModuleConfig {
 	if override.Module.Description != "" {
 		result.Module.Description = override.Module.Description
 	}
+	if len(override.Module.DependsOn) > 0 {
+		result.Module.DependsOn = override.Module.DependsOn
+	}
+	if override.Module.SchemaVersion != 0 {
+		result.Module.SchemaVersion = override.Module.SchemaVersion
+	}
 
 	// Merge custom fields
 	if len(override.Custom) > 0 {
@@ -650,7 +1162,7 @@ func getDefaultGlobalConfig() GlobalConfig {
 			Enabled:    false,
 		},
 		HTTP: HTTPGlobalConfig{
-			DefaultMiddleware: []string{"cors", "logging", "recovery"},
+			DefaultMiddleware: DefaultMiddleware,
 			RateLimiting: RateLimitConfig{
 				Enabled:           false,
 				RequestsPerMinute: 100,
@@ -711,6 +1223,16 @@ func (mc *ModulesConfig) GetModuleVaultPath(moduleName string) (string, error) {
 	return module.Vault.Path, nil
 }
 
+// GetModuleHTTPConfig returns HTTP configuration for a specific module
+// Modules without configuration serve their routes under DefaultHTTPPrefix
+func (mc *ModulesConfig) GetModuleHTTPConfig(moduleName string) HTTPConfig {
+	module, exists := mc.Modules[moduleName]
+	if !exists {
+		return HTTPConfig{Prefix: DefaultHTTPPrefix, Enabled: true}
+	}
+	return module.HTTP
+}
+
 // IsModuleEnabled checks if a module is enabled
 func (mc *ModulesConfig) IsModuleEnabled(moduleName string) bool {
 	module, exists := mc.Modules[moduleName]
@@ -720,6 +1242,52 @@ func (mc *ModulesConfig) IsModuleEnabled(moduleName string) bool {
 	return module.Enabled
 }
 
+// FailurePolicy returns the failure policy of a module: its own, else the global one, else abort
+func (mc *ModulesConfig) FailurePolicy(moduleName string) string {
+	if mc == nil {
+		return FailurePolicyAbort
+	}
+	if policy := mc.Modules[moduleName].Lifecycle.FailurePolicy; policy != "" {
+		return policy
+	}
+	if policy := mc.Global.Lifecycle.FailurePolicy; policy != "" {
+		return policy
+	}
+	return FailurePolicyAbort
+}
+
+// ValidateFailurePolicies fails for unknown failure policies, globally or of any module
+func (mc *ModulesConfig) ValidateFailurePolicies() error {
+	if err := validateFailurePolicy("lifecycle", mc.Global.Lifecycle.FailurePolicy); err != nil {
+		return err
+	}
+	for name, module := range mc.Modules {
+		if err := validateFailurePolicy("module "+name+" lifecycle", module.Lifecycle.FailurePolicy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateResources fails for negative resource budgets of modules
+func (mc *ModulesConfig) ValidateResources() error {
+	for name, module := range mc.Modules {
+		resources := module.Resources
+		if resources.MaxDBConnections < 0 || resources.MaxBackgroundJobs < 0 || resources.MaxEventHandlers < 0 {
+			return fmt.Errorf("module %s resources must not be negative", name)
+		}
+	}
+	return nil
+}
+
+// IsModuleCritical reports whether a module must be healthy for the service to be ready
+func (mc *ModulesConfig) IsModuleCritical(moduleName string) bool {
+	if mc == nil {
+		return true
+	}
+	return mc.Modules[moduleName].Health.IsCritical()
+}
+
 // GetConnMaxLifetimeDuration parses and returns connection max lifetime as duration
 func (dc *ModuleDatabaseConfig) GetConnMaxLifetimeDuration() (time.Duration, error) {
 	if dc.ConnMaxLifetime == "" {
@@ -10,6 +10,8 @@ import (
 
 	"github.com/hashicorp/vault/api"
 	"github.com/spf13/viper"
+
+	"golang_modular_monolith/internal/shared/infrastructure/resilience"
 )
 
 // VaultConfig holds Vault-specific configuration
@@ -27,6 +29,7 @@ type VaultConfig struct {
 type VaultClient struct {
 	client *api.Client
 	config VaultConfig
+	policy resilience.Policy
 }
 
 // NewVaultClient creates a new Vault client
@@ -58,6 +61,13 @@ func NewVaultClient() (*VaultClient, error) {
 	vaultClient := &VaultClient{
 		client: client,
 		config: config,
+		// Secrets are read at startup, so a Vault restarting at the same time is waited for
+		policy: resilience.Policy{
+			Timeout: 10 * time.Second,
+			Retries: 2,
+			Backoff: time.Second,
+			Breaker: resilience.NewBreaker("vault", resilience.BreakerSettings{}),
+		},
 	}
 
 	// Authenticate with Vault
@@ -178,10 +188,12 @@ func (vc *VaultClient) LoadSecrets(modulesConfig *ModulesConfig) error {
 func (vc *VaultClient) loadSecretsFromPath(vaultPath, module string) error {
 	secretPath := fmt.Sprintf("%s/data/%s", vc.config.MountPath, vaultPath)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	secret, err := vc.client.Logical().ReadWithContext(ctx, secretPath)
+	var secret *api.Secret
+	err := vc.policy.Do(context.Background(), func(ctx context.Context) error {
+		var err error
+		secret, err = vc.client.Logical().ReadWithContext(ctx, secretPath)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to read secret from path %s: %w", secretPath, err)
 	}
@@ -212,10 +224,12 @@ func (vc *VaultClient) loadSecretsFromPath(vaultPath, module string) error {
 func (vc *VaultClient) getSecretCount(vaultPath string) (int, error) {
 	secretPath := fmt.Sprintf("%s/data/%s", vc.config.MountPath, vaultPath)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	secret, err := vc.client.Logical().ReadWithContext(ctx, secretPath)
+	var secret *api.Secret
+	err := vc.policy.Do(context.Background(), func(ctx context.Context) error {
+		var err error
+		secret, err = vc.client.Logical().ReadWithContext(ctx, secretPath)
+		return err
+	})
 	if err != nil || secret == nil {
 		return 0, err
 	}
@@ -295,6 +309,36 @@ func (vc *VaultClient) convertVaultKeyToViperKey(vaultKey string) string {
 	return strings.ReplaceAll(key, "_", ".")
 }
 
+// VaultHealthCheck returns a check that the Vault at VAULT_ADDR is reachable, initialized and unsealed,
+// or nil when Vault is disabled
+// The sys/health endpoint needs no token, so the check does not authenticate
+func VaultHealthCheck() (func(context.Context) error, error) {
+	if getEnvOrDefault("VAULT_ENABLED", "false") != "true" {
+		return nil, nil
+	}
+
+	vaultConfig := api.DefaultConfig()
+	vaultConfig.Address = getEnvOrDefault("VAULT_ADDR", "http://localhost:8200")
+	client, err := api.NewClient(vaultConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Vault client: %w", err)
+	}
+
+	return func(ctx context.Context) error {
+		health, err := client.Sys().HealthWithContext(ctx)
+		if err != nil {
+			return fmt.Errorf("vault is unreachable: %w", err)
+		}
+		if !health.Initialized {
+			return fmt.Errorf("vault is not initialized")
+		}
+		if health.Sealed {
+			return fmt.Errorf("vault is sealed")
+		}
+		return nil
+	}, nil
+}
+
 // IsEnabled returns true if Vault is enabled
 func (vc *VaultClient) IsEnabled() bool {
 	return vc.config.Enabled
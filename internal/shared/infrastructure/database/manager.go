@@ -1,13 +1,16 @@
 package database
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
-	"log"
 	"os"
 	"sync"
+	"time"
 
 	"golang_modular_monolith/internal/shared/infrastructure/config"
 
+	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -22,6 +25,11 @@ type DatabaseConfig struct {
 	Password string
 	SSLMode  string
 	URL      string // Alternative to individual fields
+	// Pool settings; zero keeps the driver's default. MaxOpenConns is capped by the module's
+	// resources.max_db_connections budget
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
 }
 
 // DatabaseManager manages multiple database connections
@@ -29,6 +37,7 @@ type DatabaseManager struct {
 	connections map[string]*gorm.DB
 	configs     map[string]*DatabaseConfig
 	appConfig   *config.Config
+	plugins     []gorm.Plugin
 	mu          sync.RWMutex
 }
 
@@ -68,8 +77,12 @@ func (dm *DatabaseManager) registerDatabasesFromConfig() {
 			User:     dbConfig.User,
 			Password: dbConfig.Password,
 			SSLMode:  dbConfig.SSLMode,
+
+			MaxOpenConns:    dbConfig.MaxOpenConns,
+			MaxIdleConns:    dbConfig.MaxIdleConns,
+			ConnMaxLifetime: dbConfig.ConnMaxLifetime,
 		}
-		log.Printf("%s database registered", name)
+		zap.L().Info("database registered", zap.String("database", name))
 	}
 }
 
@@ -81,6 +94,14 @@ func (dm *DatabaseManager) RegisterDatabase(name string, config *DatabaseConfig)
 	dm.configs[name] = config
 }
 
+// Use adds GORM plugins, such as tracing, to the connections opened afterwards
+func (dm *DatabaseManager) Use(plugins ...gorm.Plugin) {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+
+	dm.plugins = append(dm.plugins, plugins...)
+}
+
 // GetConnection returns a database connection by name
 func (dm *DatabaseManager) GetConnection(name string) (*gorm.DB, error) {
 	dm.mu.RLock()
@@ -94,6 +115,16 @@ func (dm *DatabaseManager) GetConnection(name string) (*gorm.DB, error) {
 	return dm.createConnection(name)
 }
 
+// newGormLogger logs failed queries through the shared logger, without the values bound to them;
+// slow queries are logged by SlowQueryPlugin instead
+func newGormLogger() logger.Interface {
+	return logger.New(zap.NewStdLog(zap.L()), logger.Config{
+		LogLevel:                  logger.Error,
+		IgnoreRecordNotFoundError: true,
+		ParameterizedQueries:      true,
+	})
+}
+
 // createConnection creates a new database connection
 func (dm *DatabaseManager) createConnection(name string) (*gorm.DB, error) {
 	dm.mu.Lock()
@@ -112,14 +143,33 @@ func (dm *DatabaseManager) createConnection(name string) (*gorm.DB, error) {
 	dsn := dm.buildDSN(config)
 
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger: newGormLogger(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database %s: %w", name, err)
 	}
+	for _, plugin := range dm.plugins {
+		if err := db.Use(plugin); err != nil {
+			return nil, fmt.Errorf("failed to use plugin %s on database %s: %w", plugin.Name(), name, err)
+		}
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get underlying sql.DB for %s: %w", name, err)
+	}
+	if config.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(config.MaxOpenConns)
+	}
+	if config.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(config.MaxIdleConns)
+	}
+	if config.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(config.ConnMaxLifetime)
+	}
 
 	dm.connections[name] = db
-	log.Printf("Database connection established for: %s", name)
+	zap.L().Info("database connection established", zap.String("database", name))
 
 	return db, nil
 }
@@ -156,7 +206,25 @@ func (dm *DatabaseManager) VerifyConnection(name string) error {
 		return fmt.Errorf("failed to ping database %s: %w", name, err)
 	}
 
-	log.Printf("Database connection verified for: %s", name)
+	zap.L().Debug("database connection verified", zap.String("database", name))
+	return nil
+}
+
+// Ping checks that a database answers within ctx
+func (dm *DatabaseManager) Ping(ctx context.Context, name string) error {
+	db, err := dm.GetConnection(name)
+	if err != nil {
+		return err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get underlying sql.DB for %s: %w", name, err)
+	}
+
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to ping database %s: %w", name, err)
+	}
 	return nil
 }
 
@@ -168,9 +236,9 @@ func (dm *DatabaseManager) CloseAll() error {
 	for name, db := range dm.connections {
 		if sqlDB, err := db.DB(); err == nil {
 			if err := sqlDB.Close(); err != nil {
-				log.Printf("Error closing database %s: %v", name, err)
+				zap.L().Error("failed to close database", zap.String("database", name), zap.Error(err))
 			} else {
-				log.Printf("Database connection closed for: %s", name)
+				zap.L().Info("database connection closed", zap.String("database", name))
 			}
 		}
 	}
@@ -179,6 +247,20 @@ func (dm *DatabaseManager) CloseAll() error {
 	return nil
 }
 
+// Stats returns the connection pool statistics of the open databases
+func (dm *DatabaseManager) Stats() map[string]sql.DBStats {
+	dm.mu.RLock()
+	defer dm.mu.RUnlock()
+
+	stats := make(map[string]sql.DBStats, len(dm.connections))
+	for name, db := range dm.connections {
+		if sqlDB, err := db.DB(); err == nil {
+			stats[name] = sqlDB.Stats()
+		}
+	}
+	return stats
+}
+
 // GetRegisteredDatabases returns list of registered database names
 func (dm *DatabaseManager) GetRegisteredDatabases() []string {
 	dm.mu.RLock()
@@ -0,0 +1,117 @@
+package database
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// slowQueryStartKey is the key of a statement's start time in its gorm.DB instance
+const slowQueryStartKey = "slow_query:start"
+
+// slowQueries counts the slow queries of every database
+var slowQueries = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "db_slow_queries_total",
+	Help: "Number of database queries slower than the slow query threshold, by table and operation",
+}, []string{"table", "operation"})
+
+// Collector returns the slow query counters, to be added to the metrics registry
+func Collector() prometheus.Collector {
+	return slowQueries
+}
+
+// SlowQueryPlugin counts the queries running longer than its threshold and logs a sample of them
+// The logged query holds placeholders, never the values bound to them, which may be personal data
+type SlowQueryPlugin struct {
+	threshold   time.Duration
+	sampleRatio float64
+}
+
+// NewSlowQueryPlugin creates the plugin; sampleRatio is the fraction of slow queries logged, from 0
+// to 1, zero logging all of them
+func NewSlowQueryPlugin(threshold time.Duration, sampleRatio float64) *SlowQueryPlugin {
+	if sampleRatio <= 0 || sampleRatio > 1 {
+		sampleRatio = 1
+	}
+	return &SlowQueryPlugin{threshold: threshold, sampleRatio: sampleRatio}
+}
+
+// Name returns the name of the plugin
+func (p *SlowQueryPlugin) Name() string {
+	return "slow_query"
+}
+
+// Initialize registers the callbacks timing GORM's operations
+func (p *SlowQueryPlugin) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	return errors.Join(
+		callbacks.Create().Before("gorm:create").Register("slow_query:before_create", p.before),
+		callbacks.Create().After("gorm:create").Register("slow_query:after_create", p.after),
+		callbacks.Query().Before("gorm:query").Register("slow_query:before_query", p.before),
+		callbacks.Query().After("gorm:query").Register("slow_query:after_query", p.after),
+		callbacks.Update().Before("gorm:update").Register("slow_query:before_update", p.before),
+		callbacks.Update().After("gorm:update").Register("slow_query:after_update", p.after),
+		callbacks.Delete().Before("gorm:delete").Register("slow_query:before_delete", p.before),
+		callbacks.Delete().After("gorm:delete").Register("slow_query:after_delete", p.after),
+		callbacks.Row().Before("gorm:row").Register("slow_query:before_row", p.before),
+		callbacks.Row().After("gorm:row").Register("slow_query:after_row", p.after),
+		callbacks.Raw().Before("gorm:raw").Register("slow_query:before_raw", p.before),
+		callbacks.Raw().After("gorm:raw").Register("slow_query:after_raw", p.after),
+	)
+}
+
+// before records the start time of a statement
+func (p *SlowQueryPlugin) before(db *gorm.DB) {
+	db.InstanceSet(slowQueryStartKey, time.Now())
+}
+
+// after counts and samples the statement when it ran longer than the threshold
+func (p *SlowQueryPlugin) after(db *gorm.DB) {
+	value, ok := db.InstanceGet(slowQueryStartKey)
+	if !ok {
+		return
+	}
+	start, ok := value.(time.Time)
+	if !ok {
+		return
+	}
+	elapsed := time.Since(start)
+	if elapsed < p.threshold {
+		return
+	}
+
+	query := db.Statement.SQL.String()
+	operation, _, _ := strings.Cut(strings.TrimSpace(query), " ")
+	operation = strings.ToUpper(operation)
+	table := db.Statement.Table
+	slowQueries.WithLabelValues(table, operation).Inc()
+
+	if p.sampleRatio < 1 && rand.Float64() >= p.sampleRatio {
+		return
+	}
+	fields := []zap.Field{
+		zap.String("table", table),
+		zap.String("operation", operation),
+		zap.String("sql", query),
+		zap.Int("params", len(db.Statement.Vars)),
+		zap.Int64("rows", db.RowsAffected),
+		zap.Duration("duration", elapsed),
+		zap.Duration("threshold", p.threshold),
+	}
+	if ctx := db.Statement.Context; ctx != nil {
+		if requestID := domain.RequestIDFromContext(ctx); requestID != "" {
+			fields = append(fields, zap.String("request_id", requestID))
+		}
+	}
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		fields = append(fields, zap.Error(db.Error))
+	}
+	zap.L().Warn("slow query", fields...)
+}
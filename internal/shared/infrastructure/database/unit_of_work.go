@@ -0,0 +1,73 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"golang_modular_monolith/internal/shared/application"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// txKey is the context key of the transaction of a database, identified by its connection pool, so
+// that a context can carry the transactions of several module databases
+type txKey struct {
+	pool *sql.DB
+}
+
+// keyOf returns the context key of the transactions of db; db inside a transaction has none
+func keyOf(db *gorm.DB) (txKey, bool) {
+	pool, err := db.DB()
+	if err != nil {
+		return txKey{}, false
+	}
+	return txKey{pool: pool}, true
+}
+
+// UnitOfWork implements application.UnitOfWork over a gorm database
+type UnitOfWork struct {
+	db *gorm.DB
+}
+
+// NewUnitOfWork creates a unit of work running transactions on db
+func NewUnitOfWork(db *gorm.DB) *UnitOfWork {
+	return &UnitOfWork{db: db}
+}
+
+// Do runs fn in a transaction, which is rolled back when fn fails or panics
+// When ctx already carries a transaction of the database, fn runs in it and the outermost Do commits
+// Events published with application.PublishEvents in fn are published after the commit
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	key, ok := keyOf(u.db)
+	if !ok {
+		return fn(ctx)
+	}
+	if _, ok := ctx.Value(key).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	ctx, publish := application.DeferEvents(ctx)
+	err := u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, key, tx))
+	})
+	if err != nil {
+		return err
+	}
+	// The changes are committed, so a subscriber failing must not fail the unit of work
+	if err := publish(); err != nil {
+		zap.L().Warn("failed to publish events of a unit of work", zap.Error(err))
+	}
+	return nil
+}
+
+// Conn returns the transaction of db carried by ctx, or db itself, bound to ctx
+// Repositories use it instead of db.WithContext so that they take part in a UnitOfWork
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if key, ok := keyOf(db); ok {
+		if tx, ok := ctx.Value(key).(*gorm.DB); ok {
+			return tx.WithContext(ctx)
+		}
+	}
+	return db.WithContext(ctx)
+}
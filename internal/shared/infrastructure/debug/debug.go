@@ -0,0 +1,88 @@
+// Package debug serves the profiling and runtime endpoints used to investigate performance
+// issues of a running instance: net/http/pprof, expvar and a summary of the Go runtime.
+package debug
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Path is the prefix the debug endpoints are mounted under
+const Path = "/admin/debug"
+
+// Permission is required to use the debug endpoints
+// Profiles expose memory contents and command lines, so only administrators hold it
+const Permission = "debug:read"
+
+// Register mounts the debug endpoints on the group:
+//
+//	/pprof/            index of the profiles
+//	/pprof/profile     CPU profile, ?seconds=N (bounded by the server's write timeout)
+//	/pprof/trace       execution trace, ?seconds=N
+//	/pprof/:name       named profiles such as heap, goroutine, allocs, block or mutex
+//	/vars              expvar variables
+//	/runtime           memory, GC and goroutine statistics
+//
+// The group must authenticate and authorize its requests
+func Register(group *gin.RouterGroup) {
+	group.GET("/pprof/", gin.WrapF(pprof.Index))
+	group.GET("/pprof/cmdline", gin.WrapF(pprof.Cmdline))
+	group.GET("/pprof/profile", gin.WrapF(pprof.Profile))
+	group.GET("/pprof/symbol", gin.WrapF(pprof.Symbol))
+	group.POST("/pprof/symbol", gin.WrapF(pprof.Symbol))
+	group.GET("/pprof/trace", gin.WrapF(pprof.Trace))
+	group.GET("/pprof/:name", func(c *gin.Context) {
+		pprof.Handler(c.Param("name")).ServeHTTP(c.Writer, c.Request)
+	})
+	group.GET("/vars", gin.WrapH(expvar.Handler()))
+	group.GET("/runtime", RuntimeHandler())
+}
+
+// RuntimeHandler reports the memory, GC and goroutine statistics of the process
+// Reading the statistics briefly stops the world, which is negligible for occasional requests
+func RuntimeHandler() gin.HandlerFunc {
+	started := time.Now()
+	return func(c *gin.Context) {
+		var mem runtime.MemStats
+		runtime.ReadMemStats(&mem)
+
+		var lastGC *time.Time
+		if mem.LastGC > 0 {
+			at := time.Unix(0, int64(mem.LastGC)).UTC()
+			lastGC = &at
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"go_version":     runtime.Version(),
+			"num_cpu":        runtime.NumCPU(),
+			"gomaxprocs":     runtime.GOMAXPROCS(0),
+			"goroutines":     runtime.NumGoroutine(),
+			"uptime_seconds": int64(time.Since(started).Seconds()),
+			"memory": gin.H{
+				"alloc_bytes":       mem.Alloc,
+				"total_alloc_bytes": mem.TotalAlloc,
+				"sys_bytes":         mem.Sys,
+				"heap_alloc_bytes":  mem.HeapAlloc,
+				"heap_inuse_bytes":  mem.HeapInuse,
+				"heap_objects":      mem.HeapObjects,
+				"stack_inuse_bytes": mem.StackInuse,
+				"mallocs":           mem.Mallocs,
+				"frees":             mem.Frees,
+			},
+			"gc": gin.H{
+				"num_gc":          mem.NumGC,
+				"num_forced_gc":   mem.NumForcedGC,
+				"pause_total_ns":  mem.PauseTotalNs,
+				"last_pause_ns":   mem.PauseNs[(mem.NumGC+255)%256],
+				"last_gc":         lastGC,
+				"next_gc_bytes":   mem.NextGC,
+				"gc_cpu_fraction": mem.GCCPUFraction,
+			},
+		})
+	}
+}
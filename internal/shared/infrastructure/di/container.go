@@ -0,0 +1,213 @@
+// Package di is a small dependency injection container: modules register the constructors of their
+// repositories and handlers, and the container calls them with the objects their parameters ask for.
+package di
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Scope tells how often a provider's constructor runs
+type Scope int
+
+const (
+	// Singleton providers construct their object once, on first resolution
+	Singleton Scope = iota
+	// Transient providers construct a new object on every resolution
+	Transient
+)
+
+// Option configures a provider
+type Option func(*provider)
+
+// WithScope sets the scope of a provider, Singleton by default
+func WithScope(scope Scope) Option {
+	return func(p *provider) { p.scope = scope }
+}
+
+// As registers the constructor's result under interface type I instead of its own type, so that
+// constructors asking for I receive it, e.g. di.As[domain.CustomerRepository]()
+func As[I any]() Option {
+	return func(p *provider) { p.as = typeOf[I]() }
+}
+
+// provider constructs the objects of one type
+type provider struct {
+	constructor reflect.Value
+	as          reflect.Type
+	decorators  []func(any) (any, error)
+	scope       Scope
+	built       bool
+	instance    any
+}
+
+var errorType = typeOf[error]()
+
+// Container holds the providers of a module
+// It is resolved from one goroutine at a time, typically during the module's Initialize
+type Container struct {
+	mu        sync.Mutex
+	providers map[reflect.Type]*provider
+	resolving []reflect.Type
+	err       error
+}
+
+// New creates an empty container
+func New() *Container {
+	return &Container{providers: make(map[reflect.Type]*provider)}
+}
+
+// Provide registers a constructor: a function returning the object, or the object and an error,
+// whose parameters are resolved from the container when the object is first needed
+// Providing a type again replaces its provider, which lets tests swap in fakes after the module's
+// wiring ran; an invalid constructor makes every later Resolve fail
+func (c *Container) Provide(constructor any, opts ...Option) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fn := reflect.ValueOf(constructor)
+	t := fn.Type()
+	if t.Kind() != reflect.Func || t.NumOut() == 0 || t.NumOut() > 2 || (t.NumOut() == 2 && t.Out(1) != errorType) {
+		c.err = errors.Join(c.err, fmt.Errorf("di: constructor %s must return an object, or an object and an error", t))
+		return
+	}
+
+	p := &provider{constructor: fn}
+	for _, opt := range opts {
+		opt(p)
+	}
+	key := t.Out(0)
+	if p.as != nil {
+		if !key.Implements(p.as) {
+			c.err = errors.Join(c.err, fmt.Errorf("di: %s does not implement %s", key, p.as))
+			return
+		}
+		key = p.as
+	}
+	c.providers[key] = p
+}
+
+// Value registers an object already constructed as the singleton of T
+func Value[T any](c *Container, value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.providers[typeOf[T]()] = &provider{built: true, instance: value}
+}
+
+// Decorate wraps the objects of T in decorator, e.g. to add caching or tracing to a repository
+// Decorators apply in registration order, to objects constructed after they are registered
+func Decorate[T any](c *Container, decorator func(T) (T, error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p, ok := c.providers[typeOf[T]()]
+	if !ok {
+		c.err = errors.Join(c.err, fmt.Errorf("di: no provider of %s to decorate", typeOf[T]()))
+		return
+	}
+	p.decorators = append(p.decorators, func(value any) (any, error) { return decorator(value.(T)) })
+}
+
+// Resolve returns the object of T, constructing it and its dependencies as needed
+// It fails when a type has no provider, a constructor fails, or constructors depend on each other
+// in a cycle
+func Resolve[T any](c *Container) (T, error) {
+	var zero T
+	value, err := c.resolve(typeOf[T]())
+	if err != nil || value == nil {
+		return zero, err
+	}
+	return value.(T), nil
+}
+
+// resolve returns the object of t
+// The lock is not held while constructors run, since they resolve their own parameters
+func (c *Container) resolve(t reflect.Type) (any, error) {
+	c.mu.Lock()
+	if c.err != nil {
+		err := c.err
+		c.mu.Unlock()
+		return nil, err
+	}
+	p, ok := c.providers[t]
+	if !ok {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("di: no provider of %s", t)
+	}
+	if p.built && p.scope == Singleton {
+		instance := p.instance
+		c.mu.Unlock()
+		return instance, nil
+	}
+	for i, resolving := range c.resolving {
+		if resolving == t {
+			cycle := append(append([]reflect.Type(nil), c.resolving[i:]...), t)
+			c.mu.Unlock()
+			return nil, fmt.Errorf("di: dependency cycle: %s", typeNames(cycle))
+		}
+	}
+	c.resolving = append(c.resolving, t)
+	c.mu.Unlock()
+
+	value, err := c.construct(p)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.resolving = c.resolving[:len(c.resolving)-1]
+	if err != nil {
+		return nil, fmt.Errorf("di: failed to construct %s: %w", t, err)
+	}
+	if p.scope == Singleton {
+		p.built = true
+		p.instance = value
+	}
+	return value, nil
+}
+
+// construct calls the provider's constructor with its resolved parameters, then its decorators
+func (c *Container) construct(p *provider) (any, error) {
+	t := p.constructor.Type()
+	args := make([]reflect.Value, t.NumIn())
+	for i := range args {
+		arg, err := c.resolve(t.In(i))
+		if err != nil {
+			return nil, err
+		}
+		args[i] = reflect.New(t.In(i)).Elem()
+		if arg != nil {
+			args[i].Set(reflect.ValueOf(arg))
+		}
+	}
+
+	results := p.constructor.Call(args)
+	if len(results) == 2 && !results[1].IsNil() {
+		return nil, results[1].Interface().(error)
+	}
+	value := results[0].Interface()
+
+	var err error
+	for _, decorate := range p.decorators {
+		if value, err = decorate(value); err != nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
+// typeOf returns the key of T, which is an interface type when T is one
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// typeNames joins the names of types with arrows
+func typeNames(types []reflect.Type) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.String()
+	}
+	return strings.Join(names, " -> ")
+}
@@ -1,11 +1,18 @@
 package eventbus
 
 import (
-	"log"
+	"context"
+	"fmt"
 	"reflect"
 	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
 	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/tracing"
 )
 
 // EventHandler represents an event handler function
@@ -13,14 +20,16 @@ type EventHandler func(event domain.DomainEvent) error
 
 // InMemoryEventBus implements EventBus using in-memory handler registration
 type InMemoryEventBus struct {
-	handlers map[string][]EventHandler
-	mu       sync.RWMutex
+	handlers    map[string][]EventHandler
+	subscribers []domain.EventHandler
+	mu          sync.RWMutex
 }
 
 // NewInMemoryEventBus creates a new in-memory event bus
 func NewInMemoryEventBus() *InMemoryEventBus {
 	return &InMemoryEventBus{
-		handlers: make(map[string][]EventHandler),
+		handlers:    make(map[string][]EventHandler),
+		subscribers: make([]domain.EventHandler, 0),
 	}
 }
 
@@ -39,22 +48,64 @@ func (b *InMemoryEventBus) SubscribeToEvent(event domain.DomainEvent, handler Ev
 }
 
 // Publish publishes an event to all registered handlers
+// Handlers are matched by Go type name and by the event's domain type (e.g. "customer.created"); the
+// delivery is recorded as a span in the trace of the request that published the event
 func (b *InMemoryEventBus) Publish(event domain.DomainEvent) error {
 	eventType := reflect.TypeOf(event).String()
+	domainEventType := event.GetEventType()
+
+	_, span := tracing.Tracer().Start(domain.WithEventActor(context.Background(), event), "event "+domainEventType,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("event.type", domainEventType),
+			attribute.String("event.id", event.GetEventID()),
+			attribute.String("event.aggregate_id", event.GetAggregateID()),
+		),
+	)
+	defer span.End()
 
 	b.mu.RLock()
-	handlers := b.handlers[eventType]
+	handlers := make([]EventHandler, 0, len(b.handlers[eventType]))
+	handlers = append(handlers, b.handlers[eventType]...)
+	if domainEventType != eventType {
+		handlers = append(handlers, b.handlers[domainEventType]...)
+	}
+	subscribers := make([]domain.EventHandler, 0, len(b.subscribers))
+	for _, subscriber := range b.subscribers {
+		if subscriber.CanHandle(domainEventType) {
+			subscribers = append(subscribers, subscriber)
+		}
+	}
 	b.mu.RUnlock()
 
 	for _, handler := range handlers {
 		if err := handler(event); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "event handler failed")
 			// Log error but continue with other handlers
-			log.Printf("Error handling event %s: %v", eventType, err)
+			zap.L().Error("failed to handle event",
+				zap.String("event_type", eventType),
+				zap.String("request_id", requestIDOf(event)),
+				zap.Error(err),
+			)
 			// In a production system, you might want to collect these errors
 			// and handle them appropriately (retry, dead letter queue, etc.)
 		}
 	}
 
+	for _, subscriber := range subscribers {
+		if err := subscriber.Handle(event); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "event handler failed")
+			zap.L().Error("failed to handle event",
+				zap.String("event_type", domainEventType),
+				zap.String("handler", fmt.Sprintf("%T", subscriber)),
+				zap.String("request_id", requestIDOf(event)),
+				zap.Error(err),
+			)
+		}
+	}
+
 	return nil
 }
 
@@ -68,18 +119,37 @@ func (b *InMemoryEventBus) PublishAll(events []domain.DomainEvent) error {
 	return nil
 }
 
+// requestIDOf returns the ID of the request that published the event, or "" when it is not known
+func requestIDOf(event domain.DomainEvent) string {
+	if requested, ok := event.(interface{ GetRequestID() string }); ok {
+		return requested.GetRequestID()
+	}
+	return ""
+}
+
 // Subscribe subscribes a handler to events (domain.EventHandler interface)
+// The handler receives every published event for which CanHandle returns true
 func (b *InMemoryEventBus) Subscribe(handler domain.EventHandler) error {
-	// This is a simplified implementation
-	// In a real system, you'd register the handler properly
-	log.Printf("Handler subscribed: %T", handler)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.subscribers = append(b.subscribers, handler)
+	zap.L().Debug("handler subscribed", zap.String("handler", fmt.Sprintf("%T", handler)))
 	return nil
 }
 
 // Unsubscribe removes a handler
 func (b *InMemoryEventBus) Unsubscribe(handler domain.EventHandler) error {
-	// This is a simplified implementation
-	log.Printf("Handler unsubscribed: %T", handler)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i, subscriber := range b.subscribers {
+		if subscriber == handler {
+			b.subscribers = append(b.subscribers[:i], b.subscribers[i+1:]...)
+			break
+		}
+	}
+	zap.L().Debug("handler unsubscribed", zap.String("handler", fmt.Sprintf("%T", handler)))
 	return nil
 }
 
@@ -91,6 +161,11 @@ func (b *InMemoryEventBus) SubscribeByType(eventType string, handler EventHandle
 	b.handlers[eventType] = append(b.handlers[eventType], handler)
 }
 
+// Health checks the event bus; the in-memory bus has no connection to lose, so it is always healthy
+func (b *InMemoryEventBus) Health(ctx context.Context) error {
+	return nil
+}
+
 // GetSubscriberCount returns the number of subscribers for an event type
 func (b *InMemoryEventBus) GetSubscriberCount(eventType string) int {
 	b.mu.RLock()
@@ -99,12 +174,25 @@ func (b *InMemoryEventBus) GetSubscriberCount(eventType string) int {
 	return len(b.handlers[eventType])
 }
 
+// SubscriberCount returns the number of handlers subscribed to the bus, of every event type
+func (b *InMemoryEventBus) SubscriberCount() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	count := len(b.subscribers)
+	for _, handlers := range b.handlers {
+		count += len(handlers)
+	}
+	return count
+}
+
 // Clear removes all handlers (useful for testing)
 func (b *InMemoryEventBus) Clear() {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
 	b.handlers = make(map[string][]EventHandler)
+	b.subscribers = make([]domain.EventHandler, 0)
 }
 
 // GetEventTypes returns all registered event types
@@ -124,7 +212,11 @@ func (b *InMemoryEventBus) GetEventTypes() []string {
 
 // LogEventHandler logs all events
 func LogEventHandler(event domain.DomainEvent) error {
-	log.Printf("Event published: %s - AggregateID: %s", reflect.TypeOf(event).String(), event.GetAggregateID())
+	zap.L().Info("event published",
+		zap.String("event_type", reflect.TypeOf(event).String()),
+		zap.String("aggregate_id", event.GetAggregateID()),
+		zap.String("request_id", requestIDOf(event)),
+	)
 	return nil
 }
 
@@ -133,7 +225,7 @@ func MetricsEventHandler(event domain.DomainEvent) error {
 	// Here you would send metrics to your metrics system
 	// For example: increment counter, record timing, etc.
 	eventType := reflect.TypeOf(event).String()
-	log.Printf("Metrics: Event %s published at %s", eventType, event.GetOccurredAt().Format("2006-01-02 15:04:05"))
+	zap.L().Info("event metric", zap.String("event_type", eventType), zap.Time("occurred_at", event.GetOccurredAt()))
 	return nil
 }
 
@@ -163,7 +255,7 @@ func (a *AsyncEventBus) SubscribeToEvent(event domain.DomainEvent, handler Event
 func (a *AsyncEventBus) Publish(event domain.DomainEvent) error {
 	go func() {
 		if err := a.bus.Publish(event); err != nil {
-			log.Printf("Error publishing event asynchronously: %v", err)
+			zap.L().Error("failed to publish event asynchronously", zap.Error(err))
 		}
 	}()
 	return nil
@@ -0,0 +1,133 @@
+// Package eventstream streams domain events to HTTP clients as Server-Sent Events, so that
+// dashboards can follow changes of customers, orders and other aggregates live.
+package eventstream
+
+import (
+	"sync"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// clientBuffer is the number of events queued for a client before it is considered too slow
+const clientBuffer = 64
+
+// Message is the representation of a domain event sent to clients
+type Message struct {
+	EventID       string      `json:"event_id"`
+	EventType     string      `json:"event_type"`
+	AggregateID   string      `json:"aggregate_id"`
+	AggregateType string      `json:"aggregate_type"`
+	OccurredAt    time.Time   `json:"occurred_at"`
+	TriggeredBy   string      `json:"triggered_by,omitempty"`
+	TenantID      string      `json:"tenant_id,omitempty"`
+	Data          interface{} `json:"data,omitempty"`
+}
+
+// newMessage converts a domain event to its message
+func newMessage(event domain.DomainEvent) Message {
+	message := Message{
+		EventID:       event.GetEventID(),
+		EventType:     event.GetEventType(),
+		AggregateID:   event.GetAggregateID(),
+		AggregateType: event.GetAggregateType(),
+		OccurredAt:    event.GetOccurredAt(),
+		Data:          event.GetEventData(),
+	}
+	if triggered, ok := event.(interface{ GetTriggeredBy() string }); ok {
+		message.TriggeredBy = triggered.GetTriggeredBy()
+	}
+	if tenanted, ok := event.(interface{ GetTenantID() string }); ok {
+		message.TenantID = tenanted.GetTenantID()
+	}
+	return message
+}
+
+// Broker fans the events of the event bus out to the connected clients
+// It is subscribed to the bus as a domain.EventHandler; a client that does not keep up is
+// disconnected rather than slowing down publishers
+type Broker struct {
+	mu      sync.Mutex
+	clients map[*client]struct{}
+}
+
+// client is a connected stream and its queue of messages
+type client struct {
+	messages chan Message
+	dropped  chan struct{}
+}
+
+// NewBroker creates a broker without clients
+func NewBroker() *Broker {
+	return &Broker{clients: make(map[*client]struct{})}
+}
+
+// Handle queues the event for every connected client
+func (b *Broker) Handle(event domain.DomainEvent) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.clients) == 0 {
+		return nil
+	}
+
+	message := newMessage(event)
+	for c := range b.clients {
+		select {
+		case c.messages <- message:
+		default:
+			delete(b.clients, c)
+			close(c.dropped)
+		}
+	}
+	return nil
+}
+
+// CanHandle accepts every event type; clients filter their own
+func (b *Broker) CanHandle(eventType string) bool {
+	return true
+}
+
+// subscribe connects a client
+func (b *Broker) subscribe() *client {
+	c := &client{
+		messages: make(chan Message, clientBuffer),
+		dropped:  make(chan struct{}),
+	}
+
+	b.mu.Lock()
+	b.clients[c] = struct{}{}
+	b.mu.Unlock()
+	return c
+}
+
+// unsubscribe disconnects a client
+func (b *Broker) unsubscribe(c *client) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.clients[c]; ok {
+		delete(b.clients, c)
+		close(c.dropped)
+	}
+}
+
+// ClientCount returns the number of connected clients
+func (b *Broker) ClientCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.clients)
+}
+
+// Queued returns the number of messages queued for the connected clients
+func (b *Broker) Queued() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	queued := 0
+	for c := range b.clients {
+		queued += len(c.messages)
+	}
+	return queued
+}
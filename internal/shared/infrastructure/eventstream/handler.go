@@ -0,0 +1,118 @@
+package eventstream
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/authz"
+)
+
+// Path is where the stream is served, under the API prefix
+const Path = "/events/stream"
+
+// PermissionPrefix starts the permission to receive the events of an aggregate type, e.g.
+// "events:customer"; "events:*" grants the events of every aggregate type
+const PermissionPrefix = "events:"
+
+// heartbeatInterval is how often an idle stream sends a comment, so that proxies keep it open
+const heartbeatInterval = 15 * time.Second
+
+// Handler streams the events of the broker as Server-Sent Events
+// The optional aggregate_type query parameter, a comma-separated list, restricts the stream to the
+// events of those aggregate types; events are sent only when the principal holds the permission
+// "events:<aggregate type>", and principals of a tenant only receive the events of their tenant.
+// The route must authenticate the request
+func Handler(broker *Broker, authorizer authz.Authorizer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := auth.CurrentPrincipal(c)
+		if !ok {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		var filter map[string]bool
+		if types := c.Query("aggregate_type"); types != "" {
+			filter = make(map[string]bool)
+			for _, aggregateType := range strings.Split(types, ",") {
+				if aggregateType = strings.TrimSpace(aggregateType); aggregateType != "" {
+					filter[aggregateType] = true
+				}
+			}
+		}
+
+		// The stream outlives the server's write timeout
+		if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+			zap.L().Warn("failed to clear the write deadline of an event stream", zap.Error(err))
+		}
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+		c.Writer.Flush()
+
+		subscription := broker.subscribe()
+		defer broker.unsubscribe(subscription)
+
+		// Visibility is decided once per aggregate type and stream
+		visible := make(map[string]bool)
+		ctx := c.Request.Context()
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-subscription.dropped:
+				zap.L().Info("event stream client too slow, disconnected", zap.String("actor", principal.Actor().String()))
+				return
+			case <-heartbeat.C:
+				if _, err := fmt.Fprint(c.Writer, ": heartbeat\n\n"); err != nil {
+					return
+				}
+				c.Writer.Flush()
+			case message := <-subscription.messages:
+				if filter != nil && !filter[message.AggregateType] {
+					continue
+				}
+				if principal.TenantID != "" && message.TenantID != principal.TenantID {
+					continue
+				}
+				allowed, known := visible[message.AggregateType]
+				if !known {
+					allowed = authorizer.Authorize(ctx, principal, PermissionPrefix+message.AggregateType) == nil
+					visible[message.AggregateType] = allowed
+				}
+				if !allowed {
+					continue
+				}
+				if err := writeEvent(c, message); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// writeEvent writes a message as an SSE event named after the event type
+func writeEvent(c *gin.Context, message Message) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		zap.L().Warn("failed to encode streamed event", zap.String("event_type", message.EventType), zap.Error(err))
+		return nil
+	}
+	if _, err := fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", message.EventID, message.EventType, data); err != nil {
+		return err
+	}
+	c.Writer.Flush()
+	return nil
+}
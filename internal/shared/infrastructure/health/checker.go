@@ -0,0 +1,215 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Check statuses
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+	// StatusDegraded reports that only checks that are not critical are down
+	StatusDegraded = "degraded"
+)
+
+// DefaultTimeout bounds each check of a Registry, so a hung dependency reports down instead of blocking the probe
+const DefaultTimeout = 2 * time.Second
+
+// CheckFunc checks a dependency, returning an error when it is not usable
+type CheckFunc func(ctx context.Context) error
+
+// CheckOption configures a registered check
+type CheckOption func(*check)
+
+// WithTimeout gives the check its own timeout instead of the registry's
+func WithTimeout(timeout time.Duration) CheckOption {
+	return func(c *check) {
+		if timeout > 0 {
+			c.timeout = timeout
+		}
+	}
+}
+
+// NonCritical reports the check without it making the service unready, for dependencies the
+// service degrades without, e.g. circuit breakers of external services
+func NonCritical() CheckOption {
+	return func(c *check) {
+		c.critical = false
+	}
+}
+
+// Result is the outcome of one check
+type Result struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+	// Optional checks are reported without affecting the overall status
+	Optional bool `json:"optional,omitempty"`
+}
+
+// Report is the outcome of checks; Status is down when a critical check is down, degraded when only
+// optional ones are, and up otherwise
+type Report struct {
+	Status    string    `json:"status"`
+	Checks    []Result  `json:"checks"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// check is a registered check
+type check struct {
+	run      CheckFunc
+	timeout  time.Duration
+	critical bool
+}
+
+// Registry holds the named checks of the components of the service, e.g. databases, Vault, the
+// event bus, external services and modules, and runs them concurrently
+// The readiness probe and the admin health endpoints render from the same registry
+type Registry struct {
+	checks  map[string]check
+	timeout time.Duration
+	mu      sync.RWMutex
+}
+
+// NewRegistry creates a registry giving each check timeout to complete unless registered with its own
+func NewRegistry(timeout time.Duration) *Registry {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Registry{
+		checks:  make(map[string]check),
+		timeout: timeout,
+	}
+}
+
+// Register registers a critical check, replacing any check of the same name
+// Names are grouped by kind, e.g. "database:customer" or "module:order"
+func (r *Registry) Register(name string, run CheckFunc, opts ...CheckOption) {
+	c := check{run: run, timeout: r.timeout, critical: true}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[name] = c
+}
+
+// Unregister removes a check
+func (r *Registry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.checks, name)
+}
+
+// Names returns the names of the registered checks, sorted
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.checks))
+	for name := range r.checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Run runs the checks and reports their status and latency, sorted by name; with kinds, only the
+// checks of those kinds run, e.g. Run(ctx, "module") runs the "module:<name>" checks
+func (r *Registry) Run(ctx context.Context, kinds ...string) Report {
+	r.mu.RLock()
+	checks := make(map[string]check, len(r.checks))
+	for name, c := range r.checks {
+		if matchesKind(name, kinds) {
+			checks[name] = c
+		}
+	}
+	r.mu.RUnlock()
+
+	results := make([]Result, 0, len(checks))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, c := range checks {
+		wg.Add(1)
+		go func(name string, c check) {
+			defer wg.Done()
+			result := runCheck(ctx, name, c)
+
+			mu.Lock()
+			results = append(results, result)
+			mu.Unlock()
+		}(name, c)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	report := Report{Status: StatusUp, Checks: results, CheckedAt: time.Now().UTC()}
+	for _, result := range results {
+		if result.Status == StatusUp {
+			continue
+		}
+		if !result.Optional {
+			report.Status = StatusDown
+			break
+		}
+		report.Status = StatusDegraded
+	}
+	return report
+}
+
+// matchesKind reports whether a check name is of one of kinds, or kinds is empty
+func matchesKind(name string, kinds []string) bool {
+	if len(kinds) == 0 {
+		return true
+	}
+	kind, _, _ := strings.Cut(name, ":")
+	for _, k := range kinds {
+		if kind == k {
+			return true
+		}
+	}
+	return false
+}
+
+// runCheck runs one check within its timeout, turning panics into failures
+func runCheck(ctx context.Context, name string, c check) Result {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				done <- fmt.Errorf("check panicked: %v", recovered)
+			}
+		}()
+		done <- c.run(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("check timed out after %s", c.timeout)
+	}
+
+	result := Result{
+		Name:      name,
+		Status:    StatusUp,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+		Optional:  !c.critical,
+	}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package health
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Probe paths
+const (
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+)
+
+// Info identifies the running service in probe responses
+type Info struct {
+	Service     string `json:"service"`
+	Version     string `json:"version"`
+	Environment string `json:"environment"`
+}
+
+// LivenessHandler answers 200 while the process can serve requests
+// It checks no dependency, so an orchestrator restarts the process only when it is stuck
+func LivenessHandler(info Info) gin.HandlerFunc {
+	started := time.Now()
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"status":         "alive",
+			"service":        info.Service,
+			"version":        info.Version,
+			"environment":    info.Environment,
+			"uptime_seconds": int64(time.Since(started).Seconds()),
+		})
+	}
+}
+
+// ReadinessHandler runs the checks of the registry and answers 200 while the critical ones are up,
+// or 503 so that traffic is routed elsewhere until the failing dependencies recover
+func ReadinessHandler(info Info, registry *Registry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		report := registry.Run(c.Request.Context())
+
+		status := http.StatusOK
+		if report.Status == StatusDown {
+			status = http.StatusServiceUnavailable
+		}
+		c.JSON(status, gin.H{
+			"status":      report.Status,
+			"service":     info.Service,
+			"version":     info.Version,
+			"environment": info.Environment,
+			"checks":      report.Checks,
+			"checked_at":  report.CheckedAt,
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package health
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// ModulesPath serves the health of each module
+const ModulesPath = "/admin/modules/health"
+
+// ModulesPermission is required to read the health of the modules, whose errors may reveal
+// details of their dependencies
+const ModulesPermission = "modules:read"
+
+// ModuleResult is the health of one module
+type ModuleResult struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	Critical  bool    `json:"critical"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// ModuleFailure is a module that failed to initialize or start and was skipped or degraded
+type ModuleFailure struct {
+	Name     string    `json:"name"`
+	Phase    string    `json:"phase"`
+	Policy   string    `json:"policy"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// ModuleFailures converts the failures recorded by the registry for responses
+func ModuleFailures(registry *domain.ModuleRegistry) []ModuleFailure {
+	recorded := registry.Failures()
+	failures := make([]ModuleFailure, 0, len(recorded))
+	for _, failure := range recorded {
+		failures = append(failures, ModuleFailure{
+			Name:     failure.Module,
+			Phase:    failure.Phase,
+			Policy:   string(failure.Policy),
+			Error:    failure.Err.Error(),
+			FailedAt: failure.At,
+		})
+	}
+	return failures
+}
+
+// ModulesHandler runs the "module:<name>" checks of the registry and answers 200 while the critical
+// modules are up, with status degraded when others are down or modules failed to initialize or
+// start, or 503 otherwise
+func ModulesHandler(registry *Registry, modules *domain.ModuleRegistry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		report := registry.Run(c.Request.Context(), "module")
+
+		results := make([]ModuleResult, 0, len(report.Checks))
+		for _, check := range report.Checks {
+			results = append(results, ModuleResult{
+				Name:      strings.TrimPrefix(check.Name, "module:"),
+				Status:    check.Status,
+				Critical:  !check.Optional,
+				LatencyMs: check.LatencyMs,
+				Error:     check.Error,
+			})
+		}
+
+		overall := report.Status
+		failures := ModuleFailures(modules)
+		if len(failures) > 0 && overall == StatusUp {
+			overall = StatusDegraded
+		}
+
+		status := http.StatusOK
+		if overall == StatusDown {
+			status = http.StatusServiceUnavailable
+		}
+		c.JSON(status, gin.H{
+			"status":     overall,
+			"modules":    results,
+			"failures":   failures,
+			"checked_at": report.CheckedAt,
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package httplimit
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Error codes of rejected requests
+const (
+	ErrCodeRequestTooLarge = domain.ErrCodeRequestTooLarge
+	ErrCodeRequestTimeout  = domain.ErrCodeRequestTimeout
+)
+
+// BodyLimits maps routes to the size their request bodies may reach, in bytes
+// Routes are keyed by "<METHOD> <path>", with the full path of the registered route
+type BodyLimits map[string]int64
+
+// ParseBodyLimits parses the http.body_limits section of a module's configuration
+// Keys are "<path> <METHOD>" relative to the module's prefix (e.g. "/products/import POST"); the limits
+// apply under each of basePaths, the prefixes of the module's API versions
+func ParseBodyLimits(declared map[string]int64, basePaths ...string) (BodyLimits, error) {
+	limits := make(BodyLimits, len(declared)*len(basePaths))
+	for key, limit := range declared {
+		fields := strings.Fields(key)
+		if len(fields) != 2 || !strings.HasPrefix(fields[0], "/") {
+			return nil, fmt.Errorf("route %q must be \"<path> <METHOD>\"", key)
+		}
+
+		method := strings.ToUpper(fields[1])
+		if !isHTTPMethod(method) {
+			return nil, fmt.Errorf("route %q has unknown method %s", key, fields[1])
+		}
+		if limit <= 0 {
+			return nil, fmt.Errorf("route %q must have a positive body limit, got %d", key, limit)
+		}
+
+		for _, basePath := range basePaths {
+			limits[routeKey(method, basePath+fields[0])] = limit
+		}
+	}
+	return limits, nil
+}
+
+// Merge adds the limits of other, replacing those of the same routes
+func (l BodyLimits) Merge(other BodyLimits) {
+	for route, limit := range other {
+		l[route] = limit
+	}
+}
+
+// Middleware limits request bodies to their route's limit, or defaultLimit
+// Bodies announced over the limit are rejected with 413 up front; others are streamed to the handlers,
+// whose reads fail with *http.MaxBytesError past the limit (see ReadError)
+func Middleware(defaultLimit int64, limits BodyLimits) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || c.Request.Body == http.NoBody {
+			c.Next()
+			return
+		}
+
+		limit := defaultLimit
+		if routeLimit, ok := limits[routeKey(c.Request.Method, c.FullPath())]; ok {
+			limit = routeLimit
+		}
+
+		if c.Request.ContentLength > limit {
+			abortTooLarge(c, limit)
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		c.Next()
+	}
+}
+
+// ReadError converts an error reading a request body into a domain error: REQUEST_TOO_LARGE past the
+// body's limit, REQUEST_TIMEOUT when the client does not finish sending it within the server's read
+// timeout, and INVALID_INPUT otherwise
+func ReadError(err error) domain.DomainError {
+	var tooLarge *http.MaxBytesError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &tooLarge):
+		return domain.NewDomainErrorWithCause(ErrCodeRequestTooLarge, tooLargeMessage(tooLarge.Limit), err)
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return domain.NewDomainErrorWithCause(ErrCodeRequestTimeout, "The request body was not received in time", err)
+	default:
+		return domain.NewDomainErrorWithCause(domain.ErrCodeInvalidInput, "Failed to read the request body", err)
+	}
+}
+
+// Interrupted reports whether reading a request body stopped at its size limit or the read timeout,
+// rather than on malformed content
+func Interrupted(err error) bool {
+	var tooLarge *http.MaxBytesError
+	var netErr net.Error
+	return errors.As(err, &tooLarge) || (errors.As(err, &netErr) && netErr.Timeout())
+}
+
+// AbortReadError rejects a request whose body could not be read, for middleware reading it before the
+// handlers
+func AbortReadError(c *gin.Context, err error) {
+	readErr := ReadError(err)
+	switch readErr.Code {
+	case ErrCodeRequestTooLarge:
+		c.Header("Connection", "close")
+		abort(c, http.StatusRequestEntityTooLarge, readErr.Code, readErr.Message)
+	case ErrCodeRequestTimeout:
+		abort(c, http.StatusRequestTimeout, readErr.Code, readErr.Message)
+	default:
+		abort(c, http.StatusBadRequest, readErr.Code, readErr.Message)
+	}
+}
+
+// abortTooLarge rejects a request whose body exceeds limit
+func abortTooLarge(c *gin.Context, limit int64) {
+	// The rest of the body is not read, so the connection cannot be reused
+	c.Header("Connection", "close")
+	abort(c, http.StatusRequestEntityTooLarge, ErrCodeRequestTooLarge, tooLargeMessage(limit))
+}
+
+// tooLargeMessage describes a body over limit
+func tooLargeMessage(limit int64) string {
+	return fmt.Sprintf("The request body exceeds %d bytes", limit)
+}
+
+// abort writes an error response in the API's error format
+func abort(c *gin.Context, status int, code, message string) {
+	c.AbortWithStatusJSON(status, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+// routeKey builds the lookup key of a route
+func routeKey(method, path string) string {
+	return method + " " + strings.ToLower(path)
+}
+
+// isHTTPMethod checks if a method can carry a request body limit
+func isHTTPMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
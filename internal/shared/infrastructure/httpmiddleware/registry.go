@@ -0,0 +1,56 @@
+// Package httpmiddleware resolves the middleware names of the http.middleware lists in module
+// configuration to the handlers attached to each module's route group.
+package httpmiddleware
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Factory builds a named middleware for the routes of a module
+// It returns nil for middleware that needs no per-module handler, e.g. because it is always applied
+type Factory func(module string) gin.HandlerFunc
+
+// Registry maps middleware names to their factories
+type Registry struct {
+	factories map[string]Factory
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{factories: make(map[string]Factory)}
+}
+
+// Register adds a named middleware, replacing any middleware of the same name
+func (r *Registry) Register(name string, factory Factory) {
+	r.factories[strings.ToLower(name)] = factory
+}
+
+// Names returns the registered names, sorted
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Chain builds the handlers of the named middleware for a module, in the order of names
+// Unknown names are an error, so that a typo does not silently leave routes without e.g. auth
+func (r *Registry) Chain(module string, names []string) ([]gin.HandlerFunc, error) {
+	var handlers []gin.HandlerFunc
+	for _, name := range names {
+		factory, ok := r.factories[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("unknown middleware %q, expected one of %s", name, strings.Join(r.Names(), ", "))
+		}
+		if handler := factory(module); handler != nil {
+			handlers = append(handlers, handler)
+		}
+	}
+	return handlers, nil
+}
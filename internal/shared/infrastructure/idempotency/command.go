@@ -0,0 +1,103 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/application"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// IdempotentCommand is implemented by commands that clients may retry with a key, e.g. the
+// Idempotency-Key header of the request the command was built from
+// Commands returning an empty key are executed as usual
+type IdempotentCommand interface {
+	application.Command
+
+	// IdempotencyKey returns the key of the command's retries
+	IdempotencyKey() string
+}
+
+// CommandMiddleware executes an IdempotentCommand once per key on a MiddlewareCommandBus: retries
+// with the same key and payload succeed without running the handler again, for ttl
+// Keys are scoped to the command and its actor. A retry with a different payload fails with
+// IDEMPOTENCY_KEY_REUSED, and a retry while the first execution is running with
+// IDEMPOTENCY_KEY_IN_PROGRESS. Failed executions are not stored, so they can be retried
+func CommandMiddleware(store Store, ttl time.Duration) application.CommandMiddleware {
+	return application.CommandMiddlewareFunc(func(ctx context.Context, cmd application.Command, next func(context.Context, application.Command) error) error {
+		idempotent, ok := cmd.(IdempotentCommand)
+		if !ok || idempotent.IdempotencyKey() == "" {
+			return next(ctx, cmd)
+		}
+		if len(idempotent.IdempotencyKey()) > MaxKeyLength {
+			return domain.NewDomainError(domain.ErrCodeInvalidInput, fmt.Sprintf("idempotency key must be at most %d characters", MaxKeyLength))
+		}
+
+		fingerprint, err := commandFingerprint(cmd)
+		if err != nil {
+			return err
+		}
+		key := commandKey(ctx, cmd, idempotent.IdempotencyKey())
+
+		existing, err := store.Claim(ctx, key, fingerprint, ttl)
+		if err != nil {
+			return fmt.Errorf("failed to claim idempotency key: %w", err)
+		}
+		if existing != nil {
+			switch {
+			case existing.Fingerprint != fingerprint:
+				return domain.NewDomainError(ErrCodeKeyReused, "The idempotency key was already used for a different command")
+			case !existing.IsCompleted():
+				return domain.NewDomainError(ErrCodeKeyInProgress, "A command with this idempotency key is still being processed")
+			default:
+				return nil
+			}
+		}
+
+		// The outcome must be stored even when the caller gave up
+		completed := false
+		defer func() {
+			// Failed executions, and handlers that panicked, leave the key free for a retry
+			if completed {
+				return
+			}
+			if err := store.Release(context.WithoutCancel(ctx), key); err != nil {
+				zap.L().Warn("failed to release idempotency key", zap.String("command", cmd.CommandName()), zap.Error(err))
+			}
+		}()
+		if err := next(ctx, cmd); err != nil {
+			return err
+		}
+		completed = true
+		if err := store.Complete(context.WithoutCancel(ctx), key, &Response{Status: http.StatusOK}, ttl); err != nil {
+			zap.L().Warn("failed to store idempotent command outcome", zap.String("command", cmd.CommandName()), zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// commandKey prefixes the client's key with the command and its actor
+func commandKey(ctx context.Context, cmd application.Command, key string) string {
+	caller := "anonymous"
+	if actor, ok := domain.ActorFromContext(ctx); ok {
+		caller = actor.String()
+	}
+	return "command " + cmd.CommandName() + "|" + caller + "|" + key
+}
+
+// commandFingerprint hashes the JSON encoding of the command, what must not change between retries
+func commandFingerprint(cmd application.Command) (string, error) {
+	data, err := json.Marshal(cmd)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode command %s: %w", cmd.CommandName(), err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
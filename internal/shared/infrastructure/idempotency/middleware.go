@@ -0,0 +1,224 @@
+// Package idempotency makes retries of mutating requests safe: the first response for an
+// Idempotency-Key is stored and replayed to retries with the same key and payload, while a
+// key reused for a different payload is rejected.
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/httplimit"
+)
+
+// Headers of idempotent requests
+const (
+	KeyHeader      = "Idempotency-Key"
+	ReplayedHeader = "Idempotent-Replayed"
+)
+
+// MaxKeyLength is the maximum length of an Idempotency-Key
+const MaxKeyLength = 255
+
+// Error codes of rejected requests
+const (
+	ErrCodeKeyReused     = "IDEMPOTENCY_KEY_REUSED"
+	ErrCodeKeyInProgress = "IDEMPOTENCY_KEY_IN_PROGRESS"
+)
+
+// replayedHeaders are the response headers stored with the response
+var replayedHeaders = []string{"Content-Type", "Location"}
+
+// Routes is the set of routes taking an Idempotency-Key, keyed by "<METHOD> <path>" with the
+// full path of the registered route
+type Routes map[string]bool
+
+// ParseRoutes parses the http.idempotent list of a module's configuration
+// Entries are "<path> <METHOD>" relative to the module's prefix (e.g. "/customers POST"); the routes
+// are taken under each of basePaths, the prefixes of the module's API versions
+func ParseRoutes(declared []string, basePaths ...string) (Routes, error) {
+	routes := make(Routes, len(declared)*len(basePaths))
+	for _, entry := range declared {
+		fields := strings.Fields(entry)
+		if len(fields) != 2 || !strings.HasPrefix(fields[0], "/") {
+			return nil, fmt.Errorf("route %q must be \"<path> <METHOD>\"", entry)
+		}
+
+		method := strings.ToUpper(fields[1])
+		switch method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			return nil, fmt.Errorf("route %q must use a mutating method, got %s", entry, fields[1])
+		}
+
+		for _, basePath := range basePaths {
+			routes[routeKey(method, basePath+fields[0])] = true
+		}
+	}
+	return routes, nil
+}
+
+// Middleware stores the first response of the routes' requests carrying an Idempotency-Key and
+// replays it, with an Idempotent-Replayed header, to retries with the same key for ttl
+// Keys are scoped to the route and the caller, best identified once authenticated, so the middleware
+// should run after authentication; a retry with a different payload is rejected with 422 and a retry while the
+// first request is still processed with 409. Server errors are not stored, so they can be retried
+// Requests without the header, and routes outside routes, are handled as usual
+func Middleware(store Store, routes Routes, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idempotencyKey := strings.TrimSpace(c.GetHeader(KeyHeader))
+		route := routeKey(c.Request.Method, c.FullPath())
+		if idempotencyKey == "" || !routes[route] {
+			c.Next()
+			return
+		}
+		if len(idempotencyKey) > MaxKeyLength {
+			abort(c, http.StatusBadRequest, domain.ErrCodeInvalidInput, fmt.Sprintf("%s must be at most %d characters", KeyHeader, MaxKeyLength))
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			httplimit.AbortReadError(c, err)
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		key := scopedKey(c, route, idempotencyKey)
+		fingerprint := requestFingerprint(c.Request, body)
+
+		existing, err := store.Claim(ctx, key, fingerprint, ttl)
+		if err != nil {
+			zap.L().Error("failed to claim idempotency key", zap.String("route", route), zap.Error(err))
+			abort(c, http.StatusInternalServerError, "INTERNAL_ERROR", "An internal error occurred")
+			return
+		}
+		if existing != nil {
+			replay(c, existing, fingerprint)
+			return
+		}
+
+		// The request may have been canceled; the outcome must be stored regardless
+		ctx = context.WithoutCancel(ctx)
+
+		recorder := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		completed := false
+		defer func() {
+			c.Writer = recorder.ResponseWriter
+			// Requests that failed with a server error, or whose handlers panicked, leave the key
+			// free for a retry
+			if completed {
+				return
+			}
+			if err := store.Release(ctx, key); err != nil {
+				zap.L().Warn("failed to release idempotency key", zap.String("route", route), zap.Error(err))
+			}
+		}()
+		c.Next()
+
+		status := recorder.Status()
+		if status >= http.StatusInternalServerError {
+			return
+		}
+		completed = true
+
+		response := &Response{Status: status, Header: make(http.Header), Body: recorder.body.Bytes()}
+		for _, name := range replayedHeaders {
+			if value := recorder.Header().Get(name); value != "" {
+				response.Header.Set(name, value)
+			}
+		}
+		if err := store.Complete(ctx, key, response, ttl); err != nil {
+			zap.L().Warn("failed to store idempotent response", zap.String("route", route), zap.Error(err))
+		}
+	}
+}
+
+// replay answers a retry from the record of its key
+func replay(c *gin.Context, record *Record, fingerprint string) {
+	switch {
+	case record.Fingerprint != fingerprint:
+		abort(c, http.StatusUnprocessableEntity, ErrCodeKeyReused, "The idempotency key was already used for a different request")
+	case !record.IsCompleted():
+		abort(c, http.StatusConflict, ErrCodeKeyInProgress, "A request with this idempotency key is still being processed")
+	default:
+		for name, values := range record.Response.Header {
+			for _, value := range values {
+				c.Writer.Header().Add(name, value)
+			}
+		}
+		c.Header(ReplayedHeader, "true")
+		c.Status(record.Response.Status)
+		_, _ = c.Writer.Write(record.Response.Body)
+		c.Abort()
+	}
+}
+
+// scopedKey prefixes the client's key with the route and the caller of the request: its actor once
+// authenticated, otherwise a hash of the credentials it sent, for routes authenticating in their
+// own handler chain
+func scopedKey(c *gin.Context, route, key string) string {
+	caller := "anonymous"
+	if actor, ok := domain.ActorFromContext(c.Request.Context()); ok {
+		caller = actor.String()
+	} else if credentials := c.GetHeader("Authorization") + c.GetHeader(auth.APIKeyHeader); credentials != "" {
+		sum := sha256.Sum256([]byte(credentials))
+		caller = "credentials:" + hex.EncodeToString(sum[:])
+	}
+	return route + "|" + caller + "|" + key
+}
+
+// requestFingerprint hashes what must not change between retries: the request path with its
+// query, and the body
+func requestFingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.URL.RequestURI()))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// recordingWriter copies the response body while writing it
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// abort writes an error response in the API's error format
+func abort(c *gin.Context, status int, code, message string) {
+	c.AbortWithStatusJSON(status, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+// routeKey builds the lookup key of a route
+func routeKey(method, path string) string {
+	return method + " " + strings.ToLower(path)
+}
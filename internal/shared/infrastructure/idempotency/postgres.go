@@ -0,0 +1,141 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// schema creates the table of the keys; it is shared by the routes and commands of every module
+// keeping keys in the database, so it is not created by the migrations of any of them
+const schema = `
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key VARCHAR(512) PRIMARY KEY,
+    fingerprint CHAR(64) NOT NULL,
+    status INTEGER,
+    header JSONB,
+    body BYTEA,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
+`
+
+// keyModel represents the idempotency key database model
+// Status is NULL while the request that claimed the key is still being processed
+type keyModel struct {
+	Key         string `gorm:"primaryKey"`
+	Fingerprint string
+	Status      *int
+	Header      []byte
+	Body        []byte
+	ExpiresAt   time.Time
+}
+
+// TableName returns the table name for GORM
+func (keyModel) TableName() string {
+	return "idempotency_keys"
+}
+
+// toRecord converts the model to a record
+func (m *keyModel) toRecord() (*Record, error) {
+	record := &Record{Fingerprint: m.Fingerprint, ExpiresAt: m.ExpiresAt}
+	if m.Status == nil {
+		return record, nil
+	}
+
+	record.Response = &Response{Status: *m.Status, Body: m.Body}
+	if len(m.Header) > 0 {
+		if err := json.Unmarshal(m.Header, &record.Response.Header); err != nil {
+			return nil, fmt.Errorf("failed to decode stored response headers: %w", err)
+		}
+	}
+	return record, nil
+}
+
+// PostgresStore keeps the keys in the idempotency_keys table of a PostgreSQL database, shared by
+// the instances of the service, so that a retry landing on another instance is replayed too
+type PostgresStore struct {
+	db     *gorm.DB
+	claims atomic.Int64
+}
+
+// NewPostgresStore creates the store, creating its table when it does not exist
+func NewPostgresStore(ctx context.Context, db *gorm.DB) (*PostgresStore, error) {
+	if err := db.WithContext(ctx).Exec(schema).Error; err != nil {
+		return nil, fmt.Errorf("failed to create idempotency keys table: %w", err)
+	}
+	return &PostgresStore{db: db}, nil
+}
+
+// Claim reserves the key or returns its unexpired record
+// The primary key makes the insert the arbiter when two retries race; an expired record is taken
+// over by the new request
+func (s *PostgresStore) Claim(ctx context.Context, key, fingerprint string, ttl time.Duration) (*Record, error) {
+	now := time.Now()
+	if s.claims.Add(1)%sweepEvery == 0 {
+		if err := s.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&keyModel{}).Error; err != nil {
+			return nil, fmt.Errorf("failed to remove expired idempotency keys: %w", err)
+		}
+	}
+
+	model := &keyModel{Key: key, Fingerprint: fingerprint, ExpiresAt: now.Add(ttl)}
+	result := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "key"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"fingerprint": fingerprint,
+			"status":      nil,
+			"header":      nil,
+			"body":        nil,
+			"expires_at":  model.ExpiresAt,
+		}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Lte{Column: clause.Column{Table: "idempotency_keys", Name: "expires_at"}, Value: now},
+		}},
+	}).Create(model)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to claim idempotency key: %w", result.Error)
+	}
+	if result.RowsAffected == 1 {
+		return nil, nil
+	}
+
+	var existing keyModel
+	if err := s.db.WithContext(ctx).Where("key = ?", key).First(&existing).Error; err != nil {
+		return nil, fmt.Errorf("failed to load idempotency key: %w", err)
+	}
+	return existing.toRecord()
+}
+
+// Complete stores the response of a claimed key
+func (s *PostgresStore) Complete(ctx context.Context, key string, response *Response, ttl time.Duration) error {
+	header, err := json.Marshal(response.Header)
+	if err != nil {
+		return fmt.Errorf("failed to encode response headers: %w", err)
+	}
+
+	err = s.db.WithContext(ctx).Model(&keyModel{}).
+		Where("key = ? AND status IS NULL", key).
+		Updates(map[string]interface{}{
+			"status":     response.Status,
+			"header":     header,
+			"body":       response.Body,
+			"expires_at": time.Now().Add(ttl),
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to store idempotent response: %w", err)
+	}
+	return nil
+}
+
+// Release removes a key whose response was not stored
+func (s *PostgresStore) Release(ctx context.Context, key string) error {
+	if err := s.db.WithContext(ctx).Where("key = ? AND status IS NULL", key).Delete(&keyModel{}).Error; err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Response is a captured response, replayed to retries of the request
+type Response struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   []byte      `json:"body,omitempty"`
+}
+
+// Record is the state of a key
+// Response is nil while the request that claimed the key is still being processed
+type Record struct {
+	Fingerprint string
+	Response    *Response
+	ExpiresAt   time.Time
+}
+
+// IsCompleted checks if the response of the key was stored
+func (r *Record) IsCompleted() bool {
+	return r.Response != nil
+}
+
+// Store keeps the first response for each key until it expires
+type Store interface {
+	// Claim reserves the key for a new request with the given fingerprint and returns nil,
+	// or returns the existing record of the key
+	Claim(ctx context.Context, key, fingerprint string, ttl time.Duration) (*Record, error)
+	// Complete stores the response of the request that claimed the key
+	Complete(ctx context.Context, key string, response *Response, ttl time.Duration) error
+	// Release frees a claimed key whose request did not complete, so that it can be retried
+	Release(ctx context.Context, key string) error
+}
+
+// MemoryStore is a Store held in process memory
+// Keys are not shared between instances, so deployments with several instances need sticky
+// clients or a shared Store
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+	claims  int
+}
+
+// sweepEvery is the number of claims between removals of expired records
+const sweepEvery = 1024
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]*Record)}
+}
+
+// Claim reserves the key or returns its unexpired record
+func (s *MemoryStore) Claim(ctx context.Context, key, fingerprint string, ttl time.Duration) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.claims++
+	if s.claims%sweepEvery == 0 {
+		s.sweep(now)
+	}
+
+	if existing, ok := s.records[key]; ok && now.Before(existing.ExpiresAt) {
+		record := *existing
+		return &record, nil
+	}
+
+	s.records[key] = &Record{Fingerprint: fingerprint, ExpiresAt: now.Add(ttl)}
+	return nil, nil
+}
+
+// Complete stores the response of a claimed key
+func (s *MemoryStore) Complete(ctx context.Context, key string, response *Response, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok && record.Response == nil {
+		record.Response = response
+		record.ExpiresAt = time.Now().Add(ttl)
+	}
+	return nil
+}
+
+// Release removes a key whose response was not stored
+func (s *MemoryStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok && record.Response == nil {
+		delete(s.records, key)
+	}
+	return nil
+}
+
+// sweep removes the expired records
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, record := range s.records {
+		if !now.Before(record.ExpiresAt) {
+			delete(s.records, key)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package importexport
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// flushInterval is the number of records written between flushes of an export
+const flushInterval = 100
+
+// Column is a column of an export
+type Column[T any] struct {
+	Name string
+	// Value returns the value of the column for a record
+	Value     func(record T) string
+	Numeric   bool
+	Untrusted bool
+}
+
+// Exporter writes records of type T to files
+type Exporter[T any] struct {
+	// Name prefixes the names of the exported files, e.g. "orders"
+	Name    string
+	Columns []Column[T]
+	// Document returns what NDJSON exports write for a record; without it, they write the values
+	// of the columns
+	Document func(record T) any
+}
+
+// Source streams records to fn, returning the errors of fn unchanged
+type Source[T any] func(fn func(record T) error) error
+
+// Stream writes the records of source to the response as a download in the format
+// The response starts with the first record, so an error returned before, e.g. an invalid filter,
+// is returned for the caller to report; once started, a failure can only cut the file short, and is
+// logged
+func (e *Exporter[T]) Stream(c *gin.Context, format Format, source Source[T]) error {
+	writer := NewWriter(format, c.Writer)
+	headers := e.headers()
+
+	started := false
+	start := func() error {
+		started = true
+		setDownloadHeaders(c, e.Name, format)
+		return writer.Begin(headers)
+	}
+
+	count := 0
+	err := source(func(record T) error {
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		if err := writer.Write(e.values(record), e.document(record)); err != nil {
+			return err
+		}
+		count++
+		if count%flushInterval == 0 {
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			c.Writer.Flush()
+		}
+		return nil
+	})
+	if err != nil && !started {
+		return err
+	}
+	if err != nil {
+		// The status line is already sent, so the truncated file is all the client gets
+		zap.L().Warn("export aborted", zap.String("export", e.Name), zap.Int("records", count), zap.Error(err))
+		return nil
+	}
+
+	if !started {
+		if err := start(); err != nil {
+			zap.L().Warn("failed to write export", zap.String("export", e.Name), zap.Error(err))
+			return nil
+		}
+	}
+	if err := writer.Close(); err != nil {
+		zap.L().Warn("failed to write export", zap.String("export", e.Name), zap.Error(err))
+	}
+	return nil
+}
+
+// headers returns the headers of the columns
+func (e *Exporter[T]) headers() []Header {
+	headers := make([]Header, len(e.Columns))
+	for i, column := range e.Columns {
+		headers[i] = Header{Name: column.Name, Numeric: column.Numeric, Untrusted: column.Untrusted}
+	}
+	return headers
+}
+
+// values returns the values of the columns for a record
+func (e *Exporter[T]) values(record T) []string {
+	values := make([]string, len(e.Columns))
+	for i, column := range e.Columns {
+		values[i] = column.Value(record)
+	}
+	return values
+}
+
+// document returns the NDJSON document of a record, nil for the values of the columns
+func (e *Exporter[T]) document(record T) any {
+	if e.Document == nil {
+		return nil
+	}
+	return e.Document(record)
+}
+
+// setDownloadHeaders sends the status line and download headers of a file named after name and
+// the current time
+func setDownloadHeaders(c *gin.Context, name string, format Format) {
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().UTC().Format("20060102T150405Z"), format)
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+	c.Writer.WriteHeaderNow()
+}
@@ -0,0 +1,65 @@
+// Package importexport streams the records of modules to and from files: exports write CSV,
+// NDJSON or XLSX as rows are read, and imports run as tracked jobs reading a file row by row
+// through a validation pipeline, keeping the rows that failed for an error report. Modules describe
+// their columns and plug in how a row is parsed, validated and applied.
+package importexport
+
+import (
+	"mime"
+	"path"
+	"strings"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Format is a file format of imports and exports
+type Format string
+
+// Supported formats
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+	FormatXLSX   Format = "xlsx"
+)
+
+// formats lists the supported formats, in the order error messages name them
+var formats = []Format{FormatCSV, FormatNDJSON, FormatXLSX}
+
+// ParseFormat parses a format name, returning fallback when it is empty
+func ParseFormat(name string, fallback Format) (Format, error) {
+	if name == "" {
+		return fallback, nil
+	}
+	for _, format := range formats {
+		if strings.EqualFold(name, string(format)) {
+			return format, nil
+		}
+	}
+	return "", domain.NewValidationErrorWithValue("format", "format must be one of: csv, ndjson, xlsx", name)
+}
+
+// ContentType returns the media type of files in the format
+func (f Format) ContentType() string {
+	switch f {
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// formatOf returns the format of a file from its name or media type, empty when neither names one
+func formatOf(filename, contentType string) Format {
+	if format, err := ParseFormat(strings.TrimPrefix(path.Ext(filename), "."), ""); err == nil && format != "" {
+		return format
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	for _, format := range formats {
+		candidate, _, _ := mime.ParseMediaType(format.ContentType())
+		if mediaType == candidate {
+			return format
+		}
+	}
+	return ""
+}
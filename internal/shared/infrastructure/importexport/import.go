@@ -0,0 +1,190 @@
+package importexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// DefaultMaxErrors is the number of invalid rows after which an import stops
+const DefaultMaxErrors = 1000
+
+// Field is a column read by an import
+type Field struct {
+	Name     string
+	Required bool
+}
+
+// Validator checks a parsed record before it is applied
+type Validator[T any] func(ctx context.Context, record T) error
+
+// Importer reads records of type T from files and applies them row by row: each row is parsed,
+// checked by the validators in order and applied, unless the import is a dry run
+// Rows failing any step are recorded with their errors and skipped; the other rows are applied
+type Importer[T any] struct {
+	// Kind names the jobs of the importer, e.g. "customers"
+	Kind   string
+	Fields []Field
+	Parse  func(row Row) (T, error)
+	// Key returns the key of a record; a record with the key of an earlier row of the file is
+	// rejected, with the error on KeyField
+	Key       func(record T) string
+	KeyField  string
+	Validate  []Validator[T]
+	Apply     func(ctx context.Context, record T) error
+	MaxErrors int
+}
+
+// Start starts a job importing the file, which runs after the request; it is canceled when the
+// jobs are stopped, and keeps the values of ctx, such as the actor and tenant
+func (i *Importer[T]) Start(ctx context.Context, jobs *Jobs, file File, dryRun bool) (Job, error) {
+	reader, err := NewReader(file.Format, file.Data)
+	if err != nil {
+		return Job{}, domain.NewValidationError("file", err.Error())
+	}
+	return jobs.start(ctx, i.Kind, file, dryRun, func(ctx context.Context, job *job) error {
+		defer reader.Close()
+		return i.run(ctx, reader, job, dryRun)
+	})
+}
+
+// run imports the rows of reader, failing the job when the file cannot be read, lacks required
+// columns or has more than MaxErrors invalid rows
+func (i *Importer[T]) run(ctx context.Context, reader Reader, job *job, dryRun bool) error {
+	maxErrors := i.MaxErrors
+	if maxErrors <= 0 {
+		maxErrors = DefaultMaxErrors
+	}
+	keys := make(map[string]int)
+	checked := false
+
+	for {
+		row, err := reader.Next()
+		if !checked && reader.Columns() != nil {
+			if err := i.checkColumns(reader.Columns()); err != nil {
+				return err
+			}
+			job.setColumns(reader.Columns())
+			checked = true
+		}
+
+		var rowErr *RowError
+		switch {
+		case err == io.EOF:
+			return nil
+		case errors.As(err, &rowErr):
+			job.fail(rowErr.Number, nil, []FieldError{{Message: rowErr.Err.Error()}})
+		case err != nil:
+			return err
+		default:
+			if problems := i.process(ctx, row, keys, dryRun); problems != nil {
+				job.fail(row.Number, row.Fields, problems)
+			} else {
+				job.succeed()
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if job.failures() >= maxErrors {
+			return fmt.Errorf("stopped after %d invalid rows", maxErrors)
+		}
+	}
+}
+
+// checkColumns returns an error naming the required fields the columns of the file lack
+func (i *Importer[T]) checkColumns(columns []string) error {
+	present := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		present[column] = true
+	}
+	var missing []string
+	for _, field := range i.Fields {
+		if field.Required && !present[field.Name] {
+			missing = append(missing, field.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required columns: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// process parses, validates and applies a row, returning its errors
+func (i *Importer[T]) process(ctx context.Context, row Row, keys map[string]int, dryRun bool) []FieldError {
+	var problems []FieldError
+	for _, field := range i.Fields {
+		if field.Required && row.Get(field.Name) == "" {
+			problems = append(problems, FieldError{Field: field.Name, Message: field.Name + " is required"})
+		}
+	}
+	if problems != nil {
+		return problems
+	}
+
+	record, err := i.Parse(row)
+	if err != nil {
+		return fieldErrors(err)
+	}
+
+	if i.Key != nil {
+		if key := i.Key(record); key != "" {
+			if first, ok := keys[key]; ok {
+				return []FieldError{{Field: i.KeyField, Message: fmt.Sprintf("duplicates row %d", first)}}
+			}
+			keys[key] = row.Number
+		}
+	}
+
+	for _, validate := range i.Validate {
+		if err := validate(ctx, record); err != nil {
+			return fieldErrors(err)
+		}
+	}
+
+	if dryRun {
+		return nil
+	}
+	if err := i.Apply(ctx, record); err != nil {
+		return fieldErrors(err)
+	}
+	return nil
+}
+
+// FieldError is an error of a row, on one of its fields when Field is set
+type FieldError struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// fieldErrors returns the errors of a row from the error of a step, one per field for validation
+// errors
+func fieldErrors(err error) []FieldError {
+	var validationErrs domain.ValidationErrors
+	if errors.As(err, &validationErrs) && len(validationErrs) > 0 {
+		problems := make([]FieldError, len(validationErrs))
+		for i, validationErr := range validationErrs {
+			problems[i] = FieldError{Field: validationErr.Field, Message: validationErr.Message}
+		}
+		return problems
+	}
+
+	var validationErr domain.ValidationError
+	if errors.As(err, &validationErr) {
+		return []FieldError{{Field: validationErr.Field, Message: validationErr.Message}}
+	}
+	var domainErr domain.DomainError
+	if errors.As(err, &domainErr) {
+		return []FieldError{{Field: domainErr.Field, Message: domainErr.Message}}
+	}
+	var businessErr domain.BusinessRuleError
+	if errors.As(err, &businessErr) {
+		return []FieldError{{Message: businessErr.Message}}
+	}
+	return []FieldError{{Message: err.Error()}}
+}
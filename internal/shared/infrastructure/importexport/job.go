@@ -0,0 +1,308 @@
+package importexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/worker"
+)
+
+const (
+	// maxJobs is the number of jobs kept; the oldest finished jobs are forgotten first
+	maxJobs = 100
+	// previewErrors is the number of row errors shown with a job
+	previewErrors = 20
+)
+
+// Status is the status of a job
+type Status string
+
+// Job statuses
+const (
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+// Job is the state of an import at a point in time
+type Job struct {
+	ID       string `json:"id"`
+	Kind     string `json:"kind"`
+	Filename string `json:"filename,omitempty"`
+	Format   Format `json:"format"`
+	DryRun   bool   `json:"dry_run"`
+	Status   Status `json:"status"`
+	// Processed counts the rows read; Succeeded the rows applied, or found valid by a dry run
+	Processed int `json:"processed"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	// Error is why a failed job stopped
+	Error string `json:"error,omitempty"`
+	// Errors holds the first row errors; the error report has them all
+	Errors     []RowFailure `json:"errors,omitempty"`
+	CreatedAt  time.Time    `json:"created_at"`
+	FinishedAt *time.Time   `json:"finished_at,omitempty"`
+}
+
+// RowFailure is a row that failed to import
+type RowFailure struct {
+	Row    int          `json:"row"`
+	Errors []FieldError `json:"errors"`
+	values map[string]string
+}
+
+// job is a tracked import
+type job struct {
+	mu       sync.Mutex
+	state    Job
+	failed   []RowFailure
+	columns  []string
+	finished bool
+}
+
+func (j *job) setColumns(columns []string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.columns = columns
+}
+
+func (j *job) succeed() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.state.Processed++
+	j.state.Succeeded++
+}
+
+func (j *job) fail(row int, values map[string]string, problems []FieldError) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.state.Processed++
+	j.state.Failed++
+	j.failed = append(j.failed, RowFailure{Row: row, Errors: problems, values: values})
+}
+
+func (j *job) failures() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.state.Failed
+}
+
+// finish records the end of the job, failed with err when it is not nil
+func (j *job) finish(err error) Job {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	now := time.Now().UTC()
+	j.state.FinishedAt = &now
+	j.state.Status = StatusCompleted
+	if err != nil {
+		j.state.Status = StatusFailed
+		j.state.Error = err.Error()
+	}
+	j.finished = true
+	return j.state
+}
+
+// snapshot returns the state of the job with its first row errors
+func (j *job) snapshot() Job {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	state := j.state
+	state.Errors = append([]RowFailure(nil), j.failed[:min(len(j.failed), previewErrors)]...)
+	return state
+}
+
+// Jobs runs the imports of a module as workers and keeps track of them in memory, so that jobs are
+// lost when the process restarts
+type Jobs struct {
+	module  string
+	workers *worker.Manager
+	audit   domain.AuditWriter
+
+	mu    sync.Mutex
+	jobs  map[string]*job
+	order []string
+}
+
+// NewJobs creates the import jobs of a module run by its workers; finished imports are audited
+// when audit is not nil
+func NewJobs(module string, workers *worker.Manager, audit domain.AuditWriter) *Jobs {
+	return &Jobs{
+		module:  module,
+		workers: workers,
+		audit:   audit,
+		jobs:    make(map[string]*job),
+	}
+}
+
+// Get returns a job of the kind
+func (j *Jobs) Get(kind, id string) (Job, error) {
+	tracked, err := j.lookup(kind, id)
+	if err != nil {
+		return Job{}, err
+	}
+	return tracked.snapshot(), nil
+}
+
+// start tracks a new job and runs it as a worker
+func (j *Jobs) start(ctx context.Context, kind string, file File, dryRun bool, run func(ctx context.Context, job *job) error) (Job, error) {
+	tracked := &job{state: Job{
+		ID:        uuid.New().String(),
+		Kind:      kind,
+		Filename:  file.Name,
+		Format:    file.Format,
+		DryRun:    dryRun,
+		Status:    StatusRunning,
+		CreatedAt: time.Now().UTC(),
+	}}
+
+	// The job outlives the request: it keeps its values but not its cancellation
+	detached := context.WithoutCancel(ctx)
+	err := j.workers.Go(kind+"_import_"+tracked.state.ID, func(workerCtx context.Context) error {
+		ctx, cancel := context.WithCancel(detached)
+		defer cancel()
+		stop := context.AfterFunc(workerCtx, cancel)
+		defer stop()
+
+		state := tracked.finish(run(ctx, tracked))
+		j.record(ctx, state)
+		// The outcome is on the job; returning an error would run the import again
+		return nil
+	})
+	if errors.Is(err, worker.ErrBudgetExceeded) {
+		return Job{}, domain.NewBusinessRuleError("import_capacity", "too many jobs are running, retry later")
+	}
+	if err != nil {
+		return Job{}, fmt.Errorf("failed to start import: %w", err)
+	}
+
+	j.track(tracked)
+	return tracked.snapshot(), nil
+}
+
+// track adds a job, forgetting the oldest finished jobs beyond maxJobs
+func (j *Jobs) track(tracked *job) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.jobs[tracked.state.ID] = tracked
+	j.order = append(j.order, tracked.state.ID)
+
+	for i := 0; len(j.order) > maxJobs && i < len(j.order); {
+		old := j.jobs[j.order[i]]
+		old.mu.Lock()
+		finished := old.finished
+		old.mu.Unlock()
+		if !finished {
+			i++
+			continue
+		}
+		delete(j.jobs, j.order[i])
+		j.order = append(j.order[:i], j.order[i+1:]...)
+	}
+}
+
+// lookup returns a tracked job of the kind
+func (j *Jobs) lookup(kind, id string) (*job, error) {
+	j.mu.Lock()
+	tracked, ok := j.jobs[id]
+	j.mu.Unlock()
+	if !ok || tracked.state.Kind != kind {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "import job not found")
+	}
+	return tracked, nil
+}
+
+// record audits a finished import; dry runs change nothing and are not audited
+func (j *Jobs) record(ctx context.Context, state Job) {
+	if j.audit == nil || state.DryRun {
+		return
+	}
+	entry := domain.NewAuditEntry(ctx, state.Kind+".import")
+	entry.Module = j.module
+	entry.AggregateID = state.ID
+	entry.Detail = fmt.Sprintf("imported %d of %d rows of %s, %d failed", state.Succeeded, state.Processed, state.Filename, state.Failed)
+	if state.Status == StatusFailed {
+		entry.Outcome = domain.AuditFailure
+		entry.Detail += ": " + state.Error
+	}
+	if err := j.audit.Write(ctx, entry); err != nil {
+		zap.L().Warn("failed to write audit entry", zap.String("action", entry.Action), zap.Error(err))
+	}
+}
+
+// WriteErrors writes the report of the rows of a job that failed as a download in the format: their
+// values, with the row they were read from and their errors
+// It returns the error of an unknown job, before anything is written
+func (j *Jobs) WriteErrors(c *gin.Context, kind, id string, format Format) error {
+	tracked, err := j.lookup(kind, id)
+	if err != nil {
+		return err
+	}
+
+	tracked.mu.Lock()
+	failed := append([]RowFailure(nil), tracked.failed...)
+	columns := tracked.columns
+	tracked.mu.Unlock()
+
+	// NDJSON files name their columns in each row
+	if columns == nil {
+		seen := make(map[string]bool)
+		for _, failure := range failed {
+			for column := range failure.values {
+				if !seen[column] {
+					seen[column] = true
+					columns = append(columns, column)
+				}
+			}
+		}
+		sort.Strings(columns)
+	}
+
+	headers := []Header{{Name: "row", Numeric: true}}
+	for _, column := range columns {
+		headers = append(headers, Header{Name: column, Untrusted: true})
+	}
+	headers = append(headers, Header{Name: "errors", Untrusted: true})
+
+	writer := NewWriter(format, c.Writer)
+	setDownloadHeaders(c, kind+"-import-errors", format)
+	if err := writer.Begin(headers); err != nil {
+		zap.L().Warn("failed to write import errors", zap.String("job", id), zap.Error(err))
+		return nil
+	}
+	for _, failure := range failed {
+		values := make([]string, 0, len(headers))
+		values = append(values, strconv.Itoa(failure.Row))
+		for _, column := range columns {
+			values = append(values, failure.values[column])
+		}
+		messages := make([]string, len(failure.Errors))
+		for i, problem := range failure.Errors {
+			messages[i] = problem.Message
+			if problem.Field != "" && !strings.HasPrefix(problem.Message, problem.Field) {
+				messages[i] = problem.Field + ": " + problem.Message
+			}
+		}
+		values = append(values, strings.Join(messages, "; "))
+
+		document := map[string]any{"row": failure.Row, "values": failure.values, "errors": failure.Errors}
+		if err := writer.Write(values, document); err != nil {
+			zap.L().Warn("failed to write import errors", zap.String("job", id), zap.Error(err))
+			return nil
+		}
+	}
+	if err := writer.Close(); err != nil {
+		zap.L().Warn("failed to write import errors", zap.String("job", id), zap.Error(err))
+	}
+	return nil
+}
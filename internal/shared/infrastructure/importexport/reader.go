@@ -0,0 +1,195 @@
+package importexport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxLine is the longest NDJSON line read
+const maxLine = 1 << 20
+
+// Row is a record read from a file
+type Row struct {
+	// Number is the line of the record in CSV and NDJSON files, and its row in XLSX sheets
+	Number int
+	// Fields holds the values of the record by column; columns the record leaves empty are absent
+	Fields map[string]string
+}
+
+// Get returns the value of a column of the row
+func (r Row) Get(column string) string {
+	return r.Fields[column]
+}
+
+// RowError is returned by Reader.Next for a record that cannot be read; reading goes on with the
+// next record
+type RowError struct {
+	Number int
+	Err    error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Number, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Reader reads the records of a file
+// CSV and XLSX files name their columns in their first row; column names are compared lowercased
+type Reader interface {
+	// Columns returns the columns of the file once its first record was read, nil for NDJSON
+	Columns() []string
+	// Next returns the next record, a *RowError for a record that cannot be read, or io.EOF
+	Next() (Row, error)
+	Close() error
+}
+
+// NewReader creates a reader of data in the format
+func NewReader(format Format, data []byte) (Reader, error) {
+	switch format {
+	case FormatNDJSON:
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		scanner.Buffer(make([]byte, 0, 64<<10), maxLine)
+		return &ndjsonReader{scanner: scanner}, nil
+	case FormatXLSX:
+		return newXLSXReader(data)
+	}
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	return &csvReader{csv: reader}, nil
+}
+
+// csvReader reads the records of a CSV file under its header row
+type csvReader struct {
+	csv     *csv.Reader
+	columns []string
+}
+
+func (r *csvReader) Columns() []string {
+	return r.columns
+}
+
+func (r *csvReader) Next() (Row, error) {
+	for {
+		record, err := r.csv.Read()
+		if err == io.EOF {
+			return Row{}, io.EOF
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			if r.columns == nil {
+				return Row{}, fmt.Errorf("invalid csv header: %w", err)
+			}
+			return Row{}, &RowError{Number: parseErr.StartLine, Err: parseErr.Err}
+		}
+		if err != nil {
+			return Row{}, fmt.Errorf("failed to read csv: %w", err)
+		}
+		if blank(record) {
+			continue
+		}
+		if r.columns == nil {
+			r.columns = normalizeColumns(record)
+			continue
+		}
+		line, _ := r.csv.FieldPos(0)
+		return newRow(line, r.columns, record), nil
+	}
+}
+
+func (r *csvReader) Close() error { return nil }
+
+// ndjsonReader reads a JSON object per line; strings are read as they are, null as absent, and
+// other values as their JSON
+type ndjsonReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (r *ndjsonReader) Columns() []string { return nil }
+
+func (r *ndjsonReader) Next() (Row, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(line, &object); err != nil {
+			return Row{}, &RowError{Number: r.line, Err: errors.New("line is not a JSON object")}
+		}
+		fields := make(map[string]string, len(object))
+		for key, raw := range object {
+			value := string(raw)
+			if value == "null" {
+				continue
+			}
+			if raw[0] == '"' {
+				if err := json.Unmarshal(raw, &value); err != nil {
+					return Row{}, &RowError{Number: r.line, Err: err}
+				}
+			}
+			if value = strings.TrimSpace(value); value != "" {
+				fields[strings.ToLower(strings.TrimSpace(key))] = value
+			}
+		}
+		return Row{Number: r.line, Fields: fields}, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return Row{}, fmt.Errorf("line %d is longer than %d bytes", r.line+1, maxLine)
+		}
+		return Row{}, fmt.Errorf("failed to read ndjson: %w", err)
+	}
+	return Row{}, io.EOF
+}
+
+func (r *ndjsonReader) Close() error { return nil }
+
+// normalizeColumns lowercases and trims the names of a header row
+func normalizeColumns(header []string) []string {
+	columns := make([]string, len(header))
+	for i, name := range header {
+		columns[i] = strings.ToLower(strings.TrimSpace(name))
+	}
+	return columns
+}
+
+// newRow pairs the values of a record with the columns of the header, dropping empty values and
+// values past the last column
+func newRow(number int, columns, values []string) Row {
+	fields := make(map[string]string, len(columns))
+	for i, value := range values {
+		if i >= len(columns) {
+			break
+		}
+		if columns[i] == "" {
+			continue
+		}
+		if value = strings.TrimSpace(value); value != "" {
+			fields[columns[i]] = value
+		}
+	}
+	return Row{Number: number, Fields: fields}
+}
+
+// blank reports whether every value of a record is empty
+func blank(values []string) bool {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+package importexport
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/httplimit"
+)
+
+// DefaultMaxUpload is the default size limit of imported files, 32 MiB
+const DefaultMaxUpload = 32 << 20
+
+// File is an uploaded file to import
+type File struct {
+	Name   string
+	Format Format
+	Data   []byte
+}
+
+// Upload reads the file to import from a multipart/form-data request, as its "file" part, or from
+// the request body
+// Its format is ?format=, or else the extension of its name or its content type
+func Upload(c *gin.Context, maxBytes int64) (File, error) {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxUpload
+	}
+
+	var file File
+	contentType := c.ContentType()
+	if contentType == "multipart/form-data" {
+		// Leaves room for the other parts and the boundaries
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes+1<<20)
+		header, err := c.FormFile("file")
+		if httplimit.Interrupted(err) {
+			return File{}, httplimit.ReadError(err)
+		}
+		if err != nil {
+			return File{}, domain.NewValidationError("file", "file is required")
+		}
+		if header.Size > maxBytes {
+			return File{}, tooLarge(maxBytes)
+		}
+		part, err := header.Open()
+		if err != nil {
+			return File{}, fmt.Errorf("failed to read upload: %w", err)
+		}
+		defer part.Close()
+		if file.Data, err = io.ReadAll(part); err != nil {
+			return File{}, fmt.Errorf("failed to read upload: %w", err)
+		}
+		file.Name = header.Filename
+		contentType = header.Header.Get("Content-Type")
+	} else {
+		data, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes))
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return File{}, tooLarge(maxBytes)
+		}
+		if httplimit.Interrupted(err) {
+			return File{}, httplimit.ReadError(err)
+		}
+		if err != nil {
+			return File{}, fmt.Errorf("failed to read upload: %w", err)
+		}
+		file.Data = data
+	}
+	if len(bytes.TrimSpace(file.Data)) == 0 {
+		return File{}, domain.NewValidationError("file", "file is empty")
+	}
+
+	format, err := ParseFormat(c.Query("format"), formatOf(file.Name, contentType))
+	if err != nil {
+		return File{}, err
+	}
+	if format == "" {
+		return File{}, domain.NewValidationError("format", "format is required: pass ?format=csv|ndjson|xlsx or upload a file with that extension")
+	}
+	file.Format = format
+	return file, nil
+}
+
+// tooLarge returns the error of an upload larger than maxBytes
+func tooLarge(maxBytes int64) error {
+	return domain.NewDomainErrorWithField(domain.ErrCodeRequestTooLarge, fmt.Sprintf("file is larger than %d MiB", maxBytes>>20), "file")
+}
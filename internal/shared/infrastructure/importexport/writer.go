@@ -0,0 +1,114 @@
+package importexport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Header describes a column of a written file
+type Header struct {
+	Name string
+	// Numeric values are written as numbers in XLSX
+	Numeric bool
+	// Untrusted values, e.g. names typed by customers, are neutralized in CSV so that spreadsheet
+	// applications do not evaluate them as formulas
+	Untrusted bool
+}
+
+// Writer writes records in one format
+type Writer interface {
+	// Begin writes the header of the file
+	Begin(headers []Header) error
+	// Write writes a record: its values, one per header, or document in NDJSON
+	Write(values []string, document any) error
+	// Flush sends the records written so far to the underlying writer, when the format allows it
+	Flush() error
+	// Close ends the file; the underlying writer is left open
+	Close() error
+}
+
+// NewWriter creates a writer of the format to w
+func NewWriter(format Format, w io.Writer) Writer {
+	switch format {
+	case FormatNDJSON:
+		return &ndjsonWriter{encoder: json.NewEncoder(w)}
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	}
+	return &csvWriter{csv: csv.NewWriter(w)}
+}
+
+// csvWriter writes records as CSV rows under a header row
+type csvWriter struct {
+	csv     *csv.Writer
+	headers []Header
+}
+
+func (w *csvWriter) Begin(headers []Header) error {
+	w.headers = headers
+	names := make([]string, len(headers))
+	for i, header := range headers {
+		names[i] = header.Name
+	}
+	return w.csv.Write(names)
+}
+
+func (w *csvWriter) Write(values []string, _ any) error {
+	for i, value := range values {
+		if i < len(w.headers) && w.headers[i].Untrusted {
+			values[i] = csvSafe(value)
+		}
+	}
+	return w.csv.Write(values)
+}
+
+func (w *csvWriter) Flush() error {
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func (w *csvWriter) Close() error {
+	return w.Flush()
+}
+
+// csvSafe neutralizes values that spreadsheet applications would evaluate as formulas
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// ndjsonWriter writes the document of each record as a line of JSON; without a document, the values
+// are written as an object keyed by header
+type ndjsonWriter struct {
+	encoder *json.Encoder
+	headers []Header
+}
+
+func (w *ndjsonWriter) Begin(headers []Header) error {
+	w.headers = headers
+	return nil
+}
+
+func (w *ndjsonWriter) Write(values []string, document any) error {
+	if document == nil {
+		object := make(map[string]string, len(values))
+		for i, value := range values {
+			if i < len(w.headers) {
+				object[w.headers[i].Name] = value
+			}
+		}
+		document = object
+	}
+	if err := w.encoder.Encode(document); err != nil {
+		return fmt.Errorf("failed to encode record: %w", err)
+	}
+	return nil
+}
+
+func (w *ndjsonWriter) Flush() error { return nil }
+func (w *ndjsonWriter) Close() error { return nil }
@@ -0,0 +1,405 @@
+package importexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// The parts of a workbook of a single sheet, written before its rows
+var xlsxParts = []struct{ name, body string }{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+	{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`},
+}
+
+// xlsxMaxCell is the maximum number of characters of a cell
+const xlsxMaxCell = 32767
+
+// xlsxWriter streams records to the single sheet of a workbook, with inline strings so that
+// nothing is held until the end
+type xlsxWriter struct {
+	zip     *zip.Writer
+	sheet   io.Writer
+	headers []Header
+	row     int
+	buf     bytes.Buffer
+}
+
+func newXLSXWriter(w io.Writer) *xlsxWriter {
+	return &xlsxWriter{zip: zip.NewWriter(w)}
+}
+
+func (w *xlsxWriter) Begin(headers []Header) error {
+	w.headers = headers
+	for _, part := range xlsxParts {
+		file, err := w.zip.Create(part.name)
+		if err != nil {
+			return fmt.Errorf("failed to write workbook: %w", err)
+		}
+		if _, err := io.WriteString(file, part.body); err != nil {
+			return fmt.Errorf("failed to write workbook: %w", err)
+		}
+	}
+
+	sheet, err := w.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return fmt.Errorf("failed to write workbook: %w", err)
+	}
+	w.sheet = sheet
+	if _, err := io.WriteString(w.sheet, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`); err != nil {
+		return fmt.Errorf("failed to write workbook: %w", err)
+	}
+
+	names := make([]string, len(headers))
+	for i, header := range headers {
+		names[i] = header.Name
+	}
+	return w.writeRow(names, false)
+}
+
+func (w *xlsxWriter) Write(values []string, _ any) error {
+	return w.writeRow(values, true)
+}
+
+// writeRow writes a row of cells, the values of numeric columns as numbers when typed
+func (w *xlsxWriter) writeRow(values []string, typed bool) error {
+	w.row++
+	w.buf.Reset()
+	fmt.Fprintf(&w.buf, `<row r="%d">`, w.row)
+	for i, value := range values {
+		ref := columnName(i) + strconv.Itoa(w.row)
+		if typed && i < len(w.headers) && w.headers[i].Numeric && value != "" {
+			if _, err := strconv.ParseFloat(value, 64); err == nil {
+				fmt.Fprintf(&w.buf, `<c r="%s"><v>%s</v></c>`, ref, value)
+				continue
+			}
+		}
+		if len(value) > xlsxMaxCell {
+			value = value[:xlsxMaxCell]
+		}
+		fmt.Fprintf(&w.buf, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+		xml.EscapeText(&w.buf, []byte(value))
+		w.buf.WriteString(`</t></is></c>`)
+	}
+	w.buf.WriteString(`</row>`)
+
+	if _, err := w.sheet.Write(w.buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write workbook: %w", err)
+	}
+	return nil
+}
+
+func (w *xlsxWriter) Flush() error {
+	return w.zip.Flush()
+}
+
+func (w *xlsxWriter) Close() error {
+	if w.sheet == nil {
+		if err := w.Begin(w.headers); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(w.sheet, `</sheetData></worksheet>`); err != nil {
+		return fmt.Errorf("failed to write workbook: %w", err)
+	}
+	return w.zip.Close()
+}
+
+// columnName returns the letters of the zero-based column i, e.g. A, Z, AA
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+// columnIndex returns the zero-based column of a cell reference such as B12, or -1
+func columnIndex(ref string) int {
+	index := 0
+	letters := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		index = index*26 + int(r-'A'+1)
+		letters++
+	}
+	if letters == 0 {
+		return -1
+	}
+	return index - 1
+}
+
+// xlsxReader reads the rows of the first sheet of a workbook
+// Cells are read as their text: formulas as their cached value, and dates, which workbooks store as
+// numbers, as those numbers
+type xlsxReader struct {
+	decoder *xml.Decoder
+	sheet   io.Closer
+	strings []string
+	columns []string
+}
+
+func newXLSXReader(data []byte) (*xlsxReader, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("not an xlsx workbook: %w", err)
+	}
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		files[file.Name] = file
+	}
+
+	sheetName, err := firstSheet(files)
+	if err != nil {
+		return nil, err
+	}
+	sheet, ok := files[sheetName]
+	if !ok {
+		return nil, fmt.Errorf("xlsx workbook has no sheet %s", sheetName)
+	}
+
+	r := &xlsxReader{}
+	if shared, ok := files["xl/sharedStrings.xml"]; ok {
+		if r.strings, err = readSharedStrings(shared); err != nil {
+			return nil, err
+		}
+	}
+
+	body, err := sheet.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read xlsx sheet: %w", err)
+	}
+	r.sheet = body
+	r.decoder = xml.NewDecoder(body)
+	return r, nil
+}
+
+// firstSheet returns the part of the first sheet of the workbook
+func firstSheet(files map[string]*zip.File) (string, error) {
+	var workbook struct {
+		Sheets []struct {
+			ID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	var rels struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if err := decodePart(files, "xl/workbook.xml", &workbook); err != nil {
+		return "", err
+	}
+	if err := decodePart(files, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", errors.New("xlsx workbook has no sheets")
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].ID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", errors.New("xlsx workbook has no first sheet")
+}
+
+// decodePart decodes an XML part of the workbook
+func decodePart(files map[string]*zip.File, name string, v any) error {
+	file, ok := files[name]
+	if !ok {
+		return fmt.Errorf("not an xlsx workbook: missing %s", name)
+	}
+	body, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("failed to read xlsx %s: %w", name, err)
+	}
+	defer body.Close()
+	if err := xml.NewDecoder(body).Decode(v); err != nil {
+		return fmt.Errorf("failed to read xlsx %s: %w", name, err)
+	}
+	return nil
+}
+
+// readSharedStrings reads the shared strings table; rich text is read as its plain text, without
+// phonetic hints
+func readSharedStrings(file *zip.File) ([]string, error) {
+	body, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read xlsx shared strings: %w", err)
+	}
+	defer body.Close()
+
+	var (
+		values  []string
+		current strings.Builder
+		inText  bool
+		skip    int
+	)
+	decoder := xml.NewDecoder(body)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return values, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read xlsx shared strings: %w", err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch {
+			case t.Name.Local == "rPh":
+				skip++
+			case t.Name.Local == "si":
+				current.Reset()
+			case t.Name.Local == "t" && skip == 0:
+				inText = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "rPh":
+				skip--
+			case "t":
+				inText = false
+			case "si":
+				values = append(values, current.String())
+			}
+		case xml.CharData:
+			if inText {
+				current.Write(t)
+			}
+		}
+	}
+}
+
+// Columns returns the header of the sheet, once the first row was read
+func (r *xlsxReader) Columns() []string {
+	return r.columns
+}
+
+func (r *xlsxReader) Next() (Row, error) {
+	for {
+		number, cells, err := r.nextRow()
+		if err != nil {
+			return Row{}, err
+		}
+		if blank(cells) {
+			continue
+		}
+		if r.columns == nil {
+			r.columns = normalizeColumns(cells)
+			continue
+		}
+		return newRow(number, r.columns, cells), nil
+	}
+}
+
+func (r *xlsxReader) Close() error {
+	return r.sheet.Close()
+}
+
+// nextRow reads the cells of the next row of the sheet
+func (r *xlsxReader) nextRow() (int, []string, error) {
+	var (
+		number  int
+		cells   []string
+		inRow   bool
+		column  int
+		kind    string
+		value   strings.Builder
+		inValue bool
+	)
+	for {
+		token, err := r.decoder.Token()
+		if err == io.EOF {
+			return 0, nil, io.EOF
+		}
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to read xlsx sheet: %w", err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "row":
+				inRow = true
+				number++
+				cells = cells[:0]
+				column = 0
+				if n, err := strconv.Atoi(attr(t, "r")); err == nil {
+					number = n
+				}
+			case "c":
+				kind = attr(t, "t")
+				if index := columnIndex(attr(t, "r")); index >= 0 {
+					column = index
+				}
+				value.Reset()
+			case "v", "t":
+				inValue = inRow
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "v", "t":
+				inValue = false
+			case "c":
+				for len(cells) <= column {
+					cells = append(cells, "")
+				}
+				cells[column] = r.cellValue(kind, value.String())
+				column++
+			case "row":
+				return number, cells, nil
+			}
+		case xml.CharData:
+			if inValue {
+				value.Write(t)
+			}
+		}
+	}
+}
+
+// cellValue returns the text of a cell of the kind
+func (r *xlsxReader) cellValue(kind, value string) string {
+	switch kind {
+	case "s":
+		if i, err := strconv.Atoi(value); err == nil && i >= 0 && i < len(r.strings) {
+			return r.strings[i]
+		}
+		return ""
+	case "b":
+		if value == "1" {
+			return "true"
+		}
+		return "false"
+	}
+	return value
+}
+
+// attr returns the value of an attribute of the element
+func attr(element xml.StartElement, name string) string {
+	for _, a := range element.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
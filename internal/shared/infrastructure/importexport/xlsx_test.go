@@ -0,0 +1,210 @@
+package importexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestColumnName(t *testing.T) {
+	tests := []struct {
+		index int
+		name  string
+	}{
+		{0, "A"}, {1, "B"}, {25, "Z"}, {26, "AA"}, {27, "AB"}, {51, "AZ"}, {52, "BA"},
+		{701, "ZZ"}, {702, "AAA"}, {16383, "XFD"},
+	}
+	for _, tt := range tests {
+		if got := columnName(tt.index); got != tt.name {
+			t.Errorf("columnName(%d) = %s, want %s", tt.index, got, tt.name)
+		}
+		if got := columnIndex(tt.name + "12"); got != tt.index {
+			t.Errorf("columnIndex(%s12) = %d, want %d", tt.name, got, tt.index)
+		}
+	}
+	if got := columnIndex("12"); got != -1 {
+		t.Errorf("columnIndex(12) = %d, want -1", got)
+	}
+}
+
+// readAll reads every row of a workbook
+func readAll(t *testing.T, data []byte) ([]string, []Row) {
+	t.Helper()
+	reader, err := NewReader(FormatXLSX, data)
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+	defer reader.Close()
+
+	var rows []Row
+	for {
+		row, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return reader.Columns(), rows
+		}
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		rows = append(rows, row)
+	}
+}
+
+func TestXLSX_RoundTrip(t *testing.T) {
+	// 30 columns, so that references run past Z
+	headers := make([]Header, 30)
+	for i := range headers {
+		headers[i] = Header{Name: "Col" + strconv.Itoa(i)}
+	}
+	headers[1].Numeric = true
+	headers[28].Numeric = true
+
+	records := [][]string{
+		make([]string, 30),
+		make([]string, 30),
+	}
+	records[0][0] = "Jane <jane@example.com> & co"
+	records[0][1] = "42.5"
+	records[0][26] = "  spaced  "
+	records[0][28] = "-7"
+	records[0][29] = "last"
+	records[1][1] = "not a number"
+	records[1][27] = "=SUM(A1)"
+
+	var buf bytes.Buffer
+	writer := NewWriter(FormatXLSX, &buf)
+	if err := writer.Begin(headers); err != nil {
+		t.Fatalf("Begin() error = %v", err)
+	}
+	for _, record := range records {
+		if err := writer.Write(record, nil); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	columns, rows := readAll(t, buf.Bytes())
+	if len(columns) != 30 || columns[0] != "col0" || columns[29] != "col29" {
+		t.Fatalf("columns = %v, want col0 to col29", columns)
+	}
+	want := []Row{
+		{Number: 2, Fields: map[string]string{"col0": "Jane <jane@example.com> & co", "col1": "42.5", "col26": "spaced", "col28": "-7", "col29": "last"}},
+		{Number: 3, Fields: map[string]string{"col1": "not a number", "col27": "=SUM(A1)"}},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("rows = %v, want %v", rows, want)
+	}
+
+	// Numeric values of numeric columns are numbers, others inline strings
+	sheet := part(t, buf.Bytes(), "xl/worksheets/sheet1.xml")
+	for _, cell := range []string{`<c r="B2"><v>42.5</v></c>`, `<c r="AC2"><v>-7</v></c>`, `<c r="B3" t="inlineStr">`, `<c r="AA2" t="inlineStr">`} {
+		if !bytes.Contains(sheet, []byte(cell)) {
+			t.Errorf("sheet has no %s", cell)
+		}
+	}
+}
+
+func TestXLSX_EmptyWorkbook(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewWriter(FormatXLSX, &buf)
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if columns, rows := readAll(t, buf.Bytes()); len(columns) != 0 || len(rows) != 0 {
+		t.Fatalf("read %v and %v from an empty workbook", columns, rows)
+	}
+}
+
+func TestXLSX_ReadsSpreadsheetApplicationWorkbooks(t *testing.T) {
+	// Workbooks saved by spreadsheet applications keep text in shared strings, leave empty cells out
+	// and may point to the sheet with an absolute target
+	sheet := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="s"><v>2</v></c><c r="AB1" t="s"><v>3</v></c></row>
+<row r="3"><c r="A3" t="s"><v>4</v></c><c r="C3"><v>1.5E+3</v></c><c r="AB3" t="inlineStr"><is><r><t>in</t></r><r><t>line</t></r></is></c></row>
+<row r="4"><c r="B4" t="b"><v>1</v></c><c r="C4" t="str"><f>A3&amp;"!"</f><v>Acme!</v></c><c r="D4" t="s"><v>99</v></c></row>
+<row r="5"><c r="A5" t="s"><v>5</v></c></row>
+</sheetData></worksheet>`
+	sharedStrings := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" count="6" uniqueCount="6">
+<si><t>Name</t></si><si><t>Active</t></si><si><t>Amount</t></si><si><t>Note</t></si>
+<si><r><t>Ac</t></r><r><rPr><b/></rPr><t>me</t></r><rPh sb="0" eb="1"><t>ignored</t></rPh></si>
+<si><t xml:space="preserve">  </t></si>
+</sst>`
+	data := workbook(t, map[string]string{
+		"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Data" sheetId="1" r:id="rId7"/><sheet name="Other" sheetId="2" r:id="rId8"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId8" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet2.xml"/><Relationship Id="rId7" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="/xl/worksheets/data.xml"/></Relationships>`,
+		"xl/sharedStrings.xml":     sharedStrings,
+		"xl/worksheets/data.xml":   sheet,
+		"xl/worksheets/sheet2.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData/></worksheet>`,
+	})
+
+	columns, rows := readAll(t, data)
+	wantColumns := make([]string, 28)
+	wantColumns[0], wantColumns[1], wantColumns[2], wantColumns[27] = "name", "active", "amount", "note"
+	if !reflect.DeepEqual(columns, wantColumns) {
+		t.Fatalf("columns = %q, want %q", columns, wantColumns)
+	}
+	want := []Row{
+		{Number: 3, Fields: map[string]string{"name": "Acme", "amount": "1.5E+3", "note": "inline"}},
+		{Number: 4, Fields: map[string]string{"active": "true", "amount": "Acme!"}},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("rows = %v, want %v", rows, want)
+	}
+}
+
+func TestXLSX_RejectsOtherFiles(t *testing.T) {
+	if _, err := NewReader(FormatXLSX, []byte("name,email\n")); err == nil {
+		t.Fatal("NewReader() read a CSV file as a workbook")
+	}
+	if _, err := NewReader(FormatXLSX, workbook(t, map[string]string{"word/document.xml": "<document/>"})); err == nil {
+		t.Fatal("NewReader() read a zip without workbook")
+	}
+}
+
+// workbook zips the parts of a workbook
+func workbook(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, body := range parts {
+		file, err := archive.Create(name)
+		if err != nil {
+			t.Fatalf("create %s: %v", name, err)
+		}
+		if _, err := io.WriteString(file, body); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatalf("close workbook: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// part returns the content of a part of a workbook
+func part(t *testing.T, data []byte, name string) []byte {
+	t.Helper()
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("open workbook: %v", err)
+	}
+	file, err := archive.Open(name)
+	if err != nil {
+		t.Fatalf("open %s: %v", name, err)
+	}
+	defer file.Close()
+	body, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("read %s: %v", name, err)
+	}
+	return body
+}
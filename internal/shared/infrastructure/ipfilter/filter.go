@@ -0,0 +1,119 @@
+// Package ipfilter restricts paths to, or blocks them from, client IP ranges.
+package ipfilter
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrCodeIPForbidden is the error code of requests rejected for their client IP
+const ErrCodeIPForbidden = "IP_FORBIDDEN"
+
+// Rule applies to the requests whose path is Path or below it
+// Clients in Deny are rejected; when Allow is not empty, only clients in it are accepted
+type Rule struct {
+	Path  string
+	Allow []netip.Prefix
+	Deny  []netip.Prefix
+}
+
+// NewRule parses the allowed and denied ranges of path, given as CIDRs or single addresses
+func NewRule(path string, allow, deny []string) (Rule, error) {
+	if !strings.HasPrefix(path, "/") {
+		return Rule{}, fmt.Errorf("ip rule path %q must start with /", path)
+	}
+	if len(allow) == 0 && len(deny) == 0 {
+		return Rule{}, fmt.Errorf("ip rule for %s lists no allowed or denied ranges", path)
+	}
+
+	rule := Rule{Path: strings.TrimSuffix(path, "/")}
+	var err error
+	if rule.Allow, err = ParsePrefixes(allow); err != nil {
+		return Rule{}, fmt.Errorf("ip rule for %s: %w", path, err)
+	}
+	if rule.Deny, err = ParsePrefixes(deny); err != nil {
+		return Rule{}, fmt.Errorf("ip rule for %s: %w", path, err)
+	}
+	return rule, nil
+}
+
+// ParsePrefixes parses CIDRs, e.g. "10.0.0.0/8", and single addresses, which are taken as
+// ranges of one address
+func ParsePrefixes(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if strings.Contains(value, "/") {
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR %q: %w", value, err)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP address %q: %w", value, err)
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return prefixes, nil
+}
+
+// Applies reports whether the rule covers path, matching whole segments so that /admin covers
+// /admin/debug but not /administrators
+func (r Rule) Applies(path string) bool {
+	if r.Path == "" {
+		return true
+	}
+	return path == r.Path || strings.HasPrefix(path, r.Path+"/")
+}
+
+// Permits reports whether the rule lets addr through
+func (r Rule) Permits(addr netip.Addr) bool {
+	if contains(r.Deny, addr) {
+		return false
+	}
+	return len(r.Allow) == 0 || contains(r.Allow, addr)
+}
+
+// contains reports whether addr falls in any of prefixes
+func contains(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Middleware rejects with 403 the requests of clients that a rule covering their path does not permit
+// The client IP is gin's ClientIP, so forwarded addresses count only from the engine's trusted proxies
+func Middleware(rules []Rule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path := c.Request.URL.Path
+		addr, err := netip.ParseAddr(c.ClientIP())
+		addr = addr.Unmap()
+		for _, rule := range rules {
+			if !rule.Applies(path) {
+				continue
+			}
+			// An address that cannot be parsed is in no range, so only deny lists let it through
+			if (err != nil && len(rule.Allow) > 0) || (err == nil && !rule.Permits(addr)) {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+					"success": false,
+					"error": gin.H{
+						"code":    ErrCodeIPForbidden,
+						"message": "Access from this address is not allowed",
+					},
+				})
+				return
+			}
+		}
+		c.Next()
+	}
+}
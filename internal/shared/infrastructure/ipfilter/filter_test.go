@@ -0,0 +1,97 @@
+package ipfilter
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestRuleApplies(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+		path string
+		want bool
+	}{
+		{name: "same path", rule: "/admin", path: "/admin", want: true},
+		{name: "below the path", rule: "/admin", path: "/admin/debug/pprof", want: true},
+		{name: "path with a trailing slash", rule: "/admin", path: "/admin/", want: true},
+		{name: "longer segment", rule: "/admin", path: "/administrator", want: false},
+		{name: "prefix of the path", rule: "/admin/debug", path: "/admin", want: false},
+		{name: "other path", rule: "/admin", path: "/api/v1/admin", want: false},
+		{name: "rule with a trailing slash", rule: "/admin/", path: "/admin", want: true},
+		{name: "rule with a trailing slash and a longer segment", rule: "/admin/", path: "/administrator", want: false},
+		{name: "root covers every path", rule: "/", path: "/api/v1/orders", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := NewRule(tt.rule, []string{"10.0.0.0/8"}, nil)
+			if err != nil {
+				t.Fatalf("NewRule returned %v", err)
+			}
+			if got := rule.Applies(tt.path); got != tt.want {
+				t.Fatalf("rule %s applies to %s: got %t, want %t", tt.rule, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRulePermits(t *testing.T) {
+	tests := []struct {
+		name  string
+		allow []string
+		deny  []string
+		addr  string
+		want  bool
+	}{
+		{name: "IPv4 in allowed CIDR", allow: []string{"10.0.0.0/8"}, addr: "10.1.2.3", want: true},
+		{name: "IPv4 outside allowed CIDR", allow: []string{"10.0.0.0/8"}, addr: "11.0.0.1", want: false},
+		{name: "IPv4 at the end of allowed CIDR", allow: []string{"192.168.0.0/24"}, addr: "192.168.0.255", want: true},
+		{name: "IPv4 past the end of allowed CIDR", allow: []string{"192.168.0.0/24"}, addr: "192.168.1.0", want: false},
+		{name: "unmasked CIDR", allow: []string{"192.168.0.17/24"}, addr: "192.168.0.1", want: true},
+		{name: "single IPv4 address", allow: []string{"203.0.113.7"}, addr: "203.0.113.7", want: true},
+		{name: "neighbour of single IPv4 address", allow: []string{"203.0.113.7"}, addr: "203.0.113.8", want: false},
+		{name: "IPv6 in allowed CIDR", allow: []string{"2001:db8::/32"}, addr: "2001:db8:1::1", want: true},
+		{name: "IPv6 outside allowed CIDR", allow: []string{"2001:db8::/32"}, addr: "2001:db9::1", want: false},
+		{name: "single IPv6 address", allow: []string{"::1"}, addr: "::1", want: true},
+		{name: "IPv6 against IPv4 CIDR", allow: []string{"10.0.0.0/8"}, addr: "2001:db8::1", want: false},
+		{name: "IPv4 against IPv6 CIDR", allow: []string{"::/0"}, addr: "10.0.0.1", want: false},
+		{name: "IPv4-mapped address allowed as IPv4", allow: []string{"::ffff:10.0.0.1"}, addr: "10.0.0.1", want: true},
+		{name: "denied", deny: []string{"10.0.0.0/8"}, addr: "10.1.2.3", want: false},
+		{name: "not denied", deny: []string{"10.0.0.0/8"}, addr: "11.0.0.1", want: true},
+		{name: "deny over allow", allow: []string{"10.0.0.0/8"}, deny: []string{"10.1.0.0/16"}, addr: "10.1.2.3", want: false},
+		{name: "allowed beside the denied range", allow: []string{"10.0.0.0/8"}, deny: []string{"10.1.0.0/16"}, addr: "10.2.0.1", want: true},
+		{name: "deny over allow of the same address", allow: []string{"2001:db8::1"}, deny: []string{"2001:db8::/64"}, addr: "2001:db8::1", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := NewRule("/admin", tt.allow, tt.deny)
+			if err != nil {
+				t.Fatalf("NewRule returned %v", err)
+			}
+			if got := rule.Permits(netip.MustParseAddr(tt.addr)); got != tt.want {
+				t.Fatalf("rule permits %s: got %t, want %t", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRuleRejectsInvalidRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  string
+		allow []string
+		deny  []string
+	}{
+		{name: "relative path", path: "admin", allow: []string{"10.0.0.0/8"}},
+		{name: "no ranges", path: "/admin"},
+		{name: "invalid CIDR", path: "/admin", allow: []string{"10.0.0.0/33"}},
+		{name: "invalid address", path: "/admin", deny: []string{"10.0.0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRule(tt.path, tt.allow, tt.deny); err == nil {
+				t.Fatal("NewRule accepted the rule")
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package lock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+	"golang_modular_monolith/internal/shared/infrastructure/database"
+)
+
+// Backend names
+const (
+	BackendMemory   = "memory"
+	BackendPostgres = "postgres"
+	BackendRedis    = "redis"
+)
+
+// NewFromConfig creates the locker of global.lock
+// The postgres backend uses a database registered with the global database manager
+func NewFromConfig(cfg *config.Config) (*Locker, error) {
+	var settings config.LockGlobalConfig
+	if cfg.Modules != nil {
+		settings = cfg.Modules.Global.Lock
+	}
+
+	switch settings.Backend {
+	case "", BackendMemory:
+		return New(NewMemoryBackend()), nil
+	case BackendPostgres:
+		if settings.Database == "" {
+			return nil, fmt.Errorf("lock database is required by the postgres backend")
+		}
+		db, err := database.GetGlobalManager().GetConnection(settings.Database)
+		if err != nil {
+			return nil, err
+		}
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sql.DB of %s: %w", settings.Database, err)
+		}
+		return New(NewPostgresBackend(sqlDB)), nil
+	case BackendRedis:
+		ttl, err := settings.GetTTL()
+		if err != nil {
+			return nil, err
+		}
+		addr := settings.Redis.Addr
+		if addr == "" {
+			addr = "localhost:6379"
+		}
+		client := redis.NewClient(&redis.Options{
+			Addr:     addr,
+			Password: settings.Redis.Password,
+			DB:       settings.Redis.DB,
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := client.Ping(ctx).Err(); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("failed to connect to lock server at %s: %w", addr, err)
+		}
+		return New(NewRedisBackend(client, ttl)), nil
+	default:
+		return nil, fmt.Errorf("lock backend %q is unknown", settings.Backend)
+	}
+}
@@ -0,0 +1,127 @@
+// Package lock provides named locks shared by every instance of the application, so that work such
+// as migrations or scheduled jobs runs on one instance at a time when several are deployed.
+// Locks are held in Postgres (advisory locks) or Redis; the in-memory backend only coordinates the
+// goroutines of one process, for single-instance deployments.
+package lock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrLocked is returned by TryAcquire when another holder has the lock
+	ErrLocked = errors.New("lock is held by another holder")
+	// ErrLost is returned when a lock was lost while its work ran, e.g. because the connection
+	// holding it dropped or its Redis key expired; the work may have overlapped another holder's
+	ErrLost = errors.New("lock was lost")
+)
+
+// DefaultRetryInterval is how often WithLock tries again to take a lock held by another holder
+const DefaultRetryInterval = time.Second
+
+// releaseTimeout bounds the release of a lock once its work is done
+const releaseTimeout = 5 * time.Second
+
+// Backend holds named locks
+type Backend interface {
+	// TryAcquire takes the named lock without waiting, or returns ErrLocked
+	TryAcquire(ctx context.Context, name string) (Lease, error)
+}
+
+// Lease is a lock held by this process
+type Lease interface {
+	// Lost is closed when the lock is no longer held, before Release was called
+	Lost() <-chan struct{}
+	// Release gives the lock up
+	Release(ctx context.Context) error
+}
+
+// Locker runs work under named locks
+type Locker struct {
+	backend       Backend
+	retryInterval time.Duration
+}
+
+// New creates a locker taking its locks from backend
+func New(backend Backend) *Locker {
+	return &Locker{
+		backend:       backend,
+		retryInterval: DefaultRetryInterval,
+	}
+}
+
+// WithLock waits for the named lock, then runs fn while holding it
+// The context of fn is canceled when the lock is lost, and WithLock then returns ErrLost
+func (l *Locker) WithLock(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	for {
+		lease, err := l.backend.TryAcquire(ctx, name)
+		if err == nil {
+			return l.run(ctx, name, lease, fn)
+		}
+		if !errors.Is(err, ErrLocked) {
+			return fmt.Errorf("failed to acquire lock %s: %w", name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(l.retryInterval):
+		}
+	}
+}
+
+// TryWithLock runs fn while holding the named lock, unless another holder has it, in which case
+// fn is skipped and ran is false
+// Scheduled jobs use it so that each run happens on one instance
+func (l *Locker) TryWithLock(ctx context.Context, name string, fn func(ctx context.Context) error) (ran bool, err error) {
+	lease, err := l.backend.TryAcquire(ctx, name)
+	if errors.Is(err, ErrLocked) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lock %s: %w", name, err)
+	}
+	return true, l.run(ctx, name, lease, fn)
+}
+
+// Close closes the backend's connections, if it owns any
+func (l *Locker) Close() error {
+	if closer, ok := l.backend.(interface{ Close() error }); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// run runs fn under a lease and releases it
+func (l *Locker) run(ctx context.Context, name string, lease Lease, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-lease.Lost():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err := fn(ctx)
+
+	releaseCtx, cancelRelease := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancelRelease()
+	if releaseErr := lease.Release(releaseCtx); releaseErr != nil {
+		// Backends free the locks of dropped connections and expired keys, so the lock is freed anyway
+		zap.L().Warn("failed to release lock", zap.String("lock", name), zap.Error(releaseErr))
+	}
+
+	select {
+	case <-lease.Lost():
+		return errors.Join(fmt.Errorf("%w: %s", ErrLost, name), err)
+	default:
+		return err
+	}
+}
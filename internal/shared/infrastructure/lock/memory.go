@@ -0,0 +1,54 @@
+package lock
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryBackend holds locks in the memory of the process
+// It only coordinates the goroutines of one instance
+type MemoryBackend struct {
+	mu   sync.Mutex
+	held map[string]bool
+}
+
+// NewMemoryBackend creates an in-memory backend
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{
+		held: make(map[string]bool),
+	}
+}
+
+// TryAcquire takes the named lock, or returns ErrLocked
+func (b *MemoryBackend) TryAcquire(ctx context.Context, name string) (Lease, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.held[name] {
+		return nil, ErrLocked
+	}
+	b.held[name] = true
+	return &memoryLease{backend: b, name: name}, nil
+}
+
+// memoryLease is a lock of the in-memory backend; it is never lost
+type memoryLease struct {
+	backend *MemoryBackend
+	name    string
+	once    sync.Once
+}
+
+// Lost returns a channel that is never closed
+func (l *memoryLease) Lost() <-chan struct{} {
+	return nil
+}
+
+// Release gives the lock up
+func (l *memoryLease) Release(ctx context.Context) error {
+	l.once.Do(func() {
+		l.backend.mu.Lock()
+		delete(l.backend.held, l.name)
+		l.backend.mu.Unlock()
+	})
+	return nil
+}
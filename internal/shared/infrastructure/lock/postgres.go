@@ -0,0 +1,123 @@
+package lock
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+// DefaultCheckInterval is how often a Postgres lease checks that its connection is alive
+const DefaultCheckInterval = 10 * time.Second
+
+// PostgresBackend holds locks as session-level advisory locks
+// Each lease keeps a connection of the pool for as long as it is held, and the lock is released by
+// Postgres when that connection drops
+type PostgresBackend struct {
+	db            *sql.DB
+	checkInterval time.Duration
+}
+
+// NewPostgresBackend creates a backend taking advisory locks on db
+func NewPostgresBackend(db *sql.DB) *PostgresBackend {
+	return &PostgresBackend{
+		db:            db,
+		checkInterval: DefaultCheckInterval,
+	}
+}
+
+// TryAcquire takes the named lock with pg_try_advisory_lock, or returns ErrLocked
+func (b *PostgresBackend) TryAcquire(ctx context.Context, name string) (Lease, error) {
+	conn, err := b.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	key := advisoryKey(name)
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to take advisory lock: %w", err)
+	}
+	if !acquired {
+		conn.Close()
+		return nil, ErrLocked
+	}
+
+	lease := &postgresLease{
+		conn: conn,
+		key:  key,
+		lost: make(chan struct{}),
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go lease.watch(b.checkInterval)
+	return lease, nil
+}
+
+// advisoryKey maps a lock name to the 64-bit key of an advisory lock
+func advisoryKey(name string) int64 {
+	hash := fnv.New64a()
+	hash.Write([]byte("lock:" + name))
+	return int64(hash.Sum64())
+}
+
+// postgresLease is an advisory lock held by a connection
+type postgresLease struct {
+	conn *sql.Conn
+	key  int64
+	lost chan struct{}
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+// Lost is closed when the connection holding the lock fails
+func (l *postgresLease) Lost() <-chan struct{} {
+	return l.lost
+}
+
+// Release unlocks the advisory lock and returns the connection to the pool
+func (l *postgresLease) Release(ctx context.Context) error {
+	var err error
+	l.once.Do(func() {
+		close(l.stop)
+		<-l.done
+
+		select {
+		case <-l.lost:
+			// The connection is broken; closing it discards it and the lock with it
+		default:
+			_, err = l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", l.key)
+		}
+		if closeErr := l.conn.Close(); err == nil {
+			err = closeErr
+		}
+	})
+	return err
+}
+
+// watch pings the connection every interval until the lease is released, closing lost when a ping fails
+func (l *postgresLease) watch(interval time.Duration) {
+	defer close(l.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			err := l.conn.PingContext(ctx)
+			cancel()
+			if err != nil {
+				close(l.lost)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package lock
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultTTL is how long a Redis lock outlives its holder when the holder stops renewing it
+const DefaultTTL = 30 * time.Second
+
+// renewScript extends the expiry of a lock still held by the token
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+// releaseScript deletes a lock still held by the token
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// RedisBackend holds locks as keys set with NX and an expiry, renewed every third of the TTL while
+// they are held; a holder that dies frees its locks once they expire
+type RedisBackend struct {
+	client redis.UniversalClient
+	ttl    time.Duration
+}
+
+// NewRedisBackend creates a backend keeping locks in Redis for ttl past their last renewal
+func NewRedisBackend(client redis.UniversalClient, ttl time.Duration) *RedisBackend {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &RedisBackend{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// TryAcquire takes the named lock with SET NX, or returns ErrLocked
+func (b *RedisBackend) TryAcquire(ctx context.Context, name string) (Lease, error) {
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	key := "lock:" + name
+	acquired, err := b.client.SetNX(ctx, key, token, b.ttl).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to set lock key: %w", err)
+	}
+	if !acquired {
+		return nil, ErrLocked
+	}
+
+	lease := &redisLease{
+		client: b.client,
+		key:    key,
+		token:  token,
+		ttl:    b.ttl,
+		lost:   make(chan struct{}),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go lease.renew()
+	return lease, nil
+}
+
+// Close closes the Redis client
+func (b *RedisBackend) Close() error {
+	return b.client.Close()
+}
+
+// newToken returns a random value identifying the holder of a lock
+func newToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to generate lock token: %w", err)
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// redisLease is a lock key set by this process
+type redisLease struct {
+	client redis.UniversalClient
+	key    string
+	token  string
+	ttl    time.Duration
+	lost   chan struct{}
+	stop   chan struct{}
+	done   chan struct{}
+	once   sync.Once
+}
+
+// Lost is closed when the key expired or was taken by another holder
+func (l *redisLease) Lost() <-chan struct{} {
+	return l.lost
+}
+
+// Release deletes the key unless another holder took it meanwhile
+func (l *redisLease) Release(ctx context.Context) error {
+	var err error
+	l.once.Do(func() {
+		close(l.stop)
+		<-l.done
+		err = releaseScript.Run(ctx, l.client, []string{l.key}, l.token).Err()
+	})
+	return err
+}
+
+// renew extends the key every third of the TTL until the lease is released
+// Failed renewals are retried until the key would have expired, then the lock is lost
+func (l *redisLease) renew() {
+	defer close(l.done)
+
+	ticker := time.NewTicker(l.ttl / 3)
+	defer ticker.Stop()
+
+	expiresAt := time.Now().Add(l.ttl)
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), l.ttl/3)
+		renewed, err := renewScript.Run(ctx, l.client, []string{l.key}, l.token, l.ttl.Milliseconds()).Int()
+		cancel()
+
+		switch {
+		case err == nil && renewed == 1:
+			expiresAt = time.Now().Add(l.ttl)
+		case err == nil || time.Now().After(expiresAt):
+			// The key expired or belongs to another holder
+			close(l.lost)
+			return
+		}
+	}
+}
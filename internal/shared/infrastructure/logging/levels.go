@@ -0,0 +1,89 @@
+package logging
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LevelsPath is where the log levels are served and changed
+const LevelsPath = "/admin/logging/levels"
+
+// LevelsPermission is required to read and change the log levels
+const LevelsPermission = "logging:write"
+
+// levelRequest is the body of a level change
+type levelRequest struct {
+	Level string `json:"level" binding:"required"`
+}
+
+// RegisterLevels mounts the log level endpoints on the group:
+//
+//	GET    /           application level and the level of each module
+//	PUT    /           sets the application level, {"level": "debug"}
+//	PUT    /:module    sets the level of a module
+//	DELETE /:module    makes a module log at the application level again
+//
+// Changes last until the process restarts; the group must authenticate and authorize its requests
+func RegisterLevels(group *gin.RouterGroup, factory *Factory) {
+	group.GET("", func(c *gin.Context) {
+		c.JSON(http.StatusOK, levelsResponse(factory))
+	})
+	group.PUT("", func(c *gin.Context) {
+		setLevel(c, factory, "")
+	})
+	group.PUT("/:module", func(c *gin.Context) {
+		setLevel(c, factory, c.Param("module"))
+	})
+	group.DELETE("/:module", func(c *gin.Context) {
+		module := c.Param("module")
+		if !factory.HasModule(module) {
+			unknownModule(c, module)
+			return
+		}
+		factory.ResetLevel(module)
+		c.JSON(http.StatusOK, levelsResponse(factory))
+	})
+}
+
+// setLevel sets the level of a module, or of the application when module is empty, from the body
+func setLevel(c *gin.Context, factory *Factory, module string) {
+	if module != "" && !factory.HasModule(module) {
+		unknownModule(c, module)
+		return
+	}
+
+	var request levelRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   gin.H{"code": "INVALID_REQUEST", "message": err.Error()},
+		})
+		return
+	}
+	if err := factory.SetLevel(module, request.Level); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   gin.H{"code": "INVALID_LEVEL", "message": err.Error()},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, levelsResponse(factory))
+}
+
+// unknownModule answers 404 for a module without a logger
+func unknownModule(c *gin.Context, module string) {
+	c.JSON(http.StatusNotFound, gin.H{
+		"success": false,
+		"error":   gin.H{"code": "NOT_FOUND", "message": "no logger for module " + module},
+	})
+}
+
+// levelsResponse lists the application level and the level of each module
+func levelsResponse(factory *Factory) gin.H {
+	modules := gin.H{}
+	for _, level := range factory.Levels() {
+		modules[level.Module] = gin.H{"level": level.Level.String(), "inherited": level.Inherited}
+	}
+	return gin.H{"level": factory.Level().String(), "modules": modules}
+}
@@ -0,0 +1,224 @@
+package logging
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+)
+
+// Factory creates the application logger and the loggers of modules
+// Each module logs at its own level, set with its module.yaml logging.level or at runtime with
+// SetLevel, and at the application level otherwise
+type Factory struct {
+	base  *zap.Logger
+	root  *zap.Logger
+	level zap.AtomicLevel
+
+	mu      sync.RWMutex
+	modules map[string]*moduleLevel
+}
+
+// New creates the logger factory from the logging, app and modules configuration
+// Every entry carries the service, version and environment so logs of several deployments can be told apart
+func New(cfg *config.Config) (*Factory, error) {
+	level, err := ParseLevel(cfg.Logging.Level)
+	if err != nil {
+		return nil, fmt.Errorf("invalid logging.level: %w", err)
+	}
+
+	var zapConfig zap.Config
+	switch strings.ToLower(cfg.Logging.Format) {
+	case "", "json":
+		zapConfig = zap.NewProductionConfig()
+		zapConfig.EncoderConfig.TimeKey = "time"
+		zapConfig.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	case "console":
+		zapConfig = zap.NewDevelopmentConfig()
+	default:
+		return nil, fmt.Errorf("invalid logging.format %q: must be json or console", cfg.Logging.Format)
+	}
+	// The core lets every entry through; the loggers of the factory filter by their own level
+	zapConfig.Level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
+	// Sampling would drop request logs under load, exactly when they are needed
+	zapConfig.Sampling = nil
+	// Failed requests are logged at error level; only recovered panics carry a stack
+	zapConfig.DisableStacktrace = true
+
+	base, err := zapConfig.Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build logger: %w", err)
+	}
+
+	f := &Factory{
+		base: base.With(
+			zap.String("service", cfg.App.Name),
+			zap.String("version", cfg.App.Version),
+			zap.String("environment", cfg.App.Environment),
+		),
+		level:   zap.NewAtomicLevelAt(level),
+		modules: make(map[string]*moduleLevel),
+	}
+	f.root = f.base.WithOptions(withLevel(f.level))
+
+	if cfg.Modules != nil {
+		for name, module := range cfg.Modules.Modules {
+			if module.Logging.Level == "" {
+				continue
+			}
+			if err := f.SetLevel(name, module.Logging.Level); err != nil {
+				return nil, fmt.Errorf("invalid logging.level of module %s: %w", name, err)
+			}
+		}
+	}
+	return f, nil
+}
+
+// Logger returns the application logger
+func (f *Factory) Logger() *zap.Logger {
+	return f.root
+}
+
+// Module returns the logger of a module, tagging its entries with the module name
+func (f *Factory) Module(name string) *zap.Logger {
+	return f.base.WithOptions(withLevel(f.moduleLevel(name))).With(zap.String("module", name))
+}
+
+// Level returns the application level
+func (f *Factory) Level() zapcore.Level {
+	return f.level.Level()
+}
+
+// SetLevel sets the level of a module, or of the application when module is empty
+func (f *Factory) SetLevel(module, level string) error {
+	parsed, err := ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	if module == "" {
+		f.level.SetLevel(parsed)
+		return nil
+	}
+	f.moduleLevel(module).override.Store(&parsed)
+	return nil
+}
+
+// ResetLevel makes a module log at the application level again
+func (f *Factory) ResetLevel(module string) {
+	f.moduleLevel(module).override.Store(nil)
+}
+
+// ModuleLevel is the level a module logs at
+type ModuleLevel struct {
+	Module    string
+	Level     zapcore.Level
+	Inherited bool // the module logs at the application level
+}
+
+// Levels returns the levels of the modules that loggers were created or levels set for, sorted by module
+func (f *Factory) Levels() []ModuleLevel {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	levels := make([]ModuleLevel, 0, len(f.modules))
+	for name, level := range f.modules {
+		override := level.override.Load()
+		levels = append(levels, ModuleLevel{Module: name, Level: level.Level(), Inherited: override == nil})
+	}
+	sort.Slice(levels, func(i, j int) bool { return levels[i].Module < levels[j].Module })
+	return levels
+}
+
+// HasModule reports whether a logger was created or a level set for the module
+func (f *Factory) HasModule(name string) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	_, ok := f.modules[name]
+	return ok
+}
+
+// moduleLevel returns the level of a module, creating it to follow the application level
+func (f *Factory) moduleLevel(name string) *moduleLevel {
+	f.mu.RLock()
+	level, ok := f.modules[name]
+	f.mu.RUnlock()
+	if ok {
+		return level
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if level, ok := f.modules[name]; ok {
+		return level
+	}
+	level = &moduleLevel{root: f.level}
+	f.modules[name] = level
+	return level
+}
+
+// ParseLevel parses debug, info, warn or error
+func ParseLevel(level string) (zapcore.Level, error) {
+	parsed, err := zapcore.ParseLevel(level)
+	if err != nil || parsed > zapcore.ErrorLevel {
+		return 0, fmt.Errorf("level %q must be debug, info, warn or error", level)
+	}
+	return parsed, nil
+}
+
+// moduleLevel is the level of a module: its own when set, the application level otherwise
+type moduleLevel struct {
+	root     zap.AtomicLevel
+	override atomic.Pointer[zapcore.Level]
+}
+
+// Enabled reports whether the module logs entries at level
+func (l *moduleLevel) Enabled(level zapcore.Level) bool {
+	return level >= l.Level()
+}
+
+// Level returns the level the module logs at
+func (l *moduleLevel) Level() zapcore.Level {
+	if override := l.override.Load(); override != nil {
+		return *override
+	}
+	return l.root.Level()
+}
+
+// withLevel filters the entries of a logger by level, which unlike zap.IncreaseLevel may be lower
+// than the level of the core
+func withLevel(level zapcore.LevelEnabler) zap.Option {
+	return zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &levelCore{Core: core, level: level}
+	})
+}
+
+// levelCore is a core filtering entries by a level that can change at runtime
+type levelCore struct {
+	zapcore.Core
+	level zapcore.LevelEnabler
+}
+
+func (c *levelCore) Enabled(level zapcore.Level) bool {
+	return c.level.Enabled(level)
+}
+
+func (c *levelCore) Level() zapcore.Level {
+	return zapcore.LevelOf(c.level)
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields), level: c.level}
+}
+
+func (c *levelCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.level.Enabled(entry.Level) {
+		return checked
+	}
+	return c.Core.Check(entry, checked)
+}
@@ -0,0 +1,74 @@
+package logging
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Middleware logs every request as one JSON entry with its method, path, status, latency and request ID
+// The request ID is the one requestid.Middleware put on the request context, and the trace ID is added for
+// traced requests; server errors are logged at error level and client errors at warn level
+func Middleware(logger *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		status := c.Writer.Status()
+		fields := []zap.Field{
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.String("route", c.FullPath()),
+			zap.Int("status", status),
+			zap.Duration("latency", time.Since(start)),
+			zap.String("request_id", domain.RequestIDFromContext(c.Request.Context())),
+			zap.String("client_ip", c.ClientIP()),
+			zap.Int("bytes", c.Writer.Size()),
+		}
+		if spanContext := trace.SpanContextFromContext(c.Request.Context()); spanContext.IsValid() {
+			fields = append(fields, zap.String("trace_id", spanContext.TraceID().String()))
+		}
+		if actor, ok := domain.ActorFromContext(c.Request.Context()); ok {
+			fields = append(fields, zap.String("actor", actor.String()))
+		}
+		if len(c.Errors) > 0 {
+			fields = append(fields, zap.String("errors", c.Errors.String()))
+		}
+
+		level := zapcore.InfoLevel
+		switch {
+		case status >= 500:
+			level = zapcore.ErrorLevel
+		case status >= 400:
+			level = zapcore.WarnLevel
+		}
+		logger.Log(level, "http request", fields...)
+	}
+}
+
+// Recovery recovers from panics in handlers, logging them with their stack and answering 500
+func Recovery(logger *zap.Logger) gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(nil, func(c *gin.Context, recovered any) {
+		logger.Error("panic recovered",
+			zap.Any("panic", recovered),
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.String("request_id", domain.RequestIDFromContext(c.Request.Context())),
+			zap.Stack("stack"),
+		)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "An internal error occurred",
+			},
+		})
+	})
+}
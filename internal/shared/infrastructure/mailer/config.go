@@ -0,0 +1,75 @@
+package mailer
+
+import (
+	"fmt"
+	"os"
+
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+)
+
+// DefaultFrom is the sender address when global.mail.from is empty
+const DefaultFrom = "no-reply@localhost"
+
+// NewFromConfig creates the mailer of global.mail, with the transport and SMTP server of the
+// app.environment; without a transport, emails are captured by the sandbox
+func NewFromConfig(cfg *config.Config) (*Mailer, error) {
+	var settings config.MailGlobalConfig
+	if cfg.Modules != nil {
+		settings = cfg.Modules.Global.Mail.ForEnvironment(cfg.App.Environment)
+	}
+
+	var transport Transport
+	switch settings.Transport {
+	case "", TransportSandbox:
+		sandbox, err := NewSandbox(settings.Sandbox.Path, settings.Sandbox.Keep)
+		if err != nil {
+			return nil, err
+		}
+		transport = sandbox
+	case TransportSMTP:
+		timeout, err := settings.SMTP.GetTimeout()
+		if err != nil {
+			return nil, err
+		}
+		smtp, err := NewSMTPTransport(SMTPConfig{
+			Host:        settings.SMTP.Host,
+			Port:        settings.SMTP.Port,
+			Username:    settings.SMTP.Username,
+			Password:    settings.SMTP.Password,
+			ImplicitTLS: settings.SMTP.ImplicitTLS,
+			Timeout:     timeout,
+		})
+		if err != nil {
+			return nil, err
+		}
+		transport = smtp
+	default:
+		return nil, fmt.Errorf("mail transport %q is unknown", settings.Transport)
+	}
+
+	var signer *Signer
+	if dkim := settings.DKIM; dkim.Domain != "" {
+		key := []byte(dkim.PrivateKey)
+		if len(key) == 0 && dkim.PrivateKeyFile != "" {
+			var err error
+			if key, err = os.ReadFile(dkim.PrivateKeyFile); err != nil {
+				return nil, fmt.Errorf("failed to read dkim private key: %w", err)
+			}
+		}
+		var err error
+		if signer, err = NewSigner(dkim.Domain, dkim.Selector, key); err != nil {
+			return nil, err
+		}
+	}
+
+	from := settings.From
+	if from == "" {
+		from = DefaultFrom
+	}
+	return New(transport, Options{
+		From:      from,
+		Senders:   settings.Senders,
+		RateLimit: settings.RateLimit,
+		Signer:    signer,
+	})
+}
@@ -0,0 +1,170 @@
+package mailer
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// signedHeaders are the headers covered by DKIM signatures, when messages have them
+var signedHeaders = []string{
+	"From", "To", "Reply-To", "Subject", "Date", "Message-ID",
+	"MIME-Version", "Content-Type", "Content-Transfer-Encoding",
+}
+
+// Signer signs messages with DKIM (RFC 6376), with relaxed canonicalization of headers and body
+type Signer struct {
+	domain   string
+	selector string
+	key      crypto.Signer
+}
+
+// NewSigner creates a signer for the domain and selector from a PEM-encoded RSA or Ed25519 private
+// key, in PKCS #8 or PKCS #1 form
+func NewSigner(domain, selector string, keyPEM []byte) (*Signer, error) {
+	if domain == "" || selector == "" {
+		return nil, fmt.Errorf("dkim requires a domain and a selector")
+	}
+
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("dkim private key is not PEM encoded")
+	}
+
+	var key crypto.Signer
+	if parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		switch parsed := parsed.(type) {
+		case *rsa.PrivateKey:
+			key = parsed
+		case ed25519.PrivateKey:
+			key = parsed
+		default:
+			return nil, fmt.Errorf("dkim private key must be an RSA or Ed25519 key, got %T", parsed)
+		}
+	} else if parsed, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		key = parsed
+	} else {
+		return nil, fmt.Errorf("invalid dkim private key: %w", err)
+	}
+
+	return &Signer{domain: domain, selector: selector, key: key}, nil
+}
+
+// Sign returns the message with its DKIM-Signature header prepended
+func (s *Signer) Sign(message []byte) ([]byte, error) {
+	head, body, ok := bytes.Cut(message, []byte("\r\n\r\n"))
+	if !ok {
+		return nil, fmt.Errorf("dkim: message has no body")
+	}
+	headers := parseHeaders(string(head))
+
+	bodyHash := sha256.Sum256(relaxedBody(body))
+
+	var names []string
+	for _, name := range signedHeaders {
+		if _, ok := headers[strings.ToLower(name)]; ok {
+			names = append(names, name)
+		}
+	}
+
+	algorithm := "rsa-sha256"
+	if _, ok := s.key.(ed25519.PrivateKey); ok {
+		algorithm = "ed25519-sha256"
+	}
+	signature := "v=1; a=" + algorithm + "; c=relaxed/relaxed; d=" + s.domain + "; s=" + s.selector +
+		"; t=" + strconv.FormatInt(time.Now().Unix(), 10) +
+		"; h=" + strings.ToLower(strings.Join(names, ":")) +
+		"; bh=" + base64.StdEncoding.EncodeToString(bodyHash[:]) + "; b="
+
+	// The signature covers the signed headers and its own header, without its value and final CRLF
+	var signed strings.Builder
+	for _, name := range names {
+		signed.WriteString(relaxedHeader(name, headers[strings.ToLower(name)]))
+		signed.WriteString("\r\n")
+	}
+	signed.WriteString(relaxedHeader("DKIM-Signature", signature))
+	hash := sha256.Sum256([]byte(signed.String()))
+
+	var sig []byte
+	var err error
+	switch key := s.key.(type) {
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(key, hash[:])
+	default:
+		sig, err = key.Sign(rand.Reader, hash[:], crypto.SHA256)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dkim signing failed: %w", err)
+	}
+
+	header := "DKIM-Signature: " + signature + base64.StdEncoding.EncodeToString(sig) + "\r\n"
+	return append([]byte(header), message...), nil
+}
+
+// parseHeaders returns the unfolded values of the message headers by lowercase name; of repeated
+// headers, the last one is kept, as DKIM signs the last instance first
+func parseHeaders(head string) map[string]string {
+	headers := make(map[string]string)
+	var name, value string
+	flush := func() {
+		if name != "" {
+			headers[strings.ToLower(strings.TrimSpace(name))] = value
+		}
+	}
+	for _, line := range strings.Split(head, "\r\n") {
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			value += line
+			continue
+		}
+		flush()
+		name, value, _ = strings.Cut(line, ":")
+	}
+	flush()
+	return headers
+}
+
+// relaxedHeader canonicalizes a header: lowercase name, whitespace runs as one space, and no
+// whitespace around the value
+func relaxedHeader(name, value string) string {
+	return strings.ToLower(strings.TrimSpace(name)) + ":" + strings.Join(strings.Fields(value), " ")
+}
+
+// relaxedBody canonicalizes a body: whitespace runs as one space, no whitespace at the end of lines
+// and no empty lines at its end
+func relaxedBody(body []byte) []byte {
+	lines := strings.Split(string(body), "\r\n")
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t")
+		var compressed strings.Builder
+		space := false
+		for _, r := range line {
+			if r == ' ' || r == '\t' {
+				space = true
+				continue
+			}
+			if space {
+				compressed.WriteByte(' ')
+				space = false
+			}
+			compressed.WriteRune(r)
+		}
+		lines[i] = compressed.String()
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return []byte(strings.Join(lines, "\r\n") + "\r\n")
+}
@@ -0,0 +1,155 @@
+package mailer
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+)
+
+func TestRelaxedCanonicalization_RFC6376Example(t *testing.T) {
+	// RFC 6376 section 3.4.5
+	head := "A: X\r\nB : Y\t\r\n\tZ  "
+	body := " C \r\nD \t E\r\n\r\n\r\n"
+
+	headers := parseHeaders(head)
+	if got := relaxedHeader("A", headers["a"]); got != "a:X" {
+		t.Errorf("relaxed A = %q, want %q", got, "a:X")
+	}
+	if got := relaxedHeader("B", headers["b"]); got != "b:Y Z" {
+		t.Errorf("relaxed B = %q, want %q", got, "b:Y Z")
+	}
+	if got := string(relaxedBody([]byte(body))); got != " C\r\nD E\r\n" {
+		t.Errorf("relaxed body = %q, want %q", got, " C\r\nD E\r\n")
+	}
+}
+
+func TestRelaxedBody_Hashes(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		hash string
+	}{
+		// RFC 6376 section 3.4.4: an empty body canonicalizes to nothing
+		{name: "empty", body: "", hash: "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+		{name: "empty lines", body: "\r\n\r\n", hash: "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+		// RFC 8463 appendix A
+		{name: "RFC 8463", body: "Hi.\r\n\r\nWe lost the game.  Are you hungry yet?\r\n\r\nJoe.\r\n", hash: "2jUSOH9NhtVGCQWNr9BrIAPreKQjO6Sn7XIkfJVOzv8="},
+		{name: "RFC 8463 with trailing lines", body: "Hi.\r\n\r\nWe lost the game. \tAre you hungry yet?  \r\n\r\nJoe.\r\n\r\n\r\n", hash: "2jUSOH9NhtVGCQWNr9BrIAPreKQjO6Sn7XIkfJVOzv8="},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum := sha256.Sum256(relaxedBody([]byte(tt.body)))
+			if got := base64.StdEncoding.EncodeToString(sum[:]); got != tt.hash {
+				t.Fatalf("body hash = %s, want %s", got, tt.hash)
+			}
+		})
+	}
+}
+
+func TestSigner_Sign(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ed25519 key: %v", err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(edKey)
+	if err != nil {
+		t.Fatalf("encode ed25519 key: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		pem       []byte
+		algorithm string
+		verify    func(hash, sig []byte) error
+	}{
+		{
+			name:      "ed25519",
+			pem:       pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}),
+			algorithm: "ed25519-sha256",
+			verify: func(hash, sig []byte) error {
+				if !ed25519.Verify(edKey.Public().(ed25519.PublicKey), hash, sig) {
+					return rsa.ErrVerification
+				}
+				return nil
+			},
+		},
+		{
+			name:      "rsa",
+			pem:       pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+			algorithm: "rsa-sha256",
+			verify: func(hash, sig []byte) error {
+				return rsa.VerifyPKCS1v15(&rsaKey.PublicKey, crypto.SHA256, hash, sig)
+			},
+		},
+	}
+
+	message := []byte("From: Joe SixPack <joe@football.example.com>\r\n" +
+		"To: Suzie Q <suzie@shopping.example.net>\r\n" +
+		"Subject:  Is dinner   ready?\r\n" +
+		"X-Mailer: test\r\n" +
+		"\r\n" +
+		"Hi.\r\n\r\nWe lost the game.  Are you hungry yet?\r\n\r\nJoe.\r\n")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := NewSigner("football.example.com", "brisbane", tt.pem)
+			if err != nil {
+				t.Fatalf("NewSigner() error = %v", err)
+			}
+			signed, err := signer.Sign(message)
+			if err != nil {
+				t.Fatalf("Sign() error = %v", err)
+			}
+
+			header, rest, _ := bytes.Cut(signed, []byte("\r\n"))
+			if !bytes.Equal(rest, message) {
+				t.Fatal("Sign() changed the message")
+			}
+			value := strings.TrimPrefix(string(header), "DKIM-Signature: ")
+			tags := make(map[string]string)
+			for _, tag := range strings.Split(value, ";") {
+				name, tagValue, _ := strings.Cut(strings.TrimSpace(tag), "=")
+				tags[name] = tagValue
+			}
+			want := map[string]string{
+				"a":  tt.algorithm,
+				"c":  "relaxed/relaxed",
+				"d":  "football.example.com",
+				"s":  "brisbane",
+				"h":  "from:to:subject",
+				"bh": "2jUSOH9NhtVGCQWNr9BrIAPreKQjO6Sn7XIkfJVOzv8=",
+			}
+			for name, wantValue := range want {
+				if tags[name] != wantValue {
+					t.Errorf("tag %s = %q, want %q", name, tags[name], wantValue)
+				}
+			}
+
+			// Verify as a receiver would: the signed headers, then the signature header without b=
+			signedData := "from:Joe SixPack <joe@football.example.com>\r\n" +
+				"to:Suzie Q <suzie@shopping.example.net>\r\n" +
+				"subject:Is dinner ready?\r\n" +
+				relaxedHeader("DKIM-Signature", value[:strings.LastIndex(value, "; b=")+len("; b=")])
+			hash := sha256.Sum256([]byte(signedData))
+			sig, err := base64.StdEncoding.DecodeString(tags["b"])
+			if err != nil {
+				t.Fatalf("decode b=: %v", err)
+			}
+			if err := tt.verify(hash[:], sig); err != nil {
+				t.Fatalf("signature does not verify: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package mailer
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SandboxPath is the prefix the sandbox endpoints are mounted under
+const SandboxPath = "/admin/mail"
+
+// SandboxPermission is required to read the captured emails, which carry links such as email
+// verification tokens
+const SandboxPermission = "mail:read"
+
+// RegisterSandbox mounts the endpoints of the captured emails on the group:
+//
+//	GET    /          list of the emails, as a page or as JSON
+//	GET    /:id       one email with its text and HTML bodies
+//	GET    /:id/raw   the message as sent, message/rfc822
+//	DELETE /          removes every email
+//
+// The group must authenticate and authorize its requests
+func RegisterSandbox(group *gin.RouterGroup, sandbox *Sandbox) {
+	group.GET("", func(c *gin.Context) {
+		emails, err := sandbox.List()
+		if err != nil {
+			sandboxError(c, err)
+			return
+		}
+		if c.NegotiateFormat(gin.MIMEHTML, gin.MIMEJSON) == gin.MIMEJSON {
+			c.JSON(http.StatusOK, gin.H{"success": true, "data": emails})
+			return
+		}
+		render(c, listPage, gin.H{"Path": SandboxPath, "Emails": emails})
+	})
+
+	group.GET("/:id", func(c *gin.Context) {
+		email, message, err := sandbox.Get(c.Param("id"))
+		if err != nil {
+			sandboxError(c, err)
+			return
+		}
+		text, html := bodies(message)
+		render(c, emailPage, gin.H{"Path": SandboxPath, "Email": email, "Text": text, "HTML": html})
+	})
+
+	group.GET("/:id/raw", func(c *gin.Context) {
+		_, message, err := sandbox.Get(c.Param("id"))
+		if err != nil {
+			sandboxError(c, err)
+			return
+		}
+		c.Data(http.StatusOK, "message/rfc822", message)
+	})
+
+	group.DELETE("", func(c *gin.Context) {
+		if err := sandbox.Clear(); err != nil {
+			sandboxError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"success": true})
+	})
+}
+
+// sandboxError answers a failed request
+func sandboxError(c *gin.Context, err error) {
+	if errors.Is(err, ErrEmailNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   gin.H{"code": "NOT_FOUND", "message": "email not found"},
+		})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error":   gin.H{"code": "INTERNAL_ERROR", "message": err.Error()},
+	})
+}
+
+// render writes a page
+func render(c *gin.Context, page *template.Template, data gin.H) {
+	var buf bytes.Buffer
+	if err := page.Execute(&buf, data); err != nil {
+		sandboxError(c, err)
+		return
+	}
+	c.Data(http.StatusOK, "text/html; charset=utf-8", buf.Bytes())
+}
+
+// bodies returns the decoded text and HTML bodies of a message, empty when it has none
+func bodies(message []byte) (text, html string) {
+	parsed, err := mail.ReadMessage(bytes.NewReader(message))
+	if err != nil {
+		return "", ""
+	}
+	mediaType, params, _ := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		content := decode(parsed.Body, parsed.Header.Get("Content-Transfer-Encoding"))
+		if mediaType == "text/html" {
+			return "", content
+		}
+		return content, ""
+	}
+
+	parts := multipart.NewReader(parsed.Body, params["boundary"])
+	for {
+		part, err := parts.NextRawPart()
+		if err != nil {
+			return text, html
+		}
+		partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		content := decode(part, part.Header.Get("Content-Transfer-Encoding"))
+		switch partType {
+		case "text/plain":
+			text = content
+		case "text/html":
+			html = content
+		}
+	}
+}
+
+// decode reads a body in its transfer encoding
+func decode(body io.Reader, encoding string) string {
+	if strings.EqualFold(encoding, "quoted-printable") {
+		body = quotedprintable.NewReader(body)
+	}
+	content, _ := io.ReadAll(body)
+	return string(content)
+}
+
+var listPage = template.Must(template.New("list").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Mail sandbox</title>
+<style>body{font-family:sans-serif;margin:2em}table{border-collapse:collapse;width:100%}td,th{border-bottom:1px solid #ddd;padding:.4em;text-align:left}</style>
+</head><body>
+<h1>Mail sandbox</h1>
+<p>{{len .Emails}} captured emails, newest first.</p>
+<table>
+<tr><th>Captured</th><th>From</th><th>To</th><th>Subject</th></tr>
+{{range .Emails}}<tr><td>{{.CapturedAt.Format "2006-01-02 15:04:05"}}</td><td>{{.From}}</td><td>{{.To}}</td><td><a href="{{$.Path}}/{{.ID}}">{{.Subject}}</a></td></tr>
+{{end}}</table>
+</body></html>
+`))
+
+var emailPage = template.Must(template.New("email").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>{{.Email.Subject}}</title>
+<style>body{font-family:sans-serif;margin:2em}pre{white-space:pre-wrap;background:#f6f6f6;padding:1em}iframe{width:100%;height:32em;border:1px solid #ddd}</style>
+</head><body>
+<p><a href="{{.Path}}">All emails</a> · <a href="{{.Path}}/{{.Email.ID}}/raw">Raw message</a></p>
+<h1>{{.Email.Subject}}</h1>
+<p>From: {{.Email.From}}<br>To: {{.Email.To}}<br>Captured: {{.Email.CapturedAt.Format "2006-01-02 15:04:05"}}</p>
+{{if .HTML}}<h2>HTML</h2>
+<iframe sandbox srcdoc="{{.HTML}}"></iframe>
+{{end}}{{if .Text}}<h2>Text</h2>
+<pre>{{.Text}}</pre>
+{{end}}</body></html>
+`))
@@ -0,0 +1,205 @@
+// Package mailer sends the emails of modules. Each module sends from its own address through the
+// transport of the environment: SMTP, or a sandbox capturing emails on local disk where developers
+// read them at /admin/mail. Emails are DKIM-signed when a key is configured, and paced to the rate
+// the mail provider accepts.
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Transport hands composed messages over for delivery
+type Transport interface {
+	// Name returns the transport identifier, e.g. "smtp"
+	Name() string
+	// Deliver sends the message from the envelope sender to the recipients
+	// It returns an error wrapping domain.ErrMailRejected when the message is refused for good
+	Deliver(ctx context.Context, from string, to []string, message []byte) error
+}
+
+// Options configures a Mailer
+type Options struct {
+	// From is the sender address of modules without one in Senders, e.g. "Shop <no-reply@example.com>"
+	From string
+	// Senders holds the sender address of modules by name
+	Senders map[string]string
+	// RateLimit is how many emails are sent per second at most, without limit when zero
+	RateLimit float64
+	// Signer signs emails with DKIM, unsigned when nil
+	Signer *Signer
+}
+
+// Mailer composes emails and hands them to its transport
+type Mailer struct {
+	transport Transport
+	signer    *Signer
+	limiter   *limiter
+	from      *mail.Address
+	senders   map[string]*mail.Address
+}
+
+// New creates a mailer delivering through the transport
+func New(transport Transport, options Options) (*Mailer, error) {
+	from, err := mail.ParseAddress(options.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mail from address %q: %w", options.From, err)
+	}
+
+	senders := make(map[string]*mail.Address, len(options.Senders))
+	for module, address := range options.Senders {
+		sender, err := mail.ParseAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mail sender address %q of module %s: %w", address, module, err)
+		}
+		senders[module] = sender
+	}
+
+	return &Mailer{
+		transport: transport,
+		signer:    options.Signer,
+		limiter:   newLimiter(options.RateLimit),
+		from:      from,
+		senders:   senders,
+	}, nil
+}
+
+// Transport returns the transport emails are delivered through
+func (m *Mailer) Transport() Transport {
+	return m.transport
+}
+
+// Sandbox returns the store of captured emails, nil when emails are delivered
+func (m *Mailer) Sandbox() *Sandbox {
+	sandbox, _ := m.transport.(*Sandbox)
+	return sandbox
+}
+
+// Default returns the mailer sending from the default address
+func (m *Mailer) Default() domain.Mailer {
+	return sender{mailer: m, from: m.from}
+}
+
+// ForModule returns the mailer of a module, sending from its address in Options.Senders or the
+// default address
+func (m *Mailer) ForModule(module string) domain.Mailer {
+	if from, ok := m.senders[module]; ok {
+		return sender{mailer: m, from: from}
+	}
+	return m.Default()
+}
+
+// sender sends emails from one address
+type sender struct {
+	mailer *Mailer
+	from   *mail.Address
+}
+
+// Send composes, signs and delivers the email, returning its Message-ID
+func (s sender) Send(ctx context.Context, email domain.Email) (string, error) {
+	if len(email.To) == 0 {
+		return "", fmt.Errorf("email has no recipient: %w", domain.ErrMailRejected)
+	}
+	to := make([]*mail.Address, 0, len(email.To))
+	recipients := make([]string, 0, len(email.To))
+	for _, recipient := range email.To {
+		address, err := mail.ParseAddress(recipient)
+		if err != nil {
+			return "", fmt.Errorf("invalid recipient %q: %w", recipient, domain.ErrMailRejected)
+		}
+		to = append(to, address)
+		recipients = append(recipients, address.Address)
+	}
+	var replyTo *mail.Address
+	if email.ReplyTo != "" {
+		address, err := mail.ParseAddress(email.ReplyTo)
+		if err != nil {
+			return "", fmt.Errorf("invalid reply-to address %q: %w", email.ReplyTo, domain.ErrMailRejected)
+		}
+		replyTo = address
+	}
+
+	messageID := s.messageID(email.ID)
+	message, err := compose(s.from, to, replyTo, messageID, email)
+	if err != nil {
+		return "", err
+	}
+	if s.mailer.signer != nil {
+		if message, err = s.mailer.signer.Sign(message); err != nil {
+			return "", err
+		}
+	}
+
+	if err := s.mailer.limiter.wait(ctx); err != nil {
+		return "", err
+	}
+	if err := s.mailer.transport.Deliver(ctx, s.from.Address, recipients, message); err != nil {
+		return "", err
+	}
+	return messageID, nil
+}
+
+// messageID returns the Message-ID of the email with the ID, in the domain of the sender address
+func (s sender) messageID(id string) string {
+	if id == "" {
+		id = uuid.NewString()
+	}
+	_, host, ok := strings.Cut(s.from.Address, "@")
+	if !ok {
+		host = "localhost"
+	}
+	return fmt.Sprintf("<%s@%s>", id, host)
+}
+
+// limiter spaces sends evenly so that at most a rate of them start per second
+// Sends are counted in process memory, so each instance enforces the rate on its own
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// newLimiter creates a limiter of rate sends per second, nil when rate is not positive
+func newLimiter(rate float64) *limiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &limiter{interval: time.Duration(float64(time.Second) / rate)}
+}
+
+// wait blocks until the next send may start, or ctx is done
+func (l *limiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(at)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("email not sent within the rate limit: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,192 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TransportSandbox is the identifier of the sandbox transport
+const TransportSandbox = "sandbox"
+
+// ErrEmailNotFound is returned for emails the sandbox does not hold
+var ErrEmailNotFound = errors.New("email not found")
+
+// capturedID matches the IDs of captured emails: the capture time in nanoseconds and a random suffix
+var capturedID = regexp.MustCompile(`^[0-9]+-[0-9a-f]+$`)
+
+// Sandbox captures messages as .eml files in a directory instead of delivering them, for
+// development and test environments; the oldest files are removed beyond the number it keeps
+type Sandbox struct {
+	mu   sync.Mutex
+	dir  string
+	keep int
+}
+
+// CapturedEmail describes an email held by the sandbox
+type CapturedEmail struct {
+	ID         string    `json:"id"`
+	From       string    `json:"from"`
+	To         string    `json:"to"`
+	Subject    string    `json:"subject"`
+	CapturedAt time.Time `json:"captured_at"`
+	Size       int       `json:"size"`
+}
+
+// NewSandbox creates a sandbox storing messages in dir, keeping the latest keep of them
+func NewSandbox(dir string, keep int) (*Sandbox, error) {
+	if dir == "" {
+		dir = "./data/mail"
+	}
+	if keep <= 0 {
+		keep = 500
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create mail sandbox directory: %w", err)
+	}
+	return &Sandbox{dir: dir, keep: keep}, nil
+}
+
+// Name returns the transport identifier
+func (s *Sandbox) Name() string {
+	return TransportSandbox
+}
+
+// Deliver stores the message; the envelope is already in its headers
+func (s *Sandbox) Deliver(_ context.Context, _ string, _ []string, message []byte) error {
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return fmt.Errorf("failed to name captured email: %w", err)
+	}
+	id := fmt.Sprintf("%d-%s", time.Now().UnixNano(), hex.EncodeToString(suffix))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.WriteFile(s.path(id), message, 0o600); err != nil {
+		return fmt.Errorf("failed to capture email: %w", err)
+	}
+	return s.prune()
+}
+
+// List returns the captured emails, newest first
+func (s *Sandbox) List() ([]CapturedEmail, error) {
+	s.mu.Lock()
+	ids, err := s.ids()
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make([]CapturedEmail, 0, len(ids))
+	for i := len(ids) - 1; i >= 0; i-- {
+		email, _, err := s.Get(ids[i])
+		if errors.Is(err, ErrEmailNotFound) {
+			// Removed since it was listed
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		emails = append(emails, *email)
+	}
+	return emails, nil
+}
+
+// Get returns a captured email and its message
+func (s *Sandbox) Get(id string) (*CapturedEmail, []byte, error) {
+	if !capturedID.MatchString(id) {
+		return nil, nil, ErrEmailNotFound
+	}
+	message, err := os.ReadFile(s.path(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, ErrEmailNotFound
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read captured email: %w", err)
+	}
+
+	email := &CapturedEmail{ID: id, Size: len(message)}
+	var nanos int64
+	fmt.Sscanf(id, "%d-", &nanos)
+	email.CapturedAt = time.Unix(0, nanos).UTC()
+
+	if parsed, err := mail.ReadMessage(bytes.NewReader(message)); err == nil {
+		var decoder mime.WordDecoder
+		email.From = parsed.Header.Get("From")
+		email.To = parsed.Header.Get("To")
+		email.Subject = parsed.Header.Get("Subject")
+		if subject, err := decoder.DecodeHeader(email.Subject); err == nil {
+			email.Subject = subject
+		}
+	}
+	return email, message, nil
+}
+
+// Clear removes every captured email
+func (s *Sandbox) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids, err := s.ids()
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := os.Remove(s.path(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove captured email: %w", err)
+		}
+	}
+	return nil
+}
+
+// prune removes the oldest emails beyond the number kept; s.mu is held
+func (s *Sandbox) prune() error {
+	ids, err := s.ids()
+	if err != nil {
+		return err
+	}
+	for len(ids) > s.keep {
+		if err := os.Remove(s.path(ids[0])); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove captured email: %w", err)
+		}
+		ids = ids[1:]
+	}
+	return nil
+}
+
+// ids returns the IDs of the captured emails, oldest first
+func (s *Sandbox) ids() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list captured emails: %w", err)
+	}
+
+	var ids []string
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), ".eml")
+		if ok && capturedID.MatchString(id) {
+			ids = append(ids, id)
+		}
+	}
+	// Capture times have the same number of digits for centuries, so IDs sort by time
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// path returns the file of a captured email
+func (s *Sandbox) path(id string) string {
+	return filepath.Join(s.dir, id+".eml")
+}
@@ -0,0 +1,158 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// TransportSMTP is the identifier of the SMTP transport
+const TransportSMTP = "smtp"
+
+// SMTPConfig configures the SMTP transport
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// ImplicitTLS connects over TLS (port 465); otherwise STARTTLS is used when the server offers it
+	ImplicitTLS bool
+	// Timeout bounds the whole exchange of a message with the server
+	Timeout time.Duration
+}
+
+// SMTPTransport delivers messages through an SMTP server, over a connection of their own
+type SMTPTransport struct {
+	config SMTPConfig
+}
+
+// NewSMTPTransport creates a new SMTP transport
+func NewSMTPTransport(config SMTPConfig) (*SMTPTransport, error) {
+	if config.Host == "" {
+		return nil, fmt.Errorf("smtp host is required")
+	}
+	if config.Port == 0 {
+		config.Port = 587
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 30 * time.Second
+	}
+	return &SMTPTransport{config: config}, nil
+}
+
+// Name returns the transport identifier
+func (t *SMTPTransport) Name() string {
+	return TransportSMTP
+}
+
+// Deliver sends the message to the recipients
+func (t *SMTPTransport) Deliver(ctx context.Context, from string, to []string, message []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, t.config.Timeout)
+	defer cancel()
+
+	client, err := t.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// The client has no context support; closing the connection interrupts it
+	stop := context.AfterFunc(ctx, func() { client.Close() })
+	defer stop()
+
+	if err := t.exchange(client, from, to, message); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("smtp delivery timed out: %w", ctx.Err())
+		}
+		return err
+	}
+	return nil
+}
+
+// dial connects and says hello to the server, upgrading to TLS when possible, and authenticates
+func (t *SMTPTransport) dial(ctx context.Context) (*smtp.Client, error) {
+	address := net.JoinHostPort(t.config.Host, strconv.Itoa(t.config.Port))
+
+	var conn net.Conn
+	var err error
+	if t.config.ImplicitTLS {
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: t.config.Host}}
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	} else {
+		var dialer net.Dialer
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to smtp server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, t.config.Host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("smtp handshake failed: %w", err)
+	}
+
+	if !t.config.ImplicitTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: t.config.Host}); err != nil {
+				client.Close()
+				return nil, fmt.Errorf("smtp starttls failed: %w", err)
+			}
+		}
+	}
+
+	if t.config.Username != "" {
+		auth := smtp.PlainAuth("", t.config.Username, t.config.Password, t.config.Host)
+		if err := client.Auth(auth); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("smtp authentication failed: %w", err)
+		}
+	}
+
+	return client, nil
+}
+
+// exchange sends one message over an open connection
+func (t *SMTPTransport) exchange(client *smtp.Client, from string, to []string, message []byte) error {
+	if err := client.Mail(from); err != nil {
+		return commandError("MAIL FROM", err)
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return commandError("RCPT TO", err)
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return commandError("DATA", err)
+	}
+	if _, err := writer.Write(message); err != nil {
+		return fmt.Errorf("failed to write smtp message: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return commandError("DATA", err)
+	}
+
+	return client.Quit()
+}
+
+// commandError marks permanent (5xx) replies of the server as rejections
+func commandError(command string, err error) error {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+		return fmt.Errorf("smtp %s: %s: %w", command, protoErr.Msg, domain.ErrMailRejected)
+	}
+	return fmt.Errorf("smtp %s failed: %w", command, err)
+}
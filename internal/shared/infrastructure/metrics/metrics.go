@@ -0,0 +1,120 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Path is where Handler is served
+const Path = "/metrics"
+
+// Metrics holds the HTTP metrics of the application in its own Prometheus registry
+// The registry also collects the Go runtime and process metrics
+type Metrics struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	inFlight *prometheus.GaugeVec
+	// Requests between modules on the request bus
+	moduleRequests *prometheus.CounterVec
+	moduleDuration *prometheus.HistogramVec
+}
+
+// New creates the HTTP metrics and registers them
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Number of HTTP requests handled, by module, route, method and status code",
+		}, []string{"module", "route", "method", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Duration of HTTP requests in seconds, by module, route and method",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"module", "route", "method"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "http_requests_in_flight",
+			Help: "Number of HTTP requests being handled, by module and route",
+		}, []string{"module", "route"}),
+		moduleRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "module_requests_total",
+			Help: "Number of requests between modules on the request bus, by endpoint and result",
+		}, []string{"endpoint", "result"}),
+		moduleDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "module_request_duration_seconds",
+			Help:    "Duration of requests between modules on the request bus in seconds, by endpoint",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"endpoint"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests,
+		m.duration,
+		m.inFlight,
+		m.moduleRequests,
+		m.moduleDuration,
+	)
+	return m
+}
+
+// Middleware records the requests of a module's routes
+// The route label is the matched route template, e.g. "/api/v1/customers/:id", so that it stays
+// bounded whatever the request paths are
+func (m *Metrics) Middleware(module string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		inFlight := m.inFlight.WithLabelValues(module, route)
+		inFlight.Inc()
+		defer inFlight.Dec()
+
+		start := time.Now()
+		c.Next()
+
+		m.duration.WithLabelValues(module, route, c.Request.Method).Observe(time.Since(start).Seconds())
+		m.requests.WithLabelValues(module, route, c.Request.Method, strconv.Itoa(c.Writer.Status())).Inc()
+	}
+}
+
+// RequestMiddleware records the requests sent between modules on the request bus; the result is
+// success, expected for errors of the domain such as a resource not being found, or error
+func (m *Metrics) RequestMiddleware() domain.RequestMiddleware {
+	return func(endpoint string, next domain.RequestHandler) domain.RequestHandler {
+		return func(ctx context.Context, request any) (any, error) {
+			start := time.Now()
+			response, err := next(ctx, request)
+
+			result := "success"
+			switch {
+			case domain.IsExpectedError(err):
+				result = "expected"
+			case err != nil:
+				result = "error"
+			}
+			m.moduleDuration.WithLabelValues(endpoint).Observe(time.Since(start).Seconds())
+			m.moduleRequests.WithLabelValues(endpoint, result).Inc()
+			return response, err
+		}
+	}
+}
+
+// Register adds collectors of other components, e.g. circuit breakers, to the registry
+func (m *Metrics) Register(collectors ...prometheus.Collector) {
+	m.registry.MustRegister(collectors...)
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
@@ -1,19 +1,23 @@
 package migration
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"path/filepath"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"go.uber.org/zap"
 	"gorm.io/gorm"
+
+	"golang_modular_monolith/internal/shared/infrastructure/lock"
 )
 
 // MigrationManager manages database migrations for modules
 type MigrationManager struct {
 	migrators map[string]*migrate.Migrate
+	locker    *lock.Locker
 }
 
 // NewMigrationManager creates a new migration manager
@@ -23,6 +27,22 @@ func NewMigrationManager() *MigrationManager {
 	}
 }
 
+// UseLocker makes the migrations of a module wait for the lock "migrate:<module>", so that instances
+// deployed together migrate one after the other
+func (mm *MigrationManager) UseLocker(locker *lock.Locker) {
+	mm.locker = locker
+}
+
+// withLock runs fn under the migration lock of a module, when a locker is set
+func (mm *MigrationManager) withLock(moduleName string, fn func() error) error {
+	if mm.locker == nil {
+		return fn()
+	}
+	return mm.locker.WithLock(context.Background(), "migrate:"+moduleName, func(ctx context.Context) error {
+		return fn()
+	})
+}
+
 // RegisterModule registers a module's migration path with its database
 func (mm *MigrationManager) RegisterModule(moduleName string, db *gorm.DB, migrationsPath string) error {
 	// Get underlying sql.DB from GORM
@@ -54,7 +74,7 @@ func (mm *MigrationManager) RegisterModule(moduleName string, db *gorm.DB, migra
 	}
 
 	mm.migrators[moduleName] = m
-	log.Printf("Migration registered for module: %s (path: %s)", moduleName, migrationsPath)
+	zap.L().Info("migrations registered", zap.String("module", moduleName), zap.String("path", migrationsPath))
 	return nil
 }
 
@@ -65,15 +85,15 @@ func (mm *MigrationManager) MigrateUp(moduleName string) error {
 		return fmt.Errorf("no migrator found for module: %s", moduleName)
 	}
 
-	err := migrator.Up()
+	err := mm.withLock(moduleName, migrator.Up)
 	if err != nil && err != migrate.ErrNoChange {
 		return fmt.Errorf("failed to migrate up for %s: %w", moduleName, err)
 	}
 
 	if err == migrate.ErrNoChange {
-		log.Printf("No migrations to apply for module: %s", moduleName)
+		zap.L().Info("no migrations to apply", zap.String("module", moduleName))
 	} else {
-		log.Printf("Successfully migrated up for module: %s", moduleName)
+		zap.L().Info("migrated up", zap.String("module", moduleName))
 	}
 
 	return nil
@@ -86,15 +106,15 @@ func (mm *MigrationManager) MigrateDown(moduleName string) error {
 		return fmt.Errorf("no migrator found for module: %s", moduleName)
 	}
 
-	err := migrator.Steps(-1)
+	err := mm.withLock(moduleName, func() error { return migrator.Steps(-1) })
 	if err != nil && err != migrate.ErrNoChange {
 		return fmt.Errorf("failed to migrate down for %s: %w", moduleName, err)
 	}
 
 	if err == migrate.ErrNoChange {
-		log.Printf("No migrations to rollback for module: %s", moduleName)
+		zap.L().Info("no migrations to roll back", zap.String("module", moduleName))
 	} else {
-		log.Printf("Successfully migrated down for module: %s", moduleName)
+		zap.L().Info("migrated down", zap.String("module", moduleName))
 	}
 
 	return nil
@@ -107,12 +127,12 @@ func (mm *MigrationManager) MigrateToVersion(moduleName string, version uint) er
 		return fmt.Errorf("no migrator found for module: %s", moduleName)
 	}
 
-	err := migrator.Migrate(version)
+	err := mm.withLock(moduleName, func() error { return migrator.Migrate(version) })
 	if err != nil && err != migrate.ErrNoChange {
 		return fmt.Errorf("failed to migrate to version %d for %s: %w", version, moduleName, err)
 	}
 
-	log.Printf("Successfully migrated to version %d for module: %s", version, moduleName)
+	zap.L().Info("migrated to version", zap.String("module", moduleName), zap.Uint("version", version))
 	return nil
 }
 
@@ -138,19 +158,23 @@ func (mm *MigrationManager) Reset(moduleName string) error {
 		return fmt.Errorf("no migrator found for module: %s", moduleName)
 	}
 
-	// Drop all tables
-	err := migrator.Drop()
-	if err != nil {
-		return fmt.Errorf("failed to drop tables for %s: %w", moduleName, err)
-	}
+	err := mm.withLock(moduleName, func() error {
+		// Drop all tables
+		if err := migrator.Drop(); err != nil {
+			return fmt.Errorf("failed to drop tables for %s: %w", moduleName, err)
+		}
 
-	// Run all migrations
-	err = migrator.Up()
-	if err != nil && err != migrate.ErrNoChange {
-		return fmt.Errorf("failed to migrate up after reset for %s: %w", moduleName, err)
+		// Run all migrations
+		if err := migrator.Up(); err != nil && err != migrate.ErrNoChange {
+			return fmt.Errorf("failed to migrate up after reset for %s: %w", moduleName, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	log.Printf("Successfully reset and migrated module: %s", moduleName)
+	zap.L().Info("reset and migrated", zap.String("module", moduleName))
 	return nil
 }
 
@@ -187,7 +211,7 @@ func (mm *MigrationManager) GetRegisteredModules() []string {
 func (mm *MigrationManager) Close() error {
 	for moduleName, migrator := range mm.migrators {
 		if sourceErr, dbErr := migrator.Close(); sourceErr != nil || dbErr != nil {
-			log.Printf("Error closing migrator for %s: source=%v, db=%v", moduleName, sourceErr, dbErr)
+			zap.L().Error("failed to close migrator", zap.String("module", moduleName), zap.NamedError("source_error", sourceErr), zap.NamedError("db_error", dbErr))
 		}
 	}
 	return nil
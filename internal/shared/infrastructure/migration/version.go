@@ -0,0 +1,37 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// MigrationsTable is the table golang-migrate records the applied version of a database in
+const MigrationsTable = "schema_migrations"
+
+// AppliedVersion returns the migration version applied to a database, and whether the last
+// migration failed halfway; a database never migrated is at version 0
+// It reads the migrations table directly, so it needs neither the migration files nor a lock
+func AppliedVersion(ctx context.Context, db *gorm.DB) (uint, bool, error) {
+	var exists bool
+	if err := db.WithContext(ctx).Raw("SELECT to_regclass(?) IS NOT NULL", MigrationsTable).Scan(&exists).Error; err != nil {
+		return 0, false, fmt.Errorf("failed to look up %s: %w", MigrationsTable, err)
+	}
+	if !exists {
+		return 0, false, nil
+	}
+
+	var row struct {
+		Version int64
+		Dirty   bool
+	}
+	result := db.WithContext(ctx).Raw("SELECT version, dirty FROM " + MigrationsTable + " LIMIT 1").Scan(&row)
+	if result.Error != nil {
+		return 0, false, fmt.Errorf("failed to read %s: %w", MigrationsTable, result.Error)
+	}
+	if result.RowsAffected == 0 || row.Version < 0 {
+		return 0, false, nil
+	}
+	return uint(row.Version), row.Dirty, nil
+}
@@ -0,0 +1,285 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errorSchemaName is the component name of the API's error response
+const errorSchemaName = "ErrorResponse"
+
+// Module is the documentation input of a module, or of one API version of a module served under several
+// Permissions, when set, returns the permissions configured for a route in the module's
+// http.routes, with the path relative to Prefix; Deprecated marks all the operations under Prefix
+type Module struct {
+	Name        string
+	Prefix      string
+	Operations  []*Operation
+	Permissions func(method, path string) []string
+	Deprecated  bool
+}
+
+// Build generates the OpenAPI document of the registered routes under the modules' prefixes
+// Routes are tagged with the module whose handler serves them and described by the operations the
+// module documents; other routes outside the prefixes, such as /health, are left out
+func Build(info Info, routes gin.RoutesInfo, modules []Module) *Document {
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]PathItem),
+		Components: Components{
+			SecuritySchemes: map[string]SecurityScheme{
+				SecurityBearer: {Type: "http", Scheme: "bearer", BearerFormat: "JWT", Description: "Access token from POST /auth/login or /auth/token"},
+				SecurityAPIKey: {Type: "apiKey", In: "header", Name: "X-API-Key", Description: "API key from POST /api-keys"},
+			},
+		},
+	}
+	schemas := newSchemaRegistry()
+	schemas.schemas[errorSchemaName] = errorSchema()
+
+	sort.Slice(modules, func(i, j int) bool {
+		if modules[i].Name != modules[j].Name {
+			return modules[i].Name < modules[j].Name
+		}
+		return modules[i].Prefix < modules[j].Prefix
+	})
+	sorted := append(gin.RoutesInfo(nil), routes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Path != sorted[j].Path {
+			return sorted[i].Path < sorted[j].Path
+		}
+		return sorted[i].Method < sorted[j].Method
+	})
+
+	documented := make(map[string]*Operation)
+	for _, module := range modules {
+		for _, operation := range module.Operations {
+			documented[operation.Method+" "+module.Prefix+operation.Path] = operation
+		}
+	}
+
+	tags := make(map[string]bool)
+	operationIDs := make(map[string]bool)
+	for _, route := range sorted {
+		module, ok := moduleOf(route, modules)
+		if !ok {
+			continue
+		}
+
+		operation, ok := documented[route.Method+" "+route.Path]
+		if !ok {
+			operation = &Operation{Method: route.Method, Path: strings.TrimPrefix(route.Path, module.Prefix), Status: http.StatusOK}
+		}
+		var configured []string
+		if module.Permissions != nil {
+			configured = module.Permissions(route.Method, strings.TrimPrefix(route.Path, module.Prefix))
+		}
+
+		object := buildOperation(schemas, route.Path, operation, configured)
+		object.Tags = []string{module.Name}
+		object.Deprecated = module.Deprecated
+		object.OperationID = uniqueOperationID(operationIDs, operationID(route))
+		tags[module.Name] = true
+
+		path := openAPIPath(route.Path)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(PathItem)
+		}
+		doc.Paths[path][strings.ToLower(route.Method)] = object
+	}
+
+	for _, module := range modules {
+		if tags[module.Name] {
+			doc.Tags = append(doc.Tags, Tag{Name: module.Name})
+			delete(tags, module.Name)
+		}
+	}
+	doc.Components.Schemas = schemas.schemas
+
+	return doc
+}
+
+// moduleOf returns the module serving a route: the one whose prefix the route is under and, when
+// several modules share a prefix, whose package the route's handler belongs to
+func moduleOf(route gin.RouteInfo, modules []Module) (Module, bool) {
+	var candidates []Module
+	for _, module := range modules {
+		if route.Path == module.Prefix || strings.HasPrefix(route.Path, module.Prefix+"/") {
+			candidates = append(candidates, module)
+		}
+	}
+	if len(candidates) == 0 {
+		return Module{}, false
+	}
+
+	handlerModule := qualifier(handlerPackage(route.Handler))
+	for _, module := range candidates {
+		if module.Name == handlerModule {
+			return module, true
+		}
+	}
+	return candidates[0], true
+}
+
+// handlerPackage returns the package path of a handler function name, e.g.
+// ".../customer/infrastructure/http/handlers" for ".../handlers.(*CustomerHandler).CreateCustomer-fm"
+func handlerPackage(handler string) string {
+	slash := strings.LastIndex(handler, "/")
+	if dot := strings.Index(handler[slash+1:], "."); dot >= 0 {
+		return handler[:slash+1+dot]
+	}
+	return handler
+}
+
+// buildOperation converts a documented route into an OpenAPI operation
+// configured holds the permissions the module's configuration adds to the route
+func buildOperation(schemas *schemaRegistry, path string, operation *Operation, configured []string) *OperationObject {
+	object := &OperationObject{
+		Summary:     operation.Summary,
+		Description: operation.Description,
+		Responses:   make(map[string]Response),
+	}
+
+	object.Parameters = append(pathParameters(path), operation.Parameters...)
+
+	if operation.Request != nil {
+		object.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{"application/json": {Schema: schemas.schemaOf(operation.Request)}},
+		}
+	}
+
+	status := operation.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := Response{Description: http.StatusText(status)}
+	if operation.ContentType != "" {
+		success.Content = map[string]MediaType{operation.ContentType: {Schema: &Schema{Type: "string", Format: "binary"}}}
+	} else if operation.Data != nil {
+		success.Content = map[string]MediaType{"application/json": {Schema: envelope(schemas, operation)}}
+	}
+	object.Responses[strconv.Itoa(status)] = success
+	if operation.Conditional {
+		object.Responses[strconv.Itoa(http.StatusNotModified)] = Response{Description: http.StatusText(http.StatusNotModified)}
+	}
+
+	errorContent := map[string]MediaType{"application/json": {Schema: &Schema{Ref: "#/components/schemas/" + errorSchemaName}}}
+	object.Responses["default"] = Response{Description: "Error", Content: errorContent}
+	if operation.Preconditioned {
+		object.Responses[strconv.Itoa(http.StatusPreconditionFailed)] = Response{Description: http.StatusText(http.StatusPreconditionFailed), Content: errorContent}
+	}
+
+	permissions := append(append([]string(nil), operation.Permissions...), configured...)
+	if operation.Secured || len(permissions) > 0 {
+		object.Security = []map[string][]string{{SecurityBearer: {}}, {SecurityAPIKey: {}}}
+		object.Responses[strconv.Itoa(http.StatusUnauthorized)] = Response{Description: "Authentication required", Content: errorContent}
+	}
+	if len(permissions) > 0 {
+		object.Responses[strconv.Itoa(http.StatusForbidden)] = Response{Description: "Permission denied", Content: errorContent}
+		requires := "Requires " + strings.Join(permissions, ", ")
+		if object.Description == "" {
+			object.Description = requires
+		} else {
+			object.Description += "\n\n" + requires
+		}
+	}
+
+	return object
+}
+
+// envelope returns the schema of a successful JSON response wrapping the operation's data
+func envelope(schemas *schemaRegistry, operation *Operation) *Schema {
+	schema := &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"success": {Type: "boolean"},
+			"data":    schemas.schemaOf(operation.Data),
+		},
+		Required: []string{"success", "data"},
+	}
+	if operation.Pagination != nil {
+		schema.Properties["pagination"] = schemas.schemaOf(operation.Pagination)
+		schema.Required = append(schema.Required, "pagination")
+	}
+	return schema
+}
+
+// errorSchema returns the schema of the API's error responses
+func errorSchema() *Schema {
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"success": {Type: "boolean"},
+			"error": {
+				Type: "object",
+				Properties: map[string]*Schema{
+					"code":    {Type: "string"},
+					"message": {Type: "string"},
+					"field":   {Type: "string"},
+					"rule":    {Type: "string"},
+					"details": {},
+				},
+				Required: []string{"code", "message"},
+			},
+		},
+		Required: []string{"success", "error"},
+	}
+}
+
+// pathParameters returns the parameters of a gin path's ":name" and "*name" segments
+func pathParameters(path string) []Parameter {
+	var parameters []Parameter
+	for _, segment := range strings.Split(path, "/") {
+		if len(segment) > 1 && (segment[0] == ':' || segment[0] == '*') {
+			parameters = append(parameters, Parameter{
+				Name:     segment[1:],
+				In:       "path",
+				Required: true,
+				Schema:   &Schema{Type: "string"},
+			})
+		}
+	}
+	return parameters
+}
+
+// openAPIPath converts a gin path to an OpenAPI path template, e.g. "/customers/:id" to "/customers/{id}"
+func openAPIPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if len(segment) > 1 && (segment[0] == ':' || segment[0] == '*') {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// operationID names an operation after its handler method, e.g. "CreateCustomer", falling back to
+// the method and path for anonymous handlers
+func operationID(route gin.RouteInfo) string {
+	name := strings.TrimSuffix(route.Handler[strings.LastIndex(route.Handler, ".")+1:], "-fm")
+	if name != "" && !strings.HasPrefix(name, "func") {
+		return name
+	}
+
+	id := strings.ToLower(route.Method)
+	for _, segment := range strings.Split(route.Path, "/") {
+		id += exportName(strings.TrimLeft(segment, ":*"))
+	}
+	return id
+}
+
+// uniqueOperationID returns id, suffixed with a number when it is already used
+func uniqueOperationID(used map[string]bool, id string) string {
+	candidate := id
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s%d", id, i)
+	}
+	used[candidate] = true
+	return candidate
+}
@@ -0,0 +1,54 @@
+package openapi
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// swaggerUIVersion is the version of the Swagger UI assets loaded by UIHandler
+const swaggerUIVersion = "5.17.14"
+
+// swaggerUIPage renders Swagger UI for the document at SpecURL
+var swaggerUIPage = template.Must(template.New("swagger-ui").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{.Title}}</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@{{.Version}}/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@{{.Version}}/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.ui = SwaggerUIBundle({ url: {{.SpecURL}}, dom_id: "#swagger-ui" });
+  </script>
+</body>
+</html>
+`))
+
+// Handler serves the document as JSON
+func Handler(doc *Document) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, doc)
+	}
+}
+
+// UIHandler serves a Swagger UI page for the document at specURL
+// The page loads the Swagger UI assets from unpkg.com, so browsers need internet access
+func UIHandler(specURL, title string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		c.Status(http.StatusOK)
+		err := swaggerUIPage.Execute(c.Writer, struct {
+			Title   string
+			Version string
+			SpecURL string
+		}{title, swaggerUIVersion, specURL})
+		if err != nil {
+			_ = c.Error(err)
+		}
+	}
+}
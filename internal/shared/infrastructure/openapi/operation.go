@@ -0,0 +1,170 @@
+package openapi
+
+import (
+	"net/http"
+)
+
+// Documented is implemented by modules that describe their routes in the OpenAPI document
+// Routes a module does not describe are still listed, with their path parameters only
+type Documented interface {
+	// APIOperations returns the documentation of the module's routes
+	APIOperations() []*Operation
+}
+
+// VersionDocumented is implemented by versioned modules whose route sets differ in their documentation
+// It takes precedence over Documented
+type VersionDocumented interface {
+	// APIVersionOperations returns the documentation of the routes of a route set, e.g. "v2"
+	APIVersionOperations(handlers string) []*Operation
+}
+
+// Operation documents a route of a module
+// Path is relative to the module's http.prefix, in gin syntax (e.g. "/customers/:id"); successful
+// JSON responses are wrapped in the {"success": true, "data": ...} envelope
+type Operation struct {
+	Method      string
+	Path        string
+	Summary     string
+	Description string
+	Parameters  []Parameter
+	Request     interface{}
+	Data        interface{}
+	Pagination  interface{}
+	Status      int
+	ContentType string
+	Conditional bool
+	// Preconditioned writes take the version they expect in If-Match
+	Preconditioned bool
+	Secured        bool
+	Permissions    []string
+}
+
+// Get documents a GET route
+func Get(path, summary string) *Operation {
+	return newOperation(http.MethodGet, path, summary)
+}
+
+// Post documents a POST route
+func Post(path, summary string) *Operation {
+	return newOperation(http.MethodPost, path, summary)
+}
+
+// Put documents a PUT route
+func Put(path, summary string) *Operation {
+	return newOperation(http.MethodPut, path, summary)
+}
+
+// Patch documents a PATCH route
+func Patch(path, summary string) *Operation {
+	return newOperation(http.MethodPatch, path, summary)
+}
+
+// Delete documents a DELETE route
+func Delete(path, summary string) *Operation {
+	return newOperation(http.MethodDelete, path, summary)
+}
+
+// newOperation creates an operation answering 200 with JSON
+func newOperation(method, path, summary string) *Operation {
+	return &Operation{
+		Method:  method,
+		Path:    path,
+		Summary: summary,
+		Status:  http.StatusOK,
+	}
+}
+
+// Describe sets the longer description of the operation
+func (o *Operation) Describe(description string) *Operation {
+	o.Description = description
+	return o
+}
+
+// Query adds an optional query parameter of a JSON schema type ("string", "integer", "boolean", ...)
+func (o *Operation) Query(name, schemaType, description string) *Operation {
+	o.Parameters = append(o.Parameters, Parameter{
+		Name:        name,
+		In:          "query",
+		Description: description,
+		Schema:      &Schema{Type: schemaType},
+	})
+	return o
+}
+
+// Header adds an optional request header
+func (o *Operation) Header(name, description string) *Operation {
+	o.Parameters = append(o.Parameters, Parameter{
+		Name:        name,
+		In:          "header",
+		Description: description,
+		Schema:      &Schema{Type: "string"},
+	})
+	return o
+}
+
+// Body sets the JSON request body from a value of its type
+func (o *Operation) Body(request interface{}) *Operation {
+	o.Request = request
+	return o
+}
+
+// Returns sets the data of the successful response from a value of its type
+func (o *Operation) Returns(data interface{}) *Operation {
+	o.Data = data
+	return o
+}
+
+// Created sets the data of a 201 Created response from a value of its type
+func (o *Operation) Created(data interface{}) *Operation {
+	o.Data = data
+	o.Status = http.StatusCreated
+	return o
+}
+
+// Accepted sets the data of a 202 Accepted response from a value of its type
+func (o *Operation) Accepted(data interface{}) *Operation {
+	o.Data = data
+	o.Status = http.StatusAccepted
+	return o
+}
+
+// Paginated sets the data and pagination of a list response from values of their types
+func (o *Operation) Paginated(data, pagination interface{}) *Operation {
+	o.Data = data
+	o.Pagination = pagination
+	return o
+}
+
+// Produces documents a successful response that is not JSON, such as a file download
+func (o *Operation) Produces(contentType string) *Operation {
+	o.ContentType = contentType
+	return o
+}
+
+// Cached documents that the route answers with an ETag and honors If-None-Match with 304 Not Modified
+func (o *Operation) Cached() *Operation {
+	o.Conditional = true
+	o.Header("If-None-Match", "ETag of a previous response; answered 304 when unchanged")
+	return o
+}
+
+// Versioned documents that the route applies only to the version of the resource named in If-Match,
+// failing with 412 Precondition Failed otherwise
+func (o *Operation) Versioned() *Operation {
+	o.Preconditioned = true
+	o.Header("If-Match", "ETag of the version the change applies to; answered 412 when the resource is at another version")
+	return o
+}
+
+// Authenticated documents that the route requires a bearer token, API key or session
+func (o *Operation) Authenticated() *Operation {
+	o.Secured = true
+	return o
+}
+
+// Requires documents the permissions the route requires; it implies Authenticated
+func (o *Operation) Requires(permissions ...string) *Operation {
+	o.Secured = true
+	o.Permissions = append(o.Permissions, permissions...)
+	return o
+}
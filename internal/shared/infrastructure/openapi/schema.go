@@ -0,0 +1,288 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	durationType  = reflect.TypeOf(time.Duration(0))
+	rawJSONType   = reflect.TypeOf(json.RawMessage{})
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// schemaRegistry builds schemas from Go types
+// Named struct types become components referenced by $ref; the first type registered under a name
+// keeps it, later types of the same name are qualified with their module or package
+type schemaRegistry struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+// newSchemaRegistry creates an empty schema registry
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+// schemaOf returns the schema of a value's type, or nil for a nil value
+func (r *schemaRegistry) schemaOf(value interface{}) *Schema {
+	if value == nil {
+		return nil
+	}
+	return r.schema(reflect.TypeOf(value))
+}
+
+// schema returns the schema of a type
+func (r *schemaRegistry) schema(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case durationType:
+		return &Schema{Type: "integer", Format: "int64", Description: "nanoseconds"}
+	case rawJSONType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return r.schema(t.Elem())
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: r.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Implements(marshalerType) || reflect.PointerTo(t).Implements(marshalerType) {
+			// The JSON form of types with custom marshalling is not known
+			return &Schema{}
+		}
+		if t.Name() == "" {
+			return r.structSchema(t)
+		}
+		return r.ref(t)
+	}
+
+	// Interfaces and other kinds accept any value
+	return &Schema{}
+}
+
+// ref registers a named struct type as a component and returns a reference to it
+func (r *schemaRegistry) ref(t reflect.Type) *Schema {
+	name, ok := r.names[t]
+	if !ok {
+		name = r.componentName(t)
+		r.names[t] = name
+		// Registered before the properties are built, so recursive types terminate
+		r.schemas[name] = &Schema{}
+		*r.schemas[name] = *r.structSchema(t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// componentName returns an unused component name for a named type
+func (r *schemaRegistry) componentName(t reflect.Type) string {
+	name := sanitizeName(t.Name())
+	if _, taken := r.schemas[name]; !taken {
+		return name
+	}
+
+	qualified := exportName(qualifier(t.PkgPath())) + name
+	if _, taken := r.schemas[qualified]; !taken {
+		return qualified
+	}
+
+	for i := 2; ; i++ {
+		candidate := qualified + strconv.Itoa(i)
+		if _, taken := r.schemas[candidate]; !taken {
+			return candidate
+		}
+	}
+}
+
+// structSchema builds the object schema of a struct from its JSON fields
+// Embedded structs without a JSON name contribute their fields, like encoding/json
+func (r *schemaRegistry) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				inner := r.structSchema(embedded)
+				for property, propertySchema := range inner.Properties {
+					schema.Properties[property] = propertySchema
+				}
+				schema.Required = append(schema.Required, inner.Required...)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		var property *Schema
+		if strings.Contains(options, "string") {
+			property = &Schema{Type: "string"}
+		} else {
+			property = r.schema(field.Type)
+		}
+
+		if property.Ref == "" && field.Type.Kind() == reflect.Ptr {
+			property.Nullable = true
+		}
+
+		constraints := field.Tag.Get("binding")
+		if constraints == "" {
+			constraints = field.Tag.Get("validate")
+		}
+		if applyConstraints(property, constraints) {
+			schema.Required = append(schema.Required, name)
+		}
+
+		schema.Properties[name] = property
+	}
+
+	return schema
+}
+
+// applyConstraints applies binding or validate tag rules to a property schema and reports
+// if the field is required
+// Rules after "dive" apply to elements and are ignored
+func applyConstraints(schema *Schema, constraints string) bool {
+	required := false
+	for _, rule := range strings.Split(constraints, ",") {
+		key, value, _ := strings.Cut(rule, "=")
+		if key == "dive" {
+			break
+		}
+		if schema.Ref != "" {
+			required = required || key == "required"
+			continue
+		}
+
+		switch key {
+		case "required":
+			required = true
+		case "email", "uuid":
+			schema.Format = key
+		case "url":
+			schema.Format = "uri"
+		case "oneof":
+			schema.Enum = strings.Fields(value)
+		case "min", "max", "gte", "lte", "len":
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			if key != "max" && key != "lte" {
+				applyBound(schema, true, n)
+			}
+			if key != "min" && key != "gte" {
+				applyBound(schema, false, n)
+			}
+		}
+	}
+	return required
+}
+
+// applyBound sets a lower or upper bound on the length, size or value of a property
+func applyBound(schema *Schema, lower bool, n float64) {
+	switch schema.Type {
+	case "string":
+		if lower {
+			schema.MinLength = intPtr(int(n))
+		} else {
+			schema.MaxLength = intPtr(int(n))
+		}
+	case "array":
+		if lower {
+			schema.MinItems = intPtr(int(n))
+		} else {
+			schema.MaxItems = intPtr(int(n))
+		}
+	case "integer", "number":
+		if lower {
+			schema.Minimum = &n
+		} else {
+			schema.Maximum = &n
+		}
+	}
+}
+
+// qualifier returns the module of a package path, e.g. "customer" for
+// ".../internal/modules/customer/application/dto", or the package name outside modules
+func qualifier(pkgPath string) string {
+	if _, rest, found := strings.Cut(pkgPath, "/modules/"); found {
+		module, _, _ := strings.Cut(rest, "/")
+		return module
+	}
+	return pkgPath[strings.LastIndex(pkgPath, "/")+1:]
+}
+
+// sanitizeName removes the characters component names cannot contain, such as type parameters
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-' {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+// exportName upper-cases the first letter of a name and drops underscores, e.g. "user_audit" becomes "UserAudit"
+func exportName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if r == '_' || r == '-' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// intPtr returns a pointer to n
+func intPtr(n int) *int {
+	return &n
+}
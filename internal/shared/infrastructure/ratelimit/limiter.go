@@ -0,0 +1,95 @@
+// Package ratelimit limits how many requests each client sends to a group of routes per minute.
+package ratelimit
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Headers of rate limited responses
+const (
+	HeaderLimit     = "X-RateLimit-Limit"
+	HeaderRemaining = "X-RateLimit-Remaining"
+)
+
+// ErrCodeRateLimited is the error code of rejected requests
+const ErrCodeRateLimited = "RATE_LIMITED"
+
+// Limiter counts the requests of each client in fixed one-minute windows
+// Counts are held in process memory, so each instance enforces the limit on its own
+type Limiter struct {
+	mu      sync.Mutex
+	limit   int
+	windows map[string]*window
+	swept   time.Time
+}
+
+// window is the count of a client's requests since start
+type window struct {
+	start time.Time
+	count int
+}
+
+// windowLength is the length of the counting windows
+const windowLength = time.Minute
+
+// NewLimiter creates a limiter allowing requestsPerMinute requests per client
+func NewLimiter(requestsPerMinute int) *Limiter {
+	return &Limiter{limit: requestsPerMinute, windows: make(map[string]*window), swept: time.Now()}
+}
+
+// Allow counts a request of the client and reports whether it is within the limit, with the
+// number of requests left and the time until the window resets
+func (l *Limiter) Allow(client string) (bool, int, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.swept) > windowLength {
+		for key, w := range l.windows {
+			if now.Sub(w.start) >= windowLength {
+				delete(l.windows, key)
+			}
+		}
+		l.swept = now
+	}
+
+	w, ok := l.windows[client]
+	if !ok || now.Sub(w.start) >= windowLength {
+		w = &window{start: now}
+		l.windows[client] = w
+	}
+	reset := w.start.Add(windowLength).Sub(now)
+	if w.count >= l.limit {
+		return false, 0, reset
+	}
+	w.count++
+	return true, l.limit - w.count, reset
+}
+
+// Middleware rejects requests over the limit with 429 and a Retry-After header
+// Clients are identified by their IP address, read from forwarding headers of trusted proxies only
+func Middleware(limiter *Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		allowed, remaining, reset := limiter.Allow(c.ClientIP())
+		c.Header(HeaderLimit, strconv.Itoa(limiter.limit))
+		c.Header(HeaderRemaining, strconv.Itoa(remaining))
+		if !allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(reset.Seconds()))))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    ErrCodeRateLimited,
+					"message": "Too many requests, retry later",
+				},
+			})
+			return
+		}
+		c.Next()
+	}
+}
@@ -2,10 +2,12 @@ package registry
 
 import (
 	"fmt"
-	"log"
+
+	"go.uber.org/zap"
 
 	"golang_modular_monolith/internal/shared/domain"
 	"golang_modular_monolith/internal/shared/infrastructure/config"
+	"golang_modular_monolith/internal/shared/infrastructure/worker"
 )
 
 // ModuleCreator is a function that creates a module
@@ -28,7 +30,7 @@ func NewModuleManager() *ModuleManager {
 // RegisterModule registers a module creator
 func (m *ModuleManager) RegisterModule(name string, creator ModuleCreator) {
 	m.creators[name] = creator
-	log.Printf("📦 Registered module creator: %s", name)
+	zap.L().Debug("module creator registered", zap.String("module", name))
 }
 
 // CreateModule creates a module by name
@@ -39,7 +41,7 @@ func (m *ModuleManager) CreateModule(name string) (domain.Module, error) {
 	}
 
 	module := creator()
-	log.Printf("🏗️ Created module: %s", name)
+	zap.L().Debug("module created", zap.String("module", name))
 	return module, nil
 }
 
@@ -60,39 +62,65 @@ func (m *ModuleManager) HasModule(name string) bool {
 
 // LoadEnabledModules loads all enabled modules from configuration
 func (m *ModuleManager) LoadEnabledModules(cfg *config.Config) error {
-	log.Println("🔧 Loading enabled modules...")
+	zap.L().Info("loading enabled modules")
 
 	if cfg.Modules == nil {
-		log.Println("⚠️ No modules configuration found")
+		zap.L().Warn("no modules configuration found")
 		return nil
 	}
 
+	// Bound each lifecycle call of the modules by the timeout of its phase
+	timeouts, err := cfg.Modules.Global.Lifecycle.GetTimeouts()
+	if err != nil {
+		return err
+	}
+	m.registry.SetTimeouts(domain.LifecycleTimeouts{Init: timeouts.Init, Start: timeouts.Start, Stop: timeouts.Stop})
+
+	// Out-of-tree modules become available once their plugins are loaded
+	if err := m.LoadPlugins(cfg.Modules.Global.Plugins); err != nil {
+		return err
+	}
+
+	// Modules enabled in the configuration may be left out of this build by its tags
+	for _, moduleName := range cfg.Modules.GetEnabledModules() {
+		if !m.HasModule(moduleName) {
+			zap.L().Warn("module enabled in config is not compiled into this binary", zap.String("module", moduleName))
+		}
+	}
+
 	// Get all available modules
 	availableModules := m.GetAvailableModules()
-	log.Printf("📋 Available modules: %v", availableModules)
+	zap.L().Info("available modules", zap.Strings("modules", availableModules))
 
 	// Load each enabled module
 	for _, moduleName := range availableModules {
 		if m.isModuleEnabled(cfg, moduleName) {
-			log.Printf("📦 Loading %s module...", moduleName)
+			zap.L().Info("loading module", zap.String("module", moduleName))
 
 			// Create module
 			module, err := m.CreateModule(moduleName)
 			if err != nil {
-				log.Printf("❌ Failed to create %s module: %v", moduleName, err)
-				continue
+				return err
 			}
 
-			// Register module
+			// Register module, with its configuration, failure policy and the dependencies it declares
 			m.registry.Register(module)
-			log.Printf("✅ %s module registered", moduleName)
+			m.registry.SetConfig(moduleName, cfg.Modules.ResolveModule(moduleName))
+			m.registry.SetFailurePolicy(moduleName, domain.FailurePolicy(cfg.Modules.FailurePolicy(moduleName)))
+
+			// Enforce the module's resource budget; its database pool is capped when the database is opened
+			resources := cfg.Modules.Modules[moduleName].Resources
+			m.registry.SetEventHandlerLimit(moduleName, resources.MaxEventHandlers)
+			worker.SetBudget(moduleName, resources.MaxBackgroundJobs)
+			m.registry.AddDependencies(moduleName, cfg.Modules.Modules[moduleName].Module.DependencyNames()...)
+			zap.L().Info("module registered", zap.String("module", moduleName))
 		} else {
-			log.Printf("⏭️ %s module disabled in config", moduleName)
+			zap.L().Info("module disabled in config", zap.String("module", moduleName))
 		}
 	}
 
 	loadedModules := m.registry.GetModuleNames()
-	log.Printf("✅ Loaded modules: %v", loadedModules)
+	zap.L().Info("modules loaded", zap.Strings("modules", loadedModules))
 
 	return nil
 }
@@ -0,0 +1,53 @@
+package registry
+
+import (
+	"fmt"
+	"plugin"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// PluginSymbol is the function a module plugin exports to create its module:
+//
+//	func NewModule() domain.Module
+const PluginSymbol = "NewModule"
+
+// LoadPlugin opens a module built with -buildmode=plugin and registers its module creator under the
+// module's name
+// The plugin must be built with the same Go version and versions of the packages it shares with
+// the binary, which in practice means from the same tree
+func (m *ModuleManager) LoadPlugin(path string) error {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open module plugin %s: %w", path, err)
+	}
+
+	symbol, err := p.Lookup(PluginSymbol)
+	if err != nil {
+		return fmt.Errorf("module plugin %s: %w", path, err)
+	}
+	creator, ok := symbol.(func() domain.Module)
+	if !ok {
+		return fmt.Errorf("module plugin %s: %s is %T, not func() domain.Module", path, PluginSymbol, symbol)
+	}
+
+	name := creator().Name()
+	if m.HasModule(name) {
+		return fmt.Errorf("module plugin %s: module %s is already registered", path, name)
+	}
+	m.RegisterModule(name, creator)
+	zap.L().Info("module plugin loaded", zap.String("module", name), zap.String("path", path))
+	return nil
+}
+
+// LoadPlugins loads the module plugins at paths, in order
+func (m *ModuleManager) LoadPlugins(paths []string) error {
+	for _, path := range paths {
+		if err := m.LoadPlugin(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package requestid
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Header carries the request ID; it is accepted from clients and proxies and echoed in every response
+const Header = "X-Request-ID"
+
+// maxLength bounds client-supplied request IDs written to logs and audit records
+const maxLength = 128
+
+// Middleware assigns every request an ID, taken from the X-Request-ID header or generated, puts it on the
+// request context (domain.RequestIDFromContext) and returns it in the response
+// Logs, security events and domain events of the request carry the ID, so one request can be traced
+// across modules
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(Header)
+		if !valid(requestID) {
+			requestID = uuid.NewString()
+		}
+
+		c.Header(Header, requestID)
+		c.Request = c.Request.WithContext(domain.WithRequestID(c.Request.Context(), requestID))
+
+		c.Next()
+	}
+}
+
+// FromContext returns the ID Middleware assigned to the request, or "" outside it
+func FromContext(c *gin.Context) string {
+	return domain.RequestIDFromContext(c.Request.Context())
+}
+
+// valid accepts non-empty printable ASCII IDs of a bounded length, so clients cannot inject control
+// characters or oversized values into the logs
+func valid(requestID string) bool {
+	if requestID == "" || len(requestID) > maxLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] < 0x21 || requestID[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,220 @@
+// Package resilience guards calls to external services with timeouts, retries and circuit breakers.
+package resilience
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrOpen is returned without calling the service while its breaker is open
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State is the state of a circuit breaker
+type State int
+
+// Breaker states: closed lets calls through, open rejects them, and half-open lets a few trial
+// calls through to find out whether the service recovered
+const (
+	StateClosed State = iota
+	StateHalfOpen
+	StateOpen
+)
+
+// String returns the state name used in metrics and health checks
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half_open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerSettings configures when a breaker opens and how it recovers
+type BreakerSettings struct {
+	// FailureThreshold is the number of consecutive failures opening the breaker
+	FailureThreshold int
+	// OpenTimeout is how long the breaker stays open before letting trial calls through
+	OpenTimeout time.Duration
+	// HalfOpenRequests is the number of trial calls; the breaker closes when they all succeed
+	HalfOpenRequests int
+}
+
+// Default breaker settings
+const (
+	DefaultFailureThreshold = 5
+	DefaultOpenTimeout      = 30 * time.Second
+	DefaultHalfOpenRequests = 1
+)
+
+// Breaker stops calling a failing service for a while, so that callers fail fast instead of
+// waiting on timeouts and the service is not flooded while it recovers
+type Breaker struct {
+	name     string
+	settings BreakerSettings
+
+	mu        sync.Mutex
+	state     State
+	failures  int
+	trials    int
+	successes int
+	openedAt  time.Time
+
+	succeeded uint64
+	failed    uint64
+	rejected  uint64
+	lastError string
+}
+
+// NewBreaker creates a closed breaker and registers it under name, replacing any breaker of the
+// same name, so that its state is reported by Breakers
+// Names identify the service, e.g. "payment:stripe" or "vault"
+func NewBreaker(name string, settings BreakerSettings) *Breaker {
+	if settings.FailureThreshold <= 0 {
+		settings.FailureThreshold = DefaultFailureThreshold
+	}
+	if settings.OpenTimeout <= 0 {
+		settings.OpenTimeout = DefaultOpenTimeout
+	}
+	if settings.HalfOpenRequests <= 0 {
+		settings.HalfOpenRequests = DefaultHalfOpenRequests
+	}
+
+	b := &Breaker{name: name, settings: settings}
+	breakers.Store(name, b)
+	return b
+}
+
+// Name returns the name of the breaker
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// State returns the current state, moving an open breaker whose timeout elapsed to half-open
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refresh(time.Now())
+	return b.state
+}
+
+// Allow reserves a call, returning ErrOpen while the breaker rejects calls
+// Every allowed call must be reported with Done
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refresh(time.Now())
+	switch b.state {
+	case StateOpen:
+		b.rejected++
+		return fmt.Errorf("%s: %w", b.name, ErrOpen)
+	case StateHalfOpen:
+		if b.trials >= b.settings.HalfOpenRequests {
+			b.rejected++
+			return fmt.Errorf("%s: %w", b.name, ErrOpen)
+		}
+		b.trials++
+	}
+	return nil
+}
+
+// Done reports the outcome of a call reserved with Allow
+func (b *Breaker) Done(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err != nil {
+		b.failed++
+		b.lastError = err.Error()
+		b.failures++
+		if b.state == StateHalfOpen || b.failures >= b.settings.FailureThreshold {
+			b.open(time.Now())
+		}
+		return
+	}
+
+	b.succeeded++
+	b.failures = 0
+	if b.state == StateHalfOpen {
+		b.successes++
+		if b.successes >= b.settings.HalfOpenRequests {
+			b.state = StateClosed
+		}
+	}
+}
+
+// release gives back a call reserved with Allow whose outcome is unknown
+func (b *Breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == StateHalfOpen && b.trials > 0 {
+		b.trials--
+	}
+}
+
+// open moves the breaker to open as of now
+func (b *Breaker) open(now time.Time) {
+	b.state = StateOpen
+	b.openedAt = now
+	b.trials = 0
+	b.successes = 0
+}
+
+// refresh moves an open breaker to half-open once its open timeout elapsed
+func (b *Breaker) refresh(now time.Time) {
+	if b.state == StateOpen && now.Sub(b.openedAt) >= b.settings.OpenTimeout {
+		b.state = StateHalfOpen
+		b.trials = 0
+		b.successes = 0
+	}
+}
+
+// Snapshot is the state and counters of a breaker at a point in time
+type Snapshot struct {
+	Name      string
+	State     State
+	Successes uint64
+	Failures  uint64
+	Rejected  uint64
+	LastError string
+}
+
+// Snapshot returns the current state and counters of the breaker
+func (b *Breaker) Snapshot() Snapshot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refresh(time.Now())
+	return Snapshot{
+		Name:      b.name,
+		State:     b.state,
+		Successes: b.succeeded,
+		Failures:  b.failed,
+		Rejected:  b.rejected,
+		LastError: b.lastError,
+	}
+}
+
+// breakers holds the registered breakers by name
+var breakers sync.Map
+
+// Breakers returns the registered breakers sorted by name
+func Breakers() []*Breaker {
+	var list []*Breaker
+	breakers.Range(func(_, value any) bool {
+		list = append(list, value.(*Breaker))
+		return true
+	})
+	sort.Slice(list, func(i, j int) bool { return list[i].name < list[j].name })
+	return list
+}
@@ -0,0 +1,64 @@
+package resilience
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	stateDesc = prometheus.NewDesc(
+		"circuit_breaker_state",
+		"State of each circuit breaker: 1 for its current state, 0 for the others",
+		[]string{"name", "state"}, nil,
+	)
+	callsDesc = prometheus.NewDesc(
+		"circuit_breaker_calls_total",
+		"Number of calls through each circuit breaker, by result: success, failure or rejected",
+		[]string{"name", "result"}, nil,
+	)
+)
+
+// collector exposes the registered breakers as Prometheus metrics
+type collector struct{}
+
+// Collector returns a Prometheus collector of the state and calls of every registered breaker
+func Collector() prometheus.Collector {
+	return collector{}
+}
+
+// Describe implements prometheus.Collector
+func (collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- stateDesc
+	ch <- callsDesc
+}
+
+// Collect implements prometheus.Collector
+func (collector) Collect(ch chan<- prometheus.Metric) {
+	for _, breaker := range Breakers() {
+		snapshot := breaker.Snapshot()
+		for _, state := range []State{StateClosed, StateHalfOpen, StateOpen} {
+			value := 0.0
+			if snapshot.State == state {
+				value = 1
+			}
+			ch <- prometheus.MustNewConstMetric(stateDesc, prometheus.GaugeValue, value, snapshot.Name, state.String())
+		}
+		ch <- prometheus.MustNewConstMetric(callsDesc, prometheus.CounterValue, float64(snapshot.Successes), snapshot.Name, "success")
+		ch <- prometheus.MustNewConstMetric(callsDesc, prometheus.CounterValue, float64(snapshot.Failures), snapshot.Name, "failure")
+		ch <- prometheus.MustNewConstMetric(callsDesc, prometheus.CounterValue, float64(snapshot.Rejected), snapshot.Name, "rejected")
+	}
+}
+
+// HealthCheck returns a check of the breaker that fails while it is open, with the last error of
+// the service
+func HealthCheck(breaker *Breaker) func(context.Context) error {
+	return func(context.Context) error {
+		snapshot := breaker.Snapshot()
+		if snapshot.State == StateOpen {
+			return fmt.Errorf("circuit breaker is open: %s", snapshot.LastError)
+		}
+		return nil
+	}
+}
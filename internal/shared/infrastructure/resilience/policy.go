@@ -0,0 +1,107 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Default retry settings
+const (
+	DefaultBackoff    = 200 * time.Millisecond
+	DefaultMaxBackoff = 5 * time.Second
+)
+
+// Policy bounds each attempt of a call with Timeout and retries failed attempts Retries times,
+// doubling Backoff between them; with a Breaker, attempts are skipped while it is open
+// Only retry calls that are safe to repeat, e.g. reads or requests carrying an idempotency key
+type Policy struct {
+	Timeout    time.Duration
+	Retries    int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	Breaker    *Breaker
+}
+
+// permanentError marks an error that is not worth retrying
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps an error of the service that repeating the call would not fix, e.g. a rejected
+// request; it ends the retries and is not counted as a failure by the breaker
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+// Do calls op until it succeeds, fails permanently or the retries are exhausted, and returns its
+// last error, unwrapped from Permanent
+func (p Policy) Do(ctx context.Context, op func(ctx context.Context) error) error {
+	backoff := p.Backoff
+	if backoff <= 0 {
+		backoff = DefaultBackoff
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultMaxBackoff
+	}
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = p.attempt(ctx, op)
+
+		var permanent permanentError
+		if errors.As(err, &permanent) {
+			return permanent.err
+		}
+		if err == nil || errors.Is(err, ErrOpen) || attempt >= p.Retries || ctx.Err() != nil {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// attempt runs op once within the timeout and reports its outcome to the breaker
+func (p Policy) attempt(ctx context.Context, op func(ctx context.Context) error) error {
+	if p.Breaker != nil {
+		if err := p.Breaker.Allow(); err != nil {
+			return err
+		}
+	}
+
+	attemptCtx := ctx
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		attemptCtx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	err := op(attemptCtx)
+	if p.Breaker != nil {
+		var permanent permanentError
+		switch {
+		case errors.As(err, &permanent):
+			p.Breaker.Done(nil)
+		case err != nil && ctx.Err() != nil:
+			// The caller gave up, which says nothing about the service
+			p.Breaker.release()
+		default:
+			p.Breaker.Done(err)
+		}
+	}
+	return err
+}
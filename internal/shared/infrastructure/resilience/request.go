@@ -0,0 +1,40 @@
+package resilience
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// RequestMiddleware breaks the circuit of each endpoint of the request bus, in a breaker named
+// "request:<endpoint>", and bounds each request with timeout when it is positive
+// Expected errors of the domain, such as a resource not being found, do not count as failures
+func RequestMiddleware(settings BreakerSettings, timeout time.Duration) domain.RequestMiddleware {
+	var mu sync.Mutex
+	endpoints := make(map[string]*Breaker)
+	return func(endpoint string, next domain.RequestHandler) domain.RequestHandler {
+		mu.Lock()
+		breaker, ok := endpoints[endpoint]
+		if !ok {
+			breaker = NewBreaker("request:"+endpoint, settings)
+			endpoints[endpoint] = breaker
+		}
+		mu.Unlock()
+		policy := Policy{Timeout: timeout, Breaker: breaker}
+
+		return func(ctx context.Context, request any) (any, error) {
+			var response any
+			err := policy.Do(ctx, func(ctx context.Context) error {
+				var err error
+				response, err = next(ctx, request)
+				if domain.IsExpectedError(err) {
+					return Permanent(err)
+				}
+				return err
+			})
+			return response, err
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package retention
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/shared/infrastructure/awssig"
+	"golang_modular_monolith/internal/shared/infrastructure/resilience"
+)
+
+// Archive backends
+const (
+	ArchiveLocal = "local"
+	ArchiveS3    = "s3"
+)
+
+// Archive keeps the records removed by policies, as objects stored under keys
+type Archive interface {
+	// Put stores the object and returns its location, e.g. a path or an s3:// URL
+	Put(ctx context.Context, key string, body []byte) (string, error)
+}
+
+// DirArchive stores objects as files under a directory
+type DirArchive struct {
+	dir string
+}
+
+// NewDirArchive creates an archive in dir, ./data/archive when empty
+func NewDirArchive(dir string) (*DirArchive, error) {
+	if dir == "" {
+		dir = "./data/archive"
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create archive directory: %w", err)
+	}
+	return &DirArchive{dir: dir}, nil
+}
+
+// Put writes the object to its file, replacing it atomically
+func (a *DirArchive) Put(_ context.Context, key string, body []byte) (string, error) {
+	file := filepath.Join(a.dir, filepath.FromSlash(path.Clean("/"+key)))
+	if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+		return "", fmt.Errorf("failed to create archive directory: %w", err)
+	}
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, body, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write archive: %w", err)
+	}
+	if err := os.Rename(tmp, file); err != nil {
+		os.Remove(tmp)
+		return "", fmt.Errorf("failed to write archive: %w", err)
+	}
+	return file, nil
+}
+
+// S3ArchiveConfig configures the S3 archive
+type S3ArchiveConfig struct {
+	Bucket          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// Endpoint defaults to the S3 endpoint of the region; set it for S3-compatible services
+	// such as MinIO, usually with PathStyle
+	Endpoint  string
+	PathStyle bool
+	// Prefix is prepended to the keys of the objects
+	Prefix string
+	// Timeout bounds each attempt of an upload, 30s when zero
+	Timeout time.Duration
+	Retries int
+}
+
+// S3Archive stores objects in an S3 bucket
+type S3Archive struct {
+	config      S3ArchiveConfig
+	endpoint    *url.URL
+	credentials awssig.Credentials
+	client      *http.Client
+	policy      resilience.Policy
+}
+
+// NewS3Archive creates a new S3 archive
+func NewS3Archive(config S3ArchiveConfig) (*S3Archive, error) {
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+	if config.Region == "" {
+		return nil, fmt.Errorf("s3 region is required")
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("s3 access_key_id and secret_access_key are required")
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = "https://s3." + config.Region + ".amazonaws.com"
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(config.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", config.Endpoint)
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 30 * time.Second
+	}
+
+	return &S3Archive{
+		config:   config,
+		endpoint: endpoint,
+		credentials: awssig.Credentials{
+			AccessKeyID:     config.AccessKeyID,
+			SecretAccessKey: config.SecretAccessKey,
+		},
+		client: &http.Client{},
+		policy: resilience.Policy{
+			Timeout: config.Timeout,
+			Retries: config.Retries,
+			Breaker: resilience.NewBreaker("retention:archive", resilience.BreakerSettings{}),
+		},
+	}, nil
+}
+
+// Put uploads the object; the body is held in memory, so failed attempts are retried
+func (a *S3Archive) Put(ctx context.Context, key string, body []byte) (string, error) {
+	key = strings.TrimPrefix(path.Join(a.config.Prefix, key), "/")
+	err := a.policy.Do(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, a.objectURL(key).String(), bytes.NewReader(body))
+		if err != nil {
+			return resilience.Permanent(fmt.Errorf("failed to build s3 request: %w", err))
+		}
+		req.Header.Set("Content-Type", "application/gzip")
+		awssig.Sign(req, awssig.PayloadHash(body), a.credentials, awssig.Scope{Region: a.config.Region, Service: "s3"}, time.Now())
+
+		resp, err := a.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("s3 request failed: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 300 {
+			return nil
+		}
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+		if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+			return fmt.Errorf("s3 returned status %d", resp.StatusCode)
+		}
+		return resilience.Permanent(fmt.Errorf("s3 returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(message))))
+	})
+	if err != nil {
+		return "", err
+	}
+	return "s3://" + a.config.Bucket + "/" + key, nil
+}
+
+// objectURL returns the URL of the object stored under key
+func (a *S3Archive) objectURL(key string) *url.URL {
+	u := *a.endpoint
+	if a.config.PathStyle {
+		u.Path = u.Path + "/" + a.config.Bucket + "/" + key
+	} else {
+		u.Host = a.config.Bucket + "." + u.Host
+		u.Path = u.Path + "/" + key
+	}
+	return &u
+}
@@ -0,0 +1,118 @@
+package retention
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+)
+
+// PolicySettings are the settings of a policy in the retention section of a module's settings
+type PolicySettings struct {
+	// After is how long records are kept, e.g. 90d or 5y; the policy is off when it is empty
+	After string `mapstructure:"after"`
+}
+
+// After returns how long the module keeps the records of a policy, from retention.<policy>.after
+// of its settings, zero when the policy is off
+func After(cfg domain.ModuleConfig, policy string) (time.Duration, error) {
+	var settings map[string]PolicySettings
+	if err := cfg.Decode("retention", &settings); err != nil {
+		return 0, fmt.Errorf("invalid retention settings: %w", err)
+	}
+	after := settings[policy].After
+	if after == "" {
+		return 0, nil
+	}
+	retention, err := ParseDuration(after)
+	if err != nil {
+		return 0, fmt.Errorf("invalid retention of %s: %w", policy, err)
+	}
+	return retention, nil
+}
+
+// ParseDuration parses a retention period: a Go duration such as 720h, or a number of days (90d)
+// or years of 365 days (5y)
+func ParseDuration(s string) (time.Duration, error) {
+	unit := time.Duration(0)
+	switch {
+	case strings.HasSuffix(s, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(s, "y"):
+		unit = 365 * 24 * time.Hour
+	}
+
+	var retention time.Duration
+	if unit > 0 {
+		n, err := strconv.Atoi(strings.TrimSpace(s[:len(s)-1]))
+		if err != nil {
+			return 0, fmt.Errorf("retention must be a duration such as 720h, 90d or 5y, got %q", s)
+		}
+		retention = time.Duration(n) * unit
+	} else {
+		var err error
+		if retention, err = time.ParseDuration(s); err != nil {
+			return 0, fmt.Errorf("retention must be a duration such as 720h, 90d or 5y, got %q", s)
+		}
+	}
+	if retention <= 0 {
+		return 0, fmt.Errorf("retention must be positive, got %q", s)
+	}
+	return retention, nil
+}
+
+// OptionsFromConfig returns the scheduler options of global.retention, with the archive it names
+func OptionsFromConfig(cfg *config.Config) (Options, error) {
+	var settings config.RetentionGlobalConfig
+	if cfg.Modules != nil {
+		settings = cfg.Modules.Global.Retention
+	}
+
+	options := Options{BatchSize: settings.BatchSize, DryRun: settings.DryRun}
+	if settings.Interval != "" {
+		interval, err := time.ParseDuration(settings.Interval)
+		if err != nil || interval <= 0 {
+			return options, fmt.Errorf("retention interval must be a positive duration, got %q", settings.Interval)
+		}
+		options.Interval = interval
+	}
+
+	switch archive := settings.Archive; archive.Backend {
+	case "":
+	case ArchiveLocal:
+		local, err := NewDirArchive(archive.Path)
+		if err != nil {
+			return options, err
+		}
+		options.Archive = local
+	case ArchiveS3:
+		var timeout time.Duration
+		if archive.S3.Timeout != "" {
+			var err error
+			if timeout, err = time.ParseDuration(archive.S3.Timeout); err != nil || timeout <= 0 {
+				return options, fmt.Errorf("retention archive s3 timeout must be a positive duration, got %q", archive.S3.Timeout)
+			}
+		}
+		s3, err := NewS3Archive(S3ArchiveConfig{
+			Bucket:          archive.S3.Bucket,
+			Region:          archive.S3.Region,
+			AccessKeyID:     archive.S3.AccessKeyID,
+			SecretAccessKey: archive.S3.SecretAccessKey,
+			Endpoint:        archive.S3.Endpoint,
+			PathStyle:       archive.S3.PathStyle,
+			Prefix:          archive.S3.Prefix,
+			Timeout:         timeout,
+			Retries:         archive.S3.Retries,
+		})
+		if err != nil {
+			return options, fmt.Errorf("invalid retention archive: %w", err)
+		}
+		options.Archive = s3
+	default:
+		return options, fmt.Errorf("retention archive backend %q is unknown", archive.Backend)
+	}
+	return options, nil
+}
@@ -0,0 +1,65 @@
+package retention
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Path is the prefix the retention endpoints are mounted under
+const Path = "/admin/retention"
+
+// Permissions of the retention endpoints: reading the policies and reports, and running policies
+const (
+	ReadPermission  = "retention:read"
+	WritePermission = "retention:write"
+)
+
+// Register mounts the retention endpoints on the group:
+//
+//	GET  /     declared policies and the latest reports of the instance
+//	POST /run  applies the policies now, filtered by ?module= and ?policy=; ?dry_run=true only
+//	           reports what is due
+//
+// The group must authenticate its requests; read and write authorize them
+func Register(group *gin.RouterGroup, scheduler *Scheduler, read, write gin.HandlerFunc) {
+	group.GET("", read, func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"policies": scheduler.Policies(), "reports": scheduler.Reports()},
+		})
+	})
+
+	group.POST("/run", write, func(c *gin.Context) {
+		dryRun := false
+		if value := c.Query("dry_run"); value != "" {
+			var err error
+			if dryRun, err = strconv.ParseBool(value); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"success": false,
+					"error":   gin.H{"code": "VALIDATION_ERROR", "message": "dry_run must be true or false"},
+				})
+				return
+			}
+		}
+
+		reports, err := scheduler.Execute(c.Request.Context(), c.Query("module"), c.Query("policy"), dryRun)
+		if errors.Is(err, ErrUnknownPolicy) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   gin.H{"code": "NOT_FOUND", "message": err.Error()},
+			})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error":   gin.H{"code": "INTERNAL_ERROR", "message": err.Error()},
+			})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"success": true, "data": reports})
+	})
+}
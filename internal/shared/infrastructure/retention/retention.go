@@ -0,0 +1,95 @@
+// Package retention removes the records modules no longer need to keep: modules declare policies,
+// e.g. archiving delivered orders or purging deleted customers after a number of years, which a
+// scheduler applies in batches on one instance at a time, recording every removed record in the
+// audit log. Dry runs report what would be removed without changing anything.
+package retention
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrUnknownPolicy is returned for runs of policies no module declares
+var ErrUnknownPolicy = errors.New("unknown retention policy")
+
+// Policy removes the records of a module older than its retention period
+type Policy interface {
+	// Name identifies the policy within its module, e.g. delivered_orders
+	Name() string
+	// AggregateType is the type of the records the policy removes, e.g. Order, as audited
+	AggregateType() string
+	// Retention is how long records are kept before they are due
+	Retention() time.Duration
+	// Apply removes at most run.Limit of the records due at run.Cutoff, or only reports them on a
+	// dry run, and returns what it removed
+	Apply(ctx context.Context, run Run) (Outcome, error)
+}
+
+// Retained is implemented by modules declaring retention policies
+type Retained interface {
+	RetentionPolicies() []Policy
+}
+
+// Run is one application of a policy
+type Run struct {
+	Module string
+	Policy string
+	// Cutoff is the time records last changed before are due
+	Cutoff time.Time
+	// Limit is the number of records removed at most
+	Limit  int
+	DryRun bool
+
+	archive Archive
+}
+
+// Outcome is what an application of a policy removed, or would remove on a dry run
+type Outcome struct {
+	// Due is the number of records due, counted on dry runs only
+	Due int64
+	// IDs are the identifiers of the removed records, or of the first due ones on a dry run
+	IDs []string
+	// Destination is where the removed records were moved to, e.g. a cold table, empty when they
+	// were deleted
+	Destination string
+}
+
+// Archived reports whether the run stores removed records in an object archive
+func (r Run) Archived() bool {
+	return r.archive != nil
+}
+
+// Store writes records to the object archive as gzipped JSON lines, under a key named after the
+// module, the policy and the time, and returns where it stored them; without an archive it does
+// nothing and returns an empty location
+// Policies store the records before committing their removal, so that a failed upload keeps them
+func (r Run) Store(ctx context.Context, records []json.RawMessage) (string, error) {
+	if r.archive == nil || len(records) == 0 {
+		return "", nil
+	}
+
+	var buf, line bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	for _, record := range records {
+		line.Reset()
+		if err := json.Compact(&line, record); err != nil {
+			return "", fmt.Errorf("failed to encode archived record: %w", err)
+		}
+		line.WriteByte('\n')
+		if _, err := zw.Write(line.Bytes()); err != nil {
+			return "", fmt.Errorf("failed to encode archived record: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode archived records: %w", err)
+	}
+
+	now := time.Now().UTC()
+	key := fmt.Sprintf("%s/%s/%s/%s.ndjson.gz", r.Module, r.Policy, now.Format("2006/01/02"), now.Format("20060102T150405.000000000Z"))
+	return r.archive.Put(ctx, key, buf.Bytes())
+}
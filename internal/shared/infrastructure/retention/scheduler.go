@@ -0,0 +1,258 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/lock"
+	"golang_modular_monolith/internal/shared/infrastructure/worker"
+)
+
+// Defaults of the scheduler options
+const (
+	DefaultInterval  = 24 * time.Hour
+	DefaultBatchSize = 500
+)
+
+// maxReports is the number of reports a scheduler keeps
+const maxReports = 100
+
+// Options configures a scheduler
+type Options struct {
+	// Interval is how often every policy is applied, DefaultInterval when zero
+	Interval time.Duration
+	// BatchSize is the number of records removed per transaction, DefaultBatchSize when zero
+	BatchSize int
+	// DryRun makes scheduled runs report what is due without removing it
+	DryRun bool
+	// Archive keeps a copy of archived records, e.g. in S3, besides the cold tables of the modules
+	Archive Archive
+}
+
+// Report is the result of applying a policy
+type Report struct {
+	Module        string    `json:"module"`
+	Policy        string    `json:"policy"`
+	AggregateType string    `json:"aggregate_type"`
+	DryRun        bool      `json:"dry_run"`
+	Cutoff        time.Time `json:"cutoff"`
+	StartedAt     time.Time `json:"started_at"`
+	FinishedAt    time.Time `json:"finished_at"`
+	// Due is the number of records due on a dry run
+	Due int64 `json:"due,omitempty"`
+	// Removed is the number of records removed, all of them audited
+	Removed int `json:"removed"`
+	// IDs are the first removed records, or the first due ones on a dry run
+	IDs          []string `json:"ids,omitempty"`
+	Destinations []string `json:"destinations,omitempty"`
+	// Skipped is set when another instance was applying the policy
+	Skipped bool   `json:"skipped,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// PolicyInfo describes a declared policy
+type PolicyInfo struct {
+	Module        string `json:"module"`
+	Policy        string `json:"policy"`
+	AggregateType string `json:"aggregate_type"`
+	Retention     string `json:"retention"`
+}
+
+// Scheduler applies the policies of the modules every interval, one instance at a time
+type Scheduler struct {
+	policies map[string][]Policy
+	locker   *lock.Locker
+	audit    domain.AuditWriter
+	options  Options
+
+	mu      sync.Mutex
+	reports []Report // newest first
+}
+
+// NewScheduler creates a scheduler of the policies of each module
+func NewScheduler(policies map[string][]Policy, locker *lock.Locker, audit domain.AuditWriter, options Options) *Scheduler {
+	if options.Interval <= 0 {
+		options.Interval = DefaultInterval
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = DefaultBatchSize
+	}
+	return &Scheduler{policies: policies, locker: locker, audit: audit, options: options}
+}
+
+// Policies describes the declared policies, by module and name
+func (s *Scheduler) Policies() []PolicyInfo {
+	var infos []PolicyInfo
+	for _, module := range s.modules() {
+		for _, policy := range s.policies[module] {
+			infos = append(infos, PolicyInfo{
+				Module:        module,
+				Policy:        policy.Name(),
+				AggregateType: policy.AggregateType(),
+				Retention:     policy.Retention().String(),
+			})
+		}
+	}
+	return infos
+}
+
+// Reports returns the latest reports of the instance, newest first
+func (s *Scheduler) Reports() []Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Report(nil), s.reports...)
+}
+
+// Start starts a worker applying every policy every interval, the first time right away
+func (s *Scheduler) Start(workers *worker.Manager) error {
+	return workers.Go("retention", func(ctx context.Context) error {
+		ticker := time.NewTicker(s.options.Interval)
+		defer ticker.Stop()
+
+		for {
+			// Removals are attributed to the system in the audit log
+			if _, err := s.Execute(domain.WithActor(ctx, domain.SystemActor()), "", "", s.options.DryRun); err != nil {
+				return err
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// Execute applies the policies of a module, of every module when it is empty, or only the named
+// policy, and returns their reports
+// Failures of a policy are reported rather than returned, so that the others still apply
+func (s *Scheduler) Execute(ctx context.Context, module, policy string, dryRun bool) ([]Report, error) {
+	var reports []Report
+	for _, name := range s.modules() {
+		if module != "" && name != module {
+			continue
+		}
+		for _, p := range s.policies[name] {
+			if policy != "" && p.Name() != policy {
+				continue
+			}
+			report := s.apply(ctx, name, p, dryRun)
+			if ctx.Err() != nil {
+				return reports, nil
+			}
+			reports = append(reports, report)
+		}
+	}
+	if len(reports) == 0 && (module != "" || policy != "") {
+		return nil, fmt.Errorf("%w: %s %s", ErrUnknownPolicy, module, policy)
+	}
+	return reports, nil
+}
+
+// apply applies a policy under its lock, batch after batch until fewer records than the batch size
+// are removed, and records its report
+func (s *Scheduler) apply(ctx context.Context, module string, policy Policy, dryRun bool) Report {
+	started := time.Now().UTC()
+	report := Report{
+		Module:        module,
+		Policy:        policy.Name(),
+		AggregateType: policy.AggregateType(),
+		DryRun:        dryRun,
+		Cutoff:        started.Add(-policy.Retention()),
+		StartedAt:     started,
+	}
+	run := Run{
+		Module:  module,
+		Policy:  policy.Name(),
+		Cutoff:  report.Cutoff,
+		Limit:   s.options.BatchSize,
+		DryRun:  dryRun,
+		archive: s.options.Archive,
+	}
+
+	ran, err := s.locker.TryWithLock(ctx, "retention_"+module+"_"+policy.Name(), func(ctx context.Context) error {
+		for {
+			outcome, err := policy.Apply(ctx, run)
+			if err != nil {
+				return err
+			}
+			report.Due += outcome.Due
+			if len(report.IDs) < run.Limit {
+				report.IDs = append(report.IDs, outcome.IDs[:min(len(outcome.IDs), run.Limit-len(report.IDs))]...)
+			}
+			if dryRun {
+				return nil
+			}
+
+			report.Removed += len(outcome.IDs)
+			if outcome.Destination != "" {
+				report.Destinations = append(report.Destinations, outcome.Destination)
+			}
+			s.record(ctx, module, policy, report.Cutoff, outcome)
+
+			if len(outcome.IDs) < run.Limit || ctx.Err() != nil {
+				return nil
+			}
+		}
+	})
+	report.Skipped = !ran && err == nil
+	if err != nil && !errors.Is(err, context.Canceled) {
+		report.Error = err.Error()
+		zap.L().Error("retention policy failed", zap.String("module", module), zap.String("policy", policy.Name()), zap.Error(err))
+	}
+	report.FinishedAt = time.Now().UTC()
+
+	if report.Removed > 0 || report.Due > 0 {
+		zap.L().Info("retention policy applied",
+			zap.String("module", module),
+			zap.String("policy", policy.Name()),
+			zap.Bool("dry_run", dryRun),
+			zap.Int64("due", report.Due),
+			zap.Int("removed", report.Removed),
+		)
+	}
+
+	s.mu.Lock()
+	s.reports = append([]Report{report}, s.reports...)
+	if len(s.reports) > maxReports {
+		s.reports = s.reports[:maxReports]
+	}
+	s.mu.Unlock()
+	return report
+}
+
+// record writes an audit entry for each removed record
+func (s *Scheduler) record(ctx context.Context, module string, policy Policy, cutoff time.Time, outcome Outcome) {
+	detail := fmt.Sprintf("removed by retention policy %s: unchanged since before %s", policy.Name(), cutoff.Format(time.RFC3339))
+	if outcome.Destination != "" {
+		detail += ", archived to " + outcome.Destination
+	}
+	for _, id := range outcome.IDs {
+		entry := domain.NewAuditEntry(ctx, "retention."+policy.Name())
+		entry.Module = module
+		entry.AggregateType = policy.AggregateType()
+		entry.AggregateID = id
+		entry.Detail = detail
+		if err := s.audit.Write(ctx, entry); err != nil {
+			zap.L().Warn("failed to write audit entry", zap.String("action", entry.Action), zap.String("aggregate_id", id), zap.Error(err))
+		}
+	}
+}
+
+// modules returns the names of the modules declaring policies, sorted
+func (s *Scheduler) modules() []string {
+	modules := make([]string, 0, len(s.policies))
+	for module := range s.policies {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+	return modules
+}
@@ -0,0 +1,46 @@
+package search
+
+import (
+	"fmt"
+
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+)
+
+// InitializeWithConfig creates the engine of global.search and sets it as the engine of the
+// modules; it returns nil, and modules keep searching their databases, when no backend is set
+func InitializeWithConfig(cfg *config.Config) (Engine, error) {
+	var settings config.SearchGlobalConfig
+	if cfg.Modules != nil {
+		settings = cfg.Modules.Global.Search
+	}
+
+	switch settings.Backend {
+	case "":
+		SetEngine(nil)
+		return nil, nil
+	case BackendElasticsearch, BackendOpenSearch:
+		timeout, err := settings.GetTimeout()
+		if err != nil {
+			return nil, err
+		}
+		url := settings.URL
+		if url == "" {
+			url = "http://localhost:9200"
+		}
+		engine, err := NewElasticsearch(ElasticsearchConfig{
+			URL:         url,
+			Username:    settings.Username,
+			Password:    settings.Password,
+			IndexPrefix: settings.IndexPrefix,
+			Timeout:     timeout,
+			Retries:     settings.Retries,
+		})
+		if err != nil {
+			return nil, err
+		}
+		SetEngine(engine)
+		return engine, nil
+	default:
+		return nil, fmt.Errorf("search backend %q is unknown", settings.Backend)
+	}
+}
@@ -0,0 +1,217 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang_modular_monolith/internal/shared/infrastructure/resilience"
+)
+
+// Backend names; OpenSearch answers the requests of the engine like Elasticsearch does
+const (
+	BackendElasticsearch = "elasticsearch"
+	BackendOpenSearch    = "opensearch"
+)
+
+// ElasticsearchConfig configures the connection to an Elasticsearch or OpenSearch cluster
+type ElasticsearchConfig struct {
+	URL         string
+	Username    string
+	Password    string
+	IndexPrefix string
+	Timeout     time.Duration
+	Retries     int
+	Breaker     resilience.BreakerSettings
+}
+
+// Elasticsearch is an engine calling the REST API of Elasticsearch or OpenSearch
+type Elasticsearch struct {
+	config   ElasticsearchConfig
+	endpoint *url.URL
+	client   *http.Client
+	policy   resilience.Policy
+}
+
+// NewElasticsearch creates an engine of the cluster at config.URL
+func NewElasticsearch(config ElasticsearchConfig) (*Elasticsearch, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(config.URL, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid search url %q", config.URL)
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 5 * time.Second
+	}
+
+	return &Elasticsearch{
+		config:   config,
+		endpoint: endpoint,
+		client:   &http.Client{},
+		policy: resilience.Policy{
+			Timeout: config.Timeout,
+			Retries: config.Retries,
+			Breaker: resilience.NewBreaker("search", config.Breaker),
+		},
+	}, nil
+}
+
+// Register creates the index when it does not exist
+func (e *Elasticsearch) Register(ctx context.Context, index Index) (bool, error) {
+	name := e.indexName(index.Name)
+	status, err := e.do(ctx, http.MethodHead, "/"+name, nil, nil, http.StatusNotFound)
+	if err != nil {
+		return false, fmt.Errorf("failed to look up index %s: %w", name, err)
+	}
+	if status != http.StatusNotFound {
+		return false, nil
+	}
+
+	body := map[string]any{}
+	if index.Settings != nil {
+		body["settings"] = index.Settings
+	}
+	if index.Mappings != nil {
+		body["mappings"] = index.Mappings
+	}
+	var failure struct {
+		Error struct {
+			Type string `json:"type"`
+		} `json:"error"`
+	}
+	status, err = e.do(ctx, http.MethodPut, "/"+name, body, &failure, http.StatusBadRequest)
+	if err != nil {
+		return false, fmt.Errorf("failed to create index %s: %w", name, err)
+	}
+	if status == http.StatusBadRequest {
+		// Another instance created it in the meantime
+		if failure.Error.Type == "resource_already_exists_exception" {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to create index %s: %s", name, failure.Error.Type)
+	}
+	return true, nil
+}
+
+// Put indexes the document under id
+func (e *Elasticsearch) Put(ctx context.Context, index, id string, document any) error {
+	path := "/" + e.indexName(index) + "/_doc/" + url.PathEscape(id)
+	if _, err := e.do(ctx, http.MethodPut, path, document, nil); err != nil {
+		return fmt.Errorf("failed to index %s in %s: %w", id, index, err)
+	}
+	return nil
+}
+
+// Delete removes the document stored under id
+func (e *Elasticsearch) Delete(ctx context.Context, index, id string) error {
+	path := "/" + e.indexName(index) + "/_doc/" + url.PathEscape(id)
+	if _, err := e.do(ctx, http.MethodDelete, path, nil, nil, http.StatusNotFound); err != nil {
+		return fmt.Errorf("failed to remove %s from %s: %w", id, index, err)
+	}
+	return nil
+}
+
+// Search returns the IDs of the documents matching the request
+func (e *Elasticsearch) Search(ctx context.Context, index string, request Request) (*Result, error) {
+	body := map[string]any{
+		"_source":          false,
+		"track_total_hits": true,
+		"from":             request.From,
+		"size":             request.Size,
+	}
+	if request.Query != nil {
+		body["query"] = request.Query
+	}
+	if len(request.Sort) > 0 {
+		body["sort"] = request.Sort
+	}
+
+	var response struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				ID string `json:"_id"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if _, err := e.do(ctx, http.MethodPost, "/"+e.indexName(index)+"/_search", body, &response); err != nil {
+		return nil, fmt.Errorf("failed to search %s: %w", index, err)
+	}
+
+	result := &Result{Total: response.Hits.Total.Value, IDs: make([]string, len(response.Hits.Hits))}
+	for i, hit := range response.Hits.Hits {
+		result.IDs[i] = hit.ID
+	}
+	return result, nil
+}
+
+// Ping verifies the cluster answers
+func (e *Elasticsearch) Ping(ctx context.Context) error {
+	_, err := e.do(ctx, http.MethodGet, "/", nil, nil)
+	return err
+}
+
+// indexName prefixes the name of an index
+func (e *Elasticsearch) indexName(name string) string {
+	return e.config.IndexPrefix + name
+}
+
+// do sends a request with a JSON body and decodes the JSON response into out; statuses besides 2xx
+// and the accepted ones fail, 4xx permanently
+func (e *Elasticsearch) do(ctx context.Context, method, path string, body, out any, accepted ...int) (int, error) {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return 0, fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	var status int
+	err := e.policy.Do(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, method, e.endpoint.String()+path, bytes.NewReader(payload))
+		if err != nil {
+			return resilience.Permanent(err)
+		}
+		if payload != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if e.config.Username != "" {
+			req.SetBasicAuth(e.config.Username, e.config.Password)
+		}
+
+		resp, err := e.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		status = resp.StatusCode
+		accept := status/100 == 2
+		for _, code := range accepted {
+			accept = accept || status == code
+		}
+		if !accept {
+			message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+			err := fmt.Errorf("search returned status %d: %s", status, strings.TrimSpace(string(message)))
+			if status < 500 && status != http.StatusTooManyRequests {
+				return resilience.Permanent(err)
+			}
+			return err
+		}
+		if out != nil && method != http.MethodHead {
+			if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+				return resilience.Permanent(fmt.Errorf("failed to decode response: %w", err))
+			}
+		}
+		return nil
+	})
+	return status, err
+}
@@ -0,0 +1,49 @@
+package search
+
+import (
+	"context"
+	"slices"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Loader returns the document of the record with the given ID; found is false once the record is
+// gone, which removes its document from the index
+type Loader func(ctx context.Context, id string) (document any, found bool, err error)
+
+// Projection keeps the documents of an index in sync with the events of their aggregates, loading
+// the document of the aggregate of each event
+// Subscribe it after the projections updating the records it loads, which the event bus runs first
+type Projection struct {
+	engine     Engine
+	index      string
+	load       Loader
+	eventTypes []string
+}
+
+// NewProjection creates a projection of the events of the given types into the index
+func NewProjection(engine Engine, index string, load Loader, eventTypes ...string) *Projection {
+	return &Projection{engine: engine, index: index, load: load, eventTypes: eventTypes}
+}
+
+// CanHandle reports whether the event type changes documents of the index
+func (p *Projection) CanHandle(eventType string) bool {
+	return slices.Contains(p.eventTypes, eventType)
+}
+
+// Handle indexes the document of the event's aggregate
+func (p *Projection) Handle(event domain.DomainEvent) error {
+	return p.Sync(domain.WithEventActor(context.Background(), event), event.GetAggregateID())
+}
+
+// Sync indexes the document of the record with the given ID, or removes it once the record is gone
+func (p *Projection) Sync(ctx context.Context, id string) error {
+	document, found, err := p.load(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return p.engine.Delete(ctx, p.index, id)
+	}
+	return p.engine.Put(ctx, p.index, id, document)
+}
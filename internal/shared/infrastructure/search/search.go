@@ -0,0 +1,73 @@
+// Package search indexes the read models of modules in Elasticsearch or OpenSearch. Modules register
+// the mappings of their indexes, keep the documents in sync with a projection of their domain events
+// and search them for IDs, loading the matching records from their own database.
+package search
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrDisabled is returned by GetEngine when no search backend is configured
+var ErrDisabled = errors.New("search is disabled")
+
+// Index is the definition of an index: its name, without the configured prefix, and the body of
+// its settings and mappings as the search engine expects them
+type Index struct {
+	Name     string
+	Settings map[string]any
+	Mappings map[string]any
+}
+
+// Request is a search of an index in the query DSL shared by Elasticsearch and OpenSearch
+type Request struct {
+	Query map[string]any
+	Sort  []map[string]any
+	From  int
+	Size  int
+}
+
+// Result is the IDs of the documents matching a search, in order, and the number of matches
+type Result struct {
+	IDs   []string
+	Total int64
+}
+
+// Engine stores and searches the documents of indexes
+type Engine interface {
+	// Register creates the index when it does not exist, and reports whether it did; a created
+	// index is empty until the module indexes its records
+	Register(ctx context.Context, index Index) (created bool, err error)
+	// Put indexes the document under id, replacing the previous one
+	Put(ctx context.Context, index, id string, document any) error
+	// Delete removes the document stored under id, if any
+	Delete(ctx context.Context, index, id string) error
+	// Search returns the documents of the index matching the request
+	Search(ctx context.Context, index string, request Request) (*Result, error)
+	// Ping verifies the engine is reachable
+	Ping(ctx context.Context) error
+}
+
+var (
+	globalMu     sync.RWMutex
+	globalEngine Engine
+)
+
+// SetEngine sets the engine modules index their read models in
+func SetEngine(engine Engine) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	globalEngine = engine
+}
+
+// GetEngine returns the engine modules index their read models in, or ErrDisabled when search
+// is not configured and modules answer searches from their databases
+func GetEngine() (Engine, error) {
+	globalMu.RLock()
+	defer globalMu.RUnlock()
+	if globalEngine == nil {
+		return nil, ErrDisabled
+	}
+	return globalEngine, nil
+}
@@ -0,0 +1,136 @@
+// Package status serves the runtime status of the service to operators in one document: the build,
+// uptime and Go runtime, the modules and their failures, the database pools and the load of the
+// event bus.
+package status
+
+import (
+	"database/sql"
+	"net/http"
+	"runtime"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/buildinfo"
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+	"golang_modular_monolith/internal/shared/infrastructure/health"
+)
+
+// Path is where the status is served
+const Path = "/admin/status"
+
+// Permission is required to read the status
+const Permission = "status:read"
+
+// Sources are the components the status is read from; nil components are left out
+type Sources struct {
+	Service     string
+	Environment string
+	Modules     *domain.ModuleRegistry
+	Config      *config.ModulesConfig
+	// Databases returns the pool statistics of the open databases
+	Databases func() map[string]sql.DBStats
+	// Subscribers returns the number of handlers subscribed to the event bus
+	Subscribers func() int
+	// StreamClients and StreamQueued return the clients of the event stream and the messages
+	// queued for them
+	StreamClients func() int
+	StreamQueued  func() int
+}
+
+// Module is the status of an enabled module
+type Module struct {
+	Name          string                   `json:"name"`
+	Version       string                   `json:"version,omitempty"`
+	EventHandlers *domain.EventHandlerLoad `json:"event_handlers,omitempty"`
+}
+
+// Pool is the state of a database connection pool
+type Pool struct {
+	MaxOpen        int     `json:"max_open"`
+	Open           int     `json:"open"`
+	InUse          int     `json:"in_use"`
+	Idle           int     `json:"idle"`
+	WaitCount      int64   `json:"wait_count"`
+	WaitDurationMs float64 `json:"wait_duration_ms"`
+}
+
+// Handler serves the status
+// Reading the memory statistics briefly stops the world, which is negligible for occasional requests
+func Handler(sources Sources) gin.HandlerFunc {
+	started := time.Now()
+	return func(c *gin.Context) {
+		var mem runtime.MemStats
+		runtime.ReadMemStats(&mem)
+
+		response := gin.H{
+			"service":        sources.Service,
+			"environment":    sources.Environment,
+			"build":          buildinfo.Get(),
+			"started_at":     started.UTC(),
+			"uptime_seconds": int64(time.Since(started).Seconds()),
+			"runtime": gin.H{
+				"goroutines":       runtime.NumGoroutine(),
+				"gomaxprocs":       runtime.GOMAXPROCS(0),
+				"num_cpu":          runtime.NumCPU(),
+				"heap_alloc_bytes": mem.HeapAlloc,
+				"sys_bytes":        mem.Sys,
+				"num_gc":           mem.NumGC,
+				"pause_total_ns":   mem.PauseTotalNs,
+			},
+		}
+
+		if sources.Modules != nil {
+			response["modules"] = modules(sources)
+			response["failures"] = health.ModuleFailures(sources.Modules)
+		}
+		if sources.Databases != nil {
+			pools := make(map[string]Pool)
+			for name, stats := range sources.Databases() {
+				pools[name] = Pool{
+					MaxOpen:        stats.MaxOpenConnections,
+					Open:           stats.OpenConnections,
+					InUse:          stats.InUse,
+					Idle:           stats.Idle,
+					WaitCount:      stats.WaitCount,
+					WaitDurationMs: float64(stats.WaitDuration.Microseconds()) / 1000,
+				}
+			}
+			response["databases"] = pools
+		}
+
+		eventBus := gin.H{}
+		if sources.Subscribers != nil {
+			eventBus["subscribers"] = sources.Subscribers()
+		}
+		if sources.StreamClients != nil {
+			eventBus["stream_clients"] = sources.StreamClients()
+		}
+		if sources.StreamQueued != nil {
+			eventBus["stream_queued"] = sources.StreamQueued()
+		}
+		response["event_bus"] = eventBus
+
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// modules lists the enabled modules with their version and event handler load
+func modules(sources Sources) []Module {
+	load := sources.Modules.EventHandlerLoad()
+	names := sources.Modules.GetModuleNames()
+
+	list := make([]Module, 0, len(names))
+	for _, name := range names {
+		module := Module{Name: name}
+		if sources.Config != nil {
+			module.Version = sources.Config.Modules[name].Module.Version
+		}
+		if handlers, ok := load[name]; ok {
+			module.EventHandlers = &handlers
+		}
+		list = append(list, module)
+	}
+	return list
+}
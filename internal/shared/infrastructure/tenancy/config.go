@@ -0,0 +1,64 @@
+package tenancy
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+)
+
+// Tenancy is the tenant registry and resolvers of global.tenancy
+type Tenancy struct {
+	Registry  Registry
+	Resolvers []Resolver
+}
+
+// Middleware returns the tenant middleware of a module's routes
+func (t *Tenancy) Middleware(module string) gin.HandlerFunc {
+	return Middleware(t.Registry, t.Resolvers, module)
+}
+
+// InitializeWithConfig creates the tenant registry and resolvers of global.tenancy, reading tenant
+// claims from the access tokens of tokens; it returns nil when multi-tenancy is disabled
+func InitializeWithConfig(cfg *config.Config, tokens *auth.TokenService) (*Tenancy, error) {
+	if cfg.Modules == nil || !cfg.Modules.Global.Tenancy.Enabled {
+		return nil, nil
+	}
+	settings := cfg.Modules.Global.Tenancy
+
+	registry, err := NewStaticRegistry(tenantsOf(settings.Tenants))
+	if err != nil {
+		return nil, fmt.Errorf("invalid global.tenancy.tenants: %w", err)
+	}
+
+	names := settings.Resolvers
+	if len(names) == 0 {
+		names = []string{ResolverHeader, ResolverQuery, ResolverSubdomain, ResolverClaim}
+	}
+	resolvers := make([]Resolver, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case ResolverHeader:
+			header := settings.Header
+			if header == "" {
+				header = DefaultHeader
+			}
+			resolvers = append(resolvers, HeaderResolver(header))
+		case ResolverSubdomain:
+			// Without a base domain no host names a tenant
+			if settings.BaseDomain != "" {
+				resolvers = append(resolvers, SubdomainResolver(settings.BaseDomain))
+			}
+		case ResolverQuery:
+			resolvers = append(resolvers, QueryResolver(QueryParam))
+		case ResolverClaim:
+			resolvers = append(resolvers, ClaimResolver(tokens))
+		default:
+			return nil, fmt.Errorf("unknown tenant resolver %q, expected header, query, subdomain or claim", name)
+		}
+	}
+
+	return &Tenancy{Registry: registry, Resolvers: resolvers}, nil
+}
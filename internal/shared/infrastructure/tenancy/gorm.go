@@ -0,0 +1,203 @@
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// TenantField is the field of tenant-aware models, stored in their tenant_id column
+const TenantField = "TenantID"
+
+// ErrCrossTenantWrite is returned when a statement writes a record of a tenant other than the tenant
+// of its context
+var ErrCrossTenantWrite = errors.New("record belongs to another tenant")
+
+// Plugin scopes the statements on tenant-aware models, those with a TenantID field, to the tenant of
+// their context: records created or saved are stamped with it, and queries, updates and deletes only
+// see its records. Statements whose context serves no tenant are left as they are, and raw SQL must
+// filter on tenant_id itself
+type Plugin struct{}
+
+// NewPlugin creates the plugin
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+// Name returns the name of the plugin
+func (p *Plugin) Name() string {
+	return "tenancy"
+}
+
+// Initialize registers the callbacks scoping GORM's operations
+func (p *Plugin) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	return errors.Join(
+		callbacks.Create().Before("gorm:create").Register("tenancy:create", p.create),
+		callbacks.Query().Before("gorm:query").Register("tenancy:query", p.scope),
+		callbacks.Update().Before("gorm:update").Register("tenancy:update", p.update),
+		callbacks.Delete().Before("gorm:delete").Register("tenancy:delete", p.delete),
+		callbacks.Row().Before("gorm:row").Register("tenancy:row", p.scope),
+	)
+}
+
+// tenantOf returns the tenant of the statement's context and the tenant field of its model
+func (p *Plugin) tenantOf(db *gorm.DB) (string, *schema.Field, bool) {
+	field := p.tenantField(db)
+	if field == nil {
+		return "", nil, false
+	}
+	tenantID, ok := domain.TenantIDFromContext(db.Statement.Context)
+	return tenantID, field, ok
+}
+
+// tenantField returns the tenant field of the statement's model, nil when it is not tenant-aware
+func (p *Plugin) tenantField(db *gorm.DB) *schema.Field {
+	if db.Error != nil || db.Statement.Schema == nil || db.Statement.Context == nil {
+		return nil
+	}
+	return db.Statement.Schema.LookUpField(TenantField)
+}
+
+// create stamps the created records with the tenant, and keeps upserts from updating the records of
+// other tenants
+func (p *Plugin) create(db *gorm.DB) {
+	tenantID, field, ok := p.tenantOf(db)
+	if !ok {
+		return
+	}
+	if err := p.stamp(db, tenantID, field); err != nil {
+		_ = db.AddError(err)
+		return
+	}
+
+	if c, exists := db.Statement.Clauses["ON CONFLICT"]; exists {
+		if onConflict, ok := c.Expression.(clause.OnConflict); ok && !onConflict.DoNothing {
+			onConflict.Where.Exprs = append(onConflict.Where.Exprs, clause.Eq{
+				Column: clause.Column{Table: db.Statement.Table, Name: field.DBName},
+				Value:  tenantID,
+			})
+			db.Statement.AddClause(onConflict)
+		}
+	}
+}
+
+// update stamps saved records with the tenant and scopes the update to its records
+// Records saved outside a tenant, e.g. by background jobs, keep the tenant they belong to
+func (p *Plugin) update(db *gorm.DB) {
+	tenantID, field, ok := p.tenantOf(db)
+	if field == nil {
+		return
+	}
+	if !ok {
+		if value := db.Statement.ReflectValue; value.Kind() == reflect.Struct {
+			if _, isZero := field.ValueOf(db.Statement.Context, value); isZero {
+				db.Statement.Omits = append(db.Statement.Omits, field.DBName)
+			}
+		}
+		return
+	}
+	if err := p.stamp(db, tenantID, field); err != nil {
+		_ = db.AddError(err)
+		return
+	}
+	if !p.global(db) {
+		p.where(db, tenantID, field)
+	}
+}
+
+// delete scopes the delete to the records of the tenant
+func (p *Plugin) delete(db *gorm.DB) {
+	tenantID, field, ok := p.tenantOf(db)
+	if !ok || p.global(db) {
+		return
+	}
+	p.where(db, tenantID, field)
+}
+
+// scope limits the query to the records of the tenant
+func (p *Plugin) scope(db *gorm.DB) {
+	tenantID, field, ok := p.tenantOf(db)
+	if !ok {
+		return
+	}
+	p.where(db, tenantID, field)
+}
+
+// global checks if an update or delete has no conditions, neither its own nor the primary keys of its
+// records; GORM refuses those, and the tenant condition must not make them legal
+func (p *Plugin) global(db *gorm.DB) bool {
+	if _, exists := db.Statement.Clauses["WHERE"]; exists || db.AllowGlobalUpdate {
+		return false
+	}
+	value := db.Statement.ReflectValue
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		return value.Len() == 0
+	case reflect.Struct:
+		for _, primaryField := range db.Statement.Schema.PrimaryFields {
+			if _, isZero := primaryField.ValueOf(db.Statement.Context, value); !isZero {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// where adds the tenant condition to the statement
+func (p *Plugin) where(db *gorm.DB, tenantID string, field *schema.Field) {
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: tenantID},
+	}})
+}
+
+// stamp sets the tenant field of the statement's records, refusing records of other tenants
+func (p *Plugin) stamp(db *gorm.DB, tenantID string, field *schema.Field) error {
+	value := db.Statement.ReflectValue
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := p.stampRecord(db, reflect.Indirect(value.Index(i)), tenantID, field); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		return p.stampRecord(db, value, tenantID, field)
+	}
+	// Updates with a map of columns only change the columns listed
+	return nil
+}
+
+// stampRecord sets the tenant field of a record
+func (p *Plugin) stampRecord(db *gorm.DB, record reflect.Value, tenantID string, field *schema.Field) error {
+	if record.Kind() != reflect.Struct || !record.CanAddr() {
+		return nil
+	}
+	current, isZero := field.ValueOf(db.Statement.Context, record)
+	if isZero {
+		return field.Set(db.Statement.Context, record, tenantID)
+	}
+	if current != tenantID {
+		return fmt.Errorf("%w: %s", ErrCrossTenantWrite, current)
+	}
+	return nil
+}
+
+// Scope limits a query to the records of the tenant of ctx through the tenant column given, e.g. of a
+// joined table the plugin does not see; it leaves the query unchanged when ctx serves no tenant
+func Scope(ctx context.Context, column string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		tenantID, ok := domain.TenantIDFromContext(ctx)
+		if !ok {
+			return db
+		}
+		return db.Where(clause.Eq{Column: clause.Column{Name: column}, Value: tenantID})
+	}
+}
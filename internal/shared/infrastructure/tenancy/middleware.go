@@ -0,0 +1,77 @@
+package tenancy
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Middleware resolves the tenant of the requests to a module's routes and serves them in its context
+// Every resolver naming a tenant must name the same one, so that e.g. the token of one tenant cannot
+// be used on the subdomain of another; requests naming no tenant, an unknown or disabled tenant, or a
+// tenant not using the module are refused
+func Middleware(registry Registry, resolvers []Resolver, module string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var tenantID string
+		for _, resolve := range resolvers {
+			id, err := resolve(c, registry)
+			if err != nil {
+				abortTenantError(c, err)
+				return
+			}
+			if id == "" {
+				continue
+			}
+			if tenantID != "" && id != tenantID {
+				abort(c, http.StatusForbidden, "TENANT_MISMATCH", "The request names different tenants")
+				return
+			}
+			tenantID = id
+		}
+		if tenantID == "" {
+			abort(c, http.StatusBadRequest, "TENANT_REQUIRED", "The request must name a tenant")
+			return
+		}
+
+		tenant, err := registry.Get(c.Request.Context(), tenantID)
+		if err != nil {
+			abortTenantError(c, err)
+			return
+		}
+		if tenant.Disabled {
+			abort(c, http.StatusForbidden, "TENANT_DISABLED", "The tenant is disabled")
+			return
+		}
+		if !tenant.UsesModule(module) {
+			abort(c, http.StatusForbidden, "MODULE_NOT_ENABLED", fmt.Sprintf("The %s module is not enabled for the tenant", module))
+			return
+		}
+
+		c.Request = c.Request.WithContext(WithTenant(c.Request.Context(), tenant))
+		c.Next()
+	}
+}
+
+// abortTenantError answers the error of a registry lookup
+func abortTenantError(c *gin.Context, err error) {
+	if errors.Is(err, ErrTenantNotFound) {
+		abort(c, http.StatusNotFound, "TENANT_NOT_FOUND", "The tenant does not exist")
+		return
+	}
+	zap.L().Error("failed to resolve tenant", zap.Error(err))
+	abort(c, http.StatusInternalServerError, "INTERNAL_ERROR", "An internal error occurred")
+}
+
+// abort writes an error response in the API's error format
+func abort(c *gin.Context, status int, code, message string) {
+	c.AbortWithStatusJSON(status, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
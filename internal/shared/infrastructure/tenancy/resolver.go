@@ -0,0 +1,78 @@
+package tenancy
+
+import (
+	"net"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+)
+
+// Resolver names
+const (
+	ResolverHeader    = "header"
+	ResolverSubdomain = "subdomain"
+	ResolverClaim     = "claim"
+	ResolverQuery     = "query"
+)
+
+// DefaultHeader is the header naming the tenant of a request
+const DefaultHeader = "X-Tenant-ID"
+
+// QueryParam is the query parameter naming the tenant of a request, e.g. of a signed link followed
+// without headers
+const QueryParam = "tenant"
+
+// Resolver returns the ID of the tenant a request names, or "" when it names none
+type Resolver func(c *gin.Context, registry Registry) (string, error)
+
+// HeaderResolver names the tenant of the header
+func HeaderResolver(header string) Resolver {
+	return func(c *gin.Context, _ Registry) (string, error) {
+		return strings.TrimSpace(c.GetHeader(header)), nil
+	}
+}
+
+// QueryResolver names the tenant of the query parameter
+func QueryResolver(param string) Resolver {
+	return func(c *gin.Context, _ Registry) (string, error) {
+		return strings.TrimSpace(c.Query(param)), nil
+	}
+}
+
+// SubdomainResolver names the tenant served under the subdomain of the request's host, e.g. acme
+// for acme.example.com with the base domain example.com; hosts outside the base domain and nested
+// subdomains name no tenant
+func SubdomainResolver(baseDomain string) Resolver {
+	suffix := "." + strings.ToLower(strings.Trim(baseDomain, "."))
+	return func(c *gin.Context, registry Registry) (string, error) {
+		host := strings.ToLower(c.Request.Host)
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		subdomain, ok := strings.CutSuffix(host, suffix)
+		if !ok || subdomain == "" || strings.Contains(subdomain, ".") {
+			return "", nil
+		}
+
+		tenant, err := registry.GetBySubdomain(c.Request.Context(), subdomain)
+		if err != nil {
+			return "", err
+		}
+		return tenant.ID, nil
+	}
+}
+
+// ClaimResolver names the tenant the request's credentials belong to: the tenant claim of its access
+// token, or the tenant its API key was created in or its session started in; requests without valid
+// credentials are left for the authentication of their route to refuse
+func ClaimResolver(tokens *auth.TokenService) Resolver {
+	return func(c *gin.Context, _ Registry) (string, error) {
+		principal, ok := auth.RequestPrincipal(c, tokens)
+		if !ok {
+			return "", nil
+		}
+		return principal.TenantID, nil
+	}
+}
@@ -0,0 +1,127 @@
+// Package tenancy serves several tenants from one deployment. The tenant middleware resolves the
+// tenant of each request from its header, subdomain or access token, checks it against the tenant
+// registry and puts it on the request context, where the GORM plugin of this package scopes the
+// queries of tenant-aware models to it.
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+)
+
+// ErrTenantNotFound is returned by registries for unknown tenants
+var ErrTenantNotFound = errors.New("tenant not found")
+
+// Tenant is a customer organisation served by the deployment
+// Modules lists the modules the tenant may use, all of them when empty, and Features the feature
+// flags its requests override
+type Tenant struct {
+	ID        string
+	Name      string
+	Subdomain string
+	Disabled  bool
+	Modules   []string
+	Features  map[string]bool
+}
+
+// UsesModule checks if the tenant may use the module
+func (t *Tenant) UsesModule(module string) bool {
+	return len(t.Modules) == 0 || slices.Contains(t.Modules, module)
+}
+
+// Registry looks tenants up by ID and by subdomain
+type Registry interface {
+	// Get returns the tenant of an ID, or ErrTenantNotFound
+	Get(ctx context.Context, id string) (*Tenant, error)
+
+	// GetBySubdomain returns the tenant served under a subdomain, or ErrTenantNotFound
+	GetBySubdomain(ctx context.Context, subdomain string) (*Tenant, error)
+}
+
+// StaticRegistry is a registry of the tenants listed in configuration
+type StaticRegistry struct {
+	byID        map[string]*Tenant
+	bySubdomain map[string]*Tenant
+}
+
+// NewStaticRegistry creates a registry of tenants, whose IDs and subdomains must be unique
+func NewStaticRegistry(tenants []Tenant) (*StaticRegistry, error) {
+	r := &StaticRegistry{
+		byID:        make(map[string]*Tenant, len(tenants)),
+		bySubdomain: make(map[string]*Tenant, len(tenants)),
+	}
+	for i := range tenants {
+		tenant := tenants[i]
+		if tenant.ID == "" {
+			return nil, fmt.Errorf("tenant %d has no id", i)
+		}
+		if tenant.Subdomain == "" {
+			tenant.Subdomain = tenant.ID
+		}
+		tenant.Subdomain = strings.ToLower(tenant.Subdomain)
+		if _, exists := r.byID[tenant.ID]; exists {
+			return nil, fmt.Errorf("tenant %s is listed twice", tenant.ID)
+		}
+		if other, exists := r.bySubdomain[tenant.Subdomain]; exists {
+			return nil, fmt.Errorf("tenants %s and %s share the subdomain %s", other.ID, tenant.ID, tenant.Subdomain)
+		}
+		r.byID[tenant.ID] = &tenant
+		r.bySubdomain[tenant.Subdomain] = &tenant
+	}
+	return r, nil
+}
+
+// Get implements Registry
+func (r *StaticRegistry) Get(_ context.Context, id string) (*Tenant, error) {
+	if tenant, ok := r.byID[id]; ok {
+		return tenant, nil
+	}
+	return nil, ErrTenantNotFound
+}
+
+// GetBySubdomain implements Registry
+func (r *StaticRegistry) GetBySubdomain(_ context.Context, subdomain string) (*Tenant, error) {
+	if tenant, ok := r.bySubdomain[strings.ToLower(subdomain)]; ok {
+		return tenant, nil
+	}
+	return nil, ErrTenantNotFound
+}
+
+// tenantKey is the context key of the tenant
+type tenantKey struct{}
+
+// WithTenant returns a copy of ctx serving the tenant: its ID and feature flags are set for the
+// domain, and the tenant itself for FromContext
+func WithTenant(ctx context.Context, tenant *Tenant) context.Context {
+	ctx = domain.WithTenantID(ctx, tenant.ID)
+	ctx = domain.WithTenantFeatures(ctx, tenant.Features)
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// FromContext returns the tenant served by ctx
+func FromContext(ctx context.Context) (*Tenant, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(*Tenant)
+	return tenant, ok && tenant != nil
+}
+
+// tenantsOf converts the tenants of global.tenancy.tenants
+func tenantsOf(configs []config.TenantConfig) []Tenant {
+	tenants := make([]Tenant, len(configs))
+	for i, tc := range configs {
+		tenants[i] = Tenant{
+			ID:        tc.ID,
+			Name:      tc.Name,
+			Subdomain: tc.Subdomain,
+			Disabled:  tc.Disabled,
+			Modules:   tc.Modules,
+			Features:  tc.Features,
+		}
+	}
+	return tenants
+}
@@ -0,0 +1,28 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// CommandMiddleware wraps every command executed on a MiddlewareCommandBus in a span named after it
+func CommandMiddleware() application.CommandMiddleware {
+	return application.CommandMiddlewareFunc(func(ctx context.Context, cmd application.Command, next func(context.Context, application.Command) error) error {
+		ctx, span := Tracer().Start(ctx, "command "+cmd.CommandName(),
+			trace.WithAttributes(attribute.String("command.name", cmd.CommandName())),
+		)
+		defer span.End()
+
+		err := next(ctx, cmd)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return err
+	})
+}
@@ -0,0 +1,27 @@
+package tracing
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// requestIDAttribute is the span attribute holding the request ID
+const requestIDAttribute = attribute.Key("request.id")
+
+// Middleware creates a server span for every request, continuing the trace of a traceparent header
+// The span is named after the route and tagged with the request ID, so it must follow requestid.Middleware
+func Middleware(service string) []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		otelgin.Middleware(service),
+		func(c *gin.Context) {
+			trace.SpanFromContext(c.Request.Context()).SetAttributes(
+				requestIDAttribute.String(domain.RequestIDFromContext(c.Request.Context())),
+			)
+			c.Next()
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"golang_modular_monolith/internal/shared/application"
+)
+
+// QueryMiddleware wraps every query executed on a MiddlewareQueryBus in a span named after it
+func QueryMiddleware() application.QueryMiddleware {
+	return application.QueryMiddlewareFunc(func(ctx context.Context, query application.Query, next application.QueryFunc) (interface{}, error) {
+		ctx, span := Tracer().Start(ctx, "query "+query.QueryName(),
+			trace.WithAttributes(attribute.String("query.name", query.QueryName())),
+		)
+		defer span.End()
+
+		result, err := next(ctx, query)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return result, err
+	})
+}
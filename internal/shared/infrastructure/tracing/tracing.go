@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+)
+
+// instrumentationName names the tracer of the application's own spans
+const instrumentationName = "golang_modular_monolith"
+
+// Enabled reports if tracing is turned on by features.tracing_enabled in the global modules configuration
+func Enabled(cfg *config.Config) bool {
+	return cfg.Modules != nil && cfg.Modules.Global.Features.TracingEnabled
+}
+
+// Setup installs the global tracer provider, exporting spans to the OTLP/HTTP collector of cfg.Tracing,
+// and the W3C trace context propagator used by HTTP requests and domain events
+// The returned function flushes pending spans and stops the exporter
+func Setup(ctx context.Context, cfg *config.Config) (func(context.Context) error, error) {
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Tracing.Endpoint)}
+	if cfg.Tracing.Insecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(cfg.App.Name),
+		semconv.ServiceVersion(cfg.App.Version),
+		semconv.DeploymentEnvironmentName(cfg.App.Environment),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.Tracing.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	domain.SetTracePropagator(eventPropagator{})
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the application's own spans
+// It is a no-op tracer until Setup is called
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// eventPropagator carries trace contexts on domain events with the global propagator
+type eventPropagator struct{}
+
+// Inject returns the trace context of ctx, or nil when ctx is not traced
+func (eventPropagator) Inject(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	return carrier
+}
+
+// Extract returns a copy of ctx continuing the trace of carrier
+func (eventPropagator) Extract(ctx context.Context, carrier map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
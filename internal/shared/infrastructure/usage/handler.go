@@ -0,0 +1,80 @@
+package usage
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// Path is where the usage counts are served
+const Path = "/admin/usage"
+
+// Permission is required to read the usage counts
+const Permission = "usage:read"
+
+// Handler serves a page of the daily request counts, latest day and most requests first, with the
+// total of requests of every matching count
+// Filters: ?module=, ?route= (the route pattern, e.g. /api/v1/customers/:id), ?method=, ?consumer=
+// (e.g. api_key:<key id>), and ?from= / ?to= (both inclusive) as YYYY-MM-DD days in UTC; ?page= and
+// ?limit= page through the counts
+func Handler(recorder *Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filter := Filter{
+			Page:     intQuery(c, "page", 1),
+			Limit:    intQuery(c, "limit", 50),
+			Module:   c.Query("module"),
+			Route:    c.Query("route"),
+			Method:   strings.ToUpper(c.Query("method")),
+			Consumer: c.Query("consumer"),
+			From:     c.Query("from"),
+			To:       c.Query("to"),
+		}
+
+		page, err := recorder.Query(c.Request.Context(), filter)
+		if err != nil {
+			handleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success":        true,
+			"data":           page.Counts,
+			"total_requests": page.TotalRequests,
+			"pagination":     page.Pagination,
+		})
+	}
+}
+
+// handleError answers 400 for invalid filters and 500 otherwise
+func handleError(c *gin.Context, err error) {
+	var validationErr domain.ValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   gin.H{"code": "VALIDATION_ERROR", "message": err.Error()},
+		})
+		return
+	}
+
+	zap.L().Error("failed to query usage", zap.Error(err))
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error":   gin.H{"code": "INTERNAL_ERROR", "message": "failed to query usage"},
+	})
+}
+
+// intQuery gets an integer query parameter with default value
+func intQuery(c *gin.Context, key string, defaultValue int) int {
+	if str := c.Query(key); str != "" {
+		if val, err := strconv.Atoi(str); err == nil {
+			return val
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,152 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// schema creates the table of daily counts; the counts belong to no module, so the table is not
+// created by the migrations of the database it is kept in
+const schema = `
+CREATE TABLE IF NOT EXISTS usage_daily (
+    day DATE NOT NULL,
+    module VARCHAR(50) NOT NULL,
+    route VARCHAR(255) NOT NULL,
+    method VARCHAR(10) NOT NULL,
+    consumer VARCHAR(100) NOT NULL,
+    requests BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (day, module, route, method, consumer)
+);
+CREATE INDEX IF NOT EXISTS idx_usage_daily_consumer ON usage_daily (consumer, day);
+`
+
+// countModel represents the daily count database model
+type countModel struct {
+	Day      time.Time `gorm:"primaryKey;type:date"`
+	Module   string    `gorm:"primaryKey"`
+	Route    string    `gorm:"primaryKey"`
+	Method   string    `gorm:"primaryKey"`
+	Consumer string    `gorm:"primaryKey"`
+	Requests int64
+}
+
+// TableName returns the table name for GORM
+func (countModel) TableName() string {
+	return "usage_daily"
+}
+
+// toCount converts the model to a count
+func (m *countModel) toCount() Count {
+	return Count{
+		Key: Key{
+			Day:      m.Day.Format(DayLayout),
+			Module:   m.Module,
+			Route:    m.Route,
+			Method:   m.Method,
+			Consumer: m.Consumer,
+		},
+		Requests: m.Requests,
+	}
+}
+
+// PostgresStore keeps the counts in the usage_daily table of a PostgreSQL database, one row per
+// day, route and consumer incremented by each flush
+type PostgresStore struct {
+	db *gorm.DB
+}
+
+// NewPostgresStore creates the store, creating its table when it does not exist
+func NewPostgresStore(ctx context.Context, db *gorm.DB) (*PostgresStore, error) {
+	if err := db.WithContext(ctx).Exec(schema).Error; err != nil {
+		return nil, fmt.Errorf("failed to create usage table: %w", err)
+	}
+	return &PostgresStore{db: db}, nil
+}
+
+// Add adds the counts to the stored ones
+func (s *PostgresStore) Add(ctx context.Context, counts map[Key]int64) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	models := make([]countModel, 0, len(counts))
+	for key, requests := range counts {
+		day, err := time.Parse(DayLayout, key.Day)
+		if err != nil {
+			return fmt.Errorf("invalid usage day %q: %w", key.Day, err)
+		}
+		models = append(models, countModel{
+			Day:      day,
+			Module:   key.Module,
+			Route:    key.Route,
+			Method:   key.Method,
+			Consumer: key.Consumer,
+			Requests: requests,
+		})
+	}
+
+	err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "day"}, {Name: "module"}, {Name: "route"}, {Name: "method"}, {Name: "consumer"}},
+		DoUpdates: clause.Assignments(map[string]any{"requests": gorm.Expr("usage_daily.requests + EXCLUDED.requests")}),
+	}).CreateInBatches(models, 500).Error
+	if err != nil {
+		return fmt.Errorf("failed to record usage: %w", err)
+	}
+	return nil
+}
+
+// Query returns a page of the counts matching the filter, latest day and most requests first
+func (s *PostgresStore) Query(ctx context.Context, filter Filter) (*Page, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := s.db.WithContext(ctx).Model(&countModel{})
+
+	// Apply filters
+	for column, value := range map[string]string{
+		"module":   filter.Module,
+		"route":    filter.Route,
+		"method":   filter.Method,
+		"consumer": filter.Consumer,
+	} {
+		if value != "" {
+			query = query.Where(column+" = ?", value)
+		}
+	}
+	if filter.From != "" {
+		query = query.Where("day >= ?", filter.From)
+	}
+	if filter.To != "" {
+		query = query.Where("day <= ?", filter.To)
+	}
+
+	// Count matching rows and their requests
+	var totals struct {
+		Rows     int64
+		Requests int64
+	}
+	if err := query.Session(&gorm.Session{}).Select("COUNT(*) AS rows, COALESCE(SUM(requests), 0) AS requests").Scan(&totals).Error; err != nil {
+		return nil, fmt.Errorf("failed to count usage: %w", err)
+	}
+
+	var models []countModel
+	if err := query.Order("day DESC, requests DESC, module, route, method, consumer").Offset(filter.GetOffset()).Limit(filter.Limit).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to query usage: %w", err)
+	}
+
+	counts := make([]Count, len(models))
+	for i := range models {
+		counts[i] = models[i].toCount()
+	}
+
+	return &Page{
+		Counts:        counts,
+		TotalRequests: totals.Requests,
+		Pagination:    newPagination(filter.Page, filter.Limit, totals.Rows),
+	}, nil
+}
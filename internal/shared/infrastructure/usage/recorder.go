@@ -0,0 +1,144 @@
+package usage
+
+import (
+	"context"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/worker"
+)
+
+// DefaultFlushInterval is how often counts are written to the store unless configured otherwise
+const DefaultFlushInterval = time.Minute
+
+// flushTimeout bounds the last flush when the recorder stops
+const flushTimeout = 5 * time.Second
+
+// Recorder counts requests in memory and adds the counts to a store when flushed, so that a
+// request costs a map increment rather than a write
+type Recorder struct {
+	store Store
+	now   func() time.Time
+
+	mu      sync.Mutex
+	pending map[Key]int64
+}
+
+// NewRecorder creates a recorder adding its counts to the store
+func NewRecorder(store Store) *Recorder {
+	return &Recorder{store: store, now: time.Now, pending: make(map[Key]int64)}
+}
+
+// Record counts a request of the consumer to a route of a module, on the current day
+func (r *Recorder) Record(module, method, route, consumer string) {
+	key := Key{
+		Day:      r.now().UTC().Format(DayLayout),
+		Module:   truncate(module, 50),
+		Route:    truncate(route, 255),
+		Method:   truncate(method, 10),
+		Consumer: truncate(consumer, 100),
+	}
+
+	r.mu.Lock()
+	r.pending[key]++
+	r.mu.Unlock()
+}
+
+// Flush adds the pending counts to the store; they are kept for the next flush when it fails
+func (r *Recorder) Flush(ctx context.Context) error {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = make(map[Key]int64)
+	r.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+	if err := r.store.Add(ctx, pending); err != nil {
+		r.mu.Lock()
+		for key, requests := range pending {
+			r.pending[key] += requests
+		}
+		r.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Query flushes the pending counts, so that reports include the latest requests, and queries the store
+func (r *Recorder) Query(ctx context.Context, filter Filter) (*Page, error) {
+	if err := r.Flush(ctx); err != nil {
+		zap.L().Warn("failed to flush usage counts", zap.Error(err))
+	}
+	return r.store.Query(ctx, filter)
+}
+
+// Start starts a worker flushing the counts every interval, and once more when it stops
+func (r *Recorder) Start(workers *worker.Manager, interval time.Duration) error {
+	return workers.Go("usage_flush", func(ctx context.Context) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				flushCtx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+				defer cancel()
+				if err := r.Flush(flushCtx); err != nil {
+					zap.L().Error("failed to flush usage counts", zap.Error(err))
+				}
+				return nil
+			case <-ticker.C:
+				if err := r.Flush(ctx); err != nil {
+					// Counts are kept for the next flush, so the worker keeps running
+					zap.L().Warn("failed to flush usage counts", zap.Error(err))
+				}
+			}
+		}
+	})
+}
+
+// Middleware counts the requests to the routes of a module, by route pattern and consumer
+// Counting happens once the request is handled, so that the principal set by authentication
+// further down the chain is known; rejected requests count as anonymous
+func (r *Recorder) Middleware(module string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		r.Record(module, c.Request.Method, route, Consumer(c))
+	}
+}
+
+// Consumer identifies the caller of a request as "<actor type>:<id>", e.g. "api_key:<key id>",
+// or Anonymous when it is not authenticated
+func Consumer(c *gin.Context) string {
+	principal, ok := auth.CurrentPrincipal(c)
+	if !ok || principal == nil {
+		return Anonymous
+	}
+	actor := principal.Actor()
+	if actor.ID == "" {
+		return actor.Type
+	}
+	return actor.Type + ":" + actor.ID
+}
+
+// truncate shortens s to at most limit bytes without splitting a UTF-8 character
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit]
+}
@@ -0,0 +1,200 @@
+// Package usage counts the requests served by the module routes per day, route and consumer (the
+// API key, service account or user calling them), aggregated in memory and flushed to a store
+// periodically, for usage reports and quota enforcement.
+package usage
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// DayLayout is the format of the days counts are kept for, in UTC
+const DayLayout = "2006-01-02"
+
+// Anonymous is the consumer of unauthenticated requests
+const Anonymous = "anonymous"
+
+// Key identifies a counter: the requests of a consumer to a route of a module on a day
+type Key struct {
+	Day      string `json:"day"`
+	Module   string `json:"module"`
+	Route    string `json:"route"`
+	Method   string `json:"method"`
+	Consumer string `json:"consumer"`
+}
+
+// Count is the number of requests counted for a key
+type Count struct {
+	Key
+	Requests int64 `json:"requests"`
+}
+
+// Store keeps the daily request counts
+type Store interface {
+	// Add adds the counts to the stored ones
+	Add(ctx context.Context, counts map[Key]int64) error
+	// Query returns a page of the counts matching the filter, latest day and most requests first
+	Query(ctx context.Context, filter Filter) (*Page, error)
+}
+
+// Filter selects a page of counts; empty fields match every count
+type Filter struct {
+	// Pagination
+	Page  int
+	Limit int
+
+	// Filtering
+	Module   string
+	Route    string
+	Method   string
+	Consumer string
+
+	// Day range, both inclusive, as YYYY-MM-DD
+	From string
+	To   string
+}
+
+// Validate validates the filter, defaulting the pagination
+func (f *Filter) Validate() error {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+
+	if f.Limit <= 0 {
+		f.Limit = 50
+	}
+
+	// Maximum limit
+	if f.Limit > 500 {
+		f.Limit = 500
+	}
+
+	for _, day := range []struct{ field, value string }{{"from", f.From}, {"to", f.To}} {
+		if day.value == "" {
+			continue
+		}
+		if _, err := time.Parse(DayLayout, day.value); err != nil {
+			return domain.NewValidationErrorWithValue(day.field, day.field+" must be a day as YYYY-MM-DD", day.value)
+		}
+	}
+	if f.From != "" && f.To != "" && f.From > f.To {
+		return domain.NewValidationError("from", "from must not be later than to")
+	}
+
+	return nil
+}
+
+// GetOffset calculates the offset for pagination
+func (f *Filter) GetOffset() int {
+	return (f.Page - 1) * f.Limit
+}
+
+// matches reports whether a key matches the filter
+func (f *Filter) matches(key Key) bool {
+	return (f.Module == "" || key.Module == f.Module) &&
+		(f.Route == "" || key.Route == f.Route) &&
+		(f.Method == "" || key.Method == f.Method) &&
+		(f.Consumer == "" || key.Consumer == f.Consumer) &&
+		(f.From == "" || key.Day >= f.From) &&
+		(f.To == "" || key.Day <= f.To)
+}
+
+// Page is a page of counts with the total of requests of every matching count
+type Page struct {
+	Counts        []Count    `json:"counts"`
+	TotalRequests int64      `json:"total_requests"`
+	Pagination    Pagination `json:"pagination"`
+}
+
+// Pagination represents pagination information
+type Pagination struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"total_pages"`
+	HasNext    bool  `json:"has_next"`
+	HasPrev    bool  `json:"has_prev"`
+}
+
+// newPagination creates the pagination of a page
+func newPagination(page, limit int, total int64) Pagination {
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return Pagination{
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+}
+
+// MemoryStore keeps the counts in memory, for development and when no database is configured
+// for them; counts are lost on restart
+type MemoryStore struct {
+	mu     sync.RWMutex
+	counts map[Key]int64
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{counts: make(map[Key]int64)}
+}
+
+// Add adds the counts to the stored ones
+func (s *MemoryStore) Add(_ context.Context, counts map[Key]int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, requests := range counts {
+		s.counts[key] += requests
+	}
+	return nil
+}
+
+// Query returns a page of the counts matching the filter, latest day and most requests first
+func (s *MemoryStore) Query(_ context.Context, filter Filter) (*Page, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	var matching []Count
+	var totalRequests int64
+	for key, requests := range s.counts {
+		if filter.matches(key) {
+			matching = append(matching, Count{Key: key, Requests: requests})
+			totalRequests += requests
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(matching, func(i, j int) bool {
+		a, b := matching[i], matching[j]
+		if a.Day != b.Day {
+			return a.Day > b.Day
+		}
+		if a.Requests != b.Requests {
+			return a.Requests > b.Requests
+		}
+		return a.Module+a.Route+a.Method+a.Consumer < b.Module+b.Route+b.Method+b.Consumer
+	})
+
+	total := int64(len(matching))
+	start := min(filter.GetOffset(), len(matching))
+	end := min(start+filter.Limit, len(matching))
+
+	return &Page{
+		Counts:        append([]Count{}, matching[start:end]...),
+		TotalRequests: totalRequests,
+		Pagination:    newPagination(filter.Page, filter.Limit, total),
+	}, nil
+}
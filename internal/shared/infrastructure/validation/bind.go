@@ -0,0 +1,136 @@
+// Package validation binds request bodies and turns binding failures into per-field validation
+// errors whose messages follow the request's Accept-Language
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+func init() {
+	// Report fields by their JSON names so error paths match what the client sent
+	if engine, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		engine.RegisterTagNameFunc(jsonName)
+	}
+}
+
+// BindJSON decodes and validates the JSON body into obj; failures are returned as
+// domain.ValidationErrors or an INVALID_INPUT domain.DomainError with localized messages, and bodies
+// cut off by their size limit or the read timeout as REQUEST_TOO_LARGE or REQUEST_TIMEOUT
+func BindJSON(c *gin.Context, obj any) error {
+	if err := c.ShouldBindJSON(obj); err != nil {
+		return Translate(c, err)
+	}
+	return nil
+}
+
+// Translate converts a binding error into a localized domain error and marks the response with
+// the chosen language
+func Translate(c *gin.Context, err error) error {
+	lang := Locale(c)
+	c.Header("Content-Language", lang.String())
+	c.Writer.Header().Add("Vary", "Accept-Language")
+
+	var fieldErrs validator.ValidationErrors
+	if errors.As(err, &fieldErrs) {
+		var errs domain.ValidationErrors
+		for _, fe := range fieldErrs {
+			field := fieldPath(fe)
+			errs.Add(field, message(lang, messageKey(fe), field, fe.Param()))
+		}
+		return errs
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		var errs domain.ValidationErrors
+		errs.Add(typeErr.Field, message(lang, keyType, typeErr.Field, jsonType(typeErr.Type)))
+		return errs
+	}
+
+	var syntaxErr *json.SyntaxError
+	var tooLarge *http.MaxBytesError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &tooLarge):
+		return domain.NewDomainErrorWithCause(domain.ErrCodeRequestTooLarge, message(lang, keyBodyTooLarge, "", strconv.FormatInt(tooLarge.Limit, 10)), err)
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return domain.NewDomainErrorWithCause(domain.ErrCodeRequestTimeout, message(lang, keyBodyTimeout, "", ""), err)
+	case errors.Is(err, io.EOF):
+		return domain.NewDomainError(domain.ErrCodeInvalidInput, message(lang, keyBodyEmpty, "", ""))
+	case errors.As(err, &syntaxErr), errors.Is(err, io.ErrUnexpectedEOF), typeErr != nil:
+		return domain.NewDomainError(domain.ErrCodeInvalidInput, message(lang, keyBodyMalformed, "", ""))
+	default:
+		return domain.NewDomainErrorWithCause(domain.ErrCodeInvalidInput, message(lang, keyBodyInvalid, "", ""), err)
+	}
+}
+
+// messageKey selects the catalog entry for a failed tag
+func messageKey(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "min", "max", "len":
+		switch fe.Kind() {
+		case reflect.String:
+			return fe.Tag() + ".string"
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return fe.Tag() + ".items"
+		default:
+			return fe.Tag() + ".number"
+		}
+	}
+	return fe.Tag()
+}
+
+// fieldPath is the namespace of the failed field without the request struct's name,
+// e.g. items[0].quantity
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if _, rest, ok := strings.Cut(namespace, "."); ok {
+		return rest
+	}
+	return namespace
+}
+
+// jsonName is the field's name in its json tag, or its Go name when it has none
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" || name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// jsonType names the JSON type a Go type is decoded from
+func jsonType(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return t.String()
+	}
+}
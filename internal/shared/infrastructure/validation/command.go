@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/text/language"
+
+	"golang_modular_monolith/internal/shared/application"
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+var (
+	commandValidatorOnce sync.Once
+	commandValidator     *validator.Validate
+)
+
+// commands returns the validator of the validate tags of commands, reporting fields by JSON name
+func commands() *validator.Validate {
+	commandValidatorOnce.Do(func() {
+		commandValidator = validator.New(validator.WithRequiredStructEnabled())
+		commandValidator.RegisterTagNameFunc(jsonName)
+	})
+	return commandValidator
+}
+
+// CommandMiddleware validates every command executed on a MiddlewareCommandBus against its validate
+// tags before its handler runs; failures are returned as domain.ValidationErrors
+func CommandMiddleware() application.CommandMiddleware {
+	return application.CommandMiddlewareFunc(func(ctx context.Context, cmd application.Command, next func(context.Context, application.Command) error) error {
+		if err := Command(cmd); err != nil {
+			return err
+		}
+		return next(ctx, cmd)
+	})
+}
+
+// Command validates a command, or any struct, against its validate tags; failures are returned as
+// domain.ValidationErrors with English messages, keyed by the JSON path of each field
+// Values other than structs and pointers to structs have no tags and are valid
+func Command(cmd any) error {
+	err := commands().Struct(cmd)
+	if err == nil {
+		return nil
+	}
+
+	var invalid *validator.InvalidValidationError
+	if errors.As(err, &invalid) {
+		return nil
+	}
+
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return err
+	}
+	var errs domain.ValidationErrors
+	for _, fe := range fieldErrs {
+		field := fieldPath(fe)
+		errs.Add(field, message(language.English, messageKey(fe), field, fe.Param()))
+	}
+	return errs
+}
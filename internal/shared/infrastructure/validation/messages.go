@@ -0,0 +1,110 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+)
+
+// Message keys beyond validator tags; size tags (min, max, len) are suffixed with the kind of the
+// field they constrain
+const (
+	keyInvalid       = "invalid"
+	keyType          = "type"
+	keyBodyEmpty     = "body.empty"
+	keyBodyMalformed = "body.malformed"
+	keyBodyInvalid   = "body.invalid"
+	keyBodyTooLarge  = "body.too_large"
+	keyBodyTimeout   = "body.timeout"
+)
+
+// catalogs holds the messages of each supported language; {field} and {param} are replaced with
+// the JSON path of the field and the tag's parameter
+var catalogs = map[language.Tag]map[string]string{
+	language.English: {
+		"required":       "{field} is required",
+		"email":          "{field} must be a valid email address",
+		"url":            "{field} must be a valid URL",
+		"uuid":           "{field} must be a valid UUID",
+		"oneof":          "{field} must be one of: {param}",
+		"gt":             "{field} must be greater than {param}",
+		"gte":            "{field} must be at least {param}",
+		"lt":             "{field} must be less than {param}",
+		"lte":            "{field} must be at most {param}",
+		"min.string":     "{field} must be at least {param} characters long",
+		"min.number":     "{field} must be at least {param}",
+		"min.items":      "{field} must contain at least {param} items",
+		"max.string":     "{field} must be at most {param} characters long",
+		"max.number":     "{field} must be at most {param}",
+		"max.items":      "{field} must contain at most {param} items",
+		"len.string":     "{field} must be exactly {param} characters long",
+		"len.number":     "{field} must be {param}",
+		"len.items":      "{field} must contain exactly {param} items",
+		keyType:          "{field} must be a {param}",
+		keyInvalid:       "{field} is invalid",
+		keyBodyEmpty:     "Request body is required",
+		keyBodyMalformed: "Request body is not valid JSON",
+		keyBodyInvalid:   "Request body could not be read",
+		keyBodyTooLarge:  "Request body exceeds {param} bytes",
+		keyBodyTimeout:   "Request body was not received in time",
+	},
+	language.Vietnamese: {
+		"required":       "{field} là bắt buộc",
+		"email":          "{field} phải là địa chỉ email hợp lệ",
+		"url":            "{field} phải là URL hợp lệ",
+		"uuid":           "{field} phải là UUID hợp lệ",
+		"oneof":          "{field} phải là một trong các giá trị: {param}",
+		"gt":             "{field} phải lớn hơn {param}",
+		"gte":            "{field} phải lớn hơn hoặc bằng {param}",
+		"lt":             "{field} phải nhỏ hơn {param}",
+		"lte":            "{field} phải nhỏ hơn hoặc bằng {param}",
+		"min.string":     "{field} phải có ít nhất {param} ký tự",
+		"min.number":     "{field} phải lớn hơn hoặc bằng {param}",
+		"min.items":      "{field} phải có ít nhất {param} phần tử",
+		"max.string":     "{field} không được vượt quá {param} ký tự",
+		"max.number":     "{field} phải nhỏ hơn hoặc bằng {param}",
+		"max.items":      "{field} không được có quá {param} phần tử",
+		"len.string":     "{field} phải có đúng {param} ký tự",
+		"len.number":     "{field} phải bằng {param}",
+		"len.items":      "{field} phải có đúng {param} phần tử",
+		keyType:          "{field} phải có kiểu {param}",
+		keyInvalid:       "{field} không hợp lệ",
+		keyBodyEmpty:     "Nội dung yêu cầu là bắt buộc",
+		keyBodyMalformed: "Nội dung yêu cầu không phải JSON hợp lệ",
+		keyBodyInvalid:   "Không đọc được nội dung yêu cầu",
+		keyBodyTooLarge:  "Nội dung yêu cầu vượt quá {param} byte",
+		keyBodyTimeout:   "Nội dung yêu cầu không được gửi kịp thời hạn",
+	},
+}
+
+// supported lists the catalog languages in preference order; the first is the fallback
+var supported = []language.Tag{language.English, language.Vietnamese}
+
+var matcher = language.NewMatcher(supported)
+
+// Locale picks the catalog language that best matches the request's Accept-Language header
+func Locale(c *gin.Context) language.Tag {
+	tags, _, err := language.ParseAcceptLanguage(c.GetHeader("Accept-Language"))
+	if err != nil || len(tags) == 0 {
+		return supported[0]
+	}
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return supported[0]
+	}
+	return supported[index]
+}
+
+// message renders the message for key in lang, falling back to English and then to the
+// generic invalid message
+func message(lang language.Tag, key, field, param string) string {
+	text, ok := catalogs[lang][key]
+	if !ok {
+		text, ok = catalogs[supported[0]][key]
+	}
+	if !ok {
+		text = catalogs[lang][keyInvalid]
+	}
+	return strings.NewReplacer("{field}", field, "{param}", param).Replace(text)
+}
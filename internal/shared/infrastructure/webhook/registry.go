@@ -0,0 +1,143 @@
+package webhook
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+)
+
+// PayloadMapper converts a domain event into the public webhook payload
+type PayloadMapper func(event domain.DomainEvent) (interface{}, error)
+
+// EventDefinition describes an event that external systems can subscribe to
+type EventDefinition struct {
+	// Name is the public webhook event name (e.g. customer.created)
+	Name string `json:"name"`
+
+	// Module is the module that owns the event
+	Module string `json:"module"`
+
+	// Description documents when the event is emitted
+	Description string `json:"description"`
+
+	// SourceEvents lists the domain event types that trigger this webhook event
+	SourceEvents []string `json:"source_events"`
+
+	// Payload builds the webhook payload from a source domain event
+	Payload PayloadMapper `json:"-"`
+}
+
+// Envelope is the JSON document delivered to webhook subscribers
+type Envelope struct {
+	ID          string      `json:"id"`
+	Type        string      `json:"type"`
+	AggregateID string      `json:"aggregate_id"`
+	OccurredAt  time.Time   `json:"occurred_at"`
+	Data        interface{} `json:"data"`
+}
+
+// Registry keeps the catalog of webhook-eligible events
+type Registry struct {
+	definitions map[string]EventDefinition
+	bySource    map[string][]string
+	mu          sync.RWMutex
+}
+
+// NewRegistry creates a new webhook event registry
+func NewRegistry() *Registry {
+	return &Registry{
+		definitions: make(map[string]EventDefinition),
+		bySource:    make(map[string][]string),
+	}
+}
+
+// Register registers a webhook-eligible event
+func (r *Registry) Register(definition EventDefinition) error {
+	if definition.Name == "" {
+		return fmt.Errorf("webhook event name is required")
+	}
+	if definition.Payload == nil {
+		return fmt.Errorf("webhook event %s has no payload mapper", definition.Name)
+	}
+	if len(definition.SourceEvents) == 0 {
+		return fmt.Errorf("webhook event %s has no source events", definition.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.definitions[definition.Name]; exists {
+		return fmt.Errorf("webhook event %s already registered", definition.Name)
+	}
+
+	r.definitions[definition.Name] = definition
+	for _, source := range definition.SourceEvents {
+		r.bySource[source] = append(r.bySource[source], definition.Name)
+	}
+
+	return nil
+}
+
+// IsEligible checks if a domain event type triggers any webhook event
+func (r *Registry) IsEligible(eventType string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.bySource[eventType]) > 0
+}
+
+// Definitions returns all registered webhook events sorted by name
+func (r *Registry) Definitions() []EventDefinition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	definitions := make([]EventDefinition, 0, len(r.definitions))
+	for _, definition := range r.definitions {
+		definitions = append(definitions, definition)
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].Name < definitions[j].Name
+	})
+
+	return definitions
+}
+
+// BuildEnvelopes converts a domain event into the webhook envelopes it triggers
+func (r *Registry) BuildEnvelopes(event domain.DomainEvent) ([]Envelope, error) {
+	r.mu.RLock()
+	names := r.bySource[event.GetEventType()]
+	definitions := make([]EventDefinition, 0, len(names))
+	for _, name := range names {
+		definitions = append(definitions, r.definitions[name])
+	}
+	r.mu.RUnlock()
+
+	envelopes := make([]Envelope, 0, len(definitions))
+	for _, definition := range definitions {
+		data, err := definition.Payload(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build payload for webhook event %s: %w", definition.Name, err)
+		}
+
+		envelopes = append(envelopes, Envelope{
+			ID:          event.GetEventID(),
+			Type:        definition.Name,
+			AggregateID: event.GetAggregateID(),
+			OccurredAt:  event.GetOccurredAt(),
+			Data:        data,
+		})
+	}
+
+	return envelopes, nil
+}
+
+// Global registry instance
+var globalRegistry = NewRegistry()
+
+// GetGlobalRegistry returns the global webhook event registry
+func GetGlobalRegistry() *Registry {
+	return globalRegistry
+}
@@ -0,0 +1,46 @@
+package worker
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	runningDesc = prometheus.NewDesc(
+		"background_worker_running",
+		"Whether each background worker is running: 1 while running, 0 while restarting or once done or stopped",
+		[]string{"module", "worker"}, nil,
+	)
+	restartsDesc = prometheus.NewDesc(
+		"background_worker_restarts_total",
+		"Number of times each background worker failed or panicked and was restarted",
+		[]string{"module", "worker"}, nil,
+	)
+)
+
+// collector exposes the workers of the registered managers as Prometheus metrics
+type collector struct{}
+
+// Collector returns a Prometheus collector of the state and restarts of every registered worker
+func Collector() prometheus.Collector {
+	return collector{}
+}
+
+// Describe implements prometheus.Collector
+func (collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- runningDesc
+	ch <- restartsDesc
+}
+
+// Collect implements prometheus.Collector
+func (collector) Collect(ch chan<- prometheus.Metric) {
+	for _, manager := range Managers() {
+		for _, status := range manager.Status() {
+			running := 0.0
+			if status.State == StateRunning {
+				running = 1
+			}
+			ch <- prometheus.MustNewConstMetric(runningDesc, prometheus.GaugeValue, running, status.Module, status.Name)
+			ch <- prometheus.MustNewConstMetric(restartsDesc, prometheus.CounterValue, float64(status.Restarts), status.Module, status.Name)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+// Package worker supervises the long-running goroutines of modules, e.g. relays, projections or
+// import processors: it recovers their panics, restarts them with a backoff when they fail and
+// stops them when the module stops.
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Func is the body of a worker; it runs until ctx is canceled, and returns nil when its work is
+// done, or an error to be restarted
+type Func func(ctx context.Context) error
+
+// Default restart backoff
+const (
+	DefaultMinBackoff = time.Second
+	DefaultMaxBackoff = time.Minute
+)
+
+var (
+	// ErrStopped is returned when starting a worker on a stopped manager
+	ErrStopped = errors.New("worker manager is stopped")
+	// ErrBudgetExceeded is returned when starting a worker would run more workers at once than
+	// the module's resources.max_background_jobs
+	ErrBudgetExceeded = errors.New("background job budget exceeded")
+)
+
+// State is the state of a worker
+type State string
+
+// Worker states
+const (
+	StateRunning    State = "running"
+	StateRestarting State = "restarting"
+	StateDone       State = "done"
+	StateStopped    State = "stopped"
+)
+
+// Option configures a worker
+type Option func(*worker)
+
+// WithBackoff sets the delay before restarting a failed worker, doubling from first up to limit
+// The delay resets once the worker ran for limit without failing
+func WithBackoff(first, limit time.Duration) Option {
+	return func(w *worker) {
+		w.minBackoff = first
+		w.maxBackoff = limit
+	}
+}
+
+// Status is the state and restart count of a worker at a point in time
+type Status struct {
+	Module    string
+	Name      string
+	State     State
+	Restarts  uint64
+	LastError string
+	Since     time.Time
+}
+
+// worker is a supervised goroutine
+type worker struct {
+	name       string
+	run        Func
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	mu        sync.Mutex
+	state     State
+	restarts  uint64
+	lastError string
+	since     time.Time
+}
+
+// Manager runs the workers of a module
+// Workers get the manager's context rather than the context of the module's Start, which is
+// bounded by the start timeout, and are canceled by Stop
+type Manager struct {
+	module string
+	logger *zap.Logger
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	workers []*worker
+	stopped bool
+	limit   int
+}
+
+// NewManager creates the worker manager of a module and registers it, replacing any manager of
+// the same module, so that its workers are reported by Managers
+// The manager runs at most the module's budget of workers at once, as set with SetBudget
+func NewManager(module string, logger *zap.Logger) *Manager {
+	if logger == nil {
+		logger = zap.L()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Manager{module: module, logger: logger, ctx: ctx, cancel: cancel}
+	if limit, ok := budgets.Load(module); ok {
+		m.limit = limit.(int)
+	}
+	managers.Store(module, m)
+	return m
+}
+
+// Module returns the name of the module the manager runs workers of
+func (m *Manager) Module() string {
+	return m.module
+}
+
+// Go starts a worker; its panics are recovered, and a worker failing or panicking is restarted
+// after a backoff, DefaultMinBackoff doubling up to DefaultMaxBackoff unless set with WithBackoff
+func (m *Manager) Go(name string, run Func, opts ...Option) error {
+	w := &worker{name: name, run: run, minBackoff: DefaultMinBackoff, maxBackoff: DefaultMaxBackoff}
+	for _, opt := range opts {
+		opt(w)
+	}
+	if w.minBackoff <= 0 || w.maxBackoff < w.minBackoff {
+		return fmt.Errorf("worker %s: invalid backoff %s to %s", name, w.minBackoff, w.maxBackoff)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.stopped {
+		return fmt.Errorf("worker %s: %w", name, ErrStopped)
+	}
+	active := 0
+	for _, existing := range m.workers {
+		if existing.name == name {
+			return fmt.Errorf("worker %s is already registered", name)
+		}
+		if existing.active() {
+			active++
+		}
+	}
+	if m.limit > 0 && active >= m.limit {
+		return fmt.Errorf("worker %s: module %s already runs %d workers: %w", name, m.module, active, ErrBudgetExceeded)
+	}
+	m.workers = append(m.workers, w)
+
+	m.wg.Add(1)
+	go m.supervise(w)
+	return nil
+}
+
+// Stop cancels the workers and waits for them to return, until ctx is done
+// Workers cannot be started once the manager is stopped
+func (m *Manager) Stop(ctx context.Context) error {
+	m.mu.Lock()
+	m.stopped = true
+	m.mu.Unlock()
+	m.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		var running []string
+		for _, status := range m.Status() {
+			if status.State.active() {
+				running = append(running, status.Name)
+			}
+		}
+		return fmt.Errorf("workers of module %s still running: %s", m.module, strings.Join(running, ", "))
+	}
+}
+
+// Status returns the status of the workers, in the order they were started
+func (m *Manager) Status() []Status {
+	m.mu.Lock()
+	workers := append([]*worker(nil), m.workers...)
+	m.mu.Unlock()
+
+	statuses := make([]Status, len(workers))
+	for i, w := range workers {
+		w.mu.Lock()
+		statuses[i] = Status{
+			Module:    m.module,
+			Name:      w.name,
+			State:     w.state,
+			Restarts:  w.restarts,
+			LastError: w.lastError,
+			Since:     w.since,
+		}
+		w.mu.Unlock()
+	}
+	return statuses
+}
+
+// supervise runs a worker until it is done or the manager stops, restarting it when it fails
+func (m *Manager) supervise(w *worker) {
+	defer m.wg.Done()
+	logger := m.logger.With(zap.String("worker", w.name))
+
+	backoff := w.minBackoff
+	for {
+		started := time.Now()
+		w.setState(StateRunning, nil)
+		err := m.call(w, logger)
+
+		if m.ctx.Err() != nil {
+			w.setState(StateStopped, nil)
+			return
+		}
+		if err == nil {
+			w.setState(StateDone, nil)
+			logger.Info("worker done")
+			return
+		}
+
+		// A worker that ran long enough before failing starts over from the shortest delay
+		if time.Since(started) >= w.maxBackoff {
+			backoff = w.minBackoff
+		}
+		w.setState(StateRestarting, err)
+		logger.Error("worker failed, restarting", zap.Error(err), zap.Duration("backoff", backoff))
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-m.ctx.Done():
+			timer.Stop()
+			w.setState(StateStopped, nil)
+			return
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, w.maxBackoff)
+	}
+}
+
+// call runs a worker once, turning a panic into an error
+func (m *Manager) call(w *worker, logger *zap.Logger) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			logger.Error("worker panicked", zap.Any("panic", recovered), zap.ByteString("stack", debug.Stack()))
+			err = fmt.Errorf("panic: %v", recovered)
+		}
+	}()
+	return w.run(m.ctx)
+}
+
+// active reports whether the worker is running or waiting to restart
+func (w *worker) active() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.state == "" || w.state.active()
+}
+
+// active reports whether a worker in the state is running or waiting to restart
+func (s State) active() bool {
+	return s == StateRunning || s == StateRestarting
+}
+
+// setState moves the worker to state, counting a restart when it failed with err
+func (w *worker) setState(state State, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.state = state
+	w.since = time.Now()
+	if err != nil {
+		w.restarts++
+		w.lastError = err.Error()
+	}
+}
+
+// managers holds the registered managers by module, and budgets the worker limits of modules
+var managers, budgets sync.Map
+
+// SetBudget limits the workers the managers of a module created afterwards run at once; zero or
+// less removes the limit
+func SetBudget(module string, limit int) {
+	if limit <= 0 {
+		budgets.Delete(module)
+		return
+	}
+	budgets.Store(module, limit)
+}
+
+// Managers returns the registered managers sorted by module
+func Managers() []*Manager {
+	var list []*Manager
+	managers.Range(func(_, value any) bool {
+		list = append(list, value.(*Manager))
+		return true
+	})
+	sort.Slice(list, func(i, j int) bool { return list[i].module < list[j].module })
+	return list
+}
@@ -0,0 +1,192 @@
+package testsupport
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+
+	"golang_modular_monolith/internal/modules"
+	"golang_modular_monolith/internal/shared/application"
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/authz"
+	"golang_modular_monolith/internal/shared/infrastructure/cache"
+	"golang_modular_monolith/internal/shared/infrastructure/database"
+	"golang_modular_monolith/internal/shared/infrastructure/httplimit"
+	"golang_modular_monolith/internal/shared/infrastructure/idempotency"
+	"golang_modular_monolith/internal/shared/infrastructure/logging"
+	"golang_modular_monolith/internal/shared/infrastructure/registry"
+	"golang_modular_monolith/internal/shared/infrastructure/requestid"
+	"golang_modular_monolith/internal/shared/infrastructure/worker"
+)
+
+// stopTimeout bounds the stop of the modules of an App when its test ends
+const stopTimeout = 10 * time.Second
+
+// App is a set of modules initialized and started on the environment, with their own event bus,
+// serving their routes on a test server until the test ends
+// Routes are mounted under the prefix of each module with their body limits, the permissions of
+// http.routes and the replays of http.idempotent; the middleware of http.middleware, such as CORS,
+// tenant resolution and rate limits, is left out
+type App struct {
+	Env      *Environment
+	Registry *domain.ModuleRegistry
+	Events   *EventRecorder
+	Client   *Client
+	// URL is the base URL of the test server
+	URL string
+}
+
+// NewApp migrates the databases of the modules, initializes and starts them, each after the modules
+// it depends on, and serves their routes
+func (e *Environment) NewApp(t testing.TB, names ...string) *App {
+	t.Helper()
+	modules.InitializeAllModules()
+
+	logger := zaptest.NewLogger(t, zaptest.Level(zap.WarnLevel))
+	app := &App{
+		Env:      e,
+		Registry: domain.NewModuleRegistry(),
+		Events:   NewEventRecorder(),
+	}
+
+	for _, name := range names {
+		e.Migrate(t, name)
+
+		module, err := registry.GetGlobalManager().CreateModule(name)
+		if err != nil {
+			t.Fatalf("failed to create module %s: %v", name, err)
+		}
+		app.Registry.Register(module)
+		if e.Config.Modules != nil {
+			app.Registry.SetConfig(name, e.Config.Modules.ResolveModule(name))
+			app.Registry.AddDependencies(name, e.Config.Modules.Modules[name].Module.DependencyNames()...)
+			worker.SetBudget(name, e.Config.Modules.Modules[name].Resources.MaxBackgroundJobs)
+		}
+	}
+
+	deps := domain.ModuleDependencies{
+		EventBus:   app.Events,
+		PublicAPIs: app.Registry.PublicAPIs(),
+		Logger:     logger,
+		ModuleCache: func(module string) domain.Cache {
+			if e.Config.Modules == nil || !e.Config.Modules.Modules[module].Features.CachingEnabled {
+				return nil
+			}
+			return cache.Namespace(e.cache, module)
+		},
+		ModuleMailer: func(module string) domain.Mailer {
+			return e.mail.ForModule(module)
+		},
+	}
+	if err := app.Registry.InitializeAll(context.Background(), deps); err != nil {
+		t.Fatalf("failed to initialize modules: %v", err)
+	}
+
+	// Commands of the modules run in transactions of their databases, as in the API
+	for _, name := range names {
+		module, _ := app.Registry.GetModule(name)
+		if dispatcher, ok := module.(application.CommandDispatcher); ok {
+			db, err := database.GetGlobalManager().GetConnection(name)
+			if err != nil {
+				t.Fatalf("failed to get database of module %s: %v", name, err)
+			}
+			dispatcher.CommandBus().Use(application.TransactionMiddleware(database.NewUnitOfWork(db)))
+		}
+	}
+
+	router, err := e.router(app.Registry, logger)
+	if err != nil {
+		t.Fatalf("failed to register routes: %v", err)
+	}
+
+	if err := app.Registry.StartAll(context.Background()); err != nil {
+		t.Fatalf("failed to start modules: %v", err)
+	}
+	server := httptest.NewServer(router)
+	t.Cleanup(func() {
+		server.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+		defer cancel()
+		if err := app.Registry.StopAll(ctx); err != nil {
+			t.Errorf("failed to stop modules: %v", err)
+		}
+	})
+
+	app.URL = server.URL
+	app.Client = newClient(server.URL, server.Client())
+	return app
+}
+
+// router creates the router of the modules' routes
+func (e *Environment) router(modules *domain.ModuleRegistry, logger *zap.Logger) (*gin.Engine, error) {
+	gin.SetMode(gin.TestMode)
+
+	limits := make(httplimit.BodyLimits)
+	policies := make(map[string]authz.RoutePolicies)
+	idempotent := make(map[string]gin.HandlerFunc)
+	if e.Config.Modules != nil {
+		ttl, err := e.Config.Idempotency.GetTTL()
+		if err != nil {
+			return nil, err
+		}
+		store := idempotency.NewMemoryStore()
+
+		for _, name := range modules.GetModuleNames() {
+			httpConfig := e.Config.Modules.GetModuleHTTPConfig(name)
+			moduleLimits, err := httplimit.ParseBodyLimits(httpConfig.BodyLimits, httpConfig.GetPrefix())
+			if err != nil {
+				return nil, err
+			}
+			limits.Merge(moduleLimits)
+
+			if len(httpConfig.Routes) > 0 {
+				if policies[name], err = authz.ParseRoutePolicies(httpConfig.Routes); err != nil {
+					return nil, err
+				}
+			}
+
+			if declared := e.Config.Modules.Modules[name].HTTP.Idempotent; len(declared) > 0 {
+				routes, err := idempotency.ParseRoutes(declared, httpConfig.GetPrefix())
+				if err != nil {
+					return nil, err
+				}
+				idempotent[name] = idempotency.Middleware(store, routes, ttl)
+			}
+		}
+	}
+
+	router := gin.New()
+	router.Use(requestid.Middleware(), logging.Recovery(logger), httplimit.Middleware(e.Config.Server.MaxBodyBytes, limits))
+
+	authorizer := authz.Lazy(modules.PublicAPIs())
+	modules.RegisterAllRoutesInGroups(func(name string) *gin.RouterGroup {
+		prefix := e.Config.Modules.GetModuleHTTPConfig(name).GetPrefix()
+		var handlers []gin.HandlerFunc
+		if modulePolicies, ok := policies[name]; ok {
+			handlers = append(handlers, modulePolicies.Middleware(authorizer, e.tokens, prefix))
+		}
+		if replay, ok := idempotent[name]; ok {
+			handlers = append(handlers, replay)
+		}
+		return router.Group(prefix, handlers...)
+	})
+	return router, nil
+}
+
+// Token issues an access token for a principal, e.g. a user created with NewUser
+// Permissions are those of the principal's roles, checked by the user module's authorizer
+func (a *App) Token(t testing.TB, principal auth.Principal) string {
+	t.Helper()
+
+	token, _, err := a.Env.tokens.Issue(principal)
+	if err != nil {
+		t.Fatalf("failed to issue a token: %v", err)
+	}
+	return token
+}
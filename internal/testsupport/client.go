@@ -0,0 +1,194 @@
+package testsupport
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Client sends requests to the routes of an App, as JSON, with the bearer token it was given
+type Client struct {
+	baseURL string
+	http    *http.Client
+	token   string
+	header  http.Header
+}
+
+// newClient creates a client of the server at baseURL
+func newClient(baseURL string, httpClient *http.Client) *Client {
+	return &Client{baseURL: baseURL, http: httpClient, header: make(http.Header)}
+}
+
+// WithToken returns a copy of the client authenticating with a bearer token
+func (c *Client) WithToken(token string) *Client {
+	clone := c.clone()
+	clone.token = token
+	return clone
+}
+
+// WithHeader returns a copy of the client sending a header with every request
+func (c *Client) WithHeader(key, value string) *Client {
+	clone := c.clone()
+	clone.header.Set(key, value)
+	return clone
+}
+
+func (c *Client) clone() *Client {
+	clone := *c
+	clone.header = c.header.Clone()
+	return &clone
+}
+
+// Get sends a GET request
+func (c *Client) Get(t testing.TB, path string) *Response {
+	t.Helper()
+	return c.Do(t, http.MethodGet, path, nil)
+}
+
+// Post sends a POST request with body as JSON
+func (c *Client) Post(t testing.TB, path string, body any) *Response {
+	t.Helper()
+	return c.Do(t, http.MethodPost, path, body)
+}
+
+// Put sends a PUT request with body as JSON
+func (c *Client) Put(t testing.TB, path string, body any) *Response {
+	t.Helper()
+	return c.Do(t, http.MethodPut, path, body)
+}
+
+// Patch sends a PATCH request with body as JSON
+func (c *Client) Patch(t testing.TB, path string, body any) *Response {
+	t.Helper()
+	return c.Do(t, http.MethodPatch, path, body)
+}
+
+// Delete sends a DELETE request
+func (c *Client) Delete(t testing.TB, path string) *Response {
+	t.Helper()
+	return c.Do(t, http.MethodDelete, path, nil)
+}
+
+// Do sends a request; body is sent as JSON unless it is nil, a string or bytes, sent as they are
+// Paths are relative to the server, e.g. "/api/v1/customers"
+func (c *Client) Do(t testing.TB, method, path string, body any) *Response {
+	t.Helper()
+
+	var reader io.Reader
+	contentType := "application/json"
+	switch value := body.(type) {
+	case nil:
+	case string:
+		reader = strings.NewReader(value)
+		contentType = "text/plain"
+	case []byte:
+		reader = bytes.NewReader(value)
+		contentType = "application/octet-stream"
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			t.Fatalf("failed to encode %s %s request: %v", method, path, err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reader)
+	if err != nil {
+		t.Fatalf("failed to create %s %s request: %v", method, path, err)
+	}
+	for key, values := range c.header {
+		req.Header[key] = values
+	}
+	if reader != nil && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read %s %s response: %v", method, path, err)
+	}
+	return &Response{Request: method + " " + path, Status: resp.StatusCode, Header: resp.Header, Body: data}
+}
+
+// Response is the response to a request of a Client
+type Response struct {
+	// Request is the method and path of the request, for failure messages
+	Request string
+	Status  int
+	Header  http.Header
+	Body    []byte
+}
+
+// envelope is the body of the API responses
+type envelope struct {
+	Success bool            `json:"success"`
+	Data    json.RawMessage `json:"data"`
+	Error   *APIError       `json:"error"`
+}
+
+// APIError is the error of a failed API response
+type APIError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// AssertStatus fails the test unless the response has the status
+func (r *Response) AssertStatus(t testing.TB, status int) *Response {
+	t.Helper()
+
+	if r.Status != status {
+		t.Fatalf("%s answered %d, want %d: %s", r.Request, r.Status, status, r.Body)
+	}
+	return r
+}
+
+// Decode decodes the JSON body into v
+func (r *Response) Decode(t testing.TB, v any) {
+	t.Helper()
+
+	if err := json.Unmarshal(r.Body, v); err != nil {
+		t.Fatalf("failed to decode the %s response: %v: %s", r.Request, err, r.Body)
+	}
+}
+
+// Data decodes the data of a successful API response into v
+func (r *Response) Data(t testing.TB, v any) {
+	t.Helper()
+
+	var body envelope
+	r.Decode(t, &body)
+	if !body.Success {
+		t.Fatalf("%s failed: %s", r.Request, r.Body)
+	}
+	if err := json.Unmarshal(body.Data, v); err != nil {
+		t.Fatalf("failed to decode the %s response data: %v: %s", r.Request, err, body.Data)
+	}
+}
+
+// AssertError fails the test unless the response is an API error with the status and code
+func (r *Response) AssertError(t testing.TB, status int, code string) *APIError {
+	t.Helper()
+
+	r.AssertStatus(t, status)
+	var body envelope
+	r.Decode(t, &body)
+	if body.Success || body.Error == nil {
+		t.Fatalf("%s answered no error: %s", r.Request, r.Body)
+	}
+	if body.Error.Code != code {
+		t.Fatalf("%s answered error %s, want %s: %s", r.Request, body.Error.Code, code, body.Error.Message)
+	}
+	return body.Error
+}
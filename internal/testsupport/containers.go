@@ -0,0 +1,123 @@
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// Images of the containers, the versions of docker/docker-compose.dev.yml
+const (
+	PostgresImage = "postgres:16-alpine"
+	RedisImage    = "redis:7-alpine"
+	VaultImage    = "hashicorp/vault:1.17"
+)
+
+const (
+	postgresUser     = "postgres"
+	postgresPassword = "postgres"
+	// vaultToken is the root token of the Vault dev server
+	vaultToken = "test-root-token"
+	// startupTimeout bounds the start of each container, image pulls included
+	startupTimeout = 2 * time.Minute
+)
+
+// dockerAvailable reports whether containers can be started
+func dockerAvailable(ctx context.Context) (ok bool, reason string) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok, reason = false, fmt.Sprint(r)
+		}
+	}()
+
+	provider, err := testcontainers.ProviderDocker.GetProvider()
+	if err != nil {
+		return false, err.Error()
+	}
+	defer provider.Close()
+	if err := provider.Health(ctx); err != nil {
+		return false, err.Error()
+	}
+	return true, ""
+}
+
+// startPostgres starts a Postgres server and returns its host and port
+func startPostgres(ctx context.Context) (testcontainers.Container, string, string, error) {
+	server, err := testcontainers.Run(ctx, PostgresImage,
+		testcontainers.WithExposedPorts("5432/tcp"),
+		testcontainers.WithEnv(map[string]string{
+			"POSTGRES_USER":     postgresUser,
+			"POSTGRES_PASSWORD": postgresPassword,
+		}),
+		// The entrypoint restarts the server once initialized, so the log line appears twice
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(startupTimeout),
+			wait.ForListeningPort("5432/tcp"),
+		),
+	)
+	if err != nil {
+		return server, "", "", fmt.Errorf("failed to start postgres: %w", err)
+	}
+
+	host, port, err := hostPort(ctx, server, "5432/tcp")
+	return server, host, port, err
+}
+
+// startRedis starts a Redis server and returns its address
+func startRedis(ctx context.Context) (testcontainers.Container, string, error) {
+	server, err := testcontainers.Run(ctx, RedisImage,
+		testcontainers.WithExposedPorts("6379/tcp"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("Ready to accept connections").WithStartupTimeout(startupTimeout),
+		),
+	)
+	if err != nil {
+		return server, "", fmt.Errorf("failed to start redis: %w", err)
+	}
+
+	host, port, err := hostPort(ctx, server, "6379/tcp")
+	return server, host + ":" + port, err
+}
+
+// startVault starts a Vault dev server, with a KV v2 engine at secret/, and returns its address
+func startVault(ctx context.Context) (testcontainers.Container, string, error) {
+	server, err := testcontainers.Run(ctx, VaultImage,
+		testcontainers.WithExposedPorts("8200/tcp"),
+		testcontainers.WithEnv(map[string]string{
+			"VAULT_DEV_ROOT_TOKEN_ID":  vaultToken,
+			"VAULT_DEV_LISTEN_ADDRESS": "0.0.0.0:8200",
+		}),
+		testcontainers.WithHostConfigModifier(func(hostConfig *container.HostConfig) {
+			hostConfig.CapAdd = append(hostConfig.CapAdd, "IPC_LOCK")
+		}),
+		testcontainers.WithWaitStrategy(
+			wait.ForHTTP("/v1/sys/health").WithPort("8200/tcp").WithStartupTimeout(startupTimeout),
+		),
+	)
+	if err != nil {
+		return server, "", fmt.Errorf("failed to start vault: %w", err)
+	}
+
+	host, port, err := hostPort(ctx, server, "8200/tcp")
+	return server, "http://" + host + ":" + port, err
+}
+
+// hostPort returns the host and the mapped port a container port is reachable at
+func hostPort(ctx context.Context, server testcontainers.Container, port string) (string, string, error) {
+	host, err := server.Host(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get container host: %w", err)
+	}
+	mapped, err := server.MappedPort(ctx, nat.Port(port))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get mapped port %s: %w", port, err)
+	}
+	return host, mapped.Port(), nil
+}
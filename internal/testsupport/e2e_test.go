@@ -0,0 +1,160 @@
+package testsupport_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	customerdomain "golang_modular_monolith/internal/modules/customer/domain"
+	customerpersistence "golang_modular_monolith/internal/modules/customer/infrastructure/persistence"
+	shareddomain "golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/testsupport"
+)
+
+// listed is the data of a list or search response, reduced to the ids of its items
+type listed []struct {
+	ID string `json:"id"`
+}
+
+func (l listed) contains(id string) bool {
+	for _, item := range l {
+		if item.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCustomerIsListedAndFound(t *testing.T) {
+	env := testsupport.Setup(t, testsupport.Options{})
+	app := env.NewApp(t, "customer")
+
+	name := testsupport.Unique("ada")
+	customer := testsupport.NewCustomer().WithName(name, "Lovelace").Create(t, app.Client)
+	app.Events.AssertPublished(t, "customer.created", customer.ID)
+
+	var customers listed
+	app.Client.Get(t, "/api/v1/customers?limit=100").AssertStatus(t, http.StatusOK).Data(t, &customers)
+	if !customers.contains(customer.ID) {
+		t.Fatalf("customer %s is not listed", customer.ID)
+	}
+
+	var found listed
+	app.Client.Get(t, "/api/v1/customers/search?q="+url.QueryEscape(name)).AssertStatus(t, http.StatusOK).Data(t, &found)
+	if len(found) != 1 || found[0].ID != customer.ID {
+		t.Fatalf("searching %q found %v, want customer %s only", name, found, customer.ID)
+	}
+}
+
+func TestConflictingCustomerSavesAreRefused(t *testing.T) {
+	env := testsupport.Setup(t, testsupport.Options{})
+	app := env.NewApp(t, "customer")
+
+	customer := testsupport.NewCustomer().Create(t, app.Client)
+
+	// Two writers load the same version; the second to save must not overwrite the first
+	ctx := context.Background()
+	repo := customerpersistence.NewPostgreSQLCustomerRepository(env.DB(t, "customer"))
+	first, err := repo.GetByID(ctx, customer.ID)
+	if err != nil {
+		t.Fatalf("failed to load customer %s: %v", customer.ID, err)
+	}
+	second, err := repo.GetByID(ctx, customer.ID)
+	if err != nil {
+		t.Fatalf("failed to load customer %s: %v", customer.ID, err)
+	}
+
+	name, err := customerdomain.NewPersonName("Ada", "Byron")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := first.UpdateName(name); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Save(ctx, first); err != nil {
+		t.Fatalf("the first save failed: %v", err)
+	}
+
+	if err := second.ChangeEmail(testsupport.Unique("conflict") + "@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	err = repo.Save(ctx, second)
+	var domainErr shareddomain.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != shareddomain.ErrCodeConcurrencyConflict {
+		t.Fatalf("the second save returned %v, want %s", err, shareddomain.ErrCodeConcurrencyConflict)
+	}
+}
+
+func TestCustomerUpdateWithStaleETagIsRefused(t *testing.T) {
+	env := testsupport.Setup(t, testsupport.Options{})
+	app := env.NewApp(t, "customer", "user")
+
+	user := testsupport.NewUser().Register(t, app.Client)
+	env.AssignRole(t, user.ID, "admin")
+	client := app.Client.WithToken(user.Login(t, app.Client))
+
+	customer := testsupport.NewCustomer().Create(t, app.Client)
+	etag := client.Get(t, "/api/v1/customers/"+customer.ID).AssertStatus(t, http.StatusOK).Header.Get("ETag")
+	if etag == "" {
+		t.Fatalf("customer %s was returned without an ETag", customer.ID)
+	}
+
+	update := testsupport.NewCustomer().WithName("Ada", "Lovelace").Request()
+	client.WithHeader("If-Match", etag).Put(t, "/api/v1/customers/"+customer.ID, update).AssertStatus(t, http.StatusOK)
+
+	// The first update moved the customer past the version the ETag names
+	update["first_name"] = "Augusta"
+	client.WithHeader("If-Match", etag).Put(t, "/api/v1/customers/"+customer.ID, update).
+		AssertError(t, http.StatusPreconditionFailed, shareddomain.ErrCodePreconditionFailed)
+	app.Events.AssertCount(t, "customer.name_updated", 1)
+}
+
+func TestOrderOfCustomerIsListedAndFound(t *testing.T) {
+	env := testsupport.Setup(t, testsupport.Options{})
+	app := env.NewApp(t, "customer", "order")
+
+	customer := testsupport.NewCustomer().Create(t, app.Client)
+	order := testsupport.NewOrder(customer.ID).WithLine(testsupport.Unique("sku"), 2, 1500).Create(t, app.Client)
+	if order.CustomerID != customer.ID {
+		t.Fatalf("order %s belongs to customer %s, want %s", order.ID, order.CustomerID, customer.ID)
+	}
+	app.Events.AssertPublished(t, "order.created", order.ID)
+
+	var orders listed
+	app.Client.Get(t, "/api/v1/orders?customer_id="+customer.ID).AssertStatus(t, http.StatusOK).Data(t, &orders)
+	if len(orders) != 1 || orders[0].ID != order.ID {
+		t.Fatalf("orders of customer %s are %v, want order %s only", customer.ID, orders, order.ID)
+	}
+
+	var found listed
+	app.Client.Get(t, "/api/v1/orders/search?q="+url.QueryEscape(order.Number)).AssertStatus(t, http.StatusOK).Data(t, &found)
+	if !found.contains(order.ID) {
+		t.Fatalf("searching %q did not find order %s", order.Number, order.ID)
+	}
+}
+
+func TestOrderOfUnknownCustomerIsRefused(t *testing.T) {
+	env := testsupport.Setup(t, testsupport.Options{})
+	app := env.NewApp(t, "customer", "order")
+
+	request := testsupport.NewOrder(testsupport.Unique("customer")).Request()
+	app.Client.Post(t, "/api/v1/orders", request).AssertError(t, http.StatusNotFound, "NOT_FOUND")
+	app.Events.AssertCount(t, "order.created", 0)
+}
+
+func TestOrderCreationIsReplayed(t *testing.T) {
+	env := testsupport.Setup(t, testsupport.Options{})
+	app := env.NewApp(t, "customer", "order")
+
+	customer := testsupport.NewCustomer().Create(t, app.Client)
+	builder := testsupport.NewOrder(customer.ID).WithIdempotencyKey(testsupport.Unique("key"))
+	first := builder.Create(t, app.Client)
+	second := builder.Create(t, app.Client)
+
+	if second.ID != first.ID {
+		t.Fatalf("the replay created order %s, want order %s", second.ID, first.ID)
+	}
+	app.Events.AssertCount(t, "order.created", 1)
+}
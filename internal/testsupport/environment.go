@@ -0,0 +1,337 @@
+// Package testsupport runs the modules against real infrastructure in integration tests: Postgres,
+// and optionally Redis and Vault, in containers started with testcontainers, the migrations of each
+// module, fixture builders, an event bus recording what is published and an HTTP client for the
+// routes of the modules.
+//
+// The containers are started once per test binary and removed when it exits; tests are skipped
+// when Docker is unavailable or with -short:
+//
+//	env := testsupport.Setup(t, testsupport.Options{})
+//	app := env.NewApp(t, "customer", "order")
+//	customer := testsupport.NewCustomer().Create(t, app.Client)
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	vault "github.com/hashicorp/vault/api"
+	"go.uber.org/zap"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"golang_modular_monolith/internal/shared/infrastructure/auth"
+	"golang_modular_monolith/internal/shared/infrastructure/cache"
+	"golang_modular_monolith/internal/shared/infrastructure/config"
+	"golang_modular_monolith/internal/shared/infrastructure/database"
+	"golang_modular_monolith/internal/shared/infrastructure/mailer"
+	"golang_modular_monolith/internal/shared/infrastructure/migration"
+	"golang_modular_monolith/internal/shared/infrastructure/tenancy"
+)
+
+// Options selects the infrastructure of the environment besides Postgres
+type Options struct {
+	// Redis backs the shared cache with a Redis server instead of memory
+	Redis bool
+	// Vault loads the configuration secrets from a Vault dev server; VaultSecrets are written to it
+	// first, keyed by their path in the secret/ KV engine, e.g. "app" or "modules/customer"
+	Vault        bool
+	VaultSecrets map[string]map[string]interface{}
+}
+
+// Environment is the infrastructure shared by the tests of a test binary
+type Environment struct {
+	// Root is the repository root, the directory the configuration is loaded from
+	Root   string
+	Config *config.Config
+
+	PostgresHost string
+	PostgresPort string
+	// RedisAddr and VaultAddr are empty unless the services were requested
+	RedisAddr  string
+	VaultAddr  string
+	VaultToken string
+
+	options Options
+	tokens  *auth.TokenService
+	mail    *mailer.Mailer
+	cache   cache.Shared
+
+	mu       sync.Mutex
+	migrated map[string]error
+}
+
+var (
+	setupOnce  sync.Once
+	shared     *Environment
+	setupErr   error
+	skipReason string
+)
+
+// Setup returns the environment of the test binary, starting it on first use
+// Later calls share the first environment, so they must not ask for services it was started without
+func Setup(t testing.TB, opts Options) *Environment {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("integration test skipped in short mode")
+	}
+
+	setupOnce.Do(func() {
+		ctx := context.Background()
+		if ok, reason := dockerAvailable(ctx); !ok {
+			skipReason = "Docker is unavailable: " + reason
+			return
+		}
+		shared, setupErr = start(ctx, opts)
+	})
+	if skipReason != "" {
+		t.Skip(skipReason)
+	}
+	if setupErr != nil {
+		t.Fatalf("failed to set up the test environment: %v", setupErr)
+	}
+	if opts.Redis && !shared.options.Redis {
+		t.Fatal("the test environment was set up without Redis; request it from the first Setup call")
+	}
+	if opts.Vault && !shared.options.Vault {
+		t.Fatal("the test environment was set up without Vault; request it from the first Setup call")
+	}
+	return shared
+}
+
+// start starts the containers and loads the configuration against them
+func start(ctx context.Context, opts Options) (*Environment, error) {
+	root, err := repositoryRoot()
+	if err != nil {
+		return nil, err
+	}
+	env := &Environment{Root: root, options: opts, migrated: make(map[string]error)}
+
+	if _, env.PostgresHost, env.PostgresPort, err = startPostgres(ctx); err != nil {
+		return nil, err
+	}
+	if opts.Redis {
+		if _, env.RedisAddr, err = startRedis(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if opts.Vault {
+		if _, env.VaultAddr, err = startVault(ctx); err != nil {
+			return nil, err
+		}
+		env.VaultToken = vaultToken
+		if err := writeSecrets(ctx, env.VaultAddr, opts.VaultSecrets); err != nil {
+			return nil, err
+		}
+		// Read by config.NewVaultClient when the configuration is loaded
+		for key, value := range map[string]string{
+			"VAULT_ENABLED":    "true",
+			"VAULT_ADDR":       env.VaultAddr,
+			"VAULT_TOKEN":      vaultToken,
+			"VAULT_MOUNT_PATH": "secret",
+		} {
+			if err := os.Setenv(key, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if env.Config, err = loadConfig(root); err != nil {
+		return nil, err
+	}
+
+	// Every module database lives on the Postgres container, under its configured name
+	for name, db := range env.Config.Databases {
+		db.Host, db.Port = env.PostgresHost, env.PostgresPort
+		db.User, db.Password, db.SSLMode = postgresUser, postgresPassword, "disable"
+		env.Config.Databases[name] = db
+	}
+	if env.RedisAddr != "" && env.Config.Modules != nil {
+		env.Config.Modules.Global.Cache.Backend = cache.BackendRedis
+		env.Config.Modules.Global.Cache.Redis.Addr = env.RedisAddr
+	}
+
+	manager := database.InitializeWithConfig(env.Config)
+	manager.Use(tenancy.NewPlugin())
+
+	if env.tokens, err = auth.InitializeWithConfig(env.Config); err != nil {
+		return nil, fmt.Errorf("failed to initialize authentication: %w", err)
+	}
+	if env.mail, err = mailer.NewFromConfig(env.Config); err != nil {
+		return nil, fmt.Errorf("failed to initialize mail: %w", err)
+	}
+	if env.cache, err = cache.NewFromConfig(env.Config); err != nil {
+		return nil, fmt.Errorf("failed to initialize cache: %w", err)
+	}
+	return env, nil
+}
+
+// repositoryRoot returns the directory of go.mod, above the working directory of the test
+func repositoryRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("go.mod not found above the working directory")
+		}
+		dir = parent
+	}
+}
+
+// loadConfig loads the configuration from the repository root, where config.LoadConfig looks for
+// config/ and the module.yaml files
+func loadConfig(root string) (*config.Config, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chdir(root); err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.Chdir(wd) }()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// writeSecrets writes secrets to the KV v2 engine of the Vault dev server
+func writeSecrets(ctx context.Context, addr string, secrets map[string]map[string]interface{}) error {
+	if len(secrets) == 0 {
+		return nil
+	}
+
+	vaultConfig := vault.DefaultConfig()
+	vaultConfig.Address = addr
+	client, err := vault.NewClient(vaultConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create vault client: %w", err)
+	}
+	client.SetToken(vaultToken)
+
+	for path, data := range secrets {
+		if _, err := client.KVv2("secret").Put(ctx, path, data); err != nil {
+			return fmt.Errorf("failed to write vault secret %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// Migrate creates the database of a module, on first use, and applies its migrations
+func (e *Environment) Migrate(t testing.TB, module string) {
+	t.Helper()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	err, ok := e.migrated[module]
+	if !ok {
+		err = e.migrate(module)
+		e.migrated[module] = err
+	}
+	if err != nil {
+		t.Fatalf("failed to migrate module %s: %v", module, err)
+	}
+}
+
+// migrate creates the database of a module and applies its migrations
+func (e *Environment) migrate(module string) error {
+	dbConfig, ok := e.Config.Databases[module]
+	if !ok {
+		return fmt.Errorf("module %s has no database configured", module)
+	}
+	if err := e.createDatabase(dbConfig.Name); err != nil {
+		return err
+	}
+
+	db, err := database.GetGlobalManager().GetConnection(module)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join("internal/modules", module, "migrations")
+	if e.Config.Modules != nil {
+		if configured, err := e.Config.Modules.GetModuleMigrationPath(module); err == nil && configured != "" {
+			path = configured
+		}
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(e.Root, path)
+	}
+
+	migrations := migration.NewMigrationManager()
+	if err := migrations.RegisterModule(module, db, path); err != nil {
+		return err
+	}
+	return migrations.MigrateUp(module)
+}
+
+// createDatabase creates a database on the Postgres container unless it exists
+func (e *Environment) createDatabase(name string) error {
+	admin, err := gorm.Open(postgres.Open(fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=postgres sslmode=disable",
+		e.PostgresHost, e.PostgresPort, postgresUser, postgresPassword)), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		return fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+	sqlDB, err := admin.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+
+	var exists bool
+	if err := admin.Raw("SELECT EXISTS (SELECT 1 FROM pg_database WHERE datname = ?)", name).Scan(&exists).Error; err != nil {
+		return fmt.Errorf("failed to look up database %s: %w", name, err)
+	}
+	if exists {
+		return nil
+	}
+	if err := admin.Exec(fmt.Sprintf("CREATE DATABASE %q", name)).Error; err != nil {
+		return fmt.Errorf("failed to create database %s: %w", name, err)
+	}
+	zap.L().Info("test database created", zap.String("database", name))
+	return nil
+}
+
+// DB returns the database of a module, migrated, for assertions on what the modules stored
+func (e *Environment) DB(t testing.TB, module string) *gorm.DB {
+	t.Helper()
+	e.Migrate(t, module)
+
+	db, err := database.GetGlobalManager().GetConnection(module)
+	if err != nil {
+		t.Fatalf("failed to connect to the %s database: %v", module, err)
+	}
+	return db
+}
+
+// Truncate empties tables of a module's database, e.g. between tests expecting no other records
+// Tables filled by migrations, such as the default roles, are left to the caller to spare
+func (e *Environment) Truncate(t testing.TB, module string, tables ...string) {
+	t.Helper()
+	if len(tables) == 0 {
+		return
+	}
+
+	quoted := make([]string, len(tables))
+	for i, table := range tables {
+		quoted[i] = fmt.Sprintf("%q", table)
+	}
+	query := "TRUNCATE " + strings.Join(quoted, ", ") + " RESTART IDENTITY CASCADE"
+	if err := e.DB(t, module).Exec(query).Error; err != nil {
+		t.Fatalf("failed to truncate %s tables: %v", module, err)
+	}
+}
@@ -0,0 +1,161 @@
+package testsupport
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"golang_modular_monolith/internal/shared/domain"
+	"golang_modular_monolith/internal/shared/infrastructure/eventbus"
+)
+
+// EventRecorder is an event bus recording the events published on it before delivering them, like
+// the in-memory bus of the application, to its subscribers
+// Without a bus to deliver to, events are only recorded, so that a module can be tested alone
+type EventRecorder struct {
+	bus domain.EventBus
+
+	mu     sync.Mutex
+	events []domain.DomainEvent
+}
+
+// NewEventRecorder creates an event recorder delivering to an in-memory bus
+func NewEventRecorder() *EventRecorder {
+	return &EventRecorder{bus: eventbus.NewInMemoryEventBus()}
+}
+
+// NewFakeEventBus creates an event recorder delivering to no subscriber
+func NewFakeEventBus() *EventRecorder {
+	return &EventRecorder{}
+}
+
+// Publish records an event and delivers it
+func (r *EventRecorder) Publish(event domain.DomainEvent) error {
+	r.mu.Lock()
+	r.events = append(r.events, event)
+	r.mu.Unlock()
+
+	if r.bus == nil {
+		return nil
+	}
+	return r.bus.Publish(event)
+}
+
+// PublishAll records and delivers events in order
+func (r *EventRecorder) PublishAll(events []domain.DomainEvent) error {
+	for _, event := range events {
+		if err := r.Publish(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Subscribe subscribes a handler to the events
+func (r *EventRecorder) Subscribe(handler domain.EventHandler) error {
+	if r.bus == nil {
+		return nil
+	}
+	return r.bus.Subscribe(handler)
+}
+
+// Unsubscribe removes a handler
+func (r *EventRecorder) Unsubscribe(handler domain.EventHandler) error {
+	if r.bus == nil {
+		return nil
+	}
+	return r.bus.Unsubscribe(handler)
+}
+
+// Events returns the events published so far, all of them without types, or those of the types
+func (r *EventRecorder) Events(types ...string) []domain.DomainEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var events []domain.DomainEvent
+	for _, event := range r.events {
+		if len(types) == 0 || slices.Contains(types, event.GetEventType()) {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// Reset forgets the events published so far
+func (r *EventRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = nil
+}
+
+// AssertPublished fails the test unless an event of the type was published for the aggregate; an
+// empty aggregateID matches any aggregate. It returns the last matching event
+func (r *EventRecorder) AssertPublished(t testing.TB, eventType, aggregateID string) domain.DomainEvent {
+	t.Helper()
+
+	events := r.matching(eventType, aggregateID)
+	if len(events) == 0 {
+		t.Fatalf("no %s event was published for aggregate %q; published: %v", eventType, aggregateID, r.types())
+		return nil
+	}
+	return events[len(events)-1]
+}
+
+// AssertNotPublished fails the test when an event of the type was published for the aggregate; an
+// empty aggregateID matches any aggregate
+func (r *EventRecorder) AssertNotPublished(t testing.TB, eventType, aggregateID string) {
+	t.Helper()
+
+	if events := r.matching(eventType, aggregateID); len(events) > 0 {
+		t.Fatalf("%d %s event(s) were published for aggregate %q", len(events), eventType, aggregateID)
+	}
+}
+
+// AssertCount fails the test unless count events of the type were published
+func (r *EventRecorder) AssertCount(t testing.TB, eventType string, count int) {
+	t.Helper()
+
+	if published := len(r.Events(eventType)); published != count {
+		t.Fatalf("%d %s event(s) were published, want %d", published, eventType, count)
+	}
+}
+
+// WaitFor waits up to timeout for an event of the type published for the aggregate, e.g. by a
+// background worker, and fails the test when none is
+func (r *EventRecorder) WaitFor(t testing.TB, eventType, aggregateID string, timeout time.Duration) domain.DomainEvent {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+	for {
+		if events := r.matching(eventType, aggregateID); len(events) > 0 {
+			return events[len(events)-1]
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("no %s event was published for aggregate %q within %s; published: %v", eventType, aggregateID, timeout, r.types())
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// matching returns the events of the type published for the aggregate
+func (r *EventRecorder) matching(eventType, aggregateID string) []domain.DomainEvent {
+	var events []domain.DomainEvent
+	for _, event := range r.Events(eventType) {
+		if aggregateID == "" || event.GetAggregateID() == aggregateID {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// types returns the type of each event published so far, in order
+func (r *EventRecorder) types() []string {
+	events := r.Events()
+	types := make([]string, len(events))
+	for i, event := range events {
+		types[i] = event.GetEventType()
+	}
+	return types
+}
@@ -0,0 +1,278 @@
+package testsupport
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+// DefaultPassword is the password of the users created with NewUser unless another is given
+const DefaultPassword = "integration-test-password"
+
+// run prefixes the unique values of the fixtures, so that records left by earlier runs against the
+// same databases never collide with new ones
+var (
+	run      = newRunID()
+	sequence atomic.Int64
+)
+
+func newRunID() string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// Unique returns a value unique to the test binary run, e.g. Unique("sku") returns "sku-1a2b3c4d-7"
+func Unique(prefix string) string {
+	return fmt.Sprintf("%s-%s-%d", prefix, run, sequence.Add(1))
+}
+
+// uniqueEmail returns an email address unique to the test binary run
+func uniqueEmail(prefix string) string {
+	return Unique(prefix) + "@example.com"
+}
+
+// CustomerBuilder builds customers created through POST /customers
+type CustomerBuilder struct {
+	request map[string]any
+}
+
+// Customer is a customer created by a CustomerBuilder
+type Customer struct {
+	ID    string `json:"customer_id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// NewCustomer starts a customer with a unique email address
+func NewCustomer() *CustomerBuilder {
+	return &CustomerBuilder{request: map[string]any{
+		"first_name": "Test",
+		"last_name":  "Customer",
+		"email":      uniqueEmail("customer"),
+	}}
+}
+
+// WithName sets the first and last name
+func (b *CustomerBuilder) WithName(first, last string) *CustomerBuilder {
+	b.request["first_name"], b.request["last_name"] = first, last
+	return b
+}
+
+// WithEmail sets the email address; see AllowDuplicates to share it with another customer
+func (b *CustomerBuilder) WithEmail(email string) *CustomerBuilder {
+	b.request["email"] = email
+	return b
+}
+
+// WithLocale sets the locale and timezone
+func (b *CustomerBuilder) WithLocale(locale, timezone string) *CustomerBuilder {
+	b.request["locale"], b.request["timezone"] = locale, timezone
+	return b
+}
+
+// AllowDuplicates creates the customer even when another has the same email address or name
+func (b *CustomerBuilder) AllowDuplicates() *CustomerBuilder {
+	b.request["allow_duplicates"] = true
+	return b
+}
+
+// Request returns the body of the request creating the customer, e.g. to send it with a variation
+func (b *CustomerBuilder) Request() map[string]any {
+	request := make(map[string]any, len(b.request))
+	for key, value := range b.request {
+		request[key] = value
+	}
+	return request
+}
+
+// Create creates the customer and fails the test unless it is created
+func (b *CustomerBuilder) Create(t testing.TB, client *Client) Customer {
+	t.Helper()
+
+	var customer Customer
+	client.Post(t, "/api/v1/customers", b.request).AssertStatus(t, http.StatusCreated).Data(t, &customer)
+	return customer
+}
+
+// OrderBuilder builds orders created through POST /orders
+type OrderBuilder struct {
+	request        map[string]any
+	lines          []map[string]any
+	idempotencyKey string
+}
+
+// Order is an order created by an OrderBuilder
+type Order struct {
+	ID         string `json:"order_id"`
+	Number     string `json:"order_number"`
+	CustomerID string `json:"customer_id"`
+	Status     string `json:"status"`
+	Currency   string `json:"currency"`
+}
+
+// NewOrder starts an order of a customer in USD; without lines, it is created with one unit of a
+// unique product at 10.00
+func NewOrder(customerID string) *OrderBuilder {
+	return &OrderBuilder{request: map[string]any{
+		"customer_id": customerID,
+		"currency":    "USD",
+	}}
+}
+
+// WithCurrency sets the ISO 4217 currency
+func (b *OrderBuilder) WithCurrency(currency string) *OrderBuilder {
+	b.request["currency"] = currency
+	return b
+}
+
+// WithLine adds a line; unitPrice is in the currency's minor unit
+func (b *OrderBuilder) WithLine(productID string, quantity int, unitPrice int64) *OrderBuilder {
+	b.lines = append(b.lines, map[string]any{
+		"product_id":   productID,
+		"product_name": "Product " + productID,
+		"quantity":     quantity,
+		"unit_price":   unitPrice,
+	})
+	return b
+}
+
+// WithCoupon applies a coupon code
+func (b *OrderBuilder) WithCoupon(code string) *OrderBuilder {
+	b.request["coupon_code"] = code
+	return b
+}
+
+// WithShippingAddress ships the order to an address in a country, also used for billing
+func (b *OrderBuilder) WithShippingAddress(name, line1, city, country string) *OrderBuilder {
+	b.request["shipping_address"] = map[string]any{
+		"name":    name,
+		"line1":   line1,
+		"city":    city,
+		"country": country,
+	}
+	return b
+}
+
+// WithIdempotencyKey sends the Idempotency-Key header, so that creating the order again replays it
+func (b *OrderBuilder) WithIdempotencyKey(key string) *OrderBuilder {
+	b.idempotencyKey = key
+	return b
+}
+
+// Request returns the body of the request creating the order
+func (b *OrderBuilder) Request() map[string]any {
+	request := make(map[string]any, len(b.request)+1)
+	for key, value := range b.request {
+		request[key] = value
+	}
+	lines := b.lines
+	if len(lines) == 0 {
+		lines = []map[string]any{{
+			"product_id":   Unique("product"),
+			"product_name": "Test product",
+			"quantity":     1,
+			"unit_price":   1000,
+		}}
+	}
+	request["lines"] = lines
+	return request
+}
+
+// Create creates the order and fails the test unless it is created
+func (b *OrderBuilder) Create(t testing.TB, client *Client) Order {
+	t.Helper()
+
+	if b.idempotencyKey != "" {
+		client = client.WithHeader("Idempotency-Key", b.idempotencyKey)
+	}
+	var order Order
+	client.Post(t, "/api/v1/orders", b.Request()).AssertStatus(t, http.StatusCreated).Data(t, &order)
+	return order
+}
+
+// UserBuilder builds users registered through POST /users/register
+type UserBuilder struct {
+	email    string
+	name     string
+	password string
+}
+
+// User is a user registered by a UserBuilder, with the password it logs in with
+type User struct {
+	ID       string   `json:"id"`
+	Email    string   `json:"email"`
+	Name     string   `json:"name"`
+	Status   string   `json:"status"`
+	Roles    []string `json:"roles"`
+	Password string   `json:"-"`
+}
+
+// NewUser starts a user with a unique email address and DefaultPassword
+func NewUser() *UserBuilder {
+	return &UserBuilder{email: uniqueEmail("user"), name: "Test User", password: DefaultPassword}
+}
+
+// WithEmail sets the email address
+func (b *UserBuilder) WithEmail(email string) *UserBuilder {
+	b.email = email
+	return b
+}
+
+// WithName sets the display name
+func (b *UserBuilder) WithName(name string) *UserBuilder {
+	b.name = name
+	return b
+}
+
+// WithPassword sets the password
+func (b *UserBuilder) WithPassword(password string) *UserBuilder {
+	b.password = password
+	return b
+}
+
+// Register registers the user and fails the test unless it is registered
+func (b *UserBuilder) Register(t testing.TB, client *Client) User {
+	t.Helper()
+
+	var user User
+	client.Post(t, "/api/v1/users/register", map[string]any{
+		"email":    b.email,
+		"name":     b.name,
+		"password": b.password,
+	}).AssertStatus(t, http.StatusCreated).Data(t, &user)
+	user.Password = b.password
+	return user
+}
+
+// Login logs a registered user in and returns its access token
+func (u User) Login(t testing.TB, client *Client) string {
+	t.Helper()
+
+	var session struct {
+		AccessToken string `json:"access_token"`
+	}
+	client.Post(t, "/api/v1/auth/login", map[string]any{
+		"email":    u.Email,
+		"password": u.Password,
+	}).AssertStatus(t, http.StatusOK).Data(t, &session)
+	return session.AccessToken
+}
+
+// AssignRole gives a registered user a role, e.g. "admin" for every permission; the user module's
+// authorizer reads roles on each request, so tokens issued before apply it too
+func (e *Environment) AssignRole(t testing.TB, userID, role string) {
+	t.Helper()
+
+	err := e.DB(t, "user").Exec(
+		`INSERT INTO user_roles (user_id, role_name) VALUES (?, ?) ON CONFLICT DO NOTHING`, userID, role,
+	).Error
+	if err != nil {
+		t.Fatalf("failed to assign role %s to user %s: %v", role, userID, err)
+	}
+}
@@ -74,7 +74,7 @@ enabled_modules=$(get_enabled_modules)
 
 if [ -z "$enabled_modules" ]; then
     echo -e "${YELLOW}⚠️ No enabled modules found. Creating default databases...${NC}"
-    enabled_modules="customer order"
+    enabled_modules="customer order product payment notification files reporting"
 fi
 
 echo -e "${BLUE}📋 Enabled modules: ${enabled_modules}${NC}"
@@ -38,12 +38,42 @@ ORDER_DATABASE_USER=postgres \
 ORDER_DATABASE_PASSWORD=postgres \
 ORDER_DATABASE_NAME=modular_monolith_order \
 ORDER_DATABASE_SSLMODE=disable \
+PRODUCT_DATABASE_HOST=localhost \
+PRODUCT_DATABASE_PORT=5433 \
+PRODUCT_DATABASE_USER=postgres \
+PRODUCT_DATABASE_PASSWORD=postgres \
+PRODUCT_DATABASE_NAME=modular_monolith_product \
+PRODUCT_DATABASE_SSLMODE=disable \
+PAYMENT_DATABASE_HOST=localhost \
+PAYMENT_DATABASE_PORT=5433 \
+PAYMENT_DATABASE_USER=postgres \
+PAYMENT_DATABASE_PASSWORD=postgres \
+PAYMENT_DATABASE_NAME=modular_monolith_payment \
+PAYMENT_DATABASE_SSLMODE=disable \
+NOTIFICATION_DATABASE_HOST=localhost \
+NOTIFICATION_DATABASE_PORT=5433 \
+NOTIFICATION_DATABASE_USER=postgres \
+NOTIFICATION_DATABASE_PASSWORD=postgres \
+NOTIFICATION_DATABASE_NAME=modular_monolith_notification \
+NOTIFICATION_DATABASE_SSLMODE=disable \
+FILES_DATABASE_HOST=localhost \
+FILES_DATABASE_PORT=5433 \
+FILES_DATABASE_USER=postgres \
+FILES_DATABASE_PASSWORD=postgres \
+FILES_DATABASE_NAME=modular_monolith_files \
+FILES_DATABASE_SSLMODE=disable \
+REPORTING_DATABASE_HOST=localhost \
+REPORTING_DATABASE_PORT=5433 \
+REPORTING_DATABASE_USER=postgres \
+REPORTING_DATABASE_PASSWORD=postgres \
+REPORTING_DATABASE_NAME=modular_monolith_reporting \
+REPORTING_DATABASE_SSLMODE=disable \
 make migrate-all-up
 
 # Start development server with hot reload
 echo "🔥 Starting development server with hot reload..."
 echo "📝 Server will be available at: http://localhost:8080"
-echo "🏥 Health check: http://localhost:8080/health"
+echo "🏥 Health check: http://localhost:8080/readyz"
 echo "📚 API endpoints: http://localhost:8080/api/v1/"
 echo ""
 echo "Press Ctrl+C to stop the development server"
@@ -46,7 +46,7 @@ echo "💡 To run migrations, run: make migrate-up"
 # Start application with hot reload
 echo "🔥 Starting application with hot reload..."
 echo "📝 Server will be available at: http://localhost:8080"
-echo "🏥 Health check: http://localhost:8080/health"
+echo "🏥 Health check: http://localhost:8080/readyz"
 echo "📚 API endpoints: http://localhost:8080/api/v1/"
 echo ""
 echo "🐳 Docker containers:"
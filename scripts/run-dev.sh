@@ -16,6 +16,41 @@ export ORDER_DATABASE_PASSWORD=postgres
 export ORDER_DATABASE_NAME=modular_monolith_order
 export ORDER_DATABASE_SSLMODE=disable
 
+export PRODUCT_DATABASE_HOST=localhost
+export PRODUCT_DATABASE_PORT=5433
+export PRODUCT_DATABASE_USER=postgres
+export PRODUCT_DATABASE_PASSWORD=postgres
+export PRODUCT_DATABASE_NAME=modular_monolith_product
+export PRODUCT_DATABASE_SSLMODE=disable
+
+export PAYMENT_DATABASE_HOST=localhost
+export PAYMENT_DATABASE_PORT=5433
+export PAYMENT_DATABASE_USER=postgres
+export PAYMENT_DATABASE_PASSWORD=postgres
+export PAYMENT_DATABASE_NAME=modular_monolith_payment
+export PAYMENT_DATABASE_SSLMODE=disable
+
+export NOTIFICATION_DATABASE_HOST=localhost
+export NOTIFICATION_DATABASE_PORT=5433
+export NOTIFICATION_DATABASE_USER=postgres
+export NOTIFICATION_DATABASE_PASSWORD=postgres
+export NOTIFICATION_DATABASE_NAME=modular_monolith_notification
+export NOTIFICATION_DATABASE_SSLMODE=disable
+
+export FILES_DATABASE_HOST=localhost
+export FILES_DATABASE_PORT=5433
+export FILES_DATABASE_USER=postgres
+export FILES_DATABASE_PASSWORD=postgres
+export FILES_DATABASE_NAME=modular_monolith_files
+export FILES_DATABASE_SSLMODE=disable
+
+export REPORTING_DATABASE_HOST=localhost
+export REPORTING_DATABASE_PORT=5433
+export REPORTING_DATABASE_USER=postgres
+export REPORTING_DATABASE_PASSWORD=postgres
+export REPORTING_DATABASE_NAME=modular_monolith_reporting
+export REPORTING_DATABASE_SSLMODE=disable
+
 export GIN_MODE=debug
 
 # Run the binary